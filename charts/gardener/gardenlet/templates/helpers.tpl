@@ -185,6 +185,13 @@ controllers:
   controllerInstallationCare:
     concurrentSyncs: {{ required ".Values.config.controllers.controllerInstallationCare.concurrentSyncs is required" .Values.config.controllers.controllerInstallationCare.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.controllerInstallationCare.syncPeriod is required" .Values.config.controllers.controllerInstallationCare.syncPeriod }}
+    {{- if .Values.config.controllers.controllerInstallationCare.heartbeatStaleThreshold }}
+    heartbeatStaleThreshold: {{ .Values.config.controllers.controllerInstallationCare.heartbeatStaleThreshold }}
+    {{- end }}
+    {{- if .Values.config.controllers.controllerInstallationCare.remediation }}
+    remediation:
+{{ toYaml .Values.config.controllers.controllerInstallationCare.remediation | indent 6 }}
+    {{- end }}
   {{- end }}
   {{- if .Values.config.controllers.controllerInstallationRequired }}
   controllerInstallationRequired:
@@ -352,6 +359,10 @@ exposureClassHandlers:
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
 {{- end}}
+{{- if .Values.nodeAgent }}
+nodeAgent:
+{{ toYaml .Values.nodeAgent | indent 2 }}
+{{- end}}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
@@ -344,7 +344,7 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				APIGroups:     []string{"coordination.k8s.io"},
 				Resources:     []string{"leases"},
 				ResourceNames: []string{"gardener-extension-heartbeat"},
-				Verbs:         []string{"get"},
+				Verbs:         []string{"get", "patch"},
 			},
 			{
 				APIGroups:     []string{"networking.istio.io"},
@@ -782,8 +782,9 @@ func ComputeExpectedGardenletConfiguration(
 				ConcurrentSyncs: &twenty,
 			},
 			ControllerInstallationCare: &gardenletconfigv1alpha1.ControllerInstallationCareControllerConfiguration{
-				ConcurrentSyncs: &twenty,
-				SyncPeriod:      &metav1.Duration{Duration: 30 * time.Second},
+				ConcurrentSyncs:         &twenty,
+				SyncPeriod:              &metav1.Duration{Duration: 30 * time.Second},
+				HeartbeatStaleThreshold: &metav1.Duration{Duration: 5 * time.Minute},
 			},
 			ControllerInstallationRequired: &gardenletconfigv1alpha1.ControllerInstallationRequiredControllerConfiguration{
 				ConcurrentSyncs: &one,
@@ -16,6 +16,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
 	initcmd "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/supportbundle"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/token"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/version"
 )
@@ -101,6 +102,7 @@ func prepareAdditionalGroup(cmd *cobra.Command, opts *cmd.Options) {
 	cmd.SetCompletionCommandGroupID(group.ID)
 
 	for _, subcommand := range []*cobra.Command{
+		supportbundle.NewCommand(opts),
 		version.NewCommand(opts),
 	} {
 		subcommand.GroupID = group.ID
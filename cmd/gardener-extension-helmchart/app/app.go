@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionscmdcontroller "github.com/gardener/gardener/extensions/pkg/controller/cmd"
+	"github.com/gardener/gardener/extensions/pkg/controller/heartbeat"
+	extensionsheartbeatcmd "github.com/gardener/gardener/extensions/pkg/controller/heartbeat/cmd"
+	extensioncontroller "github.com/gardener/gardener/pkg/extension-helmchart/controller/extension"
+	healthcheckcontroller "github.com/gardener/gardener/pkg/extension-helmchart/controller/healthcheck"
+	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+)
+
+// Name is the name of the Helm chart extension.
+const Name = "extension-helmchart"
+
+// NewControllerManagerCommand creates a new command for running the Helm chart extension controllers.
+func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
+	var (
+		restOpts = &extensionscmdcontroller.RESTOptions{}
+		mgrOpts  = &extensionscmdcontroller.ManagerOptions{
+			LeaderElection:          true,
+			LeaderElectionID:        extensionscmdcontroller.LeaderElectionNameID(Name),
+			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
+			MetricsBindAddress:      ":8080",
+			HealthBindAddress:       ":8081",
+		}
+		configOpts = &ConfigOptions{}
+
+		// options for the extension controller
+		extensionCtrlOpts = &extensionscmdcontroller.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+		reconcileOpts = &extensionscmdcontroller.ReconcilerOptions{}
+
+		// options for the health care controller
+		healthCheckCtrlOpts = &extensionscmdcontroller.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		heartbeatCtrlOptions = &extensionsheartbeatcmd.Options{
+			ExtensionName:        Name,
+			RenewIntervalSeconds: 30,
+			Namespace:            os.Getenv("LEADER_ELECTION_NAMESPACE"),
+		}
+
+		controllerSwitches = ControllerSwitchOptions()
+
+		aggOption = extensionscmdcontroller.NewOptionAggregator(
+			restOpts,
+			mgrOpts,
+			configOpts,
+			extensionscmdcontroller.PrefixOption("extension-", extensionCtrlOpts),
+			extensionscmdcontroller.PrefixOption("healthcheck-", healthCheckCtrlOpts),
+			extensionscmdcontroller.PrefixOption("heartbeat-", heartbeatCtrlOptions),
+			controllerSwitches,
+			reconcileOpts,
+		)
+	)
+
+	cmd := &cobra.Command{
+		Use: fmt.Sprintf("gardener-%s", Name),
+
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := aggOption.Complete(); err != nil {
+				return fmt.Errorf("error completing options: %w", err)
+			}
+
+			if err := heartbeatCtrlOptions.Validate(); err != nil {
+				return err
+			}
+
+			mgr, err := manager.New(restOpts.Completed().Config, mgrOpts.Completed().Options())
+			if err != nil {
+				return fmt.Errorf("could not instantiate manager: %w", err)
+			}
+
+			if err := controller.AddToScheme(mgr.GetScheme()); err != nil {
+				return fmt.Errorf("could not update manager scheme: %w", err)
+			}
+
+			mgr.GetLogger().Info("Adding controllers to manager")
+			extensionCtrlOpts.Completed().Apply(&extensioncontroller.DefaultAddOptions.Controller)
+			healthCheckCtrlOpts.Completed().Apply(&healthcheckcontroller.DefaultAddOptions.Controller)
+			heartbeatCtrlOptions.Completed().Apply(&heartbeat.DefaultAddOptions)
+
+			reconcileOpts.Completed().Apply(&extensioncontroller.DefaultAddOptions.IgnoreOperationAnnotation, &extensioncontroller.DefaultAddOptions.ExtensionClass)
+			reconcileOpts.Completed().Apply(nil, &healthcheckcontroller.DefaultAddOptions.ExtensionClass)
+
+			extensioncontroller.DefaultAddOptions.Config = configOpts.Completed()
+			extensioncontroller.DefaultAddOptions.PullSecretNamespace = os.Getenv("LEADER_ELECTION_NAMESPACE")
+			healthcheckcontroller.DefaultAddOptions.Config = configOpts.Completed()
+
+			if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+				return fmt.Errorf("could not add healthcheck: %w", err)
+			}
+			if err := mgr.AddHealthzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline)); err != nil {
+				return err
+			}
+			if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
+				return fmt.Errorf("could not add readycheck for informers: %w", err)
+			}
+
+			if err := controllerSwitches.Completed().AddToManager(ctx, mgr); err != nil {
+				return fmt.Errorf("could not add controllers to manager: %w", err)
+			}
+
+			if err := mgr.Start(ctx); err != nil {
+				return fmt.Errorf("error running manager: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	aggOption.AddFlags(cmd.Flags())
+
+	return cmd
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	extensionscmdcontroller "github.com/gardener/gardener/extensions/pkg/controller/cmd"
+	extensionshealthcheckcontroller "github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	extensionsheartbeatcontroller "github.com/gardener/gardener/extensions/pkg/controller/heartbeat"
+	helmchartconfigv1alpha1 "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1"
+	helmchartvalidation "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1/validation"
+	extensioncontroller "github.com/gardener/gardener/pkg/extension-helmchart/controller/extension"
+	healthcheckcontroller "github.com/gardener/gardener/pkg/extension-helmchart/controller/healthcheck"
+)
+
+var configDecoder runtime.Decoder
+
+func init() {
+	configScheme := runtime.NewScheme()
+	utilruntime.Must(helmchartconfigv1alpha1.AddToScheme(configScheme))
+	configDecoder = serializer.NewCodecFactory(configScheme).UniversalDecoder()
+}
+
+// ConfigOptions are command line options that can be set for the configuration of the Helm chart extension.
+type ConfigOptions struct {
+	// ConfigFilePath is the path to the configuration file.
+	ConfigFilePath string
+
+	config *helmchartconfigv1alpha1.ControllerConfiguration
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *ConfigOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ConfigFilePath, "config-file", o.ConfigFilePath, "Path to the file containing the ControllerConfiguration.")
+}
+
+// Complete implements Completer.Complete.
+func (o *ConfigOptions) Complete() error {
+	if len(o.ConfigFilePath) == 0 {
+		return fmt.Errorf("missing config file")
+	}
+
+	data, err := os.ReadFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("error reading config file: %w", err)
+	}
+
+	o.config = &helmchartconfigv1alpha1.ControllerConfiguration{}
+	if err := runtime.DecodeInto(configDecoder, data, o.config); err != nil {
+		return fmt.Errorf("error decoding config: %w", err)
+	}
+
+	if errs := helmchartvalidation.ValidateControllerConfiguration(o.config); len(errs) > 0 {
+		return fmt.Errorf("invalid config: %w", errs.ToAggregate())
+	}
+
+	return nil
+}
+
+// Completed returns the decoded ControllerConfiguration. Only call this if `Complete` was successful.
+func (o *ConfigOptions) Completed() *helmchartconfigv1alpha1.ControllerConfiguration {
+	return o.config
+}
+
+// ControllerSwitchOptions are the extensionscmdcontroller.SwitchOptions for the Helm chart extension controllers.
+func ControllerSwitchOptions() *extensionscmdcontroller.SwitchOptions {
+	return extensionscmdcontroller.NewSwitchOptions(
+		extensionscmdcontroller.Switch(extensioncontroller.ControllerName, extensioncontroller.AddToManager),
+		extensionscmdcontroller.Switch(extensionshealthcheckcontroller.ControllerName, healthcheckcontroller.AddToManager),
+		extensionscmdcontroller.Switch(extensionsheartbeatcontroller.ControllerName, extensionsheartbeatcontroller.AddToManager),
+	)
+}
@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -46,6 +47,7 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/bootstrappers"
 	"github.com/gardener/gardener/pkg/nodeagent/controller"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/supportbundle"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -115,7 +117,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		extraHandlers = maps.Clone(routes.ProfilingHandlers)
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -134,6 +136,20 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		return fmt.Errorf("failed fetching name of node: %w", err)
 	}
 
+	var supportBundleCollector *supportbundle.Collector
+	if cfg.Server.SupportBundle != nil && cfg.Server.SupportBundle.Enabled {
+		supportBundleCollector = &supportbundle.Collector{
+			Log:          log.WithName("support-bundle"),
+			FS:           fs,
+			DBus:         dbus.New(log),
+			Clock:        clock.RealClock{},
+			NodeName:     nodeName,
+			HostName:     hostName,
+			JournalUnits: supportbundle.DefaultJournalUnits,
+			JournalLines: ptr.Deref(cfg.Server.SupportBundle.JournalLines, supportbundle.DefaultJournalLines),
+		}
+	}
+
 	log.Info("Setting up manager")
 	mgr, err := manager.New(restConfig, manager.Options{
 		Logger:                  log,
@@ -152,6 +168,14 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		return err
 	}
 
+	if supportBundleCollector != nil {
+		log.Info("Serving support bundle endpoint on loopback interface", "port", cfg.Server.SupportBundle.Port, "path", supportbundle.HandlerPath)
+		supportBundleCollector.Client = mgr.GetAPIReader()
+		if err := mgr.Add(supportbundle.NewServer(supportBundleCollector, cfg.Server.SupportBundle.Port)); err != nil {
+			return fmt.Errorf("failed adding support bundle server to manager: %w", err)
+		}
+	}
+
 	log.Info("Setting up health check endpoints")
 	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
 		return err
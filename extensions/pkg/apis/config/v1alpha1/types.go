@@ -20,6 +20,29 @@ type HealthCheckConfig struct {
 	// ShootRESTOptions allow overwriting certain default settings of the shoot rest.Config.
 	// +optional
 	ShootRESTOptions *RESTOptions `json:"shootRESTOptions,omitempty"`
+	// UnhealthyThreshold is the number of consecutive unsuccessful results of a health check after which a healthy
+	// condition is transitioned to `False`. Until then, the condition keeps its previous status. It must be at least 1.
+	// defaults to 1
+	// +optional
+	UnhealthyThreshold *int32 `json:"unhealthyThreshold,omitempty"`
+	// FlappingDetection configures the detection of health checks which frequently change their status.
+	// If not set, flapping health checks are not detected.
+	// +optional
+	FlappingDetection *FlappingDetection `json:"flappingDetection,omitempty"`
+}
+
+// FlappingDetection configures the detection of flapping health checks.
+type FlappingDetection struct {
+	// HistorySize is the number of recent results per condition type which are considered for the detection. It must
+	// be at least 2.
+	// defaults to 10
+	// +optional
+	HistorySize *int32 `json:"historySize,omitempty"`
+	// TransitionThreshold is the number of transitions between healthy and unhealthy results within the history
+	// after which the health check is considered to be flapping. It must be between 1 and HistorySize-1.
+	// defaults to 4
+	// +optional
+	TransitionThreshold *int32 `json:"transitionThreshold,omitempty"`
 }
 
 // RESTOptions define a subset of optional parameters for a rest.Config.
@@ -13,6 +13,32 @@ import (
 	time "time"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlappingDetection) DeepCopyInto(out *FlappingDetection) {
+	*out = *in
+	if in.HistorySize != nil {
+		in, out := &in.HistorySize, &out.HistorySize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TransitionThreshold != nil {
+		in, out := &in.TransitionThreshold, &out.TransitionThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlappingDetection.
+func (in *FlappingDetection) DeepCopy() *FlappingDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(FlappingDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 	*out = *in
@@ -22,6 +48,16 @@ func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 		*out = new(RESTOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UnhealthyThreshold != nil {
+		in, out := &in.UnhealthyThreshold, &out.UnhealthyThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FlappingDetection != nil {
+		in, out := &in.FlappingDetection, &out.FlappingDetection
+		*out = new(FlappingDetection)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
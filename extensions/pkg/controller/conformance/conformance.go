@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/extensions"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	// DefaultPollInterval is the default interval for polling the extension objects.
+	DefaultPollInterval = time.Second
+	// DefaultTimeout is the default timeout for a single scenario.
+	DefaultTimeout = 5 * time.Minute
+)
+
+// TestCase describes the conformance tests for an extension resource of a given kind.
+type TestCase struct {
+	// Kind is the kind of the extension resource, e.g. extensionsv1alpha1.InfrastructureResource.
+	Kind string
+	// NewObject returns a new extension object which is handled by the extension under test. The object must be created
+	// in the given namespace (or be cluster-scoped). Its name must be set.
+	NewObject func(namespace string) extensionsv1alpha1.Object
+	// Update mutates the spec of the given object for the Update scenario. If it is nil, the Update scenario only
+	// requests another reconciliation.
+	Update func(obj extensionsv1alpha1.Object)
+	// Verify is an optional function with provider-specific checks which is called after each successful
+	// reconciliation and restoration of the object.
+	Verify func(ctx context.Context, obj extensionsv1alpha1.Object) error
+	// SkipMigration skips the Migrate and Restore scenarios, e.g. for kinds which are not migrated with the shoot
+	// control plane.
+	SkipMigration bool
+	// RequireProviderStatus requires the extension to report the `.status.providerStatus` field after reconciliation.
+	RequireProviderStatus bool
+	// RequireState requires the extension to persist its state in the `.status.state` field for the migration.
+	RequireState bool
+}
+
+// Config contains the configuration of the conformance test suite.
+type Config struct {
+	// Client is a client for the cluster the extension under test is running against.
+	Client client.Client
+	// Log is the logger used by the suite.
+	Log logr.Logger
+	// ExtensionName is the name of the extension under test. It is only used in the report.
+	ExtensionName string
+	// NewCluster returns the Cluster resource for the namespace the extension objects are created in. If it is nil, no
+	// Cluster resource is created.
+	NewCluster func(namespace string) *extensionsv1alpha1.Cluster
+	// HeartbeatNamespace is the namespace of the heartbeat Lease of the extension. If it is empty, the Heartbeat
+	// scenario is skipped.
+	HeartbeatNamespace string
+	// PollInterval is the interval for polling the extension objects. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+	// Timeout is the timeout for a single scenario. Defaults to DefaultTimeout.
+	Timeout time.Duration
+	// ReportPath is the path of the file the conformance report is written to. If it is empty, no report is written.
+	ReportPath string
+}
+
+// Suite drives the actuators of an extension through the conformance scenarios.
+type Suite struct {
+	config Config
+	// Report contains the results of all executed scenarios.
+	Report *Report
+}
+
+// NewSuite creates a new Suite for the given configuration.
+func NewSuite(config Config) *Suite {
+	if config.PollInterval == 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	return &Suite{
+		config: config,
+		Report: NewReport(config.ExtensionName),
+	}
+}
+
+// Reconcile creates the given object and waits until it has been reconciled successfully.
+func (s *Suite) Reconcile(ctx context.Context, testCase TestCase, obj extensionsv1alpha1.Object) error {
+	setOperationAnnotations(obj, v1beta1constants.GardenerOperationReconcile)
+	if err := s.config.Client.Create(ctx, obj); err != nil {
+		return fmt.Errorf("failed creating %s: %w", testCase.Kind, err)
+	}
+
+	return s.waitUntilReconciled(ctx, testCase, obj, gardencorev1beta1.LastOperationTypeCreate, gardencorev1beta1.LastOperationTypeReconcile)
+}
+
+// Update updates the spec of the given object, requests a reconciliation and waits until it has been reconciled
+// successfully.
+func (s *Suite) Update(ctx context.Context, testCase TestCase, obj extensionsv1alpha1.Object) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	if testCase.Update != nil {
+		testCase.Update(obj)
+	}
+	setOperationAnnotations(obj, v1beta1constants.GardenerOperationReconcile)
+	if err := s.config.Client.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("failed updating %s: %w", testCase.Kind, err)
+	}
+
+	return s.waitUntilReconciled(ctx, testCase, obj, gardencorev1beta1.LastOperationTypeReconcile)
+}
+
+// Migrate migrates the given object and verifies that the extension released it, i.e. that the finalizer was removed
+// and the state required for the restoration was persisted. Afterwards, the object is deleted like the gardenlet does
+// in the source seed.
+func (s *Suite) Migrate(ctx context.Context, testCase TestCase, obj extensionsv1alpha1.Object) error {
+	if err := extensions.MigrateExtensionObject(ctx, s.config.Client, obj); err != nil {
+		return fmt.Errorf("failed annotating %s with migrate operation: %w", testCase.Kind, err)
+	}
+
+	if err := extensions.WaitUntilExtensionObjectMigrated(ctx, s.config.Client, obj, testCase.Kind, s.config.PollInterval, s.config.Timeout); err != nil {
+		return err
+	}
+
+	if err := retry.UntilTimeout(ctx, s.config.PollInterval, s.config.Timeout, func(ctx context.Context) (bool, error) {
+		if err := s.config.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return retry.SevereError(err)
+		}
+		if err := VerifyMigrated(obj, testCase.RequireState); err != nil {
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	}); err != nil {
+		return err
+	}
+
+	if err := extensions.DeleteExtensionObject(ctx, s.config.Client, obj); err != nil {
+		return fmt.Errorf("failed deleting migrated %s: %w", testCase.Kind, err)
+	}
+
+	return extensions.WaitUntilExtensionObjectDeleted(ctx, s.config.Client, s.config.Log, obj, testCase.Kind, s.config.PollInterval, s.config.Timeout)
+}
+
+// Restore recreates the given object with its state like the gardenlet does in the destination seed and waits until it
+// has been restored successfully. The object is expected to be the one which has been migrated before.
+func (s *Suite) Restore(ctx context.Context, testCase TestCase, obj extensionsv1alpha1.Object) (extensionsv1alpha1.Object, error) {
+	var (
+		status   = obj.GetExtensionStatus()
+		restored = testCase.NewObject(obj.GetNamespace())
+	)
+
+	setOperationAnnotations(restored, v1beta1constants.GardenerOperationWaitForState)
+	if err := s.config.Client.Create(ctx, restored); err != nil {
+		return nil, fmt.Errorf("failed recreating %s: %w", testCase.Kind, err)
+	}
+
+	patch := client.MergeFrom(restored.DeepCopyObject().(client.Object))
+	restored.GetExtensionStatus().SetState(status.GetState())
+	restored.GetExtensionStatus().SetResources(status.GetResources())
+	if err := s.config.Client.Status().Patch(ctx, restored, patch); err != nil {
+		return nil, fmt.Errorf("failed restoring state of %s: %w", testCase.Kind, err)
+	}
+
+	if err := extensions.AnnotateObjectWithOperation(ctx, s.config.Client, restored, v1beta1constants.GardenerOperationRestore); err != nil {
+		return nil, fmt.Errorf("failed annotating %s with restore operation: %w", testCase.Kind, err)
+	}
+
+	return restored, s.waitUntilReconciled(ctx, testCase, restored, gardencorev1beta1.LastOperationTypeRestore)
+}
+
+// Delete deletes the given object and waits until it is gone.
+func (s *Suite) Delete(ctx context.Context, testCase TestCase, obj extensionsv1alpha1.Object) error {
+	if err := extensions.DeleteExtensionObject(ctx, s.config.Client, obj); err != nil {
+		return fmt.Errorf("failed deleting %s: %w", testCase.Kind, err)
+	}
+
+	return extensions.WaitUntilExtensionObjectDeleted(ctx, s.config.Client, s.config.Log, obj, testCase.Kind, s.config.PollInterval, s.config.Timeout)
+}
+
+// Heartbeat verifies that the extension maintains its heartbeat Lease, i.e. that the Lease is renewed before it
+// expires.
+func (s *Suite) Heartbeat(ctx context.Context) error {
+	var (
+		lease            = &coordinationv1.Lease{}
+		leaseKey         = client.ObjectKey{Namespace: s.config.HeartbeatNamespace, Name: extensions.HeartBeatResourceName}
+		initialRenewTime time.Time
+	)
+
+	return retry.UntilTimeout(ctx, s.config.PollInterval, s.config.Timeout, func(ctx context.Context) (bool, error) {
+		if err := s.config.Client.Get(ctx, leaseKey, lease); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.MinorError(fmt.Errorf("heartbeat Lease %s does not exist yet", leaseKey))
+			}
+			return retry.SevereError(err)
+		}
+
+		if err := VerifyHeartbeat(lease, time.Now()); err != nil {
+			return retry.MinorError(err)
+		}
+
+		if initialRenewTime.IsZero() {
+			initialRenewTime = lease.Spec.RenewTime.Time
+		}
+		if !lease.Spec.RenewTime.After(initialRenewTime) {
+			return retry.MinorError(fmt.Errorf("heartbeat Lease %s has not been renewed since %s yet", leaseKey, initialRenewTime.Format(time.RFC3339)))
+		}
+
+		return retry.Ok()
+	})
+}
+
+func (s *Suite) waitUntilReconciled(ctx context.Context, testCase TestCase, obj extensionsv1alpha1.Object, expectedTypes ...gardencorev1beta1.LastOperationType) error {
+	return extensions.WaitUntilExtensionObjectReady(ctx, s.config.Client, s.config.Log, obj, testCase.Kind, s.config.PollInterval, s.config.Timeout, s.config.Timeout, func() error {
+		if err := VerifyReconciled(obj, testCase.RequireProviderStatus, expectedTypes...); err != nil {
+			return err
+		}
+		if testCase.Verify != nil {
+			return testCase.Verify(ctx, obj)
+		}
+		return nil
+	})
+}
+
+// setOperationAnnotations sets the operation and timestamp annotations like the gardenlet does when it deploys
+// extension objects.
+func setOperationAnnotations(obj extensionsv1alpha1.Object, operation string) {
+	kubernetesutils.SetMetaDataAnnotation(obj, v1beta1constants.GardenerOperation, operation)
+	kubernetesutils.SetMetaDataAnnotation(obj, v1beta1constants.GardenerTimestamp, extensions.TimeNow().UTC().Format(time.RFC3339Nano))
+}
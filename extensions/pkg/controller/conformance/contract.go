@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// finalizerPrefix is the prefix of the finalizers the generic extension controllers add to the extension objects.
+const finalizerPrefix = "extensions.gardener.cloud/"
+
+// VerifyReconciled verifies that the status of the given object fulfills the contract for a successfully completed
+// operation of one of the given types.
+func VerifyReconciled(obj extensionsv1alpha1.Object, requireProviderStatus bool, expectedTypes ...gardencorev1beta1.LastOperationType) error {
+	status := obj.GetExtensionStatus()
+
+	lastOperation := status.GetLastOperation()
+	if lastOperation == nil {
+		return fmt.Errorf("status.lastOperation is not set")
+	}
+	if !slices.Contains(expectedTypes, lastOperation.Type) {
+		return fmt.Errorf("status.lastOperation.type is %q but expected one of %v", lastOperation.Type, expectedTypes)
+	}
+	if lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return fmt.Errorf("status.lastOperation.state is %q but expected %q", lastOperation.State, gardencorev1beta1.LastOperationStateSucceeded)
+	}
+	if lastOperation.Progress != 100 {
+		return fmt.Errorf("status.lastOperation.progress is %d but expected 100", lastOperation.Progress)
+	}
+	if lastError := status.GetLastError(); lastError != nil {
+		return fmt.Errorf("status.lastError is still set after a successful operation: %s", lastError.Description)
+	}
+	if status.GetObservedGeneration() != obj.GetGeneration() {
+		return fmt.Errorf("status.observedGeneration is %d but the generation is %d", status.GetObservedGeneration(), obj.GetGeneration())
+	}
+	if operation, ok := obj.GetAnnotations()[v1beta1constants.GardenerOperation]; ok {
+		return fmt.Errorf("operation annotation %s=%s has not been removed", v1beta1constants.GardenerOperation, operation)
+	}
+	if !hasExtensionFinalizer(obj) {
+		return fmt.Errorf("finalizer with prefix %q is missing", finalizerPrefix)
+	}
+	if requireProviderStatus && status.GetProviderStatus() == nil {
+		return fmt.Errorf("status.providerStatus is not set")
+	}
+
+	return nil
+}
+
+// VerifyMigrated verifies that the given object fulfills the contract for a successfully completed migration, i.e.
+// that the extension released the object and persisted the state required for the restoration.
+func VerifyMigrated(obj extensionsv1alpha1.Object, requireState bool) error {
+	status := obj.GetExtensionStatus()
+
+	lastOperation := status.GetLastOperation()
+	if lastOperation == nil || lastOperation.Type != gardencorev1beta1.LastOperationTypeMigrate || lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return fmt.Errorf("status.lastOperation does not report a successful migration")
+	}
+	if hasExtensionFinalizer(obj) {
+		return fmt.Errorf("finalizer with prefix %q has not been removed after the migration", finalizerPrefix)
+	}
+	if operation, ok := obj.GetAnnotations()[v1beta1constants.GardenerOperation]; ok {
+		return fmt.Errorf("operation annotation %s=%s has not been removed", v1beta1constants.GardenerOperation, operation)
+	}
+	if requireState && status.GetState() == nil {
+		return fmt.Errorf("status.state is not set after the migration")
+	}
+
+	return nil
+}
+
+// VerifyHeartbeat verifies that the given heartbeat Lease has been renewed and is not expired at the given time.
+func VerifyHeartbeat(lease *coordinationv1.Lease, now time.Time) error {
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" {
+		return fmt.Errorf("heartbeat Lease does not have a holder identity")
+	}
+	if lease.Spec.RenewTime == nil {
+		return fmt.Errorf("heartbeat Lease has never been renewed")
+	}
+	if lease.Spec.LeaseDurationSeconds == nil || *lease.Spec.LeaseDurationSeconds <= 0 {
+		return fmt.Errorf("heartbeat Lease does not have a lease duration")
+	}
+	if expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second); now.After(expiry) {
+		return fmt.Errorf("heartbeat Lease expired at %s", expiry.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func hasExtensionFinalizer(obj extensionsv1alpha1.Object) bool {
+	return slices.ContainsFunc(obj.GetFinalizers(), func(finalizer string) bool {
+		return strings.HasPrefix(finalizer, finalizerPrefix)
+	})
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/conformance"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Contract", func() {
+	var infrastructure *extensionsv1alpha1.Infrastructure
+
+	BeforeEach(func() {
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "foo",
+				Namespace:  "bar",
+				Generation: 2,
+				Finalizers: []string{"extensions.gardener.cloud/infrastructure"},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ObservedGeneration: 2,
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:     gardencorev1beta1.LastOperationTypeReconcile,
+						State:    gardencorev1beta1.LastOperationStateSucceeded,
+						Progress: 100,
+					},
+					ProviderStatus: &runtime.RawExtension{Raw: []byte(`{}`)},
+				},
+			},
+		}
+	})
+
+	Describe("#VerifyReconciled", func() {
+		It("should succeed if the status fulfills the contract", func() {
+			Expect(conformance.VerifyReconciled(infrastructure, true, gardencorev1beta1.LastOperationTypeReconcile)).To(Succeed())
+		})
+
+		It("should fail if the last operation has an unexpected type", func() {
+			Expect(conformance.VerifyReconciled(infrastructure, false, gardencorev1beta1.LastOperationTypeRestore)).To(MatchError(ContainSubstring(`status.lastOperation.type is "Reconcile"`)))
+		})
+
+		It("should fail if the last operation did not succeed", func() {
+			infrastructure.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+			Expect(conformance.VerifyReconciled(infrastructure, false, gardencorev1beta1.LastOperationTypeReconcile)).To(MatchError(ContainSubstring(`status.lastOperation.state is "Error"`)))
+		})
+
+		It("should fail if the last error was not reset", func() {
+			infrastructure.Status.LastError = &gardencorev1beta1.LastError{Description: "some error"}
+			Expect(conformance.VerifyReconciled(infrastructure, false, gardencorev1beta1.LastOperationTypeReconcile)).To(MatchError(ContainSubstring("status.lastError is still set")))
+		})
+
+		It("should fail if the observed generation is outdated", func() {
+			infrastructure.Status.ObservedGeneration = 1
+			Expect(conformance.VerifyReconciled(infrastructure, false, gardencorev1beta1.LastOperationTypeReconcile)).To(MatchError(ContainSubstring("status.observedGeneration is 1")))
+		})
+
+		It("should fail if the operation annotation was not removed", func() {
+			metav1.SetMetaDataAnnotation(&infrastructure.ObjectMeta, "gardener.cloud/operation", "reconcile")
+			Expect(conformance.VerifyReconciled(infrastructure, false, gardencorev1beta1.LastOperationTypeReconcile)).To(MatchError(ContainSubstring("operation annotation")))
+		})
+
+		It("should fail if the finalizer is missing", func() {
+			infrastructure.Finalizers = nil
+			Expect(conformance.VerifyReconciled(infrastructure, false, gardencorev1beta1.LastOperationTypeReconcile)).To(MatchError(ContainSubstring("finalizer")))
+		})
+
+		It("should fail if the provider status is required but missing", func() {
+			infrastructure.Status.ProviderStatus = nil
+			Expect(conformance.VerifyReconciled(infrastructure, false, gardencorev1beta1.LastOperationTypeReconcile)).To(Succeed())
+			Expect(conformance.VerifyReconciled(infrastructure, true, gardencorev1beta1.LastOperationTypeReconcile)).To(MatchError("status.providerStatus is not set"))
+		})
+	})
+
+	Describe("#VerifyMigrated", func() {
+		BeforeEach(func() {
+			infrastructure.Finalizers = nil
+			infrastructure.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeMigrate
+			infrastructure.Status.State = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+		})
+
+		It("should succeed if the object was released", func() {
+			Expect(conformance.VerifyMigrated(infrastructure, true)).To(Succeed())
+		})
+
+		It("should fail if the migration did not succeed", func() {
+			infrastructure.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+			Expect(conformance.VerifyMigrated(infrastructure, false)).To(MatchError(ContainSubstring("does not report a successful migration")))
+		})
+
+		It("should fail if the finalizer was not removed", func() {
+			infrastructure.Finalizers = []string{"extensions.gardener.cloud/infrastructure"}
+			Expect(conformance.VerifyMigrated(infrastructure, false)).To(MatchError(ContainSubstring("has not been removed after the migration")))
+		})
+
+		It("should fail if the state is required but missing", func() {
+			infrastructure.Status.State = nil
+			Expect(conformance.VerifyMigrated(infrastructure, false)).To(Succeed())
+			Expect(conformance.VerifyMigrated(infrastructure, true)).To(MatchError("status.state is not set after the migration"))
+		})
+	})
+
+	Describe("#VerifyHeartbeat", func() {
+		var (
+			now   time.Time
+			lease *coordinationv1.Lease
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			lease = &coordinationv1.Lease{
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("provider-foo"),
+					LeaseDurationSeconds: ptr.To[int32](30),
+					RenewTime:            &metav1.MicroTime{Time: now.Add(-10 * time.Second)},
+				},
+			}
+		})
+
+		It("should succeed if the lease is valid", func() {
+			Expect(conformance.VerifyHeartbeat(lease, now)).To(Succeed())
+		})
+
+		It("should fail if the lease was never renewed", func() {
+			lease.Spec.RenewTime = nil
+			Expect(conformance.VerifyHeartbeat(lease, now)).To(MatchError("heartbeat Lease has never been renewed"))
+		})
+
+		It("should fail if the lease is expired", func() {
+			Expect(conformance.VerifyHeartbeat(lease, now.Add(time.Minute))).To(MatchError(ContainSubstring("heartbeat Lease expired at")))
+		})
+	})
+})
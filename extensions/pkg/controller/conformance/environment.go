@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/component/extensions/crds"
+)
+
+// NewTestEnvironment returns a new envtest environment which installs all extensions CRDs. Further CRDs required by the
+// extension under test (e.g. the machine CRDs for worker extensions) can be added to the returned environment's
+// CRDInstallOptions before starting it.
+func NewTestEnvironment() (*envtest.Environment, error) {
+	var customResourceDefinitions []*apiextensionsv1.CustomResourceDefinition
+	for _, manifest := range crds.Manifests(true, true) {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal([]byte(manifest), crd); err != nil {
+			return nil, fmt.Errorf("failed decoding extensions CRD: %w", err)
+		}
+		customResourceDefinitions = append(customResourceDefinitions, crd)
+	}
+
+	return &envtest.Environment{
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			CRDs: customResourceDefinitions,
+		},
+	}, nil
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// DescribeSuite registers the conformance tests for the given test cases as an ordered Ginkgo container. The
+// configuration is retrieved lazily so that it can be populated in a BeforeSuite node, e.g. after starting the test
+// environment and the extension controllers. It is meant to be called in a top-level `var _ = ...` declaration.
+// If a scenario fails, the remaining scenarios of the same test case are skipped while the other test cases are still
+// executed.
+func DescribeSuite(getConfig func() Config, testCases ...TestCase) bool {
+	return ginkgo.Describe("Extension conformance", ginkgo.Ordered, ginkgo.ContinueOnFailure, func() {
+		var (
+			ctx       = context.Background()
+			suite     *Suite
+			namespace *corev1.Namespace
+		)
+
+		ginkgo.BeforeAll(func() {
+			config := getConfig()
+			suite = NewSuite(config)
+
+			ginkgo.By("Create test Namespace")
+			namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: "conformance-"}}
+			gomega.Expect(config.Client.Create(ctx, namespace)).To(gomega.Succeed())
+
+			ginkgo.DeferCleanup(func() {
+				ginkgo.By("Delete test Namespace")
+				gomega.Expect(client.IgnoreNotFound(config.Client.Delete(ctx, namespace))).To(gomega.Succeed())
+			})
+
+			if config.NewCluster != nil {
+				ginkgo.By("Create Cluster")
+				cluster := config.NewCluster(namespace.Name)
+				gomega.Expect(config.Client.Create(ctx, cluster)).To(gomega.Succeed())
+
+				ginkgo.DeferCleanup(func() {
+					ginkgo.By("Delete Cluster")
+					gomega.Expect(client.IgnoreNotFound(config.Client.Delete(ctx, cluster))).To(gomega.Succeed())
+				})
+			}
+		})
+
+		ginkgo.AfterAll(func() {
+			for _, testCase := range testCases {
+				for _, scenario := range Scenarios {
+					if !suite.Report.Has(testCase.Kind, scenario) {
+						suite.Report.Skip(testCase.Kind, scenario, "the scenario was not executed")
+					}
+				}
+			}
+			if !suite.Report.Has("", ScenarioHeartbeat) {
+				suite.Report.Skip("", ScenarioHeartbeat, "the scenario was not executed")
+			}
+
+			ginkgo.AddReportEntry("Conformance report", suite.Report.Summary())
+			if path := suite.config.ReportPath; path != "" {
+				gomega.Expect(suite.Report.WriteFile(path)).To(gomega.Succeed())
+			}
+		})
+
+		run := func(kind string, scenario Scenario, fn func() error) error {
+			start := time.Now()
+			err := fn()
+			suite.Report.Record(kind, scenario, time.Since(start), err)
+			return err
+		}
+
+		for _, testCase := range testCases {
+			ginkgo.Context(testCase.Kind, func() {
+				var (
+					obj    extensionsv1alpha1.Object
+					failed bool
+				)
+
+				scenario := func(scenario Scenario, fn func() error) {
+					if failed {
+						suite.Report.Skip(testCase.Kind, scenario, "a previous scenario failed")
+						ginkgo.Skip("a previous scenario of " + testCase.Kind + " failed")
+					}
+					if testCase.SkipMigration && (scenario == ScenarioMigrate || scenario == ScenarioRestore) {
+						suite.Report.Skip(testCase.Kind, scenario, "migration is not supported for this kind")
+						ginkgo.Skip("migration is not supported for " + testCase.Kind)
+					}
+
+					err := run(testCase.Kind, scenario, fn)
+					failed = err != nil
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				}
+
+				ginkgo.It("should reconcile the "+testCase.Kind, func() {
+					obj = testCase.NewObject(namespace.Name)
+					scenario(ScenarioReconcile, func() error { return suite.Reconcile(ctx, testCase, obj) })
+				})
+
+				ginkgo.It("should reconcile the updated "+testCase.Kind, func() {
+					scenario(ScenarioUpdate, func() error { return suite.Update(ctx, testCase, obj) })
+				})
+
+				ginkgo.It("should migrate the "+testCase.Kind, func() {
+					scenario(ScenarioMigrate, func() error { return suite.Migrate(ctx, testCase, obj) })
+				})
+
+				ginkgo.It("should restore the "+testCase.Kind, func() {
+					scenario(ScenarioRestore, func() error {
+						restored, err := suite.Restore(ctx, testCase, obj)
+						if restored != nil {
+							obj = restored
+						}
+						return err
+					})
+				})
+
+				ginkgo.It("should delete the "+testCase.Kind, func() {
+					scenario(ScenarioDelete, func() error { return suite.Delete(ctx, testCase, obj) })
+				})
+			})
+		}
+
+		ginkgo.It("should renew the heartbeat Lease", func() {
+			if suite.config.HeartbeatNamespace == "" {
+				suite.Report.Skip("", ScenarioHeartbeat, "no heartbeat namespace configured")
+				ginkgo.Skip("no heartbeat namespace configured")
+			}
+			gomega.Expect(run("", ScenarioHeartbeat, func() error { return suite.Heartbeat(ctx) })).To(gomega.Succeed())
+		})
+	})
+}
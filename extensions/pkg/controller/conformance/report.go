@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Scenario is a conformance test scenario.
+type Scenario string
+
+const (
+	// ScenarioReconcile creates an extension object and waits until it has been reconciled.
+	ScenarioReconcile Scenario = "Reconcile"
+	// ScenarioUpdate updates an extension object and waits until it has been reconciled again.
+	ScenarioUpdate Scenario = "Update"
+	// ScenarioMigrate migrates an extension object and deletes it afterwards.
+	ScenarioMigrate Scenario = "Migrate"
+	// ScenarioRestore recreates a migrated extension object with its state and waits until it has been restored.
+	ScenarioRestore Scenario = "Restore"
+	// ScenarioDelete deletes an extension object and waits until it is gone.
+	ScenarioDelete Scenario = "Delete"
+	// ScenarioHeartbeat verifies that the extension renews its heartbeat Lease.
+	ScenarioHeartbeat Scenario = "Heartbeat"
+)
+
+// Scenarios are all scenarios executed for each test case, in order.
+var Scenarios = []Scenario{ScenarioReconcile, ScenarioUpdate, ScenarioMigrate, ScenarioRestore, ScenarioDelete}
+
+// ResultStatus is the status of an executed scenario.
+type ResultStatus string
+
+const (
+	// ResultStatusPassed means that the scenario passed.
+	ResultStatusPassed ResultStatus = "Passed"
+	// ResultStatusFailed means that the scenario failed.
+	ResultStatusFailed ResultStatus = "Failed"
+	// ResultStatusSkipped means that the scenario was not executed.
+	ResultStatusSkipped ResultStatus = "Skipped"
+)
+
+// Report is the conformance report of an extension.
+type Report struct {
+	lock sync.Mutex
+
+	// Extension is the name of the extension under test.
+	Extension string `json:"extension,omitempty"`
+	// Conformant is true if no scenario failed.
+	Conformant bool `json:"conformant"`
+	// Results contains the results of the scenarios.
+	Results []Result `json:"results"`
+}
+
+// Result is the result of a scenario for an extension kind.
+type Result struct {
+	// Kind is the kind of the extension resource. It is empty for scenarios which are not specific to a kind.
+	Kind string `json:"kind,omitempty"`
+	// Scenario is the name of the scenario.
+	Scenario Scenario `json:"scenario"`
+	// Status is the status of the scenario.
+	Status ResultStatus `json:"status"`
+	// Message contains the reason why the scenario failed or was skipped.
+	Message string `json:"message,omitempty"`
+	// Duration is the duration of the scenario.
+	Duration string `json:"duration,omitempty"`
+}
+
+// NewReport creates a new report for the given extension.
+func NewReport(extension string) *Report {
+	return &Report{Extension: extension, Conformant: true}
+}
+
+// Record adds the result of a scenario to the report. A nil error is recorded as passed.
+func (r *Report) Record(kind string, scenario Scenario, duration time.Duration, err error) {
+	result := Result{Kind: kind, Scenario: scenario, Status: ResultStatusPassed, Duration: duration.Round(time.Millisecond).String()}
+	if err != nil {
+		result.Status = ResultStatusFailed
+		result.Message = err.Error()
+	}
+	r.add(result)
+}
+
+// Skip adds a skipped scenario to the report.
+func (r *Report) Skip(kind string, scenario Scenario, reason string) {
+	r.add(Result{Kind: kind, Scenario: scenario, Status: ResultStatusSkipped, Message: reason})
+}
+
+// Has returns true if the report contains a result for the given kind and scenario.
+func (r *Report) Has(kind string, scenario Scenario) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, result := range r.Results {
+		if result.Kind == kind && result.Scenario == scenario {
+			return true
+		}
+	}
+	return false
+}
+
+// Summary returns a short human-readable summary of the report.
+func (r *Report) Summary() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	counts := map[ResultStatus]int{}
+	for _, result := range r.Results {
+		counts[result.Status]++
+	}
+
+	verdict := "conformant"
+	if !r.Conformant {
+		verdict = "not conformant"
+	}
+
+	return fmt.Sprintf("Extension %q is %s: %d passed, %d failed, %d skipped", r.Extension, verdict, counts[ResultStatusPassed], counts[ResultStatusFailed], counts[ResultStatusSkipped])
+}
+
+// WriteFile writes the report as JSON to the given path.
+func (r *Report) WriteFile(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed marshalling conformance report: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+func (r *Report) add(result Result) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if result.Status == ResultStatusFailed {
+		r.Conformant = false
+	}
+	r.Results = append(r.Results, result)
+}
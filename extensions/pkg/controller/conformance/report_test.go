@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/conformance"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Report", func() {
+	var report *conformance.Report
+
+	BeforeEach(func() {
+		report = conformance.NewReport("provider-foo")
+	})
+
+	It("should be conformant if no scenario failed", func() {
+		report.Record(extensionsv1alpha1.InfrastructureResource, conformance.ScenarioReconcile, 1500*time.Millisecond, nil)
+		report.Skip(extensionsv1alpha1.InfrastructureResource, conformance.ScenarioMigrate, "migration is not supported for this kind")
+
+		Expect(report.Conformant).To(BeTrue())
+		Expect(report.Has(extensionsv1alpha1.InfrastructureResource, conformance.ScenarioReconcile)).To(BeTrue())
+		Expect(report.Has(extensionsv1alpha1.InfrastructureResource, conformance.ScenarioDelete)).To(BeFalse())
+		Expect(report.Summary()).To(Equal(`Extension "provider-foo" is conformant: 1 passed, 0 failed, 1 skipped`))
+	})
+
+	It("should not be conformant if a scenario failed", func() {
+		report.Record(extensionsv1alpha1.InfrastructureResource, conformance.ScenarioReconcile, time.Second, nil)
+		report.Record(extensionsv1alpha1.InfrastructureResource, conformance.ScenarioUpdate, time.Second, errors.New("fake"))
+
+		Expect(report.Conformant).To(BeFalse())
+		Expect(report.Results[1]).To(Equal(conformance.Result{
+			Kind:     extensionsv1alpha1.InfrastructureResource,
+			Scenario: conformance.ScenarioUpdate,
+			Status:   conformance.ResultStatusFailed,
+			Message:  "fake",
+			Duration: "1s",
+		}))
+		Expect(report.Summary()).To(Equal(`Extension "provider-foo" is not conformant: 1 passed, 1 failed, 0 skipped`))
+	})
+
+	It("should write the report as JSON", func() {
+		report.Record(extensionsv1alpha1.InfrastructureResource, conformance.ScenarioReconcile, 1500*time.Millisecond, nil)
+		path := filepath.Join(GinkgoT().TempDir(), "report.json")
+
+		Expect(report.WriteFile(path)).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(json.Unmarshal(data, &map[string]any{})).To(Succeed())
+		Expect(string(data)).To(Equal(`{
+  "extension": "provider-foo",
+  "conformant": true,
+  "results": [
+    {
+      "kind": "Infrastructure",
+      "scenario": "Reconcile",
+      "status": "Passed",
+      "duration": "1.5s"
+    }
+  ]
+}`))
+	})
+})
@@ -47,6 +47,8 @@ type AddArgs struct {
 	ExtensionClass extensionsv1alpha1.ExtensionClass
 	// SyncPeriod is the duration how often the registered extension is being reconciled
 	SyncPeriod metav1.Duration
+	// HistoryOptions configures how the results are evaluated against the previous results of the health checks.
+	HistoryOptions HistoryOptions
 	// registeredExtension is the registered extensions that the HealthCheck Controller watches and writes HealthConditions for.
 	// The Gardenlet reads the conditions on the extension Resource.
 	// Through this mechanism, the extension can contribute to the Shoot's HealthStatus.
@@ -103,12 +105,18 @@ func DefaultRegistration(
 	predicates := append(DefaultPredicates(), customPredicates...)
 	opts.Controller.RecoverPanic = ptr.To(true)
 
+	historyOptions, err := HistoryOptionsFromConfig(opts.HealthCheckConfig)
+	if err != nil {
+		return err
+	}
+
 	args := AddArgs{
 		ControllerOptions:       opts.Controller,
 		Predicates:              predicates,
 		Type:                    extensionType,
 		ExtensionClass:          opts.ExtensionClass,
 		SyncPeriod:              opts.HealthCheckConfig.SyncPeriod,
+		HistoryOptions:          historyOptions,
 		GetExtensionObjListFunc: getExtensionObjListFunc,
 	}
 
@@ -201,7 +209,7 @@ func add(mgr manager.Manager, args AddArgs, actuator HealthCheckActuator) error
 			&extensionsv1alpha1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(mapper.ClusterToObjectMapper(mgr.GetClient(), args.GetExtensionObjListFunc, predicates)),
 		).
-		Complete(NewReconciler(mgr, actuator, *args.registeredExtension, args.SyncPeriod))
+		Complete(NewReconciler(mgr, actuator, *args.registeredExtension, args.SyncPeriod, args.HistoryOptions))
 }
 
 func getHealthCheckTypes(healthChecks []ConditionTypeToHealthCheck) []string {
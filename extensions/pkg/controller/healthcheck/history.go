@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+)
+
+const (
+	// DefaultHistorySize is the default number of recent results per condition type which are kept.
+	DefaultHistorySize = 10
+	// DefaultFlappingThreshold is the default number of transitions within the history after which a health check is
+	// considered to be flapping.
+	DefaultFlappingThreshold = 4
+)
+
+// HistoryOptions configures how the results of health checks are evaluated against their previous results.
+type HistoryOptions struct {
+	// UnhealthyThreshold is the number of consecutive unsuccessful results after which a healthy condition is
+	// transitioned to `False`. Values lower than 1 are treated as 1.
+	UnhealthyThreshold int
+	// HistorySize is the number of recent results per condition type which are kept.
+	HistorySize int
+	// FlappingThreshold is the number of transitions between successful and unsuccessful results within the history
+	// after which a health check is considered to be flapping. If it is 0, flapping health checks are not detected.
+	FlappingThreshold int
+}
+
+// HistoryOptionsFromConfig validates the given health check configuration and returns the according HistoryOptions.
+func HistoryOptionsFromConfig(config extensionsconfigv1alpha1.HealthCheckConfig) (HistoryOptions, error) {
+	options := HistoryOptions{
+		UnhealthyThreshold: int(ptr.Deref(config.UnhealthyThreshold, 1)),
+		HistorySize:        DefaultHistorySize,
+	}
+
+	if config.FlappingDetection != nil {
+		options.HistorySize = int(ptr.Deref(config.FlappingDetection.HistorySize, DefaultHistorySize))
+		options.FlappingThreshold = int(ptr.Deref(config.FlappingDetection.TransitionThreshold, DefaultFlappingThreshold))
+	}
+
+	if errs := validateHistoryOptions(options, config.FlappingDetection != nil); len(errs) > 0 {
+		return HistoryOptions{}, fmt.Errorf("invalid health check configuration: %w", errs.ToAggregate())
+	}
+	return options, nil
+}
+
+func validateHistoryOptions(options HistoryOptions, flappingDetection bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if options.UnhealthyThreshold < 1 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("unhealthyThreshold"), options.UnhealthyThreshold, "must be at least 1"))
+	}
+
+	if flappingDetection {
+		fldPath := field.NewPath("flappingDetection")
+		if options.HistorySize < 2 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("historySize"), options.HistorySize, "must be at least 2 to detect any transition"))
+		}
+		// A history of n results contains at most n-1 transitions, a higher threshold could never be reached.
+		if options.FlappingThreshold < 1 || options.FlappingThreshold > options.HistorySize-1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("transitionThreshold"), options.FlappingThreshold, fmt.Sprintf("must be between 1 and historySize-1 (%d)", options.HistorySize-1)))
+		}
+	}
+
+	return allErrs
+}
+
+type historyKey struct {
+	request       types.NamespacedName
+	conditionType string
+}
+
+// resultHistory keeps the recent results of the health checks per extension resource and condition type. Only
+// successful and unsuccessful results are recorded, i.e., progressing health checks and health checks which could not
+// be executed do not change the history.
+type resultHistory struct {
+	options HistoryOptions
+
+	lock    sync.Mutex
+	results map[historyKey][]bool
+}
+
+func newResultHistory(options HistoryOptions) *resultHistory {
+	return &resultHistory{
+		options: options,
+		results: map[historyKey][]bool{},
+	}
+}
+
+type historyStats struct {
+	// consecutiveUnhealthy is the number of unsuccessful results since the last successful one.
+	consecutiveUnhealthy int
+	// transitions is the number of changes between successful and unsuccessful results within the history.
+	transitions int
+	// transitioned is true if the recorded result differs from the previous one.
+	transitioned bool
+}
+
+// record adds the given result to the history of the condition type and returns the statistics of the history.
+func (h *resultHistory) record(request types.NamespacedName, conditionType string, healthy bool) historyStats {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	var (
+		key     = historyKey{request: request, conditionType: conditionType}
+		results = append(h.results[key], healthy)
+		stats   historyStats
+	)
+
+	if size := max(h.options.HistorySize, h.options.UnhealthyThreshold, 1); len(results) > size {
+		results = results[len(results)-size:]
+	}
+	h.results[key] = results
+
+	for i := len(results) - 1; i >= 0 && !results[i]; i-- {
+		stats.consecutiveUnhealthy++
+	}
+	// More results than the history size are kept if required for the unhealthy threshold, they must not be considered
+	// for the flapping detection.
+	for i := max(1, len(results)-h.options.HistorySize+1); i < len(results); i++ {
+		if results[i] != results[i-1] {
+			stats.transitions++
+		}
+	}
+	stats.transitioned = len(results) > 1 && results[len(results)-1] != results[len(results)-2]
+
+	return stats
+}
+
+// forget removes the history of all condition types of the given extension resource.
+func (h *resultHistory) forget(request types.NamespacedName) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for key := range h.results {
+		if key.request == request {
+			delete(h.results, key)
+		}
+	}
+}
+
+// isFlapping returns true if the number of transitions within the history reached the flapping threshold.
+func (h *resultHistory) isFlapping(stats historyStats) bool {
+	return h.options.FlappingThreshold > 0 && stats.transitions >= h.options.FlappingThreshold
+}
+
+// isBelowUnhealthyThreshold returns true if an unsuccessful result shall not yet transition a healthy condition.
+func (h *resultHistory) isBelowUnhealthyThreshold(stats historyStats) bool {
+	return stats.consecutiveUnhealthy < h.options.UnhealthyThreshold
+}
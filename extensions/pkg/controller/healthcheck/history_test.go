@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+)
+
+var _ = Describe("history", func() {
+	var (
+		request = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "bar"}
+		history *resultHistory
+	)
+
+	BeforeEach(func() {
+		history = newResultHistory(HistoryOptions{UnhealthyThreshold: 3, HistorySize: 5, FlappingThreshold: 3})
+	})
+
+	recordAll := func(results ...bool) historyStats {
+		var stats historyStats
+		for _, healthy := range results {
+			stats = history.record(request, "ControlPlaneHealthy", healthy)
+		}
+		return stats
+	}
+
+	Describe("#HistoryOptionsFromConfig", func() {
+		It("should return the defaults", func() {
+			Expect(HistoryOptionsFromConfig(extensionsconfigv1alpha1.HealthCheckConfig{})).To(Equal(HistoryOptions{
+				UnhealthyThreshold: 1,
+				HistorySize:        DefaultHistorySize,
+			}))
+			Expect(HistoryOptionsFromConfig(extensionsconfigv1alpha1.HealthCheckConfig{
+				FlappingDetection: &extensionsconfigv1alpha1.FlappingDetection{},
+			})).To(Equal(HistoryOptions{
+				UnhealthyThreshold: 1,
+				HistorySize:        DefaultHistorySize,
+				FlappingThreshold:  DefaultFlappingThreshold,
+			}))
+		})
+
+		It("should return the configured values", func() {
+			Expect(HistoryOptionsFromConfig(extensionsconfigv1alpha1.HealthCheckConfig{
+				UnhealthyThreshold: ptr.To[int32](2),
+				FlappingDetection: &extensionsconfigv1alpha1.FlappingDetection{
+					HistorySize:         ptr.To[int32](6),
+					TransitionThreshold: ptr.To[int32](3),
+				},
+			})).To(Equal(HistoryOptions{
+				UnhealthyThreshold: 2,
+				HistorySize:        6,
+				FlappingThreshold:  3,
+			}))
+		})
+
+		It("should fail for invalid values", func() {
+			_, err := HistoryOptionsFromConfig(extensionsconfigv1alpha1.HealthCheckConfig{UnhealthyThreshold: ptr.To[int32](0)})
+			Expect(err).To(MatchError(ContainSubstring("unhealthyThreshold: Invalid value: 0: must be at least 1")))
+
+			_, err = HistoryOptionsFromConfig(extensionsconfigv1alpha1.HealthCheckConfig{
+				FlappingDetection: &extensionsconfigv1alpha1.FlappingDetection{HistorySize: ptr.To[int32](1)},
+			})
+			Expect(err).To(MatchError(And(
+				ContainSubstring("flappingDetection.historySize: Invalid value: 1: must be at least 2"),
+				ContainSubstring("flappingDetection.transitionThreshold: Invalid value: 4: must be between 1 and historySize-1 (0)"),
+			)))
+
+			_, err = HistoryOptionsFromConfig(extensionsconfigv1alpha1.HealthCheckConfig{
+				FlappingDetection: &extensionsconfigv1alpha1.FlappingDetection{HistorySize: ptr.To[int32](4), TransitionThreshold: ptr.To[int32](4)},
+			})
+			Expect(err).To(MatchError(ContainSubstring("flappingDetection.transitionThreshold: Invalid value: 4: must be between 1 and historySize-1 (3)")))
+		})
+	})
+
+	Describe("#record", func() {
+		It("should count the consecutive unhealthy results", func() {
+			Expect(recordAll(false, true, false, false)).To(Equal(historyStats{consecutiveUnhealthy: 2, transitions: 2}))
+			Expect(history.isBelowUnhealthyThreshold(historyStats{consecutiveUnhealthy: 2})).To(BeTrue())
+			Expect(history.isBelowUnhealthyThreshold(recordAll(false))).To(BeFalse())
+		})
+
+		It("should count the transitions", func() {
+			Expect(recordAll(true, false)).To(Equal(historyStats{consecutiveUnhealthy: 1, transitions: 1, transitioned: true}))
+			Expect(history.isFlapping(recordAll(true))).To(BeFalse())
+			Expect(history.isFlapping(recordAll(false))).To(BeTrue())
+		})
+
+		It("should only keep the configured number of results", func() {
+			Expect(recordAll(true, false, true, false, true, true, true, true)).To(Equal(historyStats{transitions: 1}))
+		})
+
+		It("should keep at least as many results as required for the unhealthy threshold", func() {
+			history = newResultHistory(HistoryOptions{UnhealthyThreshold: 3, HistorySize: 1})
+			Expect(recordAll(false, false, false, false).consecutiveUnhealthy).To(Equal(3))
+		})
+
+		It("should only count the transitions within the history size", func() {
+			history = newResultHistory(HistoryOptions{UnhealthyThreshold: 5, HistorySize: 2})
+			Expect(recordAll(true, false, true, false, false)).To(Equal(historyStats{consecutiveUnhealthy: 2}))
+			Expect(recordAll(true)).To(Equal(historyStats{transitions: 1, transitioned: true}))
+		})
+
+		It("should keep the results per condition type", func() {
+			recordAll(false, false)
+			Expect(history.record(request, "SystemComponentsHealthy", false)).To(Equal(historyStats{consecutiveUnhealthy: 1}))
+		})
+	})
+
+	Describe("#forget", func() {
+		It("should remove the results of the extension resource", func() {
+			recordAll(false, false)
+			otherRequest := types.NamespacedName{Namespace: "shoot--foo--baz", Name: "baz"}
+			history.record(otherRequest, "ControlPlaneHealthy", false)
+
+			history.forget(request)
+
+			Expect(recordAll(false)).To(Equal(historyStats{consecutiveUnhealthy: 1}))
+			Expect(history.record(otherRequest, "ControlPlaneHealthy", false)).To(Equal(historyStats{consecutiveUnhealthy: 2}))
+		})
+	})
+
+	Describe("#isFlapping", func() {
+		It("should never detect flapping if the threshold is not set", func() {
+			history = newResultHistory(HistoryOptions{HistorySize: 5})
+			Expect(history.isFlapping(recordAll(true, false, true, false, true))).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/apimachinery/pkg/types"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "extension"
+	metricsSubsystem = "healthcheck"
+)
+
+var (
+	metricsLabels = []string{"kind", "namespace", "name", "condition_type"}
+
+	factory = promauto.With(runtimemetrics.Registry)
+
+	metricResultHealthy = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "result_healthy",
+			Help:      "Whether the last result of the health checks for the condition type was successful (1) or not (0).",
+		},
+		metricsLabels,
+	)
+	metricConsecutiveUnhealthyResults = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "consecutive_unhealthy_results",
+			Help:      "Number of consecutive unsuccessful results of the health checks for the condition type.",
+		},
+		metricsLabels,
+	)
+	metricFlapping = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "flapping",
+			Help:      "Whether the health checks for the condition type are considered to be flapping (1) or not (0).",
+		},
+		metricsLabels,
+	)
+	metricTransitionsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "transitions_total",
+			Help:      "Total number of transitions between successful and unsuccessful results of the health checks for the condition type.",
+		},
+		metricsLabels,
+	)
+)
+
+func recordMetrics(kind string, request types.NamespacedName, conditionType string, healthy, flapping bool, stats historyStats) {
+	labels := prometheus.Labels{"kind": kind, "namespace": request.Namespace, "name": request.Name, "condition_type": conditionType}
+
+	metricResultHealthy.With(labels).Set(boolToFloat(healthy))
+	metricConsecutiveUnhealthyResults.With(labels).Set(float64(stats.consecutiveUnhealthy))
+	metricFlapping.With(labels).Set(boolToFloat(flapping))
+	if stats.transitioned {
+		metricTransitionsTotal.With(labels).Inc()
+	}
+}
+
+func deleteMetrics(kind string, request types.NamespacedName) {
+	labels := prometheus.Labels{"kind": kind, "namespace": request.Namespace, "name": request.Name}
+
+	for _, metric := range []interface{ DeletePartialMatch(prometheus.Labels) int }{
+		metricResultHealthy,
+		metricConsecutiveUnhealthyResults,
+		metricFlapping,
+		metricTransitionsTotal,
+	} {
+		metric.DeletePartialMatch(labels)
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -33,6 +33,7 @@ type reconciler struct {
 	actuator            HealthCheckActuator
 	registeredExtension RegisteredExtension
 	syncPeriod          metav1.Duration
+	history             *resultHistory
 }
 
 const (
@@ -42,16 +43,20 @@ const (
 	ReasonProgressing = "HealthCheckProgressing"
 	// ReasonSuccessful is the reason phrase for the health check condition if all tests are successful.
 	ReasonSuccessful = "HealthCheckSuccessful"
+	// ReasonFlapping is the reason phrase for the health check condition if its tests frequently change their status.
+	ReasonFlapping = "HealthCheckFlapping"
 )
 
 // NewReconciler creates a new performHealthCheck.Reconciler that reconciles
 // the registered extension resources (Gardener's `extensions.gardener.cloud` API group).
-func NewReconciler(mgr manager.Manager, actuator HealthCheckActuator, registeredExtension RegisteredExtension, syncPeriod metav1.Duration) reconcile.Reconciler {
+// The history options configure how the results are evaluated against the previous results of the health checks.
+func NewReconciler(mgr manager.Manager, actuator HealthCheckActuator, registeredExtension RegisteredExtension, syncPeriod metav1.Duration, historyOptions HistoryOptions) reconcile.Reconciler {
 	return &reconciler{
 		actuator:            actuator,
 		client:              mgr.GetClient(),
 		registeredExtension: registeredExtension,
 		syncPeriod:          syncPeriod,
+		history:             newResultHistory(historyOptions),
 	}
 }
 
@@ -69,6 +74,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.client.Get(ctx, request.NamespacedName, extension); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object was not found, requeuing")
+			r.forgetHistory(request.NamespacedName)
 			return r.resultWithRequeue(), nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -81,6 +87,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if acc.GetDeletionTimestamp() != nil {
 		log.V(1).Info("Do not perform HealthCheck for extension resource, extension is being deleted")
+		r.forgetHistory(request.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 
@@ -106,6 +113,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if extensionscontroller.IsHibernationEnabled(cluster) {
+		r.forgetHistory(request.NamespacedName)
+
 		var conditions []condition
 		for _, healthConditionType := range r.registeredExtension.healthConditionTypes {
 			conditionBuilder, err := v1beta1helper.NewConditionBuilder(gardencorev1beta1.ConditionType(healthConditionType))
@@ -164,6 +173,27 @@ func (r *reconciler) performHealthCheck(ctx context.Context, log logr.Logger, re
 			logger = log
 		}
 
+		if healthy, recorded := isRecordedInHistory(healthCheckResult); recorded {
+			stats := r.history.record(request.NamespacedName, healthCheckResult.HealthConditionType, healthy)
+			flapping := r.history.isFlapping(stats)
+			recordMetrics(r.registeredExtension.groupVersionKind.Kind, request.NamespacedName, healthCheckResult.HealthConditionType, healthy, flapping, stats)
+
+			// Unsuccessful results of flapping health checks are reported immediately, i.e., the unhealthy threshold does
+			// not hide recurring failures.
+			if flapping {
+				log.Info("Health check for extension resource is flapping", "kind", r.registeredExtension.groupVersionKind.Kind, "conditionType", healthCheckResult.HealthConditionType, "transitions", stats.transitions)
+				conditions = append(conditions, extensionConditionFlapping(conditionBuilder, healthCheckResult.HealthConditionType, stats, healthCheckResult))
+				continue
+			}
+
+			oldCondition := v1beta1helper.GetCondition(extension.GetExtensionStatus().GetConditions(), gardencorev1beta1.ConditionType(healthCheckResult.HealthConditionType))
+			if !healthy && r.history.isBelowUnhealthyThreshold(stats) && oldCondition != nil && oldCondition.Status == gardencorev1beta1.ConditionTrue {
+				log.Info("Health check for extension resource unsuccessful, keeping condition until unhealthy threshold is reached", "kind", r.registeredExtension.groupVersionKind.Kind, "conditionType", healthCheckResult.HealthConditionType, "consecutiveUnhealthyResults", stats.consecutiveUnhealthy, "details", healthCheckResult.GetDetails())
+				conditions = append(conditions, extensionConditionKept(conditionBuilder, healthCheckResult.HealthConditionType, *oldCondition))
+				continue
+			}
+		}
+
 		if healthCheckResult.Status == gardencorev1beta1.ConditionTrue {
 			logger.Info("Health check for extension resource successful", "kind", r.registeredExtension.groupVersionKind.Kind, "conditionType", healthCheckResult.HealthConditionType)
 			conditions = append(conditions, extensionConditionSuccessful(conditionBuilder, healthCheckResult.HealthConditionType))
@@ -187,6 +217,24 @@ func (r *reconciler) performHealthCheck(ctx context.Context, log logr.Logger, re
 	return r.resultWithRequeue(), nil
 }
 
+// isRecordedInHistory returns whether the result is recorded in the history and whether it is successful. Progressing
+// results and results of health checks which could not be executed are not recorded.
+func isRecordedInHistory(healthCheckResult Result) (healthy bool, recorded bool) {
+	switch {
+	case healthCheckResult.Status == gardencorev1beta1.ConditionTrue:
+		return true, true
+	case healthCheckResult.Status == gardencorev1beta1.ConditionFalse && healthCheckResult.FailedChecks == 0:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+func (r *reconciler) forgetHistory(request types.NamespacedName) {
+	r.history.forget(request)
+	deleteMetrics(r.registeredExtension.groupVersionKind.Kind, request)
+}
+
 func extensionConditionFailedToExecute(conditionBuilder v1beta1helper.ConditionBuilder, healthConditionType string, executionError error) condition {
 	conditionBuilder.
 		WithStatus(gardencorev1beta1.ConditionUnknown).
@@ -240,6 +288,42 @@ func extensionConditionUnsuccessful(conditionBuilder v1beta1helper.ConditionBuil
 	}
 }
 
+func extensionConditionFlapping(conditionBuilder v1beta1helper.ConditionBuilder, healthConditionType string, stats historyStats, healthCheckResult Result) condition {
+	var (
+		status     = gardencorev1beta1.ConditionTrue
+		lastResult = "All health checks successful"
+	)
+	if healthCheckResult.Status != gardencorev1beta1.ConditionTrue {
+		status = gardencorev1beta1.ConditionFalse
+		lastResult = getUnsuccessfulDetailMessage(healthCheckResult.UnsuccessfulChecks, healthCheckResult.ProgressingChecks, healthCheckResult.GetDetails())
+	}
+
+	conditionBuilder.
+		WithStatus(status).
+		WithReason(ReasonFlapping).
+		WithCodes(healthCheckResult.Codes...).
+		WithMessage(fmt.Sprintf("Health checks are flapping (%d status changes within the recent results), last result: %s", stats.transitions, lastResult))
+	return condition{
+		builder:             conditionBuilder,
+		healthConditionType: healthConditionType,
+	}
+}
+
+// extensionConditionKept returns the given old condition with a refreshed last update time, so that it is not
+// considered outdated while it is kept because of the unhealthy threshold.
+func extensionConditionKept(conditionBuilder v1beta1helper.ConditionBuilder, healthConditionType string, oldCondition gardencorev1beta1.Condition) condition {
+	conditionBuilder.
+		WithStatus(oldCondition.Status).
+		WithReason(oldCondition.Reason).
+		WithCodes(oldCondition.Codes...).
+		WithMessage(oldCondition.Message)
+	return condition{
+		builder:               conditionBuilder,
+		healthConditionType:   healthConditionType,
+		refreshLastUpdateTime: true,
+	}
+}
+
 func extensionConditionSuccessful(conditionBuilder v1beta1helper.ConditionBuilder, healthConditionType string) condition {
 	conditionBuilder.
 		WithStatus(gardencorev1beta1.ConditionTrue).
@@ -265,6 +349,8 @@ func extensionConditionHibernated(conditionBuilder v1beta1helper.ConditionBuilde
 type condition struct {
 	builder             v1beta1helper.ConditionBuilder
 	healthConditionType string
+	// refreshLastUpdateTime indicates that the last update time is refreshed even if the condition did not change.
+	refreshLastUpdateTime bool
 }
 
 func (r *reconciler) updateExtensionConditions(ctx context.Context, extension extensionsv1alpha1.Object, conditions ...condition) error {
@@ -273,6 +359,9 @@ func (r *reconciler) updateExtensionConditions(ctx context.Context, extension ex
 			cond.builder.WithOldCondition(*c)
 		}
 		updatedCondition, _ := cond.builder.WithClock(clock.RealClock{}).Build()
+		if cond.refreshLastUpdateTime {
+			updatedCondition.LastUpdateTime = metav1.Now()
+		}
 		extension.GetExtensionStatus().SetConditions(v1beta1helper.MergeConditions(extension.GetExtensionStatus().GetConditions(), updatedCondition))
 	}
 	return r.client.Status().Update(ctx, extension)
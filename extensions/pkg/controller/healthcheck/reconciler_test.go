@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+type fakeActuator struct {
+	result Result
+}
+
+func (a *fakeActuator) ExecuteHealthCheckFunctions(context.Context, logr.Logger, types.NamespacedName) (*[]Result, error) {
+	return &[]Result{a.result}, nil
+}
+
+var _ = Describe("reconciler", func() {
+	const conditionType = "ControlPlaneHealthy"
+
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		actuator   *fakeActuator
+		r          *reconciler
+
+		worker  *extensionsv1alpha1.Worker
+		request reconcile.Request
+
+		healthy   = Result{HealthConditionType: conditionType, Status: gardencorev1beta1.ConditionTrue, SuccessfulChecks: 1}
+		unhealthy = Result{HealthConditionType: conditionType, Status: gardencorev1beta1.ConditionFalse, UnsuccessfulChecks: 1, Detail: ptr.To("deployment is unhealthy")}
+	)
+
+	BeforeEach(func() {
+		worker = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "shoot--foo--bar"}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(worker)}
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Worker{}).WithObjects(worker).Build()
+		actuator = &fakeActuator{}
+		r = &reconciler{
+			client:   fakeClient,
+			actuator: actuator,
+			registeredExtension: RegisteredExtension{
+				groupVersionKind: extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.WorkerResource),
+			},
+			syncPeriod: metav1.Duration{Duration: time.Minute},
+			history:    newResultHistory(HistoryOptions{UnhealthyThreshold: 2, HistorySize: 5, FlappingThreshold: 3}),
+		}
+	})
+
+	check := func(result Result) *gardencorev1beta1.Condition {
+		actuator.result = result
+		Expect(fakeClient.Get(ctx, request.NamespacedName, worker)).To(Succeed())
+		_, err := r.performHealthCheck(ctx, logr.Discard(), request, worker)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, worker)).To(Succeed())
+		return v1beta1helper.GetCondition(worker.Status.Conditions, conditionType)
+	}
+
+	It("should keep a healthy condition until the unhealthy threshold is reached", func() {
+		previous := check(healthy)
+		Expect(previous.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		previous.LastUpdateTime = metav1.NewTime(previous.LastUpdateTime.Add(-time.Hour))
+		worker.Status.Conditions = v1beta1helper.MergeConditions(worker.Status.Conditions, *previous)
+		Expect(fakeClient.Status().Update(ctx, worker)).To(Succeed())
+
+		kept := check(unhealthy)
+		Expect(kept.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(kept.Reason).To(Equal(previous.Reason))
+		Expect(kept.Message).To(Equal(previous.Message))
+		Expect(kept.LastTransitionTime).To(Equal(previous.LastTransitionTime))
+		Expect(kept.LastUpdateTime.After(previous.LastUpdateTime.Time)).To(BeTrue())
+
+		condition := check(unhealthy)
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonUnsuccessful))
+	})
+
+	It("should immediately report an unhealthy condition if it was not healthy before", func() {
+		Expect(check(unhealthy).Status).To(Equal(gardencorev1beta1.ConditionFalse))
+	})
+
+	It("should report flapping health checks", func() {
+		check(healthy)
+		check(unhealthy)
+		Expect(check(healthy).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		condition := check(unhealthy)
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonFlapping))
+		Expect(condition.Message).To(HavePrefix("Health checks are flapping (3 status changes within the recent results), last result: "))
+		Expect(condition.Message).To(ContainSubstring("deployment is unhealthy"))
+
+		condition = check(healthy)
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonFlapping))
+		Expect(condition.Message).To(Equal("Health checks are flapping (4 status changes within the recent results), last result: All health checks successful"))
+
+		By("Verify that unsuccessful results of flapping health checks are not hidden by the unhealthy threshold")
+		Expect(check(unhealthy).Status).To(Equal(gardencorev1beta1.ConditionFalse))
+	})
+})
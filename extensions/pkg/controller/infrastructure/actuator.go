@@ -26,3 +26,12 @@ type Actuator interface {
 	// Migrate deletes the terraform k8s resources without deleting the corresponding resources in the IaaS provider
 	Migrate(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
 }
+
+// Planner can optionally be implemented by an Actuator to support planning changes of Infrastructure resources. It is
+// called if the Infrastructure is annotated with `gardener.cloud/operation=plan`.
+type Planner interface {
+	// Plan returns the changes which Reconcile would apply to the infrastructure in the IaaS provider if the given
+	// Infrastructure was applied. Its `.spec.providerConfig` contains the planned provider config. It must not change any
+	// resources in the IaaS provider.
+	Plan(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) ([]extensionsv1alpha1.InfrastructureChange, error)
+}
@@ -18,6 +18,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 )
@@ -56,11 +57,19 @@ type AddArgs struct {
 	KnownCodes map[gardencorev1beta1.ErrorCode]func(string) bool
 }
 
-// DefaultPredicates returns the default predicates for an infrastructure reconciler.
+// DefaultPredicates returns the default predicates for an infrastructure reconciler. In addition to the default
+// controller predicates, Infrastructures annotated with `gardener.cloud/operation=plan` are admitted.
 func DefaultPredicates(ctx context.Context, mgr manager.Manager, ignoreOperationAnnotation bool) []predicate.Predicate {
-	return extensionspredicate.DefaultControllerPredicates(ignoreOperationAnnotation, extensionspredicate.ShootNotFailedPredicate(ctx, mgr))
+	return []predicate.Predicate{
+		extensionspredicate.ShootNotFailedPredicate(ctx, mgr),
+		predicate.Or(append(extensionspredicate.DefaultControllerPredicates(ignoreOperationAnnotation), hasPlanOperationAnnotation)...),
+	}
 }
 
+var hasPlanOperationAnnotation = predicate.NewPredicateFuncs(func(obj client.Object) bool {
+	return obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan
+})
+
 // Add creates a new Infrastructure Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
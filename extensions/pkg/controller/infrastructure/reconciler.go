@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -81,6 +82,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	switch {
 	case extensionscontroller.ShouldSkipOperation(operationType, infrastructure):
 		return reconcile.Result{}, nil
+	case infrastructure.DeletionTimestamp == nil && infrastructure.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan:
+		return r.plan(ctx, log.WithValues("operation", "plan"), infrastructure, cluster)
 	case operationType == gardencorev1beta1.LastOperationTypeMigrate:
 		return r.migrate(ctx, log.WithValues("operation", "migrate"), infrastructure, cluster)
 	case infrastructure.DeletionTimestamp != nil:
@@ -128,6 +131,57 @@ func (r *reconciler) reconcile(
 		return reconcile.Result{}, err
 	}
 
+	return reconcile.Result{}, r.removePlannedChanges(ctx, infrastructure)
+}
+
+// plan computes the changes which the next reconciliation would apply and reports them in the status. The last
+// operation and the observed generation are not changed since nothing is applied.
+func (r *reconciler) plan(
+	ctx context.Context,
+	log logr.Logger,
+	infrastructure *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster,
+) (
+	reconcile.Result,
+	error,
+) {
+	planner, ok := r.actuator.(Planner)
+	if !ok {
+		log.Info("Actuator does not support planning changes, skipping plan")
+		return reconcile.Result{}, r.removeAnnotation(ctx, log, infrastructure)
+	}
+
+	// The changes are planned for the planned provider config, the provider config in the spec is the one which was
+	// applied last.
+	desired := infrastructure.DeepCopy()
+	if desired.Spec.PlannedProviderConfig != nil {
+		desired.Spec.ProviderConfig = desired.Spec.PlannedProviderConfig
+	}
+
+	if err := r.validateConfig(ctx, desired); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error checking infrastructure config: %w", err)
+	}
+
+	log.Info("Planning the changes of infrastructure")
+	changes, err := planner.Plan(ctx, log, desired, cluster)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("error planning infrastructure changes: %w", err)
+	}
+
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.PlannedChanges = &extensionsv1alpha1.InfrastructurePlannedChanges{
+		ObservedGeneration: infrastructure.Generation,
+		LastUpdateTime:     metav1.Now(),
+		Changes:            changes,
+	}
+	if err := r.client.Status().Patch(ctx, infrastructure, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error updating planned changes of Infrastructure: %w", err)
+	}
+
+	if err := r.removeAnnotation(ctx, log, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -239,6 +293,18 @@ func (r *reconciler) restore(
 	return reconcile.Result{}, err
 }
 
+// removePlannedChanges removes previously planned changes from the status since they have been applied by the
+// reconciliation.
+func (r *reconciler) removePlannedChanges(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	if infrastructure.Status.PlannedChanges == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.PlannedChanges = nil
+	return r.client.Status().Patch(ctx, infrastructure, patch)
+}
+
 func (r *reconciler) removeFinalizerFromInfrastructure(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure) error {
 	if controllerutil.ContainsFinalizer(infrastructure, FinalizerName) {
 		log.Info("Removing finalizer")
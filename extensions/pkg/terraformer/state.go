@@ -53,6 +53,15 @@ func (t *terraformer) GetState(ctx context.Context) ([]byte, error) {
 // GetStateOutputVariables returns the given <variable> from the given Terraform <stateData>.
 // In case the variable was not found, an error is returned.
 func (t *terraformer) GetStateOutputVariables(ctx context.Context, variables ...string) (map[string]string, error) {
+	stateConfigMap, err := t.GetState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputVariablesFromState(stateConfigMap, variables...)
+}
+
+func outputVariablesFromState(state []byte, variables ...string) (map[string]string, error) {
 	var (
 		output = make(map[string]string)
 
@@ -60,16 +69,11 @@ func (t *terraformer) GetStateOutputVariables(ctx context.Context, variables ...
 		foundVariables  = sets.New[string]()
 	)
 
-	stateConfigMap, err := t.GetState(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(stateConfigMap) == 0 {
+	if len(state) == 0 {
 		return nil, &variablesNotFoundError{sets.List(wantedVariables)}
 	}
 
-	outputVariables, err := getOutputVariables(stateConfigMap)
+	outputVariables, err := getOutputVariables(state)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,551 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// DefaultTofuBinary is the default name of the OpenTofu binary which is looked up in the PATH.
+	DefaultTofuBinary = "tofu"
+	// DefaultLockDuration is the default duration of the Lease locking the Terraform state.
+	DefaultLockDuration = 30 * time.Second
+)
+
+// TofuOptions are options for the OpenTofu runner.
+type TofuOptions struct {
+	// Binary is the path of the OpenTofu binary. Defaults to DefaultTofuBinary.
+	Binary string
+	// PluginDir is an optional directory containing the provider plugins. If it is set, providers are not downloaded
+	// during `tofu init`.
+	PluginDir string
+	// WorkDir is the directory in which temporary working directories are created for each run. Defaults to the
+	// directory returned by os.TempDir.
+	WorkDir string
+	// NewStateBackend returns the backend for storing the Terraform state. Defaults to NewConfigMapStateBackend.
+	NewStateBackend NewStateBackendFunc
+	// LockDuration is the duration of the Lease locking the Terraform state. The Lease is renewed while OpenTofu is
+	// running. Defaults to DefaultLockDuration.
+	LockDuration time.Duration
+	// Identity is the holder identity used for locking the Terraform state. Defaults to the hostname and a random
+	// suffix.
+	Identity string
+	// Executor is used for running OpenTofu. Defaults to an executor running Binary.
+	Executor Executor
+	// Clock is used for locking the Terraform state. Defaults to a real clock.
+	Clock clock.WithTicker
+}
+
+type tofuFactory struct {
+	options TofuOptions
+}
+
+// NewTofuFactory returns a factory for runners which execute OpenTofu directly in the extension process instead of
+// spawning Terraformer pods. The image passed to the factory is ignored.
+func NewTofuFactory(options TofuOptions) Factory {
+	return &tofuFactory{options: options}
+}
+
+func (f *tofuFactory) NewForConfig(logger logr.Logger, config *rest.Config, purpose, namespace, name, _ string) (Terraformer, error) {
+	c, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTofu(logger, c, purpose, namespace, name, f.options), nil
+}
+
+func (f *tofuFactory) New(logger logr.Logger, c client.Client, _ corev1client.CoreV1Interface, purpose, namespace, name, _ string) Terraformer {
+	return NewTofu(logger, c, purpose, namespace, name, f.options)
+}
+
+func (f *tofuFactory) DefaultInitializer(c client.Client, main, variables string, tfVars []byte, stateInitializer StateConfigMapInitializer) Initializer {
+	return DefaultInitializer(c, main, variables, tfVars, stateInitializer)
+}
+
+// tofuRunner implements the Terraformer interface by running OpenTofu in the current process. It uses the same
+// configuration ConfigMap and variables Secret as the Terraformer pods. The Terraform state is stored in the configured
+// StateBackend and locked by a Lease while OpenTofu is running.
+// The pod-related settings are mapped as follows:
+//   - deadlinePod is the timeout of an OpenTofu run.
+//   - deadlinePodCreation is the timeout for acquiring the state lock.
+//   - deadlineCleaning is the timeout to wait for other OpenTofu runs to release the state lock.
+//   - terminationGracePeriodSeconds is the time OpenTofu is given to persist its state after being interrupted.
+type tofuRunner struct {
+	logger   logr.Logger
+	client   client.Client
+	clock    clock.WithTicker
+	executor Executor
+	binary   string
+
+	purpose   string
+	name      string
+	namespace string
+	ownerRef  *metav1.OwnerReference
+
+	configName    string
+	variablesName string
+	stateName     string
+	lockName      string
+	envVars       []corev1.EnvVar
+
+	stateBackend StateBackend
+	pluginDir    string
+	workDir      string
+
+	identity          string
+	lockDuration      time.Duration
+	lockRetryInterval time.Duration
+
+	configurationInitialized bool
+	stateInitialized         bool
+
+	logLevel                      string
+	terminationGracePeriodSeconds int64
+
+	deadlineCleaning    time.Duration
+	deadlinePod         time.Duration
+	deadlinePodCreation time.Duration
+}
+
+// NewTofu returns a Terraformer which runs OpenTofu in the current process. It uses the same names for the
+// configuration and state resources as the Terraformer created by New, hence, existing infrastructures can be switched
+// to this runner without any migration steps.
+func NewTofu(logger logr.Logger, c client.Client, purpose, namespace, name string, options TofuOptions) Terraformer {
+	var prefix = fmt.Sprintf("%s.%s", name, purpose)
+
+	t := &tofuRunner{
+		logger:   logger.WithName("tofu"),
+		client:   c,
+		clock:    options.Clock,
+		executor: options.Executor,
+		binary:   options.Binary,
+
+		name:      name,
+		namespace: namespace,
+		purpose:   purpose,
+
+		configName:    prefix + ConfigSuffix,
+		variablesName: prefix + VariablesSuffix,
+		stateName:     prefix + StateSuffix,
+		lockName:      prefix + LockSuffix,
+
+		pluginDir: options.PluginDir,
+		workDir:   options.WorkDir,
+
+		identity:          options.Identity,
+		lockDuration:      options.LockDuration,
+		lockRetryInterval: 5 * time.Second,
+
+		logLevel:                      "info",
+		terminationGracePeriodSeconds: int64(3600),
+
+		deadlineCleaning:    20 * time.Minute,
+		deadlinePod:         20 * time.Minute,
+		deadlinePodCreation: 5 * time.Minute,
+	}
+
+	if t.clock == nil {
+		t.clock = clock.RealClock{}
+	}
+	if t.lockDuration == 0 {
+		t.lockDuration = DefaultLockDuration
+	}
+	if t.identity == "" {
+		hostname, _ := os.Hostname()
+		t.identity = hostname + "_" + uuid.NewString()
+	}
+	if t.binary == "" {
+		t.binary = DefaultTofuBinary
+	}
+
+	newStateBackend := options.NewStateBackend
+	if newStateBackend == nil {
+		newStateBackend = NewConfigMapStateBackend
+	}
+	t.stateBackend = newStateBackend(c, namespace, t.stateName)
+
+	return t
+}
+
+// SetEnvVars sets the provided environment variables for the OpenTofu process.
+func (t *tofuRunner) SetEnvVars(envVars ...corev1.EnvVar) Terraformer {
+	t.envVars = append(t.envVars, envVars...)
+	return t
+}
+
+// SetTerminationGracePeriodSeconds configures the time OpenTofu is given to terminate after being interrupted.
+func (t *tofuRunner) SetTerminationGracePeriodSeconds(terminationGracePeriodSeconds int64) Terraformer {
+	t.terminationGracePeriodSeconds = terminationGracePeriodSeconds
+	return t
+}
+
+// SetDeadlineCleaning configures the deadline while waiting for other OpenTofu runs to release the state lock.
+func (t *tofuRunner) SetDeadlineCleaning(d time.Duration) Terraformer {
+	t.deadlineCleaning = d
+	return t
+}
+
+// SetDeadlinePod configures the deadline of an OpenTofu run.
+func (t *tofuRunner) SetDeadlinePod(d time.Duration) Terraformer {
+	t.deadlinePod = d
+	return t
+}
+
+// SetDeadlinePodCreation configures the deadline while waiting for the state lock.
+func (t *tofuRunner) SetDeadlinePodCreation(d time.Duration) Terraformer {
+	t.deadlinePodCreation = d
+	return t
+}
+
+// SetOwnerRef configures the resource that will be used as owner of the secrets, configmaps and leases.
+func (t *tofuRunner) SetOwnerRef(owner *metav1.OwnerReference) Terraformer {
+	t.ownerRef = owner
+	return t
+}
+
+// UseProjectedTokenMount is a no-op as no pods are created.
+func (t *tofuRunner) UseProjectedTokenMount(bool) Terraformer {
+	return t
+}
+
+// SetLogLevel sets the log level of OpenTofu. Only "debug" has an effect, it enables the OpenTofu debug logs.
+func (t *tofuRunner) SetLogLevel(level string) Terraformer {
+	t.logLevel = level
+	return t
+}
+
+// InitializeWith initializes the runner with the given Initializer. It is expected from the Initializer to correctly
+// create all the resources as specified in the given InitializerConfig.
+func (t *tofuRunner) InitializeWith(ctx context.Context, initializer Initializer) Terraformer {
+	config := &InitializerConfig{
+		Namespace:         t.namespace,
+		ConfigurationName: t.configName,
+		VariablesName:     t.variablesName,
+		StateName:         t.stateName,
+		InitializeState:   t.IsStateEmpty(ctx),
+	}
+
+	if err := initializer.Initialize(ctx, config, t.ownerRef); err != nil {
+		t.logger.Error(err, "Could not create Terraformer ConfigMaps/Secrets")
+		return t
+	}
+
+	t.configurationInitialized = true
+	t.stateInitialized = config.InitializeState
+
+	return t
+}
+
+// Apply runs 'tofu apply'.
+func (t *tofuRunner) Apply(ctx context.Context) error {
+	if !t.configurationInitialized {
+		return errors.New("terraformer configuration has not been defined, cannot execute OpenTofu")
+	}
+	return t.execute(ctx, CommandApply)
+}
+
+// Destroy runs 'tofu destroy' and cleans up the configuration afterwards.
+func (t *tofuRunner) Destroy(ctx context.Context) error {
+	if err := t.execute(ctx, CommandDestroy); err != nil {
+		return err
+	}
+	return t.CleanupConfiguration(ctx)
+}
+
+func (t *tofuRunner) execute(ctx context.Context, command string) error {
+	logger := t.logger.WithValues("command", command)
+
+	// See terraformer.execute for an explanation of the tolerated missing resources.
+	if !t.configurationInitialized || !t.stateInitialized {
+		numberOfExistingResources, err := t.NumberOfResources(ctx)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case numberOfExistingResources == numberOfConfigResources:
+			logger.Info("All ConfigMaps and Secrets exist, will execute OpenTofu")
+		case numberOfExistingResources == 0:
+			logger.Info("All ConfigMaps and Secrets missing, can not execute OpenTofu")
+			return nil
+		case command != CommandDestroy:
+			errResourcesMissing := fmt.Errorf("%d/%d Terraform resources are missing", numberOfConfigResources-numberOfExistingResources, numberOfConfigResources)
+			logger.Error(errResourcesMissing, "Cannot execute OpenTofu")
+			return errResourcesMissing
+		}
+	}
+
+	if command == CommandDestroy && t.IsStateEmpty(ctx) {
+		logger.Info("Terraform state is empty, skipping OpenTofu execution")
+		return nil
+	}
+
+	logger.Info("Acquiring Terraform state lock", "lease", client.ObjectKey{Namespace: t.namespace, Name: t.lockName})
+	if err := t.acquireLock(ctx); err != nil {
+		return fmt.Errorf("failed acquiring Terraform state lock: %w", err)
+	}
+
+	runCtx, cancelRun := context.WithCancelCause(ctx)
+	go t.renewLock(runCtx, cancelRun)
+
+	defer func() {
+		cancelRun(nil)
+
+		// The lock must also be released if the context was cancelled, otherwise the next run has to wait until it expires.
+		releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+		defer cancel()
+		if err := t.releaseLock(releaseCtx); err != nil {
+			logger.Error(err, "Failed releasing Terraform state lock")
+		}
+	}()
+
+	if err := t.importLegacyState(runCtx); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(runCtx, t.deadlinePod)
+	defer cancel()
+
+	if err := t.run(ctx, logger, command); err != nil {
+		if cause := context.Cause(runCtx); errors.Is(cause, errStateLockLost) {
+			return errors.Join(cause, err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (t *tofuRunner) run(ctx context.Context, logger logr.Logger, command string) error {
+	dir, err := t.prepareWorkDir(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logger.Error(err, "Failed removing working directory", "dir", dir)
+		}
+	}()
+
+	env, err := t.resolveEnvVars(ctx)
+	if err != nil {
+		return err
+	}
+
+	initArgs := []string{"init", "-input=false", "-no-color"}
+	if t.pluginDir != "" {
+		initArgs = append(initArgs, "-plugin-dir="+t.pluginDir)
+	}
+
+	executor := t.executor
+	if executor == nil {
+		executor = NewBinaryExecutor(t.binary, time.Duration(t.terminationGracePeriodSeconds)*time.Second)
+	}
+
+	logger.Info("Initializing OpenTofu working directory")
+	if output, err := executor.Execute(ctx, dir, env, initArgs...); err != nil {
+		return t.executionError("init", output, err)
+	}
+
+	logger.Info("Running OpenTofu")
+	output, execErr := executor.Execute(ctx, dir, env, command, "-auto-approve", "-input=false", "-no-color")
+
+	// The state is stored even if OpenTofu failed since the infrastructure might have been changed partially.
+	if err := t.storeState(ctx, logger, dir); err != nil {
+		return errors.Join(t.executionError(command, output, execErr), err)
+	}
+
+	if execErr != nil {
+		logger.Info("OpenTofu finished with error", "output", string(output))
+		return t.executionError(command, output, execErr)
+	}
+
+	logger.Info("OpenTofu finished successfully")
+	return nil
+}
+
+func (t *tofuRunner) executionError(command string, output []byte, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errorMessage := fmt.Sprintf("Terraform execution for command '%s' could not be completed", command)
+	if terraformErrors := findTerraformErrors(string(output)); terraformErrors != "" {
+		return fmt.Errorf("%s:\n\n%s", errorMessage, terraformErrors)
+	}
+	return fmt.Errorf("%s: %w", errorMessage, err)
+}
+
+// prepareWorkDir creates a temporary working directory containing the Terraform configuration, the variables and the
+// current state.
+func (t *tofuRunner) prepareWorkDir(ctx context.Context) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, client.ObjectKey{Namespace: t.namespace, Name: t.configName}, configMap); err != nil {
+		return "", fmt.Errorf("failed reading Terraform configuration: %w", err)
+	}
+
+	variables := &corev1.Secret{}
+	if err := t.client.Get(ctx, client.ObjectKey{Namespace: t.namespace, Name: t.variablesName}, variables); err != nil {
+		return "", fmt.Errorf("failed reading Terraform variables: %w", err)
+	}
+
+	state, err := t.GetState(ctx)
+	if client.IgnoreNotFound(err) != nil {
+		return "", fmt.Errorf("failed reading Terraform state: %w", err)
+	}
+
+	dir, err := os.MkdirTemp(t.workDir, fmt.Sprintf("%s.%s.%s-", t.namespace, t.name, t.purpose))
+	if err != nil {
+		return "", fmt.Errorf("failed creating working directory: %w", err)
+	}
+
+	files := map[string][]byte{
+		MainKey:      []byte(configMap.Data[MainKey]),
+		VariablesKey: []byte(configMap.Data[VariablesKey]),
+		TFVarsKey:    variables.Data[TFVarsKey],
+	}
+	if len(state) > 0 {
+		files[StateKey] = state
+	}
+
+	for file, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, file), content, 0600); err != nil {
+			return "", errors.Join(fmt.Errorf("failed writing %s: %w", file, err), os.RemoveAll(dir))
+		}
+	}
+
+	return dir, nil
+}
+
+// storeState stores the state written by OpenTofu in the state backend if it has changed.
+func (t *tofuRunner) storeState(ctx context.Context, logger logr.Logger, dir string) error {
+	state, err := os.ReadFile(filepath.Join(dir, StateKey)) // #nosec G304 -- The path is controlled by the runner.
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading Terraform state: %w", err)
+	}
+
+	// The state must also be stored if the context was cancelled or exceeded the deadline.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+	defer cancel()
+
+	current, err := t.stateBackend.Get(ctx)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed reading Terraform state: %w", err)
+	}
+	if err == nil && bytes.Equal(current, state) {
+		return nil
+	}
+
+	logger.Info("Storing Terraform state")
+	if err := t.stateBackend.Store(ctx, state, t.ownerRef); err != nil {
+		return fmt.Errorf("failed storing Terraform state: %w", err)
+	}
+	return nil
+}
+
+// NumberOfResources returns the number of existing Terraform resources or an error in case something went wrong.
+func (t *tofuRunner) NumberOfResources(ctx context.Context) (int, error) {
+	numberOfExistingResources := 0
+
+	for _, obj := range []client.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.configName}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.variablesName}},
+	} {
+		if err := t.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err == nil {
+			numberOfExistingResources++
+		} else if !apierrors.IsNotFound(err) {
+			return -1, err
+		}
+	}
+
+	if _, err := t.GetState(ctx); err == nil {
+		numberOfExistingResources++
+	} else if !apierrors.IsNotFound(err) {
+		return -1, err
+	}
+
+	return numberOfExistingResources, nil
+}
+
+// ConfigExists returns true if the configuration, variables and state exist, and false otherwise.
+func (t *tofuRunner) ConfigExists(ctx context.Context) (bool, error) {
+	numberOfExistingResources, err := t.NumberOfResources(ctx)
+	return numberOfExistingResources == numberOfConfigResources, err
+}
+
+// CleanupConfiguration deletes the resources storing the Terraform configuration, variables, state and lock.
+func (t *tofuRunner) CleanupConfiguration(ctx context.Context) error {
+	t.logger.Info("Cleaning up all terraformer configuration")
+
+	t.logger.V(1).Info("Deleting Terraform state", "name", t.stateName)
+	if err := t.stateBackend.Delete(ctx); err != nil {
+		return err
+	}
+
+	for _, obj := range []client.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.stateName}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.variablesName}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.configName}},
+		&coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.lockName}},
+	} {
+		t.logger.V(1).Info("Deleting Terraform resource", "name", obj.GetName())
+		if err := t.client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveTerraformerFinalizerFromConfig deletes the terraformer finalizer from the resources storing the Terraform
+// configuration and state. The finalizer is only added by Terraformer pods, i.e., it is only present if the
+// infrastructure was previously reconciled by them.
+func (t *tofuRunner) RemoveTerraformerFinalizerFromConfig(ctx context.Context) error {
+	for _, obj := range []client.Object{
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.variablesName}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.stateName}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.configName}},
+		t.stateBackend.Object(),
+	} {
+		if err := t.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if controllerutil.ContainsFinalizer(obj, TerraformerFinalizer) {
+			t.logger.Info("Removing finalizer", "obj", client.ObjectKeyFromObject(obj))
+			if err := controllerutils.RemoveFinalizers(ctx, t.client, obj, TerraformerFinalizer); err != nil {
+				return fmt.Errorf("failed to remove finalizer: %w", err)
+			}
+		}
+	}
+	return nil
+}
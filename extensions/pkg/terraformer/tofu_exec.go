@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Executor executes OpenTofu commands.
+type Executor interface {
+	// Execute runs OpenTofu with the given arguments in the given working directory and returns its combined output.
+	// The environment is added to the environment of the current process.
+	Execute(ctx context.Context, dir string, env []string, args ...string) ([]byte, error)
+}
+
+// NewBinaryExecutor returns an Executor which runs the given OpenTofu binary. When the context is cancelled, the
+// process is interrupted so that OpenTofu can persist its state, and killed if it does not terminate within the given
+// grace period.
+func NewBinaryExecutor(binary string, gracePeriod time.Duration) Executor {
+	return &binaryExecutor{binary: binary, gracePeriod: gracePeriod}
+}
+
+type binaryExecutor struct {
+	binary      string
+	gracePeriod time.Duration
+}
+
+func (e *binaryExecutor) Execute(ctx context.Context, dir string, env []string, args ...string) ([]byte, error) {
+	var output bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, e.binary, args...) // #nosec G204 -- The binary and arguments are controlled by the extension.
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = e.gracePeriod
+
+	err := cmd.Run()
+	return output.Bytes(), err
+}
+
+// resolveEnvVars resolves the configured environment variables for the OpenTofu process. Contrary to the Terraformer
+// pods, only literal values and references to keys of Secrets and ConfigMaps in the Terraformer namespace are
+// supported.
+func (t *tofuRunner) resolveEnvVars(ctx context.Context) ([]string, error) {
+	var env []string
+
+	for _, envVar := range t.envVars {
+		value, err := t.resolveEnvVar(ctx, envVar)
+		if err != nil {
+			return nil, fmt.Errorf("failed resolving environment variable %q: %w", envVar.Name, err)
+		}
+		env = append(env, envVar.Name+"="+value)
+	}
+
+	if t.logLevel == "debug" {
+		env = append(env, "TF_LOG=DEBUG")
+	}
+
+	return env, nil
+}
+
+func (t *tofuRunner) resolveEnvVar(ctx context.Context, envVar corev1.EnvVar) (string, error) {
+	if envVar.ValueFrom == nil {
+		return envVar.Value, nil
+	}
+
+	switch {
+	case envVar.ValueFrom.SecretKeyRef != nil:
+		ref := envVar.ValueFrom.SecretKeyRef
+		secret := &corev1.Secret{}
+		if err := t.client.Get(ctx, client.ObjectKey{Namespace: t.namespace, Name: ref.Name}, secret); err != nil {
+			return "", err
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("key %q not found in Secret %s/%s", ref.Key, t.namespace, ref.Name)
+		}
+		return string(value), nil
+
+	case envVar.ValueFrom.ConfigMapKeyRef != nil:
+		ref := envVar.ValueFrom.ConfigMapKeyRef
+		configMap := &corev1.ConfigMap{}
+		if err := t.client.Get(ctx, client.ObjectKey{Namespace: t.namespace, Name: ref.Name}, configMap); err != nil {
+			return "", err
+		}
+		value, ok := configMap.Data[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("key %q not found in ConfigMap %s/%s", ref.Key, t.namespace, ref.Name)
+		}
+		return value, nil
+
+	default:
+		return "", fmt.Errorf("only secretKeyRef and configMapKeyRef are supported as value sources")
+	}
+}
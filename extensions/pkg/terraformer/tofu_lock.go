@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// LockSuffix is the suffix used for the Lease which locks the Terraform state while OpenTofu is running.
+const LockSuffix = ".tf-lock"
+
+// stateLockedError is returned if the Terraform state is locked by another holder.
+type stateLockedError struct {
+	holder string
+}
+
+func (e *stateLockedError) Error() string {
+	return fmt.Sprintf("Terraform state is locked by %q", e.holder)
+}
+
+// IsStateLockedError returns true if the error indicates that the Terraform state is locked by another holder.
+func IsStateLockedError(err error) bool {
+	var lockedErr *stateLockedError
+	return errors.As(err, &lockedErr)
+}
+
+// acquireLock acquires the Lease locking the Terraform state. It waits until the deadline for the lock creation if the
+// Lease is held by somebody else. Expired Leases are taken over.
+func (t *tofuRunner) acquireLock(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, t.deadlinePodCreation)
+	defer cancel()
+
+	return retry.Until(ctx, t.lockRetryInterval, func(ctx context.Context) (done bool, err error) {
+		if err := t.tryAcquireLock(ctx); err != nil {
+			if IsStateLockedError(err) || apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) {
+				t.logger.Info("Waiting for Terraform state lock", "reason", err.Error())
+				return retry.MinorError(err)
+			}
+			return retry.SevereError(err)
+		}
+		return retry.Ok()
+	})
+}
+
+func (t *tofuRunner) tryAcquireLock(ctx context.Context) error {
+	var (
+		now   = metav1.NewMicroTime(t.clock.Now())
+		lease = &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.lockName}}
+	)
+
+	if err := t.client.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		lease.Spec = coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(t.identity),
+			LeaseDurationSeconds: ptr.To(int32(t.lockDuration / time.Second)),
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		}
+		if t.ownerRef != nil {
+			lease.SetOwnerReferences([]metav1.OwnerReference{*t.ownerRef})
+		}
+		return t.client.Create(ctx, lease)
+	}
+
+	if holder := ptr.Deref(lease.Spec.HolderIdentity, ""); holder != "" && holder != t.identity && !t.isLockExpired(lease) {
+		return &stateLockedError{holder: holder}
+	}
+
+	// Updating the Lease fails with a conflict error if it was changed concurrently, hence, only one holder can win.
+	lease.Spec.HolderIdentity = ptr.To(t.identity)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(t.lockDuration / time.Second))
+	lease.Spec.AcquireTime = &now
+	lease.Spec.RenewTime = &now
+	return t.client.Update(ctx, lease)
+}
+
+func (t *tofuRunner) isLockExpired(lease *coordinationv1.Lease) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	return lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second).Before(t.clock.Now())
+}
+
+// errStateLockLost is the cause the OpenTofu run is cancelled with if the Lease locking the Terraform state was lost.
+var errStateLockLost = errors.New("Terraform state lock lost")
+
+// renewLock renews the Lease locking the Terraform state until the given context is cancelled. If the Lease is held by
+// somebody else or could not be renewed within the lease duration, the lock is considered lost and the OpenTofu run is
+// cancelled via the given function, since another run might already operate on the same state.
+func (t *tofuRunner) renewLock(ctx context.Context, cancelRun context.CancelCauseFunc) {
+	ticker := t.clock.NewTicker(t.lockDuration / 3)
+	defer ticker.Stop()
+
+	lastRenewTime := t.clock.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			if err := t.tryRenewLock(ctx); err != nil {
+				if errors.Is(err, errStateLockLost) {
+					t.logger.Error(err, "Cancelling OpenTofu run")
+					cancelRun(err)
+					return
+				}
+
+				t.logger.Error(err, "Failed to renew Terraform state lock")
+				if t.clock.Since(lastRenewTime) >= t.lockDuration {
+					cancelRun(fmt.Errorf("%w: failed renewing it within the lease duration of %s: %w", errStateLockLost, t.lockDuration, err))
+					return
+				}
+				continue
+			}
+			lastRenewTime = t.clock.Now()
+		}
+	}
+}
+
+func (t *tofuRunner) tryRenewLock(ctx context.Context) error {
+	lease := &coordinationv1.Lease{}
+	if err := t.client.Get(ctx, client.ObjectKey{Namespace: t.namespace, Name: t.lockName}, lease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%w: Lease has been deleted", errStateLockLost)
+		}
+		return err
+	}
+
+	if holder := ptr.Deref(lease.Spec.HolderIdentity, ""); holder != t.identity {
+		return fmt.Errorf("%w: Lease is held by %q", errStateLockLost, holder)
+	}
+
+	// Updating the Lease fails with a conflict error if it was changed concurrently, e.g., taken over by somebody else.
+	lease.Spec.RenewTime = ptr.To(metav1.NewMicroTime(t.clock.Now()))
+	return t.client.Update(ctx, lease)
+}
+
+// releaseLock releases the Lease locking the Terraform state if it is still held by this runner.
+func (t *tofuRunner) releaseLock(ctx context.Context) error {
+	lease := &coordinationv1.Lease{}
+	if err := t.client.Get(ctx, client.ObjectKey{Namespace: t.namespace, Name: t.lockName}, lease); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if ptr.Deref(lease.Spec.HolderIdentity, "") != t.identity {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(lease.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.AcquireTime = nil
+	lease.Spec.RenewTime = nil
+	return t.client.Patch(ctx, lease, patch)
+}
+
+// WaitForCleanEnvironment waits until the Terraform state is no longer locked by another OpenTofu run.
+func (t *tofuRunner) WaitForCleanEnvironment(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, t.deadlineCleaning)
+	defer cancel()
+
+	t.logger.Info("Waiting for clean environment")
+	return retry.Until(ctx, t.lockRetryInterval, func(ctx context.Context) (done bool, err error) {
+		lease := &coordinationv1.Lease{}
+		if err := t.client.Get(ctx, client.ObjectKey{Namespace: t.namespace, Name: t.lockName}, lease); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.Ok()
+			}
+			return retry.SevereError(err)
+		}
+
+		if holder := ptr.Deref(lease.Spec.HolderIdentity, ""); holder != "" && holder != t.identity && !t.isLockExpired(lease) {
+			t.logger.Info("Waiting until the Terraform state lock has been released", "holder", holder)
+			return retry.MinorError(&stateLockedError{holder: holder})
+		}
+		return retry.Ok()
+	})
+}
+
+// EnsureCleanedUp waits until no other OpenTofu run holds the Terraform state lock. OpenTofu runs of other processes
+// cannot be terminated, they release the lock when they are finished or their lock expires.
+func (t *tofuRunner) EnsureCleanedUp(ctx context.Context) error {
+	return t.WaitForCleanEnvironment(ctx)
+}
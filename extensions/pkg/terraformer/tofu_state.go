@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformer
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// StateEncryptionKey is the key inside the state Secret which denotes the encryption of the Terraform state.
+	StateEncryptionKey = "encryption"
+	// StateEncryptionAESGCM denotes that the Terraform state is encrypted with AES-GCM.
+	StateEncryptionAESGCM = "aes-gcm"
+)
+
+// StateBackend stores the Terraform state of the OpenTofu runner.
+type StateBackend interface {
+	// Get returns the Terraform state. It returns an error which can be checked with apierrors.IsNotFound if no state
+	// exists.
+	Get(ctx context.Context) ([]byte, error)
+	// Store creates or updates the Terraform state.
+	Store(ctx context.Context, state []byte, ownerRef *metav1.OwnerReference) error
+	// Delete deletes the Terraform state. It does not return an error if no state exists.
+	Delete(ctx context.Context) error
+	// Object returns an empty object of the resource storing the Terraform state.
+	Object() client.Object
+}
+
+// NewStateBackendFunc returns a StateBackend for the Terraform state with the given name.
+type NewStateBackendFunc func(c client.Client, namespace, name string) StateBackend
+
+// NewConfigMapStateBackend returns a StateBackend which stores the Terraform state in a ConfigMap. This is the format
+// used by the Terraformer pods, hence, both runners can be used interchangeably with this backend.
+func NewConfigMapStateBackend(c client.Client, namespace, name string) StateBackend {
+	return &configMapStateBackend{client: c, namespace: namespace, name: name}
+}
+
+type configMapStateBackend struct {
+	client    client.Client
+	namespace string
+	name      string
+}
+
+func (b *configMapStateBackend) Object() client.Object {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: b.namespace, Name: b.name}}
+}
+
+func (b *configMapStateBackend) Get(ctx context.Context) ([]byte, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := b.client.Get(ctx, client.ObjectKey{Namespace: b.namespace, Name: b.name}, configMap); err != nil {
+		return nil, err
+	}
+	return []byte(configMap.Data[StateKey]), nil
+}
+
+func (b *configMapStateBackend) Store(ctx context.Context, state []byte, ownerRef *metav1.OwnerReference) error {
+	_, err := createOrUpdateConfigMap(ctx, b.client, b.namespace, b.name, map[string]string{StateKey: string(state)}, ownerRef)
+	return err
+}
+
+func (b *configMapStateBackend) Delete(ctx context.Context) error {
+	return client.IgnoreNotFound(b.client.Delete(ctx, b.Object()))
+}
+
+// NewSecretStateBackend returns a StateBackend which stores the Terraform state in a Secret. If an encryption key is
+// given, the state is encrypted with AES-GCM. The key must be 16, 24 or 32 bytes long. States which were stored
+// unencrypted can still be read after an encryption key has been configured, they are encrypted with the next update.
+func NewSecretStateBackend(encryptionKey []byte) NewStateBackendFunc {
+	return func(c client.Client, namespace, name string) StateBackend {
+		return &secretStateBackend{client: c, namespace: namespace, name: name, encryptionKey: encryptionKey}
+	}
+}
+
+type secretStateBackend struct {
+	client        client.Client
+	namespace     string
+	name          string
+	encryptionKey []byte
+}
+
+func (b *secretStateBackend) Object() client.Object {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: b.namespace, Name: b.name}}
+}
+
+func (b *secretStateBackend) Get(ctx context.Context) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := b.client.Get(ctx, client.ObjectKey{Namespace: b.namespace, Name: b.name}, secret); err != nil {
+		return nil, err
+	}
+
+	switch encryption := string(secret.Data[StateEncryptionKey]); encryption {
+	case "":
+		return secret.Data[StateKey], nil
+	case StateEncryptionAESGCM:
+		if len(b.encryptionKey) == 0 {
+			return nil, fmt.Errorf("Terraform state in Secret %s is encrypted but no encryption key is configured", client.ObjectKeyFromObject(secret))
+		}
+		return decryptState(b.encryptionKey, secret.Data[StateKey])
+	default:
+		return nil, fmt.Errorf("unsupported encryption %q of Terraform state in Secret %s", encryption, client.ObjectKeyFromObject(secret))
+	}
+}
+
+func (b *secretStateBackend) Store(ctx context.Context, state []byte, ownerRef *metav1.OwnerReference) error {
+	data := map[string][]byte{StateKey: state}
+	if len(b.encryptionKey) > 0 && len(state) > 0 {
+		encrypted, err := encryptState(b.encryptionKey, state)
+		if err != nil {
+			return err
+		}
+		data = map[string][]byte{StateKey: encrypted, StateEncryptionKey: []byte(StateEncryptionAESGCM)}
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: b.namespace, Name: b.name}}
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.client, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = data
+		if ownerRef != nil {
+			secret.SetOwnerReferences(kubernetesutils.MergeOwnerReferences(secret.OwnerReferences, *ownerRef))
+		}
+		return nil
+	})
+	return err
+}
+
+func (b *secretStateBackend) Delete(ctx context.Context) error {
+	return client.IgnoreNotFound(b.client.Delete(ctx, b.Object()))
+}
+
+func encryptState(key, state []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, state, nil), nil
+}
+
+func decryptState(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("encrypted Terraform state is too short")
+	}
+
+	state, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting Terraform state: %w", err)
+	}
+	return state, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Terraform state encryption key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// AnnotationImportedStateChecksum is the annotation on the legacy state ConfigMap containing the checksum of the
+// Terraform state which was last imported into the configured state backend.
+const AnnotationImportedStateChecksum = "terraformer.gardener.cloud/imported-state-checksum"
+
+// legacyState returns the Terraform state of the state ConfigMap used by the Terraformer pods if it has not been
+// imported into the configured state backend yet. The ConfigMap is also written by the StateConfigMapInitializer, e.g.
+// when the state is restored after a control plane migration. Hence, a non-empty state in the ConfigMap takes
+// precedence over the state in the backend until it has been imported.
+func (t *tofuRunner) legacyState(ctx context.Context) (*corev1.ConfigMap, []byte, error) {
+	if _, ok := t.stateBackend.(*configMapStateBackend); ok {
+		return nil, nil, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, client.ObjectKey{Namespace: t.namespace, Name: t.stateName}, configMap); err != nil {
+		return nil, nil, client.IgnoreNotFound(err)
+	}
+
+	state := configMap.Data[StateKey]
+	if len(state) == 0 || configMap.Annotations[AnnotationImportedStateChecksum] == utils.ComputeSHA256Hex([]byte(state)) {
+		return configMap, nil, nil
+	}
+	return configMap, []byte(state), nil
+}
+
+// importLegacyState imports the Terraform state from the state ConfigMap used by the Terraformer pods into the
+// configured state backend. The ConfigMap is kept as a fallback and annotated with the checksum of the imported state,
+// so that it is only imported again if it was changed afterwards. It must only be called while holding the lock.
+func (t *tofuRunner) importLegacyState(ctx context.Context) error {
+	configMap, state, err := t.legacyState(ctx)
+	if err != nil || configMap == nil {
+		return err
+	}
+
+	if state != nil {
+		t.logger.Info("Importing Terraform state from ConfigMap into state backend", "configMap", client.ObjectKeyFromObject(configMap))
+		if err := t.stateBackend.Store(ctx, state, t.ownerRef); err != nil {
+			return fmt.Errorf("failed importing Terraform state from ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+		}
+	}
+
+	if state == nil && !controllerutil.ContainsFinalizer(configMap, TerraformerFinalizer) {
+		return nil
+	}
+
+	patch := client.MergeFrom(configMap.DeepCopy())
+	if state != nil {
+		metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, AnnotationImportedStateChecksum, utils.ComputeSHA256Hex(state))
+	}
+	controllerutil.RemoveFinalizer(configMap, TerraformerFinalizer)
+	if err := t.client.Patch(ctx, configMap, patch); err != nil {
+		return fmt.Errorf("failed marking Terraform state in ConfigMap %s as imported: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+	return nil
+}
+
+// GetState returns the Terraform state as byte slice. A state in the legacy ConfigMap which has not been imported yet
+// is returned without importing it, see legacyState.
+func (t *tofuRunner) GetState(ctx context.Context) ([]byte, error) {
+	_, state, err := t.legacyState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if state != nil {
+		return state, nil
+	}
+	return t.stateBackend.Get(ctx)
+}
+
+// GetRawState returns the Terraform state stored in the state backend.
+func (t *tofuRunner) GetRawState(ctx context.Context) (*RawState, error) {
+	state, err := t.GetState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &RawState{
+		Data:     string(state),
+		Encoding: NoneEncoding,
+	}, nil
+}
+
+// GetStateOutputVariables returns the given <variables> from the Terraform state.
+// In case a variable was not found, an error is returned.
+func (t *tofuRunner) GetStateOutputVariables(ctx context.Context, variables ...string) (map[string]string, error) {
+	state, err := t.GetState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return outputVariablesFromState(state, variables...)
+}
+
+// IsStateEmpty returns true if the Terraform state is empty and the terraformer finalizer is not present on any of
+// the used configmaps and secrets. Otherwise, it returns false.
+func (t *tofuRunner) IsStateEmpty(ctx context.Context) bool {
+	for _, obj := range []client.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.configName}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.stateName}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.variablesName}},
+	} {
+		if err := t.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); client.IgnoreNotFound(err) != nil {
+			t.logger.Error(err, "Failed to get resource", "name", obj.GetName())
+			return false
+		}
+
+		if controllerutil.ContainsFinalizer(obj, TerraformerFinalizer) {
+			return false
+		}
+	}
+
+	state, err := t.GetState(ctx)
+	if err != nil {
+		return apierrors.IsNotFound(err)
+	}
+	return len(state) == 0
+}
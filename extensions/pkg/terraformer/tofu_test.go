@@ -0,0 +1,357 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformer_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	. "github.com/gardener/gardener/extensions/pkg/terraformer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+type fakeExecutor struct {
+	calls [][]string
+	files map[string]string
+	env   []string
+
+	state  string
+	output string
+	err    error
+	// run is called instead of returning the output and error for all commands except init if set.
+	run func(ctx context.Context) ([]byte, error)
+}
+
+func (e *fakeExecutor) Execute(ctx context.Context, dir string, env []string, args ...string) ([]byte, error) {
+	e.calls = append(e.calls, args)
+	e.env = env
+
+	e.files = map[string]string{}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		e.files[entry.Name()] = string(content)
+	}
+
+	if args[0] == "init" {
+		return nil, nil
+	}
+	if e.state != "" {
+		if err := os.WriteFile(filepath.Join(dir, StateKey), []byte(e.state), 0600); err != nil {
+			return nil, err
+		}
+	}
+	if e.run != nil {
+		return e.run(ctx)
+	}
+	return []byte(e.output), e.err
+}
+
+var _ = Describe("OpenTofu runner", func() {
+	const (
+		prefix = name + "." + purpose
+		state  = `{"version":4,"outputs":{"vpc_id":{"type":"string","value":"vpc-1"}}}`
+	)
+
+	var (
+		ctx        = context.Background()
+		log        = logr.Discard()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		executor   *fakeExecutor
+		options    TofuOptions
+
+		newRunner func() Terraformer
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		executor = &fakeExecutor{state: state}
+		options = TofuOptions{
+			PluginDir: "/plugins",
+			WorkDir:   GinkgoT().TempDir(),
+			Identity:  "provider-foo-0",
+			Executor:  executor,
+			Clock:     fakeClock,
+		}
+
+		newRunner = func() Terraformer {
+			return NewTofu(log, fakeClient, purpose, namespace, name, options).
+				SetDeadlinePodCreation(10*time.Millisecond).
+				InitializeWith(ctx, DefaultInitializer(fakeClient, "main", "variables", []byte("tfvars"), StateConfigMapInitializerFunc(CreateState)))
+		}
+	})
+
+	Describe("#Apply", func() {
+		It("should run OpenTofu and store the state", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+				Data:       map[string][]byte{"accessKeyID": []byte("key")},
+			})).To(Succeed())
+
+			runner := newRunner().SetEnvVars(
+				corev1.EnvVar{Name: "TF_VAR_REGION", Value: "eu-1"},
+				corev1.EnvVar{Name: "TF_VAR_ACCESS_KEY_ID", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "cloudprovider"},
+					Key:                  "accessKeyID",
+				}}},
+			)
+
+			Expect(runner.Apply(ctx)).To(Succeed())
+
+			Expect(executor.calls).To(Equal([][]string{
+				{"init", "-input=false", "-no-color", "-plugin-dir=/plugins"},
+				{"apply", "-auto-approve", "-input=false", "-no-color"},
+			}))
+			Expect(executor.files).To(Equal(map[string]string{MainKey: "main", VariablesKey: "variables", TFVarsKey: "tfvars"}))
+			Expect(executor.env).To(ConsistOf("TF_VAR_REGION=eu-1", "TF_VAR_ACCESS_KEY_ID=key"))
+
+			Expect(runner.GetState(ctx)).To(BeEquivalentTo(state))
+			Expect(runner.GetStateOutputVariables(ctx, "vpc_id")).To(Equal(map[string]string{"vpc_id": "vpc-1"}))
+
+			lease := &coordinationv1.Lease{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + LockSuffix}, lease)).To(Succeed())
+			Expect(lease.Spec.HolderIdentity).To(BeNil())
+		})
+
+		It("should pass the existing state to OpenTofu", func() {
+			Expect(newRunner().Apply(ctx)).To(Succeed())
+			Expect(newRunner().Apply(ctx)).To(Succeed())
+
+			Expect(executor.files).To(HaveKeyWithValue(StateKey, state))
+		})
+
+		It("should store the state and return the errors if OpenTofu failed", func() {
+			executor.output = "Error: quota exceeded\n\nTerraform does not automatically rollback"
+			executor.err = errors.New("exit status 1")
+
+			runner := newRunner()
+			Expect(runner.Apply(ctx)).To(MatchError("Terraform execution for command 'apply' could not be completed:\n\n* quota exceeded"))
+			Expect(runner.GetState(ctx)).To(BeEquivalentTo(state))
+		})
+
+		It("should fail if the configuration is missing", func() {
+			runner := NewTofu(log, fakeClient, purpose, namespace, name, options)
+			Expect(runner.Apply(ctx)).To(MatchError(ContainSubstring("configuration has not been defined")))
+		})
+
+		It("should fail if the state is locked by somebody else", func() {
+			Expect(fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: prefix + LockSuffix, Namespace: namespace},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("provider-foo-1"),
+					LeaseDurationSeconds: ptr.To[int32](30),
+					RenewTime:            ptr.To(metav1.NewMicroTime(fakeClock.Now())),
+				},
+			})).To(Succeed())
+
+			err := newRunner().Apply(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`Terraform state is locked by "provider-foo-1"`)))
+			Expect(IsStateLockedError(err)).To(BeTrue())
+			Expect(executor.calls).To(BeEmpty())
+		})
+
+		It("should take over an expired lock", func() {
+			Expect(fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: prefix + LockSuffix, Namespace: namespace},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("provider-foo-1"),
+					LeaseDurationSeconds: ptr.To[int32](30),
+					RenewTime:            ptr.To(metav1.NewMicroTime(fakeClock.Now().Add(-time.Minute))),
+				},
+			})).To(Succeed())
+
+			Expect(newRunner().Apply(ctx)).To(Succeed())
+			Expect(executor.calls).To(HaveLen(2))
+		})
+
+		It("should cancel OpenTofu if the lock was taken over by somebody else", func() {
+			executor.run = func(ctx context.Context) ([]byte, error) {
+				lease := &coordinationv1.Lease{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + LockSuffix}, lease)).To(Succeed())
+				lease.Spec.HolderIdentity = ptr.To("provider-foo-1")
+				Expect(fakeClient.Update(ctx, lease)).To(Succeed())
+
+				Eventually(func() error {
+					fakeClock.Step(DefaultLockDuration / 3)
+					return ctx.Err()
+				}).Should(HaveOccurred())
+				return nil, ctx.Err()
+			}
+
+			Expect(newRunner().Apply(ctx)).To(MatchError(ContainSubstring(`Terraform state lock lost: Lease is held by "provider-foo-1"`)))
+
+			lease := &coordinationv1.Lease{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + LockSuffix}, lease)).To(Succeed())
+			Expect(lease.Spec.HolderIdentity).To(PointTo(Equal("provider-foo-1")))
+		})
+
+		It("should cancel OpenTofu if the lock could not be renewed within the lease duration", func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					if _, ok := obj.(*coordinationv1.Lease); ok {
+						return errors.New("fake")
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+			}).Build()
+
+			var renewals int
+			executor.run = func(ctx context.Context) ([]byte, error) {
+				Eventually(func() error {
+					fakeClock.Step(DefaultLockDuration / 3)
+					renewals++
+					return ctx.Err()
+				}).Should(HaveOccurred())
+				return nil, ctx.Err()
+			}
+
+			Expect(newRunner().Apply(ctx)).To(MatchError(ContainSubstring("Terraform state lock lost: failed renewing it within the lease duration of 30s: fake")))
+			Expect(renewals).To(BeNumerically(">=", 3))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should skip OpenTofu if the state is empty and clean up the configuration", func() {
+			runner := newRunner()
+			Expect(runner.Destroy(ctx)).To(Succeed())
+
+			Expect(executor.calls).To(BeEmpty())
+			Expect(runner.NumberOfResources(ctx)).To(Equal(0))
+		})
+
+		It("should run OpenTofu and clean up the configuration", func() {
+			Expect(newRunner().Apply(ctx)).To(Succeed())
+
+			executor.state = `{"version":4}`
+			runner := newRunner()
+			Expect(runner.Destroy(ctx)).To(Succeed())
+
+			Expect(executor.calls[len(executor.calls)-1]).To(Equal([]string{"destroy", "-auto-approve", "-input=false", "-no-color"}))
+			Expect(runner.NumberOfResources(ctx)).To(Equal(0))
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + LockSuffix}, &coordinationv1.Lease{})).To(BeNotFoundError())
+		})
+	})
+
+	Describe("Secret state backend", func() {
+		var key = []byte("0123456789abcdef0123456789abcdef")
+
+		BeforeEach(func() {
+			options.NewStateBackend = NewSecretStateBackend(key)
+		})
+
+		It("should store the state encrypted", func() {
+			runner := newRunner()
+			Expect(runner.Apply(ctx)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + StateSuffix}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue(StateEncryptionKey, []byte(StateEncryptionAESGCM)))
+			Expect(string(secret.Data[StateKey])).NotTo(ContainSubstring("vpc_id"))
+
+			Expect(runner.GetState(ctx)).To(BeEquivalentTo(state))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + StateSuffix}, configMap)).To(Succeed())
+			Expect(configMap.Data[StateKey]).To(BeEmpty())
+		})
+
+		It("should import the state from the legacy ConfigMap", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: prefix + StateSuffix, Namespace: namespace, Finalizers: []string{TerraformerFinalizer}},
+				Data:       map[string]string{StateKey: state},
+			})).To(Succeed())
+
+			runner := newRunner()
+			Expect(runner.GetState(ctx)).To(BeEquivalentTo(state))
+			Expect(runner.IsStateEmpty(ctx)).To(BeFalse())
+
+			By("Verify that reading the state does not import it")
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + StateSuffix}, configMap)).To(Succeed())
+			Expect(configMap.Annotations).NotTo(HaveKey(AnnotationImportedStateChecksum))
+			Expect(configMap.Finalizers).To(ConsistOf(TerraformerFinalizer))
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + StateSuffix}, &corev1.Secret{})).To(BeNotFoundError())
+
+			By("Apply and verify that the state was imported and the ConfigMap was kept")
+			executor.state = `{"version":4}`
+			Expect(runner.Apply(ctx)).To(Succeed())
+			Expect(executor.files).To(HaveKeyWithValue(StateKey, state))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + StateSuffix}, configMap)).To(Succeed())
+			Expect(configMap.Annotations).To(HaveKey(AnnotationImportedStateChecksum))
+			Expect(configMap.Finalizers).To(BeEmpty())
+			Expect(configMap.Data).To(HaveKeyWithValue(StateKey, state))
+			Expect(runner.GetState(ctx)).To(BeEquivalentTo(`{"version":4}`))
+
+			By("Change the state in the ConfigMap and verify that it is imported again")
+			configMap.Data[StateKey] = `{"version":4,"serial":2}`
+			Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+			Expect(runner.GetState(ctx)).To(BeEquivalentTo(`{"version":4,"serial":2}`))
+
+			Expect(runner.Apply(ctx)).To(Succeed())
+			Expect(executor.files).To(HaveKeyWithValue(StateKey, `{"version":4,"serial":2}`))
+			Expect(runner.GetState(ctx)).To(BeEquivalentTo(`{"version":4}`))
+
+			By("Clean up and verify that the ConfigMap is deleted")
+			Expect(runner.CleanupConfiguration(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + StateSuffix}, &corev1.ConfigMap{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prefix + StateSuffix}, &corev1.Secret{})).To(BeNotFoundError())
+		})
+
+		It("should fail reading an encrypted state without key", func() {
+			Expect(newRunner().Apply(ctx)).To(Succeed())
+
+			options.NewStateBackend = NewSecretStateBackend(nil)
+			runner := NewTofu(log, fakeClient, purpose, namespace, name, options)
+			_, err := runner.GetState(ctx)
+			Expect(err).To(MatchError(ContainSubstring("is encrypted but no encryption key is configured")))
+		})
+	})
+
+	Describe("#WaitForCleanEnvironment", func() {
+		It("should succeed if the state is not locked", func() {
+			Expect(NewTofu(log, fakeClient, purpose, namespace, name, options).WaitForCleanEnvironment(ctx)).To(Succeed())
+		})
+
+		It("should fail if the state is locked until the deadline", func() {
+			Expect(fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: prefix + LockSuffix, Namespace: namespace},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("provider-foo-1"),
+					LeaseDurationSeconds: ptr.To[int32](30),
+					RenewTime:            ptr.To(metav1.NewMicroTime(fakeClock.Now())),
+				},
+			})).To(Succeed())
+
+			runner := NewTofu(log, fakeClient, purpose, namespace, name, options).SetDeadlineCleaning(10 * time.Millisecond)
+			Expect(runner.WaitForCleanEnvironment(ctx)).To(MatchError(ContainSubstring("locked")))
+		})
+	})
+})
@@ -32,6 +32,7 @@ AVAILABLE_CODEGEN_OPTIONS=(
   "provider_local_groups"
   "extensions_config_groups"
   "nodeagent_groups"
+  "extension_helmchart_groups"
 )
 
 # setup virtual GOPATH
@@ -310,6 +311,21 @@ nodeagent_groups() {
 }
 export -f nodeagent_groups
 
+# Componentconfig for extension-helmchart
+
+extension_helmchart_groups() {
+  echo "Generating API groups for pkg/extension-helmchart/apis/config"
+  
+  kube::codegen::gen_helpers \
+    --boilerplate "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt" \
+    --extra-peer-dir github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1 \
+    --extra-peer-dir k8s.io/apimachinery/pkg/apis/meta/v1 \
+    --extra-peer-dir k8s.io/apimachinery/pkg/conversion \
+    --extra-peer-dir k8s.io/apimachinery/pkg/runtime \
+    "${PROJECT_ROOT}/pkg/extension-helmchart/apis/config"
+}
+export -f extension_helmchart_groups
+
 # Componentconfig for admission plugins
 
 shoottolerationrestriction_groups() {
@@ -244,6 +244,26 @@ func GetResourceByName(resources []core.NamedResourceReference, name string) *co
 	return nil
 }
 
+// GetReferencedConfigMapName returns the name of the ConfigMap referenced by the NamedResourceReference with the given
+// name in the given slice, or an empty string if there is no such reference to a ConfigMap.
+func GetReferencedConfigMapName(resources []core.NamedResourceReference, name string) string {
+	resource := GetResourceByName(resources, name)
+	if resource == nil || resource.ResourceRef.APIVersion != "v1" || resource.ResourceRef.Kind != "ConfigMap" {
+		return ""
+	}
+	return resource.ResourceRef.Name
+}
+
+// GetReferencedSecretName returns the name of the Secret referenced by the NamedResourceReference with the given name
+// in the given slice, or an empty string if there is no such reference to a Secret.
+func GetReferencedSecretName(resources []core.NamedResourceReference, name string) string {
+	resource := GetResourceByName(resources, name)
+	if resource == nil || resource.ResourceRef.APIVersion != "v1" || resource.ResourceRef.Kind != "Secret" {
+		return ""
+	}
+	return resource.ResourceRef.Name
+}
+
 // AccessRestrictionsAreSupported returns true when all the given access restrictions are supported.
 func AccessRestrictionsAreSupported(seedAccessRestrictions []core.AccessRestriction, shootAccessRestrictions []core.AccessRestrictionWithOptions) bool {
 	if len(shootAccessRestrictions) == 0 {
@@ -542,6 +542,52 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	DescribeTable("#GetReferencedConfigMapName",
+		func(resources []core.NamedResourceReference, expected string) {
+			Expect(GetReferencedConfigMapName(resources, "foo")).To(Equal(expected))
+		},
+
+		Entry("resources is nil", nil, ""),
+		Entry("resources doesn't contain a resource with the given name",
+			[]core.NamedResourceReference{{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "bar"}}},
+			"",
+		),
+		Entry("resource with the given name is not a ConfigMap",
+			[]core.NamedResourceReference{{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "foo"}}},
+			"",
+		),
+		Entry("resource with the given name is a ConfigMap",
+			[]core.NamedResourceReference{
+				{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "bar"}},
+				{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "baz"}},
+			},
+			"baz",
+		),
+	)
+
+	DescribeTable("#GetReferencedSecretName",
+		func(resources []core.NamedResourceReference, expected string) {
+			Expect(GetReferencedSecretName(resources, "foo")).To(Equal(expected))
+		},
+
+		Entry("resources is nil", nil, ""),
+		Entry("resources doesn't contain a resource with the given name",
+			[]core.NamedResourceReference{{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "bar"}}},
+			"",
+		),
+		Entry("resource with the given name is not a Secret",
+			[]core.NamedResourceReference{{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "foo"}}},
+			"",
+		),
+		Entry("resource with the given name is a Secret",
+			[]core.NamedResourceReference{
+				{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "bar"}},
+				{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "baz"}},
+			},
+			"baz",
+		),
+	)
+
 	DescribeTable("#AccessRestrictionsAreSupported",
 		func(seedAccessRestrictions []core.AccessRestriction, shootAccessRestrictions []core.AccessRestrictionWithOptions, expectation bool) {
 			Expect(AccessRestrictionsAreSupported(seedAccessRestrictions, shootAccessRestrictions)).To(Equal(expectation))
@@ -115,6 +115,9 @@ type ShootSpec struct {
 	CredentialsBindingName *string
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	AccessRestrictions []AccessRestrictionWithOptions
+	// CustomHealthChecks contains health checks for objects in the Shoot cluster which are evaluated by the gardenlet.
+	// Their result is reported in the `CustomHealthChecksPassed` condition.
+	CustomHealthChecks []CustomHealthCheck
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -1231,12 +1234,103 @@ type MaintenanceTimeWindow struct {
 type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
 	Alerting *Alerting
+	// RemoteWrites is a list of remote write targets to which the shoot Prometheus forwards selected metrics. They are
+	// only considered if the seed operator allows shoot owners to configure remote write targets.
+	RemoteWrites []MonitoringRemoteWrite
+}
+
+// MonitoringRemoteWrite is a remote write target of the shoot Prometheus. At most one of basicAuth or bearerToken can be
+// set.
+type MonitoringRemoteWrite struct {
+	// Name is the unique name of the remote write target.
+	Name string
+	// URL is the https URL of the remote write endpoint.
+	URL string
+	// BasicAuth configures the basic authentication credentials sent to the endpoint.
+	BasicAuth *MonitoringRemoteWriteBasicAuth
+	// BearerToken references the secret key containing the bearer token sent to the endpoint.
+	BearerToken *ResourceSecretKeyReference
+	// Keep is the list of metric names which are forwarded to the endpoint. All other metrics are dropped. Regular
+	// expressions are only supported as long as they match a limited set of metric names, e.g., `etcd_(disk|mvcc)_.*`
+	// is rejected while `kube_pod_(info|labels)` is allowed.
+	Keep []string
+}
+
+// MonitoringRemoteWriteBasicAuth configures basic authentication for a remote write target.
+type MonitoringRemoteWriteBasicAuth struct {
+	// Username references the secret key containing the username.
+	Username ResourceSecretKeyReference
+	// Password references the secret key containing the password.
+	Password ResourceSecretKeyReference
 }
 
 // Alerting contains information about how alerting will be done (i.e. who will receive alerts and how).
 type Alerting struct {
 	// MonitoringEmailReceivers is a list of recipients for alerts
 	EmailReceivers []string
+	// Receivers is a list of additional alert receivers, e.g. webhooks, Slack channels or PagerDuty services, which
+	// get the alerts of the shoot cluster.
+	Receivers []AlertingReceiver
+	// Rules contains the alerting rules which are evaluated by the shoot Prometheus in addition to the rules provided
+	// by Gardener.
+	Rules *AlertingRules
+}
+
+// AlertingRules contains a reference to additional alerting rules for the shoot cluster.
+type AlertingRules struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains the alerting rules in the
+	// format of the spec of a PrometheusRule.
+	ConfigMapName string
+}
+
+// AlertingReceiver is an additional alert receiver. Exactly one of webhook, slack or pagerDuty must be set.
+type AlertingReceiver struct {
+	// Name is the unique name of the receiver.
+	Name string
+	// Severities restricts the alerts routed to this receiver to the given severities. If empty, alerts of all
+	// severities are routed.
+	Severities []string
+	// Components restricts the alerts routed to this receiver to the given components (the `service` label of the
+	// alerts). If empty, alerts of all components are routed.
+	Components []string
+	// SendResolved specifies whether notifications about resolved alerts are sent.
+	SendResolved *bool
+	// Webhook configures a generic webhook receiver.
+	Webhook *WebhookAlertingReceiver
+	// Slack configures a Slack-compatible receiver.
+	Slack *SlackAlertingReceiver
+	// PagerDuty configures a PagerDuty-compatible receiver.
+	PagerDuty *PagerDutyAlertingReceiver
+}
+
+// WebhookAlertingReceiver configures a generic webhook receiver.
+type WebhookAlertingReceiver struct {
+	// URL references the secret key containing the URL of the webhook.
+	URL ResourceSecretKeyReference
+}
+
+// SlackAlertingReceiver configures a Slack-compatible receiver.
+type SlackAlertingReceiver struct {
+	// APIURL references the secret key containing the URL of the incoming webhook.
+	APIURL ResourceSecretKeyReference
+	// Channel is the channel or user to send notifications to.
+	Channel *string
+}
+
+// PagerDutyAlertingReceiver configures a PagerDuty-compatible receiver.
+type PagerDutyAlertingReceiver struct {
+	// RoutingKey references the secret key containing the routing key of the PagerDuty Events API v2 integration.
+	RoutingKey ResourceSecretKeyReference
+	// URL is the URL of the events API. If not set, the default PagerDuty URL is used.
+	URL *string
+}
+
+// ResourceSecretKeyReference references a key of a Secret which is referenced in the `.spec.resources` of the Shoot.
+type ResourceSecretKeyReference struct {
+	// ResourceName is the name of the resource reference in the `.spec.resources` of the Shoot.
+	ResourceName string
+	// Key is the key in the data of the Secret.
+	Key string
 }
 
 // Provider contains provider-specific information that are handed-over to the provider-specific
@@ -1538,6 +1632,34 @@ type NodeLocalDNS struct {
 	DisableForwardToUpstreamDNS *bool
 }
 
+// CustomHealthCheck is a health check for objects in the Shoot cluster expressed in CEL.
+type CustomHealthCheck struct {
+	// Name is the unique name of the health check.
+	Name string
+	// Resource selects the objects in the Shoot cluster the expression is evaluated for.
+	Resource CustomHealthCheckResource
+	// Expression is a CEL expression which must evaluate to true for each selected object in order to consider the
+	// check as passed. The object is bound to the `object` variable.
+	Expression string
+	// Message is reported if the check fails.
+	Message *string
+}
+
+// CustomHealthCheckResource selects the objects in the Shoot cluster a custom health check is evaluated for.
+type CustomHealthCheckResource struct {
+	// APIVersion is the API version of the objects.
+	APIVersion string
+	// Kind is the kind of the objects.
+	Kind string
+	// Namespace is the namespace of the objects. It must not be set for cluster-scoped objects.
+	Namespace *string
+	// Name is the name of the object. Exactly one of name or labelSelector must be set.
+	Name *string
+	// LabelSelector selects the objects by their labels. Exactly one of name or labelSelector must be set. It must not be
+	// empty if namespace is not set. At least one object must match the selector.
+	LabelSelector *metav1.LabelSelector
+}
+
 const (
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
@@ -1556,6 +1678,9 @@ const (
 const (
 	// ShootAPIServerAvailable is a constant for a condition type indicating that the Shoot cluster's API server is available.
 	ShootAPIServerAvailable ConditionType = "APIServerAvailable"
+	// ShootAPIServerExternallyReachable is a constant for a condition type indicating that the Shoot cluster's API
+	// server is reachable via its external endpoint from outside the seed.
+	ShootAPIServerExternallyReachable ConditionType = "APIServerExternallyReachable"
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the health of core control plane components.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
 	// ShootObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:onlyVerbs=get,list
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootSummary is a read-only summary of the health, the versions and the last operation of a Shoot. It is computed
+// by the gardener-apiserver from the Shoot on request and not persisted.
+type ShootSummary struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Status contains the summarized status of the Shoot.
+	Status ShootSummaryStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootSummaryList is a list of ShootSummary objects.
+type ShootSummaryList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+
+	// Items is the list of ShootSummaries.
+	Items []ShootSummary
+}
+
+// ShootSummaryStatus contains the summarized status of a Shoot.
+type ShootSummaryStatus struct {
+	// SeedName is the name of the seed cluster that runs the control plane of the Shoot.
+	SeedName *string
+	// ProviderType is the type of the infrastructure provider of the Shoot.
+	ProviderType string
+	// Purpose is the purpose of the Shoot.
+	Purpose *ShootPurpose
+	// KubernetesVersion is the Kubernetes version of the Shoot.
+	KubernetesVersion string
+	// GardenerVersion is the version of the Gardener which last acted on the Shoot.
+	GardenerVersion string
+	// Hibernated indicates whether the Shoot is currently hibernated.
+	Hibernated bool
+	// UnhealthyConditions contains the types of the conditions of the Shoot whose status is False or Unknown.
+	UnhealthyConditions []ConditionType
+	// LastOperation holds information about the last operation on the Shoot.
+	LastOperation *LastOperation
+	// OperationStartTime is the time when the current operation on the Shoot started. It is unset once the
+	// operation succeeded.
+	OperationStartTime *metav1.Time
+}
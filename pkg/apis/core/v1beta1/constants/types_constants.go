@@ -224,6 +224,9 @@ const (
 	// GardenerOperationRestore is a constant for the value of the operation annotation describing a restoration
 	// operation.
 	GardenerOperationRestore = "restore"
+	// GardenerOperationPlan is a constant for the value of the operation annotation describing a plan operation, i.e.,
+	// the changes which would be applied by the next reconciliation are computed but not applied.
+	GardenerOperationPlan = "plan"
 	// GardenerOperationWaitForState is a constant for the value of the operation annotation describing a wait
 	// operation.
 	GardenerOperationWaitForState = "wait-for-state"
@@ -298,6 +301,8 @@ const (
 	GardenRoleHelmPullSecret = "helm-pull-secret"
 	// GardenRoleObservability is the value of the GardenRole key indicating type 'observability'.
 	GardenRoleObservability = "observability"
+	// GardenRoleAvailability is the value of the GardenRole key indicating type 'availability'.
+	GardenRoleAvailability = "availability"
 
 	// ShootUID is an annotation key for the shoot namespace in the seed cluster,
 	// which value will be the value of `shoot.status.uid`
@@ -691,6 +696,13 @@ const (
 	AnnotationShootSkipCleanup = "shoot.gardener.cloud/skip-cleanup"
 	// AnnotationShootSkipReadiness is a key for an annotation on a Shoot resource that instructs the shoot flow to skip readiness steps during reconciliation.
 	AnnotationShootSkipReadiness = "shoot.gardener.cloud/skip-readiness"
+	// AnnotationShootInfrastructurePlan is a key for an annotation on a Shoot resource that instructs the shoot flow to
+	// only plan pending infrastructure changes instead of applying them. The changes are applied once the annotation
+	// is removed.
+	AnnotationShootInfrastructurePlan = "shoot.gardener.cloud/infrastructure-plan"
+	// AnnotationShootInfrastructurePlannedChanges is a key for an annotation on a Shoot resource that contains a
+	// summary of the infrastructure changes planned because of the AnnotationShootInfrastructurePlan annotation.
+	AnnotationShootInfrastructurePlannedChanges = "shoot.gardener.cloud/infrastructure-planned-changes"
 	// AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds is a key for an annotation on a Shoot resource that
 	// declares the grace period in seconds for finalizing the resources handled in the 'cleanup webhooks' step.
 	// Concretely, after the specified seconds, all the finalizers of the affected resources are forcefully removed.
@@ -713,6 +725,10 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootLoggingRetentionPeriod is a key for an annotation on a Shoot resource that declares the period
+	// after which the control plane logs of the shoot are deleted, e.g. 72h. It must be a multiple of 24h and can only
+	// shorten the retention period configured by the operator.
+	AnnotationShootLoggingRetentionPeriod = "shoot.gardener.cloud/logging-retention-period"
 
 	// AnnotationAuthenticationIssuer is the key for an annotation applied to a Shoot which specifies
 	// if the shoot's issuer is managed by Gardener.
@@ -778,6 +794,12 @@ const (
 	// ReferencedResourcesPrefix is the prefix used when copying referenced resources to the Shoot namespace in the Seed,
 	// to avoid naming collisions with resources managed by Gardener.
 	ReferencedResourcesPrefix = "ref-"
+	// ShootResourceNameAuditSink is the name of the resource reference in the Shoot's `.spec.resources` which refers to
+	// the ConfigMap containing the configuration of the sink for the audit events of the kube-apiserver.
+	ShootResourceNameAuditSink = "audit-sink"
+	// ShootResourceNameTelemetryExport is the name of the resource reference in the Shoot's `.spec.resources` which
+	// refers to the ConfigMap containing the configuration for exporting the control plane logs and traces via OTLP.
+	ShootResourceNameTelemetryExport = "telemetry-export"
 
 	// ClusterIdentity is a constant equal to the name and data key (that stores the identity) of the cluster-identity ConfigMap
 	ClusterIdentity = "cluster-identity"
@@ -267,10 +267,66 @@ func (m *Alerting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Alerting proto.InternalMessageInfo
 
+func (m *AlertingReceiver) Reset()      { *m = AlertingReceiver{} }
+func (*AlertingReceiver) ProtoMessage() {}
+func (*AlertingReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{8}
+}
+func (m *AlertingReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertingReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AlertingReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertingReceiver.Merge(m, src)
+}
+func (m *AlertingReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertingReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertingReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertingReceiver proto.InternalMessageInfo
+
+func (m *AlertingRules) Reset()      { *m = AlertingRules{} }
+func (*AlertingRules) ProtoMessage() {}
+func (*AlertingRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{9}
+}
+func (m *AlertingRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertingRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AlertingRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertingRules.Merge(m, src)
+}
+func (m *AlertingRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertingRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertingRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertingRules proto.InternalMessageInfo
+
 func (m *AuditConfig) Reset()      { *m = AuditConfig{} }
 func (*AuditConfig) ProtoMessage() {}
 func (*AuditConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{8}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
 }
 func (m *AuditConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +354,7 @@ var xxx_messageInfo_AuditConfig proto.InternalMessageInfo
 func (m *AuditPolicy) Reset()      { *m = AuditPolicy{} }
 func (*AuditPolicy) ProtoMessage() {}
 func (*AuditPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{9}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
 }
 func (m *AuditPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +382,7 @@ var xxx_messageInfo_AuditPolicy proto.InternalMessageInfo
 func (m *AuthorizerKubeconfigReference) Reset()      { *m = AuthorizerKubeconfigReference{} }
 func (*AuthorizerKubeconfigReference) ProtoMessage() {}
 func (*AuthorizerKubeconfigReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
 }
 func (m *AuthorizerKubeconfigReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +410,7 @@ var xxx_messageInfo_AuthorizerKubeconfigReference proto.InternalMessageInfo
 func (m *AvailabilityZone) Reset()      { *m = AvailabilityZone{} }
 func (*AvailabilityZone) ProtoMessage() {}
 func (*AvailabilityZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
 }
 func (m *AvailabilityZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +438,7 @@ var xxx_messageInfo_AvailabilityZone proto.InternalMessageInfo
 func (m *Backup) Reset()      { *m = Backup{} }
 func (*Backup) ProtoMessage() {}
 func (*Backup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
 }
 func (m *Backup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +466,7 @@ var xxx_messageInfo_Backup proto.InternalMessageInfo
 func (m *BackupBucket) Reset()      { *m = BackupBucket{} }
 func (*BackupBucket) ProtoMessage() {}
 func (*BackupBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
 }
 func (m *BackupBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +494,7 @@ var xxx_messageInfo_BackupBucket proto.InternalMessageInfo
 func (m *BackupBucketList) Reset()      { *m = BackupBucketList{} }
 func (*BackupBucketList) ProtoMessage() {}
 func (*BackupBucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
 }
 func (m *BackupBucketList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,7 +522,7 @@ var xxx_messageInfo_BackupBucketList proto.InternalMessageInfo
 func (m *BackupBucketProvider) Reset()      { *m = BackupBucketProvider{} }
 func (*BackupBucketProvider) ProtoMessage() {}
 func (*BackupBucketProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
 }
 func (m *BackupBucketProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +550,7 @@ var xxx_messageInfo_BackupBucketProvider proto.InternalMessageInfo
 func (m *BackupBucketSpec) Reset()      { *m = BackupBucketSpec{} }
 func (*BackupBucketSpec) ProtoMessage() {}
 func (*BackupBucketSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
 }
 func (m *BackupBucketSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,7 +578,7 @@ var xxx_messageInfo_BackupBucketSpec proto.InternalMessageInfo
 func (m *BackupBucketStatus) Reset()      { *m = BackupBucketStatus{} }
 func (*BackupBucketStatus) ProtoMessage() {}
 func (*BackupBucketStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
 }
 func (m *BackupBucketStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +606,7 @@ var xxx_messageInfo_BackupBucketStatus proto.InternalMessageInfo
 func (m *BackupEntry) Reset()      { *m = BackupEntry{} }
 func (*BackupEntry) ProtoMessage() {}
 func (*BackupEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
 }
 func (m *BackupEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +634,7 @@ var xxx_messageInfo_BackupEntry proto.InternalMessageInfo
 func (m *BackupEntryList) Reset()      { *m = BackupEntryList{} }
 func (*BackupEntryList) ProtoMessage() {}
 func (*BackupEntryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
 }
 func (m *BackupEntryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,7 +662,7 @@ var xxx_messageInfo_BackupEntryList proto.InternalMessageInfo
 func (m *BackupEntrySpec) Reset()      { *m = BackupEntrySpec{} }
 func (*BackupEntrySpec) ProtoMessage() {}
 func (*BackupEntrySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
 }
 func (m *BackupEntrySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,7 +690,7 @@ var xxx_messageInfo_BackupEntrySpec proto.InternalMessageInfo
 func (m *BackupEntryStatus) Reset()      { *m = BackupEntryStatus{} }
 func (*BackupEntryStatus) ProtoMessage() {}
 func (*BackupEntryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
 }
 func (m *BackupEntryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,7 +718,7 @@ var xxx_messageInfo_BackupEntryStatus proto.InternalMessageInfo
 func (m *Bastion) Reset()      { *m = Bastion{} }
 func (*Bastion) ProtoMessage() {}
 func (*Bastion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
 }
 func (m *Bastion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +746,7 @@ var xxx_messageInfo_Bastion proto.InternalMessageInfo
 func (m *BastionMachineImage) Reset()      { *m = BastionMachineImage{} }
 func (*BastionMachineImage) ProtoMessage() {}
 func (*BastionMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
 }
 func (m *BastionMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +774,7 @@ var xxx_messageInfo_BastionMachineImage proto.InternalMessageInfo
 func (m *BastionMachineType) Reset()      { *m = BastionMachineType{} }
 func (*BastionMachineType) ProtoMessage() {}
 func (*BastionMachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
 }
 func (m *BastionMachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +802,7 @@ var xxx_messageInfo_BastionMachineType proto.InternalMessageInfo
 func (m *CARotation) Reset()      { *m = CARotation{} }
 func (*CARotation) ProtoMessage() {}
 func (*CARotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
 }
 func (m *CARotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +830,7 @@ var xxx_messageInfo_CARotation proto.InternalMessageInfo
 func (m *CRI) Reset()      { *m = CRI{} }
 func (*CRI) ProtoMessage() {}
 func (*CRI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
 }
 func (m *CRI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +858,7 @@ var xxx_messageInfo_CRI proto.InternalMessageInfo
 func (m *CapabilityDefinition) Reset()      { *m = CapabilityDefinition{} }
 func (*CapabilityDefinition) ProtoMessage() {}
 func (*CapabilityDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
 }
 func (m *CapabilityDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +886,7 @@ var xxx_messageInfo_CapabilityDefinition proto.InternalMessageInfo
 func (m *CapabilitySet) Reset()      { *m = CapabilitySet{} }
 func (*CapabilitySet) ProtoMessage() {}
 func (*CapabilitySet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
 }
 func (m *CapabilitySet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +914,7 @@ var xxx_messageInfo_CapabilitySet proto.InternalMessageInfo
 func (m *CapabilityValues) Reset()      { *m = CapabilityValues{} }
 func (*CapabilityValues) ProtoMessage() {}
 func (*CapabilityValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
 }
 func (m *CapabilityValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -886,7 +942,7 @@ var xxx_messageInfo_CapabilityValues proto.InternalMessageInfo
 func (m *CloudProfile) Reset()      { *m = CloudProfile{} }
 func (*CloudProfile) ProtoMessage() {}
 func (*CloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
 }
 func (m *CloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,7 +970,7 @@ var xxx_messageInfo_CloudProfile proto.InternalMessageInfo
 func (m *CloudProfileList) Reset()      { *m = CloudProfileList{} }
 func (*CloudProfileList) ProtoMessage() {}
 func (*CloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
 }
 func (m *CloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -942,7 +998,7 @@ var xxx_messageInfo_CloudProfileList proto.InternalMessageInfo
 func (m *CloudProfileReference) Reset()      { *m = CloudProfileReference{} }
 func (*CloudProfileReference) ProtoMessage() {}
 func (*CloudProfileReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
 }
 func (m *CloudProfileReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1026,7 @@ var xxx_messageInfo_CloudProfileReference proto.InternalMessageInfo
 func (m *CloudProfileSpec) Reset()      { *m = CloudProfileSpec{} }
 func (*CloudProfileSpec) ProtoMessage() {}
 func (*CloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
 }
 func (m *CloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1054,7 @@ var xxx_messageInfo_CloudProfileSpec proto.InternalMessageInfo
 func (m *ClusterAutoscaler) Reset()      { *m = ClusterAutoscaler{} }
 func (*ClusterAutoscaler) ProtoMessage() {}
 func (*ClusterAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
 }
 func (m *ClusterAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1082,7 @@ var xxx_messageInfo_ClusterAutoscaler proto.InternalMessageInfo
 func (m *ClusterAutoscalerOptions) Reset()      { *m = ClusterAutoscalerOptions{} }
 func (*ClusterAutoscalerOptions) ProtoMessage() {}
 func (*ClusterAutoscalerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
 }
 func (m *ClusterAutoscalerOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1110,7 @@ var xxx_messageInfo_ClusterAutoscalerOptions proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1138,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerRuntime) Reset()      { *m = ContainerRuntime{} }
 func (*ContainerRuntime) ProtoMessage() {}
 func (*ContainerRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
 }
 func (m *ContainerRuntime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1166,7 @@ var xxx_messageInfo_ContainerRuntime proto.InternalMessageInfo
 func (m *ControlPlane) Reset()      { *m = ControlPlane{} }
 func (*ControlPlane) ProtoMessage() {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1194,7 @@ var xxx_messageInfo_ControlPlane proto.InternalMessageInfo
 func (m *ControlPlaneAutoscaling) Reset()      { *m = ControlPlaneAutoscaling{} }
 func (*ControlPlaneAutoscaling) ProtoMessage() {}
 func (*ControlPlaneAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
 }
 func (m *ControlPlaneAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1222,7 @@ var xxx_messageInfo_ControlPlaneAutoscaling proto.InternalMessageInfo
 func (m *ControllerDeployment) Reset()      { *m = ControllerDeployment{} }
 func (*ControllerDeployment) ProtoMessage() {}
 func (*ControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
 }
 func (m *ControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1250,7 @@ var xxx_messageInfo_ControllerDeployment proto.InternalMessageInfo
 func (m *ControllerDeploymentList) Reset()      { *m = ControllerDeploymentList{} }
 func (*ControllerDeploymentList) ProtoMessage() {}
 func (*ControllerDeploymentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
 }
 func (m *ControllerDeploymentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1278,7 @@ var xxx_messageInfo_ControllerDeploymentList proto.InternalMessageInfo
 func (m *ControllerInstallation) Reset()      { *m = ControllerInstallation{} }
 func (*ControllerInstallation) ProtoMessage() {}
 func (*ControllerInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *ControllerInstallation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1306,7 @@ var xxx_messageInfo_ControllerInstallation proto.InternalMessageInfo
 func (m *ControllerInstallationList) Reset()      { *m = ControllerInstallationList{} }
 func (*ControllerInstallationList) ProtoMessage() {}
 func (*ControllerInstallationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *ControllerInstallationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1334,7 @@ var xxx_messageInfo_ControllerInstallationList proto.InternalMessageInfo
 func (m *ControllerInstallationSpec) Reset()      { *m = ControllerInstallationSpec{} }
 func (*ControllerInstallationSpec) ProtoMessage() {}
 func (*ControllerInstallationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
 }
 func (m *ControllerInstallationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1306,7 +1362,7 @@ var xxx_messageInfo_ControllerInstallationSpec proto.InternalMessageInfo
 func (m *ControllerInstallationStatus) Reset()      { *m = ControllerInstallationStatus{} }
 func (*ControllerInstallationStatus) ProtoMessage() {}
 func (*ControllerInstallationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
 }
 func (m *ControllerInstallationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1334,7 +1390,7 @@ var xxx_messageInfo_ControllerInstallationStatus proto.InternalMessageInfo
 func (m *ControllerRegistration) Reset()      { *m = ControllerRegistration{} }
 func (*ControllerRegistration) ProtoMessage() {}
 func (*ControllerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *ControllerRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1418,7 @@ var xxx_messageInfo_ControllerRegistration proto.InternalMessageInfo
 func (m *ControllerRegistrationDeployment) Reset()      { *m = ControllerRegistrationDeployment{} }
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 func (*ControllerRegistrationDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *ControllerRegistrationDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1390,7 +1446,7 @@ var xxx_messageInfo_ControllerRegistrationDeployment proto.InternalMessageInfo
 func (m *ControllerRegistrationList) Reset()      { *m = ControllerRegistrationList{} }
 func (*ControllerRegistrationList) ProtoMessage() {}
 func (*ControllerRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *ControllerRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1418,7 +1474,7 @@ var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1446,7 +1502,7 @@ var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1474,7 +1530,7 @@ var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1558,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,7 +1586,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1558,7 +1614,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1583,10 +1639,66 @@ func (m *CoreDNSRewriting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 
+func (m *CustomHealthCheck) Reset()      { *m = CustomHealthCheck{} }
+func (*CustomHealthCheck) ProtoMessage() {}
+func (*CustomHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+}
+func (m *CustomHealthCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomHealthCheck.Merge(m, src)
+}
+func (m *CustomHealthCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomHealthCheck proto.InternalMessageInfo
+
+func (m *CustomHealthCheckResource) Reset()      { *m = CustomHealthCheckResource{} }
+func (*CustomHealthCheckResource) ProtoMessage() {}
+func (*CustomHealthCheckResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+}
+func (m *CustomHealthCheckResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomHealthCheckResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CustomHealthCheckResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomHealthCheckResource.Merge(m, src)
+}
+func (m *CustomHealthCheckResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomHealthCheckResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomHealthCheckResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomHealthCheckResource proto.InternalMessageInfo
+
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,7 +1726,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1642,7 +1754,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1670,7 +1782,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1698,7 +1810,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1726,7 +1838,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *DualApprovalForDeletion) Reset()      { *m = DualApprovalForDeletion{} }
 func (*DualApprovalForDeletion) ProtoMessage() {}
 func (*DualApprovalForDeletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *DualApprovalForDeletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1754,7 +1866,7 @@ var xxx_messageInfo_DualApprovalForDeletion proto.InternalMessageInfo
 func (m *ETCD) Reset()      { *m = ETCD{} }
 func (*ETCD) ProtoMessage() {}
 func (*ETCD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *ETCD) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1782,7 +1894,7 @@ var xxx_messageInfo_ETCD proto.InternalMessageInfo
 func (m *ETCDConfig) Reset()      { *m = ETCDConfig{} }
 func (*ETCDConfig) ProtoMessage() {}
 func (*ETCDConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *ETCDConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1810,7 +1922,7 @@ var xxx_messageInfo_ETCDConfig proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1838,7 +1950,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *EncryptionConfig) Reset()      { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage() {}
 func (*EncryptionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *EncryptionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1866,7 +1978,7 @@ var xxx_messageInfo_EncryptionConfig proto.InternalMessageInfo
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1894,7 +2006,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1922,7 +2034,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1950,7 +2062,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1978,7 +2090,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2006,7 +2118,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2034,7 +2146,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2062,7 +2174,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2090,7 +2202,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2118,7 +2230,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *HelmControllerDeployment) Reset()      { *m = HelmControllerDeployment{} }
 func (*HelmControllerDeployment) ProtoMessage() {}
 func (*HelmControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *HelmControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2146,7 +2258,7 @@ var xxx_messageInfo_HelmControllerDeployment proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2174,7 +2286,7 @@ var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2202,7 +2314,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2230,7 +2342,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2258,7 +2370,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *InPlaceUpdates) Reset()      { *m = InPlaceUpdates{} }
 func (*InPlaceUpdates) ProtoMessage() {}
 func (*InPlaceUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *InPlaceUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2286,7 +2398,7 @@ var xxx_messageInfo_InPlaceUpdates proto.InternalMessageInfo
 func (m *InPlaceUpdatesStatus) Reset()      { *m = InPlaceUpdatesStatus{} }
 func (*InPlaceUpdatesStatus) ProtoMessage() {}
 func (*InPlaceUpdatesStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *InPlaceUpdatesStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2314,7 +2426,7 @@ var xxx_messageInfo_InPlaceUpdatesStatus proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2342,7 +2454,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2370,7 +2482,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2398,7 +2510,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2426,7 +2538,7 @@ var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2454,7 +2566,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2482,7 +2594,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2510,7 +2622,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2538,7 +2650,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2566,7 +2678,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2594,7 +2706,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2622,7 +2734,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2650,7 +2762,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2678,7 +2790,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2706,7 +2818,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2734,7 +2846,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2762,7 +2874,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2790,7 +2902,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2818,7 +2930,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2846,7 +2958,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2874,7 +2986,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Limits) Reset()      { *m = Limits{} }
 func (*Limits) ProtoMessage() {}
 func (*Limits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
 }
 func (m *Limits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2902,7 +3014,7 @@ var xxx_messageInfo_Limits proto.InternalMessageInfo
 func (m *LoadBalancerServicesProxyProtocol) Reset()      { *m = LoadBalancerServicesProxyProtocol{} }
 func (*LoadBalancerServicesProxyProtocol) ProtoMessage() {}
 func (*LoadBalancerServicesProxyProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
 }
 func (m *LoadBalancerServicesProxyProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2930,7 +3042,7 @@ var xxx_messageInfo_LoadBalancerServicesProxyProtocol proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2958,7 +3070,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2986,7 +3098,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3014,7 +3126,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3042,7 +3154,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3070,7 +3182,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3098,7 +3210,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3126,7 +3238,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3154,7 +3266,7 @@ var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3182,7 +3294,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3210,7 +3322,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3235,10 +3347,66 @@ func (m *Monitoring) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 
+func (m *MonitoringRemoteWrite) Reset()      { *m = MonitoringRemoteWrite{} }
+func (*MonitoringRemoteWrite) ProtoMessage() {}
+func (*MonitoringRemoteWrite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+}
+func (m *MonitoringRemoteWrite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonitoringRemoteWrite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MonitoringRemoteWrite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitoringRemoteWrite.Merge(m, src)
+}
+func (m *MonitoringRemoteWrite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonitoringRemoteWrite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitoringRemoteWrite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitoringRemoteWrite proto.InternalMessageInfo
+
+func (m *MonitoringRemoteWriteBasicAuth) Reset()      { *m = MonitoringRemoteWriteBasicAuth{} }
+func (*MonitoringRemoteWriteBasicAuth) ProtoMessage() {}
+func (*MonitoringRemoteWriteBasicAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+}
+func (m *MonitoringRemoteWriteBasicAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonitoringRemoteWriteBasicAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MonitoringRemoteWriteBasicAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitoringRemoteWriteBasicAuth.Merge(m, src)
+}
+func (m *MonitoringRemoteWriteBasicAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonitoringRemoteWriteBasicAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitoringRemoteWriteBasicAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitoringRemoteWriteBasicAuth proto.InternalMessageInfo
+
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3266,7 +3434,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *NamespacedCloudProfile) Reset()      { *m = NamespacedCloudProfile{} }
 func (*NamespacedCloudProfile) ProtoMessage() {}
 func (*NamespacedCloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *NamespacedCloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3294,7 +3462,7 @@ var xxx_messageInfo_NamespacedCloudProfile proto.InternalMessageInfo
 func (m *NamespacedCloudProfileList) Reset()      { *m = NamespacedCloudProfileList{} }
 func (*NamespacedCloudProfileList) ProtoMessage() {}
 func (*NamespacedCloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *NamespacedCloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3322,7 +3490,7 @@ var xxx_messageInfo_NamespacedCloudProfileList proto.InternalMessageInfo
 func (m *NamespacedCloudProfileSpec) Reset()      { *m = NamespacedCloudProfileSpec{} }
 func (*NamespacedCloudProfileSpec) ProtoMessage() {}
 func (*NamespacedCloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *NamespacedCloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3350,7 +3518,7 @@ var xxx_messageInfo_NamespacedCloudProfileSpec proto.InternalMessageInfo
 func (m *NamespacedCloudProfileStatus) Reset()      { *m = NamespacedCloudProfileStatus{} }
 func (*NamespacedCloudProfileStatus) ProtoMessage() {}
 func (*NamespacedCloudProfileStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *NamespacedCloudProfileStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3378,7 +3546,7 @@ var xxx_messageInfo_NamespacedCloudProfileStatus proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3406,7 +3574,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NetworkingStatus) Reset()      { *m = NetworkingStatus{} }
 func (*NetworkingStatus) ProtoMessage() {}
 func (*NetworkingStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *NetworkingStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3434,7 +3602,7 @@ var xxx_messageInfo_NetworkingStatus proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3462,7 +3630,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3490,7 +3658,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OCIRepository) Reset()      { *m = OCIRepository{} }
 func (*OCIRepository) ProtoMessage() {}
 func (*OCIRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *OCIRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3518,7 +3686,7 @@ var xxx_messageInfo_OCIRepository proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3546,7 +3714,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3574,7 +3742,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3599,10 +3767,38 @@ func (m *OpenIDConnectClientAuthentication) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 
+func (m *PagerDutyAlertingReceiver) Reset()      { *m = PagerDutyAlertingReceiver{} }
+func (*PagerDutyAlertingReceiver) ProtoMessage() {}
+func (*PagerDutyAlertingReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+}
+func (m *PagerDutyAlertingReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PagerDutyAlertingReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PagerDutyAlertingReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PagerDutyAlertingReceiver.Merge(m, src)
+}
+func (m *PagerDutyAlertingReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *PagerDutyAlertingReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_PagerDutyAlertingReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PagerDutyAlertingReceiver proto.InternalMessageInfo
+
 func (m *PendingWorkerUpdates) Reset()      { *m = PendingWorkerUpdates{} }
 func (*PendingWorkerUpdates) ProtoMessage() {}
 func (*PendingWorkerUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *PendingWorkerUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3630,7 +3826,7 @@ var xxx_messageInfo_PendingWorkerUpdates proto.InternalMessageInfo
 func (m *PendingWorkersRollout) Reset()      { *m = PendingWorkersRollout{} }
 func (*PendingWorkersRollout) ProtoMessage() {}
 func (*PendingWorkersRollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *PendingWorkersRollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3658,7 +3854,7 @@ var xxx_messageInfo_PendingWorkersRollout proto.InternalMessageInfo
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3686,7 +3882,7 @@ var xxx_messageInfo_Project proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3714,7 +3910,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3742,7 +3938,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3770,7 +3966,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3798,7 +3994,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3826,7 +4022,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3854,7 +4050,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3882,7 +4078,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3910,7 +4106,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3938,7 +4134,7 @@ var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4162,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3991,10 +4187,38 @@ func (m *ResourceData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 
+func (m *ResourceSecretKeyReference) Reset()      { *m = ResourceSecretKeyReference{} }
+func (*ResourceSecretKeyReference) ProtoMessage() {}
+func (*ResourceSecretKeyReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+}
+func (m *ResourceSecretKeyReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceSecretKeyReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceSecretKeyReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceSecretKeyReference.Merge(m, src)
+}
+func (m *ResourceSecretKeyReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceSecretKeyReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceSecretKeyReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceSecretKeyReference proto.InternalMessageInfo
+
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4022,7 +4246,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,7 +4274,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4302,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4106,7 +4330,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4134,7 +4358,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4162,7 +4386,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4190,7 +4414,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4218,7 +4442,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedDNSProviderConfig) Reset()      { *m = SeedDNSProviderConfig{} }
 func (*SeedDNSProviderConfig) ProtoMessage() {}
 func (*SeedDNSProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *SeedDNSProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4246,7 +4470,7 @@ var xxx_messageInfo_SeedDNSProviderConfig proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4274,7 +4498,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4302,7 +4526,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4330,7 +4554,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4358,7 +4582,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4386,7 +4610,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4414,7 +4638,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4442,7 +4666,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4472,7 +4696,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4500,7 +4724,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4528,7 +4752,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4556,7 +4780,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4584,7 +4808,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4612,7 +4836,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4640,7 +4864,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4668,7 +4892,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4696,7 +4920,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4724,7 +4948,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4752,7 +4976,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4780,7 +5004,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4808,7 +5032,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4836,7 +5060,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4864,7 +5088,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4892,7 +5116,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4920,7 +5144,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4948,7 +5172,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4976,7 +5200,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5004,7 +5228,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5032,7 +5256,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5060,7 +5284,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5088,7 +5312,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5116,7 +5340,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5144,7 +5368,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5172,7 +5396,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5200,7 +5424,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5228,7 +5452,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5256,7 +5480,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5281,10 +5505,94 @@ func (m *ShootStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 
+func (m *ShootSummary) Reset()      { *m = ShootSummary{} }
+func (*ShootSummary) ProtoMessage() {}
+func (*ShootSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
+}
+func (m *ShootSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootSummary.Merge(m, src)
+}
+func (m *ShootSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootSummary proto.InternalMessageInfo
+
+func (m *ShootSummaryList) Reset()      { *m = ShootSummaryList{} }
+func (*ShootSummaryList) ProtoMessage() {}
+func (*ShootSummaryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
+}
+func (m *ShootSummaryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootSummaryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootSummaryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootSummaryList.Merge(m, src)
+}
+func (m *ShootSummaryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootSummaryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootSummaryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootSummaryList proto.InternalMessageInfo
+
+func (m *ShootSummaryStatus) Reset()      { *m = ShootSummaryStatus{} }
+func (*ShootSummaryStatus) ProtoMessage() {}
+func (*ShootSummaryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
+}
+func (m *ShootSummaryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootSummaryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootSummaryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootSummaryStatus.Merge(m, src)
+}
+func (m *ShootSummaryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootSummaryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootSummaryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootSummaryStatus proto.InternalMessageInfo
+
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5309,10 +5617,38 @@ func (m *ShootTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 
+func (m *SlackAlertingReceiver) Reset()      { *m = SlackAlertingReceiver{} }
+func (*SlackAlertingReceiver) ProtoMessage() {}
+func (*SlackAlertingReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
+}
+func (m *SlackAlertingReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlackAlertingReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SlackAlertingReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlackAlertingReceiver.Merge(m, src)
+}
+func (m *SlackAlertingReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlackAlertingReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlackAlertingReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlackAlertingReceiver proto.InternalMessageInfo
+
 func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
 func (*StructuredAuthentication) ProtoMessage() {}
 func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
 }
 func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5340,7 +5676,7 @@ var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
 func (m *StructuredAuthorization) Reset()      { *m = StructuredAuthorization{} }
 func (*StructuredAuthorization) ProtoMessage() {}
 func (*StructuredAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
 }
 func (m *StructuredAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5368,7 +5704,7 @@ var xxx_messageInfo_StructuredAuthorization proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5396,7 +5732,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5424,7 +5760,7 @@ var xxx_messageInfo_Toleration proto.InternalMessageInfo
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{204}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5452,7 +5788,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{205}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5480,7 +5816,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{206}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5508,7 +5844,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{207}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5533,10 +5869,38 @@ func (m *WatchCacheSizes) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 
+func (m *WebhookAlertingReceiver) Reset()      { *m = WebhookAlertingReceiver{} }
+func (*WebhookAlertingReceiver) ProtoMessage() {}
+func (*WebhookAlertingReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{208}
+}
+func (m *WebhookAlertingReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookAlertingReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookAlertingReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookAlertingReceiver.Merge(m, src)
+}
+func (m *WebhookAlertingReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookAlertingReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookAlertingReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookAlertingReceiver proto.InternalMessageInfo
+
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{209}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5564,7 +5928,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerControlPlane) Reset()      { *m = WorkerControlPlane{} }
 func (*WorkerControlPlane) ProtoMessage() {}
 func (*WorkerControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{210}
 }
 func (m *WorkerControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5592,7 +5956,7 @@ var xxx_messageInfo_WorkerControlPlane proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{211}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5620,7 +5984,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{212}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5648,7 +6012,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{213}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5683,6 +6047,8 @@ func init() {
 	proto.RegisterType((*Addons)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Addons")
 	proto.RegisterType((*AdmissionPlugin)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AdmissionPlugin")
 	proto.RegisterType((*Alerting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Alerting")
+	proto.RegisterType((*AlertingReceiver)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AlertingReceiver")
+	proto.RegisterType((*AlertingRules)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AlertingRules")
 	proto.RegisterType((*AuditConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditConfig")
 	proto.RegisterType((*AuditPolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditPolicy")
 	proto.RegisterType((*AuthorizerKubeconfigReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthorizerKubeconfigReference")
@@ -5732,6 +6098,8 @@ func init() {
 	proto.RegisterType((*CoreDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNS")
 	proto.RegisterType((*CoreDNSAutoscaling)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNSAutoscaling")
 	proto.RegisterType((*CoreDNSRewriting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CoreDNSRewriting")
+	proto.RegisterType((*CustomHealthCheck)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CustomHealthCheck")
+	proto.RegisterType((*CustomHealthCheckResource)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CustomHealthCheckResource")
 	proto.RegisterType((*DNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.DNS")
 	proto.RegisterType((*DNSIncludeExclude)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.DNSIncludeExclude")
 	proto.RegisterType((*DNSProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.DNSProvider")
@@ -5797,6 +6165,8 @@ func init() {
 	proto.RegisterType((*MaintenanceTimeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow")
 	proto.RegisterType((*MemorySwapConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MemorySwapConfiguration")
 	proto.RegisterType((*Monitoring)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Monitoring")
+	proto.RegisterType((*MonitoringRemoteWrite)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MonitoringRemoteWrite")
+	proto.RegisterType((*MonitoringRemoteWriteBasicAuth)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MonitoringRemoteWriteBasicAuth")
 	proto.RegisterType((*NamedResourceReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamedResourceReference")
 	proto.RegisterType((*NamespacedCloudProfile)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamespacedCloudProfile")
 	proto.RegisterType((*NamespacedCloudProfileList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NamespacedCloudProfileList")
@@ -5813,6 +6183,7 @@ func init() {
 	proto.RegisterType((*ObservabilityRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ObservabilityRotation")
 	proto.RegisterType((*OpenIDConnectClientAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication.ExtraConfigEntry")
+	proto.RegisterType((*PagerDutyAlertingReceiver)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PagerDutyAlertingReceiver")
 	proto.RegisterType((*PendingWorkerUpdates)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PendingWorkerUpdates")
 	proto.RegisterType((*PendingWorkersRollout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PendingWorkersRollout")
 	proto.RegisterType((*Project)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Project")
@@ -5829,6 +6200,7 @@ func init() {
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
+	proto.RegisterType((*ResourceSecretKeyReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceSecretKeyReference")
 	proto.RegisterType((*ResourceWatchCacheSize)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceWatchCacheSize")
 	proto.RegisterType((*SSHAccess)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SSHAccess")
 	proto.RegisterType((*SecretBinding)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBinding")
@@ -5883,7 +6255,11 @@ func init() {
 	proto.RegisterType((*ShootStateList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStateList")
 	proto.RegisterType((*ShootStateSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStateSpec")
 	proto.RegisterType((*ShootStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStatus")
+	proto.RegisterType((*ShootSummary)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootSummary")
+	proto.RegisterType((*ShootSummaryList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootSummaryList")
+	proto.RegisterType((*ShootSummaryStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootSummaryStatus")
 	proto.RegisterType((*ShootTemplate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootTemplate")
+	proto.RegisterType((*SlackAlertingReceiver)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SlackAlertingReceiver")
 	proto.RegisterType((*StructuredAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthentication")
 	proto.RegisterType((*StructuredAuthorization)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthorization")
 	proto.RegisterType((*SystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SystemComponents")
@@ -5894,6 +6270,7 @@ func init() {
 	proto.RegisterType((*Volume)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Volume")
 	proto.RegisterType((*VolumeType)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VolumeType")
 	proto.RegisterType((*WatchCacheSizes)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WatchCacheSizes")
+	proto.RegisterType((*WebhookAlertingReceiver)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WebhookAlertingReceiver")
 	proto.RegisterType((*Worker)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.LabelsEntry")
@@ -5909,918 +6286,971 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 14565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x25, 0xd9,
-	0x59, 0x98, 0xfb, 0xea, 0xfd, 0xe9, 0x31, 0xd2, 0x19, 0xcd, 0x8c, 0x56, 0x3b, 0x33, 0x77, 0xdc,
-	0xbb, 0x36, 0xb3, 0xac, 0xad, 0xc1, 0xe3, 0xc7, 0xda, 0x6b, 0xd6, 0x6b, 0xe9, 0x4a, 0x33, 0x73,
-	0x3d, 0x92, 0x46, 0x3e, 0x57, 0xda, 0x5d, 0xaf, 0x61, 0x4d, 0xab, 0xef, 0xd1, 0x55, 0xef, 0xf4,
-	0xed, 0xbe, 0xdb, 0xdd, 0x57, 0x23, 0xed, 0xda, 0xf8, 0x01, 0x26, 0xb6, 0x31, 0x2e, 0x20, 0x80,
-	0x63, 0x1b, 0x2a, 0x6b, 0x28, 0x4c, 0x12, 0x12, 0x93, 0x22, 0x45, 0x52, 0x40, 0xe5, 0x45, 0x15,
-	0xc1, 0x50, 0x40, 0x51, 0x18, 0x12, 0x93, 0x80, 0xc0, 0x8a, 0x03, 0xa9, 0x4a, 0x48, 0x52, 0xa1,
-	0x2a, 0x54, 0x4d, 0x28, 0x92, 0x3a, 0x8f, 0xee, 0x3e, 0xa7, 0x1f, 0x57, 0x57, 0x7d, 0x25, 0xd9,
-	0x0b, 0xfe, 0x25, 0xdd, 0xf3, 0x9d, 0xf3, 0x7d, 0xe7, 0xd5, 0xdf, 0xf9, 0xce, 0x77, 0xbe, 0x07,
-	0x2c, 0x34, 0xac, 0x60, 0xbb, 0xbd, 0x39, 0x67, 0xba, 0xcd, 0x6b, 0x0d, 0xc3, 0xab, 0x13, 0x87,
-	0x78, 0xf1, 0x3f, 0xad, 0xbb, 0x8d, 0x6b, 0x46, 0xcb, 0xf2, 0xaf, 0x99, 0xae, 0x47, 0xae, 0xed,
-	0xbc, 0x61, 0x93, 0x04, 0xc6, 0x1b, 0xae, 0x35, 0x28, 0xcc, 0x08, 0x48, 0x7d, 0xae, 0xe5, 0xb9,
-	0x81, 0x8b, 0xae, 0xc7, 0x38, 0xe6, 0xc2, 0xa6, 0xf1, 0x3f, 0xad, 0xbb, 0x8d, 0x39, 0x8a, 0x63,
-	0x8e, 0xe2, 0x98, 0x13, 0x38, 0x66, 0x5f, 0x2f, 0xd3, 0x75, 0x1b, 0xee, 0x35, 0x86, 0x6a, 0xb3,
-	0xbd, 0xc5, 0x7e, 0xb1, 0x1f, 0xec, 0x3f, 0x4e, 0x62, 0xf6, 0x91, 0xbb, 0x6f, 0xf5, 0xe7, 0x2c,
-	0x97, 0x76, 0xe6, 0x9a, 0xd1, 0x0e, 0x5c, 0xdf, 0x34, 0x6c, 0xcb, 0x69, 0x5c, 0xdb, 0x49, 0xf5,
-	0x66, 0x56, 0x97, 0xaa, 0x8a, 0x6e, 0x77, 0xac, 0xe3, 0x6d, 0x1a, 0x66, 0x56, 0x9d, 0x5b, 0x71,
-	0x1d, 0xb2, 0x1b, 0x10, 0xc7, 0xb7, 0x5c, 0xc7, 0x7f, 0x3d, 0x1d, 0x09, 0xf1, 0x76, 0xe4, 0xb9,
-	0x51, 0x2a, 0x64, 0x61, 0x7a, 0x53, 0x8c, 0xa9, 0x69, 0x98, 0xdb, 0x96, 0x43, 0xbc, 0xbd, 0xb0,
-	0xf9, 0x35, 0x8f, 0xf8, 0x6e, 0xdb, 0x33, 0xc9, 0x91, 0x5a, 0xf9, 0xd7, 0x9a, 0x24, 0x30, 0xb2,
-	0x68, 0x5d, 0xcb, 0x6b, 0xe5, 0xb5, 0x9d, 0xc0, 0x6a, 0xa6, 0xc9, 0xbc, 0xe5, 0xb0, 0x06, 0xbe,
-	0xb9, 0x4d, 0x9a, 0x46, 0xaa, 0xdd, 0x1b, 0xf3, 0xda, 0xb5, 0x03, 0xcb, 0xbe, 0x66, 0x39, 0x81,
-	0x1f, 0x78, 0xc9, 0x46, 0xfa, 0x27, 0x34, 0x98, 0x9c, 0x5f, 0xab, 0xd6, 0xd8, 0x0c, 0x2e, 0xbb,
-	0x8d, 0x86, 0xe5, 0x34, 0xd0, 0xa3, 0x30, 0xb2, 0x43, 0xbc, 0x4d, 0xd7, 0xb7, 0x82, 0xbd, 0x19,
-	0xed, 0x8a, 0x76, 0x75, 0x60, 0x61, 0xfc, 0x60, 0xbf, 0x3c, 0xf2, 0x54, 0x58, 0x88, 0x63, 0x38,
-	0xaa, 0xc2, 0xd9, 0xed, 0x20, 0x68, 0xcd, 0x9b, 0x26, 0xf1, 0xfd, 0xa8, 0xc6, 0x4c, 0x89, 0x35,
-	0xbb, 0x70, 0xb0, 0x5f, 0x3e, 0x7b, 0x6b, 0x7d, 0x7d, 0x2d, 0x01, 0xc6, 0x59, 0x6d, 0xf4, 0x9f,
-	0xd7, 0x60, 0x2a, 0xea, 0x0c, 0x26, 0x2f, 0xb4, 0x89, 0x1f, 0xf8, 0x08, 0xc3, 0xf9, 0xa6, 0xb1,
-	0xbb, 0xea, 0x3a, 0x2b, 0xed, 0xc0, 0x08, 0x2c, 0xa7, 0x51, 0x75, 0xb6, 0x6c, 0xab, 0xb1, 0x1d,
-	0x88, 0xae, 0xcd, 0x1e, 0xec, 0x97, 0xcf, 0xaf, 0x64, 0xd6, 0xc0, 0x39, 0x2d, 0x69, 0xa7, 0x9b,
-	0xc6, 0x6e, 0x0a, 0xa1, 0xd4, 0xe9, 0x95, 0x34, 0x18, 0x67, 0xb5, 0xd1, 0xdf, 0x0c, 0x53, 0x7c,
-	0x1c, 0x98, 0xf8, 0x81, 0x67, 0x99, 0x81, 0xe5, 0x3a, 0xe8, 0x0a, 0xf4, 0x3b, 0x46, 0x93, 0xb0,
-	0x1e, 0x8e, 0x2c, 0x8c, 0x7d, 0x69, 0xbf, 0xfc, 0xaa, 0x83, 0xfd, 0x72, 0xff, 0xaa, 0xd1, 0x24,
-	0x98, 0x41, 0xf4, 0xff, 0x53, 0x82, 0x8b, 0xa9, 0x76, 0x4f, 0x5b, 0xc1, 0xf6, 0x9d, 0x16, 0xfd,
-	0xcf, 0x47, 0x9f, 0xd2, 0x60, 0xca, 0x48, 0x56, 0x60, 0x08, 0x47, 0xaf, 0x2f, 0xcd, 0x1d, 0xfd,
-	0x03, 0x9f, 0x4b, 0x51, 0x5b, 0x78, 0x40, 0xf4, 0x2b, 0x3d, 0x00, 0x9c, 0x26, 0x8d, 0x3e, 0xa6,
-	0xc1, 0x90, 0xcb, 0x3b, 0x37, 0x53, 0xba, 0xd2, 0x77, 0x75, 0xf4, 0xfa, 0x77, 0x1e, 0x4b, 0x37,
-	0xa4, 0x41, 0xcf, 0x89, 0xbf, 0x4b, 0x4e, 0xe0, 0xed, 0x2d, 0x9c, 0x11, 0xdd, 0x1b, 0x12, 0xa5,
-	0x38, 0x24, 0x3f, 0xfb, 0x38, 0x8c, 0xc9, 0x35, 0xd1, 0x24, 0xf4, 0xdd, 0x25, 0x7c, 0xab, 0x8e,
-	0x60, 0xfa, 0x2f, 0x9a, 0x86, 0x81, 0x1d, 0xc3, 0x6e, 0x13, 0xb6, 0xa4, 0x23, 0x98, 0xff, 0x78,
-	0xbc, 0xf4, 0x56, 0x4d, 0xbf, 0x0e, 0x03, 0xf3, 0xf5, 0xba, 0xeb, 0xa0, 0x47, 0x60, 0x88, 0x38,
-	0xc6, 0xa6, 0x4d, 0xea, 0xac, 0xe1, 0x70, 0x4c, 0x6f, 0x89, 0x17, 0xe3, 0x10, 0xae, 0xff, 0x68,
-	0x09, 0x06, 0x59, 0x23, 0x1f, 0xfd, 0xb0, 0x06, 0x67, 0xef, 0xb6, 0x37, 0x89, 0xe7, 0x90, 0x80,
-	0xf8, 0x8b, 0x86, 0xbf, 0xbd, 0xe9, 0x1a, 0x5e, 0x5d, 0x2c, 0xcc, 0xcd, 0x22, 0x33, 0x72, 0x3b,
-	0x8d, 0x8e, 0xef, 0xc1, 0x0c, 0x00, 0xce, 0x22, 0x8e, 0x76, 0x60, 0xcc, 0x69, 0x58, 0xce, 0x6e,
-	0xd5, 0x69, 0x78, 0xc4, 0xf7, 0xd9, 0xa0, 0x47, 0xaf, 0xbf, 0xb3, 0x48, 0x67, 0x56, 0x25, 0x3c,
-	0x0b, 0x93, 0x07, 0xfb, 0xe5, 0x31, 0xb9, 0x04, 0x2b, 0x74, 0xf4, 0xbf, 0xd6, 0xe0, 0xcc, 0x7c,
-	0xbd, 0x69, 0xf9, 0x94, 0xd3, 0xae, 0xd9, 0xed, 0x86, 0xd5, 0xc5, 0xd6, 0x47, 0xef, 0x86, 0x41,
-	0xd3, 0x75, 0xb6, 0xac, 0x86, 0xe8, 0xe7, 0xeb, 0xe7, 0x38, 0xe7, 0x9a, 0x93, 0x39, 0x17, 0xeb,
-	0x9e, 0xe0, 0x78, 0x73, 0xd8, 0xb8, 0xb7, 0x14, 0x32, 0xf4, 0x05, 0x38, 0xd8, 0x2f, 0x0f, 0x56,
-	0x18, 0x02, 0x2c, 0x10, 0xa1, 0xab, 0x30, 0x5c, 0xb7, 0x7c, 0xbe, 0x98, 0x7d, 0x6c, 0x31, 0xc7,
-	0x0e, 0xf6, 0xcb, 0xc3, 0x8b, 0xa2, 0x0c, 0x47, 0x50, 0xb4, 0x0c, 0xd3, 0x74, 0x06, 0x79, 0xbb,
-	0x1a, 0x31, 0x3d, 0x12, 0xd0, 0xae, 0xcd, 0xf4, 0xb3, 0xee, 0xce, 0x1c, 0xec, 0x97, 0xa7, 0x6f,
-	0x67, 0xc0, 0x71, 0x66, 0x2b, 0xfd, 0x06, 0x0c, 0xcf, 0xdb, 0xc4, 0xa3, 0x0c, 0x01, 0x3d, 0x0e,
-	0x13, 0xa4, 0x69, 0x58, 0x36, 0x26, 0x26, 0xb1, 0x76, 0x88, 0xe7, 0xcf, 0x68, 0x57, 0xfa, 0xae,
-	0x8e, 0x2c, 0xa0, 0x83, 0xfd, 0xf2, 0xc4, 0x92, 0x02, 0xc1, 0x89, 0x9a, 0xfa, 0x87, 0x35, 0x18,
-	0x9d, 0x6f, 0xd7, 0xad, 0x80, 0x8f, 0x0b, 0x79, 0x30, 0x6a, 0xd0, 0x9f, 0x6b, 0xae, 0x6d, 0x99,
-	0x7b, 0x62, 0x73, 0x3d, 0x59, 0xe8, 0x73, 0x8b, 0xd1, 0x2c, 0x9c, 0x39, 0xd8, 0x2f, 0x8f, 0x4a,
-	0x05, 0x58, 0x26, 0xa2, 0x6f, 0x83, 0x0c, 0x43, 0xef, 0x81, 0x31, 0x3e, 0xdc, 0x15, 0xa3, 0x85,
-	0xc9, 0x96, 0xe8, 0xc3, 0x43, 0xd2, 0x5a, 0x85, 0x84, 0xe6, 0xee, 0x6c, 0x3e, 0x4f, 0xcc, 0x00,
-	0x93, 0x2d, 0xe2, 0x11, 0xc7, 0x24, 0x7c, 0xdb, 0x54, 0xa4, 0xc6, 0x58, 0x41, 0xa5, 0xff, 0x5d,
-	0x0d, 0x2e, 0xcd, 0xb7, 0x83, 0x6d, 0xd7, 0xb3, 0x5e, 0x24, 0x5e, 0x3c, 0xdd, 0x11, 0x06, 0xf4,
-	0x0e, 0x98, 0x30, 0xa2, 0x0a, 0xab, 0xf1, 0x76, 0x3a, 0x2f, 0xb6, 0xd3, 0xc4, 0xbc, 0x02, 0xc5,
-	0x89, 0xda, 0xe8, 0x3a, 0x80, 0x1f, 0xaf, 0x2d, 0xe3, 0x01, 0x0b, 0x48, 0xb4, 0x05, 0x69, 0x55,
-	0xa5, 0x5a, 0xfa, 0x1f, 0xd3, 0xa3, 0x70, 0xc7, 0xb0, 0x6c, 0x63, 0xd3, 0xb2, 0xad, 0x60, 0xef,
-	0x59, 0xd7, 0x21, 0x5d, 0xec, 0xe6, 0x0d, 0xb8, 0xd0, 0x76, 0x0c, 0xde, 0xce, 0x26, 0x2b, 0x7c,
-	0xff, 0xae, 0xef, 0xb5, 0x08, 0xe7, 0x92, 0x23, 0x0b, 0x0f, 0x1e, 0xec, 0x97, 0x2f, 0x6c, 0x64,
-	0x57, 0xc1, 0x79, 0x6d, 0xe9, 0xa9, 0x27, 0x81, 0x9e, 0x72, 0xed, 0x76, 0x53, 0x60, 0xed, 0x63,
-	0x58, 0xd9, 0xa9, 0xb7, 0x91, 0x59, 0x03, 0xe7, 0xb4, 0xd4, 0xbf, 0x50, 0x82, 0xc1, 0x05, 0xc3,
-	0xbc, 0xdb, 0x6e, 0xa1, 0xd7, 0xc1, 0x70, 0xcb, 0x73, 0x77, 0xac, 0x3a, 0xf1, 0xc4, 0xd8, 0x26,
-	0xc5, 0xd8, 0x86, 0xd7, 0x44, 0x39, 0x8e, 0x6a, 0x20, 0x0b, 0x26, 0xc2, 0xff, 0x2b, 0x3d, 0x7c,
-	0xb9, 0xec, 0x4b, 0x58, 0x53, 0x10, 0xe1, 0x04, 0x62, 0xa4, 0xc3, 0xa0, 0x47, 0x1a, 0xf4, 0xa8,
-	0xeb, 0x63, 0xdd, 0x62, 0x5f, 0x3b, 0x66, 0x25, 0x58, 0x40, 0xd0, 0xfb, 0x60, 0xc2, 0xf4, 0x48,
-	0x9d, 0x38, 0x81, 0x65, 0xd8, 0x3e, 0xdd, 0x9c, 0x03, 0xdd, 0x6f, 0x4e, 0xd6, 0x89, 0x8a, 0xd2,
-	0x1c, 0x27, 0xd0, 0xe9, 0x5f, 0x2a, 0xc1, 0x18, 0x9f, 0xa8, 0x85, 0xb6, 0x79, 0x97, 0x04, 0xe8,
-	0xbb, 0x60, 0x98, 0xca, 0x77, 0x75, 0x23, 0x30, 0xc4, 0x87, 0xf0, 0x6d, 0xb9, 0x43, 0x67, 0xdf,
-	0x20, 0xad, 0x1d, 0x53, 0x5f, 0x21, 0x81, 0x11, 0xef, 0xbf, 0xb8, 0x0c, 0x47, 0x58, 0xd1, 0x16,
-	0xf4, 0xfb, 0x2d, 0x62, 0x8a, 0x89, 0x5d, 0x2c, 0xf2, 0xa9, 0xcb, 0x3d, 0xae, 0xb5, 0x88, 0x19,
-	0x6f, 0x57, 0xfa, 0x0b, 0x33, 0xfc, 0xc8, 0x81, 0x41, 0x3f, 0x30, 0x82, 0xb6, 0xcf, 0xe6, 0x77,
-	0xf4, 0xfa, 0x8d, 0x9e, 0x29, 0x31, 0x6c, 0x0b, 0x13, 0x82, 0xd6, 0x20, 0xff, 0x8d, 0x05, 0x15,
-	0xfd, 0x3f, 0x68, 0x30, 0x29, 0x57, 0x5f, 0xb6, 0xfc, 0x00, 0x7d, 0x47, 0x6a, 0x3a, 0xe7, 0xba,
-	0x9b, 0x4e, 0xda, 0x9a, 0x4d, 0x66, 0xb4, 0x5b, 0xc3, 0x12, 0x69, 0x2a, 0x09, 0x0c, 0x58, 0x01,
-	0x69, 0x86, 0x52, 0xca, 0x3b, 0x7b, 0x1d, 0xe1, 0xc2, 0xb8, 0x20, 0x36, 0x50, 0xa5, 0x68, 0x31,
-	0xc7, 0xae, 0x7f, 0x17, 0x4c, 0xcb, 0xb5, 0xc2, 0x7d, 0x4d, 0x59, 0x46, 0xb0, 0xd7, 0x4a, 0xb1,
-	0x0c, 0xfa, 0x09, 0x62, 0x06, 0x41, 0xaf, 0x8d, 0xf6, 0x38, 0xe7, 0x4c, 0xd1, 0xdc, 0xa9, 0xfb,
-	0x5c, 0xff, 0x50, 0x9f, 0x3a, 0x77, 0x74, 0x19, 0xd1, 0x4e, 0xe2, 0xcb, 0x1d, 0xbd, 0x7e, 0xab,
-	0xd7, 0x01, 0x86, 0x5d, 0xff, 0x46, 0xe1, 0x01, 0x57, 0x61, 0xd8, 0x27, 0xa4, 0x2e, 0x9d, 0xcb,
-	0xec, 0x34, 0xaf, 0x89, 0x32, 0x1c, 0x41, 0x4f, 0x9e, 0x13, 0xbc, 0xdc, 0x0f, 0x28, 0xbd, 0xdb,
-	0xe5, 0xc9, 0xe0, 0x25, 0x62, 0x29, 0x7a, 0x99, 0x0c, 0xf1, 0xe1, 0x24, 0x10, 0xa3, 0x17, 0x61,
-	0xdc, 0x36, 0xfc, 0xe0, 0x4e, 0x8b, 0xde, 0xdb, 0xc2, 0x3d, 0x33, 0x7a, 0x7d, 0xbe, 0xc8, 0xa2,
-	0x2f, 0xcb, 0x88, 0x16, 0xa6, 0x0e, 0xf6, 0xcb, 0xe3, 0x4a, 0x11, 0x56, 0x49, 0xa1, 0xe7, 0x61,
-	0x84, 0x16, 0x2c, 0x79, 0x9e, 0xeb, 0x09, 0x7e, 0xf1, 0x44, 0x51, 0xba, 0x0c, 0x09, 0xbf, 0x47,
-	0x46, 0x3f, 0x71, 0x8c, 0x1e, 0xbd, 0x0b, 0x90, 0xbb, 0xc9, 0x6e, 0xf2, 0xf5, 0x9b, 0xfc, 0x92,
-	0x4a, 0x07, 0x4b, 0x97, 0xbf, 0x6f, 0x61, 0x56, 0xec, 0x4b, 0x74, 0x27, 0x55, 0x03, 0x67, 0xb4,
-	0x42, 0x77, 0x01, 0x45, 0x17, 0x5d, 0x7e, 0xda, 0x1f, 0xb2, 0x35, 0xa2, 0x4a, 0x62, 0x6b, 0x9c,
-	0xa7, 0xc4, 0x6e, 0xa6, 0x50, 0xe0, 0x0c, 0xb4, 0xfa, 0xaf, 0x96, 0x60, 0x94, 0x6f, 0x11, 0x7e,
-	0x19, 0x39, 0xf9, 0xb3, 0x82, 0x28, 0x67, 0x45, 0xa5, 0xf8, 0xe7, 0xcf, 0x3a, 0x9c, 0x7b, 0x54,
-	0x34, 0x13, 0x47, 0xc5, 0x52, 0xaf, 0x84, 0x3a, 0x9f, 0x14, 0xbf, 0xaf, 0xc1, 0x19, 0xa9, 0xf6,
-	0x29, 0x1c, 0x14, 0x75, 0xf5, 0xa0, 0x78, 0xb2, 0xc7, 0xf1, 0xe5, 0x9c, 0x13, 0xae, 0x32, 0x2c,
-	0xc6, 0xc3, 0xaf, 0x03, 0x6c, 0x32, 0x76, 0x22, 0x89, 0xb6, 0xd1, 0x92, 0x2f, 0x44, 0x10, 0x2c,
-	0xd5, 0x52, 0x98, 0x62, 0xa9, 0x13, 0x53, 0xd4, 0xff, 0x4b, 0x1f, 0x4c, 0xa5, 0xa6, 0x3d, 0xcd,
-	0x47, 0xb4, 0xaf, 0x13, 0x1f, 0x29, 0x7d, 0x3d, 0xf8, 0x48, 0x5f, 0x21, 0x3e, 0xd2, 0xfd, 0x41,
-	0xe4, 0x01, 0x6a, 0x5a, 0x0d, 0xde, 0xac, 0x16, 0x18, 0x5e, 0xb0, 0x6e, 0x35, 0x89, 0xe0, 0x38,
-	0xdf, 0xda, 0xdd, 0x96, 0xa5, 0x2d, 0x38, 0xe3, 0x59, 0x49, 0x61, 0xc2, 0x19, 0xd8, 0xf5, 0xef,
-	0x29, 0xc1, 0xd0, 0x82, 0xe1, 0xb3, 0x9e, 0x7e, 0x00, 0xc6, 0x04, 0xea, 0x6a, 0xd3, 0x68, 0x90,
-	0x5e, 0xd4, 0x11, 0x02, 0xe5, 0x8a, 0x84, 0x8e, 0xdf, 0xe8, 0xe4, 0x12, 0xac, 0x90, 0x43, 0x7b,
-	0x30, 0xda, 0x8c, 0x6f, 0x2f, 0x62, 0x89, 0x6f, 0xf4, 0x4e, 0x9d, 0x62, 0xe3, 0xd7, 0x56, 0xa9,
-	0x00, 0xcb, 0xb4, 0xf4, 0xe7, 0xe0, 0x6c, 0x46, 0x8f, 0xbb, 0xb8, 0xb8, 0xbd, 0x06, 0x86, 0xe8,
-	0xdd, 0x3b, 0x16, 0xc3, 0x46, 0x0f, 0xf6, 0xcb, 0x43, 0x4f, 0xf1, 0x22, 0x1c, 0xc2, 0xf4, 0xb7,
-	0x50, 0x01, 0x20, 0xd9, 0xa7, 0x6e, 0x14, 0x7c, 0x03, 0x00, 0x95, 0x79, 0xec, 0x06, 0x7c, 0x2b,
-	0x3d, 0x09, 0x03, 0xad, 0x6d, 0xc3, 0x0f, 0x5b, 0x3c, 0x12, 0xb2, 0x8a, 0x35, 0x5a, 0x78, 0x7f,
-	0xbf, 0x3c, 0x23, 0x0b, 0x22, 0xa2, 0x11, 0x83, 0x61, 0xde, 0x8e, 0xee, 0x30, 0xba, 0xc9, 0x2b,
-	0x6e, 0xb3, 0x65, 0x13, 0x0a, 0x65, 0x3b, 0xac, 0x54, 0x6c, 0x87, 0x2d, 0xa7, 0x30, 0xe1, 0x0c,
-	0xec, 0x21, 0xcd, 0xaa, 0x63, 0x05, 0x96, 0x11, 0xd1, 0xec, 0x2b, 0x4e, 0x53, 0xc5, 0x84, 0x33,
-	0xb0, 0xa3, 0x4f, 0x68, 0x30, 0xab, 0x16, 0xdf, 0xb0, 0x1c, 0xcb, 0xdf, 0x26, 0x75, 0x46, 0xbc,
-	0xff, 0xc8, 0xc4, 0x2f, 0x1f, 0xec, 0x97, 0x67, 0x97, 0x73, 0x31, 0xe2, 0x0e, 0xd4, 0xd0, 0x0f,
-	0x68, 0xf0, 0x60, 0x62, 0x5e, 0x3c, 0xab, 0xd1, 0x20, 0x9e, 0xe8, 0xcd, 0xd1, 0x3f, 0xf0, 0xf2,
-	0xc1, 0x7e, 0xf9, 0xc1, 0xe5, 0x7c, 0x94, 0xb8, 0x13, 0x3d, 0xf4, 0x79, 0x0d, 0xce, 0xb7, 0x88,
-	0x53, 0xb7, 0x9c, 0xc6, 0xd3, 0xae, 0x77, 0x97, 0x78, 0x3e, 0x76, 0x6d, 0xdb, 0x6d, 0x07, 0xfe,
-	0xcc, 0x20, 0x3b, 0xc3, 0xaa, 0x45, 0xbe, 0xb9, 0xb5, 0x2c, 0x8c, 0x0b, 0x97, 0xc5, 0x16, 0x3d,
-	0x9f, 0x09, 0xf6, 0x71, 0x4e, 0x47, 0xf4, 0x5f, 0xd1, 0xa0, 0xaf, 0x82, 0xab, 0xe8, 0x51, 0xe5,
-	0x13, 0xb9, 0x20, 0x7f, 0x22, 0xf7, 0xf7, 0xcb, 0x43, 0x15, 0x5c, 0x95, 0x3e, 0xc6, 0x1f, 0xd0,
-	0x60, 0xca, 0x74, 0x9d, 0xc0, 0xa0, 0x73, 0x87, 0xb9, 0xac, 0x1c, 0x9e, 0xcb, 0x85, 0x2e, 0xc3,
-	0x95, 0x04, 0xb2, 0x58, 0xd9, 0x9d, 0x84, 0xf8, 0x38, 0x4d, 0x59, 0xff, 0x49, 0x0d, 0xa6, 0x2b,
-	0x46, 0x4b, 0xa8, 0x82, 0x16, 0xc9, 0x96, 0x45, 0x37, 0x48, 0x37, 0x9a, 0x7d, 0xb4, 0x0d, 0x83,
-	0x4c, 0xdb, 0xec, 0xf7, 0x72, 0x97, 0x8f, 0x69, 0x3f, 0xc5, 0x70, 0x71, 0x3d, 0x08, 0xff, 0x1f,
-	0x0b, 0xfc, 0xfa, 0xbf, 0x28, 0xc1, 0x78, 0x5c, 0xb1, 0x46, 0x02, 0xf4, 0x13, 0x1a, 0x8c, 0x99,
-	0x61, 0x89, 0x45, 0xb8, 0x0a, 0x72, 0xf4, 0x7a, 0xad, 0xb7, 0x2e, 0xd4, 0x48, 0x10, 0xff, 0xb2,
-	0x88, 0x50, 0xcf, 0x3f, 0x2c, 0xc6, 0x3e, 0x26, 0x83, 0xee, 0x27, 0x7e, 0x63, 0xa5, 0x3b, 0xb3,
-	0x1f, 0xd5, 0x60, 0x2a, 0x85, 0x29, 0x43, 0x7d, 0xff, 0xac, 0xac, 0xbe, 0x3f, 0xa6, 0x29, 0x94,
-	0x1f, 0x01, 0x9e, 0x80, 0xc9, 0x24, 0x18, 0x95, 0x43, 0x69, 0x90, 0xab, 0x6d, 0x47, 0x92, 0x82,
-	0xdc, 0xe3, 0xc3, 0x7f, 0xef, 0xe5, 0xf2, 0xab, 0x3e, 0xf4, 0x87, 0x57, 0x5e, 0xa5, 0x7f, 0x45,
-	0x83, 0xb1, 0x8a, 0xed, 0xb6, 0xeb, 0x6b, 0x9e, 0xbb, 0x65, 0xd9, 0xe4, 0x95, 0xa1, 0x1f, 0x92,
-	0x7b, 0x9c, 0x27, 0xf4, 0x33, 0x7d, 0x8d, 0x5c, 0xf1, 0x15, 0xa2, 0xaf, 0x91, 0xbb, 0x9c, 0x23,
-	0x87, 0xbf, 0x17, 0xce, 0xc9, 0xb5, 0x62, 0x65, 0xf3, 0x15, 0xe8, 0xbf, 0x6b, 0x39, 0xf5, 0xe4,
-	0x27, 0x7d, 0xdb, 0x72, 0xea, 0x98, 0x41, 0xa2, 0x8f, 0xbe, 0x94, 0x7b, 0xda, 0xef, 0x8f, 0xa8,
-	0xd3, 0xc6, 0xc4, 0xfc, 0xab, 0x30, 0x6c, 0x1a, 0x0b, 0x6d, 0xa7, 0x6e, 0x47, 0xfc, 0x82, 0x4e,
-	0x41, 0x65, 0x9e, 0x97, 0xe1, 0x08, 0x8a, 0x5e, 0x04, 0x88, 0xdf, 0x75, 0x7a, 0x11, 0x9f, 0xe2,
-	0x27, 0xa3, 0x1a, 0x09, 0x02, 0xcb, 0x69, 0xf8, 0xf1, 0xbe, 0x8a, 0x61, 0x58, 0xa2, 0x86, 0x3e,
-	0x00, 0xe3, 0xb2, 0x2c, 0xc7, 0x15, 0xcc, 0x05, 0x97, 0x41, 0x11, 0x1a, 0xcf, 0x09, 0xc2, 0xe3,
-	0x72, 0xa9, 0x8f, 0x55, 0x6a, 0x68, 0x2f, 0x92, 0x5c, 0xb9, 0x7a, 0xbb, 0xbf, 0xf8, 0x5d, 0x4c,
-	0x16, 0x1a, 0xa7, 0x43, 0xee, 0xa4, 0xa8, 0xdb, 0x15, 0x52, 0x19, 0x2a, 0xad, 0x81, 0x93, 0x52,
-	0x69, 0x11, 0x18, 0xe2, 0x4a, 0xbd, 0xf0, 0xa0, 0x7e, 0xbc, 0xc8, 0x00, 0xb9, 0x7e, 0x30, 0x7e,
-	0xa8, 0xe4, 0xbf, 0x7d, 0x1c, 0xe2, 0x46, 0x3b, 0x30, 0x46, 0xaf, 0x24, 0x35, 0x62, 0x13, 0x33,
-	0x70, 0xbd, 0x99, 0xa1, 0xe2, 0x0f, 0x81, 0x35, 0x09, 0x0f, 0x97, 0xff, 0xe5, 0x12, 0xac, 0xd0,
-	0x89, 0x74, 0x9e, 0xc3, 0xb9, 0x3a, 0xcf, 0x36, 0x8c, 0xee, 0x48, 0x8f, 0x18, 0x23, 0x6c, 0x12,
-	0xde, 0x51, 0xa4, 0x63, 0xf1, 0x8b, 0xc6, 0xc2, 0x59, 0x41, 0x68, 0x54, 0x7e, 0xfd, 0x90, 0xe9,
-	0xa0, 0x4d, 0x18, 0xda, 0xe4, 0xd2, 0xfb, 0x0c, 0xb0, 0xb9, 0x78, 0x7b, 0x0f, 0x97, 0x12, 0x7e,
-	0x43, 0x10, 0x3f, 0x70, 0x88, 0x18, 0x3d, 0x07, 0x83, 0xb6, 0xd5, 0xb4, 0x02, 0x7f, 0x66, 0x94,
-	0x91, 0x28, 0xb4, 0xb4, 0xcb, 0x0c, 0x03, 0x3f, 0xe6, 0xf9, 0xff, 0x58, 0x60, 0x45, 0x1f, 0x49,
-	0x1e, 0xea, 0x63, 0x6c, 0xf2, 0x6e, 0xf5, 0x76, 0x28, 0xc6, 0x32, 0x4d, 0xfc, 0xad, 0xe4, 0x9f,
-	0xdc, 0xfa, 0xbf, 0x1e, 0x87, 0xa9, 0x8a, 0xdd, 0xf6, 0x03, 0xe2, 0xcd, 0x0b, 0x73, 0x1f, 0xe2,
-	0xd1, 0xae, 0x9d, 0x67, 0xff, 0x2e, 0xba, 0xf7, 0x9c, 0x45, 0x62, 0x1b, 0x7b, 0xf3, 0x5b, 0xb4,
-	0x46, 0xbd, 0x7e, 0xb4, 0x73, 0x62, 0xb1, 0x2d, 0x74, 0x09, 0xec, 0x59, 0xab, 0x96, 0x89, 0x11,
-	0xe7, 0x50, 0x42, 0xdf, 0xaf, 0xc1, 0x03, 0x19, 0xa0, 0x45, 0x62, 0x93, 0x20, 0x94, 0x20, 0x8e,
-	0xda, 0x8f, 0x4b, 0x07, 0xfb, 0xe5, 0x07, 0x6a, 0x79, 0x48, 0x71, 0x3e, 0x3d, 0xf4, 0x29, 0x0d,
-	0x66, 0x33, 0xa0, 0x37, 0x0c, 0xcb, 0x6e, 0x7b, 0xe1, 0xe5, 0xe9, 0xa8, 0xdd, 0x61, 0x77, 0x98,
-	0x5a, 0x2e, 0x56, 0xdc, 0x81, 0x22, 0xfa, 0x20, 0x9c, 0x8b, 0xa0, 0x1b, 0x8e, 0x43, 0x48, 0x5d,
-	0xb9, 0x4a, 0x1d, 0xb5, 0x2b, 0x0f, 0x1c, 0xec, 0x97, 0xcf, 0xd5, 0xb2, 0x10, 0xe2, 0x6c, 0x3a,
-	0xa8, 0x01, 0x97, 0x62, 0x40, 0x60, 0xd9, 0xd6, 0x8b, 0xfc, 0xb6, 0xb7, 0xed, 0x11, 0x7f, 0xdb,
-	0xb5, 0xeb, 0x8c, 0xeb, 0x6a, 0x0b, 0xaf, 0x3e, 0xd8, 0x2f, 0x5f, 0xaa, 0x75, 0xaa, 0x88, 0x3b,
-	0xe3, 0x41, 0x75, 0x18, 0xf3, 0x4d, 0xc3, 0xa9, 0x3a, 0x01, 0xf1, 0x76, 0x0c, 0x7b, 0x66, 0xb0,
-	0xd0, 0x00, 0x39, 0xaf, 0x93, 0xf0, 0x60, 0x05, 0x2b, 0x7a, 0x2b, 0x0c, 0x93, 0xdd, 0x96, 0xe1,
-	0xd4, 0x09, 0xe7, 0xaf, 0x23, 0x0b, 0x17, 0xe9, 0xa9, 0xbe, 0x24, 0xca, 0xa8, 0x04, 0x1c, 0xfe,
-	0xbf, 0xe2, 0xd6, 0x09, 0x8e, 0x6a, 0xa3, 0xf7, 0xc3, 0x34, 0xb3, 0x47, 0xaa, 0x13, 0x76, 0x5a,
-	0xf8, 0xe1, 0x85, 0x7a, 0xb8, 0x50, 0x3f, 0x99, 0xad, 0xc2, 0x4a, 0x06, 0x3e, 0x9c, 0x49, 0x85,
-	0x2e, 0x43, 0xd3, 0xd8, 0xbd, 0xe9, 0x19, 0x26, 0xd9, 0x6a, 0xdb, 0xeb, 0xc4, 0x6b, 0x5a, 0x0e,
-	0xd7, 0x28, 0x11, 0xd3, 0x75, 0xea, 0x94, 0x27, 0x6b, 0x57, 0x07, 0xf8, 0x32, 0xac, 0x74, 0xaa,
-	0x88, 0x3b, 0xe3, 0x41, 0x6f, 0x82, 0x31, 0xab, 0xe1, 0xb8, 0x1e, 0x59, 0x37, 0x2c, 0x27, 0xf0,
-	0x67, 0x80, 0xc9, 0xd3, 0x6c, 0x5a, 0xab, 0x52, 0x39, 0x56, 0x6a, 0xa1, 0x1d, 0x40, 0x0e, 0xb9,
-	0xb7, 0xe6, 0xd6, 0xd9, 0x16, 0xd8, 0x68, 0xb1, 0x8d, 0x2c, 0x38, 0xea, 0x51, 0xa7, 0x86, 0xe9,
-	0x1b, 0x56, 0x53, 0xd8, 0x70, 0x06, 0x05, 0x74, 0x03, 0x50, 0xd3, 0xd8, 0x5d, 0x6a, 0xb6, 0x82,
-	0xbd, 0x85, 0xb6, 0x7d, 0x57, 0x70, 0x8d, 0x31, 0x36, 0x17, 0x5c, 0x1b, 0x97, 0x82, 0xe2, 0x8c,
-	0x16, 0xc8, 0x80, 0x07, 0xf9, 0x78, 0x16, 0x0d, 0xd2, 0x74, 0x1d, 0x9f, 0x04, 0xbe, 0xb4, 0x49,
-	0x67, 0xc6, 0x99, 0x55, 0x0a, 0xbb, 0xfd, 0x57, 0xf3, 0xab, 0xe1, 0x4e, 0x38, 0x54, 0xbb, 0xbc,
-	0x89, 0x43, 0xec, 0xf2, 0x1e, 0x83, 0x71, 0x3f, 0x30, 0xbc, 0xa0, 0xdd, 0x12, 0xcb, 0x70, 0x86,
-	0x2d, 0x03, 0x53, 0xd6, 0xd6, 0x64, 0x00, 0x56, 0xeb, 0xd1, 0xe5, 0xe3, 0x1a, 0x79, 0xd1, 0x6e,
-	0x32, 0x5e, 0xbe, 0x9a, 0x54, 0x8e, 0x95, 0x5a, 0x68, 0x03, 0x2e, 0x34, 0x8d, 0xdd, 0xe8, 0xf3,
-	0x5d, 0x33, 0x3c, 0xc3, 0xb6, 0x89, 0x6d, 0xf9, 0xcd, 0x99, 0x29, 0xd6, 0x53, 0x66, 0x06, 0xb1,
-	0x92, 0x5d, 0x05, 0xe7, 0xb5, 0x15, 0x86, 0x7a, 0x8b, 0x9e, 0x61, 0x29, 0x28, 0x91, 0x62, 0xa8,
-	0x97, 0x04, 0xe3, 0xac, 0x36, 0xfa, 0xff, 0xee, 0x87, 0x99, 0xd4, 0x09, 0x16, 0x5a, 0xdb, 0x1d,
-	0xca, 0xa3, 0xb4, 0x63, 0xe2, 0x51, 0x2d, 0xb8, 0x12, 0x55, 0xb8, 0xd9, 0x6a, 0x67, 0xd2, 0x2a,
-	0x31, 0x5a, 0x0f, 0x1f, 0xec, 0x97, 0xaf, 0xd4, 0x0e, 0xa9, 0x8b, 0x0f, 0xc5, 0x96, 0xcf, 0xff,
-	0xfb, 0x4e, 0x89, 0xff, 0xbf, 0x1f, 0xa6, 0x25, 0x80, 0x47, 0x8c, 0xfa, 0x5e, 0x0f, 0xe7, 0x0f,
-	0x63, 0x7b, 0xb5, 0x0c, 0x7c, 0x38, 0x93, 0x4a, 0x2e, 0xd3, 0x1d, 0x38, 0x0d, 0xa6, 0xab, 0xef,
-	0xf7, 0xc1, 0x48, 0xc5, 0x75, 0xea, 0x5c, 0x79, 0xf4, 0x06, 0xc5, 0x34, 0xe0, 0x92, 0x2c, 0x26,
-	0xdf, 0xdf, 0x2f, 0x8f, 0x47, 0x15, 0x25, 0xb9, 0xf9, 0x6d, 0xd1, 0x23, 0x1c, 0xbf, 0x7c, 0xbe,
-	0x5a, 0x7d, 0x3d, 0xbb, 0xbf, 0x5f, 0x3e, 0x13, 0x35, 0x53, 0x1f, 0xd4, 0x28, 0x47, 0xb5, 0x0d,
-	0x3f, 0x58, 0xf7, 0x0c, 0xc7, 0xb7, 0x7a, 0xd0, 0xde, 0x46, 0xaf, 0x26, 0xcb, 0x29, 0x6c, 0x38,
-	0x83, 0x02, 0x7a, 0x1e, 0x26, 0x68, 0xe9, 0x46, 0xab, 0x6e, 0x04, 0xa4, 0xa0, 0xd2, 0x36, 0x32,
-	0xf4, 0x5a, 0x56, 0x30, 0xe1, 0x04, 0x66, 0x6e, 0x4a, 0x61, 0xf8, 0xae, 0xc3, 0xd6, 0x53, 0x31,
-	0xa5, 0xa0, 0xa5, 0x58, 0x40, 0xd1, 0x23, 0x30, 0xd4, 0x24, 0xbe, 0x6f, 0x34, 0x08, 0x93, 0x0a,
-	0x46, 0xe2, 0x3b, 0xd4, 0x0a, 0x2f, 0xc6, 0x21, 0x1c, 0xbd, 0x0e, 0x06, 0x4c, 0xb7, 0x4e, 0xfc,
-	0x99, 0x21, 0xc6, 0xf8, 0xe8, 0x19, 0x30, 0x50, 0xa1, 0x05, 0xf7, 0xf7, 0xcb, 0x23, 0xec, 0x8d,
-	0x89, 0xfe, 0xc2, 0xbc, 0x92, 0xfe, 0xf7, 0x35, 0x98, 0x4c, 0x6a, 0x14, 0xbb, 0x30, 0x01, 0x39,
-	0x3d, 0x6b, 0x0a, 0xfd, 0xd3, 0x1a, 0x8c, 0xd1, 0x1e, 0x7a, 0xae, 0xbd, 0x66, 0x1b, 0x0e, 0x41,
-	0xdf, 0xa7, 0xc1, 0xe4, 0xb6, 0xd5, 0xd8, 0x96, 0x8d, 0xdd, 0x84, 0xb8, 0x5e, 0x48, 0xaf, 0x74,
-	0x2b, 0x81, 0x6b, 0x61, 0xfa, 0x60, 0xbf, 0x3c, 0x99, 0x2c, 0xc5, 0x29, 0x9a, 0xfa, 0x9f, 0x94,
-	0xe0, 0x82, 0xdc, 0xb3, 0xf9, 0xd8, 0x8f, 0x00, 0xfd, 0xbe, 0x06, 0xd0, 0xb4, 0x9c, 0x79, 0xdb,
-	0x76, 0xef, 0x31, 0x0b, 0x5d, 0x7a, 0xe5, 0x79, 0x6f, 0x51, 0x4d, 0x70, 0x06, 0x85, 0xb9, 0x95,
-	0x08, 0x3b, 0xd7, 0x67, 0x3e, 0x13, 0xea, 0x49, 0x62, 0xc0, 0xfd, 0xfd, 0x72, 0x39, 0xed, 0xbc,
-	0x30, 0x87, 0x85, 0x87, 0xc0, 0xb2, 0xe5, 0x07, 0x1f, 0xf9, 0xe3, 0x8e, 0x55, 0xf8, 0x33, 0x6e,
-	0x3c, 0x90, 0xd9, 0x26, 0x9c, 0x49, 0x10, 0xce, 0x50, 0x7f, 0x2e, 0xaa, 0xea, 0xcf, 0x8e, 0x4c,
-	0x6a, 0x2e, 0xf4, 0x57, 0x98, 0x7b, 0x77, 0xdb, 0x70, 0x02, 0x3a, 0xd3, 0x92, 0xa2, 0xf3, 0x8f,
-	0x4a, 0x30, 0x2d, 0x26, 0xc0, 0xa6, 0x57, 0x94, 0x96, 0xed, 0xee, 0x35, 0x89, 0x73, 0x1a, 0x16,
-	0x6d, 0xe1, 0x47, 0x50, 0xca, 0xfd, 0x08, 0x9a, 0xa9, 0x8f, 0xa0, 0xaf, 0xc8, 0x47, 0x10, 0xf1,
-	0x8a, 0x43, 0x74, 0x30, 0x18, 0xce, 0x5b, 0x0e, 0xed, 0xe8, 0x4d, 0xb6, 0x61, 0x62, 0xbb, 0x53,
-	0xc6, 0x9f, 0x86, 0xf9, 0xdd, 0xb3, 0x9a, 0x59, 0x03, 0xe7, 0xb4, 0xd4, 0xff, 0x4c, 0x83, 0x99,
-	0xac, 0xf9, 0x3d, 0x05, 0xb5, 0x69, 0x53, 0x55, 0x9b, 0xde, 0xea, 0xe1, 0xdb, 0x50, 0xba, 0x9e,
-	0xa3, 0x3e, 0xfd, 0xd3, 0x12, 0x9c, 0x8f, 0xab, 0x57, 0x1d, 0x3f, 0x30, 0x6c, 0x9b, 0xcb, 0xa5,
-	0x27, 0xbf, 0x97, 0x5a, 0x8a, 0xf6, 0x7b, 0xb5, 0xb7, 0xa1, 0xca, 0x7d, 0xcf, 0x35, 0x7e, 0xd9,
-	0x4d, 0x18, 0xbf, 0xac, 0x1d, 0x23, 0xcd, 0xce, 0x76, 0x30, 0xff, 0x4d, 0x83, 0xd9, 0xec, 0x86,
-	0xa7, 0xb0, 0xa9, 0x5c, 0x75, 0x53, 0xbd, 0xeb, 0xf8, 0x46, 0x9d, 0xb3, 0xad, 0x7e, 0xbe, 0x94,
-	0x37, 0x5a, 0xa6, 0x42, 0xdf, 0x82, 0x33, 0x1e, 0x69, 0x58, 0x7e, 0x20, 0xac, 0x34, 0x8e, 0x66,
-	0x88, 0x1e, 0x3e, 0x3a, 0x9e, 0xc1, 0x2a, 0x0e, 0x9c, 0x44, 0x8a, 0x56, 0x61, 0xc8, 0x27, 0xa4,
-	0x4e, 0xf1, 0x97, 0xba, 0xc7, 0x1f, 0x09, 0x11, 0x35, 0xde, 0x16, 0x87, 0x48, 0xd0, 0x77, 0xc0,
-	0x78, 0x3d, 0xfa, 0xa2, 0x28, 0xd6, 0xbe, 0xee, 0xb1, 0xb2, 0x2b, 0xda, 0xa2, 0xdc, 0x1a, 0xab,
-	0xc8, 0xf4, 0xbf, 0xd2, 0xe0, 0x62, 0xa7, 0xbd, 0x85, 0x5e, 0x00, 0x30, 0x43, 0xa9, 0x30, 0x7c,
-	0x04, 0x7c, 0xa2, 0xe0, 0x5a, 0x72, 0x2c, 0xf1, 0x07, 0x1a, 0x15, 0xf9, 0x58, 0x22, 0x92, 0x61,
-	0x12, 0x59, 0x3a, 0x21, 0x93, 0x48, 0xfd, 0xbf, 0x6b, 0x32, 0x2b, 0x92, 0xd7, 0xf6, 0x95, 0xc6,
-	0x8a, 0xe4, 0xbe, 0xe7, 0x3e, 0xc9, 0x7d, 0xb9, 0x04, 0x57, 0xb2, 0x9b, 0x48, 0xe7, 0xf9, 0x3b,
-	0x61, 0xb0, 0xc5, 0x9d, 0x45, 0xb8, 0xdd, 0xfc, 0x55, 0xca, 0x59, 0xb8, 0x2b, 0xc7, 0xfd, 0xfd,
-	0xf2, 0x6c, 0x16, 0xa3, 0x17, 0x4e, 0x20, 0xa2, 0x1d, 0xb2, 0x12, 0x6f, 0x07, 0x5c, 0x68, 0x7f,
-	0x63, 0x97, 0xcc, 0xc5, 0xd8, 0x24, 0x76, 0xd7, 0xcf, 0x05, 0x1f, 0xd6, 0x60, 0x42, 0xd9, 0xd1,
-	0xfe, 0xcc, 0x00, 0xdb, 0xa3, 0x85, 0xac, 0xd1, 0x94, 0x4f, 0x25, 0x96, 0x06, 0x94, 0x62, 0x1f,
-	0x27, 0x08, 0x26, 0xd8, 0xac, 0x3c, 0xab, 0xaf, 0x38, 0x36, 0x2b, 0x77, 0x3e, 0x87, 0xcd, 0xfe,
-	0x78, 0x29, 0x6f, 0xb4, 0x8c, 0xcd, 0xde, 0x83, 0x91, 0x50, 0x8c, 0x0c, 0xd9, 0xc5, 0x8d, 0x5e,
-	0xfb, 0xc4, 0xd1, 0x2d, 0x4c, 0x89, 0xfe, 0x8c, 0x84, 0x25, 0x3e, 0x8e, 0x69, 0xa1, 0xef, 0xd5,
-	0x00, 0xe2, 0x85, 0x11, 0x1f, 0xd5, 0xfa, 0xf1, 0x4d, 0x87, 0x24, 0xd6, 0x4c, 0xd0, 0x4f, 0x5a,
-	0xda, 0x14, 0x12, 0x5d, 0xfd, 0xdf, 0xf7, 0x03, 0x4a, 0xf7, 0xbd, 0xbb, 0x97, 0xe1, 0x43, 0x84,
-	0xdc, 0x16, 0x4c, 0x7a, 0x54, 0x5a, 0x34, 0x2d, 0x9b, 0x5d, 0x59, 0xdd, 0x76, 0x50, 0x50, 0xf3,
-	0xc1, 0xae, 0x55, 0x38, 0x81, 0x0b, 0xa7, 0xb0, 0xa3, 0xd7, 0xc0, 0x50, 0xcb, 0xb3, 0x9a, 0x86,
-	0xb7, 0xc7, 0x2e, 0xc5, 0xc3, 0xfc, 0xd9, 0x6a, 0x8d, 0x17, 0xe1, 0x10, 0x86, 0xde, 0x0f, 0x23,
-	0xb6, 0xb5, 0x45, 0xcc, 0x3d, 0xd3, 0x26, 0x42, 0x55, 0x7e, 0xe7, 0x78, 0xd6, 0x7c, 0x39, 0x44,
-	0x2b, 0xcc, 0x34, 0xc3, 0x9f, 0x38, 0x26, 0x88, 0xaa, 0x70, 0xf6, 0x1e, 0x33, 0x1c, 0xb2, 0x89,
-	0xef, 0xd7, 0xda, 0xad, 0x96, 0xeb, 0x05, 0xa4, 0xce, 0x14, 0xea, 0xc3, 0x5c, 0xb1, 0xf7, 0x74,
-	0x1a, 0x8c, 0xb3, 0xda, 0xa0, 0x27, 0x00, 0x8c, 0x76, 0xe0, 0x72, 0xaf, 0xcd, 0x99, 0x61, 0x76,
-	0x6b, 0xbf, 0x44, 0x17, 0x7b, 0x3e, 0x2a, 0xbd, 0xbf, 0x5f, 0x1e, 0x15, 0xba, 0x3f, 0xb6, 0x34,
-	0x52, 0x03, 0xf4, 0x5e, 0x98, 0x36, 0x39, 0xa8, 0xe2, 0x36, 0x5b, 0x46, 0x60, 0x89, 0x1b, 0xf1,
-	0x08, 0x43, 0xf4, 0x2d, 0x07, 0xfb, 0xe5, 0xe9, 0x4a, 0x06, 0x3c, 0x89, 0x32, 0x13, 0x89, 0xfe,
-	0x89, 0x12, 0x3c, 0xd8, 0x61, 0x82, 0x10, 0xa6, 0x1f, 0x9e, 0x58, 0x3f, 0xb1, 0xcd, 0xde, 0xc4,
-	0x3f, 0x16, 0x51, 0x78, 0x7f, 0xbf, 0xfc, 0x50, 0x07, 0x04, 0x35, 0xba, 0xcf, 0x49, 0x63, 0x0f,
-	0xc7, 0x68, 0x50, 0x15, 0x06, 0xeb, 0xf1, 0xdb, 0xd7, 0xc8, 0xc2, 0x1b, 0xe8, 0x51, 0xc0, 0xb5,
-	0xd4, 0xdd, 0x62, 0x13, 0x08, 0xd0, 0x32, 0x0c, 0x71, 0xc3, 0x53, 0x22, 0x8e, 0x95, 0xeb, 0x4c,
-	0x65, 0xc2, 0x8b, 0xba, 0x45, 0x16, 0xa2, 0xd0, 0xff, 0x52, 0x83, 0xa1, 0x8a, 0xeb, 0x91, 0xc5,
-	0xd5, 0x1a, 0xda, 0x83, 0x51, 0x29, 0x6c, 0x80, 0x60, 0xb1, 0x05, 0x79, 0x0e, 0xc3, 0x28, 0x5d,
-	0xed, 0x43, 0x47, 0xc7, 0xa8, 0x00, 0xcb, 0xb4, 0xd0, 0x0b, 0x74, 0xce, 0xef, 0x79, 0x56, 0x40,
-	0x09, 0xf7, 0x62, 0x4f, 0xc3, 0x09, 0xe3, 0x10, 0x17, 0xdf, 0xed, 0xd1, 0x4f, 0x1c, 0x53, 0xd1,
-	0xd7, 0x28, 0x7b, 0x49, 0x76, 0x13, 0x3d, 0x0e, 0xfd, 0x4d, 0xb7, 0x1e, 0xae, 0xfb, 0x6b, 0x43,
-	0xe6, 0xb1, 0xe2, 0xd6, 0xe9, 0xdc, 0x9e, 0x4f, 0xb7, 0x60, 0xef, 0x49, 0xac, 0x8d, 0xbe, 0x0a,
-	0x93, 0x49, 0xfa, 0xe8, 0x71, 0x98, 0x30, 0xdd, 0x66, 0xd3, 0x75, 0x6a, 0xed, 0xad, 0x2d, 0x6b,
-	0x97, 0x28, 0x1e, 0xa8, 0x15, 0x05, 0x82, 0x13, 0x35, 0xf5, 0xcf, 0x69, 0xd0, 0x47, 0xd7, 0x45,
-	0x87, 0xc1, 0xba, 0xdb, 0x34, 0x2c, 0x47, 0xf4, 0x8a, 0x3d, 0x48, 0x2f, 0xb2, 0x12, 0x2c, 0x20,
-	0xa8, 0x05, 0x23, 0xa1, 0x44, 0xd6, 0x93, 0xed, 0xfc, 0xe2, 0x6a, 0x2d, 0x72, 0x3d, 0x8a, 0x8e,
-	0x89, 0xb0, 0xc4, 0xc7, 0x31, 0x11, 0xdd, 0x80, 0xa9, 0xc5, 0xd5, 0x5a, 0xd5, 0x31, 0xed, 0x76,
-	0x9d, 0x2c, 0xed, 0xb2, 0x3f, 0x94, 0xcf, 0x59, 0xbc, 0x44, 0x8c, 0x93, 0xf1, 0x39, 0x51, 0x09,
-	0x87, 0x30, 0x5a, 0x8d, 0xf0, 0x16, 0xc2, 0x21, 0x93, 0x55, 0x13, 0x48, 0x70, 0x08, 0xd3, 0xbf,
-	0x52, 0x82, 0x51, 0xa9, 0x43, 0xc8, 0x86, 0x21, 0x3e, 0x5c, 0xbf, 0x17, 0xa7, 0xfb, 0x54, 0xaf,
-	0x39, 0x75, 0x3e, 0xa1, 0x3e, 0x0e, 0x49, 0xc8, 0x3c, 0xbb, 0xd4, 0x81, 0x67, 0xcf, 0x29, 0x7e,
-	0xad, 0xfc, 0x93, 0x9c, 0xc8, 0xf7, 0x69, 0x45, 0x17, 0xc5, 0xf1, 0xc4, 0x8d, 0xd7, 0x87, 0x13,
-	0x47, 0xd3, 0x16, 0x0c, 0xbc, 0xe8, 0x3a, 0xc4, 0x17, 0xba, 0xf0, 0x63, 0x1a, 0x20, 0x33, 0x9c,
-	0x7b, 0x96, 0xe2, 0xc5, 0x1c, 0xbd, 0xfe, 0x79, 0x0d, 0x60, 0xd1, 0x08, 0x0c, 0x6e, 0xa5, 0xd1,
-	0x85, 0x09, 0xe5, 0x45, 0xe5, 0x54, 0x1d, 0x4e, 0xb9, 0xcf, 0xf5, 0xfb, 0xd6, 0x8b, 0xe1, 0xf0,
-	0x23, 0x69, 0x9d, 0x63, 0xaf, 0x59, 0x2f, 0x12, 0xcc, 0xe0, 0xe8, 0x51, 0x18, 0x21, 0x8e, 0xe9,
-	0xed, 0xb5, 0xe8, 0xc1, 0xc2, 0x55, 0x3c, 0xec, 0x0b, 0x5d, 0x0a, 0x0b, 0x71, 0x0c, 0xd7, 0xdf,
-	0x00, 0xea, 0x95, 0xab, 0x0b, 0x0b, 0xef, 0xbf, 0xd6, 0xe0, 0xc2, 0x62, 0xdb, 0xb0, 0xe7, 0x5b,
-	0x74, 0xa3, 0x1a, 0xf6, 0x0d, 0x97, 0xdb, 0x00, 0xd0, 0x7b, 0xc8, 0xeb, 0x60, 0x38, 0x14, 0x72,
-	0x92, 0xfe, 0xb5, 0x21, 0xa3, 0xc4, 0x51, 0x0d, 0x64, 0xc0, 0xb0, 0x1f, 0x8a, 0xdd, 0xa5, 0x1e,
-	0xc4, 0xee, 0x90, 0x44, 0x24, 0x76, 0x47, 0x68, 0xb9, 0xf2, 0x8b, 0x2d, 0x50, 0x8d, 0x78, 0x3b,
-	0x96, 0x49, 0xe6, 0x4d, 0xd3, 0x6d, 0x3b, 0x81, 0x2f, 0xfc, 0xe5, 0x85, 0xf2, 0x2b, 0xab, 0x06,
-	0xce, 0x69, 0xa9, 0x7f, 0x49, 0x83, 0xfe, 0xa5, 0xf5, 0xca, 0x22, 0xfa, 0x0e, 0xe8, 0x8f, 0x58,
-	0x46, 0x41, 0xab, 0x1e, 0x8a, 0x87, 0xeb, 0xe9, 0xf8, 0x7a, 0xaf, 0x50, 0x86, 0xc3, 0xb0, 0xa2,
-	0x4d, 0x18, 0x24, 0x3b, 0x84, 0x76, 0xb5, 0x74, 0x2c, 0xf8, 0x19, 0x4b, 0x5b, 0x62, 0x18, 0xb1,
-	0xc0, 0xac, 0x7f, 0x52, 0x03, 0x88, 0xab, 0xa0, 0xef, 0xce, 0x3a, 0x9d, 0x6e, 0x1f, 0xa3, 0xf6,
-	0xb9, 0xf3, 0x11, 0xa5, 0x7f, 0xb5, 0x1f, 0x1e, 0xa0, 0xdd, 0x11, 0x5b, 0xd5, 0x72, 0x9d, 0xdb,
-	0x64, 0xef, 0x9b, 0xbe, 0x04, 0xdf, 0xf4, 0x25, 0x38, 0x3e, 0x5f, 0x02, 0xfd, 0x49, 0x98, 0x8c,
-	0xb7, 0x97, 0xd8, 0xf7, 0x8f, 0x26, 0xef, 0x81, 0x23, 0xa1, 0x50, 0x93, 0xbe, 0xbb, 0xe9, 0xf7,
-	0x35, 0x98, 0x5c, 0xda, 0x6d, 0x59, 0x1e, 0x8b, 0x33, 0xc0, 0xdd, 0x65, 0xd0, 0x23, 0xb1, 0x57,
-	0x8d, 0xa6, 0x3e, 0xb4, 0x25, 0x3d, 0x6b, 0xd0, 0x16, 0x4c, 0x10, 0xd6, 0x9c, 0x5d, 0xd4, 0x8c,
-	0xa0, 0xc8, 0x0e, 0xe4, 0xc1, 0x35, 0x14, 0x2c, 0x38, 0x81, 0x15, 0xd5, 0x60, 0xc2, 0xb4, 0x0d,
-	0xdf, 0xb7, 0xb6, 0x2c, 0x33, 0xf6, 0x06, 0x1b, 0x59, 0x78, 0x94, 0x89, 0x45, 0x0a, 0xe4, 0xfe,
-	0x7e, 0xf9, 0x9c, 0xe8, 0xa7, 0x0a, 0xc0, 0x09, 0x14, 0xfa, 0x67, 0x4a, 0x30, 0xbe, 0xb4, 0xdb,
-	0x72, 0xfd, 0xb6, 0x47, 0x58, 0xd5, 0x53, 0x50, 0x3d, 0x3d, 0x02, 0x43, 0xdb, 0x86, 0x53, 0xb7,
-	0x89, 0x27, 0x4e, 0xc6, 0x68, 0x6e, 0x6f, 0xf1, 0x62, 0x1c, 0xc2, 0xd1, 0x4b, 0x00, 0xbe, 0xb9,
-	0x4d, 0xea, 0x6d, 0xc6, 0xbf, 0xfa, 0x8a, 0xf3, 0x2f, 0x65, 0x8c, 0xb5, 0x08, 0xa5, 0x90, 0x3a,
-	0xa2, 0xdf, 0x58, 0x22, 0xa7, 0xff, 0x81, 0x06, 0x53, 0x4a, 0xbb, 0x53, 0xd0, 0xa8, 0x6c, 0xa9,
-	0x1a, 0x95, 0xf9, 0x9e, 0xc7, 0x9a, 0xa3, 0x48, 0xf9, 0x58, 0x09, 0x2e, 0xe4, 0xcc, 0x49, 0xca,
-	0xfa, 0x56, 0x3b, 0x25, 0xeb, 0xdb, 0x36, 0x8c, 0x06, 0xae, 0x2d, 0x9c, 0x16, 0xc3, 0x19, 0x28,
-	0x74, 0x4a, 0xae, 0x47, 0x68, 0x62, 0xdb, 0xda, 0xb8, 0xcc, 0xc7, 0x32, 0x1d, 0xfd, 0x57, 0x34,
-	0x18, 0x89, 0x14, 0xb7, 0xdf, 0x50, 0x6f, 0xde, 0xdd, 0xc7, 0x03, 0xd2, 0x7f, 0xb3, 0x04, 0xe7,
-	0x23, 0xdc, 0x21, 0x9b, 0xab, 0x05, 0x94, 0x6f, 0x1c, 0xae, 0xfd, 0xb9, 0xa8, 0xf8, 0x05, 0x0c,
-	0xa7, 0x1d, 0x0c, 0x5b, 0x6d, 0xaf, 0xe5, 0xfa, 0xa1, 0xa8, 0xca, 0x65, 0x7a, 0x5e, 0x84, 0x43,
-	0x18, 0x5a, 0x85, 0x01, 0x9f, 0xd2, 0x13, 0xc7, 0xd1, 0x11, 0x67, 0x83, 0x49, 0xdb, 0xac, 0xbf,
-	0x98, 0xa3, 0x41, 0x2f, 0xc9, 0x3c, 0x7c, 0xa0, 0xb8, 0x7e, 0x91, 0x8e, 0xa4, 0x1e, 0x09, 0xab,
-	0xf1, 0xeb, 0x44, 0x27, 0x7d, 0x9e, 0xbe, 0x0c, 0x93, 0xc2, 0xee, 0x94, 0x6f, 0x1b, 0xc7, 0x24,
-	0xe8, 0xad, 0xca, 0xce, 0x78, 0x38, 0x61, 0xf5, 0x32, 0x9d, 0xac, 0x1f, 0xef, 0x18, 0xdd, 0x87,
-	0xe1, 0x9b, 0xa2, 0x93, 0x68, 0x16, 0x4a, 0x56, 0xb8, 0x16, 0x20, 0x70, 0x94, 0xaa, 0x8b, 0xb8,
-	0x64, 0x75, 0xe1, 0x9f, 0x21, 0x1f, 0x4b, 0x7d, 0x9d, 0x8f, 0x25, 0xfd, 0x6b, 0x25, 0x98, 0x0e,
-	0xa9, 0x86, 0x63, 0x5c, 0x14, 0x0f, 0xda, 0x87, 0xdc, 0x5b, 0x0e, 0xd7, 0x06, 0xde, 0x81, 0x7e,
-	0xc6, 0x00, 0x0b, 0x3d, 0x74, 0x47, 0x08, 0x69, 0x77, 0x30, 0x43, 0x84, 0xde, 0x0f, 0x83, 0x36,
-	0xbd, 0x04, 0x84, 0x8e, 0x13, 0x85, 0x74, 0xa7, 0x59, 0xc3, 0xe5, 0x77, 0x0b, 0xe1, 0xeb, 0x15,
-	0xbd, 0x55, 0xf2, 0x42, 0x2c, 0x68, 0xce, 0xbe, 0x0d, 0x46, 0xa5, 0x6a, 0x47, 0x8a, 0xc3, 0xf6,
-	0xb9, 0x12, 0xcc, 0xdc, 0x22, 0x76, 0x33, 0xd3, 0x3a, 0xa1, 0x0c, 0x03, 0xe6, 0xb6, 0xe1, 0xf1,
-	0x10, 0x7f, 0x63, 0x7c, 0x93, 0x57, 0x68, 0x01, 0xe6, 0xe5, 0xf4, 0x4e, 0xa0, 0x38, 0xd9, 0xbd,
-	0x43, 0x9a, 0xc9, 0x38, 0xf6, 0xe3, 0xfb, 0xa2, 0xe0, 0x90, 0xf1, 0xc0, 0x95, 0x0a, 0xf4, 0x78,
-	0x79, 0x57, 0xed, 0xce, 0x6a, 0x96, 0x7b, 0x1d, 0x7a, 0x11, 0xc6, 0x5d, 0xd3, 0xc2, 0xa4, 0xe5,
-	0xfa, 0x56, 0xe0, 0x7a, 0x7b, 0x62, 0xd1, 0x0a, 0x1d, 0x2d, 0x77, 0x2a, 0xd5, 0x18, 0x11, 0x7f,
-	0xe1, 0x53, 0x8a, 0xb0, 0x4a, 0x4a, 0xff, 0xa2, 0x06, 0xa3, 0xb7, 0xac, 0x4d, 0xe2, 0x71, 0xd3,
-	0x5a, 0xa6, 0xc4, 0x50, 0x82, 0xd5, 0x8d, 0x66, 0x05, 0xaa, 0x43, 0xbb, 0x30, 0x22, 0xce, 0xe1,
-	0xc8, 0x7b, 0xf2, 0x66, 0x31, 0x93, 0x9e, 0x88, 0xb4, 0x38, 0xdf, 0xa4, 0x0f, 0x3f, 0x2c, 0xf1,
-	0x71, 0x4c, 0x4c, 0x7f, 0x09, 0xce, 0x66, 0x34, 0xa2, 0x0b, 0xc9, 0xac, 0x4b, 0xc5, 0x47, 0x13,
-	0x72, 0x2b, 0xba, 0x90, 0xac, 0x1c, 0x3d, 0x00, 0x7d, 0xc4, 0xa9, 0x8b, 0x2f, 0x66, 0xe8, 0x60,
-	0xbf, 0xdc, 0xb7, 0xe4, 0xd4, 0x31, 0x2d, 0xa3, 0x4c, 0xdc, 0x76, 0x15, 0x89, 0x8d, 0x31, 0xf1,
-	0x65, 0x51, 0x86, 0x23, 0x28, 0x33, 0xc2, 0x4a, 0xda, 0x1b, 0x51, 0xe1, 0x7f, 0x72, 0x2b, 0xc1,
-	0x5b, 0x7a, 0x31, 0x73, 0x4a, 0xf2, 0xa9, 0x85, 0x19, 0x31, 0x21, 0x29, 0x8e, 0x87, 0x53, 0x74,
-	0xf5, 0x5f, 0xea, 0x87, 0x4b, 0xb7, 0x5c, 0xcf, 0x7a, 0xd1, 0x75, 0x02, 0xc3, 0x5e, 0x73, 0xeb,
-	0xb1, 0x09, 0xaa, 0x38, 0xb2, 0x3e, 0xaa, 0xc1, 0x05, 0xb3, 0xd5, 0xe6, 0x97, 0x87, 0xd0, 0x8a,
-	0x73, 0x8d, 0x78, 0x96, 0x5b, 0xd4, 0x97, 0x82, 0x59, 0xdc, 0x56, 0xd6, 0x36, 0xb2, 0x50, 0xe2,
-	0x3c, 0x5a, 0xcc, 0xa5, 0xa3, 0xee, 0xde, 0x73, 0x58, 0xe7, 0x6a, 0x01, 0x9b, 0xcd, 0x17, 0xe3,
-	0x45, 0x28, 0xe8, 0xd2, 0xb1, 0x98, 0x89, 0x11, 0xe7, 0x50, 0x42, 0x1f, 0x84, 0x73, 0x16, 0xef,
-	0x1c, 0x26, 0x46, 0xdd, 0x72, 0x88, 0xef, 0x73, 0x7b, 0xf0, 0x1e, 0x7c, 0x16, 0xaa, 0x59, 0x08,
-	0x71, 0x36, 0x1d, 0xf4, 0x1c, 0x80, 0xbf, 0xe7, 0x98, 0x62, 0xfe, 0x8b, 0xd9, 0x8a, 0x72, 0x11,
-	0x39, 0xc2, 0x82, 0x25, 0x8c, 0xf4, 0xa2, 0x15, 0x44, 0x9b, 0x72, 0x90, 0xd9, 0xfb, 0xb2, 0x8b,
-	0x56, 0xbc, 0x87, 0x62, 0xb8, 0xfe, 0x49, 0x0d, 0x26, 0xaa, 0xce, 0x9a, 0x6d, 0x98, 0x84, 0x9b,
-	0x3e, 0xfa, 0xe8, 0x1a, 0x8c, 0xf8, 0xd1, 0xa3, 0x09, 0xe7, 0x08, 0xf1, 0xf7, 0x19, 0x3d, 0x97,
-	0xc4, 0x75, 0x98, 0x21, 0xb5, 0xe5, 0x88, 0xc3, 0xee, 0x86, 0xeb, 0x71, 0x44, 0xe2, 0xbb, 0xe3,
-	0x86, 0xd4, 0x69, 0x30, 0xce, 0x6a, 0xa3, 0xff, 0x9c, 0x06, 0xd3, 0x6a, 0x77, 0x84, 0xd5, 0xc1,
-	0x8f, 0x69, 0x30, 0xad, 0x38, 0x85, 0x0b, 0x70, 0x2f, 0x71, 0xaa, 0xd6, 0x32, 0xf0, 0x71, 0x2b,
-	0xdc, 0x2c, 0x08, 0xce, 0xa4, 0xaf, 0xff, 0x63, 0x0d, 0x86, 0x44, 0xcc, 0x4a, 0xf4, 0xda, 0x84,
-	0x82, 0x3b, 0x3a, 0xda, 0x12, 0x4a, 0xee, 0x3d, 0x66, 0x42, 0x21, 0x8e, 0x26, 0x71, 0xca, 0x14,
-	0xd2, 0x90, 0x0a, 0xc2, 0xf1, 0x39, 0xa7, 0x98, 0x52, 0x84, 0xaf, 0x27, 0x12, 0x31, 0xfd, 0x65,
-	0x0d, 0xa6, 0x52, 0xad, 0xba, 0x10, 0x47, 0x4f, 0xd1, 0xa8, 0xf4, 0xcb, 0xfd, 0x74, 0x4b, 0x06,
-	0x94, 0xdf, 0xdb, 0x5c, 0xf7, 0x7c, 0x0a, 0xf7, 0xdf, 0x47, 0x61, 0xc4, 0x6a, 0x36, 0xdb, 0x01,
-	0x7b, 0xe7, 0x1b, 0x88, 0x15, 0xba, 0xd5, 0xb0, 0x10, 0xc7, 0x70, 0xe4, 0x08, 0x49, 0x8b, 0x9f,
-	0x82, 0xcb, 0xc5, 0x56, 0x4e, 0x1e, 0xe0, 0x1c, 0x95, 0x8a, 0xb8, 0x38, 0x94, 0x25, 0x88, 0x7d,
-	0x9f, 0x06, 0xe0, 0x07, 0x9e, 0xe5, 0x34, 0x68, 0xa1, 0x90, 0xc6, 0xf0, 0x31, 0x90, 0xad, 0x45,
-	0x48, 0x39, 0xf1, 0x38, 0x8e, 0x65, 0x04, 0xc0, 0x12, 0x65, 0x34, 0x2f, 0x84, 0x50, 0x7e, 0x64,
-	0xbe, 0x3e, 0x21, 0x6e, 0x5f, 0xca, 0x30, 0x56, 0xe5, 0x84, 0x62, 0x29, 0x75, 0xf6, 0x31, 0x18,
-	0x89, 0xe8, 0x1d, 0x26, 0xd4, 0x8d, 0x49, 0x42, 0xdd, 0xec, 0x13, 0x70, 0x26, 0xd1, 0xdd, 0x23,
-	0xc9, 0x84, 0xff, 0x49, 0x03, 0xa4, 0x8e, 0xfe, 0x14, 0x34, 0x07, 0x0d, 0x55, 0x73, 0xb0, 0xd0,
-	0xfb, 0x92, 0xe5, 0xa8, 0x0e, 0x7e, 0x06, 0x01, 0x0b, 0xe9, 0x1b, 0x85, 0xb8, 0x16, 0x27, 0x3f,
-	0x15, 0x54, 0x62, 0x7f, 0x6c, 0xf1, 0xe5, 0xf6, 0x20, 0xa8, 0xdc, 0x4e, 0xe0, 0x8a, 0x05, 0x95,
-	0x24, 0x04, 0xa7, 0xe8, 0xa2, 0x8f, 0x6b, 0x30, 0x69, 0xa8, 0x21, 0x7d, 0xc3, 0x99, 0x29, 0x14,
-	0x68, 0x2c, 0x11, 0x1e, 0x38, 0xee, 0x4b, 0x02, 0xe0, 0xe3, 0x14, 0x59, 0xf4, 0x26, 0x18, 0x33,
-	0x5a, 0xd6, 0x7c, 0xbb, 0x6e, 0xd1, 0x9b, 0x67, 0x18, 0xf9, 0x94, 0x69, 0x43, 0xe6, 0xd7, 0xaa,
-	0x51, 0x39, 0x56, 0x6a, 0x45, 0xb1, 0x73, 0x2b, 0xb1, 0x6d, 0x6f, 0x2f, 0xb1, 0x73, 0xc5, 0x1c,
-	0xc6, 0xb1, 0x73, 0xc5, 0xd4, 0xc9, 0x44, 0x90, 0x03, 0xe0, 0x5a, 0x75, 0x53, 0x90, 0x1c, 0x2c,
-	0xfe, 0x4c, 0x71, 0xa7, 0xba, 0x58, 0x11, 0x14, 0x99, 0xf8, 0x10, 0xff, 0xc6, 0x12, 0x05, 0xf4,
-	0x69, 0x0d, 0xc6, 0x05, 0xef, 0x16, 0x34, 0x87, 0xd8, 0x12, 0x3d, 0x5b, 0x74, 0xbf, 0x24, 0xf6,
-	0xe4, 0x1c, 0x96, 0x91, 0x73, 0xbe, 0x13, 0xb9, 0xf3, 0x2b, 0x30, 0xac, 0xf6, 0x83, 0xc9, 0x00,
-	0xbe, 0xf2, 0x4e, 0x24, 0x3a, 0x38, 0x5c, 0x5c, 0x06, 0xa8, 0x65, 0xe0, 0x13, 0x7e, 0x40, 0x19,
-	0x10, 0x9c, 0x49, 0x9f, 0xca, 0xb5, 0x67, 0xee, 0x19, 0x81, 0xb9, 0x5d, 0x31, 0xcc, 0x6d, 0xf6,
-	0x4c, 0xc8, 0x3d, 0x1e, 0x0b, 0xee, 0xeb, 0xa7, 0x55, 0x54, 0x0b, 0x67, 0x0f, 0xf6, 0xcb, 0x67,
-	0x12, 0x85, 0x38, 0x49, 0x10, 0xb9, 0x30, 0xec, 0x89, 0xb8, 0xf6, 0xc2, 0x1f, 0xbd, 0x58, 0x28,
-	0xf7, 0x64, 0x90, 0x7c, 0x7e, 0x33, 0x0a, 0x7f, 0xe1, 0x88, 0x08, 0x6a, 0xc0, 0x25, 0x7e, 0x37,
-	0x9c, 0x77, 0x5c, 0x67, 0xaf, 0xe9, 0xb6, 0xfd, 0xf9, 0x76, 0xb0, 0x4d, 0x9c, 0x20, 0x54, 0x85,
-	0x8f, 0xb2, 0x63, 0x94, 0xf9, 0xb5, 0x2d, 0x75, 0xaa, 0x88, 0x3b, 0xe3, 0x41, 0xcf, 0xc0, 0x30,
-	0x7b, 0x4a, 0x5b, 0x5f, 0x5f, 0x66, 0xce, 0x93, 0x47, 0x17, 0x97, 0xd9, 0x10, 0x96, 0x04, 0x0e,
-	0x1c, 0x61, 0x43, 0x77, 0x61, 0xc8, 0xe6, 0x89, 0x09, 0x98, 0x13, 0x65, 0x41, 0xa6, 0x98, 0x4c,
-	0x72, 0xc0, 0x2f, 0xd0, 0xe2, 0x07, 0x0e, 0x29, 0xa0, 0x16, 0x5c, 0xa9, 0x93, 0x2d, 0xa3, 0x6d,
-	0x07, 0xab, 0x6e, 0x80, 0x99, 0x13, 0x59, 0xa4, 0xf1, 0x0c, 0xfd, 0x64, 0x27, 0x58, 0x2c, 0x39,
-	0xe6, 0x9e, 0xb7, 0x78, 0x48, 0x5d, 0x7c, 0x28, 0x36, 0xb4, 0x07, 0x0f, 0x89, 0x3a, 0xcc, 0x6b,
-	0xcd, 0xdc, 0xa6, 0xb3, 0x9c, 0x26, 0x7a, 0x86, 0x11, 0xfd, 0x96, 0x83, 0xfd, 0xf2, 0x43, 0x8b,
-	0x87, 0x57, 0xc7, 0xdd, 0xe0, 0x64, 0x8e, 0x40, 0x24, 0xf1, 0x04, 0x34, 0x33, 0x59, 0x7c, 0x8e,
-	0x93, 0xcf, 0x49, 0xdc, 0x62, 0x2d, 0x59, 0x8a, 0x53, 0x34, 0xd1, 0x17, 0x34, 0x98, 0xf1, 0x03,
-	0xaf, 0x6d, 0x06, 0x6d, 0x8f, 0xd4, 0x13, 0x3b, 0x74, 0x8a, 0x75, 0xa8, 0x90, 0x00, 0x57, 0xcb,
-	0xc1, 0xc9, 0x3c, 0xb6, 0x67, 0xf2, 0xa0, 0x38, 0xb7, 0x2f, 0xe8, 0x27, 0x35, 0xb8, 0xa0, 0x02,
-	0xe9, 0x9d, 0x9e, 0xf7, 0x13, 0x15, 0x7f, 0x64, 0xa9, 0x65, 0xa3, 0xe4, 0x37, 0xf8, 0x1c, 0x20,
-	0xce, 0xeb, 0x48, 0xf2, 0xf1, 0xfa, 0xec, 0x29, 0x3f, 0x5e, 0xcf, 0xbe, 0x13, 0x50, 0xfa, 0xf8,
-	0x38, 0x4c, 0x0e, 0x1c, 0x96, 0xe5, 0xc0, 0xcf, 0x0e, 0xc0, 0x83, 0xf4, 0x54, 0x8a, 0x6f, 0x3f,
-	0x2b, 0x86, 0x63, 0x34, 0xbe, 0x31, 0x25, 0xa6, 0x2f, 0x6a, 0x70, 0x61, 0x3b, 0x5b, 0xb5, 0x23,
-	0xee, 0x5f, 0xef, 0x2e, 0xa4, 0x82, 0xeb, 0xa4, 0x2d, 0xe2, 0x0c, 0xbb, 0x63, 0x15, 0x9c, 0xd7,
-	0x29, 0xf4, 0x4e, 0x98, 0x74, 0xdc, 0x3a, 0xa9, 0x54, 0x17, 0xf1, 0x8a, 0xe1, 0xdf, 0xad, 0x85,
-	0xb6, 0x34, 0x03, 0xfc, 0x7b, 0x5d, 0x4d, 0xc0, 0x70, 0xaa, 0x36, 0xda, 0x01, 0xd4, 0x72, 0xeb,
-	0x4b, 0x3b, 0x3c, 0x81, 0x47, 0x6f, 0x56, 0xad, 0xec, 0x3d, 0x7f, 0x2d, 0x85, 0x0d, 0x67, 0x50,
-	0x60, 0xba, 0x29, 0xda, 0x99, 0x15, 0xd7, 0xb1, 0x02, 0xd7, 0x63, 0x31, 0x08, 0x7a, 0x52, 0xd1,
-	0x30, 0xdd, 0xd4, 0x6a, 0x26, 0x46, 0x9c, 0x43, 0x49, 0xff, 0x5f, 0x1a, 0x9c, 0xa1, 0xdb, 0x62,
-	0xcd, 0x73, 0x77, 0xf7, 0xbe, 0x11, 0x37, 0xe4, 0x23, 0xc2, 0xac, 0x90, 0xeb, 0x76, 0xce, 0x49,
-	0x26, 0x85, 0x23, 0xac, 0xcf, 0xb1, 0x15, 0xa1, 0xac, 0x56, 0xee, 0xcb, 0x57, 0x2b, 0xeb, 0x9f,
-	0x2e, 0xf1, 0x9b, 0x4b, 0xa8, 0xd6, 0xfd, 0x86, 0xfc, 0x0e, 0x1f, 0x83, 0x71, 0x5a, 0xb6, 0x62,
-	0xec, 0xae, 0x2d, 0x3e, 0xe5, 0xda, 0xa1, 0xc3, 0x34, 0xd3, 0xb5, 0xdf, 0x96, 0x01, 0x58, 0xad,
-	0x87, 0x1e, 0x87, 0xa1, 0x16, 0x8f, 0xda, 0x25, 0xee, 0xcc, 0x57, 0xb8, 0xed, 0x1d, 0x2b, 0xba,
-	0xbf, 0x5f, 0x9e, 0x8a, 0x9f, 0x78, 0xc3, 0xd8, 0x61, 0x61, 0x03, 0xfd, 0x97, 0xce, 0x03, 0x43,
-	0x6e, 0x93, 0xe0, 0x1b, 0x71, 0x4e, 0xde, 0x00, 0xa3, 0x66, 0xab, 0x5d, 0xb9, 0x51, 0x7b, 0x77,
-	0xdb, 0x65, 0xba, 0x10, 0x96, 0xe7, 0x86, 0x72, 0xef, 0xca, 0xda, 0x46, 0x58, 0x8c, 0xe5, 0x3a,
-	0x94, 0x3b, 0x98, 0xad, 0xb6, 0xe0, 0xb7, 0x6b, 0xb2, 0x4b, 0x09, 0xe3, 0x0e, 0x95, 0xb5, 0x0d,
-	0x05, 0x86, 0x53, 0xb5, 0xd1, 0x07, 0x61, 0x8c, 0x88, 0x0f, 0xf7, 0x96, 0xe1, 0xd5, 0x05, 0x5f,
-	0xa8, 0x16, 0x1d, 0x7c, 0x34, 0xb5, 0x21, 0x37, 0xe0, 0x37, 0xc0, 0x25, 0x89, 0x04, 0x56, 0x08,
-	0xa2, 0xf7, 0xc2, 0x03, 0xe1, 0x6f, 0xba, 0xca, 0x6e, 0x3d, 0xc9, 0x28, 0x06, 0x78, 0x7c, 0x9f,
-	0xa5, 0xbc, 0x4a, 0x38, 0xbf, 0x3d, 0xfa, 0x59, 0x0d, 0xce, 0x47, 0x50, 0xcb, 0xb1, 0x9a, 0xed,
-	0x26, 0x26, 0xa6, 0x6d, 0x58, 0x4d, 0x71, 0xef, 0x7b, 0xfa, 0xd8, 0x06, 0xaa, 0xa2, 0xe7, 0xcc,
-	0x2a, 0x1b, 0x86, 0x73, 0xba, 0x84, 0x5e, 0xd6, 0xe0, 0x4a, 0x08, 0x5a, 0xf3, 0x88, 0xef, 0xb7,
-	0x3d, 0x12, 0xbb, 0xeb, 0x8b, 0x29, 0x19, 0x2a, 0xc4, 0x3b, 0x99, 0x00, 0xbc, 0x74, 0x08, 0x6e,
-	0x7c, 0x28, 0x75, 0x79, 0xbb, 0xd4, 0xdc, 0xad, 0x40, 0x5c, 0x14, 0x4f, 0x6a, 0xbb, 0x50, 0x12,
-	0x58, 0x21, 0x88, 0x7e, 0x4e, 0x83, 0x0b, 0x72, 0x81, 0xbc, 0x5b, 0xf8, 0x0d, 0xf1, 0x99, 0x63,
-	0xeb, 0x4c, 0x02, 0x3f, 0x97, 0xf0, 0x72, 0x80, 0x38, 0xaf, 0x57, 0x94, 0x6d, 0x37, 0xd9, 0xc6,
-	0xe4, 0xb7, 0xc8, 0x01, 0xce, 0xb6, 0xf9, 0x5e, 0xf5, 0x71, 0x08, 0x43, 0x6f, 0x82, 0xb1, 0x96,
-	0x5b, 0x5f, 0xb3, 0xea, 0x3e, 0x8b, 0x28, 0xc6, 0xee, 0x7a, 0x7d, 0x7c, 0x3a, 0xd6, 0xdc, 0xfa,
-	0x5a, 0x75, 0x91, 0x97, 0x63, 0xa5, 0x16, 0x9a, 0x03, 0xd8, 0x32, 0x2c, 0xbb, 0x76, 0xcf, 0x68,
-	0xdd, 0x09, 0xe3, 0xd6, 0x30, 0x5d, 0xc4, 0x8d, 0xa8, 0x14, 0x4b, 0x35, 0xe8, 0xfa, 0x51, 0xbe,
-	0x83, 0x09, 0x0f, 0x9f, 0xcd, 0xae, 0x47, 0xc7, 0xb1, 0x7e, 0x21, 0x42, 0xde, 0xe1, 0xdb, 0x12,
-	0x09, 0xac, 0x10, 0x44, 0x1f, 0xd5, 0x60, 0xc2, 0xdf, 0xf3, 0x03, 0xd2, 0x8c, 0xfa, 0x70, 0xe6,
-	0xb8, 0xfb, 0xc0, 0x74, 0xe2, 0x35, 0x85, 0x08, 0x4e, 0x10, 0x65, 0x11, 0x80, 0x9a, 0x46, 0x83,
-	0xdc, 0xac, 0xdc, 0xb2, 0x1a, 0xdb, 0x51, 0x00, 0x96, 0x35, 0xe2, 0x99, 0xc4, 0x09, 0xd8, 0xc5,
-	0x6a, 0x40, 0x44, 0x00, 0xca, 0xaf, 0x86, 0x3b, 0xe1, 0x40, 0xcf, 0xc1, 0xac, 0x00, 0x2f, 0xbb,
-	0xf7, 0x52, 0x14, 0x78, 0xa0, 0x1d, 0x66, 0xa3, 0x58, 0xcd, 0xad, 0x85, 0x3b, 0x60, 0x40, 0x55,
-	0x38, 0xeb, 0x13, 0x8f, 0xbd, 0x09, 0xf2, 0xf8, 0x8c, 0x6b, 0x6d, 0xdb, 0xf6, 0xd9, 0xd5, 0x46,
-	0x78, 0xe5, 0xd4, 0xd2, 0x60, 0x9c, 0xd5, 0x06, 0x3d, 0x11, 0x79, 0xee, 0xee, 0xd1, 0x82, 0x77,
-	0xaf, 0xd5, 0xd8, 0x4d, 0x64, 0x80, 0x6b, 0x4a, 0xb0, 0x0a, 0xc2, 0xc9, 0xba, 0xf4, 0x34, 0x0f,
-	0x8b, 0x16, 0xda, 0x9e, 0x1f, 0xcc, 0x4c, 0xb3, 0xc6, 0xec, 0x34, 0xc7, 0x32, 0x00, 0xab, 0xf5,
-	0xd0, 0xe3, 0x30, 0xe1, 0x13, 0xd3, 0x74, 0x9b, 0x2d, 0x71, 0x4f, 0x9e, 0x39, 0xc7, 0x7a, 0xcf,
-	0x57, 0x50, 0x81, 0xe0, 0x44, 0x4d, 0xb4, 0x07, 0x67, 0xa3, 0x50, 0xc0, 0xcb, 0x6e, 0x63, 0xc5,
-	0xd8, 0x65, 0xc2, 0xf1, 0xf9, 0x22, 0x51, 0x18, 0xf8, 0x74, 0x55, 0xd2, 0xe8, 0x70, 0x16, 0x0d,
-	0xb4, 0x0c, 0xd3, 0x89, 0xe2, 0x1b, 0x96, 0x4d, 0xfc, 0x99, 0x0b, 0x6c, 0xd8, 0x4c, 0xd9, 0x55,
-	0xc9, 0x80, 0xe3, 0xcc, 0x56, 0xe8, 0x0e, 0x9c, 0x6b, 0x79, 0x6e, 0x40, 0xcc, 0xe0, 0x36, 0x15,
-	0x08, 0x6c, 0x31, 0x40, 0x7f, 0x66, 0x86, 0xcd, 0x05, 0x7b, 0x0f, 0x5d, 0xcb, 0xaa, 0x80, 0xb3,
-	0xdb, 0xa1, 0xcf, 0x6a, 0x70, 0xd9, 0x0f, 0x3c, 0x62, 0x34, 0x2d, 0xa7, 0x51, 0x71, 0x1d, 0x87,
-	0x30, 0xc6, 0x54, 0xad, 0xc7, 0x4e, 0x6d, 0x0f, 0x14, 0x3a, 0x45, 0xf4, 0x83, 0xfd, 0xf2, 0xe5,
-	0x5a, 0x47, 0xcc, 0xf8, 0x10, 0xca, 0xe8, 0x25, 0x80, 0x26, 0x69, 0xba, 0xde, 0x1e, 0xe5, 0x48,
-	0x33, 0xb3, 0xc5, 0xef, 0xbb, 0x2b, 0x11, 0x16, 0xfe, 0xf9, 0x2b, 0x2f, 0xb9, 0x31, 0x10, 0x4b,
-	0xe4, 0xe8, 0x54, 0x53, 0x7e, 0x2b, 0x02, 0x4d, 0x49, 0x1f, 0xcd, 0x83, 0x6c, 0xe5, 0xd8, 0x54,
-	0xaf, 0x64, 0x55, 0xc0, 0xd9, 0xed, 0x90, 0x0f, 0x53, 0xec, 0x0b, 0x15, 0x27, 0xf9, 0xcd, 0xca,
-	0x7c, 0x83, 0xcc, 0x5c, 0x2c, 0x34, 0xb9, 0x54, 0xf6, 0x9f, 0xaa, 0x26, 0x91, 0xe1, 0x34, 0xfe,
-	0x98, 0xa8, 0xb1, 0x1b, 0x13, 0xbd, 0xd4, 0x2b, 0x51, 0x09, 0x19, 0x4e, 0xe3, 0xd7, 0xf7, 0x4b,
-	0x70, 0x2e, 0xf3, 0x94, 0xa4, 0xcc, 0x83, 0x4f, 0xf1, 0x7c, 0x98, 0xc7, 0x4c, 0x3c, 0x7b, 0x32,
-	0xe6, 0xb1, 0xa2, 0x82, 0x70, 0xb2, 0x2e, 0x95, 0x61, 0x19, 0xb5, 0x1b, 0xb5, 0xb8, 0x7d, 0x29,
-	0x96, 0x61, 0xab, 0x09, 0x18, 0x4e, 0xd5, 0x46, 0x15, 0x31, 0x1f, 0x37, 0x6a, 0x55, 0x7a, 0x0d,
-	0xf4, 0x6f, 0x78, 0x24, 0xbc, 0x1d, 0xc4, 0xe3, 0x93, 0x81, 0x38, 0x5d, 0x9f, 0x8e, 0x82, 0xfe,
-	0x90, 0x7b, 0xd1, 0x1f, 0x8f, 0x62, 0x55, 0x05, 0xe1, 0x64, 0xdd, 0xf0, 0x9e, 0xae, 0x74, 0x61,
-	0x20, 0x1e, 0xc5, 0x6a, 0x02, 0x86, 0x53, 0xb5, 0xf5, 0x3f, 0xec, 0x87, 0x87, 0xba, 0x90, 0x2c,
-	0x51, 0x33, 0x7b, 0xba, 0x8f, 0xce, 0xf3, 0xba, 0x5b, 0x9e, 0x56, 0xce, 0xf2, 0x1c, 0x9d, 0x5e,
-	0xb7, 0xcb, 0xe9, 0xe7, 0x2d, 0xe7, 0xd1, 0x49, 0x76, 0xbf, 0xfc, 0xcd, 0xec, 0xe5, 0x2f, 0x38,
-	0xab, 0x87, 0x6e, 0x97, 0x56, 0xce, 0x76, 0x29, 0x38, 0xab, 0x5d, 0x6c, 0xaf, 0x3f, 0xea, 0x87,
-	0x87, 0xbb, 0x91, 0x72, 0x0b, 0xee, 0xaf, 0x0c, 0xde, 0x72, 0xa2, 0xfb, 0x2b, 0xcf, 0xe5, 0xfa,
-	0x04, 0xf7, 0x57, 0x47, 0xf6, 0x79, 0x32, 0xfb, 0x2b, 0x6f, 0x56, 0x4f, 0x6a, 0x7f, 0xe5, 0xcd,
-	0x6a, 0x17, 0xfb, 0xeb, 0x2f, 0x92, 0xe7, 0x43, 0x24, 0x6a, 0x57, 0xa1, 0xcf, 0x6c, 0xb5, 0x0b,
-	0x32, 0x29, 0x66, 0x65, 0x58, 0x59, 0xdb, 0xc0, 0x14, 0x07, 0xc2, 0x30, 0xc8, 0xf7, 0x4f, 0x41,
-	0x16, 0xc4, 0x2c, 0x47, 0xf9, 0x96, 0xc4, 0x02, 0x13, 0x9d, 0x2a, 0xd2, 0xda, 0x26, 0x4d, 0xe2,
-	0x19, 0x76, 0x2d, 0x70, 0x3d, 0xa3, 0x51, 0x94, 0xdb, 0xf0, 0x17, 0x94, 0x04, 0x2e, 0x9c, 0xc2,
-	0x4e, 0x27, 0xa4, 0x65, 0xd5, 0x0b, 0xf2, 0x17, 0x36, 0x21, 0x6b, 0xd5, 0x45, 0x4c, 0x71, 0xe8,
-	0xff, 0x6a, 0x18, 0xa4, 0x50, 0xf1, 0xe8, 0x13, 0x1a, 0x4c, 0x99, 0xc9, 0xb0, 0x99, 0xbd, 0xd8,
-	0x43, 0xa5, 0x62, 0x70, 0xf2, 0x2d, 0x9f, 0x2a, 0xc6, 0x69, 0xb2, 0xe8, 0x43, 0x1a, 0x57, 0xf2,
-	0x45, 0xaf, 0x79, 0x62, 0x5a, 0x6f, 0x1e, 0xd3, 0xbb, 0x77, 0xac, 0x2d, 0x8c, 0x9f, 0x58, 0x55,
-	0x82, 0xe8, 0x65, 0x0d, 0xce, 0xdd, 0xcd, 0x7a, 0x9b, 0x10, 0x93, 0x7f, 0xa7, 0x68, 0x57, 0x72,
-	0x1e, 0x3b, 0xb8, 0x04, 0x99, 0x59, 0x01, 0x67, 0x77, 0x24, 0x9a, 0xa5, 0x48, 0x5d, 0x2b, 0xbe,
-	0xd3, 0xc2, 0xb3, 0x94, 0xd0, 0xfb, 0xc6, 0xb3, 0x14, 0x01, 0xb0, 0x4a, 0x10, 0xb5, 0x60, 0xe4,
-	0x6e, 0xa8, 0x23, 0x17, 0x7a, 0xb1, 0x4a, 0x51, 0xea, 0x92, 0xa2, 0x9d, 0xdb, 0x7b, 0x45, 0x85,
-	0x38, 0x26, 0x82, 0xb6, 0x61, 0xe8, 0x2e, 0xe7, 0x15, 0x42, 0x9f, 0x35, 0xdf, 0xf3, 0xed, 0x9f,
-	0xab, 0x55, 0x44, 0x11, 0x0e, 0xd1, 0xcb, 0xbe, 0x04, 0xc3, 0x87, 0xb8, 0xb8, 0x7d, 0x56, 0x83,
-	0x73, 0x3b, 0xc4, 0x0b, 0x2c, 0x33, 0xf9, 0x32, 0x34, 0x52, 0x5c, 0x43, 0xf1, 0x54, 0x16, 0x42,
-	0xbe, 0x4d, 0x32, 0x41, 0x38, 0xbb, 0x0b, 0xe8, 0x29, 0xe8, 0x27, 0x81, 0x59, 0x17, 0x31, 0x96,
-	0xdf, 0x5a, 0xd4, 0xab, 0x96, 0xfb, 0xbd, 0xd0, 0xff, 0x30, 0xc3, 0xa7, 0xff, 0xa9, 0x06, 0x29,
-	0xdd, 0x34, 0xfa, 0x41, 0x0d, 0xc6, 0xb6, 0x88, 0x11, 0xb4, 0x3d, 0x72, 0x53, 0xd8, 0x84, 0xf6,
-	0x5d, 0x1d, 0xbd, 0xfe, 0xd4, 0x71, 0xa8, 0xc4, 0xe7, 0x6e, 0x48, 0x88, 0xb9, 0xb1, 0x4a, 0x14,
-	0xd2, 0x5e, 0x06, 0x61, 0xa5, 0x07, 0xb3, 0x4f, 0xc2, 0x54, 0xaa, 0xe1, 0x91, 0x9e, 0x29, 0xff,
-	0xa5, 0x06, 0x59, 0x39, 0xda, 0xd1, 0x73, 0x30, 0x60, 0xd4, 0xeb, 0x51, 0xaa, 0xce, 0xb7, 0x15,
-	0xb3, 0x9b, 0xaa, 0xcb, 0xc1, 0x7c, 0xd8, 0x4f, 0xcc, 0xd1, 0xa2, 0x1b, 0x80, 0x0c, 0xe5, 0x5d,
-	0x7a, 0x25, 0x8e, 0x22, 0xc1, 0x9e, 0xd3, 0xe6, 0x53, 0x50, 0x9c, 0xd1, 0x42, 0xff, 0x98, 0x06,
-	0x28, 0x9d, 0x30, 0x04, 0x79, 0x30, 0x2c, 0xf6, 0x6f, 0xb8, 0x4a, 0x8b, 0x05, 0xbd, 0xe9, 0x14,
-	0xd7, 0xd0, 0xd8, 0x08, 0x4f, 0x14, 0xf8, 0x38, 0xa2, 0xa3, 0xff, 0x95, 0x06, 0x71, 0x3a, 0x3f,
-	0xf4, 0x66, 0x18, 0xad, 0x13, 0xdf, 0xf4, 0xac, 0x56, 0x10, 0x3b, 0x92, 0x46, 0x0e, 0x69, 0x8b,
-	0x31, 0x08, 0xcb, 0xf5, 0x90, 0x0e, 0x83, 0x81, 0xe1, 0xdf, 0xad, 0x2e, 0x8a, 0xcb, 0x1e, 0x3b,
-	0x9a, 0xd7, 0x59, 0x09, 0x16, 0x90, 0x38, 0xba, 0x6b, 0x5f, 0x17, 0xd1, 0x5d, 0xd1, 0xd6, 0x31,
-	0x84, 0xb2, 0x45, 0x87, 0x87, 0xb1, 0xd5, 0x7f, 0xba, 0x04, 0x67, 0x68, 0x95, 0x15, 0xc3, 0x72,
-	0x02, 0xe2, 0x30, 0xb7, 0xa9, 0x82, 0x93, 0xd0, 0x80, 0xf1, 0x40, 0xf1, 0x2b, 0x3e, 0xba, 0x53,
-	0x6d, 0x64, 0xe9, 0xa5, 0x7a, 0x13, 0xab, 0x78, 0xd1, 0xdb, 0x42, 0xbf, 0x35, 0x7e, 0x2d, 0x7e,
-	0x28, 0xdc, 0xaa, 0xcc, 0x19, 0xed, 0xbe, 0x70, 0xd2, 0x8e, 0x72, 0x40, 0x2a, 0x2e, 0x6a, 0x8f,
-	0xc1, 0xb8, 0xf0, 0x90, 0xe0, 0x61, 0x7a, 0xc5, 0xb5, 0x98, 0x1d, 0x2b, 0x37, 0x64, 0x00, 0x56,
-	0xeb, 0xe9, 0xbf, 0x5b, 0x02, 0x35, 0xd3, 0x64, 0xd1, 0x59, 0x4a, 0xc7, 0x28, 0x2e, 0x9d, 0x58,
-	0x8c, 0x62, 0x9e, 0x6b, 0x9d, 0x99, 0x73, 0x8b, 0x77, 0x76, 0x39, 0xcf, 0x32, 0x2b, 0xc7, 0x51,
-	0x8d, 0x78, 0x5a, 0xfb, 0x8f, 0x3c, 0xad, 0x6f, 0x16, 0x96, 0xbf, 0x03, 0x4a, 0xa4, 0xe8, 0xd0,
-	0xf2, 0x77, 0x4a, 0x69, 0x28, 0x79, 0xd9, 0xcd, 0x83, 0xc8, 0x38, 0x42, 0xd7, 0x45, 0x44, 0xb1,
-	0xf6, 0xd7, 0xdd, 0xc0, 0xb0, 0xd9, 0x74, 0x0a, 0xa5, 0xeb, 0x8a, 0x0c, 0xc0, 0x6a, 0x3d, 0x7d,
-	0x15, 0x5e, 0xbd, 0xec, 0x1a, 0xf5, 0x05, 0xc3, 0xa6, 0x5b, 0xd7, 0x13, 0x66, 0x79, 0x3e, 0x3b,
-	0x99, 0xd7, 0x3c, 0x37, 0x70, 0x4d, 0xd7, 0xa6, 0xe7, 0xa6, 0x11, 0x85, 0xf3, 0x65, 0x0f, 0x91,
-	0xe1, 0xb9, 0x29, 0xc2, 0xe1, 0xe2, 0x10, 0xae, 0xff, 0xba, 0x06, 0x43, 0x22, 0x71, 0x4f, 0x17,
-	0x8e, 0xa5, 0x5b, 0x30, 0xc0, 0x6e, 0x47, 0xbd, 0x48, 0xa5, 0xb5, 0x6d, 0xd7, 0x0d, 0x94, 0xf4,
-	0x45, 0x3c, 0x01, 0x18, 0x4b, 0x76, 0xc9, 0xd1, 0x33, 0x7b, 0x54, 0xcf, 0xdc, 0xb6, 0x02, 0xc2,
-	0xcc, 0x6e, 0xc4, 0xc6, 0xe7, 0xf6, 0xa8, 0x52, 0x39, 0x56, 0x6a, 0xe9, 0xff, 0x73, 0x00, 0xae,
-	0x08, 0xc4, 0x29, 0x51, 0x2d, 0xe2, 0xb9, 0x7b, 0x70, 0x56, 0x6c, 0x37, 0x16, 0xb7, 0x3e, 0xd4,
-	0xa9, 0x16, 0xbb, 0x25, 0x8b, 0xb8, 0xf8, 0x29, 0x74, 0x38, 0x8b, 0x06, 0x0f, 0x90, 0xce, 0x8a,
-	0x6f, 0x11, 0xc3, 0x0e, 0xb6, 0x43, 0xda, 0xa5, 0x5e, 0x02, 0xa4, 0xa7, 0xf1, 0xe1, 0x4c, 0x2a,
-	0xcc, 0xa4, 0x43, 0x00, 0x2a, 0x1e, 0x31, 0x64, 0x7b, 0x92, 0x1e, 0xdc, 0x8d, 0x56, 0x32, 0x31,
-	0xe2, 0x1c, 0x4a, 0x4c, 0xdd, 0x68, 0xec, 0x32, 0xed, 0x05, 0x26, 0x81, 0x67, 0xb1, 0x34, 0x54,
-	0xd1, 0x5b, 0xc5, 0x8a, 0x0a, 0xc2, 0xc9, 0xba, 0xe8, 0x71, 0x98, 0x60, 0x26, 0x32, 0x71, 0xc4,
-	0xcd, 0x81, 0x38, 0xee, 0xd2, 0xaa, 0x02, 0xc1, 0x89, 0x9a, 0xe8, 0x7b, 0x34, 0x98, 0xb6, 0x64,
-	0x67, 0x9a, 0x70, 0xf4, 0xc5, 0x92, 0x97, 0xb0, 0xe7, 0xa5, 0x70, 0x1b, 0x67, 0xa0, 0xc5, 0x99,
-	0xc4, 0xd0, 0x32, 0x4c, 0x0b, 0x8f, 0x68, 0x75, 0x0f, 0xf0, 0x70, 0x6c, 0x6c, 0x4d, 0x17, 0x33,
-	0xe0, 0x38, 0xb3, 0x95, 0xfe, 0xe1, 0x12, 0x8c, 0x1d, 0x31, 0x19, 0x6b, 0x5b, 0x92, 0x39, 0x7a,
-	0xf0, 0x5b, 0x94, 0xa9, 0x76, 0x21, 0x76, 0xa0, 0x67, 0x60, 0xa2, 0xcd, 0x26, 0x22, 0x0c, 0x56,
-	0x26, 0xbe, 0xe9, 0x6f, 0xa3, 0x2b, 0xb7, 0xa1, 0x40, 0xee, 0xef, 0x97, 0x67, 0x65, 0xf4, 0x2a,
-	0x14, 0x27, 0xf0, 0xe8, 0x3f, 0x34, 0x00, 0x67, 0x33, 0x7a, 0xc3, 0xcc, 0x43, 0x48, 0x42, 0x32,
-	0xea, 0xc5, 0x3c, 0x24, 0x25, 0x65, 0x45, 0xe6, 0x21, 0x49, 0x08, 0x4e, 0xd1, 0x45, 0x4f, 0x41,
-	0x9f, 0xe9, 0x59, 0x62, 0xc2, 0x1f, 0x2b, 0x74, 0x99, 0xc7, 0xd5, 0x85, 0x51, 0x41, 0xb1, 0xaf,
-	0x82, 0xab, 0x98, 0x22, 0xa4, 0xe7, 0x88, 0xcc, 0x02, 0x43, 0x61, 0x8b, 0x9d, 0x23, 0x32, 0xa7,
-	0xf4, 0xb1, 0x5a, 0x0f, 0x3d, 0x03, 0x33, 0xe2, 0x96, 0x15, 0x46, 0xe1, 0x70, 0x1d, 0x3f, 0xa0,
-	0xdc, 0x2a, 0x10, 0xe7, 0x21, 0xb3, 0xdc, 0xbc, 0x9d, 0x53, 0x07, 0xe7, 0xb6, 0x46, 0xdf, 0x0d,
-	0x13, 0xca, 0xce, 0x0f, 0x83, 0x5e, 0x15, 0x74, 0xf7, 0x90, 0x31, 0xf1, 0xef, 0x5c, 0x2d, 0xc3,
-	0x09, 0x6a, 0x2c, 0xe4, 0xa9, 0x29, 0x67, 0xd4, 0x0c, 0x13, 0xc1, 0xcd, 0xf7, 0x9c, 0x9b, 0x33,
-	0x16, 0x44, 0x94, 0x62, 0x1f, 0x27, 0x08, 0xea, 0x3f, 0x32, 0x08, 0x72, 0x1e, 0x65, 0xb4, 0xd2,
-	0x8b, 0x16, 0x2d, 0x5e, 0xf5, 0x50, 0x93, 0xb6, 0x02, 0x7d, 0x8d, 0x56, 0xbb, 0xa0, 0x1a, 0x2d,
-	0x42, 0x77, 0x93, 0xa2, 0x6b, 0xb4, 0xda, 0xe8, 0xa9, 0x48, 0x31, 0x57, 0x4c, 0x75, 0x16, 0x39,
-	0xf6, 0x25, 0x94, 0x73, 0x21, 0x33, 0xea, 0xcf, 0x65, 0x46, 0x4d, 0x18, 0xf2, 0x85, 0xd6, 0x6e,
-	0xa0, 0x78, 0x5c, 0x42, 0x69, 0xa6, 0x85, 0x96, 0x8e, 0xeb, 0x13, 0x42, 0x25, 0x5e, 0x48, 0x83,
-	0x5e, 0x5b, 0xda, 0x8c, 0x8b, 0x32, 0x9e, 0x3f, 0xcc, 0xaf, 0x2d, 0x1b, 0xac, 0x04, 0x0b, 0x48,
-	0x4a, 0xf4, 0x18, 0xea, 0x46, 0xf4, 0x40, 0x9f, 0x4b, 0x66, 0x8e, 0x1b, 0x66, 0x5b, 0xee, 0xdd,
-	0x3d, 0x0e, 0xe7, 0x6f, 0x58, 0x32, 0xd8, 0xbf, 0x53, 0x02, 0x94, 0x5e, 0x2c, 0xf4, 0x10, 0x0c,
-	0xb0, 0x98, 0x3f, 0xe2, 0xd4, 0x8a, 0xae, 0xe2, 0x2c, 0xea, 0x0b, 0xe6, 0x30, 0x54, 0x13, 0xb1,
-	0xe8, 0x8a, 0x6d, 0x7a, 0x66, 0x89, 0x27, 0xe8, 0x49, 0x81, 0xeb, 0xae, 0x28, 0x1e, 0x7c, 0x59,
-	0x12, 0xef, 0x06, 0x0c, 0x35, 0x2d, 0x87, 0x19, 0x27, 0x14, 0x53, 0xf9, 0x72, 0x83, 0x21, 0x8e,
-	0x02, 0x87, 0xb8, 0xf4, 0x3f, 0x2a, 0x51, 0x06, 0x11, 0x5f, 0x41, 0xf7, 0x78, 0x64, 0x55, 0xe1,
-	0x2b, 0xac, 0x15, 0x57, 0x59, 0x49, 0x48, 0xe7, 0x23, 0x84, 0xfc, 0x59, 0x3d, 0xfe, 0x8d, 0x25,
-	0x62, 0x94, 0x74, 0x60, 0x35, 0xc9, 0xd3, 0x96, 0x53, 0x77, 0xef, 0x89, 0xe9, 0xed, 0x95, 0xf4,
-	0x7a, 0x84, 0x90, 0x93, 0x8e, 0x7f, 0x63, 0x89, 0x18, 0x3d, 0x84, 0x58, 0x76, 0x07, 0x87, 0x65,
-	0x71, 0x15, 0x7d, 0xe3, 0xd9, 0xad, 0x85, 0x95, 0x2c, 0x3b, 0x84, 0x2a, 0x39, 0x75, 0x70, 0x6e,
-	0x6b, 0xfd, 0x67, 0x35, 0x38, 0x97, 0x39, 0x15, 0xe8, 0x26, 0x4c, 0xc5, 0xc6, 0x9b, 0xb2, 0x58,
-	0x30, 0x1c, 0x27, 0xae, 0xbe, 0x9d, 0xac, 0x80, 0xd3, 0x6d, 0x78, 0xc2, 0xac, 0x94, 0xd8, 0x21,
-	0x2c, 0x3f, 0xe5, 0x8b, 0x81, 0x0c, 0xc6, 0x59, 0x6d, 0xf4, 0xf7, 0x2a, 0x9d, 0x8d, 0x27, 0x8b,
-	0x7e, 0x19, 0x9b, 0xa4, 0x11, 0x79, 0x50, 0x47, 0x5f, 0xc6, 0x02, 0x2d, 0xc4, 0x1c, 0x86, 0x2e,
-	0xc9, 0x81, 0x1d, 0x22, 0xee, 0x1e, 0x06, 0x77, 0xd0, 0xdf, 0x07, 0x17, 0x72, 0xac, 0x2d, 0xd0,
-	0x22, 0x8c, 0xf9, 0xf7, 0x8c, 0xd6, 0x02, 0xd9, 0x36, 0x76, 0x2c, 0x11, 0x46, 0x89, 0x1b, 0xe5,
-	0x8e, 0xd5, 0xa4, 0xf2, 0xfb, 0x89, 0xdf, 0x58, 0x69, 0xa5, 0x07, 0x00, 0xc2, 0x78, 0xdb, 0x72,
-	0x1a, 0x68, 0x0b, 0x86, 0x0d, 0x9b, 0x78, 0x41, 0x1c, 0xcd, 0xef, 0xdb, 0x0b, 0x69, 0xe5, 0x04,
-	0x0e, 0xee, 0xac, 0x14, 0xfe, 0xc2, 0x11, 0x6e, 0xfd, 0x1f, 0x6a, 0x70, 0x3e, 0x3b, 0x70, 0x4e,
-	0x17, 0x42, 0x70, 0x13, 0x46, 0xbd, 0xb8, 0x99, 0xd8, 0xf4, 0x6f, 0x91, 0x53, 0x06, 0x48, 0x6e,
-	0x16, 0x54, 0xec, 0xaf, 0x78, 0xae, 0x1f, 0xae, 0x7c, 0x32, 0x8b, 0x40, 0xa4, 0x03, 0x91, 0x7a,
-	0x82, 0x65, 0xfc, 0x2c, 0xa3, 0x07, 0xa5, 0xee, 0xb7, 0x0c, 0x93, 0xd4, 0x4f, 0x39, 0x9f, 0xf5,
-	0x31, 0x84, 0xd1, 0xcf, 0xee, 0xfb, 0xc9, 0x66, 0xf4, 0xc8, 0xa1, 0x79, 0x78, 0x46, 0x8f, 0xec,
-	0x86, 0xaf, 0x90, 0x50, 0xf3, 0xd9, 0x9d, 0xcf, 0x71, 0x73, 0xfe, 0xf3, 0xc1, 0xbc, 0xd1, 0x1e,
-	0x31, 0x29, 0xf6, 0xce, 0x09, 0x26, 0xc5, 0x9e, 0xf8, 0x66, 0x42, 0xec, 0x8c, 0x84, 0xd8, 0x89,
-	0x24, 0xcd, 0x83, 0xa7, 0x94, 0xa4, 0xf9, 0x05, 0x18, 0x6c, 0x19, 0x1e, 0x71, 0xc2, 0x97, 0xbb,
-	0x6a, 0xaf, 0x19, 0xe0, 0x63, 0x2e, 0x18, 0x7d, 0x92, 0x6b, 0x8c, 0x00, 0x16, 0x84, 0x32, 0x42,
-	0x65, 0x0c, 0x9f, 0x54, 0x2c, 0xba, 0x38, 0x3d, 0xf4, 0xc8, 0x49, 0xa4, 0x87, 0xd6, 0xff, 0x52,
-	0x83, 0x8b, 0x9d, 0xd8, 0x12, 0x53, 0x39, 0x98, 0x89, 0xcf, 0xb0, 0x17, 0x95, 0x43, 0x8a, 0xdb,
-	0x46, 0x2a, 0x87, 0x24, 0x04, 0xa7, 0xe8, 0xa2, 0x77, 0x01, 0x72, 0x37, 0xb9, 0x15, 0xc7, 0x4d,
-	0x4a, 0x83, 0xfb, 0x4e, 0x96, 0x98, 0x65, 0x7a, 0x94, 0x68, 0xf0, 0x4e, 0xaa, 0x06, 0xce, 0x68,
-	0xa5, 0xff, 0x52, 0x09, 0x60, 0x95, 0x04, 0xf7, 0x5c, 0xef, 0x2e, 0x3d, 0xe3, 0x2f, 0x2a, 0x8a,
-	0xe2, 0xe1, 0xaf, 0x5f, 0xf4, 0xc1, 0x8b, 0xd0, 0xdf, 0x72, 0xeb, 0xbe, 0x90, 0xdf, 0x59, 0x47,
-	0x98, 0x61, 0x3e, 0x2b, 0x45, 0x65, 0x18, 0x60, 0x26, 0x2e, 0xe2, 0x02, 0xca, 0xd4, 0xcc, 0x4c,
-	0x95, 0x8e, 0x79, 0x39, 0xe5, 0x90, 0xc2, 0x83, 0xdd, 0x17, 0xaa, 0xfc, 0x31, 0x1e, 0xd4, 0x99,
-	0x97, 0xe1, 0x08, 0x8a, 0x1e, 0x07, 0xb0, 0x5a, 0x37, 0x8c, 0xa6, 0x65, 0x5b, 0xe2, 0x73, 0x1d,
-	0x61, 0xfa, 0x4f, 0xa8, 0xae, 0x85, 0xa5, 0xf7, 0xf7, 0xcb, 0xc3, 0xe2, 0xd7, 0x1e, 0x96, 0x6a,
-	0xeb, 0x5f, 0xd4, 0x60, 0x32, 0x9e, 0x3c, 0xb1, 0x55, 0xc2, 0x9e, 0xf3, 0xd0, 0xaf, 0xb9, 0x3d,
-	0xe7, 0x71, 0xd4, 0x3b, 0xf7, 0x9c, 0xab, 0x7c, 0xf2, 0x7a, 0xfe, 0x06, 0x18, 0x25, 0x3c, 0xc0,
-	0x4d, 0x75, 0x11, 0x73, 0x1e, 0x37, 0xc2, 0xaf, 0x43, 0x4b, 0x71, 0x31, 0x96, 0xeb, 0xe8, 0x7f,
-	0xdd, 0x07, 0x63, 0xab, 0x0d, 0xcb, 0xd9, 0x0d, 0x23, 0xf9, 0x44, 0xcf, 0xac, 0xda, 0xc9, 0x3c,
-	0xb3, 0x3e, 0x03, 0x33, 0xb6, 0xfc, 0xa8, 0xc1, 0x05, 0x27, 0xc3, 0x69, 0x44, 0x33, 0xc0, 0xee,
-	0x01, 0xcb, 0x39, 0x75, 0x70, 0x6e, 0x6b, 0x14, 0xc0, 0xa0, 0x19, 0x26, 0xbc, 0x2b, 0x1c, 0x9d,
-	0x46, 0x9e, 0x8b, 0x39, 0x39, 0x50, 0x43, 0xc4, 0xf3, 0xc4, 0xf6, 0x14, 0xb4, 0xd0, 0x47, 0x34,
-	0x38, 0x47, 0x76, 0x79, 0xa0, 0x92, 0x75, 0xcf, 0xd8, 0xda, 0xb2, 0x4c, 0xe1, 0xdf, 0xc5, 0x77,
-	0xe2, 0xf2, 0xc1, 0x7e, 0xf9, 0xdc, 0x52, 0x56, 0x85, 0xfb, 0xfb, 0xe5, 0x6b, 0x99, 0x71, 0x63,
-	0xd8, 0x6a, 0x66, 0x36, 0xc1, 0xd9, 0xa4, 0x66, 0xdf, 0x06, 0xa3, 0x47, 0xf0, 0x0a, 0x56, 0xa2,
-	0xc3, 0xfc, 0x72, 0x09, 0xc6, 0xe8, 0x76, 0x5b, 0x76, 0x4d, 0xc3, 0x5e, 0x5c, 0xad, 0xa1, 0x47,
-	0x92, 0x41, 0xf1, 0xa2, 0x07, 0xa5, 0x54, 0x60, 0xbc, 0x65, 0x98, 0xde, 0x72, 0x3d, 0x93, 0xac,
-	0x57, 0xd6, 0xd6, 0x5d, 0x61, 0x6a, 0xb4, 0xb8, 0x5a, 0x13, 0xf7, 0x22, 0xa6, 0xe0, 0xbe, 0x91,
-	0x01, 0xc7, 0x99, 0xad, 0xd0, 0x1d, 0x38, 0x17, 0x97, 0x6f, 0xb4, 0xb8, 0x79, 0x3a, 0x45, 0xd7,
-	0x17, 0x9b, 0xd7, 0xdf, 0xc8, 0xaa, 0x80, 0xb3, 0xdb, 0x21, 0x03, 0x1e, 0x14, 0x9a, 0xf4, 0x1b,
-	0xae, 0x77, 0xcf, 0xf0, 0xea, 0x2a, 0xda, 0xfe, 0x38, 0x79, 0xf4, 0x62, 0x7e, 0x35, 0xdc, 0x09,
-	0x87, 0xfe, 0x57, 0x1a, 0xa8, 0x21, 0x07, 0xd1, 0x03, 0xd0, 0xe7, 0x89, 0x7c, 0x6a, 0x22, 0xf4,
-	0x1e, 0xbd, 0x22, 0xd0, 0x32, 0x34, 0x07, 0xe0, 0xc5, 0x71, 0x0f, 0x4b, 0x71, 0x9e, 0x01, 0x29,
-	0x62, 0xa1, 0x54, 0x83, 0xa2, 0x0a, 0x8c, 0x86, 0x60, 0x78, 0x0c, 0xd5, 0xba, 0xd1, 0xc0, 0xb4,
-	0x8c, 0x25, 0x94, 0xb0, 0x1a, 0xc4, 0x0f, 0x15, 0xb8, 0x3c, 0xa1, 0x04, 0x2b, 0xc1, 0x02, 0x82,
-	0x0c, 0x18, 0x6f, 0xb5, 0x6d, 0x11, 0x3d, 0x87, 0x5e, 0x7d, 0xb8, 0xda, 0xed, 0x6a, 0x56, 0xb6,
-	0x34, 0xb6, 0xfa, 0x99, 0x29, 0xd3, 0xd6, 0x64, 0x14, 0x58, 0xc5, 0xa8, 0xff, 0xf8, 0x20, 0x48,
-	0xc1, 0x54, 0x8e, 0x20, 0x85, 0xfe, 0x94, 0x06, 0xd3, 0xa6, 0x6d, 0x11, 0x27, 0x48, 0xc4, 0x25,
-	0xe0, 0xc7, 0xc7, 0x46, 0xa1, 0x28, 0x2f, 0x2d, 0xe2, 0x54, 0x17, 0x85, 0x33, 0x43, 0x25, 0x03,
-	0xb9, 0x70, 0xf8, 0xc8, 0x80, 0xe0, 0xcc, 0xce, 0xb0, 0xf1, 0xb0, 0xf2, 0xea, 0xa2, 0x1c, 0x2b,
-	0xb1, 0x22, 0xca, 0x70, 0x04, 0xa5, 0x9c, 0xb7, 0xe1, 0xb9, 0xed, 0x96, 0x5f, 0x61, 0x3e, 0x8b,
-	0x7c, 0x51, 0x18, 0xe7, 0xbd, 0x19, 0x17, 0x63, 0xb9, 0x0e, 0x7a, 0x13, 0x8c, 0xf1, 0x9f, 0x6b,
-	0x1e, 0xd9, 0xb2, 0x76, 0xc5, 0xa1, 0xc4, 0x94, 0x8f, 0x37, 0xa5, 0x72, 0xac, 0xd4, 0x62, 0xd1,
-	0xba, 0x7c, 0xbf, 0x4d, 0xbc, 0x0d, 0xbc, 0x2c, 0x92, 0xee, 0xf2, 0x68, 0x5d, 0x61, 0x21, 0x8e,
-	0xe1, 0xe8, 0x87, 0x35, 0x98, 0xf0, 0xc8, 0x0b, 0x6d, 0xcb, 0xa3, 0x22, 0x8c, 0x61, 0x35, 0x7d,
-	0x11, 0xd1, 0x06, 0xf7, 0x16, 0x45, 0x67, 0x0e, 0x2b, 0x48, 0x39, 0x83, 0x8c, 0xf4, 0xe5, 0x2a,
-	0x10, 0x27, 0x7a, 0x40, 0xa7, 0xca, 0xb7, 0x1a, 0x8e, 0xe5, 0x34, 0xe6, 0xed, 0x86, 0x2f, 0x32,
-	0x0b, 0x71, 0x9d, 0x5d, 0x5c, 0x8c, 0xe5, 0x3a, 0xe8, 0x31, 0x18, 0x6f, 0xfb, 0x94, 0xed, 0x35,
-	0x09, 0x9f, 0xdf, 0x91, 0xd8, 0xb2, 0x61, 0x43, 0x06, 0x60, 0xb5, 0x1e, 0x7a, 0x1c, 0x26, 0xc2,
-	0x02, 0x31, 0xcb, 0xc0, 0xd3, 0x5b, 0xb0, 0x97, 0x28, 0x05, 0x82, 0x13, 0x35, 0x67, 0xe7, 0xe1,
-	0x6c, 0xc6, 0x30, 0x8f, 0xc4, 0x5b, 0xff, 0x9f, 0x06, 0xe7, 0xb8, 0xd4, 0x15, 0xa6, 0xeb, 0x0d,
-	0x93, 0x0d, 0x64, 0xc7, 0xed, 0xd7, 0x4e, 0x34, 0x6e, 0xff, 0xd7, 0x21, 0x3f, 0x81, 0xfe, 0x33,
-	0x25, 0x78, 0xf5, 0xa1, 0xdf, 0x25, 0xfa, 0x09, 0x0d, 0x46, 0xc9, 0x6e, 0xe0, 0x19, 0x91, 0x63,
-	0x37, 0xdd, 0xa4, 0x5b, 0x27, 0xc2, 0x04, 0xe6, 0x96, 0x62, 0x42, 0x7c, 0xe3, 0x46, 0x57, 0x29,
-	0x09, 0x82, 0xe5, 0xfe, 0x50, 0x6e, 0xcb, 0xd3, 0xbf, 0xc8, 0x26, 0x50, 0x82, 0x0b, 0x0a, 0xc8,
-	0xec, 0x3b, 0x60, 0x32, 0x89, 0xf9, 0x48, 0x7b, 0xe5, 0x0b, 0x1a, 0x64, 0x06, 0x5f, 0x44, 0x15,
-	0x98, 0x32, 0xda, 0x81, 0xab, 0xbc, 0x84, 0x09, 0x51, 0x92, 0x99, 0xf8, 0xce, 0x27, 0x81, 0x38,
-	0x5d, 0x9f, 0x2b, 0x30, 0x9d, 0xb6, 0x61, 0xab, 0x68, 0xb8, 0xc0, 0x25, 0x14, 0x98, 0x29, 0x30,
-	0xce, 0x6a, 0xa3, 0xff, 0x13, 0x0d, 0xce, 0x29, 0x1d, 0xf5, 0x85, 0x22, 0xb6, 0x0b, 0x5d, 0x5c,
-	0xf6, 0xb6, 0x2f, 0x9d, 0xe4, 0xb6, 0xd7, 0x7f, 0xb1, 0x04, 0x43, 0x6b, 0x9e, 0x4b, 0x8f, 0xb6,
-	0x53, 0xd0, 0xc0, 0x19, 0x8a, 0x06, 0xae, 0x90, 0x7e, 0x41, 0x74, 0x36, 0x57, 0xe5, 0x66, 0x25,
-	0x54, 0x6e, 0xf3, 0xbd, 0x10, 0xe9, 0xac, 0x63, 0xfb, 0x2d, 0x0d, 0x46, 0x45, 0xcd, 0x53, 0x50,
-	0xaa, 0x7d, 0x97, 0xaa, 0x54, 0x7b, 0x7b, 0x0f, 0xe3, 0xca, 0xd1, 0xa2, 0x7d, 0x56, 0x83, 0x71,
-	0x51, 0x63, 0x85, 0x34, 0x37, 0x89, 0x87, 0x6e, 0xc0, 0x90, 0xdf, 0x66, 0x0b, 0x29, 0x06, 0xf4,
-	0xa0, 0x2c, 0x1e, 0x79, 0x9b, 0x86, 0x49, 0xbb, 0x5f, 0xe3, 0x55, 0xa4, 0xd4, 0xb4, 0xbc, 0x00,
-	0x87, 0x8d, 0xe9, 0xde, 0xf7, 0x5c, 0x3b, 0x15, 0xa4, 0x1c, 0xbb, 0x36, 0xc1, 0x0c, 0x42, 0xef,
-	0x79, 0xf4, 0x6f, 0x78, 0x87, 0x63, 0xf7, 0x3c, 0x0a, 0xf6, 0x31, 0x2f, 0xd7, 0xbf, 0x38, 0x10,
-	0x4d, 0x36, 0xbb, 0xd4, 0xdf, 0x82, 0x11, 0xd3, 0x23, 0x46, 0x40, 0xea, 0x0b, 0x7b, 0xdd, 0x74,
-	0x8e, 0xc9, 0x01, 0x95, 0xb0, 0x05, 0x8e, 0x1b, 0xd3, 0x23, 0x57, 0x36, 0xe7, 0x2b, 0xc5, 0xd2,
-	0x49, 0xae, 0x29, 0xdf, 0xb7, 0xc3, 0x80, 0x7b, 0xcf, 0x89, 0x5c, 0x01, 0x3a, 0x12, 0x66, 0x43,
-	0xb9, 0x43, 0x6b, 0x63, 0xde, 0x48, 0x0e, 0xd2, 0xdf, 0xdf, 0x21, 0x48, 0xbf, 0x0d, 0x43, 0x4d,
-	0xb6, 0x0c, 0x3d, 0x65, 0x2a, 0x55, 0x16, 0x34, 0x5e, 0x22, 0xfe, 0xdb, 0xc7, 0x21, 0x09, 0x2a,
-	0x3a, 0x39, 0xa1, 0x46, 0x47, 0x16, 0x9d, 0x22, 0x35, 0x0f, 0x8e, 0xe1, 0x68, 0x4f, 0xcd, 0xfe,
-	0x30, 0x54, 0x5c, 0x4f, 0x2a, 0xba, 0x27, 0x25, 0x7c, 0xe0, 0x53, 0x9f, 0x97, 0x01, 0x02, 0x7d,
-	0x41, 0x83, 0x0b, 0xf5, 0xec, 0x0c, 0x58, 0xe2, 0xa9, 0xb9, 0x90, 0x1b, 0x6e, 0x4e, 0x52, 0xad,
-	0x85, 0xb2, 0x98, 0xb0, 0xbc, 0xac, 0x5b, 0x38, 0xaf, 0x33, 0xfa, 0xf7, 0xf7, 0x47, 0x5f, 0x93,
-	0xd0, 0x74, 0x64, 0xeb, 0xa1, 0xb4, 0x22, 0x7a, 0x28, 0xf4, 0xc6, 0x30, 0x1f, 0x13, 0xdf, 0xae,
-	0x97, 0x92, 0xf9, 0x98, 0xc6, 0x04, 0x69, 0x25, 0x07, 0x53, 0x1b, 0xce, 0xfa, 0x81, 0x61, 0x93,
-	0x9a, 0x25, 0x1e, 0xd7, 0xfc, 0xc0, 0x68, 0xb6, 0x0a, 0x24, 0x44, 0xe2, 0x6e, 0xf9, 0x69, 0x54,
-	0x38, 0x0b, 0x3f, 0xfa, 0x5e, 0x16, 0x6a, 0xcd, 0xb0, 0xd9, 0xe3, 0x23, 0x4f, 0x0a, 0x19, 0x13,
-	0x3f, 0xba, 0x71, 0xb3, 0x08, 0xa4, 0x96, 0x8d, 0x0f, 0xe7, 0x52, 0x42, 0x2f, 0xc1, 0x39, 0x7a,
-	0x00, 0xce, 0x9b, 0x81, 0xb5, 0x63, 0x05, 0x7b, 0x71, 0x17, 0x8e, 0x9e, 0x05, 0x89, 0xdd, 0xb6,
-	0x97, 0xb3, 0x90, 0xe1, 0x6c, 0x1a, 0xfa, 0x5f, 0x68, 0x80, 0xd2, 0x7b, 0x1d, 0xd9, 0x30, 0x5c,
-	0x0f, 0xfd, 0xe4, 0xb5, 0x63, 0xc9, 0xa1, 0x12, 0x1d, 0x21, 0x91, 0x7b, 0x7d, 0x44, 0x01, 0xb9,
-	0x30, 0x72, 0x6f, 0xdb, 0x0a, 0x88, 0x6d, 0xf9, 0xc1, 0x31, 0xa5, 0x6c, 0x89, 0x22, 0x80, 0x3f,
-	0x1d, 0x22, 0xc6, 0x31, 0x0d, 0xfd, 0x93, 0xfd, 0x30, 0x1c, 0x65, 0x37, 0x3c, 0xdc, 0xa8, 0xb6,
-	0x0d, 0xc8, 0x94, 0xc2, 0xbf, 0xf5, 0xa2, 0x33, 0x65, 0x32, 0x50, 0x25, 0x85, 0x0c, 0x67, 0x10,
-	0x40, 0x2f, 0xc1, 0xb4, 0xe5, 0x6c, 0x79, 0x46, 0x14, 0xdc, 0xae, 0x12, 0x2a, 0xca, 0x0a, 0x10,
-	0x66, 0xb7, 0xe8, 0x6a, 0x06, 0x3a, 0x9c, 0x49, 0x04, 0x11, 0x18, 0xe2, 0x09, 0x66, 0xc3, 0x57,
-	0x97, 0x42, 0xba, 0x7a, 0x2e, 0x6b, 0xc6, 0xec, 0x3d, 0x94, 0x3d, 0x43, 0xdc, 0x3c, 0x14, 0x29,
-	0xff, 0x3f, 0x7c, 0x90, 0x12, 0xfb, 0xbe, 0x52, 0x9c, 0x5e, 0xfc, 0xb6, 0xc5, 0x43, 0x91, 0xaa,
-	0x85, 0x38, 0x49, 0x50, 0xff, 0x0d, 0x0d, 0x06, 0x78, 0xc4, 0xa7, 0x93, 0x17, 0x35, 0xdf, 0xa7,
-	0x88, 0x9a, 0x85, 0x12, 0xd1, 0xb3, 0xae, 0xe6, 0xa6, 0x48, 0xff, 0x75, 0x0d, 0x46, 0x58, 0x8d,
-	0x53, 0x90, 0xfd, 0x9e, 0x53, 0x65, 0xbf, 0xb7, 0x15, 0x1e, 0x4d, 0x8e, 0xe4, 0xf7, 0x1b, 0x7d,
-	0x62, 0x2c, 0x4c, 0xb4, 0xaa, 0xc2, 0x59, 0xe1, 0x06, 0xb9, 0x6c, 0x6d, 0x11, 0xba, 0xc5, 0x17,
-	0x8d, 0x3d, 0x5f, 0x18, 0xe6, 0xf3, 0x10, 0x23, 0x69, 0x30, 0xce, 0x6a, 0x83, 0x7e, 0x59, 0xa3,
-	0x42, 0x4c, 0xe0, 0x59, 0x66, 0x4f, 0x8f, 0xc1, 0x51, 0xdf, 0xe6, 0x56, 0x38, 0x32, 0x7e, 0x37,
-	0xdd, 0x88, 0xa5, 0x19, 0x56, 0x7a, 0x7f, 0xbf, 0x5c, 0xce, 0xd0, 0x19, 0xc7, 0x69, 0x82, 0xfd,
-	0xe0, 0x23, 0x7f, 0xdc, 0xb1, 0x0a, 0xbb, 0x8d, 0x85, 0x3d, 0x46, 0xb7, 0x60, 0xc0, 0x37, 0xdd,
-	0x56, 0xe8, 0x48, 0xfb, 0x50, 0x96, 0x6e, 0x30, 0xa9, 0x16, 0x8c, 0x26, 0xb8, 0x46, 0x5b, 0x62,
-	0x8e, 0x60, 0xf6, 0x79, 0x18, 0x93, 0x7b, 0x9e, 0x71, 0xf7, 0x5d, 0x54, 0x2d, 0xce, 0x8e, 0x68,
-	0x5c, 0x25, 0xdf, 0x95, 0x7f, 0xaf, 0x0f, 0x06, 0x31, 0x69, 0x88, 0x04, 0x59, 0x87, 0xdc, 0x39,
-	0xad, 0x30, 0x1f, 0x6b, 0xa9, 0xb8, 0xd7, 0x95, 0x9c, 0x02, 0xe5, 0x59, 0xd7, 0x91, 0xe6, 0x40,
-	0x4e, 0xc9, 0x8a, 0x9c, 0x28, 0x6d, 0x50, 0x5f, 0xf1, 0x6c, 0xef, 0x7c, 0x60, 0xdd, 0x24, 0x0a,
-	0x42, 0x3f, 0xa4, 0x01, 0x32, 0x4c, 0x93, 0xf8, 0x3e, 0x26, 0x3e, 0x9d, 0x7b, 0x2e, 0xac, 0x72,
-	0x2e, 0x5b, 0x2c, 0x06, 0x72, 0x12, 0x5b, 0x2c, 0xb6, 0xa5, 0x40, 0x3e, 0xce, 0x20, 0xde, 0x4b,
-	0xf2, 0xa2, 0xdf, 0xd6, 0x60, 0x4c, 0xc9, 0x0d, 0xd5, 0x8c, 0x75, 0xe9, 0xc5, 0x4d, 0x76, 0x42,
-	0x5f, 0x9f, 0x07, 0x3b, 0x54, 0xe2, 0xfa, 0xf9, 0x3b, 0x51, 0x72, 0x83, 0xe3, 0x49, 0x23, 0xa5,
-	0x7f, 0x5a, 0x83, 0xf3, 0xe1, 0x80, 0xd4, 0x28, 0xd6, 0xe8, 0x2a, 0x0c, 0x1b, 0x2d, 0x8b, 0x29,
-	0x7a, 0x65, 0x55, 0xf9, 0xfc, 0x5a, 0x95, 0x95, 0xe1, 0x08, 0xaa, 0x24, 0xbd, 0x2d, 0x1d, 0x9a,
-	0xf4, 0xf6, 0x35, 0x52, 0x1a, 0xdf, 0x81, 0x58, 0x76, 0x89, 0x08, 0x73, 0x63, 0x48, 0xfd, 0x2d,
-	0x30, 0x52, 0xab, 0xdd, 0xe2, 0x4b, 0x7a, 0x84, 0x17, 0x1f, 0xfd, 0xe3, 0x7d, 0x30, 0x2e, 0xc2,
-	0xf1, 0x5b, 0x4c, 0x05, 0x74, 0x0a, 0xe7, 0xdc, 0x3a, 0x8c, 0xf8, 0xd1, 0x1b, 0x46, 0x29, 0x9f,
-	0x4f, 0x45, 0xcf, 0x12, 0xc9, 0x9c, 0x6a, 0xf1, 0xf3, 0x45, 0x8c, 0x08, 0xdd, 0x86, 0xc1, 0x17,
-	0x28, 0xcf, 0x0d, 0xbf, 0xd5, 0xae, 0x58, 0x5f, 0xf4, 0x21, 0x32, 0x76, 0xed, 0x63, 0x81, 0x02,
-	0xf9, 0xcc, 0x19, 0x8d, 0x09, 0x81, 0xbd, 0x04, 0x66, 0x54, 0x66, 0x36, 0x4a, 0xe2, 0x3d, 0x26,
-	0x7c, 0xda, 0xd8, 0x2f, 0x1c, 0x11, 0x62, 0x09, 0x21, 0x95, 0x16, 0xaf, 0x90, 0x84, 0x90, 0x4a,
-	0x9f, 0x73, 0x8e, 0xeb, 0xb7, 0xc1, 0xb9, 0xcc, 0xc9, 0x38, 0x5c, 0xc4, 0xd6, 0xff, 0x69, 0x09,
-	0xfa, 0x6b, 0x84, 0xd4, 0x4f, 0x61, 0x67, 0x3e, 0xa7, 0x48, 0x60, 0xdf, 0x5e, 0x38, 0x25, 0x65,
-	0x9e, 0xa6, 0x6f, 0x2b, 0xa1, 0xe9, 0x7b, 0x47, 0x61, 0x0a, 0x9d, 0xd5, 0x7c, 0x7f, 0xae, 0xc1,
-	0x10, 0xad, 0xb6, 0xb8, 0x5a, 0x43, 0x4d, 0x69, 0x2b, 0x97, 0x8a, 0x8b, 0xcf, 0x02, 0xdd, 0x61,
-	0x9b, 0x98, 0x7e, 0x39, 0x96, 0x48, 0xf3, 0x21, 0x06, 0x59, 0x3d, 0x06, 0x72, 0x22, 0xd2, 0x00,
-	0x23, 0x1a, 0x66, 0x11, 0xc1, 0x11, 0x21, 0xfd, 0xe3, 0x1a, 0x9c, 0x49, 0xb4, 0xe8, 0xe2, 0xee,
-	0x76, 0x22, 0x7c, 0x48, 0xff, 0x9a, 0x46, 0xb7, 0x7a, 0x46, 0xef, 0xbb, 0xe8, 0x51, 0x9c, 0x75,
-	0xa9, 0xd4, 0x31, 0xeb, 0xd2, 0x45, 0xe8, 0xa7, 0x02, 0x8a, 0x6c, 0x3a, 0x43, 0xe5, 0x16, 0xcc,
-	0x4a, 0x91, 0x09, 0x13, 0xa6, 0x94, 0xda, 0x9a, 0x6c, 0x09, 0x16, 0xd6, 0x15, 0x47, 0x8c, 0xbd,
-	0x64, 0x14, 0x14, 0x38, 0x81, 0x52, 0xff, 0x35, 0x0d, 0x86, 0xe9, 0x30, 0x4f, 0x81, 0x47, 0x7d,
-	0xa7, 0xca, 0xa3, 0xde, 0x5a, 0x74, 0x3f, 0xe5, 0xb0, 0xa6, 0x3f, 0x2b, 0x01, 0x4b, 0x1b, 0x2b,
-	0xcc, 0x7c, 0x24, 0x03, 0x1e, 0x2d, 0xc7, 0xf4, 0xe8, 0x8a, 0xb0, 0xff, 0x49, 0xe8, 0x86, 0x25,
-	0x1b, 0xa0, 0xd7, 0x29, 0x26, 0x3e, 0xca, 0x19, 0x9f, 0x61, 0xe6, 0xf3, 0x22, 0x8c, 0xfb, 0xdb,
-	0xae, 0x1b, 0x44, 0xf1, 0x07, 0xfb, 0x8b, 0xbf, 0x03, 0x30, 0x0f, 0xdd, 0x70, 0x28, 0xfc, 0x45,
-	0xb5, 0x26, 0xe3, 0xc6, 0x2a, 0x29, 0x34, 0x07, 0xb0, 0x69, 0xbb, 0xe6, 0x5d, 0x6e, 0x61, 0xc4,
-	0x3d, 0x32, 0x99, 0x0d, 0xc3, 0x42, 0x54, 0x8a, 0xa5, 0x1a, 0x3d, 0x19, 0x53, 0x7d, 0x4d, 0xe3,
-	0x33, 0x7d, 0x84, 0x6f, 0xf4, 0x14, 0xed, 0xd1, 0x5e, 0x0b, 0x83, 0x1e, 0x13, 0xd5, 0xc5, 0x8a,
-	0x45, 0x1f, 0x1f, 0x17, 0xe0, 0xb1, 0x80, 0xd2, 0xed, 0xc1, 0xef, 0x1f, 0xfd, 0xb1, 0xde, 0x5f,
-	0xbe, 0x35, 0xe8, 0xbf, 0x28, 0x86, 0x19, 0x65, 0x1e, 0x6e, 0xc1, 0x38, 0x13, 0xf0, 0x13, 0x29,
-	0x8f, 0xdf, 0xd8, 0xe5, 0x37, 0x22, 0x37, 0x8d, 0xad, 0x63, 0x95, 0x62, 0xac, 0x12, 0x40, 0x8f,
-	0xc1, 0x78, 0x38, 0x3a, 0x6e, 0xa4, 0x5a, 0x8a, 0x5d, 0x0b, 0xd7, 0x64, 0x00, 0x56, 0xeb, 0xe9,
-	0x9f, 0x29, 0xc1, 0x25, 0xde, 0x77, 0xa6, 0x00, 0x59, 0x24, 0x2d, 0xe2, 0xd4, 0x89, 0x63, 0xee,
-	0x31, 0x71, 0xb7, 0xee, 0x36, 0xd0, 0x4b, 0x30, 0x78, 0x8f, 0x90, 0x7a, 0xf4, 0x92, 0xf0, 0x74,
-	0xf1, 0xc4, 0xcd, 0x39, 0x24, 0x9e, 0x66, 0xe8, 0xf9, 0xa3, 0x2c, 0xff, 0x1f, 0x0b, 0x92, 0x94,
-	0x78, 0xcb, 0x73, 0x37, 0x23, 0xa9, 0xec, 0xf8, 0x89, 0xaf, 0x31, 0xf4, 0x9c, 0x38, 0xff, 0x1f,
-	0x0b, 0x92, 0xfa, 0x1a, 0x3c, 0xd4, 0x45, 0xd3, 0xa3, 0x48, 0xdf, 0x87, 0x61, 0xe4, 0xa3, 0x3f,
-	0x0a, 0xc6, 0x3f, 0xd0, 0xe0, 0x61, 0x09, 0xe5, 0xd2, 0x2e, 0xbd, 0x10, 0x54, 0x8c, 0x96, 0x61,
-	0xd2, 0x2b, 0x37, 0x0b, 0x0c, 0x76, 0xa4, 0x54, 0xa9, 0x1f, 0xd7, 0x60, 0x88, 0x1b, 0xc6, 0x85,
-	0xec, 0xf7, 0xb9, 0x1e, 0xa7, 0x3c, 0xb7, 0x4b, 0x61, 0x0a, 0xa9, 0x70, 0x6c, 0xfc, 0xb7, 0x8f,
-	0x43, 0xfa, 0xfa, 0xbf, 0x1b, 0x80, 0x6f, 0xed, 0x1e, 0x11, 0xfa, 0x9a, 0x96, 0x4c, 0xd3, 0x3f,
-	0x7a, 0xbd, 0x79, 0xb2, 0x9d, 0x8f, 0x94, 0x32, 0xe2, 0x9e, 0xff, 0x74, 0x2a, 0x0b, 0xf4, 0x31,
-	0xe9, 0x7b, 0xe2, 0x81, 0xa1, 0x7f, 0xa4, 0xc1, 0x18, 0x3d, 0x96, 0x22, 0xe6, 0xc2, 0x97, 0xa9,
-	0x75, 0xc2, 0x23, 0x5d, 0x95, 0x48, 0x26, 0x82, 0x09, 0xc9, 0x20, 0xac, 0xf4, 0x0d, 0x6d, 0xa8,
-	0xaf, 0x70, 0xfc, 0xa6, 0x76, 0x39, 0x4b, 0x2e, 0x39, 0x4a, 0x8e, 0xf5, 0x59, 0x1b, 0x26, 0xd4,
-	0x99, 0x3f, 0x49, 0x6d, 0xd5, 0xec, 0x93, 0x30, 0x95, 0x1a, 0xfd, 0x91, 0xf4, 0x22, 0x3f, 0x32,
-	0x00, 0x65, 0x69, 0xaa, 0xb3, 0x62, 0x82, 0xa0, 0xcf, 0x69, 0x30, 0x6a, 0x38, 0x8e, 0xb0, 0x2f,
-	0x0a, 0xf7, 0x6f, 0xbd, 0xc7, 0x55, 0xcd, 0x22, 0x35, 0x37, 0x1f, 0x93, 0x49, 0x18, 0xd0, 0x48,
-	0x10, 0x2c, 0xf7, 0xa6, 0x83, 0x91, 0x6c, 0xe9, 0xd4, 0x8c, 0x64, 0xd1, 0x07, 0xc2, 0x83, 0x98,
-	0x6f, 0xa3, 0x67, 0x4e, 0x60, 0x6e, 0xd8, 0xb9, 0x9e, 0xa3, 0x1c, 0xfc, 0x94, 0xc6, 0x0e, 0xd9,
-	0x38, 0x74, 0x8b, 0x38, 0x93, 0x0a, 0xd9, 0x3a, 0x1e, 0x1a, 0x17, 0x26, 0x3a, 0xbb, 0xe3, 0x22,
-	0xac, 0x92, 0x9f, 0x7d, 0x07, 0x4c, 0x26, 0x97, 0xf2, 0x48, 0xdb, 0xf2, 0xdf, 0xf6, 0x2b, 0x67,
-	0x47, 0xee, 0x7c, 0x74, 0xa1, 0xa3, 0x7d, 0x39, 0xb1, 0x7b, 0x39, 0x4f, 0xb2, 0x4e, 0x6a, 0x85,
-	0x8e, 0x77, 0x0b, 0xf7, 0x9d, 0xde, 0x16, 0xfe, 0x1b, 0xb7, 0x87, 0x16, 0xf8, 0xe5, 0x57, 0x2c,
-	0x58, 0x9c, 0x12, 0x86, 0x85, 0x03, 0xb4, 0x7c, 0x2b, 0x0c, 0x6a, 0x2b, 0xc9, 0x30, 0x4f, 0xf1,
-	0x62, 0x1c, 0xc2, 0xf5, 0x65, 0x85, 0x3b, 0xae, 0xbb, 0x2d, 0xd7, 0x76, 0x1b, 0x7b, 0xf3, 0xf7,
-	0x0c, 0x8f, 0x60, 0xb7, 0x1d, 0x08, 0x6c, 0xdd, 0x4a, 0x44, 0x1f, 0x1e, 0x80, 0x2b, 0x12, 0xba,
-	0xcc, 0xd8, 0x7f, 0x47, 0xb1, 0x91, 0x7f, 0x39, 0x19, 0xa2, 0xaf, 0x54, 0xdc, 0xb8, 0xf1, 0xb0,
-	0x7e, 0x15, 0x0b, 0xd9, 0x87, 0xf6, 0x35, 0x80, 0xa6, 0xb1, 0x2b, 0xe2, 0x45, 0x09, 0xf6, 0x58,
-	0x3f, 0x91, 0x0e, 0xae, 0x44, 0x64, 0x78, 0xf7, 0x9e, 0x09, 0xf5, 0x6b, 0x31, 0xe0, 0x98, 0x44,
-	0x1e, 0x69, 0x44, 0x3d, 0xc7, 0x24, 0x9c, 0x6d, 0xc2, 0x99, 0x44, 0xcf, 0x4f, 0xf4, 0x7d, 0xeb,
-	0xb7, 0x86, 0xc2, 0x1b, 0xa1, 0x08, 0x64, 0xf5, 0x0b, 0x1a, 0x3c, 0x40, 0xf2, 0x24, 0x2c, 0x71,
-	0x3d, 0x7c, 0xe6, 0xa4, 0x24, 0x38, 0x91, 0xd6, 0x27, 0x0f, 0x8c, 0xf3, 0x7b, 0x86, 0xf6, 0x00,
-	0xfc, 0xe8, 0x9b, 0xee, 0x25, 0xde, 0x40, 0x26, 0x93, 0x10, 0xb9, 0xe0, 0xa3, 0xdf, 0x58, 0x22,
-	0x86, 0x3e, 0xaf, 0xc1, 0xb4, 0x9d, 0xc1, 0xe1, 0x04, 0xc7, 0xac, 0x9d, 0xc0, 0xd9, 0xc2, 0x2d,
-	0x23, 0xb2, 0x20, 0x38, 0xb3, 0x2b, 0xe8, 0xa7, 0x73, 0x03, 0x99, 0x72, 0xc3, 0x85, 0xf5, 0x93,
-	0xf8, 0x06, 0x0b, 0xc4, 0x34, 0xfd, 0x8c, 0x06, 0xa8, 0x9e, 0xba, 0x6d, 0x0a, 0xa3, 0xb8, 0x77,
-	0x1f, 0xfb, 0x9d, 0x9a, 0x9b, 0xb6, 0xa4, 0xcb, 0x71, 0x46, 0x27, 0xd8, 0x3a, 0x07, 0x19, 0x3c,
-	0x5f, 0xb8, 0x9e, 0xf6, 0xba, 0xce, 0x59, 0xc7, 0x09, 0x5f, 0xe7, 0x2c, 0x08, 0xce, 0xec, 0x8a,
-	0xfe, 0xab, 0x23, 0x5c, 0xf9, 0xc9, 0x6c, 0x0f, 0x9e, 0x83, 0xc1, 0x4d, 0xc3, 0xbc, 0x2b, 0xde,
-	0xfd, 0x0a, 0x5a, 0xc3, 0x2c, 0x30, 0x0c, 0x5c, 0xed, 0xc0, 0xff, 0xc7, 0x02, 0x2b, 0x7a, 0x16,
-	0xfa, 0xea, 0x4e, 0xe8, 0xd9, 0xfd, 0xf6, 0x1e, 0x94, 0xe9, 0x71, 0x7c, 0x89, 0xc5, 0xd5, 0x1a,
-	0xa6, 0x48, 0x91, 0x03, 0xc3, 0x8e, 0xd0, 0x15, 0x0a, 0x75, 0xce, 0x3b, 0x8b, 0x12, 0x88, 0x74,
-	0x8e, 0x91, 0xa6, 0x33, 0x2c, 0xc1, 0x11, 0x0d, 0x4a, 0x2f, 0xf1, 0xae, 0x56, 0x98, 0x5e, 0xf4,
-	0x12, 0x21, 0x87, 0x89, 0x4c, 0xbe, 0x46, 0x10, 0x18, 0x0c, 0x0c, 0xcb, 0x89, 0xc2, 0x48, 0x3d,
-	0x51, 0x94, 0xda, 0x3a, 0xc5, 0x12, 0xab, 0x04, 0xd9, 0x4f, 0x1f, 0x0b, 0xe4, 0x68, 0x13, 0x06,
-	0xb9, 0xa7, 0xb6, 0xf8, 0x84, 0x0a, 0xbf, 0xeb, 0x70, 0xe7, 0x6f, 0xbe, 0x0d, 0xf8, 0xff, 0x58,
-	0x60, 0x46, 0xcf, 0xc3, 0xb0, 0x1f, 0x9a, 0x41, 0x0d, 0xf7, 0x36, 0x75, 0x91, 0x0d, 0x94, 0xf0,
-	0x3b, 0x15, 0xc6, 0x4f, 0x11, 0x7e, 0xb4, 0x09, 0x43, 0x16, 0x77, 0x99, 0x14, 0xde, 0xd8, 0x6f,
-	0x2f, 0x16, 0xff, 0x8b, 0xa1, 0xe0, 0x9a, 0x25, 0xf1, 0x03, 0x87, 0x88, 0xf3, 0x6c, 0x1d, 0xe0,
-	0xeb, 0x68, 0xeb, 0x80, 0x5e, 0x00, 0x20, 0xa1, 0xce, 0xd8, 0x9f, 0x19, 0x2d, 0xbe, 0x65, 0x24,
-	0xcd, 0x73, 0x28, 0x10, 0x45, 0x45, 0x3e, 0x96, 0x88, 0xa0, 0x97, 0x64, 0x25, 0xd5, 0x58, 0x6f,
-	0xc1, 0x27, 0xd2, 0xe1, 0x54, 0xe2, 0xb7, 0xaa, 0x48, 0x3f, 0x22, 0xe9, 0x8e, 0xf4, 0xdf, 0x02,
-	0x80, 0xf8, 0x39, 0x11, 0x6d, 0xc1, 0x70, 0x48, 0xa5, 0x97, 0xf0, 0x2f, 0x37, 0x05, 0x98, 0x6f,
-	0xaf, 0xf0, 0x17, 0x8e, 0x70, 0xa3, 0x4a, 0x56, 0x18, 0x9f, 0x38, 0x0f, 0x67, 0x77, 0x21, 0x7c,
-	0x5e, 0x00, 0x30, 0xe3, 0x50, 0x92, 0x7d, 0xc5, 0xd7, 0x2a, 0x0a, 0x33, 0x19, 0xaf, 0x95, 0x14,
-	0x89, 0x52, 0x22, 0x92, 0x63, 0x0d, 0xdd, 0x5f, 0xc8, 0x1a, 0xfa, 0x09, 0x38, 0x23, 0xac, 0xcf,
-	0xaa, 0xec, 0x59, 0x2d, 0xd8, 0x13, 0x0e, 0x83, 0xcc, 0x2e, 0xb1, 0xa2, 0x82, 0x70, 0xb2, 0x2e,
-	0xfa, 0x37, 0x1a, 0x0c, 0x9b, 0x42, 0x40, 0x13, 0xbc, 0x6d, 0xb9, 0xb7, 0xc7, 0xe4, 0xb9, 0x50,
-	0xde, 0xe3, 0x82, 0xfc, 0x53, 0x21, 0x57, 0x0d, 0x8b, 0x8f, 0x49, 0x8c, 0x8f, 0x7a, 0x8d, 0x7e,
-	0x53, 0x83, 0x51, 0xc3, 0xb6, 0x5d, 0xd3, 0x08, 0x58, 0x58, 0x37, 0xee, 0xc9, 0x78, 0xa7, 0xc7,
-	0x51, 0xcc, 0xc7, 0x18, 0xf9, 0x40, 0xde, 0x13, 0x69, 0x02, 0x62, 0xc8, 0x31, 0x8d, 0x45, 0xee,
-	0x3e, 0xfa, 0x07, 0x1a, 0x3c, 0xcc, 0xdd, 0x47, 0x2b, 0x54, 0xe6, 0xda, 0xb2, 0x4c, 0x23, 0x20,
-	0x3c, 0xba, 0x64, 0xe8, 0xba, 0xc4, 0x6d, 0xb7, 0x87, 0x8f, 0x6c, 0xbb, 0x7d, 0xf5, 0x60, 0xbf,
-	0xfc, 0x70, 0xa5, 0x0b, 0xdc, 0xb8, 0xab, 0x1e, 0xa0, 0x17, 0x61, 0xdc, 0x96, 0xa3, 0x1c, 0x0b,
-	0x26, 0x5f, 0xe8, 0xbd, 0x51, 0x09, 0x97, 0xcc, 0x15, 0x0c, 0x4a, 0x11, 0x56, 0x49, 0xcd, 0xde,
-	0x85, 0x71, 0x65, 0xa3, 0x9d, 0xa8, 0xa6, 0xd6, 0x81, 0xc9, 0xe4, 0x7e, 0x38, 0xd1, 0x7b, 0xde,
-	0x6d, 0x18, 0x89, 0x84, 0x05, 0x74, 0x49, 0x22, 0x14, 0x8b, 0x5e, 0xb7, 0xc9, 0x1e, 0xa7, 0x5a,
-	0x56, 0x74, 0x28, 0xfc, 0x19, 0x91, 0x45, 0xdd, 0x13, 0x08, 0xf5, 0xdf, 0x11, 0xcf, 0x88, 0xeb,
-	0xa4, 0xd9, 0xb2, 0x8d, 0x80, 0xbc, 0xf2, 0xed, 0x5f, 0xf4, 0xff, 0xaa, 0xf1, 0xf3, 0x86, 0x8b,
-	0x36, 0xc8, 0x80, 0xd1, 0x26, 0x4f, 0xb1, 0xc5, 0x62, 0xf4, 0x69, 0xc5, 0xa3, 0x03, 0xae, 0xc4,
-	0x68, 0xb0, 0x8c, 0x13, 0xdd, 0x83, 0x91, 0x50, 0x18, 0x0c, 0x35, 0x35, 0x37, 0x7a, 0x13, 0xce,
-	0x22, 0xb9, 0x33, 0x3a, 0x5a, 0xc3, 0x12, 0x1f, 0xc7, 0xb4, 0x74, 0x03, 0x50, 0xba, 0x0d, 0x7a,
-	0x24, 0xf6, 0xa3, 0xd2, 0xd4, 0xa4, 0x18, 0x29, 0x5f, 0xaa, 0x50, 0xc9, 0x5a, 0xca, 0x53, 0xb2,
-	0xea, 0xbf, 0x52, 0x82, 0x69, 0x71, 0xf5, 0x9c, 0x37, 0x4d, 0xb7, 0xed, 0x04, 0xb1, 0x7b, 0x2b,
-	0xf7, 0x19, 0x17, 0x44, 0x98, 0x38, 0xc9, 0x1d, 0xca, 0xb1, 0x80, 0xa0, 0x3b, 0x5c, 0xfb, 0xe9,
-	0xd4, 0xd7, 0xdd, 0xbb, 0xc4, 0x89, 0xb9, 0x84, 0x1c, 0x9c, 0x61, 0x29, 0xab, 0x02, 0xce, 0x6e,
-	0x87, 0x76, 0x00, 0x35, 0x8d, 0xdd, 0x24, 0xb6, 0x1e, 0xb2, 0x9d, 0xaf, 0xa4, 0xb0, 0xe1, 0x0c,
-	0x0a, 0xf4, 0x20, 0xa5, 0x92, 0x5c, 0x2b, 0x20, 0x75, 0x3e, 0xc4, 0xd0, 0x8a, 0x81, 0x1d, 0xa4,
-	0xf3, 0x2a, 0x08, 0x27, 0xeb, 0xea, 0x1f, 0x1d, 0x84, 0x07, 0xd4, 0x49, 0xa4, 0x5f, 0x68, 0xe8,
-	0xd6, 0xfd, 0x64, 0xe8, 0xb3, 0xc4, 0x27, 0xf2, 0x91, 0xa4, 0xcf, 0xd2, 0x8c, 0x6c, 0x25, 0x23,
-	0x1a, 0x29, 0xfe, 0x4b, 0x5f, 0x07, 0x1f, 0xed, 0x1c, 0xa7, 0xdc, 0xbe, 0x13, 0xf5, 0x45, 0xff,
-	0x84, 0x06, 0xb3, 0x6a, 0xf1, 0x0d, 0xcb, 0xb1, 0xfc, 0x6d, 0x91, 0x5d, 0xe1, 0xe8, 0x2e, 0x53,
-	0x2c, 0x3f, 0xeb, 0x72, 0x2e, 0x46, 0xdc, 0x81, 0x1a, 0xfa, 0x01, 0x0d, 0x1e, 0x4c, 0xcc, 0x8b,
-	0x92, 0xeb, 0xe1, 0xe8, 0xde, 0x53, 0x2c, 0xa8, 0xc8, 0x72, 0x3e, 0x4a, 0xdc, 0x89, 0x1e, 0xfa,
-	0xbc, 0x06, 0xe7, 0x5b, 0x59, 0x1e, 0xd6, 0xe1, 0xb5, 0xb4, 0x90, 0x0a, 0x2d, 0xd3, 0x67, 0x7b,
-	0xe1, 0xb2, 0xd8, 0xa2, 0xe7, 0x33, 0xc1, 0x3e, 0xce, 0xe9, 0x88, 0xfe, 0xcf, 0x4a, 0x30, 0xc0,
-	0x0c, 0x85, 0x5e, 0x19, 0x8e, 0x2e, 0xac, 0xab, 0xb9, 0x76, 0x96, 0x8d, 0x84, 0x9d, 0xe5, 0x93,
-	0xc5, 0x49, 0x74, 0x36, 0xb4, 0x7c, 0x0f, 0x9c, 0x67, 0xd5, 0xe6, 0xeb, 0x4c, 0xd1, 0xe6, 0x93,
-	0xfa, 0x7c, 0xbd, 0xce, 0xae, 0xb7, 0x87, 0xbf, 0x91, 0x5d, 0x82, 0xbe, 0xb6, 0x67, 0x27, 0x43,
-	0x7f, 0x6e, 0xe0, 0x65, 0x4c, 0xcb, 0xf5, 0x4f, 0x68, 0x30, 0xc9, 0x70, 0x4b, 0x2c, 0x06, 0xed,
-	0xc0, 0xb0, 0x27, 0xd8, 0x8c, 0x58, 0x9b, 0xe5, 0xc2, 0x43, 0xcb, 0x60, 0x5d, 0xfc, 0xc6, 0x16,
-	0xfe, 0xc2, 0x11, 0x2d, 0xfd, 0x2b, 0x83, 0x30, 0x93, 0xd7, 0x08, 0xfd, 0xb0, 0x06, 0xe7, 0xcd,
-	0x58, 0xe2, 0x9c, 0x6f, 0x07, 0xdb, 0xae, 0xc7, 0x23, 0x29, 0x6b, 0xc5, 0xd5, 0x21, 0x95, 0xf9,
-	0xa8, 0x57, 0x2c, 0x59, 0x41, 0x25, 0x93, 0x02, 0xce, 0xa1, 0x8c, 0x5e, 0xe2, 0x61, 0x11, 0x4d,
-	0xd9, 0x68, 0xec, 0x76, 0xe1, 0xb9, 0xba, 0x1d, 0xa1, 0x8a, 0x3a, 0x15, 0xc5, 0x46, 0x14, 0xe5,
-	0x12, 0x39, 0x4a, 0xdc, 0xf7, 0xb7, 0x6f, 0x93, 0xbd, 0x96, 0x61, 0x85, 0x76, 0x52, 0xc5, 0x89,
-	0xd7, 0x6a, 0xb7, 0x04, 0x2a, 0x95, 0xb8, 0x54, 0x2e, 0x91, 0x43, 0x1f, 0xd1, 0x60, 0xdc, 0x95,
-	0x83, 0x94, 0xf4, 0x62, 0xc1, 0x9e, 0x19, 0xed, 0x84, 0x8b, 0xf9, 0x2a, 0x48, 0x25, 0x49, 0xf7,
-	0xc4, 0x94, 0x9f, 0x3c, 0x56, 0x05, 0xe3, 0x5d, 0x29, 0x26, 0x80, 0xe5, 0x9c, 0xd1, 0x5c, 0x65,
-	0x90, 0x06, 0xa7, 0xc9, 0xb3, 0x4e, 0x91, 0xc0, 0xac, 0x2f, 0x39, 0xa6, 0xb7, 0xc7, 0xdc, 0xe2,
-	0x69, 0xa7, 0x06, 0x8b, 0x77, 0x6a, 0x69, 0xbd, 0xb2, 0xa8, 0x20, 0x53, 0x3b, 0x95, 0x06, 0xa7,
-	0xc9, 0xeb, 0x1f, 0x2e, 0xc1, 0x85, 0x9c, 0x3d, 0xf6, 0xb7, 0x26, 0xaa, 0xcc, 0xaf, 0x6b, 0x30,
-	0xc2, 0xe6, 0xe0, 0x15, 0xe2, 0x98, 0xc8, 0xfa, 0x9a, 0x63, 0x4e, 0xfc, 0x6b, 0x1a, 0x4c, 0xa5,
-	0xd2, 0xe4, 0x74, 0xe5, 0xd6, 0x76, 0x6a, 0x96, 0xae, 0xaf, 0x89, 0x53, 0xf3, 0xf5, 0xc5, 0xd1,
-	0x1c, 0x92, 0x69, 0xf9, 0xf4, 0xa7, 0x61, 0x5c, 0xb1, 0x26, 0x96, 0xe2, 0x1e, 0x66, 0x45, 0x6c,
-	0x94, 0xc3, 0x1a, 0x96, 0x3a, 0x05, 0x64, 0x8c, 0xb7, 0x7c, 0x9a, 0xb3, 0xfd, 0xed, 0xd9, 0xf2,
-	0x48, 0x6c, 0xf9, 0xf0, 0x0d, 0x89, 0x45, 0x53, 0xf4, 0x7b, 0x79, 0x43, 0x62, 0x61, 0x19, 0x45,
-	0xf4, 0x53, 0xfe, 0x3f, 0x16, 0x58, 0xd1, 0x3b, 0xd5, 0xd8, 0xa6, 0xab, 0xf1, 0xc5, 0x72, 0x3a,
-	0x19, 0x91, 0x94, 0x6d, 0xc9, 0x54, 0x6d, 0x84, 0xf9, 0x2b, 0x14, 0x3f, 0xcb, 0x0a, 0x25, 0x41,
-	0x59, 0x5c, 0xad, 0xf1, 0xa0, 0x77, 0xd1, 0xeb, 0x93, 0xaa, 0x6e, 0xef, 0x3f, 0x0d, 0x75, 0xbb,
-	0x07, 0xa3, 0xdb, 0xd6, 0x26, 0xf1, 0x1c, 0x2e, 0x43, 0x0d, 0x14, 0x17, 0x0f, 0x6f, 0xc5, 0x68,
-	0xb8, 0x0e, 0x42, 0x2a, 0xc0, 0x32, 0x11, 0xe4, 0x29, 0x11, 0x9a, 0x07, 0x8b, 0x8b, 0x44, 0xb1,
-	0x5e, 0x3c, 0x1e, 0x67, 0x4e, 0x74, 0x66, 0x07, 0xc0, 0x89, 0xc2, 0x96, 0xf6, 0xf2, 0x2a, 0x15,
-	0x07, 0x3f, 0xe5, 0x42, 0x47, 0xfc, 0x1b, 0x4b, 0x14, 0xe8, 0xbc, 0x36, 0xe3, 0x28, 0xf8, 0x42,
-	0xc7, 0xf9, 0x64, 0x8f, 0x99, 0x08, 0x84, 0x6e, 0x27, 0x2e, 0xc0, 0x32, 0x11, 0x3a, 0xc6, 0x66,
-	0x14, 0xbb, 0x5e, 0xe8, 0x30, 0x0b, 0x8d, 0x31, 0x8e, 0x80, 0xcf, 0xc7, 0x18, 0xff, 0xc6, 0x12,
-	0x05, 0xf4, 0xbc, 0xf4, 0x78, 0x09, 0xc5, 0x35, 0x64, 0x5d, 0x3d, 0x5c, 0xbe, 0x39, 0x56, 0x14,
-	0x8d, 0xb2, 0xef, 0xf4, 0x41, 0x49, 0x49, 0xc4, 0x62, 0xfa, 0x53, 0xde, 0x91, 0x52, 0x1a, 0xc5,
-	0x3e, 0x0c, 0x63, 0x1d, 0x7d, 0x18, 0x2a, 0x54, 0x3a, 0x93, 0xdc, 0xf1, 0x18, 0x43, 0x18, 0x8f,
-	0x5f, 0x60, 0x6a, 0x49, 0x20, 0x4e, 0xd7, 0xe7, 0x0c, 0x9f, 0xd4, 0x59, 0xdb, 0x09, 0x99, 0xe1,
-	0xf3, 0x32, 0x1c, 0x41, 0xd1, 0x0e, 0x8c, 0xf9, 0x92, 0x43, 0xc4, 0xcc, 0x99, 0x5e, 0xdf, 0x2f,
-	0x85, 0x33, 0x04, 0x0b, 0xae, 0x28, 0x97, 0x60, 0x85, 0x8e, 0xfa, 0xb8, 0x36, 0x79, 0xba, 0x8f,
-	0x6b, 0xa8, 0xad, 0xda, 0x3a, 0x4f, 0x1d, 0x4b, 0xf0, 0x92, 0x43, 0x6d, 0xa1, 0xe9, 0xd2, 0x92,
-	0xdd, 0x96, 0xeb, 0xb7, 0x3d, 0xc2, 0x72, 0xb0, 0xb0, 0xe5, 0x41, 0xf1, 0xd2, 0x2e, 0x25, 0x81,
-	0x38, 0x5d, 0x1f, 0x7d, 0x9f, 0x06, 0x93, 0xfe, 0x9e, 0x1f, 0x90, 0x26, 0x3d, 0xb6, 0x5c, 0x87,
-	0x38, 0x81, 0x3f, 0x73, 0xb6, 0x78, 0x30, 0xec, 0x5a, 0x02, 0x17, 0x3f, 0x76, 0x92, 0xa5, 0x38,
-	0x45, 0x93, 0xee, 0x1c, 0x39, 0xfc, 0xc9, 0xcc, 0x74, 0xf1, 0x9d, 0x23, 0x87, 0x56, 0xe1, 0x3b,
-	0x47, 0x2e, 0xc1, 0x0a, 0x1d, 0xf4, 0x18, 0x8c, 0xfb, 0x61, 0x9a, 0x66, 0x36, 0x83, 0xe7, 0xe2,
-	0x08, 0x95, 0x35, 0x19, 0x80, 0xd5, 0x7a, 0xe8, 0x83, 0x30, 0x26, 0x9f, 0x9d, 0x33, 0xe7, 0x8f,
-	0x3b, 0x56, 0x3b, 0xef, 0xb9, 0x0c, 0x52, 0x08, 0x22, 0x0c, 0xe7, 0x25, 0x57, 0x3d, 0xf9, 0xfb,
-	0xbe, 0xc0, 0x86, 0xc0, 0x2f, 0xd3, 0x99, 0x35, 0x70, 0x4e, 0x4b, 0xf4, 0xe3, 0xd9, 0x6f, 0xf5,
-	0x33, 0x6c, 0x4b, 0xaf, 0x1d, 0xcb, 0x5b, 0xfd, 0xd3, 0x56, 0xb0, 0x7d, 0xa7, 0xc5, 0xc3, 0x69,
-	0x1d, 0xf1, 0xd9, 0x5e, 0xff, 0x3d, 0x0d, 0x20, 0xd2, 0xd6, 0x9c, 0xc6, 0x3b, 0x49, 0x5d, 0x51,
-	0x60, 0x2d, 0xf4, 0xa4, 0x5d, 0xca, 0x4d, 0xc5, 0xa1, 0x7f, 0x59, 0x83, 0x89, 0xb8, 0xda, 0x29,
-	0x5c, 0x8d, 0x4c, 0xf5, 0x6a, 0xf4, 0x8e, 0xde, 0xc6, 0x95, 0x73, 0x3f, 0xfa, 0xbf, 0x25, 0x79,
-	0x54, 0x4c, 0xfa, 0xdd, 0x51, 0xec, 0x0e, 0x28, 0xe9, 0x5b, 0xbd, 0xd8, 0x1d, 0xc8, 0x21, 0x27,
-	0xe2, 0xf1, 0x66, 0xd8, 0x21, 0x7c, 0xb7, 0x22, 0x7f, 0xf6, 0x10, 0xec, 0x25, 0x12, 0x36, 0x43,
-	0xd2, 0x7c, 0x02, 0x0e, 0x13, 0x46, 0x5f, 0x90, 0x8f, 0xa7, 0x1e, 0xd2, 0x67, 0x28, 0x03, 0xee,
-	0x6c, 0xf1, 0xf1, 0xa9, 0x49, 0x18, 0x95, 0x14, 0x9b, 0x09, 0x2b, 0x0a, 0xed, 0x34, 0xac, 0x28,
-	0x02, 0x18, 0x35, 0xa3, 0x8c, 0x83, 0xe1, 0xb4, 0xf7, 0x48, 0x33, 0x3a, 0x16, 0xe3, 0x5c, 0x86,
-	0x3e, 0x96, 0xc9, 0x50, 0xe1, 0x2d, 0xda, 0x63, 0x7d, 0xc7, 0x60, 0xdb, 0xd2, 0x69, 0x5f, 0xbd,
-	0x09, 0x20, 0x94, 0xff, 0x49, 0x5d, 0x84, 0x25, 0x8f, 0xec, 0xb6, 0xab, 0xfe, 0xad, 0x08, 0x86,
-	0xa5, 0x7a, 0xe9, 0x57, 0xf9, 0x81, 0x53, 0x7b, 0x95, 0xa7, 0xdb, 0xc0, 0x0e, 0xf3, 0x8a, 0xf7,
-	0x64, 0x2b, 0x17, 0x65, 0x27, 0x8f, 0xb7, 0x41, 0x54, 0xe4, 0x63, 0x89, 0x48, 0x8e, 0x31, 0xcd,
-	0x50, 0x21, 0x63, 0x9a, 0x36, 0x9c, 0xf5, 0x48, 0xe0, 0xed, 0x55, 0xf6, 0x4c, 0x96, 0xcf, 0xc3,
-	0x0b, 0xd8, 0x0d, 0x7e, 0xb8, 0x58, 0x94, 0x40, 0x9c, 0x46, 0x85, 0xb3, 0xf0, 0x2b, 0x02, 0xf0,
-	0x48, 0x47, 0x01, 0xf8, 0xcd, 0x30, 0x1a, 0x10, 0x73, 0xdb, 0xb1, 0x4c, 0xc3, 0xae, 0x2e, 0x8a,
-	0xa0, 0xd5, 0xb1, 0x2c, 0x17, 0x83, 0xb0, 0x5c, 0x0f, 0x2d, 0x40, 0x5f, 0xdb, 0xaa, 0x8b, 0x1b,
-	0xc0, 0xb7, 0x45, 0x4f, 0x04, 0xd5, 0xc5, 0xfb, 0xfb, 0xe5, 0x57, 0xc7, 0xd6, 0x29, 0xd1, 0xa8,
-	0xae, 0xb5, 0xee, 0x36, 0xae, 0x05, 0x7b, 0x2d, 0xe2, 0xcf, 0x6d, 0x54, 0x17, 0x31, 0x6d, 0x9c,
-	0x65, 0x68, 0x34, 0x76, 0x04, 0x43, 0xa3, 0xcf, 0x68, 0x70, 0xd6, 0x48, 0xbe, 0x6e, 0x10, 0x7f,
-	0x66, 0xbc, 0x38, 0xb7, 0xcc, 0x7e, 0x31, 0x59, 0x78, 0x50, 0x8c, 0xef, 0xec, 0x7c, 0x9a, 0x1c,
-	0xce, 0xea, 0x03, 0xf2, 0x00, 0x35, 0xad, 0x46, 0x94, 0xe2, 0x5b, 0xac, 0xfa, 0x44, 0x31, 0xbd,
-	0xcd, 0x4a, 0x0a, 0x13, 0xce, 0xc0, 0x8e, 0xee, 0xc1, 0xa8, 0x24, 0x24, 0x89, 0x9b, 0xcc, 0xe2,
-	0x71, 0x3c, 0xc2, 0xf0, 0xdb, 0xae, 0xfc, 0xc0, 0x22, 0x53, 0x8a, 0x5e, 0x58, 0x25, 0x35, 0x83,
-	0x78, 0x65, 0x64, 0xa3, 0x9e, 0x2c, 0xfe, 0xc2, 0x9a, 0x8d, 0x11, 0x77, 0xa0, 0xc6, 0x62, 0xf3,
-	0xd9, 0x6a, 0x26, 0xfe, 0x99, 0xa9, 0xe2, 0xc1, 0x45, 0x12, 0x49, 0xfd, 0xf9, 0xd6, 0x4c, 0x14,
-	0xe2, 0x24, 0x41, 0x74, 0x03, 0x10, 0xe1, 0xaa, 0xf4, 0xf8, 0x72, 0xe6, 0xcf, 0x20, 0xf6, 0xf8,
-	0xcf, 0x96, 0x74, 0x29, 0x05, 0xc5, 0x19, 0x2d, 0x50, 0xa0, 0xe8, 0x4a, 0x7a, 0xb8, 0xe5, 0x24,
-	0x13, 0xc5, 0x74, 0xd4, 0x98, 0x7c, 0xaf, 0x96, 0xca, 0xb5, 0xcb, 0x2f, 0x37, 0xb7, 0x7a, 0xcf,
-	0xb5, 0x2b, 0xc8, 0x77, 0x91, 0x71, 0x57, 0xff, 0x5d, 0x4d, 0x68, 0x79, 0x4f, 0xd1, 0xcc, 0xe8,
-	0xa4, 0xdf, 0x7f, 0xf5, 0xa7, 0x61, 0xa6, 0x16, 0x06, 0xad, 0xac, 0x27, 0x62, 0xd3, 0xbf, 0x1d,
-	0xc6, 0xf9, 0x2b, 0xcb, 0x8a, 0xd1, 0x5a, 0x8d, 0x55, 0xf2, 0x51, 0x5c, 0x85, 0x8a, 0x0c, 0xc4,
-	0x6a, 0x5d, 0xfd, 0xab, 0x1a, 0x5c, 0x50, 0x31, 0xbb, 0x9e, 0xf5, 0x62, 0xef, 0x88, 0xd1, 0xc7,
-	0x34, 0x18, 0x8d, 0x1f, 0x10, 0x43, 0xa9, 0xa8, 0x90, 0x2b, 0x46, 0xd8, 0x2b, 0xe2, 0x49, 0x2f,
-	0x4a, 0xe9, 0x84, 0x87, 0x31, 0xd0, 0xc7, 0x32, 0x69, 0xfd, 0x7f, 0x68, 0x90, 0xba, 0x99, 0xa3,
-	0x4d, 0x18, 0xa2, 0x44, 0x16, 0x57, 0x6b, 0x62, 0x4f, 0xbc, 0xbd, 0x98, 0xc0, 0xc6, 0x50, 0xf0,
-	0xf7, 0x06, 0xf1, 0x03, 0x87, 0x88, 0xe9, 0x5d, 0xdf, 0x91, 0x12, 0xd7, 0x88, 0xed, 0x51, 0x48,
-	0x22, 0x96, 0x13, 0xe0, 0xf0, 0x1b, 0xb3, 0x5c, 0x82, 0x15, 0x3a, 0xfa, 0x32, 0x40, 0xac, 0x4d,
-	0xe9, 0xd9, 0x6c, 0xef, 0x3f, 0x9e, 0x85, 0x73, 0x3d, 0x3b, 0x19, 0x7e, 0x44, 0x83, 0xf3, 0x64,
-	0xc7, 0x32, 0x83, 0xf9, 0xad, 0x80, 0x78, 0x77, 0xee, 0xac, 0xac, 0x6f, 0x7b, 0xc4, 0xdf, 0x76,
-	0xed, 0x7a, 0x37, 0x46, 0x8a, 0x79, 0xf9, 0xfe, 0x97, 0x32, 0x31, 0xe2, 0x1c, 0x4a, 0x4c, 0x93,
-	0xb4, 0xc3, 0xef, 0xd8, 0x98, 0x5e, 0x67, 0xda, 0x9e, 0x1f, 0x88, 0x38, 0x74, 0x5c, 0x93, 0x94,
-	0x04, 0xe2, 0x74, 0xfd, 0x24, 0x12, 0x96, 0x95, 0x8d, 0x89, 0xc4, 0x5a, 0x1a, 0x09, 0x03, 0xe2,
-	0x74, 0x7d, 0x19, 0x09, 0x5f, 0x29, 0x7a, 0xde, 0x0c, 0xa4, 0x91, 0x44, 0x40, 0x9c, 0xae, 0x8f,
-	0xea, 0x70, 0xd1, 0x23, 0xa6, 0xdb, 0x6c, 0x12, 0xa7, 0xce, 0x26, 0x65, 0xc5, 0xf0, 0x1a, 0x96,
-	0x73, 0xc3, 0x33, 0x58, 0x45, 0xa6, 0x98, 0xd7, 0x58, 0x02, 0xd5, 0x8b, 0xb8, 0x43, 0x3d, 0xdc,
-	0x11, 0x0b, 0x6a, 0xc2, 0x19, 0x9e, 0xe3, 0xde, 0x63, 0x71, 0xaa, 0x76, 0x0c, 0x5b, 0x68, 0xdf,
-	0x8f, 0xba, 0x62, 0xec, 0x0c, 0xdc, 0x50, 0x51, 0xe1, 0x24, 0x6e, 0xb4, 0x47, 0x25, 0x5f, 0xd1,
-	0x1d, 0x89, 0xe4, 0x70, 0x21, 0x92, 0x42, 0xfa, 0x4d, 0xa1, 0xc3, 0x59, 0x34, 0x50, 0x15, 0xce,
-	0x06, 0x86, 0xd7, 0x20, 0x41, 0x65, 0x6d, 0x63, 0x8d, 0x78, 0x26, 0xe5, 0xb1, 0x36, 0x17, 0x84,
-	0x35, 0x8e, 0x6a, 0x3d, 0x0d, 0xc6, 0x59, 0x6d, 0xd0, 0x07, 0xe1, 0x35, 0xea, 0xa4, 0x2e, 0xbb,
-	0xf7, 0x88, 0xb7, 0xe0, 0xb6, 0x9d, 0xba, 0x8a, 0x1c, 0x18, 0xf2, 0x47, 0x0e, 0xf6, 0xcb, 0xaf,
-	0xc1, 0xdd, 0x34, 0xc0, 0xdd, 0xe1, 0x4d, 0x77, 0x60, 0xa3, 0xd5, 0xca, 0xec, 0xc0, 0x68, 0x5e,
-	0x07, 0x72, 0x1a, 0xe0, 0xee, 0xf0, 0x22, 0x0c, 0xe7, 0xf9, 0xc4, 0xf0, 0x74, 0xbf, 0x12, 0xc5,
-	0x31, 0x46, 0x91, 0x7d, 0xbf, 0xeb, 0x99, 0x35, 0x70, 0x4e, 0x4b, 0x7a, 0xa6, 0x5c, 0xcd, 0x1b,
-	0x7e, 0x8a, 0xcc, 0x38, 0x23, 0xf3, 0xba, 0x83, 0xfd, 0xf2, 0x55, 0xdc, 0x65, 0x1b, 0xdc, 0x35,
-	0xf6, 0x8c, 0xae, 0xc4, 0x13, 0x91, 0xea, 0xca, 0x44, 0x5e, 0x57, 0xf2, 0xdb, 0xe0, 0xae, 0xb1,
-	0xa3, 0xef, 0xd7, 0xe0, 0x01, 0xb3, 0xd5, 0xbe, 0x65, 0xf9, 0x81, 0xdb, 0xf0, 0x8c, 0xe6, 0x22,
-	0x31, 0x8d, 0xbd, 0x5b, 0x86, 0xbd, 0xb5, 0x6c, 0x6d, 0x11, 0x21, 0xcf, 0x1f, 0xf5, 0xc3, 0x61,
-	0x0e, 0xb5, 0x95, 0xb5, 0x8d, 0x6c, 0xa4, 0x38, 0x9f, 0x1e, 0xfa, 0x11, 0x0d, 0x2e, 0xf2, 0x8c,
-	0xfb, 0x39, 0x1d, 0x9a, 0x2c, 0xd4, 0x21, 0xc6, 0xc5, 0x56, 0x3a, 0xe0, 0xc5, 0x1d, 0xa9, 0xb2,
-	0x49, 0xe2, 0x15, 0xe6, 0x1b, 0x0d, 0x8f, 0x34, 0x18, 0xd6, 0x88, 0xbb, 0x4c, 0x15, 0x9f, 0xa4,
-	0x95, 0x3c, 0xa4, 0x38, 0x9f, 0x1e, 0x7a, 0x1e, 0x2e, 0xe7, 0x02, 0x2b, 0x6e, 0xdb, 0x09, 0xd8,
-	0xfb, 0x46, 0xdf, 0x82, 0x7e, 0xb0, 0x5f, 0xbe, 0xbc, 0xd2, 0xb1, 0x26, 0x3e, 0x04, 0x13, 0xfa,
-	0xd1, 0xa4, 0x7b, 0xff, 0x59, 0x26, 0x89, 0xbd, 0xb7, 0x50, 0x7a, 0xd7, 0x63, 0xf4, 0xe9, 0xff,
-	0xb2, 0xea, 0xd3, 0x3f, 0xcd, 0x7a, 0xf5, 0x9e, 0xe3, 0xeb, 0xd5, 0x37, 0x1d, 0xf9, 0xbb, 0x71,
-	0xf0, 0xf8, 0x8c, 0x06, 0xc2, 0x2f, 0x13, 0x5d, 0x54, 0x2c, 0x7a, 0x86, 0x13, 0xd6, 0x3c, 0x61,
-	0x96, 0xd5, 0x52, 0x66, 0x96, 0xd5, 0xd7, 0x4a, 0x41, 0x7d, 0x47, 0xe2, 0xcb, 0x12, 0xc7, 0x1c,
-	0x47, 0xf5, 0x45, 0x8f, 0xc2, 0x48, 0x74, 0x5f, 0x15, 0x7a, 0x44, 0x96, 0xe1, 0x24, 0xbe, 0xd8,
-	0xc6, 0x70, 0xfd, 0xb7, 0x35, 0x80, 0x38, 0x79, 0x30, 0x7a, 0x08, 0x06, 0x4c, 0xdb, 0xf0, 0xfd,
-	0x64, 0xfa, 0x79, 0xf6, 0xc8, 0x87, 0x39, 0xec, 0x70, 0x27, 0x03, 0xa4, 0xc3, 0x60, 0x9b, 0xa5,
-	0x4b, 0x14, 0x8e, 0x01, 0xcc, 0xba, 0x64, 0x83, 0x95, 0x60, 0x01, 0x41, 0x1b, 0x30, 0xd4, 0xb4,
-	0x1c, 0xe6, 0xc3, 0xd1, 0x5f, 0xc8, 0x87, 0x83, 0xdd, 0x07, 0x56, 0x38, 0x0a, 0x1c, 0xe2, 0xd2,
-	0x7f, 0x41, 0x83, 0x33, 0x6a, 0x94, 0x65, 0x1f, 0xbd, 0x06, 0x86, 0x44, 0x6e, 0x08, 0x11, 0xdc,
-	0x9d, 0x35, 0x15, 0xd1, 0x0c, 0x71, 0x08, 0x53, 0x1f, 0x7e, 0x7b, 0x50, 0xec, 0x67, 0x07, 0x7b,
-	0x3e, 0x44, 0xc7, 0xfe, 0x63, 0x67, 0x61, 0x90, 0x9b, 0x57, 0x53, 0x39, 0x3e, 0x23, 0x8a, 0xd3,
-	0xed, 0xe2, 0xf9, 0x0b, 0x8a, 0x44, 0xba, 0x91, 0xb3, 0x38, 0x96, 0x3a, 0x66, 0x71, 0xc4, 0xd0,
-	0x67, 0x7a, 0x56, 0x2f, 0x46, 0x3e, 0x15, 0x5c, 0xe5, 0x46, 0x3e, 0x15, 0x5c, 0xc5, 0x14, 0x19,
-	0x0a, 0x14, 0xeb, 0x97, 0xfe, 0xe2, 0xda, 0x15, 0x3e, 0x01, 0x92, 0x0d, 0xcc, 0x44, 0x47, 0xfb,
-	0x97, 0x30, 0x72, 0xfb, 0x40, 0x71, 0xa7, 0x1f, 0x31, 0xe5, 0xdd, 0x44, 0x6e, 0x0f, 0x3f, 0xa4,
-	0xc1, 0xdc, 0x0f, 0x69, 0x0b, 0x86, 0xc4, 0xa7, 0x20, 0x2e, 0x04, 0x6f, 0xef, 0x21, 0x57, 0xb9,
-	0x94, 0x15, 0x89, 0x17, 0xe0, 0x10, 0x39, 0xbd, 0x65, 0x36, 0x8d, 0x5d, 0xab, 0xd9, 0x6e, 0xb2,
-	0x5b, 0xc0, 0x80, 0x5c, 0x95, 0x15, 0xe3, 0x10, 0xce, 0xaa, 0x72, 0x5f, 0x29, 0x26, 0xb5, 0xcb,
-	0x55, 0x79, 0x31, 0x0e, 0xe1, 0xe8, 0x59, 0x18, 0x6e, 0x1a, 0xbb, 0xb5, 0xb6, 0xd7, 0x20, 0xc2,
-	0xf6, 0x25, 0x5f, 0x29, 0xd4, 0x0e, 0x2c, 0x7b, 0xce, 0x72, 0x02, 0x3f, 0xf0, 0xe6, 0xaa, 0x4e,
-	0x70, 0xc7, 0xab, 0x05, 0xcc, 0xb6, 0x86, 0xed, 0xba, 0x15, 0x81, 0x05, 0x47, 0xf8, 0x90, 0x0d,
-	0x13, 0x4d, 0x63, 0x77, 0xc3, 0x31, 0x78, 0x50, 0x7e, 0x21, 0x65, 0x17, 0xa1, 0xc0, 0x14, 0x5e,
-	0x2b, 0x0a, 0x2e, 0x9c, 0xc0, 0x9d, 0x61, 0x67, 0x39, 0x76, 0x52, 0x76, 0x96, 0xf3, 0x51, 0xf4,
-	0x01, 0xae, 0x2d, 0x7f, 0x20, 0x33, 0xd0, 0x5d, 0xc7, 0xc8, 0x02, 0xcf, 0x45, 0x91, 0x05, 0x26,
-	0x8a, 0x1b, 0x06, 0x76, 0x88, 0x2a, 0xd0, 0x86, 0xd1, 0xba, 0x11, 0x18, 0xbc, 0xd4, 0x9f, 0x39,
-	0x53, 0xfc, 0xe1, 0x77, 0x31, 0x42, 0x13, 0xb3, 0xa4, 0xb8, 0xcc, 0xc7, 0x32, 0x1d, 0x74, 0x07,
-	0xce, 0xd1, 0x8f, 0xd5, 0x26, 0x41, 0x5c, 0x85, 0xe9, 0xcc, 0x26, 0xd9, 0xf7, 0xc3, 0xbc, 0xcf,
-	0x6e, 0x67, 0x55, 0xc0, 0xd9, 0xed, 0xe2, 0xa0, 0xac, 0x53, 0xd9, 0x41, 0x59, 0xd1, 0x27, 0xb3,
-	0x2c, 0x5a, 0x10, 0x9b, 0xd3, 0x77, 0x15, 0xe7, 0x0d, 0x85, 0xed, 0x5a, 0xfe, 0xb9, 0x06, 0x33,
-	0x62, 0x97, 0x09, 0x2b, 0x14, 0x9b, 0x78, 0x2b, 0x86, 0x63, 0x34, 0x88, 0x27, 0x54, 0xd0, 0xeb,
-	0x3d, 0xf0, 0x87, 0x14, 0xce, 0x28, 0xe4, 0xc3, 0xc3, 0x07, 0xfb, 0xe5, 0x2b, 0x87, 0xd5, 0xc2,
-	0xb9, 0x7d, 0x43, 0x1e, 0x0c, 0xf9, 0x7b, 0xbe, 0x19, 0xd8, 0xbe, 0x90, 0x41, 0x6f, 0xf6, 0xc0,
-	0x59, 0x6b, 0x1c, 0x13, 0x67, 0xad, 0x71, 0x2e, 0x3e, 0x5e, 0x8a, 0x43, 0x42, 0xe8, 0x87, 0x34,
-	0x98, 0x12, 0xef, 0x52, 0x52, 0x48, 0x9d, 0x73, 0xc5, 0xfd, 0x5f, 0x2a, 0x49, 0x64, 0xa1, 0xe5,
-	0x09, 0xd3, 0x26, 0xa5, 0xa0, 0x38, 0x4d, 0x9d, 0x1e, 0xaa, 0x2d, 0xcf, 0x72, 0x3d, 0x2b, 0xd8,
-	0x63, 0x36, 0x3e, 0x03, 0x61, 0x44, 0x74, 0x5e, 0x86, 0x23, 0x28, 0xaa, 0xc1, 0x04, 0xd7, 0xda,
-	0xd4, 0x02, 0xcf, 0x08, 0x48, 0x63, 0x4f, 0x18, 0xe2, 0x3c, 0xca, 0x72, 0xd6, 0x2a, 0x90, 0xfb,
-	0xfb, 0xe5, 0x73, 0x62, 0x6d, 0x54, 0x00, 0x4e, 0xa0, 0x40, 0xef, 0x4f, 0xd8, 0x45, 0xcd, 0x14,
-	0xcf, 0x67, 0xc7, 0xd7, 0xe2, 0x28, 0xd6, 0x51, 0xbd, 0x46, 0x89, 0xeb, 0x21, 0xa7, 0xc8, 0xec,
-	0xe3, 0x30, 0x26, 0xef, 0x9a, 0x23, 0x05, 0xa7, 0x0b, 0x00, 0xa5, 0x07, 0x7b, 0xd2, 0xf1, 0x7b,
-	0xf4, 0x9f, 0xd2, 0x60, 0x32, 0x29, 0xbb, 0xa0, 0x6d, 0x18, 0x12, 0x8c, 0x4c, 0x50, 0x9d, 0x2f,
-	0x6a, 0x10, 0x6c, 0x13, 0xe1, 0xf6, 0xcb, 0x45, 0x61, 0x51, 0x84, 0x43, 0xf4, 0xb2, 0xb1, 0x7f,
-	0xa9, 0x83, 0xb1, 0xff, 0x13, 0x70, 0x3e, 0x9b, 0xa5, 0xd1, 0x8b, 0x84, 0x41, 0xef, 0x57, 0x42,
-	0x69, 0x1d, 0x5d, 0x24, 0xd8, 0xa5, 0x0b, 0x73, 0x98, 0xfe, 0x01, 0x48, 0xe6, 0xd2, 0x42, 0xcf,
-	0xc3, 0x88, 0xef, 0x6f, 0x73, 0x13, 0x2e, 0x31, 0xc8, 0x62, 0x4f, 0x3d, 0x61, 0x5e, 0x13, 0x7e,
-	0xf7, 0x89, 0x7e, 0xe2, 0x18, 0xfd, 0xc2, 0x33, 0x5f, 0xfa, 0xea, 0xe5, 0x57, 0xfd, 0xce, 0x57,
-	0x2f, 0xbf, 0xea, 0x2b, 0x5f, 0xbd, 0xfc, 0xaa, 0x0f, 0x1d, 0x5c, 0xd6, 0xbe, 0x74, 0x70, 0x59,
-	0xfb, 0x9d, 0x83, 0xcb, 0xda, 0x57, 0x0e, 0x2e, 0x6b, 0x7f, 0x72, 0x70, 0x59, 0xfb, 0xc1, 0xff,
-	0x7c, 0xf9, 0x55, 0xcf, 0x5e, 0x8f, 0xa9, 0x5f, 0x0b, 0x89, 0xc6, 0xff, 0xb4, 0xee, 0x36, 0xe8,
-	0xa5, 0xd5, 0x0f, 0x6f, 0xad, 0x8c, 0xfa, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x19, 0x2c, 0x52,
-	0x9a, 0x69, 0x22, 0x01, 0x00,
+	// 15423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x70, 0x24, 0xd9,
+	0x59, 0x20, 0xea, 0x2c, 0xa9, 0xf4, 0xf8, 0xf4, 0xe8, 0xee, 0xd3, 0xaf, 0x6a, 0xcd, 0x4c, 0xab,
+	0x9d, 0x33, 0xf6, 0x9d, 0xc1, 0xb6, 0x1a, 0x8f, 0x5f, 0xe3, 0x31, 0xe3, 0xb1, 0x54, 0x52, 0x77,
+	0xcb, 0x2d, 0x75, 0xcb, 0x5f, 0xb5, 0xa6, 0xc7, 0x36, 0x8c, 0x49, 0x55, 0x1d, 0x95, 0x72, 0xba,
+	0x2a, 0xb3, 0x26, 0x33, 0x4b, 0x2d, 0x8d, 0x6d, 0xfc, 0xe0, 0x71, 0x6d, 0x83, 0x09, 0xe0, 0x02,
+	0xbe, 0x36, 0x10, 0xd7, 0x86, 0xc0, 0xdc, 0x0b, 0xf7, 0xc2, 0x0d, 0x6e, 0xc0, 0x0d, 0x70, 0xdc,
+	0xbb, 0xbb, 0x44, 0xb0, 0x78, 0x09, 0xd8, 0x20, 0x30, 0x2c, 0x66, 0x17, 0x04, 0xd6, 0x7a, 0x61,
+	0x23, 0x76, 0xd9, 0xdd, 0x58, 0x22, 0x76, 0x23, 0x9a, 0x0d, 0x76, 0xe3, 0x3c, 0x32, 0xf3, 0x9c,
+	0x7c, 0x94, 0x4a, 0x59, 0x92, 0xec, 0x01, 0x7e, 0x49, 0x75, 0xbe, 0x73, 0xbe, 0xef, 0xe4, 0xc9,
+	0x93, 0xdf, 0xf9, 0xce, 0xf7, 0x84, 0x85, 0xa6, 0x1d, 0x6c, 0x75, 0x37, 0xe6, 0xea, 0x6e, 0xfb,
+	0x6a, 0xd3, 0xf2, 0x1a, 0xd4, 0xa1, 0x5e, 0xfc, 0x4f, 0xe7, 0x5e, 0xf3, 0xaa, 0xd5, 0xb1, 0xfd,
+	0xab, 0x75, 0xd7, 0xa3, 0x57, 0xb7, 0xdf, 0xb8, 0x41, 0x03, 0xeb, 0x8d, 0x57, 0x9b, 0x0c, 0x66,
+	0x05, 0xb4, 0x31, 0xd7, 0xf1, 0xdc, 0xc0, 0x25, 0x4f, 0xc6, 0x38, 0xe6, 0xc2, 0xa1, 0xf1, 0x3f,
+	0x9d, 0x7b, 0xcd, 0x39, 0x86, 0x63, 0x8e, 0xe1, 0x98, 0x93, 0x38, 0x66, 0xde, 0xa0, 0xd2, 0x75,
+	0x9b, 0xee, 0x55, 0x8e, 0x6a, 0xa3, 0xbb, 0xc9, 0x7f, 0xf1, 0x1f, 0xfc, 0x3f, 0x41, 0x62, 0xe6,
+	0x89, 0x7b, 0x4f, 0xf9, 0x73, 0xb6, 0xcb, 0x26, 0x73, 0xd5, 0xea, 0x06, 0xae, 0x5f, 0xb7, 0x5a,
+	0xb6, 0xd3, 0xbc, 0xba, 0x9d, 0x9a, 0xcd, 0x8c, 0xa9, 0x74, 0x95, 0xd3, 0xee, 0xd9, 0xc7, 0xdb,
+	0xb0, 0xea, 0x59, 0x7d, 0x6e, 0xc4, 0x7d, 0xe8, 0x4e, 0x40, 0x1d, 0xdf, 0x76, 0x1d, 0xff, 0x0d,
+	0xec, 0x49, 0xa8, 0xb7, 0xad, 0xae, 0x8d, 0xd6, 0x21, 0x0b, 0xd3, 0x9b, 0x63, 0x4c, 0x6d, 0xab,
+	0xbe, 0x65, 0x3b, 0xd4, 0xdb, 0x0d, 0x87, 0x5f, 0xf5, 0xa8, 0xef, 0x76, 0xbd, 0x3a, 0x3d, 0xd4,
+	0x28, 0xff, 0x6a, 0x9b, 0x06, 0x56, 0x16, 0xad, 0xab, 0x79, 0xa3, 0xbc, 0xae, 0x13, 0xd8, 0xed,
+	0x34, 0x99, 0xb7, 0x1e, 0x34, 0xc0, 0xaf, 0x6f, 0xd1, 0xb6, 0x95, 0x1a, 0xf7, 0xa6, 0xbc, 0x71,
+	0xdd, 0xc0, 0x6e, 0x5d, 0xb5, 0x9d, 0xc0, 0x0f, 0xbc, 0xe4, 0x20, 0xf3, 0x53, 0x06, 0x9c, 0x9e,
+	0x5f, 0x5b, 0xae, 0xf1, 0x15, 0x5c, 0x71, 0x9b, 0x4d, 0xdb, 0x69, 0x92, 0xd7, 0xc1, 0xf8, 0x36,
+	0xf5, 0x36, 0x5c, 0xdf, 0x0e, 0x76, 0x2b, 0xc6, 0x15, 0xe3, 0xf1, 0xf2, 0xc2, 0xd4, 0xfe, 0xde,
+	0xec, 0xf8, 0x73, 0x61, 0x23, 0xc6, 0x70, 0xb2, 0x0c, 0x67, 0xb7, 0x82, 0xa0, 0x33, 0x5f, 0xaf,
+	0x53, 0xdf, 0x8f, 0x7a, 0x54, 0x4a, 0x7c, 0xd8, 0xc5, 0xfd, 0xbd, 0xd9, 0xb3, 0x37, 0xee, 0xdc,
+	0x59, 0x4b, 0x80, 0x31, 0x6b, 0x8c, 0xf9, 0xcb, 0x06, 0x9c, 0x89, 0x26, 0x83, 0xf4, 0xa5, 0x2e,
+	0xf5, 0x03, 0x9f, 0x20, 0x5c, 0x68, 0x5b, 0x3b, 0xb7, 0x5c, 0x67, 0xb5, 0x1b, 0x58, 0x81, 0xed,
+	0x34, 0x97, 0x9d, 0xcd, 0x96, 0xdd, 0xdc, 0x0a, 0xe4, 0xd4, 0x66, 0xf6, 0xf7, 0x66, 0x2f, 0xac,
+	0x66, 0xf6, 0xc0, 0x9c, 0x91, 0x6c, 0xd2, 0x6d, 0x6b, 0x27, 0x85, 0x50, 0x99, 0xf4, 0x6a, 0x1a,
+	0x8c, 0x59, 0x63, 0xcc, 0xb7, 0xc0, 0x19, 0xf1, 0x1c, 0x48, 0xfd, 0xc0, 0xb3, 0xeb, 0x81, 0xed,
+	0x3a, 0xe4, 0x0a, 0x0c, 0x3b, 0x56, 0x9b, 0xf2, 0x19, 0x8e, 0x2f, 0x4c, 0x7e, 0x79, 0x6f, 0xf6,
+	0x55, 0xfb, 0x7b, 0xb3, 0xc3, 0xb7, 0xac, 0x36, 0x45, 0x0e, 0x31, 0xff, 0x4b, 0x09, 0x1e, 0x4e,
+	0x8d, 0xbb, 0x6b, 0x07, 0x5b, 0xb7, 0x3b, 0xec, 0x3f, 0x9f, 0xfc, 0xa0, 0x01, 0x67, 0xac, 0x64,
+	0x07, 0x8e, 0x70, 0xe2, 0xc9, 0xa5, 0xb9, 0xc3, 0x7f, 0xe0, 0x73, 0x29, 0x6a, 0x0b, 0x97, 0xe4,
+	0xbc, 0xd2, 0x0f, 0x80, 0x69, 0xd2, 0xe4, 0x13, 0x06, 0x8c, 0xba, 0x62, 0x72, 0x95, 0xd2, 0x95,
+	0xa1, 0xc7, 0x27, 0x9e, 0xfc, 0x8e, 0x23, 0x99, 0x86, 0xf2, 0xd0, 0x73, 0xf2, 0xef, 0x92, 0x13,
+	0x78, 0xbb, 0x0b, 0xa7, 0xe4, 0xf4, 0x46, 0x65, 0x2b, 0x86, 0xe4, 0x67, 0x9e, 0x86, 0x49, 0xb5,
+	0x27, 0x39, 0x0d, 0x43, 0xf7, 0xa8, 0xd8, 0xaa, 0xe3, 0xc8, 0xfe, 0x25, 0xe7, 0xa0, 0xbc, 0x6d,
+	0xb5, 0xba, 0x94, 0xbf, 0xd2, 0x71, 0x14, 0x3f, 0x9e, 0x2e, 0x3d, 0x65, 0x98, 0x4f, 0x42, 0x79,
+	0xbe, 0xd1, 0x70, 0x1d, 0xf2, 0x04, 0x8c, 0x52, 0xc7, 0xda, 0x68, 0xd1, 0x06, 0x1f, 0x38, 0x16,
+	0xd3, 0x5b, 0x12, 0xcd, 0x18, 0xc2, 0xcd, 0x1f, 0x2b, 0xc1, 0x08, 0x1f, 0xe4, 0x93, 0x1f, 0x31,
+	0xe0, 0xec, 0xbd, 0xee, 0x06, 0xf5, 0x1c, 0x1a, 0x50, 0x7f, 0xd1, 0xf2, 0xb7, 0x36, 0x5c, 0xcb,
+	0x6b, 0xc8, 0x17, 0x73, 0xbd, 0xc8, 0x8a, 0xdc, 0x4c, 0xa3, 0x13, 0x7b, 0x30, 0x03, 0x80, 0x59,
+	0xc4, 0xc9, 0x36, 0x4c, 0x3a, 0x4d, 0xdb, 0xd9, 0x59, 0x76, 0x9a, 0x1e, 0xf5, 0x7d, 0xfe, 0xd0,
+	0x13, 0x4f, 0xbe, 0xab, 0xc8, 0x64, 0x6e, 0x29, 0x78, 0x16, 0x4e, 0xef, 0xef, 0xcd, 0x4e, 0xaa,
+	0x2d, 0xa8, 0xd1, 0x31, 0xff, 0xd6, 0x80, 0x53, 0xf3, 0x8d, 0xb6, 0xed, 0x33, 0x4e, 0xbb, 0xd6,
+	0xea, 0x36, 0xed, 0x3e, 0xb6, 0x3e, 0x79, 0x0f, 0x8c, 0xd4, 0x5d, 0x67, 0xd3, 0x6e, 0xca, 0x79,
+	0xbe, 0x61, 0x4e, 0x70, 0xae, 0x39, 0x95, 0x73, 0xf1, 0xe9, 0x49, 0x8e, 0x37, 0x87, 0xd6, 0xfd,
+	0xa5, 0x90, 0xa1, 0x2f, 0xc0, 0xfe, 0xde, 0xec, 0x48, 0x95, 0x23, 0x40, 0x89, 0x88, 0x3c, 0x0e,
+	0x63, 0x0d, 0xdb, 0x17, 0x2f, 0x73, 0x88, 0xbf, 0xcc, 0xc9, 0xfd, 0xbd, 0xd9, 0xb1, 0x45, 0xd9,
+	0x86, 0x11, 0x94, 0xac, 0xc0, 0x39, 0xb6, 0x82, 0x62, 0x5c, 0x8d, 0xd6, 0x3d, 0x1a, 0xb0, 0xa9,
+	0x55, 0x86, 0xf9, 0x74, 0x2b, 0xfb, 0x7b, 0xb3, 0xe7, 0x6e, 0x66, 0xc0, 0x31, 0x73, 0x94, 0xf9,
+	0x85, 0x12, 0x8c, 0xcd, 0xb7, 0xa8, 0xc7, 0x38, 0x02, 0x79, 0x1a, 0xa6, 0x69, 0xdb, 0xb2, 0x5b,
+	0x48, 0xeb, 0xd4, 0xde, 0xa6, 0x9e, 0x5f, 0x31, 0xae, 0x0c, 0x3d, 0x3e, 0xbe, 0x40, 0xf6, 0xf7,
+	0x66, 0xa7, 0x97, 0x34, 0x08, 0x26, 0x7a, 0x92, 0x2e, 0x8c, 0x7b, 0xd1, 0x30, 0xf1, 0x75, 0x2d,
+	0x16, 0xfa, 0xba, 0xe4, 0x64, 0x42, 0xcc, 0x0b, 0x67, 0xe4, 0x0b, 0x18, 0x8f, 0x69, 0xc7, 0x94,
+	0xc8, 0x06, 0x94, 0xbd, 0x6e, 0x8b, 0xfa, 0x7c, 0xd1, 0x26, 0x9e, 0x9c, 0x1f, 0x88, 0x24, 0x43,
+	0xb4, 0x30, 0xbe, 0xbf, 0x37, 0x5b, 0xe6, 0xff, 0xa2, 0x40, 0x6d, 0x7e, 0x71, 0x18, 0x4e, 0x27,
+	0xa7, 0xd5, 0xc7, 0x2e, 0x99, 0x03, 0xf0, 0xe9, 0x36, 0xf5, 0xec, 0xc0, 0xa6, 0x62, 0x49, 0xc6,
+	0x17, 0xa6, 0xf7, 0xf7, 0x66, 0xa1, 0x16, 0xb5, 0xa2, 0xd2, 0x83, 0xf5, 0xaf, 0xbb, 0xed, 0x8e,
+	0xeb, 0x50, 0x27, 0x60, 0xcf, 0x13, 0xf5, 0xaf, 0x46, 0xad, 0xa8, 0xf4, 0x20, 0x6f, 0x86, 0x49,
+	0x9f, 0x3a, 0x0d, 0xa4, 0xbe, 0xdb, 0xda, 0xa6, 0x0d, 0xbe, 0x01, 0xc6, 0xc4, 0x8e, 0xaf, 0x29,
+	0xed, 0xa8, 0xf5, 0x22, 0x1e, 0x8c, 0xde, 0xa7, 0x1b, 0x5b, 0xae, 0x7b, 0xaf, 0x52, 0xe6, 0x4b,
+	0x76, 0xb3, 0xc8, 0x92, 0xdd, 0x15, 0x28, 0x52, 0x2f, 0x6b, 0x82, 0x71, 0x1f, 0x09, 0xc4, 0x90,
+	0x10, 0x79, 0x11, 0xca, 0x7e, 0xcb, 0xaa, 0xdf, 0xab, 0x8c, 0x70, 0x8a, 0xcb, 0x45, 0x28, 0xd6,
+	0x18, 0x82, 0x14, 0x3d, 0xfe, 0xb2, 0x38, 0x08, 0x05, 0x09, 0xf2, 0x32, 0x8c, 0x77, 0xac, 0x26,
+	0xf5, 0x16, 0xbb, 0xc1, 0x6e, 0x65, 0x94, 0xd3, 0x5b, 0x2d, 0x42, 0x6f, 0x2d, 0x44, 0x92, 0xa2,
+	0xc9, 0x25, 0x89, 0x08, 0x8c, 0x31, 0x39, 0x73, 0x05, 0xa6, 0xb4, 0xbd, 0x44, 0xde, 0x01, 0x53,
+	0xe2, 0x8b, 0x5b, 0xb5, 0x3a, 0xb7, 0xe2, 0xdd, 0x72, 0x5e, 0xee, 0x96, 0xa9, 0xaa, 0x0a, 0x44,
+	0xbd, 0xaf, 0xf9, 0x31, 0x03, 0x26, 0xe6, 0xbb, 0x0d, 0x3b, 0x10, 0xbd, 0x88, 0x07, 0x13, 0x16,
+	0xfb, 0xb9, 0xe6, 0xb6, 0xec, 0xfa, 0xae, 0xe4, 0xd7, 0xcf, 0x16, 0xda, 0xf0, 0x31, 0x9a, 0x85,
+	0x53, 0xfb, 0x7b, 0xb3, 0x13, 0x4a, 0x03, 0xaa, 0x44, 0xcc, 0x2d, 0x50, 0x61, 0xe4, 0xbd, 0x30,
+	0x19, 0xcd, 0x11, 0xe9, 0xa6, 0x9c, 0xc3, 0xa3, 0x0a, 0xfb, 0x0b, 0x09, 0xcd, 0xdd, 0xde, 0x78,
+	0x91, 0xd6, 0x03, 0xa4, 0x9b, 0xd4, 0xa3, 0x4e, 0x9d, 0x8a, 0x7d, 0x59, 0x55, 0x06, 0xa3, 0x86,
+	0xca, 0xfc, 0x5f, 0x0c, 0x78, 0x64, 0xbe, 0x1b, 0x6c, 0xb9, 0x9e, 0xfd, 0x32, 0xf5, 0x62, 0x0e,
+	0x16, 0x61, 0x20, 0xef, 0x84, 0x69, 0x2b, 0xea, 0xa0, 0xac, 0xe6, 0x05, 0xb9, 0x9a, 0xd3, 0xf3,
+	0x1a, 0x14, 0x13, 0xbd, 0xc9, 0x93, 0xec, 0x7b, 0x8c, 0xd8, 0x25, 0x3f, 0x56, 0x17, 0x88, 0x1c,
+	0x0b, 0x0a, 0xa3, 0x54, 0x7a, 0x99, 0x7f, 0xc6, 0xa4, 0xcb, 0x6d, 0xcb, 0x6e, 0x59, 0x1b, 0x76,
+	0xcb, 0x0e, 0x76, 0xdf, 0xe7, 0x3a, 0xb4, 0x8f, 0x4f, 0x7f, 0x1d, 0x2e, 0x76, 0x1d, 0x4b, 0x8c,
+	0x6b, 0xd1, 0x55, 0x71, 0x24, 0xdc, 0xd9, 0xed, 0x44, 0x7c, 0xe0, 0xa1, 0xfd, 0xbd, 0xd9, 0x8b,
+	0xeb, 0xd9, 0x5d, 0x30, 0x6f, 0x2c, 0x13, 0x24, 0x15, 0xd0, 0x73, 0x6e, 0xab, 0xdb, 0x96, 0x58,
+	0x05, 0xb7, 0xe0, 0x82, 0xe4, 0x7a, 0x66, 0x0f, 0xcc, 0x19, 0x69, 0x7e, 0xb1, 0x04, 0x23, 0x0b,
+	0x56, 0xfd, 0x5e, 0xb7, 0x43, 0x5e, 0x0f, 0x63, 0x1d, 0xcf, 0xdd, 0xb6, 0x1b, 0xd4, 0x93, 0xcf,
+	0x76, 0x5a, 0x3e, 0xdb, 0xd8, 0x9a, 0x6c, 0xc7, 0xa8, 0x07, 0xb1, 0x61, 0x3a, 0xfc, 0xbf, 0x3a,
+	0xc0, 0x61, 0xc8, 0xcf, 0x96, 0x35, 0x0d, 0x11, 0x26, 0x10, 0x13, 0x13, 0x46, 0x3c, 0xda, 0x64,
+	0xd2, 0xe3, 0x10, 0x9f, 0x16, 0x3f, 0x40, 0x91, 0xb7, 0xa0, 0x84, 0x90, 0x0f, 0xc0, 0x74, 0xdd,
+	0xa3, 0x0d, 0xea, 0x04, 0xb6, 0xd5, 0xf2, 0xd9, 0xe6, 0x2c, 0xf7, 0xbf, 0x39, 0xf9, 0x24, 0xaa,
+	0xda, 0x70, 0x4c, 0xa0, 0x33, 0xbf, 0x5c, 0x82, 0x49, 0xb1, 0x50, 0x0b, 0xdd, 0xfa, 0x3d, 0x1a,
+	0x90, 0xef, 0x84, 0x31, 0x76, 0x65, 0x6a, 0x58, 0x81, 0x25, 0x3f, 0x84, 0x6f, 0xcd, 0x7d, 0x74,
+	0xfe, 0x0d, 0xb2, 0xde, 0x31, 0xf5, 0x55, 0x1a, 0x58, 0xf1, 0xfe, 0x8b, 0xdb, 0x30, 0xc2, 0x4a,
+	0x36, 0x61, 0xd8, 0xef, 0xd0, 0xba, 0x5c, 0xd8, 0x42, 0xc7, 0xa9, 0x3a, 0xe3, 0x5a, 0x87, 0xd6,
+	0xe3, 0xed, 0xca, 0x7e, 0x21, 0xc7, 0x4f, 0x1c, 0x18, 0xf1, 0x03, 0x2b, 0xe8, 0x86, 0xa7, 0xe8,
+	0xb5, 0x81, 0x29, 0x71, 0x6c, 0x0b, 0xd3, 0x92, 0xd6, 0x88, 0xf8, 0x8d, 0x92, 0x8a, 0xf9, 0x47,
+	0x06, 0x9c, 0x56, 0xbb, 0xaf, 0xd8, 0x7e, 0x40, 0xbe, 0x3d, 0xb5, 0x9c, 0x73, 0xfd, 0x2d, 0x27,
+	0x1b, 0xcd, 0x17, 0x33, 0xda, 0xad, 0x61, 0x8b, 0xb2, 0x94, 0x14, 0xca, 0x76, 0x40, 0xdb, 0xa1,
+	0x68, 0xf2, 0xae, 0x41, 0x9f, 0x70, 0x61, 0x4a, 0x12, 0x2b, 0x2f, 0x33, 0xb4, 0x28, 0xb0, 0x9b,
+	0xdf, 0x09, 0xe7, 0xd4, 0x5e, 0xe1, 0xbe, 0x66, 0x2c, 0x23, 0xd8, 0xed, 0xa4, 0x58, 0x06, 0xfb,
+	0x04, 0x91, 0x43, 0xc8, 0x6b, 0xa3, 0x3d, 0x2e, 0x38, 0x53, 0xb4, 0x76, 0xfa, 0x3e, 0x37, 0x3f,
+	0x3a, 0xa4, 0xaf, 0x1d, 0x7b, 0x8d, 0x64, 0x3b, 0xf1, 0xe5, 0x4e, 0x3c, 0x79, 0x63, 0xd0, 0x07,
+	0x0c, 0xa7, 0xfe, 0xcd, 0xc2, 0x03, 0x1e, 0x87, 0x31, 0x9f, 0xd2, 0x86, 0x22, 0xea, 0x72, 0x01,
+	0xb9, 0x26, 0xdb, 0x30, 0x82, 0x1e, 0x3f, 0x27, 0xf8, 0xfc, 0x30, 0x90, 0xf4, 0x6e, 0x57, 0x17,
+	0x43, 0xb4, 0x54, 0x8c, 0x81, 0x17, 0x43, 0x7e, 0x38, 0x09, 0xc4, 0xe4, 0x65, 0x98, 0x6a, 0x59,
+	0x7e, 0x70, 0xbb, 0x43, 0x3d, 0x2b, 0x08, 0xf7, 0x4c, 0x41, 0xe9, 0x77, 0x45, 0x45, 0xb4, 0x70,
+	0x86, 0x89, 0x25, 0x5a, 0x13, 0xea, 0xa4, 0xc8, 0x8b, 0x30, 0xce, 0x1a, 0x96, 0x3c, 0xcf, 0xf5,
+	0x24, 0xbf, 0x78, 0xa6, 0x28, 0x5d, 0x8e, 0x44, 0x08, 0x54, 0xd1, 0x4f, 0x8c, 0xd1, 0x93, 0x77,
+	0x03, 0x71, 0x37, 0xb8, 0x72, 0xac, 0x71, 0x9d, 0x3a, 0xe1, 0xc3, 0xb2, 0xd7, 0x3f, 0xb4, 0x30,
+	0x23, 0xf7, 0x25, 0xb9, 0x9d, 0xea, 0x81, 0x19, 0xa3, 0xc8, 0x3d, 0x20, 0x91, 0xee, 0x48, 0x9c,
+	0xf6, 0x07, 0x6c, 0x8d, 0xa8, 0x93, 0xdc, 0x1a, 0x17, 0x18, 0xb1, 0xeb, 0x29, 0x14, 0x98, 0x81,
+	0xd6, 0xfc, 0xcd, 0x12, 0x4c, 0x88, 0x2d, 0x22, 0xee, 0xf7, 0xc7, 0x7f, 0x56, 0x50, 0xed, 0xac,
+	0xa8, 0x16, 0xff, 0xfc, 0xf9, 0x84, 0x73, 0x8f, 0x8a, 0x76, 0xe2, 0xa8, 0x58, 0x1a, 0x94, 0x50,
+	0xef, 0x93, 0xe2, 0x0f, 0x0d, 0x38, 0xa5, 0xf4, 0x3e, 0x81, 0x83, 0xa2, 0xa1, 0x1f, 0x14, 0xcf,
+	0x0e, 0xf8, 0x7c, 0x39, 0xe7, 0x84, 0xab, 0x3d, 0x16, 0xe7, 0xe1, 0x4f, 0x02, 0x6c, 0x70, 0x76,
+	0xa2, 0x88, 0xb6, 0xd1, 0x2b, 0x5f, 0x88, 0x20, 0xa8, 0xf4, 0xd2, 0x98, 0x62, 0xa9, 0x17, 0x53,
+	0x34, 0xff, 0xcd, 0x10, 0x9c, 0x49, 0x2d, 0x7b, 0x9a, 0x8f, 0x18, 0xdf, 0x20, 0x3e, 0x52, 0xfa,
+	0x46, 0xf0, 0x91, 0xa1, 0x42, 0x7c, 0xa4, 0xff, 0x83, 0xc8, 0x03, 0xd2, 0xb6, 0x9b, 0x62, 0x58,
+	0x2d, 0xb0, 0xbc, 0xe0, 0x8e, 0xdd, 0xa6, 0x92, 0xe3, 0x7c, 0x4b, 0x7f, 0x5b, 0x96, 0x8d, 0x10,
+	0x8c, 0x67, 0x35, 0x85, 0x09, 0x33, 0xb0, 0x9b, 0xdf, 0x5d, 0x82, 0xd1, 0x05, 0xcb, 0xe7, 0x33,
+	0xfd, 0x30, 0x4c, 0x4a, 0xd4, 0xcb, 0x6d, 0xab, 0x49, 0x07, 0xd1, 0xf0, 0x49, 0x94, 0xab, 0x0a,
+	0x3a, 0x71, 0xa3, 0x53, 0x5b, 0x50, 0x23, 0x47, 0x76, 0x61, 0xa2, 0x1d, 0xdf, 0x5e, 0x2a, 0xa5,
+	0x41, 0x44, 0x4b, 0x95, 0x3a, 0xc3, 0x26, 0xae, 0xad, 0x4a, 0x03, 0xaa, 0xb4, 0xcc, 0x17, 0xe0,
+	0x6c, 0xc6, 0x8c, 0xfb, 0xb8, 0xb8, 0xbd, 0x06, 0x46, 0x99, 0x5a, 0x29, 0x16, 0xc3, 0xb8, 0x42,
+	0xe3, 0x39, 0xd1, 0x84, 0x21, 0xcc, 0x7c, 0x2b, 0x10, 0x1d, 0x3f, 0xa3, 0xda, 0x8f, 0xce, 0xbc,
+	0x0c, 0x50, 0x9d, 0x47, 0x37, 0x10, 0x5b, 0xe9, 0x59, 0x28, 0x77, 0xb6, 0x2c, 0x3f, 0x1c, 0xf1,
+	0x44, 0xc8, 0x2a, 0xd6, 0x58, 0xe3, 0x83, 0xbd, 0xd9, 0x8a, 0x2a, 0x88, 0xc8, 0x41, 0x1c, 0x86,
+	0x62, 0x1c, 0xdb, 0x61, 0x6c, 0x93, 0x33, 0x05, 0x51, 0x8b, 0x32, 0x28, 0xdf, 0x61, 0xa5, 0x62,
+	0x3b, 0x6c, 0x25, 0x85, 0x09, 0x33, 0xb0, 0x87, 0x34, 0x97, 0x1d, 0x3b, 0xb0, 0xad, 0x88, 0xe6,
+	0x50, 0x71, 0x9a, 0x3a, 0x26, 0xcc, 0xc0, 0x4e, 0x3e, 0x65, 0xc0, 0x8c, 0xde, 0x7c, 0xcd, 0x76,
+	0x6c, 0x7f, 0x8b, 0x36, 0xee, 0xd8, 0xf2, 0x33, 0x3c, 0x1c, 0xf1, 0xcb, 0xfb, 0x7b, 0xb3, 0x33,
+	0x2b, 0xb9, 0x18, 0xb1, 0x07, 0x35, 0xf2, 0x69, 0x03, 0x1e, 0x4a, 0xac, 0x8b, 0x67, 0x37, 0x9b,
+	0xd4, 0xa3, 0x8d, 0x82, 0x1f, 0xf8, 0xec, 0xfe, 0xde, 0xec, 0x43, 0x2b, 0xf9, 0x28, 0xb1, 0x17,
+	0x3d, 0xf2, 0x05, 0x03, 0x2e, 0x74, 0xa8, 0xd3, 0xb0, 0x9d, 0xe6, 0x5d, 0xd7, 0xbb, 0xc7, 0x14,
+	0xa4, 0x6e, 0xab, 0xe5, 0x76, 0x03, 0xbf, 0x32, 0x72, 0x65, 0xa8, 0xa8, 0xbe, 0x6d, 0x2d, 0x0b,
+	0xe3, 0xc2, 0x65, 0xb9, 0x45, 0x2f, 0x64, 0x82, 0x7d, 0xcc, 0x99, 0x88, 0xf9, 0x1b, 0x06, 0x0c,
+	0x55, 0x71, 0x99, 0xbc, 0x4e, 0xfb, 0x44, 0x2e, 0xaa, 0x9f, 0xc8, 0x83, 0xbd, 0xd9, 0xd1, 0x2a,
+	0x2e, 0x2b, 0x1f, 0xe3, 0xa7, 0x0d, 0x38, 0x53, 0x77, 0x9d, 0xc0, 0x62, 0x6b, 0x87, 0x42, 0x56,
+	0x1e, 0x48, 0xb7, 0x5c, 0x4d, 0x20, 0x8b, 0xed, 0x47, 0x49, 0x88, 0x8f, 0x69, 0xca, 0xe6, 0x4f,
+	0x1b, 0x70, 0xae, 0x6a, 0x75, 0xa4, 0x2a, 0x68, 0x91, 0x6e, 0xda, 0x8e, 0xdd, 0x9f, 0xb1, 0x8c,
+	0x6c, 0xc1, 0x08, 0x37, 0xe0, 0xf8, 0x83, 0xdc, 0xe5, 0x63, 0xda, 0xcf, 0x71, 0x5c, 0x42, 0x0f,
+	0x22, 0xfe, 0x47, 0x89, 0xdf, 0xfc, 0x7f, 0x4b, 0x30, 0x15, 0x77, 0xac, 0xd1, 0x80, 0xfc, 0x94,
+	0x01, 0x93, 0xf5, 0xb0, 0xc5, 0xa6, 0x42, 0xa9, 0x3f, 0xf1, 0x64, 0x6d, 0xb0, 0x29, 0xd4, 0x68,
+	0x10, 0xff, 0xb2, 0xa9, 0xb4, 0x78, 0x3d, 0x26, 0x9f, 0x7d, 0x52, 0x05, 0x3d, 0x48, 0xfc, 0x46,
+	0x6d, 0x3a, 0x33, 0xdf, 0x6b, 0xc0, 0x99, 0x14, 0xa6, 0x0c, 0x8b, 0xd8, 0xfb, 0x54, 0x8b, 0xd8,
+	0x11, 0x2d, 0xa1, 0x6a, 0x57, 0x7b, 0x06, 0x4e, 0x27, 0xc1, 0x64, 0x36, 0x94, 0x06, 0x85, 0x21,
+	0x64, 0x3c, 0x29, 0xc8, 0x3d, 0x3d, 0xf6, 0xbf, 0x7e, 0x7e, 0xf6, 0x55, 0x1f, 0xfd, 0x93, 0x2b,
+	0xaf, 0x32, 0xbf, 0x6a, 0xc0, 0x64, 0xb5, 0xe5, 0x76, 0x1b, 0x6b, 0x9e, 0xbb, 0x69, 0xb7, 0xe8,
+	0x2b, 0x43, 0x3f, 0xa4, 0xce, 0x38, 0x4f, 0xe8, 0xe7, 0xfa, 0x1a, 0xb5, 0xe3, 0x2b, 0x44, 0x5f,
+	0xa3, 0x4e, 0x39, 0x47, 0x0e, 0x7f, 0x3f, 0x9c, 0x57, 0x7b, 0xc5, 0xca, 0xe6, 0x2b, 0x30, 0x7c,
+	0xcf, 0x76, 0x1a, 0xc9, 0x4f, 0xfa, 0xa6, 0xed, 0x34, 0x90, 0x43, 0xa2, 0x8f, 0xbe, 0x94, 0x7b,
+	0xda, 0xef, 0x8d, 0xeb, 0xcb, 0xc6, 0xc5, 0xfc, 0xc7, 0x61, 0xac, 0x6e, 0x2d, 0x74, 0x9d, 0x46,
+	0x2b, 0xe2, 0x17, 0x6c, 0x09, 0xaa, 0xf3, 0xa2, 0x0d, 0x23, 0x28, 0x79, 0x19, 0x20, 0x36, 0x95,
+	0x0e, 0x22, 0x3e, 0xc5, 0x56, 0xd8, 0x1a, 0x0d, 0x02, 0xdb, 0x69, 0xfa, 0xf1, 0xbe, 0x8a, 0x61,
+	0xa8, 0x50, 0x23, 0x1f, 0x86, 0x29, 0x55, 0x96, 0x13, 0x0a, 0xe6, 0x82, 0xaf, 0x41, 0x13, 0x1a,
+	0x23, 0xd3, 0x87, 0xda, 0xea, 0xa3, 0x4e, 0x8d, 0xec, 0x46, 0x92, 0xab, 0x50, 0x6f, 0x0f, 0x17,
+	0xbf, 0x8b, 0xa9, 0x42, 0xe3, 0xb9, 0x90, 0x3b, 0x69, 0xea, 0x76, 0x8d, 0x54, 0x86, 0x4a, 0xab,
+	0x7c, 0x5c, 0x2a, 0x2d, 0x0a, 0xa3, 0x42, 0xa9, 0x17, 0x1e, 0xd4, 0x4f, 0x17, 0x79, 0x40, 0xa1,
+	0x1f, 0x8c, 0x6d, 0xff, 0xe2, 0xb7, 0x8f, 0x21, 0x6e, 0x66, 0x5b, 0x67, 0x57, 0x92, 0x1a, 0x6d,
+	0xd1, 0x7a, 0xe0, 0x7a, 0xd2, 0x28, 0x56, 0xe8, 0x55, 0xd6, 0x14, 0x3c, 0xa1, 0xa5, 0x31, 0x6e,
+	0x41, 0x8d, 0x4e, 0xa4, 0xf3, 0x1c, 0xcb, 0xd5, 0x79, 0x76, 0x61, 0x62, 0x5b, 0x31, 0x62, 0x8c,
+	0xf3, 0x45, 0x78, 0x67, 0x91, 0x89, 0xc5, 0x16, 0x8d, 0x85, 0xb3, 0x92, 0xd0, 0x84, 0x6a, 0xfd,
+	0x50, 0xe9, 0x90, 0x0d, 0x18, 0xdd, 0x10, 0xd2, 0x7b, 0x05, 0xf8, 0x5a, 0xbc, 0x63, 0x80, 0x4b,
+	0x89, 0xb8, 0x21, 0xc8, 0x1f, 0x18, 0x22, 0x26, 0x2f, 0xc0, 0x48, 0xcb, 0x6e, 0xdb, 0x81, 0x5f,
+	0x99, 0xb8, 0x62, 0x14, 0x7d, 0xb5, 0x2b, 0x1c, 0x83, 0x38, 0xe6, 0xc5, 0xff, 0x28, 0xb1, 0x92,
+	0x8f, 0x27, 0x0f, 0xf5, 0xc9, 0x2b, 0x43, 0x45, 0xd5, 0xbe, 0x59, 0x32, 0x4d, 0xfc, 0xad, 0xe4,
+	0x9f, 0xdc, 0xe6, 0x3f, 0x9a, 0x82, 0x33, 0xd5, 0x56, 0xd7, 0x0f, 0xa8, 0x37, 0x2f, 0x3d, 0xe8,
+	0xa8, 0xc7, 0xa6, 0x76, 0x81, 0xff, 0xbb, 0xe8, 0xde, 0x77, 0x16, 0x69, 0xcb, 0xda, 0x9d, 0xdf,
+	0x64, 0x3d, 0x1a, 0x8d, 0xc3, 0x9d, 0x13, 0x8b, 0x5d, 0xa9, 0x4b, 0xe0, 0x66, 0xad, 0x5a, 0x26,
+	0x46, 0xcc, 0xa1, 0x44, 0xbe, 0xdf, 0x80, 0x4b, 0x19, 0xa0, 0x45, 0xda, 0xa2, 0x41, 0x28, 0x41,
+	0x1c, 0x76, 0x1e, 0x8f, 0xec, 0xef, 0xcd, 0x5e, 0xaa, 0xe5, 0x21, 0xc5, 0x7c, 0x7a, 0xcc, 0x15,
+	0x6a, 0x26, 0x03, 0x7a, 0xcd, 0xb2, 0x5b, 0x5d, 0x2f, 0xbc, 0x3c, 0x1d, 0x76, 0x3a, 0xfc, 0x0e,
+	0x53, 0xcb, 0xc5, 0x8a, 0x3d, 0x28, 0x92, 0x8f, 0xc0, 0xf9, 0x08, 0xba, 0xee, 0x38, 0x94, 0x36,
+	0xb4, 0xab, 0xd4, 0x61, 0xa7, 0x72, 0x69, 0x7f, 0x6f, 0xf6, 0x7c, 0x2d, 0x0b, 0x21, 0x66, 0xd3,
+	0x21, 0x4d, 0x78, 0x24, 0x06, 0x04, 0x76, 0xcb, 0x7e, 0x59, 0xdc, 0xf6, 0xb6, 0x3c, 0xea, 0x6f,
+	0xb9, 0xad, 0x06, 0xe7, 0xba, 0xc6, 0xc2, 0xab, 0xf7, 0xf7, 0x66, 0x1f, 0xa9, 0xf5, 0xea, 0x88,
+	0xbd, 0xf1, 0x90, 0x06, 0x4c, 0xfa, 0x75, 0xcb, 0x59, 0x76, 0x02, 0xea, 0x6d, 0x5b, 0xad, 0xca,
+	0x48, 0xa1, 0x07, 0x14, 0xbc, 0x4e, 0xc1, 0x83, 0x1a, 0x56, 0xf2, 0x14, 0x8c, 0xd1, 0x9d, 0x8e,
+	0xe5, 0x34, 0xa8, 0xe0, 0xaf, 0xe3, 0x0b, 0x0f, 0xb3, 0x53, 0x7d, 0x49, 0xb6, 0x31, 0x09, 0x38,
+	0xfc, 0x7f, 0xd5, 0x6d, 0x50, 0x8c, 0x7a, 0x93, 0x0f, 0xc1, 0x39, 0xee, 0xe2, 0xd7, 0xa0, 0xfc,
+	0xb4, 0xf0, 0xc3, 0x0b, 0xf5, 0x58, 0xa1, 0x79, 0x72, 0xf7, 0x9f, 0xd5, 0x0c, 0x7c, 0x98, 0x49,
+	0x85, 0xbd, 0x86, 0xb6, 0xb5, 0x73, 0xdd, 0xb3, 0xea, 0x74, 0xb3, 0xdb, 0xba, 0x43, 0xbd, 0xb6,
+	0xed, 0x08, 0x8d, 0x12, 0x33, 0xbf, 0x37, 0x18, 0x4f, 0x66, 0x0e, 0x85, 0xfc, 0x35, 0xac, 0xf6,
+	0xea, 0x88, 0xbd, 0xf1, 0x30, 0x67, 0x15, 0xbb, 0xe9, 0xb8, 0x1e, 0xbd, 0x63, 0xd9, 0xcc, 0xbd,
+	0x05, 0xb8, 0x3c, 0xcd, 0x97, 0x75, 0x59, 0x69, 0x47, 0xad, 0x17, 0xd9, 0x06, 0xe2, 0xd0, 0xfb,
+	0x6b, 0x6e, 0x83, 0x6f, 0x81, 0xf5, 0x0e, 0xdf, 0xc8, 0x95, 0x89, 0x42, 0x4b, 0xc3, 0xf5, 0x0d,
+	0xb7, 0x52, 0xd8, 0x30, 0x83, 0x02, 0xb9, 0x06, 0xa4, 0x6d, 0xed, 0x2c, 0xb5, 0x3b, 0xc1, 0xee,
+	0x42, 0xb7, 0x75, 0x4f, 0x72, 0x8d, 0x49, 0xbe, 0x16, 0x42, 0x1b, 0x97, 0x82, 0x62, 0xc6, 0x08,
+	0x62, 0xc1, 0x43, 0xe2, 0x79, 0x16, 0x2d, 0xda, 0x76, 0x1d, 0x9f, 0x06, 0xbe, 0xb2, 0x49, 0x2b,
+	0x53, 0xdc, 0x63, 0x87, 0xdf, 0xfe, 0x97, 0xf3, 0xbb, 0x61, 0x2f, 0x1c, 0xba, 0xab, 0xeb, 0xf4,
+	0x01, 0xae, 0xae, 0x6f, 0x83, 0x29, 0x3f, 0xb0, 0xbc, 0xa0, 0xdb, 0x91, 0xaf, 0xe1, 0x14, 0x7f,
+	0x0d, 0x5c, 0x59, 0x5b, 0x53, 0x01, 0xa8, 0xf7, 0x63, 0xaf, 0x4f, 0x68, 0xe4, 0xe5, 0xb8, 0xd3,
+	0xf1, 0xeb, 0xab, 0x29, 0xed, 0xa8, 0xf5, 0x62, 0x6e, 0x10, 0x6d, 0x6b, 0x27, 0xfa, 0x7c, 0xd7,
+	0x2c, 0xcf, 0x6a, 0xb5, 0x68, 0xcb, 0xf6, 0xdb, 0x95, 0x33, 0x7c, 0xa6, 0xdc, 0x0d, 0x62, 0x35,
+	0xbb, 0x0b, 0xe6, 0x8d, 0x95, 0xbe, 0xaf, 0x8b, 0x9e, 0x65, 0x6b, 0x28, 0x89, 0xe6, 0xfb, 0x9a,
+	0x04, 0x63, 0xd6, 0x18, 0xf3, 0x3f, 0x0f, 0x43, 0x25, 0x75, 0x82, 0x85, 0x0e, 0xac, 0x07, 0xf2,
+	0x28, 0xe3, 0x88, 0x78, 0x54, 0x07, 0xae, 0x44, 0x1d, 0xae, 0x77, 0xba, 0x99, 0xb4, 0x4a, 0x9c,
+	0xd6, 0x63, 0xfb, 0x7b, 0xb3, 0x57, 0x6a, 0x07, 0xf4, 0xc5, 0x03, 0xb1, 0xe5, 0xf3, 0xff, 0xa1,
+	0x13, 0xe2, 0xff, 0x1f, 0x82, 0x73, 0x0a, 0xc0, 0xa3, 0x56, 0x63, 0x77, 0x80, 0xf3, 0x87, 0xb3,
+	0xbd, 0x5a, 0x06, 0x3e, 0xcc, 0xa4, 0x92, 0xcb, 0x74, 0xcb, 0x27, 0xc1, 0x74, 0xcd, 0xbd, 0x21,
+	0x18, 0xaf, 0xba, 0x4e, 0x43, 0x28, 0x8f, 0xde, 0xa8, 0xb9, 0x06, 0x3c, 0xa2, 0x8a, 0xc9, 0x0f,
+	0x84, 0x8b, 0x98, 0xe8, 0xa8, 0xc8, 0xcd, 0x6f, 0x8f, 0x8c, 0x70, 0xe2, 0xf2, 0xf9, 0x6a, 0xdd,
+	0x7a, 0xf6, 0x60, 0x6f, 0xf6, 0x54, 0x34, 0x4c, 0x37, 0xa8, 0x31, 0x8e, 0xda, 0xb2, 0xfc, 0xe0,
+	0x8e, 0x67, 0x39, 0xbe, 0x3d, 0x80, 0xf6, 0x36, 0xb2, 0x9a, 0xac, 0xa4, 0xb0, 0x61, 0x06, 0x05,
+	0xf2, 0x22, 0x4c, 0xb3, 0xd6, 0xf5, 0x4e, 0xc3, 0x0a, 0x68, 0x41, 0xa5, 0x6d, 0xe4, 0xe8, 0xb5,
+	0xa2, 0x61, 0xc2, 0x04, 0x66, 0xe1, 0x4a, 0x61, 0xf9, 0xae, 0x53, 0x29, 0x27, 0x5d, 0x29, 0x2c,
+	0x5f, 0xb8, 0x52, 0x58, 0xbe, 0xf0, 0x9f, 0x6e, 0x53, 0xdf, 0x67, 0xa6, 0x91, 0x11, 0xde, 0x31,
+	0xba, 0x43, 0xad, 0x8a, 0x66, 0x0c, 0xe1, 0xe4, 0xf5, 0x50, 0xae, 0xbb, 0x0d, 0xea, 0x57, 0x46,
+	0x39, 0xe3, 0x63, 0x67, 0x40, 0xb9, 0xca, 0x1a, 0x1e, 0xec, 0xcd, 0x8e, 0x73, 0x1b, 0x13, 0xfb,
+	0x85, 0xa2, 0x93, 0xf9, 0xbf, 0x31, 0x7d, 0x49, 0x42, 0x7d, 0xd8, 0x87, 0x0b, 0xc8, 0xc9, 0x79,
+	0x53, 0x98, 0x9f, 0x61, 0xca, 0x2a, 0xd7, 0x09, 0x3c, 0xb7, 0xb5, 0xd6, 0xb2, 0x1c, 0x4a, 0xbe,
+	0xcf, 0x80, 0xd3, 0x5b, 0x76, 0x73, 0x4b, 0x75, 0x76, 0xab, 0x18, 0xc5, 0xf5, 0x4a, 0x37, 0x12,
+	0xb8, 0x16, 0xce, 0xed, 0xef, 0xcd, 0x9e, 0x4e, 0xb6, 0x62, 0x8a, 0xa6, 0xf9, 0xe7, 0x25, 0xb8,
+	0xa8, 0xce, 0x6c, 0x3e, 0x0e, 0xcd, 0x21, 0x7f, 0x68, 0x00, 0xb4, 0x6d, 0x67, 0xbe, 0xd5, 0x72,
+	0xef, 0x73, 0xa7, 0x77, 0x76, 0xe5, 0x79, 0x7f, 0x51, 0x4d, 0x70, 0x06, 0x85, 0xb9, 0xd5, 0x08,
+	0xbb, 0xd0, 0x67, 0x3e, 0x1f, 0xea, 0x49, 0x62, 0xc0, 0x83, 0xbd, 0xd9, 0xd9, 0x74, 0x3c, 0xd0,
+	0x1c, 0xca, 0xa0, 0x1b, 0xa6, 0xcb, 0xfa, 0xf8, 0x9f, 0xf5, 0xec, 0x22, 0xcc, 0xb8, 0xf1, 0x83,
+	0xcc, 0xb4, 0xe1, 0x54, 0x82, 0x70, 0x86, 0xfa, 0x73, 0x51, 0x57, 0x7f, 0xf6, 0x64, 0x52, 0x73,
+	0x61, 0x08, 0xd0, 0xdc, 0x7b, 0xba, 0x96, 0x13, 0xb0, 0x95, 0x56, 0x14, 0x9d, 0x7f, 0x5a, 0x82,
+	0x73, 0x72, 0x01, 0x5a, 0xec, 0x8a, 0xd2, 0x69, 0xb9, 0xbb, 0x6d, 0xea, 0x9c, 0x84, 0x47, 0x5b,
+	0xf8, 0x11, 0x94, 0x72, 0x3f, 0x82, 0x76, 0xea, 0x23, 0x18, 0x2a, 0xf2, 0x11, 0x44, 0xbc, 0xe2,
+	0x00, 0x1d, 0x0c, 0xc2, 0x05, 0xdb, 0x61, 0x13, 0xbd, 0xce, 0x37, 0x4c, 0xec, 0x77, 0x2a, 0xdd,
+	0xa9, 0xf9, 0xdd, 0x73, 0x39, 0xb3, 0x07, 0xe6, 0x8c, 0x34, 0xff, 0xd2, 0x80, 0x4a, 0xd6, 0xfa,
+	0x9e, 0x80, 0xda, 0xb4, 0xad, 0xab, 0x4d, 0x6f, 0x0c, 0xf0, 0x6d, 0x68, 0x53, 0xcf, 0x51, 0x9f,
+	0xfe, 0x45, 0x09, 0x2e, 0xc4, 0xdd, 0x97, 0x1d, 0x3f, 0xb0, 0x5a, 0x2d, 0x21, 0x97, 0x1e, 0xff,
+	0x5e, 0xea, 0x68, 0xda, 0xef, 0x5b, 0x83, 0x3d, 0xaa, 0x3a, 0xf7, 0x5c, 0xe7, 0x97, 0x9d, 0x84,
+	0xf3, 0xcb, 0xda, 0x11, 0xd2, 0xec, 0xed, 0x07, 0xf3, 0xef, 0x0c, 0x98, 0xc9, 0x1e, 0x78, 0x02,
+	0x9b, 0xca, 0xd5, 0x37, 0xd5, 0xbb, 0x8f, 0xee, 0xa9, 0x73, 0xb6, 0xd5, 0x2f, 0x97, 0xf2, 0x9e,
+	0x96, 0xab, 0xd0, 0x37, 0xe1, 0x94, 0x47, 0x9b, 0xb6, 0x1f, 0x48, 0x2f, 0x8d, 0xc3, 0x39, 0xa2,
+	0x87, 0x46, 0xc7, 0x53, 0xa8, 0xe3, 0xc0, 0x24, 0x52, 0x72, 0x0b, 0x46, 0x99, 0x42, 0x93, 0xe1,
+	0x2f, 0xf5, 0x8f, 0x3f, 0x12, 0x22, 0x6a, 0x62, 0x2c, 0x86, 0x48, 0xc8, 0xb7, 0xc3, 0x54, 0x23,
+	0xfa, 0xa2, 0x18, 0xd6, 0xa1, 0xfe, 0xb1, 0xf2, 0x2b, 0xda, 0xa2, 0x3a, 0x1a, 0x75, 0x64, 0xe6,
+	0x7f, 0x33, 0xe0, 0xe1, 0x5e, 0x7b, 0x8b, 0xbc, 0xc4, 0xe2, 0x4b, 0xa4, 0x54, 0x18, 0x1a, 0x01,
+	0x9f, 0x29, 0xf8, 0x2e, 0x05, 0x96, 0xf8, 0x03, 0x8d, 0x9a, 0x78, 0x88, 0x4a, 0xf8, 0x7f, 0x86,
+	0x4b, 0x64, 0xe9, 0x98, 0x5c, 0x22, 0xcd, 0x7f, 0x6f, 0xa8, 0xac, 0x48, 0x7d, 0xb7, 0xaf, 0x34,
+	0x56, 0xa4, 0xce, 0x3d, 0xd7, 0x24, 0xf7, 0x95, 0x12, 0x5c, 0xc9, 0x1e, 0xa2, 0x9c, 0xe7, 0xef,
+	0x82, 0x91, 0x8e, 0x08, 0x16, 0x11, 0x7e, 0xf3, 0x8f, 0x33, 0xce, 0x22, 0x42, 0x39, 0x1e, 0xec,
+	0xcd, 0xce, 0x64, 0x31, 0x7a, 0x01, 0x45, 0x39, 0x8e, 0xd8, 0x09, 0xdb, 0x81, 0x10, 0xda, 0xdf,
+	0xd4, 0x27, 0x73, 0xb1, 0x36, 0x68, 0xab, 0x6f, 0x73, 0xc1, 0xc7, 0x0c, 0x98, 0xd6, 0x76, 0xb4,
+	0x5f, 0x29, 0x5f, 0x19, 0x2a, 0xea, 0x8d, 0xa6, 0x7d, 0x2a, 0xb1, 0x34, 0xa0, 0x35, 0xfb, 0x98,
+	0x20, 0x98, 0x60, 0xb3, 0xea, 0xaa, 0xbe, 0xe2, 0xd8, 0xac, 0x3a, 0xf9, 0x1c, 0x36, 0xfb, 0x93,
+	0xa5, 0xbc, 0xa7, 0xe5, 0x6c, 0xf6, 0x3e, 0x8c, 0x87, 0x62, 0x64, 0xc8, 0x2e, 0xae, 0x0d, 0x3a,
+	0x27, 0x81, 0x4e, 0x8d, 0xe9, 0x93, 0x04, 0x30, 0xa6, 0x45, 0xbe, 0xc7, 0x00, 0x88, 0x5f, 0x8c,
+	0xfc, 0xa8, 0xee, 0x1c, 0xdd, 0x72, 0x28, 0x62, 0x0d, 0x8f, 0xaf, 0x8b, 0x7f, 0xa3, 0x42, 0xd7,
+	0xfc, 0x17, 0xc3, 0x40, 0xd2, 0x73, 0xef, 0xcf, 0x32, 0x7c, 0x80, 0x90, 0xdb, 0x81, 0xd3, 0x1e,
+	0x93, 0x16, 0xeb, 0x76, 0x8b, 0x5f, 0x59, 0xdd, 0x6e, 0x50, 0x50, 0xf3, 0xc1, 0xaf, 0x55, 0x98,
+	0xc0, 0x85, 0x29, 0xec, 0xcc, 0xb1, 0xad, 0xe3, 0xd9, 0x6d, 0xcb, 0xdb, 0xe5, 0x97, 0xe2, 0x31,
+	0x61, 0xb6, 0x5a, 0x13, 0x4d, 0x18, 0xc2, 0xc8, 0x87, 0x60, 0xbc, 0x65, 0x6f, 0xd2, 0xfa, 0x6e,
+	0xbd, 0x45, 0xa5, 0xaa, 0xfc, 0xf6, 0xd1, 0xbc, 0xf3, 0x95, 0x10, 0xad, 0x74, 0xd3, 0x0c, 0x7f,
+	0x62, 0x4c, 0x90, 0x29, 0xf6, 0xee, 0x73, 0xc7, 0xa1, 0x16, 0xf5, 0xfd, 0x5a, 0xb7, 0xd3, 0x71,
+	0xbd, 0x80, 0x36, 0xb8, 0x42, 0x7d, 0x4c, 0x28, 0xf6, 0xee, 0xa6, 0xc1, 0x98, 0x35, 0x86, 0x3c,
+	0x03, 0x60, 0x75, 0x03, 0x57, 0x04, 0x42, 0x57, 0xc6, 0xf8, 0xad, 0x9d, 0xd9, 0x6f, 0x60, 0x3e,
+	0x6a, 0x7d, 0xb0, 0x37, 0x3b, 0x21, 0x75, 0x7f, 0xfc, 0xd5, 0x28, 0x03, 0xc8, 0xfb, 0xe1, 0x5c,
+	0x5d, 0x80, 0x98, 0xd7, 0x95, 0x15, 0xd8, 0xf2, 0x46, 0x3c, 0xce, 0x11, 0xfd, 0x4f, 0x4c, 0xe1,
+	0x53, 0xcd, 0x80, 0x27, 0x51, 0x66, 0x22, 0x31, 0x3f, 0x55, 0x82, 0x87, 0x7a, 0x2c, 0x10, 0x41,
+	0x18, 0x8f, 0xde, 0x9f, 0xdc, 0x66, 0x6f, 0x96, 0x01, 0xb0, 0xa2, 0xf1, 0xc1, 0xde, 0xec, 0xa3,
+	0x3d, 0x10, 0xd4, 0xd8, 0x3e, 0xa7, 0xcd, 0x5d, 0x8c, 0xd1, 0x90, 0x65, 0x18, 0x69, 0xc4, 0xb6,
+	0xaf, 0xf1, 0x85, 0x37, 0xb2, 0xa3, 0x40, 0x68, 0xa9, 0xfb, 0xc5, 0x26, 0x11, 0x90, 0x15, 0x18,
+	0x15, 0x8e, 0xa7, 0x54, 0x1e, 0x2b, 0x4f, 0x72, 0x95, 0x89, 0x68, 0xea, 0x17, 0x59, 0x88, 0xc2,
+	0xfc, 0xaf, 0x06, 0x8c, 0x56, 0x99, 0x76, 0xfb, 0x56, 0x8d, 0x79, 0x8c, 0x2a, 0x99, 0x38, 0x24,
+	0x8b, 0x2d, 0xc8, 0x73, 0x38, 0x46, 0xe5, 0x6a, 0x1f, 0x06, 0x3a, 0x46, 0x0d, 0xa8, 0xd2, 0x22,
+	0x2f, 0xb1, 0x35, 0xbf, 0xef, 0xd9, 0x01, 0x23, 0x3c, 0x88, 0x3f, 0x8d, 0x20, 0x8c, 0x21, 0x2e,
+	0xb1, 0xdb, 0xa3, 0x9f, 0x18, 0x53, 0x31, 0xd7, 0x80, 0xc8, 0xde, 0xaa, 0x8e, 0xe3, 0x69, 0x18,
+	0x6e, 0xbb, 0x8d, 0xf0, 0xbd, 0xbf, 0x36, 0x64, 0x1e, 0xcc, 0x6a, 0xf4, 0x60, 0x6f, 0xf6, 0x42,
+	0x7a, 0x04, 0x83, 0x20, 0x1f, 0x63, 0xde, 0x82, 0xd3, 0x12, 0x1e, 0x11, 0x64, 0x31, 0xdd, 0x75,
+	0xb7, 0xdd, 0x76, 0x9d, 0x5a, 0x77, 0x73, 0xd3, 0xde, 0xa1, 0x5a, 0x4c, 0x77, 0x55, 0x83, 0x60,
+	0xa2, 0xa7, 0xf9, 0xe9, 0x12, 0x9c, 0xa9, 0x76, 0xfd, 0xc0, 0x6d, 0xdf, 0xa0, 0x56, 0x2b, 0xd8,
+	0xaa, 0x6e, 0xd1, 0xfa, 0xbd, 0x3e, 0xbc, 0xdd, 0x3e, 0x08, 0x63, 0x21, 0x37, 0xaf, 0x94, 0x8a,
+	0x87, 0xe0, 0xa6, 0x48, 0x47, 0xe7, 0x47, 0x74, 0x8c, 0x86, 0x2d, 0x18, 0x11, 0x64, 0x7e, 0xf4,
+	0x74, 0xa7, 0xe3, 0x51, 0x1e, 0xd2, 0x2f, 0x77, 0x68, 0x24, 0xbd, 0x2d, 0x45, 0x10, 0x54, 0x7a,
+	0x31, 0xee, 0x18, 0x6a, 0x02, 0x87, 0x63, 0xb7, 0xdf, 0xa4, 0x16, 0xd0, 0xfc, 0xf9, 0x12, 0x5c,
+	0xca, 0x9d, 0x14, 0x23, 0x6c, 0x75, 0x6c, 0xe9, 0x2b, 0x9c, 0x74, 0xe0, 0x9f, 0x5f, 0x5b, 0x96,
+	0x10, 0x54, 0x7a, 0x45, 0x87, 0x49, 0x29, 0xf7, 0x30, 0x79, 0x1d, 0x8c, 0xb3, 0x35, 0xf5, 0x3b,
+	0x56, 0x3d, 0xfc, 0xde, 0xf8, 0x96, 0xba, 0x15, 0x36, 0x62, 0x0c, 0x27, 0x0f, 0xcb, 0x57, 0x23,
+	0x1e, 0x62, 0x2c, 0xf1, 0x5a, 0x5a, 0xcc, 0xdb, 0x5f, 0x11, 0xc9, 0x2a, 0xe5, 0xe2, 0xd2, 0x9c,
+	0xf4, 0xef, 0x57, 0x9a, 0x50, 0x47, 0x6e, 0xfe, 0x84, 0x01, 0x43, 0xec, 0xa3, 0x36, 0x61, 0xa4,
+	0xe1, 0xb6, 0x2d, 0x3b, 0x5c, 0x12, 0xee, 0xcd, 0xb0, 0xc8, 0x5b, 0x50, 0x42, 0x48, 0x07, 0xc6,
+	0x43, 0x71, 0x7e, 0xa0, 0xc0, 0x8b, 0xc5, 0x5b, 0xb5, 0x28, 0x6e, 0x2d, 0x92, 0x31, 0xc2, 0x16,
+	0x1f, 0x63, 0x22, 0xa6, 0x05, 0x67, 0x16, 0x6f, 0xd5, 0x96, 0x9d, 0x7a, 0xab, 0xdb, 0xa0, 0x4b,
+	0x3b, 0xfc, 0x0f, 0xdb, 0x06, 0xb6, 0x68, 0x91, 0x1f, 0x09, 0xdf, 0x06, 0xb2, 0x13, 0x86, 0x30,
+	0xd6, 0x8d, 0x8a, 0x11, 0x95, 0x52, 0xdc, 0x4d, 0x22, 0xc1, 0x10, 0x66, 0x7e, 0xb5, 0x04, 0x13,
+	0xca, 0x84, 0x48, 0x0b, 0x46, 0xc5, 0xe3, 0xfa, 0x83, 0x24, 0x41, 0x49, 0xcd, 0x5a, 0x50, 0x17,
+	0x0b, 0xea, 0x63, 0x48, 0x42, 0x3d, 0xf0, 0x4b, 0x3d, 0x0e, 0xfc, 0x39, 0x2d, 0x28, 0x5a, 0xec,
+	0xaf, 0xe9, 0xfc, 0x80, 0x68, 0xb6, 0xc3, 0xb8, 0x6c, 0xa3, 0xec, 0x30, 0x45, 0xae, 0xd9, 0x84,
+	0xf2, 0xcb, 0xae, 0x43, 0xfd, 0x4a, 0xf9, 0x28, 0x1f, 0x90, 0x7b, 0x5d, 0xb2, 0xc8, 0x6b, 0x1f,
+	0x05, 0x7a, 0xf3, 0x0b, 0x06, 0xc0, 0xa2, 0x15, 0x58, 0xc2, 0xc5, 0xa7, 0x0f, 0x8e, 0xf4, 0xb0,
+	0x26, 0x92, 0x8d, 0xa5, 0x62, 0x2f, 0x87, 0x7d, 0xfb, 0x65, 0x9a, 0x64, 0x16, 0x02, 0x7b, 0xcd,
+	0x7e, 0x99, 0x22, 0x87, 0xb3, 0x6f, 0x91, 0x3a, 0x75, 0x6f, 0xb7, 0x13, 0x44, 0xe9, 0x16, 0xf8,
+	0xb7, 0xb8, 0x14, 0x36, 0x62, 0x0c, 0x37, 0xdf, 0x08, 0xfa, 0x7d, 0xbd, 0x8f, 0xf0, 0x80, 0xbf,
+	0x35, 0xe0, 0xe2, 0x62, 0xd7, 0x6a, 0xcd, 0x77, 0xd8, 0x46, 0xb5, 0x5a, 0xd7, 0x5c, 0xe1, 0x40,
+	0xc2, 0x38, 0xc5, 0xeb, 0x15, 0x9e, 0x9a, 0x08, 0xce, 0xce, 0x60, 0x82, 0x16, 0x0b, 0x52, 0x91,
+	0x5f, 0x79, 0x69, 0x80, 0x3b, 0x5b, 0x48, 0x22, 0xfa, 0xc8, 0x23, 0xb4, 0x42, 0x73, 0xca, 0x5f,
+	0x10, 0x4b, 0x77, 0x64, 0xd7, 0xe9, 0x7c, 0xbd, 0xee, 0x76, 0x45, 0xea, 0x0a, 0x45, 0x73, 0x9a,
+	0xd5, 0x03, 0x73, 0x46, 0x9a, 0x5f, 0x36, 0x60, 0x78, 0xe9, 0x4e, 0x75, 0x91, 0x7c, 0x3b, 0x0c,
+	0x47, 0x2c, 0xa3, 0xa0, 0x4b, 0x18, 0xc3, 0x23, 0x94, 0xbc, 0xe2, 0x7d, 0xaf, 0x32, 0x86, 0xc3,
+	0xb1, 0x92, 0x0d, 0x18, 0xa1, 0xdb, 0x3c, 0xcb, 0x46, 0xe9, 0x48, 0xf0, 0x73, 0x96, 0xb6, 0xc4,
+	0x31, 0xa2, 0xc4, 0x6c, 0xfe, 0x80, 0x01, 0x10, 0x77, 0x21, 0xdf, 0x95, 0x25, 0xda, 0xdc, 0x3c,
+	0x42, 0xd3, 0x45, 0x6f, 0xf9, 0xc6, 0xfc, 0xda, 0x30, 0x5c, 0x62, 0xd3, 0x91, 0x5b, 0xd5, 0x76,
+	0x9d, 0x9b, 0x74, 0xf7, 0x1f, 0x02, 0x51, 0xfe, 0x21, 0x10, 0xe5, 0xe8, 0x02, 0x51, 0xcc, 0x67,
+	0xe1, 0x74, 0xbc, 0xbd, 0xe4, 0xbe, 0x7f, 0x5d, 0x52, 0x89, 0x30, 0x1e, 0x4a, 0xc4, 0xe9, 0x8b,
+	0xbf, 0xf9, 0xc0, 0x80, 0xd3, 0x4b, 0x3b, 0x1d, 0xdb, 0xe3, 0x49, 0x2a, 0xa4, 0x88, 0xf4, 0x44,
+	0x1c, 0x92, 0x65, 0xe8, 0x56, 0xda, 0x64, 0x58, 0x16, 0xd9, 0x84, 0x69, 0xca, 0x87, 0xf3, 0x5b,
+	0xbe, 0x15, 0x14, 0xd9, 0x81, 0x22, 0xd7, 0x91, 0x86, 0x05, 0x13, 0x58, 0x49, 0x0d, 0xa6, 0xeb,
+	0x2d, 0xcb, 0xf7, 0xed, 0x4d, 0xbb, 0x1e, 0x87, 0x12, 0x8e, 0x2f, 0xbc, 0x8e, 0xcb, 0xd4, 0x1a,
+	0xe4, 0xc1, 0xde, 0xec, 0x79, 0x39, 0x4f, 0x1d, 0x80, 0x09, 0x14, 0xe6, 0x67, 0x4b, 0x30, 0xb5,
+	0xb4, 0xd3, 0x71, 0xfd, 0xae, 0x47, 0x79, 0xd7, 0x13, 0xd0, 0x5b, 0x3e, 0x01, 0xa3, 0x5b, 0x16,
+	0x73, 0x36, 0xf7, 0x2a, 0x25, 0x7d, 0x6d, 0x6f, 0x88, 0x66, 0x0c, 0xe1, 0xe4, 0x83, 0x00, 0x2c,
+	0x6d, 0x5f, 0xa3, 0xcb, 0xf9, 0xd7, 0x50, 0x71, 0xfe, 0xa5, 0x3d, 0x63, 0x2d, 0x42, 0x29, 0xa5,
+	0x8e, 0xe8, 0x37, 0x2a, 0xe4, 0xcc, 0x3f, 0x36, 0xe0, 0x8c, 0x36, 0xee, 0x04, 0xd4, 0x71, 0x9b,
+	0xba, 0x3a, 0x6e, 0x7e, 0xe0, 0x67, 0xcd, 0xd1, 0xc2, 0x7d, 0xa2, 0x04, 0x17, 0x73, 0xd6, 0x24,
+	0xe5, 0xba, 0x6d, 0x9c, 0x90, 0xeb, 0x76, 0x17, 0x26, 0x02, 0xb7, 0x25, 0x23, 0x5e, 0xc3, 0x15,
+	0x28, 0x74, 0x4a, 0xde, 0x89, 0xd0, 0xc4, 0x8e, 0xd9, 0x71, 0x9b, 0x8f, 0x2a, 0x1d, 0x16, 0x25,
+	0x36, 0x1e, 0x69, 0xfd, 0xbf, 0xa9, 0x1c, 0x26, 0xfa, 0xcf, 0xcf, 0x66, 0xfe, 0x4e, 0x09, 0x2e,
+	0x44, 0xb8, 0x43, 0x36, 0xc7, 0x8c, 0x14, 0xfd, 0xa8, 0x0e, 0x1f, 0xd6, 0x82, 0x4a, 0xc6, 0xd2,
+	0xd1, 0xa9, 0x9d, 0xae, 0xd7, 0x71, 0xfd, 0x50, 0x54, 0x15, 0x32, 0xbd, 0x68, 0xc2, 0x10, 0x46,
+	0x6e, 0x41, 0xd9, 0x67, 0xf4, 0x2a, 0xc3, 0x45, 0x56, 0x43, 0xa4, 0xd4, 0x62, 0xe3, 0x51, 0xa0,
+	0x21, 0x1f, 0x54, 0x79, 0x78, 0xb9, 0xb8, 0x72, 0x9a, 0x3d, 0x49, 0x23, 0x12, 0x56, 0x63, 0xd3,
+	0x56, 0x2f, 0x65, 0xb0, 0xb9, 0x02, 0xa7, 0xa5, 0xd3, 0xb2, 0xd8, 0x36, 0x2c, 0x38, 0xe7, 0x29,
+	0x6d, 0x67, 0x3c, 0x96, 0x70, 0x99, 0x3a, 0x97, 0xec, 0x1f, 0xef, 0x18, 0xd3, 0x87, 0xb1, 0xeb,
+	0x72, 0x92, 0x64, 0x06, 0x4a, 0x76, 0xf8, 0x2e, 0x40, 0xe2, 0x28, 0x2d, 0x2f, 0x62, 0xc9, 0xee,
+	0x23, 0xb8, 0x47, 0x3d, 0x96, 0x86, 0x7a, 0x1f, 0x4b, 0xe6, 0xd7, 0x4b, 0x70, 0x2e, 0xa4, 0x1a,
+	0x3e, 0xe3, 0xa2, 0xf4, 0x86, 0x38, 0xe0, 0xde, 0x72, 0xb0, 0x2a, 0xf9, 0x36, 0x0c, 0x73, 0x06,
+	0x58, 0xc8, 0x4b, 0x22, 0x42, 0xc8, 0xa6, 0x83, 0x1c, 0x11, 0xf9, 0x10, 0x8c, 0xf0, 0x8b, 0x7c,
+	0x18, 0x75, 0x53, 0x48, 0xf1, 0x9e, 0xf5, 0xb8, 0xe2, 0x6e, 0x21, 0x03, 0x05, 0x23, 0x43, 0xb7,
+	0x68, 0x44, 0x49, 0x73, 0xe6, 0xed, 0x30, 0xa1, 0x74, 0x3b, 0x54, 0x5e, 0xcc, 0x9f, 0x28, 0x41,
+	0xe5, 0x06, 0x6d, 0xb5, 0x33, 0x5d, 0x5b, 0x66, 0xa1, 0x5c, 0xdf, 0xb2, 0x3c, 0x91, 0x72, 0x75,
+	0x52, 0x6c, 0xf2, 0x2a, 0x6b, 0x40, 0xd1, 0xce, 0xee, 0x04, 0x5a, 0x84, 0xe6, 0x3b, 0x95, 0x95,
+	0x8c, 0x73, 0xf1, 0x7e, 0x20, 0x4a, 0xd6, 0x1b, 0x3f, 0xb8, 0xd6, 0x81, 0x1d, 0x2f, 0xef, 0xae,
+	0xdd, 0xbe, 0x95, 0x15, 0x9b, 0xc9, 0xd2, 0x2d, 0xb8, 0x75, 0x1b, 0x69, 0xc7, 0xf5, 0xed, 0xc0,
+	0xf5, 0x76, 0x07, 0x49, 0x5a, 0x78, 0xbb, 0xba, 0x1c, 0x23, 0x12, 0xea, 0x18, 0xad, 0x09, 0x75,
+	0x52, 0xe6, 0x2f, 0x1a, 0x30, 0x71, 0xc3, 0xde, 0xa0, 0x9e, 0xf0, 0xcb, 0xe6, 0x4a, 0x0c, 0x2d,
+	0x79, 0xe8, 0x44, 0x56, 0xe2, 0x50, 0xb2, 0x03, 0xe3, 0xf2, 0x1c, 0x8e, 0x42, 0x6f, 0xaf, 0x17,
+	0xf3, 0x07, 0x8b, 0x48, 0xcb, 0xf3, 0x4d, 0xf9, 0xf0, 0xc3, 0x16, 0x1f, 0x63, 0x62, 0xe6, 0x07,
+	0xe1, 0x6c, 0xc6, 0x20, 0xf6, 0x22, 0xb9, 0x6b, 0xb2, 0xfc, 0x68, 0x42, 0x6e, 0xc5, 0x5e, 0x24,
+	0x6f, 0x27, 0x97, 0x60, 0x88, 0x46, 0x1a, 0xb5, 0xd1, 0xfd, 0xbd, 0xd9, 0xa1, 0x25, 0xa7, 0x81,
+	0xac, 0x8d, 0x31, 0xf1, 0x96, 0xab, 0x49, 0x6c, 0x9c, 0x89, 0xaf, 0xc8, 0x36, 0x8c, 0xa0, 0xdc,
+	0x83, 0x2f, 0xe9, 0xac, 0xc6, 0x84, 0xff, 0xd3, 0x9b, 0x09, 0xde, 0x32, 0x88, 0x8f, 0x5c, 0x92,
+	0x4f, 0x2d, 0x54, 0xe4, 0x82, 0xa4, 0x38, 0x1e, 0xa6, 0xe8, 0x9a, 0xbf, 0x3e, 0x0c, 0x8f, 0xdc,
+	0x60, 0xd9, 0xed, 0x5c, 0x27, 0xb0, 0x5a, 0x6b, 0x6e, 0x23, 0xf6, 0x5f, 0x96, 0x47, 0xd6, 0xf7,
+	0x1a, 0x70, 0xb1, 0xde, 0xe9, 0x8a, 0xcb, 0x43, 0xe8, 0x02, 0xbc, 0x46, 0x3d, 0xdb, 0x2d, 0x1a,
+	0x88, 0xc3, 0xdd, 0xb5, 0xab, 0x6b, 0xeb, 0x59, 0x28, 0x31, 0x8f, 0x16, 0x8f, 0x07, 0x6a, 0xb8,
+	0xf7, 0x1d, 0x3e, 0xb9, 0x5a, 0xc0, 0x57, 0xf3, 0xe5, 0xf8, 0x25, 0x14, 0x8c, 0x07, 0x5a, 0xcc,
+	0xc4, 0x88, 0x39, 0x94, 0x98, 0xc3, 0xb3, 0x2d, 0x26, 0x87, 0xd4, 0x6a, 0xd8, 0x0e, 0xf5, 0x7d,
+	0x11, 0x4c, 0x30, 0x40, 0xc0, 0xcb, 0x72, 0x16, 0x42, 0xcc, 0xa6, 0x43, 0x5e, 0x00, 0xf0, 0x77,
+	0x9d, 0xba, 0x5c, 0xff, 0x62, 0x8e, 0xc6, 0x42, 0x44, 0x8e, 0xb0, 0xa0, 0x82, 0x91, 0x5d, 0xb4,
+	0x82, 0x68, 0x53, 0x8e, 0x70, 0x67, 0x71, 0x7e, 0xd1, 0x8a, 0xf7, 0x50, 0x0c, 0x67, 0xca, 0x89,
+	0xe9, 0x65, 0x67, 0xad, 0x65, 0xd5, 0xa9, 0xf0, 0x9b, 0xf5, 0xc9, 0x55, 0x18, 0xf7, 0x23, 0x8b,
+	0x9b, 0xe0, 0x08, 0xf1, 0xf7, 0x19, 0x02, 0x30, 0xee, 0xc3, 0xbd, 0xf0, 0x6d, 0x47, 0x1e, 0x76,
+	0xd7, 0x5c, 0x4f, 0x20, 0x92, 0xdf, 0x9d, 0xf0, 0xc2, 0x4f, 0x83, 0x31, 0x6b, 0x8c, 0xf9, 0x4b,
+	0x06, 0x9c, 0xd3, 0xa7, 0x23, 0x5d, 0x56, 0x7e, 0xdc, 0x80, 0x73, 0x5a, 0x46, 0x01, 0x09, 0x1e,
+	0x24, 0xc9, 0xd9, 0x5a, 0x06, 0x3e, 0xe1, 0xc2, 0x9d, 0x05, 0xc1, 0x4c, 0xfa, 0xe6, 0xff, 0x69,
+	0xc0, 0xa8, 0xcc, 0x21, 0xcc, 0xdc, 0x8d, 0x35, 0x05, 0x77, 0x74, 0xb4, 0x25, 0x94, 0xdc, 0xbb,
+	0xdc, 0xff, 0x46, 0x1e, 0x4d, 0xf2, 0x94, 0x29, 0xa4, 0x21, 0x95, 0x84, 0xe3, 0x73, 0x4e, 0xf3,
+	0xc3, 0x91, 0x6d, 0xa8, 0x10, 0x33, 0x3f, 0x6f, 0xc0, 0x99, 0xd4, 0xa8, 0x3e, 0xc4, 0xd1, 0x13,
+	0xf4, 0x48, 0xfe, 0xca, 0x30, 0xdb, 0x92, 0x01, 0xe3, 0xf7, 0x2d, 0xa1, 0x7b, 0x3e, 0x81, 0xfb,
+	0xef, 0xeb, 0x60, 0xdc, 0x6e, 0xb7, 0xbb, 0x01, 0x37, 0x12, 0x97, 0x63, 0x85, 0xee, 0x72, 0xd8,
+	0x88, 0x31, 0x9c, 0x38, 0x52, 0xd2, 0x12, 0xa7, 0xe0, 0x4a, 0xb1, 0x37, 0xa7, 0x3e, 0xe0, 0x1c,
+	0x93, 0x8a, 0x84, 0x38, 0x94, 0x25, 0x88, 0x7d, 0x9f, 0x01, 0xe0, 0x07, 0x9e, 0xed, 0x34, 0x59,
+	0xa3, 0x94, 0xc6, 0xf0, 0x08, 0xc8, 0xd6, 0x22, 0xa4, 0x82, 0x78, 0x9c, 0x04, 0x35, 0x02, 0xa0,
+	0x42, 0x99, 0xcc, 0x4b, 0x21, 0x54, 0x1c, 0x99, 0x6f, 0x48, 0x88, 0xdb, 0x8f, 0x64, 0x78, 0x3a,
+	0x0b, 0x42, 0xb1, 0x94, 0x3a, 0xf3, 0x36, 0x18, 0x8f, 0xe8, 0x1d, 0x24, 0xd4, 0x4d, 0x2a, 0x42,
+	0xdd, 0xcc, 0x33, 0x70, 0x2a, 0x31, 0xdd, 0x43, 0xc9, 0x84, 0xff, 0xca, 0x00, 0xa2, 0x3f, 0xfd,
+	0x09, 0x68, 0x0e, 0x9a, 0xba, 0xe6, 0x60, 0x61, 0xf0, 0x57, 0x96, 0xa3, 0x3a, 0xf8, 0x39, 0x02,
+	0x3c, 0xc5, 0x7a, 0x54, 0x72, 0x40, 0x9e, 0xfc, 0x4c, 0x50, 0x89, 0x83, 0xf9, 0xe5, 0x97, 0x3b,
+	0x80, 0xa0, 0x72, 0x33, 0x81, 0x2b, 0x16, 0x54, 0x92, 0x10, 0x4c, 0xd1, 0x25, 0x9f, 0x34, 0xe0,
+	0xb4, 0xa5, 0xa7, 0x58, 0x0f, 0x57, 0xa6, 0x50, 0x96, 0xba, 0x44, 0xba, 0xf6, 0x78, 0x2e, 0x09,
+	0x80, 0x8f, 0x29, 0xb2, 0x2c, 0x8c, 0xcd, 0xea, 0xd8, 0x2c, 0xa3, 0x31, 0xbb, 0x79, 0x86, 0x69,
+	0x73, 0xb9, 0x36, 0x64, 0x7e, 0x6d, 0x39, 0x6a, 0x47, 0xad, 0x57, 0x94, 0x78, 0xb9, 0x1a, 0x3b,
+	0x86, 0x0f, 0x92, 0x78, 0x59, 0xae, 0x61, 0x9c, 0x78, 0x59, 0x2e, 0x9d, 0x4a, 0x84, 0x38, 0x00,
+	0xae, 0xdd, 0xa8, 0x4b, 0x92, 0x23, 0xc5, 0xcd, 0x14, 0xb7, 0x97, 0x17, 0xab, 0x92, 0x22, 0x17,
+	0x1f, 0xe2, 0xdf, 0xa8, 0x50, 0x20, 0x9f, 0x31, 0x60, 0x4a, 0xf2, 0x6e, 0x49, 0x73, 0x94, 0xbf,
+	0xa2, 0xf7, 0x15, 0xdd, 0x2f, 0x89, 0x3d, 0x39, 0x87, 0x2a, 0x72, 0xc1, 0x77, 0xa2, 0x5c, 0x10,
+	0x1a, 0x0c, 0xf5, 0x79, 0x70, 0x19, 0xc0, 0xd7, 0xec, 0x44, 0x72, 0x82, 0x63, 0xc5, 0x65, 0x80,
+	0x5a, 0x06, 0x3e, 0x19, 0x44, 0x96, 0x01, 0xc1, 0x4c, 0xfa, 0x4c, 0xae, 0x3d, 0x75, 0xdf, 0x0a,
+	0xea, 0x5b, 0x55, 0xab, 0xbe, 0xc5, 0xcd, 0x84, 0x22, 0x5c, 0xb6, 0xe0, 0xbe, 0xbe, 0xab, 0xa3,
+	0x5a, 0x38, 0xcb, 0x7c, 0x94, 0x13, 0x8d, 0x98, 0x24, 0x48, 0x5c, 0x66, 0x16, 0x14, 0x75, 0x46,
+	0x2a, 0x50, 0x5c, 0xa4, 0x48, 0x15, 0x2d, 0x11, 0x37, 0xa3, 0xf0, 0x17, 0x46, 0x44, 0x58, 0x50,
+	0xa4, 0xb8, 0x1b, 0xce, 0x3b, 0xae, 0xb3, 0xdb, 0x76, 0xbb, 0x3e, 0x4b, 0xbb, 0x4d, 0x9d, 0x20,
+	0x54, 0x85, 0x4f, 0xf0, 0x63, 0x94, 0x07, 0x45, 0x2e, 0xf5, 0xea, 0x88, 0xbd, 0xf1, 0x90, 0xe7,
+	0x61, 0x8c, 0x9b, 0xd2, 0xee, 0xdc, 0x59, 0xa9, 0x4c, 0x1e, 0x86, 0x47, 0x47, 0xe2, 0x32, 0x7f,
+	0x84, 0x25, 0x89, 0x03, 0x23, 0x6c, 0xe4, 0x1e, 0x8c, 0xb6, 0x44, 0xa1, 0x98, 0xca, 0x54, 0x71,
+	0xa6, 0x98, 0x2c, 0x3a, 0x23, 0x2e, 0xd0, 0xf2, 0x07, 0x86, 0x14, 0x58, 0x6c, 0x67, 0x83, 0x6e,
+	0x5a, 0xdd, 0x56, 0x70, 0xcb, 0x0d, 0x90, 0x47, 0x20, 0x46, 0x1a, 0xcf, 0x30, 0xc8, 0x7a, 0x9a,
+	0x27, 0x22, 0xe4, 0xb1, 0x9d, 0x8b, 0x07, 0xf4, 0xc5, 0x03, 0xb1, 0x91, 0x5d, 0x78, 0x54, 0xf6,
+	0xe1, 0x21, 0x8f, 0xf5, 0x2d, 0xb6, 0xca, 0x69, 0xa2, 0xa7, 0x38, 0x51, 0xe6, 0xca, 0xf6, 0xe8,
+	0xe2, 0xc1, 0xdd, 0xb1, 0x1f, 0x9c, 0x3c, 0x8a, 0x8c, 0x26, 0x4c, 0x40, 0x95, 0xd3, 0xc5, 0xd7,
+	0x38, 0x69, 0x4e, 0x12, 0xee, 0x8e, 0xc9, 0x56, 0x4c, 0xd1, 0x24, 0x5f, 0x34, 0xa0, 0xe2, 0x07,
+	0x5e, 0xb7, 0x1e, 0x74, 0x3d, 0xda, 0x48, 0xec, 0xd0, 0x33, 0x57, 0x8c, 0xa2, 0x02, 0x5c, 0x2d,
+	0x07, 0x27, 0x0f, 0xf7, 0xaf, 0xe4, 0x41, 0x31, 0x77, 0x2e, 0xe4, 0xa7, 0x0d, 0xb8, 0xa8, 0x03,
+	0xd9, 0x9d, 0x5e, 0xcc, 0x93, 0x14, 0x37, 0xb2, 0xd4, 0xb2, 0x51, 0x8a, 0x1b, 0x7c, 0x0e, 0x10,
+	0xf3, 0x26, 0x92, 0x34, 0x5e, 0x9f, 0x3d, 0x61, 0xe3, 0xf5, 0xcc, 0xbb, 0x80, 0xa4, 0x8f, 0x8f,
+	0x83, 0xe4, 0xc0, 0x31, 0x55, 0x0e, 0xfc, 0x5c, 0x19, 0x1e, 0x62, 0xa7, 0x52, 0x7c, 0xfb, 0x59,
+	0xb5, 0x1c, 0xab, 0xf9, 0xcd, 0x29, 0x31, 0xfd, 0xa2, 0x01, 0x17, 0xb7, 0xb2, 0x55, 0x3b, 0xf2,
+	0xfe, 0xf5, 0x9e, 0x42, 0x2a, 0xb8, 0x5e, 0xda, 0x22, 0xc1, 0xb0, 0x7b, 0x76, 0xc1, 0xbc, 0x49,
+	0x91, 0x77, 0xc1, 0x69, 0xc7, 0x6d, 0xd0, 0xea, 0xf2, 0x22, 0xae, 0x5a, 0xfe, 0xbd, 0x5a, 0xe8,
+	0x4b, 0x53, 0x16, 0xdf, 0xeb, 0xad, 0x04, 0x0c, 0x53, 0xbd, 0x59, 0x58, 0x72, 0xc7, 0x6d, 0x2c,
+	0x6d, 0x8b, 0x82, 0x4a, 0x83, 0xb9, 0x44, 0x73, 0x7b, 0xfe, 0x5a, 0x0a, 0x1b, 0x66, 0x50, 0xe0,
+	0xba, 0x29, 0x36, 0x99, 0x55, 0xd7, 0xb1, 0x03, 0xd7, 0xe3, 0x09, 0x2c, 0x06, 0x52, 0xd1, 0x70,
+	0xdd, 0xd4, 0xad, 0x4c, 0x8c, 0x98, 0x43, 0xc9, 0xfc, 0x4f, 0x06, 0x9c, 0x62, 0xdb, 0x62, 0xcd,
+	0x73, 0x77, 0x76, 0xbf, 0x19, 0x37, 0xe4, 0x13, 0xd2, 0x27, 0x55, 0xe8, 0x76, 0xce, 0x2b, 0xfe,
+	0xa8, 0xe3, 0x7c, 0xce, 0xb1, 0x0b, 0xaa, 0xaa, 0x56, 0x1e, 0xca, 0x57, 0x2b, 0x9b, 0x9f, 0x29,
+	0x89, 0x9b, 0x4b, 0xa8, 0xd6, 0xfd, 0xa6, 0xfc, 0x0e, 0xdf, 0x06, 0x53, 0xac, 0x6d, 0xd5, 0xda,
+	0x59, 0x5b, 0x7c, 0xce, 0x6d, 0x85, 0xd1, 0xf6, 0x5c, 0xd7, 0x7e, 0x53, 0x05, 0xa0, 0xde, 0x8f,
+	0x3c, 0xcd, 0x7c, 0xef, 0x78, 0xca, 0x37, 0x79, 0x67, 0xbe, 0x22, 0x7c, 0xef, 0x78, 0xd3, 0x83,
+	0xbd, 0xd9, 0x33, 0xb1, 0x89, 0x57, 0x36, 0x62, 0x38, 0xc0, 0xfc, 0xf5, 0x0b, 0xc0, 0x91, 0xb7,
+	0x68, 0xf0, 0xcd, 0xb8, 0x26, 0x6f, 0x84, 0x89, 0x7a, 0xa7, 0x5b, 0xbd, 0x56, 0x7b, 0x4f, 0xd7,
+	0xe5, 0xba, 0x10, 0x5e, 0x77, 0x8c, 0x71, 0xef, 0xea, 0xda, 0x7a, 0xd8, 0x8c, 0x6a, 0x1f, 0xc6,
+	0x1d, 0xea, 0x9d, 0xae, 0xe4, 0xb7, 0x6b, 0x6a, 0x3c, 0x12, 0xe7, 0x0e, 0xd5, 0xb5, 0x75, 0x0d,
+	0x86, 0xa9, 0xde, 0xe4, 0x23, 0x30, 0x49, 0xe5, 0x87, 0x7b, 0x83, 0x95, 0x2a, 0x1b, 0x2e, 0x5e,
+	0x46, 0x48, 0x5b, 0xda, 0x90, 0x1b, 0x88, 0x1b, 0xe0, 0x92, 0x42, 0x02, 0x35, 0x82, 0xe4, 0xfd,
+	0x70, 0x29, 0xfc, 0xcd, 0xde, 0xb2, 0xdb, 0x48, 0x32, 0x8a, 0xb2, 0x48, 0x0e, 0xb5, 0x94, 0xd7,
+	0x09, 0xf3, 0xc7, 0x93, 0x5f, 0x30, 0xe0, 0x42, 0x04, 0xb5, 0x1d, 0xbb, 0xdd, 0x6d, 0x23, 0xad,
+	0xb7, 0x2c, 0xbb, 0x2d, 0xef, 0x7d, 0x77, 0x8f, 0xec, 0x41, 0x75, 0xf4, 0x82, 0x59, 0x65, 0xc3,
+	0x30, 0x67, 0x4a, 0xe4, 0xf3, 0x06, 0x5c, 0x09, 0x41, 0x6b, 0x1e, 0xf5, 0x7d, 0x66, 0x95, 0x88,
+	0x72, 0x3d, 0xc8, 0x25, 0x19, 0x2d, 0xc4, 0x3b, 0xb9, 0x00, 0xbc, 0x74, 0x00, 0x6e, 0x3c, 0x90,
+	0xba, 0xba, 0x5d, 0x6a, 0xee, 0x66, 0x50, 0x19, 0x3b, 0xd6, 0xed, 0xc2, 0x48, 0xa0, 0x46, 0x90,
+	0xfc, 0x92, 0x01, 0x17, 0xd5, 0x06, 0x75, 0xb7, 0x88, 0x1b, 0xe2, 0xf3, 0x47, 0x36, 0x99, 0x04,
+	0x7e, 0x21, 0xe1, 0xe5, 0x00, 0x31, 0x6f, 0x56, 0xdc, 0x01, 0x9e, 0x6f, 0x4c, 0x71, 0x8b, 0x2c,
+	0x4b, 0x07, 0x78, 0xd1, 0x84, 0x21, 0x8c, 0xe9, 0x4f, 0x3a, 0x6e, 0x63, 0xcd, 0x6e, 0xf8, 0x3c,
+	0x1d, 0x1d, 0xbf, 0xeb, 0x0d, 0x89, 0xe5, 0x58, 0x73, 0x1b, 0x6b, 0xcb, 0x8b, 0xa2, 0x1d, 0xb5,
+	0x5e, 0xcc, 0xc7, 0x98, 0x99, 0xaf, 0x6a, 0xf7, 0xad, 0xce, 0xed, 0x30, 0xe9, 0x11, 0xd7, 0x45,
+	0x5c, 0x8b, 0x5a, 0x51, 0xe9, 0xc1, 0xde, 0x1f, 0xe3, 0x3b, 0x48, 0x45, 0xee, 0xf5, 0xca, 0xf4,
+	0x11, 0xbd, 0xbf, 0x10, 0xa1, 0x98, 0xf0, 0x4d, 0x85, 0x04, 0x6a, 0x04, 0x99, 0xe5, 0x6c, 0xda,
+	0xdf, 0xf5, 0x03, 0xda, 0x8e, 0xe6, 0x70, 0xea, 0xa8, 0xe7, 0xc0, 0x75, 0xe2, 0x35, 0x8d, 0x08,
+	0x26, 0x88, 0xf2, 0xf4, 0x51, 0x6d, 0xab, 0x49, 0xaf, 0x57, 0x99, 0x2d, 0x32, 0xca, 0xde, 0xb3,
+	0x46, 0xbd, 0x3a, 0x0b, 0x8c, 0x3b, 0xcd, 0xdf, 0x94, 0x48, 0x1f, 0x95, 0xdf, 0x0d, 0x7b, 0xe1,
+	0x20, 0x2f, 0xc0, 0x8c, 0x04, 0xaf, 0xb8, 0xf7, 0x53, 0x14, 0x44, 0x96, 0x26, 0xee, 0xa3, 0xb8,
+	0x9c, 0xdb, 0x0b, 0x7b, 0x60, 0x60, 0x56, 0x22, 0x9f, 0x7a, 0xdc, 0x26, 0x28, 0x92, 0x7b, 0xae,
+	0x75, 0x5b, 0x2d, 0xbf, 0x42, 0xe2, 0x90, 0xae, 0x5a, 0x1a, 0x8c, 0x59, 0x63, 0xc8, 0x33, 0x51,
+	0xd8, 0xf7, 0x2e, 0x6b, 0x78, 0xcf, 0x5a, 0x8d, 0xdf, 0x44, 0xca, 0x42, 0x53, 0x82, 0x3a, 0x08,
+	0x93, 0x7d, 0xd9, 0x69, 0x1e, 0x36, 0x2d, 0x74, 0x3d, 0x3f, 0xa8, 0x9c, 0xe3, 0x83, 0xf9, 0x69,
+	0x8e, 0x2a, 0x00, 0xf5, 0x7e, 0x2c, 0x82, 0xc6, 0xa7, 0x75, 0x56, 0x78, 0x4f, 0xde, 0x93, 0x2b,
+	0xe7, 0xf9, 0xec, 0xc5, 0x1b, 0xd4, 0x20, 0x98, 0xe8, 0x49, 0x76, 0xe1, 0x6c, 0x94, 0x47, 0x7a,
+	0xc5, 0x6d, 0xb2, 0x54, 0x57, 0x4c, 0x38, 0xbe, 0x50, 0x24, 0x85, 0x87, 0x58, 0xae, 0x6a, 0x1a,
+	0x1d, 0x66, 0xd1, 0x60, 0x75, 0x22, 0x13, 0xcd, 0xd7, 0x6c, 0x66, 0xc4, 0xbf, 0xc8, 0x1f, 0x9b,
+	0x2b, 0xbb, 0xaa, 0x19, 0x70, 0xcc, 0x1c, 0x45, 0x6e, 0xc3, 0xf9, 0x8e, 0xe7, 0x06, 0xb4, 0x1e,
+	0xdc, 0xa4, 0x9e, 0x43, 0x5b, 0xf2, 0x01, 0xfd, 0x4a, 0x85, 0xaf, 0x05, 0xb7, 0x87, 0xae, 0x65,
+	0x75, 0xc0, 0xec, 0x71, 0xe4, 0x73, 0x06, 0x5c, 0xf6, 0x03, 0x8f, 0x5a, 0x6d, 0xdb, 0x69, 0x56,
+	0x5d, 0xc7, 0xa1, 0x9c, 0x31, 0x2d, 0x37, 0xe2, 0x88, 0xc8, 0x4b, 0x85, 0x4e, 0x11, 0x73, 0x7f,
+	0x6f, 0xf6, 0x72, 0xad, 0x27, 0x66, 0x3c, 0x80, 0x32, 0x73, 0x76, 0x6c, 0xd3, 0xb6, 0xeb, 0xed,
+	0x32, 0x8e, 0x54, 0x99, 0x29, 0x7e, 0xdf, 0x5d, 0x8d, 0xb0, 0x88, 0xcf, 0x5f, 0xb3, 0xe4, 0xc6,
+	0x40, 0x54, 0xc8, 0xb1, 0xa5, 0x66, 0xfc, 0x56, 0x66, 0x29, 0x53, 0x3e, 0x9a, 0x87, 0xf8, 0x9b,
+	0xe3, 0x4b, 0xbd, 0x9a, 0xd5, 0x01, 0xb3, 0xc7, 0x11, 0x1f, 0xce, 0xf0, 0x2f, 0x54, 0x9e, 0xe4,
+	0xd7, 0xab, 0xf3, 0x4d, 0x5a, 0x79, 0xb8, 0xd0, 0xe2, 0x32, 0xd9, 0xff, 0xcc, 0x72, 0x12, 0x19,
+	0xa6, 0xf1, 0xc7, 0x44, 0xad, 0x9d, 0x98, 0xe8, 0x23, 0x83, 0x12, 0xb5, 0x76, 0x52, 0x44, 0x95,
+	0x26, 0x73, 0xaf, 0x04, 0xe7, 0x33, 0x4f, 0x49, 0xc6, 0x3c, 0xc4, 0x12, 0xcf, 0x87, 0x45, 0xf0,
+	0xa4, 0xd9, 0x93, 0x33, 0x8f, 0x55, 0x1d, 0x84, 0xc9, 0xbe, 0x4c, 0x86, 0xe5, 0xd4, 0xae, 0xd5,
+	0xe2, 0xf1, 0xa5, 0x58, 0x86, 0x5d, 0x4e, 0xc0, 0x30, 0xd5, 0x9b, 0x54, 0xe5, 0x7a, 0x5c, 0xab,
+	0x2d, 0xb3, 0x6b, 0xa0, 0x7f, 0xcd, 0xa3, 0xe1, 0xed, 0x20, 0x7e, 0x3e, 0x15, 0x88, 0xe9, 0xfe,
+	0xec, 0x29, 0xd8, 0x0f, 0x75, 0x16, 0xc3, 0xf1, 0x53, 0xdc, 0xd2, 0x41, 0x98, 0xec, 0x1b, 0xde,
+	0xd3, 0xb5, 0x29, 0x94, 0xe3, 0xa7, 0xb8, 0x95, 0x80, 0x61, 0xaa, 0xb7, 0xf9, 0x27, 0xc3, 0xf0,
+	0x68, 0x1f, 0x92, 0x25, 0x69, 0x67, 0x2f, 0xf7, 0xe1, 0x79, 0x5e, 0x7f, 0xaf, 0xa7, 0x93, 0xf3,
+	0x7a, 0x0e, 0x4f, 0xaf, 0xdf, 0xd7, 0xe9, 0xe7, 0xbd, 0xce, 0xc3, 0x93, 0xec, 0xff, 0xf5, 0xb7,
+	0xb3, 0x5f, 0x7f, 0xc1, 0x55, 0x3d, 0x70, 0xbb, 0x74, 0x72, 0xb6, 0x4b, 0xc1, 0x55, 0xed, 0x63,
+	0x7b, 0xfd, 0xe9, 0x30, 0x3c, 0xd6, 0x8f, 0x94, 0x5b, 0x70, 0x7f, 0x65, 0xf0, 0x96, 0x63, 0xdd,
+	0x5f, 0x79, 0xf1, 0xfa, 0xc7, 0xb8, 0xbf, 0x7a, 0xb2, 0xcf, 0xe3, 0xd9, 0x5f, 0x79, 0xab, 0x7a,
+	0x5c, 0xfb, 0x2b, 0x6f, 0x55, 0xfb, 0xd8, 0x5f, 0x7f, 0x9d, 0x3c, 0x1f, 0x22, 0x51, 0x7b, 0x19,
+	0x86, 0xea, 0x9d, 0x6e, 0x41, 0x26, 0xc5, 0xbd, 0x0c, 0xab, 0x6b, 0xeb, 0xc8, 0x70, 0x10, 0x84,
+	0x11, 0xb1, 0x7f, 0x0a, 0xb2, 0x20, 0xee, 0x39, 0x2a, 0xb6, 0x24, 0x4a, 0x4c, 0x6c, 0xa9, 0x68,
+	0x67, 0x8b, 0xb6, 0xa9, 0x67, 0xb5, 0x6a, 0x81, 0xeb, 0x59, 0xcd, 0xbe, 0x76, 0x43, 0xde, 0xa7,
+	0xb8, 0x94, 0xc0, 0x85, 0x29, 0xec, 0x6c, 0x41, 0x3a, 0x76, 0xa3, 0x32, 0x5c, 0x7c, 0x41, 0xd6,
+	0x96, 0x17, 0x91, 0xe1, 0x30, 0xff, 0xff, 0x31, 0x50, 0xea, 0x0c, 0x30, 0x7d, 0xd6, 0x99, 0x7a,
+	0x32, 0xe7, 0xea, 0x20, 0xfe, 0x50, 0xa9, 0x04, 0xae, 0x62, 0xcb, 0xa7, 0x9a, 0x31, 0x4d, 0x96,
+	0x7c, 0xd4, 0x10, 0x4a, 0xbe, 0xc8, 0x9a, 0x27, 0x97, 0xf5, 0xfa, 0x11, 0xd9, 0xbd, 0x63, 0x6d,
+	0x61, 0x04, 0x40, 0x9d, 0x20, 0xd3, 0xa8, 0x9c, 0xbf, 0x97, 0x65, 0x9b, 0xa8, 0x0c, 0x17, 0x4f,
+	0xc0, 0xd1, 0xc3, 0xd8, 0x21, 0x24, 0xc8, 0xcc, 0x0e, 0x98, 0x3d, 0x91, 0x68, 0x95, 0x22, 0x75,
+	0x6d, 0xa5, 0x3c, 0xd8, 0x2a, 0x25, 0xf4, 0xbe, 0xf1, 0x2a, 0x45, 0x00, 0xd4, 0x09, 0xb2, 0x10,
+	0xf1, 0x7b, 0xa1, 0x8e, 0xbc, 0x32, 0x52, 0xdc, 0xcc, 0x9e, 0x50, 0xb4, 0x0b, 0x7f, 0xaf, 0xa8,
+	0x11, 0x63, 0x22, 0x64, 0x0b, 0x46, 0xef, 0x09, 0x5e, 0x51, 0x19, 0x2d, 0xee, 0xa7, 0xad, 0xb1,
+	0x1b, 0xa1, 0x56, 0x91, 0x4d, 0x18, 0xa2, 0x57, 0x63, 0x09, 0xc6, 0x0e, 0x08, 0x71, 0xfb, 0x9c,
+	0x01, 0xe7, 0xb7, 0xa9, 0x17, 0xd8, 0xf5, 0xa4, 0x65, 0x68, 0xbc, 0xb8, 0x86, 0xe2, 0xb9, 0x2c,
+	0x84, 0x62, 0x9b, 0x64, 0x82, 0x30, 0x7b, 0x0a, 0xe4, 0x39, 0x18, 0xa6, 0x41, 0xbd, 0x21, 0x13,
+	0x74, 0x3f, 0x55, 0x34, 0xaa, 0x56, 0xc4, 0xbd, 0xb0, 0xff, 0x90, 0xe3, 0x33, 0xff, 0xc2, 0x80,
+	0x94, 0x6e, 0x9a, 0xfc, 0x90, 0x01, 0x93, 0x9b, 0xd4, 0x0a, 0xba, 0x1e, 0xbd, 0x2e, 0x7d, 0x42,
+	0x99, 0xc3, 0xca, 0x73, 0x47, 0xa1, 0x12, 0x9f, 0xbb, 0xa6, 0x20, 0x16, 0xce, 0x2a, 0x51, 0x3d,
+	0x04, 0x15, 0x84, 0xda, 0x0c, 0x66, 0x9e, 0x85, 0x33, 0xa9, 0x81, 0x87, 0x32, 0x53, 0xfe, 0x7f,
+	0x06, 0x9c, 0x8d, 0xe7, 0xb2, 0x68, 0xf9, 0x5b, 0x1b, 0x2e, 0xd3, 0x3f, 0xbf, 0x00, 0x65, 0xab,
+	0xd1, 0x88, 0xea, 0xbc, 0xbe, 0xbd, 0x98, 0xdf, 0x54, 0x43, 0xcd, 0x04, 0xc5, 0x7f, 0xa2, 0x40,
+	0xcb, 0xf2, 0x9d, 0x5b, 0x9a, 0x5d, 0x7a, 0x35, 0x4e, 0x41, 0xc2, 0xcd, 0x69, 0xf3, 0x29, 0x28,
+	0x66, 0x8c, 0x30, 0x3f, 0x61, 0x00, 0x49, 0x57, 0x9b, 0x21, 0x1e, 0x8c, 0xc9, 0xfd, 0x1b, 0xbe,
+	0xa5, 0xc5, 0x82, 0xd1, 0x74, 0x5a, 0x68, 0x68, 0xec, 0x84, 0x27, 0x1b, 0x7c, 0x8c, 0xe8, 0xb0,
+	0x74, 0x78, 0x71, 0x2d, 0x48, 0xf2, 0x16, 0x98, 0x68, 0x50, 0xbf, 0xee, 0xd9, 0x9d, 0x20, 0x0e,
+	0x24, 0x8d, 0x02, 0xd2, 0x16, 0x63, 0x10, 0xaa, 0xfd, 0x58, 0xee, 0x8a, 0xc0, 0xf2, 0xef, 0x2d,
+	0x2f, 0xca, 0xcb, 0x1e, 0x3f, 0x9a, 0xef, 0xf0, 0x16, 0x94, 0x90, 0x38, 0x35, 0xf0, 0x50, 0x1f,
+	0xa9, 0x81, 0x59, 0x88, 0xea, 0xc0, 0x79, 0x90, 0xc9, 0xc1, 0x39, 0x90, 0xcd, 0x9f, 0x2d, 0xc1,
+	0x29, 0xd6, 0x85, 0x45, 0xbd, 0x07, 0xd4, 0xe1, 0x61, 0x53, 0x05, 0x17, 0xa1, 0x09, 0x53, 0x81,
+	0x16, 0x57, 0x7c, 0xf8, 0xa0, 0xda, 0xc8, 0xd3, 0x4b, 0x8f, 0x26, 0xd6, 0xf1, 0x92, 0xb7, 0x87,
+	0x71, 0x6b, 0xe2, 0x5a, 0xfc, 0x68, 0xb8, 0x55, 0x79, 0x30, 0xda, 0x03, 0x19, 0xa4, 0x1d, 0x15,
+	0x10, 0xd5, 0x42, 0xd4, 0xde, 0x06, 0x53, 0x32, 0x42, 0x42, 0xe4, 0x78, 0x96, 0xd7, 0x62, 0x7e,
+	0xac, 0x5c, 0x53, 0x01, 0xa8, 0xf7, 0x33, 0x7f, 0xbf, 0x04, 0x7a, 0x99, 0xd2, 0xa2, 0xab, 0x94,
+	0x4e, 0x70, 0x5d, 0x3a, 0xb6, 0x04, 0xd7, 0xa2, 0x50, 0x3f, 0x77, 0xe7, 0x96, 0x76, 0x76, 0xb5,
+	0x48, 0x37, 0x6f, 0xc7, 0xa8, 0x47, 0xbc, 0xac, 0xc3, 0x87, 0x5e, 0xd6, 0xb7, 0x48, 0xcf, 0xdf,
+	0xb2, 0x96, 0x66, 0x3c, 0xf4, 0xfc, 0x3d, 0xa3, 0x0d, 0x54, 0xa2, 0xec, 0xe6, 0x41, 0x96, 0xab,
+	0x61, 0xef, 0x45, 0xa6, 0x40, 0xf7, 0xef, 0xb8, 0x81, 0xd5, 0xaa, 0x18, 0xb1, 0xd2, 0x75, 0x55,
+	0x05, 0xa0, 0xde, 0xcf, 0xbc, 0x05, 0xaf, 0x5e, 0x71, 0xad, 0xc6, 0x82, 0xd5, 0x62, 0x5b, 0xd7,
+	0x93, 0x6e, 0x79, 0x3e, 0x3f, 0x99, 0x99, 0x9e, 0xd1, 0xad, 0xbb, 0x2d, 0x76, 0x6e, 0x5a, 0x51,
+	0x2e, 0x68, 0x6e, 0x88, 0x0c, 0xcf, 0x4d, 0x99, 0x4b, 0x19, 0x43, 0xb8, 0xf9, 0xcf, 0x0c, 0x18,
+	0x95, 0x55, 0x9f, 0xfa, 0x08, 0x2c, 0x65, 0xb1, 0xbf, 0xbc, 0x64, 0xea, 0x00, 0x52, 0x69, 0x6d,
+	0xcb, 0x75, 0x03, 0xad, 0xf6, 0x95, 0xa8, 0x1e, 0xc7, 0xfe, 0x45, 0x81, 0x9e, 0xfb, 0xa3, 0x7a,
+	0xf5, 0x2d, 0x3b, 0xa0, 0xdc, 0xed, 0x46, 0x6e, 0x7c, 0xe1, 0x8f, 0xaa, 0xb4, 0xa3, 0xd6, 0xcb,
+	0xfc, 0x8f, 0x65, 0xb8, 0x22, 0x11, 0xa7, 0x44, 0xb5, 0x88, 0xe7, 0xee, 0xb2, 0x22, 0x09, 0xbc,
+	0x0f, 0x2f, 0x7a, 0x10, 0xea, 0x54, 0x8b, 0xdd, 0x92, 0x65, 0x51, 0x85, 0x14, 0x3a, 0xcc, 0xa2,
+	0x21, 0xb2, 0xeb, 0xf3, 0x66, 0x91, 0x26, 0x29, 0xa4, 0x5d, 0x1a, 0x24, 0xbb, 0x7e, 0x1a, 0x1f,
+	0x66, 0x52, 0xe1, 0x2e, 0x1d, 0x12, 0x50, 0xf5, 0xa8, 0xa5, 0xfa, 0x93, 0x0c, 0x10, 0x6e, 0xb4,
+	0x9a, 0x89, 0x11, 0x73, 0x28, 0x71, 0x75, 0xa3, 0xb5, 0xc3, 0xb5, 0x17, 0x48, 0x03, 0xcf, 0xe6,
+	0x35, 0xcc, 0x22, 0x5b, 0xc5, 0xaa, 0x0e, 0xc2, 0x64, 0x5f, 0x66, 0x72, 0xe0, 0x2e, 0x32, 0x71,
+	0xba, 0xd6, 0x72, 0x9c, 0xb4, 0xeb, 0x96, 0x06, 0xc1, 0x44, 0x4f, 0xf2, 0xdd, 0x06, 0x9c, 0xb3,
+	0xd5, 0x60, 0x9a, 0xf0, 0xe9, 0x8b, 0x55, 0xbe, 0xe1, 0xe6, 0xa5, 0x70, 0x1b, 0x67, 0xa0, 0xc5,
+	0x4c, 0x62, 0xcc, 0xfa, 0x20, 0x23, 0xa2, 0xf5, 0x3d, 0x20, 0x72, 0xf9, 0xf1, 0x77, 0xba, 0x98,
+	0x01, 0xc7, 0xcc, 0x51, 0xe6, 0xc7, 0x4a, 0x30, 0x79, 0xc8, 0x4a, 0xbe, 0x5d, 0x45, 0xe6, 0x18,
+	0x20, 0x6e, 0x51, 0xa5, 0xda, 0x87, 0xd8, 0x41, 0x9e, 0x87, 0xe9, 0x2e, 0x5f, 0x88, 0x30, 0xd3,
+	0x9d, 0xfc, 0xa6, 0xbf, 0x95, 0xbd, 0xb9, 0x75, 0x0d, 0xc2, 0x52, 0xb0, 0xaa, 0xe8, 0x75, 0x28,
+	0x26, 0xf0, 0x98, 0x3f, 0x5c, 0x86, 0xb3, 0x19, 0xb3, 0xe1, 0xee, 0x21, 0x34, 0x21, 0x19, 0x0d,
+	0xe2, 0x1e, 0x92, 0x92, 0xb2, 0x22, 0xf7, 0x90, 0x24, 0x04, 0x53, 0x74, 0xc9, 0x73, 0x30, 0x54,
+	0xf7, 0x6c, 0xb9, 0xe0, 0x6f, 0x2b, 0x74, 0x99, 0xc7, 0xe5, 0x85, 0x09, 0x49, 0x91, 0x95, 0x8c,
+	0x45, 0x86, 0x90, 0x9d, 0x23, 0x2a, 0x0b, 0x0c, 0x85, 0x2d, 0x7e, 0x8e, 0xa8, 0x9c, 0xd2, 0x47,
+	0xbd, 0x1f, 0x79, 0x1e, 0x2a, 0xf2, 0x96, 0x25, 0xa7, 0x58, 0x75, 0x1d, 0x3f, 0x60, 0xdc, 0x2a,
+	0x90, 0xe7, 0x21, 0xf7, 0xdc, 0xbc, 0x99, 0xd3, 0x07, 0x73, 0x47, 0x93, 0xef, 0x82, 0x69, 0x6d,
+	0xe7, 0x87, 0x49, 0xaf, 0x0a, 0x86, 0x7b, 0xa8, 0x98, 0xc4, 0x77, 0xae, 0xb7, 0x61, 0x82, 0x1a,
+	0xcf, 0x97, 0x5b, 0x57, 0xcb, 0xb1, 0x86, 0x55, 0x04, 0xe7, 0x07, 0x2e, 0xec, 0x1a, 0x0b, 0x22,
+	0x5a, 0x33, 0x4b, 0x10, 0xa8, 0xfd, 0x36, 0x7f, 0x74, 0x04, 0xd4, 0x22, 0xdc, 0x64, 0x75, 0x10,
+	0x2d, 0x5a, 0xfc, 0xd6, 0x43, 0x4d, 0xda, 0x2a, 0x0c, 0x35, 0x3b, 0xdd, 0x4a, 0x69, 0x30, 0x74,
+	0xd7, 0x19, 0xba, 0x66, 0xa7, 0x4b, 0x9e, 0x8b, 0x14, 0x73, 0xc5, 0x54, 0x67, 0x51, 0x60, 0x5f,
+	0x42, 0x39, 0x77, 0x45, 0xcb, 0xba, 0x97, 0xc5, 0x8c, 0xda, 0x30, 0xea, 0x4b, 0xad, 0x5d, 0xb9,
+	0x78, 0x52, 0x4b, 0x65, 0xa5, 0xa5, 0x96, 0x4e, 0xe8, 0x13, 0xe4, 0x0f, 0x0c, 0x69, 0xb0, 0x6b,
+	0x4b, 0x97, 0x73, 0x51, 0xce, 0xf3, 0xc7, 0xc4, 0xb5, 0x65, 0x9d, 0xb7, 0xa0, 0x84, 0xa4, 0x44,
+	0x8f, 0xd1, 0x7e, 0x44, 0x0f, 0xf2, 0x13, 0xc9, 0xb2, 0x83, 0x63, 0x57, 0x86, 0x8a, 0xfa, 0xa3,
+	0x2a, 0x8f, 0xf3, 0x77, 0xac, 0x92, 0xf0, 0xff, 0x5c, 0x02, 0x92, 0x7e, 0x59, 0xe4, 0x51, 0x28,
+	0xf3, 0x9c, 0x3f, 0xf2, 0xd4, 0x8a, 0xae, 0xe2, 0x3c, 0xeb, 0x0b, 0x0a, 0x18, 0xa9, 0xc9, 0x5c,
+	0x74, 0xc5, 0x36, 0x3d, 0xf7, 0xc4, 0x93, 0xf4, 0x94, 0xc4, 0x75, 0x57, 0xb4, 0x08, 0xbe, 0x2c,
+	0x89, 0x77, 0x9d, 0x25, 0x75, 0x75, 0xd8, 0x90, 0x82, 0x2a, 0x5f, 0xe1, 0x30, 0x24, 0x50, 0x60,
+	0x88, 0x8b, 0x95, 0x1a, 0x99, 0x50, 0xaf, 0xa0, 0xbb, 0x22, 0x2d, 0xaf, 0x8c, 0x15, 0x36, 0x8a,
+	0xab, 0xac, 0x14, 0xa4, 0xf3, 0x11, 0x42, 0x61, 0x56, 0x8f, 0x7f, 0xa3, 0x42, 0x8c, 0x91, 0x0e,
+	0xec, 0x36, 0xbd, 0x6b, 0x3b, 0x0d, 0xf7, 0x7e, 0xa5, 0x74, 0x24, 0xa4, 0xef, 0x44, 0x08, 0x05,
+	0xe9, 0xf8, 0x37, 0x2a, 0xc4, 0xd8, 0x21, 0xc4, 0x4b, 0x83, 0x38, 0xbc, 0x04, 0xb0, 0x9c, 0x9b,
+	0x28, 0x8d, 0x2e, 0xbd, 0x64, 0xf9, 0x21, 0x54, 0xcd, 0xe9, 0x83, 0xb9, 0xa3, 0xcd, 0x5f, 0x30,
+	0xe0, 0x7c, 0xe6, 0x52, 0x90, 0xeb, 0x70, 0x26, 0x76, 0xde, 0x54, 0xc5, 0x82, 0xb1, 0xb8, 0xea,
+	0xf9, 0xcd, 0x64, 0x07, 0x4c, 0x8f, 0x11, 0xd5, 0xd6, 0x52, 0x62, 0x87, 0xf4, 0xfc, 0x54, 0x2f,
+	0x06, 0x2a, 0x18, 0xb3, 0xc6, 0x98, 0xef, 0xd7, 0x26, 0x1b, 0x2f, 0x16, 0xfb, 0x32, 0x36, 0x68,
+	0xd3, 0x76, 0x92, 0x5f, 0xc6, 0x02, 0x6b, 0x44, 0x01, 0x23, 0x8f, 0xa8, 0x89, 0x1d, 0x22, 0xee,
+	0x1e, 0x26, 0x77, 0x30, 0x3f, 0x00, 0x17, 0x73, 0xbc, 0x2d, 0xc8, 0x22, 0x4c, 0xfa, 0xf7, 0xad,
+	0xce, 0x02, 0xdd, 0xb2, 0xb6, 0x6d, 0x99, 0x46, 0x49, 0x38, 0xe5, 0x4e, 0xd6, 0x94, 0xf6, 0x07,
+	0x89, 0xdf, 0xa8, 0x8d, 0x62, 0xd9, 0xc9, 0x40, 0x7a, 0x6f, 0xb3, 0xc0, 0x9e, 0x4d, 0x18, 0xb3,
+	0x5a, 0xd4, 0x0b, 0xe2, 0x74, 0x7e, 0xdf, 0x56, 0x48, 0x2d, 0x27, 0x71, 0x88, 0x68, 0xa5, 0xf0,
+	0x17, 0x46, 0xb8, 0x99, 0x3c, 0x3f, 0xe9, 0xd1, 0xb6, 0x1b, 0xd0, 0xbb, 0x9e, 0x1d, 0x44, 0x59,
+	0x38, 0x8a, 0x6d, 0xdd, 0x68, 0xfa, 0x18, 0x63, 0x8c, 0x55, 0x9b, 0x4a, 0xa3, 0x8f, 0x1a, 0x51,
+	0xf3, 0x6f, 0x4a, 0x70, 0x3e, 0x73, 0x74, 0x1f, 0xa2, 0xf8, 0x23, 0x30, 0xd4, 0xf5, 0x5a, 0xc9,
+	0x17, 0xb7, 0x8e, 0x2b, 0xc8, 0xda, 0xc9, 0x47, 0x60, 0x7c, 0xc3, 0xf2, 0xed, 0x3a, 0xd3, 0x31,
+	0xca, 0x93, 0x19, 0x8f, 0xee, 0xe1, 0x42, 0xcc, 0x42, 0xd1, 0x1f, 0xfd, 0xc4, 0x98, 0x26, 0x93,
+	0xa4, 0x26, 0x36, 0xa8, 0xe5, 0x51, 0xef, 0x8e, 0x7b, 0x8f, 0x3a, 0x95, 0xe1, 0xe2, 0x55, 0x1c,
+	0xa2, 0x7c, 0x4f, 0x3c, 0x6c, 0x97, 0xa5, 0x58, 0x8c, 0xeb, 0x82, 0x30, 0xd6, 0xbc, 0x10, 0x93,
+	0x41, 0x95, 0x26, 0x4b, 0xfc, 0x74, 0x8f, 0xd2, 0x8e, 0xbc, 0xe7, 0x71, 0x05, 0xf8, 0x4d, 0x4a,
+	0x3b, 0xc8, 0x5b, 0x59, 0xa6, 0xfe, 0xcb, 0xbd, 0x1f, 0x8f, 0x7c, 0x08, 0xc6, 0xba, 0x3e, 0xf5,
+	0xa2, 0x57, 0x71, 0xf4, 0x0f, 0x10, 0x5d, 0x7b, 0xd6, 0x25, 0x1d, 0x8c, 0x28, 0x32, 0xea, 0x1d,
+	0xcb, 0xf7, 0xef, 0xbb, 0x5e, 0xa3, 0x52, 0x3a, 0x5e, 0xea, 0x6b, 0x92, 0x0e, 0x46, 0x14, 0xcd,
+	0xff, 0xc3, 0x80, 0x0b, 0xd9, 0xc9, 0xa5, 0xfa, 0xd8, 0x9d, 0x6d, 0x98, 0xf0, 0xe2, 0x61, 0x72,
+	0xf6, 0x6f, 0x55, 0x4e, 0xbf, 0x39, 0x25, 0x14, 0x89, 0x5d, 0x8d, 0xab, 0x9e, 0xeb, 0x87, 0xdc,
+	0x31, 0x59, 0xa6, 0x25, 0xd2, 0x13, 0x2a, 0x33, 0x41, 0x15, 0x3f, 0x2f, 0x99, 0x14, 0xe5, 0x6e,
+	0x6e, 0xa8, 0xe5, 0xe1, 0x5f, 0x19, 0x75, 0x4a, 0xb2, 0xe7, 0x7e, 0xbc, 0x25, 0x93, 0x72, 0x68,
+	0x1e, 0x5c, 0x32, 0x29, 0x7b, 0xe0, 0x2b, 0xa4, 0x96, 0x47, 0xf6, 0xe4, 0x73, 0x52, 0x01, 0xfc,
+	0xd5, 0x48, 0xde, 0xd3, 0xb2, 0x97, 0xc1, 0x32, 0x1f, 0xd5, 0xad, 0x85, 0xae, 0xd3, 0x88, 0xfc,
+	0xde, 0xf8, 0x71, 0x53, 0x9d, 0x17, 0x6d, 0x18, 0x41, 0xc9, 0x36, 0x40, 0x2c, 0x03, 0x54, 0x4a,
+	0xc5, 0x6f, 0x2b, 0x69, 0x3b, 0x90, 0x90, 0x91, 0xe2, 0x76, 0x54, 0x28, 0x91, 0x0f, 0xc3, 0x94,
+	0x5c, 0x74, 0x2e, 0x32, 0x88, 0x1b, 0x7e, 0xc1, 0x5c, 0x87, 0x9a, 0xd6, 0x35, 0xb2, 0x3d, 0xa8,
+	0xad, 0x3e, 0xea, 0xd4, 0xc8, 0x2e, 0x4c, 0xb6, 0x63, 0x89, 0x3d, 0xcc, 0x7d, 0xf6, 0xec, 0x80,
+	0xf7, 0x9a, 0xf8, 0x68, 0x55, 0x1a, 0x7d, 0xd4, 0x48, 0x25, 0xab, 0xe0, 0x8f, 0x9c, 0x50, 0x15,
+	0xfc, 0x97, 0x60, 0xa4, 0x63, 0x79, 0xcc, 0xcb, 0x7b, 0xb4, 0xb8, 0x2c, 0xac, 0x6e, 0xb4, 0x98,
+	0x0b, 0x46, 0x9f, 0xe4, 0x1a, 0x27, 0x80, 0x92, 0x50, 0x46, 0x3a, 0x99, 0xb1, 0xe3, 0xca, 0xd7,
+	0x18, 0xd7, 0xdf, 0x1f, 0x3f, 0x8e, 0xfa, 0xfb, 0xac, 0x6c, 0xc5, 0xc3, 0xbd, 0xd8, 0x12, 0x57,
+	0xcb, 0xd5, 0x13, 0x9f, 0xe1, 0x20, 0x6a, 0xb9, 0x14, 0xb7, 0x8d, 0xd4, 0x72, 0x49, 0x08, 0xa6,
+	0xe8, 0x92, 0x77, 0x03, 0x71, 0x37, 0x84, 0xa7, 0xd3, 0x75, 0x46, 0x43, 0xc4, 0x17, 0x97, 0x78,
+	0xf4, 0x46, 0x54, 0xc9, 0xf5, 0x76, 0xaa, 0x07, 0x66, 0x8c, 0x32, 0x7f, 0xbd, 0x04, 0x70, 0x8b,
+	0x06, 0xac, 0xe8, 0x0a, 0x13, 0x4f, 0x1f, 0xd6, 0x8c, 0x29, 0x63, 0xdf, 0xb8, 0x0c, 0x9d, 0x0f,
+	0xc3, 0x70, 0xc7, 0x6d, 0x88, 0x73, 0x46, 0x4e, 0x84, 0x07, 0xaf, 0xf0, 0x56, 0x96, 0x36, 0x8e,
+	0xbb, 0x81, 0x49, 0x25, 0x0d, 0x37, 0xc5, 0x70, 0x73, 0x13, 0x8a, 0x76, 0xc6, 0x21, 0x65, 0x96,
+	0x07, 0xbf, 0x52, 0x8e, 0x39, 0x64, 0x68, 0x78, 0xc2, 0x08, 0x4a, 0x9e, 0x06, 0xb0, 0x3b, 0xd7,
+	0xac, 0xb6, 0xdd, 0xb2, 0xe5, 0xe7, 0x3a, 0xce, 0x6d, 0x04, 0xb0, 0xbc, 0x16, 0xb6, 0x3e, 0xd8,
+	0x9b, 0x1d, 0x93, 0xbf, 0x76, 0x51, 0xe9, 0xcd, 0xb2, 0xf0, 0x9d, 0x8e, 0x17, 0x4f, 0x6e, 0x95,
+	0x70, 0xe6, 0xc6, 0x95, 0xa1, 0x5e, 0x33, 0x17, 0xb5, 0x06, 0x7a, 0xcf, 0x5c, 0xa8, 0x45, 0xf3,
+	0x66, 0xfe, 0x46, 0x98, 0xa0, 0x22, 0x09, 0xd4, 0xf2, 0x22, 0x0a, 0x1e, 0x37, 0x2e, 0xe4, 0xd2,
+	0xa5, 0xb8, 0x19, 0xd5, 0x3e, 0xe6, 0xdf, 0x0e, 0xc1, 0xe4, 0xad, 0xa6, 0xed, 0xec, 0x84, 0xd9,
+	0xae, 0x22, 0x57, 0x04, 0xe3, 0x78, 0x5c, 0x11, 0x9e, 0x87, 0x4a, 0x4b, 0x35, 0xfc, 0x09, 0xc1,
+	0xc9, 0x72, 0x9a, 0xd1, 0x0a, 0xf0, 0xbb, 0xf2, 0x4a, 0x4e, 0x1f, 0xcc, 0x1d, 0x4d, 0x02, 0x18,
+	0xa9, 0x87, 0x15, 0x45, 0x0b, 0x67, 0x70, 0x52, 0xd7, 0x62, 0x4e, 0x4d, 0x66, 0x12, 0xf1, 0x3c,
+	0xb9, 0x3d, 0x25, 0x2d, 0x66, 0x8e, 0x3a, 0x4f, 0x77, 0x44, 0x32, 0x9f, 0x3b, 0x9e, 0xb5, 0xb9,
+	0x69, 0xd7, 0x65, 0x0c, 0xa4, 0xd8, 0x89, 0x2b, 0xcc, 0xcb, 0x66, 0x29, 0xab, 0xc3, 0x83, 0xbd,
+	0xd9, 0xab, 0x99, 0xb9, 0x95, 0xf8, 0xdb, 0xcc, 0x1c, 0x82, 0xd9, 0xa4, 0x58, 0x56, 0xcd, 0x43,
+	0x44, 0xce, 0x6b, 0x19, 0x94, 0xbe, 0x54, 0x82, 0x49, 0xb6, 0xdd, 0x58, 0x92, 0xc4, 0x16, 0xab,
+	0xe7, 0xf1, 0x44, 0x32, 0x71, 0x64, 0x64, 0x74, 0x4d, 0x25, 0x8f, 0x5c, 0x81, 0x73, 0x9b, 0xae,
+	0x57, 0xa7, 0x77, 0xaa, 0x6b, 0x77, 0x5c, 0xe9, 0x8e, 0xb7, 0x78, 0xab, 0x56, 0x29, 0xc5, 0x46,
+	0xa0, 0x6b, 0x19, 0x70, 0xcc, 0x1c, 0xc5, 0xe2, 0x22, 0xe2, 0xf6, 0xf5, 0x8e, 0x08, 0xe1, 0x60,
+	0xe8, 0x86, 0xe2, 0x10, 0x94, 0x6b, 0x59, 0x1d, 0x30, 0x7b, 0x1c, 0x0b, 0xaf, 0x92, 0xd6, 0xa6,
+	0x6b, 0xae, 0x77, 0xdf, 0xf2, 0x1a, 0x3a, 0xda, 0xe1, 0xb8, 0x3a, 0xff, 0x62, 0x7e, 0x37, 0xec,
+	0x85, 0x83, 0x79, 0xa1, 0xe8, 0x69, 0x39, 0x59, 0x7a, 0x4a, 0x4f, 0x16, 0xac, 0x94, 0xe9, 0x29,
+	0xd9, 0x15, 0x81, 0xb5, 0xb1, 0x38, 0x39, 0x2f, 0xea, 0x28, 0xaf, 0xcb, 0x5c, 0x64, 0x8a, 0x87,
+	0x23, 0x78, 0x1a, 0xaa, 0xc0, 0x6a, 0x56, 0x86, 0x62, 0x54, 0x77, 0xac, 0x26, 0xb2, 0x36, 0x5e,
+	0x74, 0xc5, 0x6e, 0x52, 0x3f, 0x34, 0x72, 0x88, 0xa2, 0x2b, 0xbc, 0x05, 0x25, 0x84, 0x58, 0x30,
+	0xd5, 0xe9, 0xb6, 0x64, 0x86, 0x29, 0x76, 0xf5, 0x11, 0xaa, 0xe9, 0xc7, 0xb3, 0xca, 0x51, 0xf2,
+	0xb7, 0x9f, 0x59, 0x93, 0x72, 0x4d, 0x45, 0x81, 0x3a, 0x46, 0xf3, 0x27, 0x47, 0x40, 0x49, 0x38,
+	0x74, 0x08, 0x29, 0xf4, 0x67, 0x0c, 0x56, 0x80, 0xcb, 0xa6, 0x4e, 0x90, 0xc8, 0xdd, 0x21, 0x8e,
+	0x8f, 0xf5, 0x42, 0x99, 0x90, 0x3a, 0xd4, 0x59, 0x5e, 0x94, 0x01, 0x3f, 0xd5, 0x0c, 0xe4, 0x32,
+	0x28, 0x2a, 0x03, 0x82, 0x99, 0x93, 0xe1, 0xcf, 0xc3, 0xdb, 0x97, 0x17, 0xd5, 0x7c, 0xa2, 0x55,
+	0xd9, 0x86, 0x11, 0x94, 0x71, 0xde, 0xa6, 0xe7, 0x76, 0x3b, 0x7e, 0x95, 0xc7, 0xf5, 0x8a, 0x97,
+	0xc2, 0x39, 0xef, 0xf5, 0xb8, 0x19, 0xd5, 0x3e, 0x4c, 0x41, 0x2f, 0x7e, 0xae, 0x79, 0x74, 0xd3,
+	0xde, 0xa9, 0x94, 0x63, 0x05, 0xfd, 0x75, 0xa5, 0x1d, 0xb5, 0x5e, 0x3c, 0xa3, 0x9d, 0xef, 0x77,
+	0xa9, 0xb7, 0x8e, 0x2b, 0xb2, 0xaa, 0xb9, 0xc8, 0x68, 0x17, 0x36, 0x62, 0x0c, 0x27, 0x3f, 0x62,
+	0xc0, 0x34, 0x4b, 0xec, 0x63, 0x7b, 0x4c, 0x84, 0xb1, 0xec, 0xb6, 0x2f, 0xb3, 0x3e, 0xe1, 0x60,
+	0x99, 0xa6, 0xe6, 0x50, 0x43, 0x2a, 0x18, 0x64, 0x64, 0x53, 0xd2, 0x81, 0x98, 0x98, 0x01, 0x5b,
+	0x2a, 0xdf, 0x6e, 0x3a, 0xb6, 0xd3, 0x9c, 0x6f, 0x35, 0x7d, 0x59, 0xba, 0x4d, 0xe8, 0xb5, 0xe3,
+	0x66, 0x54, 0xfb, 0x30, 0xeb, 0x60, 0xa8, 0x89, 0x10, 0xeb, 0x3b, 0x1e, 0x7b, 0xff, 0xac, 0xab,
+	0x00, 0xd4, 0xfb, 0x31, 0x3b, 0x7b, 0xd8, 0x20, 0x57, 0x19, 0xf8, 0x48, 0x2e, 0x6f, 0xac, 0x6b,
+	0x10, 0x4c, 0xf4, 0x9c, 0x99, 0x87, 0xb3, 0x19, 0x8f, 0x79, 0x28, 0xde, 0xfa, 0xdf, 0x0d, 0x38,
+	0x2f, 0xa4, 0xae, 0xb0, 0x1e, 0x7a, 0x58, 0x90, 0x23, 0xbb, 0xb6, 0x85, 0x71, 0xac, 0xb5, 0x2d,
+	0xbe, 0x01, 0x35, 0x3c, 0xcc, 0x9f, 0x2b, 0xc1, 0xab, 0x0f, 0xfc, 0x2e, 0xc9, 0x4f, 0x19, 0x30,
+	0x41, 0x77, 0x02, 0xcf, 0x8a, 0x92, 0x1f, 0xb0, 0x4d, 0xba, 0x79, 0x2c, 0x4c, 0x60, 0x6e, 0x29,
+	0x26, 0x24, 0x36, 0x6e, 0x74, 0x95, 0x52, 0x20, 0xa8, 0xce, 0x87, 0x71, 0x5b, 0x51, 0x22, 0x49,
+	0x75, 0x13, 0x94, 0x5c, 0x50, 0x42, 0x66, 0xde, 0xc9, 0x4a, 0x5b, 0xe8, 0x98, 0x0f, 0xb5, 0x57,
+	0xbe, 0x64, 0xc0, 0xa5, 0x35, 0xab, 0x49, 0xbd, 0xc5, 0x6e, 0xb0, 0x1b, 0xa9, 0x89, 0x69, 0x9d,
+	0xda, 0xcc, 0x87, 0xfc, 0xe3, 0x06, 0x80, 0xe7, 0x76, 0x59, 0xdb, 0x4d, 0xba, 0x7b, 0x4c, 0x0a,
+	0xc0, 0x48, 0xb7, 0x84, 0x11, 0x25, 0x54, 0xa8, 0x92, 0x4b, 0xaa, 0x9e, 0x77, 0x54, 0xd5, 0xf1,
+	0x9a, 0x5f, 0x34, 0x20, 0x33, 0xbd, 0x2a, 0x0b, 0x8b, 0x63, 0xea, 0x35, 0xcd, 0xd6, 0x2d, 0x05,
+	0x61, 0xee, 0xc4, 0x3f, 0x9f, 0x04, 0x62, 0xba, 0xbf, 0x30, 0x51, 0x38, 0x5d, 0xab, 0xa5, 0xa3,
+	0x11, 0xe2, 0xa2, 0x34, 0x51, 0xa4, 0xc0, 0x98, 0x35, 0xc6, 0xfc, 0xbf, 0x0c, 0x38, 0xaf, 0x4d,
+	0xd4, 0x97, 0xa6, 0x96, 0x3e, 0x34, 0x89, 0xd9, 0x1f, 0x6d, 0xe9, 0x38, 0x3f, 0x5a, 0xf3, 0xd7,
+	0x4a, 0xc0, 0x12, 0x8b, 0xb0, 0x83, 0xf9, 0x04, 0xf4, 0x87, 0x96, 0xa6, 0x3f, 0x2c, 0xa4, 0x1d,
+	0x91, 0x93, 0xcd, 0x55, 0x18, 0xda, 0x09, 0x85, 0xe1, 0xfc, 0x20, 0x44, 0x7a, 0x6b, 0x08, 0x7f,
+	0xd7, 0x80, 0x09, 0xd9, 0xf3, 0x04, 0x54, 0x82, 0xdf, 0xa9, 0xab, 0x04, 0xdf, 0x31, 0xc0, 0x73,
+	0xe5, 0xe8, 0x00, 0x3f, 0x67, 0xc0, 0x94, 0xec, 0xb1, 0x4a, 0xdb, 0x1b, 0xd4, 0x23, 0xd7, 0x60,
+	0xd4, 0xef, 0xf2, 0x17, 0x29, 0x1f, 0xe8, 0x21, 0xe5, 0x81, 0xe6, 0xbc, 0x0d, 0xab, 0xce, 0xa6,
+	0x5f, 0x13, 0x5d, 0x94, 0xca, 0xe5, 0xa2, 0x01, 0xc3, 0xc1, 0x6c, 0xef, 0x7b, 0x6e, 0x2b, 0x55,
+	0x86, 0x00, 0xdd, 0x16, 0x45, 0x0e, 0x61, 0xb7, 0x54, 0xf6, 0x37, 0xbc, 0x81, 0xf2, 0x5b, 0x2a,
+	0x03, 0xfb, 0x28, 0xda, 0xcd, 0x5f, 0x2c, 0x47, 0x8b, 0xcd, 0x55, 0x12, 0x37, 0x60, 0xbc, 0xee,
+	0x51, 0x2b, 0xa0, 0x8d, 0x85, 0xdd, 0x7e, 0x26, 0xc7, 0xa5, 0x98, 0x6a, 0x38, 0x02, 0xe3, 0xc1,
+	0x4c, 0x60, 0x50, 0x1d, 0x76, 0x4b, 0xb1, 0x6c, 0x95, 0xeb, 0xac, 0xfb, 0x6d, 0x50, 0x76, 0xef,
+	0x3b, 0x51, 0xb0, 0x4f, 0x4f, 0xc2, 0xfc, 0x51, 0x6e, 0xb3, 0xde, 0x28, 0x06, 0xa9, 0x65, 0x38,
+	0x86, 0x7b, 0x94, 0xe1, 0x68, 0xb1, 0xa2, 0x92, 0xec, 0x35, 0x0c, 0x54, 0xc8, 0x5a, 0x7b, 0xa1,
+	0xf1, 0x2b, 0x12, 0xbf, 0x59, 0x6a, 0x0e, 0xf1, 0x8f, 0x5e, 0x27, 0x72, 0xe4, 0x80, 0x3a, 0x91,
+	0xbb, 0x7a, 0x7d, 0x97, 0xd1, 0xe2, 0x5a, 0x5e, 0x39, 0x3d, 0xa5, 0xa4, 0x8b, 0x58, 0xfa, 0xbc,
+	0x1a, 0x2f, 0x2c, 0x33, 0xdf, 0xc5, 0x46, 0x76, 0x8d, 0x3b, 0xe9, 0x4c, 0x52, 0x28, 0xd0, 0x3e,
+	0xa7, 0x6c, 0xde, 0xc2, 0xac, 0x5c, 0xb0, 0xbc, 0xba, 0x7a, 0x98, 0x37, 0x19, 0xf3, 0xfb, 0x87,
+	0xa3, 0xaf, 0x49, 0xea, 0x69, 0xb2, 0xb5, 0x68, 0x46, 0x11, 0x2d, 0x1a, 0x79, 0x53, 0x58, 0x71,
+	0x4d, 0x6c, 0xd7, 0x47, 0x92, 0x15, 0xd7, 0x26, 0x25, 0x69, 0xad, 0xca, 0x5a, 0x17, 0xce, 0xfa,
+	0x81, 0xd5, 0xa2, 0x35, 0x5b, 0x9a, 0xcf, 0xfd, 0xc0, 0x6a, 0x77, 0x0a, 0x94, 0x3c, 0x13, 0x89,
+	0x37, 0xd2, 0xa8, 0x30, 0x0b, 0x3f, 0xab, 0xc7, 0x5d, 0xe1, 0xed, 0xec, 0x30, 0xe6, 0xeb, 0xa3,
+	0x10, 0x3f, 0x7c, 0xf8, 0x82, 0x4c, 0x95, 0x98, 0x8d, 0x0f, 0x73, 0x29, 0x91, 0x0f, 0xc2, 0x79,
+	0x76, 0x00, 0xce, 0xd7, 0x03, 0x7b, 0xdb, 0x0e, 0x76, 0xe3, 0x29, 0x1c, 0xbe, 0xce, 0x19, 0xd7,
+	0x15, 0xac, 0x64, 0x21, 0xc3, 0x6c, 0x1a, 0xe6, 0x5f, 0x1b, 0x40, 0xd2, 0x7b, 0x9d, 0xb4, 0x60,
+	0xac, 0x11, 0x66, 0xc2, 0x30, 0x8e, 0xa4, 0x4a, 0x52, 0x74, 0x84, 0x44, 0x09, 0x34, 0x22, 0x0a,
+	0xc4, 0x85, 0xf1, 0xfb, 0x5b, 0x76, 0x40, 0x5b, 0xb6, 0x1f, 0x1c, 0x51, 0x51, 0xa6, 0x28, 0xc7,
+	0xff, 0xdd, 0x10, 0x31, 0xc6, 0x34, 0xcc, 0x1f, 0x18, 0x86, 0xb1, 0xa8, 0x7e, 0xe9, 0xc1, 0x6e,
+	0xf3, 0x5d, 0x20, 0x75, 0x25, 0xc1, 0xe3, 0x20, 0x1a, 0x5f, 0x2e, 0x03, 0x55, 0x53, 0xc8, 0x30,
+	0x83, 0x00, 0xf9, 0x20, 0x73, 0x78, 0xde, 0xf4, 0xac, 0x28, 0x7d, 0x65, 0x35, 0x54, 0xf3, 0x15,
+	0x20, 0xcc, 0x75, 0x00, 0xcb, 0x19, 0xe8, 0x30, 0x93, 0x08, 0xa1, 0x30, 0x2a, 0xea, 0x8f, 0x87,
+	0x36, 0xa3, 0x42, 0x96, 0x06, 0x21, 0x6b, 0xc6, 0xec, 0x3d, 0x94, 0x3d, 0x43, 0xdc, 0x22, 0xd9,
+	0xb0, 0xf8, 0x3f, 0x34, 0xa7, 0x55, 0xca, 0xc5, 0xa3, 0x20, 0xef, 0xea, 0xa8, 0x64, 0xb2, 0x61,
+	0xbd, 0x11, 0x93, 0x04, 0xcd, 0xdf, 0x36, 0xa0, 0x2c, 0x72, 0xba, 0x1d, 0xbf, 0xa8, 0xf9, 0x01,
+	0x4d, 0xd4, 0x7c, 0xa6, 0xc8, 0x43, 0xf2, 0xa9, 0xe6, 0x09, 0x9a, 0x2c, 0x22, 0x64, 0x9c, 0xf7,
+	0x38, 0x01, 0xd9, 0xef, 0x05, 0x5d, 0xf6, 0x7b, 0x7b, 0xe1, 0xa7, 0xc9, 0x91, 0xfc, 0x7e, 0x7b,
+	0x48, 0x3e, 0x0b, 0x17, 0xad, 0x96, 0xe1, 0xac, 0x0c, 0x74, 0x66, 0x75, 0xd7, 0xd9, 0x16, 0x5f,
+	0xb4, 0x76, 0x7d, 0x19, 0x7a, 0x23, 0x92, 0x08, 0xa5, 0xc1, 0x98, 0x35, 0x86, 0x7c, 0xc9, 0x60,
+	0x42, 0x4c, 0xe0, 0xd9, 0xf5, 0x81, 0x4c, 0xd9, 0xd1, 0xdc, 0xe6, 0x56, 0x05, 0x32, 0x71, 0xb3,
+	0x5e, 0x8f, 0xa5, 0x19, 0xde, 0xfa, 0x60, 0x6f, 0x76, 0x36, 0x43, 0xe3, 0x1d, 0x57, 0x91, 0xf7,
+	0x83, 0x8f, 0xff, 0x59, 0xcf, 0x2e, 0xfc, 0x36, 0x16, 0xce, 0x98, 0xdc, 0x80, 0xb2, 0x5f, 0x77,
+	0x3b, 0x61, 0xa8, 0xfc, 0xa3, 0x59, 0x9a, 0xcd, 0xa4, 0x52, 0x33, 0x5a, 0xe0, 0x1a, 0x1b, 0x89,
+	0x02, 0xc1, 0xcc, 0x8b, 0x30, 0xa9, 0xce, 0x3c, 0xe3, 0xe6, 0xbe, 0xa8, 0xfb, 0x94, 0x1e, 0xd2,
+	0x7d, 0x52, 0xbd, 0xe9, 0xff, 0xc1, 0x10, 0x8c, 0x20, 0x6d, 0xca, 0x12, 0x78, 0x07, 0xdc, 0x39,
+	0xed, 0xb0, 0xe2, 0x72, 0xa9, 0x78, 0x5c, 0xa5, 0x5a, 0xe4, 0x88, 0x95, 0x59, 0x8e, 0xd7, 0x40,
+	0x2d, 0xba, 0x4c, 0x9c, 0xa8, 0x30, 0x98, 0xb0, 0x9f, 0x5c, 0x2b, 0xa6, 0x5e, 0x60, 0x0f, 0xd6,
+	0x4f, 0x29, 0x30, 0xf2, 0xc3, 0x06, 0x10, 0xab, 0x5e, 0x67, 0xc1, 0x6c, 0xd4, 0x67, 0x6b, 0x2f,
+	0x84, 0x55, 0xc1, 0x65, 0x8b, 0x65, 0x39, 0x4f, 0x62, 0x8b, 0xc5, 0xb6, 0x14, 0xc8, 0xc7, 0x0c,
+	0xe2, 0x83, 0x94, 0x27, 0xfb, 0xe7, 0x06, 0x4c, 0x6a, 0xd5, 0xdf, 0xda, 0xb1, 0x25, 0xa0, 0xb8,
+	0xc3, 0x51, 0x18, 0xcd, 0xf7, 0x50, 0x8f, 0x4e, 0xc2, 0xba, 0x70, 0x3b, 0x2a, 0x5f, 0x72, 0x34,
+	0x85, 0xe2, 0xcc, 0x2e, 0xcc, 0xe4, 0x2b, 0x8b, 0xc8, 0x53, 0xcc, 0x6b, 0x31, 0xfe, 0x28, 0xe5,
+	0x16, 0x56, 0x5c, 0x0d, 0x63, 0x18, 0x6a, 0x3d, 0xc9, 0x23, 0x62, 0x51, 0x13, 0xee, 0x82, 0x0c,
+	0x39, 0x6b, 0x37, 0x3f, 0x63, 0xc0, 0x85, 0x70, 0xb4, 0x9e, 0x1e, 0x9f, 0xe9, 0xe3, 0xad, 0x8e,
+	0xcd, 0xb5, 0xe3, 0xaa, 0x7d, 0x61, 0x7e, 0x6d, 0x99, 0xb7, 0x61, 0x04, 0xd5, 0xaa, 0x69, 0x97,
+	0x0e, 0xac, 0xa6, 0xfd, 0x1a, 0xa5, 0x3e, 0x78, 0x39, 0x16, 0x99, 0x22, 0xc2, 0xc2, 0xcb, 0xda,
+	0x7c, 0x2b, 0x8c, 0xd7, 0x6a, 0x37, 0xc4, 0x4e, 0x3a, 0x84, 0x99, 0xcc, 0xfc, 0xe4, 0x10, 0x4c,
+	0xc9, 0x3a, 0x1f, 0x36, 0xd7, 0x3c, 0x9d, 0xc0, 0xf1, 0x7a, 0x07, 0xc6, 0xfd, 0xc8, 0xf0, 0x53,
+	0xca, 0x67, 0x8f, 0x91, 0x2d, 0x27, 0x59, 0xac, 0x31, 0x02, 0x60, 0x8c, 0x88, 0xdc, 0x84, 0x91,
+	0x97, 0x18, 0xab, 0x0f, 0x59, 0x44, 0x5f, 0x1c, 0x37, 0xfa, 0xfe, 0xf9, 0x29, 0xe1, 0xa3, 0x44,
+	0x41, 0x7c, 0x1e, 0xe5, 0xca, 0x65, 0xcf, 0x41, 0x32, 0xbe, 0x6a, 0x2b, 0x1b, 0x0a, 0xb3, 0x62,
+	0x63, 0x84, 0xbf, 0x30, 0x22, 0xc4, 0x2b, 0xcd, 0x6a, 0x23, 0x5e, 0x21, 0x95, 0x66, 0xb5, 0x39,
+	0xe7, 0x48, 0x09, 0x6f, 0x87, 0xf3, 0x99, 0x8b, 0x71, 0xb0, 0x64, 0x6f, 0xfe, 0xdf, 0x25, 0x18,
+	0x66, 0xf5, 0x62, 0x4f, 0x60, 0x67, 0xbe, 0xa0, 0x09, 0x7e, 0xdf, 0x56, 0xb8, 0xd6, 0x6d, 0x9e,
+	0x82, 0x71, 0x33, 0xa1, 0x60, 0x7c, 0x67, 0x61, 0x0a, 0xbd, 0xb5, 0x8b, 0x7f, 0x65, 0xc0, 0x28,
+	0xeb, 0xc6, 0x2c, 0xcd, 0x6d, 0x65, 0x2b, 0x97, 0x8a, 0x4b, 0xed, 0x12, 0xdd, 0x41, 0x9b, 0x98,
+	0x7d, 0x39, 0xb6, 0xac, 0x1f, 0x54, 0x19, 0x1a, 0xe4, 0xcb, 0xd1, 0xc8, 0xc9, 0x14, 0x26, 0x9c,
+	0x68, 0x58, 0x9e, 0x08, 0x23, 0x42, 0xe6, 0x27, 0x0d, 0x38, 0x95, 0x18, 0xd1, 0xc7, 0x95, 0xf1,
+	0x58, 0xf8, 0x90, 0xf9, 0x75, 0x03, 0xce, 0x27, 0xe6, 0x22, 0xaf, 0x6b, 0x07, 0xcf, 0x28, 0x2e,
+	0xe7, 0x56, 0xea, 0x59, 0xce, 0xed, 0x61, 0x18, 0x66, 0x72, 0x91, 0xea, 0x6f, 0xc4, 0xc4, 0x25,
+	0xe4, 0xad, 0xa4, 0x0e, 0xd3, 0x75, 0xa5, 0x66, 0x3e, 0xdd, 0xac, 0x0c, 0xe7, 0x3f, 0x5c, 0x92,
+	0x23, 0xc6, 0xe1, 0x77, 0x1a, 0x0a, 0x4c, 0xa0, 0x34, 0x7f, 0xcb, 0x80, 0x31, 0xf6, 0x98, 0x27,
+	0xc0, 0xa3, 0xbe, 0x43, 0xe7, 0x51, 0x4f, 0x15, 0xdd, 0x4f, 0x39, 0xac, 0xe9, 0x2f, 0x4b, 0xc0,
+	0xeb, 0x51, 0x4b, 0xdf, 0x28, 0xc5, 0xeb, 0xc9, 0xc8, 0xf1, 0xd7, 0xba, 0x22, 0x9d, 0xa6, 0x12,
+	0x2a, 0x69, 0xc5, 0x71, 0xea, 0xf5, 0x9a, 0x5f, 0x94, 0x76, 0xc6, 0x67, 0xf8, 0x46, 0xbd, 0x0c,
+	0x53, 0x3e, 0x8b, 0xd8, 0x8f, 0x12, 0x9b, 0x0e, 0x17, 0x37, 0x3f, 0xf0, 0xd0, 0xff, 0xf0, 0x51,
+	0x84, 0x19, 0xba, 0xa6, 0xe2, 0x46, 0x9d, 0x14, 0x73, 0xfc, 0xd8, 0x68, 0xb9, 0xf5, 0x7b, 0xc2,
+	0x2d, 0x4b, 0x84, 0x00, 0x70, 0xc7, 0x8f, 0x85, 0xa8, 0x15, 0x95, 0x1e, 0x03, 0x79, 0xa0, 0x7d,
+	0xdd, 0x10, 0x2b, 0x7d, 0x88, 0x6f, 0xf4, 0x04, 0x9d, 0xf8, 0x5e, 0x0b, 0x23, 0x1e, 0xbf, 0x21,
+	0x54, 0x86, 0xf4, 0x8f, 0x4f, 0xdc, 0x1b, 0x50, 0x42, 0xd9, 0xf6, 0x10, 0xd7, 0x9e, 0xe1, 0xd8,
+	0xdc, 0xa0, 0x5e, 0x56, 0xcc, 0x5f, 0x93, 0x8f, 0x19, 0x95, 0x34, 0xef, 0xc0, 0x14, 0xbf, 0x57,
+	0x24, 0x6a, 0xa9, 0xbf, 0xa9, 0xcf, 0x6f, 0x44, 0x1d, 0x1a, 0xbb, 0x14, 0x6b, 0xcd, 0xa8, 0x13,
+	0x60, 0x5e, 0x09, 0xe1, 0xd3, 0x09, 0xcf, 0xde, 0x52, 0x1c, 0xb3, 0xbc, 0xa6, 0x02, 0x50, 0xef,
+	0x67, 0x7e, 0xb6, 0x04, 0x8f, 0x88, 0xb9, 0x73, 0xbd, 0xcb, 0x22, 0xed, 0x50, 0xa7, 0x41, 0x9d,
+	0xfa, 0x2e, 0x17, 0x77, 0x1b, 0x2e, 0xd3, 0x78, 0x8d, 0xdc, 0xa7, 0xb4, 0x11, 0x19, 0x30, 0xee,
+	0x16, 0x3e, 0xc3, 0xf2, 0x48, 0xdc, 0xe5, 0xe8, 0x85, 0x25, 0x5b, 0xfc, 0x8f, 0x92, 0x24, 0x23,
+	0xde, 0xf1, 0xdc, 0x8d, 0x48, 0x2a, 0x3b, 0x7a, 0xe2, 0x6b, 0x1c, 0xbd, 0x20, 0x2e, 0xfe, 0x47,
+	0x49, 0xd2, 0x5c, 0x83, 0x47, 0xfb, 0x18, 0x7a, 0x18, 0xe9, 0xfb, 0x20, 0x8c, 0xe2, 0xe9, 0x0f,
+	0x83, 0xf1, 0x8f, 0x0d, 0x78, 0x4c, 0x41, 0xb9, 0xb4, 0xc3, 0x2e, 0x04, 0x2c, 0x5e, 0xb4, 0xce,
+	0x6e, 0xfa, 0x3c, 0xe3, 0xe0, 0xa1, 0x6a, 0x30, 0x7f, 0xd2, 0x80, 0x51, 0xe1, 0x4d, 0x18, 0xb2,
+	0xdf, 0x17, 0x06, 0x5c, 0xf2, 0xdc, 0x29, 0x85, 0xb5, 0xe9, 0xc2, 0x67, 0x13, 0xbf, 0x7d, 0x0c,
+	0xe9, 0x9b, 0xff, 0xb4, 0x0c, 0xdf, 0xd2, 0x3f, 0x22, 0xf2, 0x75, 0x43, 0xad, 0x1d, 0x2f, 0x34,
+	0xe4, 0xed, 0xe3, 0x9d, 0x7c, 0xa4, 0x0b, 0x92, 0xea, 0x85, 0xbb, 0xa9, 0xf2, 0xf2, 0x47, 0xa4,
+	0x66, 0x8a, 0x1f, 0x8c, 0xfc, 0xbc, 0x01, 0x93, 0xec, 0x58, 0x8a, 0x98, 0x8b, 0x78, 0x4d, 0x9d,
+	0x63, 0x7e, 0xd2, 0x5b, 0x0a, 0xc9, 0x44, 0x96, 0x32, 0x15, 0x84, 0xda, 0xdc, 0xc8, 0xba, 0x6e,
+	0xfc, 0x13, 0x37, 0xb5, 0xcb, 0x59, 0x72, 0x89, 0x62, 0x27, 0x88, 0x9c, 0x60, 0xf2, 0x0c, 0x7b,
+	0x33, 0x2d, 0x98, 0xd6, 0x57, 0xfe, 0x38, 0x95, 0x64, 0x2c, 0xd5, 0x5a, 0xea, 0xe9, 0x0f, 0xa5,
+	0x8e, 0xf9, 0xd1, 0x32, 0xcc, 0x2a, 0x4b, 0x9d, 0x95, 0x6c, 0x88, 0x45, 0xbb, 0x4f, 0x58, 0x8e,
+	0x23, 0x9d, 0xb2, 0xc2, 0xfd, 0xdb, 0x18, 0xf0, 0xad, 0x66, 0x91, 0x9a, 0x9b, 0x8f, 0xc9, 0x24,
+	0xbc, 0x8e, 0x14, 0x08, 0xaa, 0xb3, 0xe9, 0xe1, 0x59, 0x5c, 0x3a, 0x31, 0xcf, 0x62, 0xf2, 0xe1,
+	0xf0, 0x20, 0x16, 0xdb, 0xe8, 0xf9, 0x63, 0x58, 0x1b, 0x7e, 0xae, 0xe7, 0xe8, 0x24, 0x7f, 0xd0,
+	0xe0, 0x87, 0x6c, 0x9c, 0x13, 0xaa, 0x32, 0x5c, 0xdc, 0x41, 0xf4, 0xc0, 0x84, 0x53, 0xd1, 0xd9,
+	0x1d, 0x37, 0xa1, 0x4e, 0x9e, 0xb9, 0x79, 0x25, 0x5f, 0xe5, 0xa1, 0xb6, 0xe5, 0x3f, 0x19, 0xd6,
+	0xce, 0x8e, 0xdc, 0xf5, 0xe8, 0x43, 0x35, 0xfc, 0xf9, 0xc4, 0xee, 0x15, 0x3c, 0xc9, 0x3e, 0xae,
+	0x37, 0x74, 0xb4, 0x5b, 0x78, 0xe8, 0xe4, 0xb6, 0xf0, 0xdf, 0xb9, 0x3d, 0xb4, 0x00, 0xe7, 0x95,
+	0x17, 0x16, 0xd7, 0x9a, 0xe2, 0x79, 0x46, 0x6d, 0xdf, 0x0e, 0xb3, 0x65, 0x2b, 0x32, 0xcc, 0x73,
+	0xa2, 0x19, 0x43, 0xb8, 0xb9, 0xa2, 0x71, 0xc7, 0x3b, 0x6e, 0xc7, 0x6d, 0xb9, 0xcd, 0xdd, 0xf9,
+	0xfb, 0x96, 0x47, 0xa5, 0xf7, 0xdf, 0x61, 0x24, 0xa2, 0x8f, 0x95, 0xe1, 0x8a, 0x82, 0x2e, 0x33,
+	0xa9, 0xe8, 0x21, 0xf0, 0xb1, 0xad, 0xad, 0xe7, 0xfe, 0x2c, 0x15, 0xf7, 0x08, 0x3d, 0x68, 0x5e,
+	0xc5, 0x72, 0x81, 0x92, 0x3d, 0x03, 0xa0, 0x6d, 0xed, 0xc8, 0x44, 0x74, 0x95, 0xa1, 0x23, 0x39,
+	0x3a, 0xb2, 0x27, 0xb8, 0x1a, 0x91, 0x11, 0xd3, 0x7b, 0x3e, 0xd4, 0xaf, 0xc5, 0x80, 0x23, 0x12,
+	0x79, 0x94, 0x27, 0x1a, 0x38, 0xd9, 0xe9, 0x4c, 0x1b, 0x4e, 0x25, 0x66, 0x7e, 0xac, 0x66, 0xb5,
+	0xdf, 0x1d, 0x85, 0x49, 0x65, 0x29, 0x7d, 0xf2, 0x2b, 0x06, 0x5c, 0xa2, 0x79, 0x12, 0x96, 0xbc,
+	0x1e, 0x3e, 0x7f, 0x5c, 0x12, 0x9c, 0xac, 0x17, 0x96, 0x07, 0xc6, 0xfc, 0x99, 0xb1, 0x44, 0x26,
+	0x7e, 0xf4, 0x4d, 0x0f, 0x92, 0xc8, 0x24, 0x93, 0x49, 0x08, 0xc5, 0x43, 0xfc, 0x1b, 0x15, 0x62,
+	0xe4, 0x0b, 0x06, 0x9c, 0x6b, 0x65, 0x70, 0x38, 0xc9, 0x31, 0x6b, 0xc7, 0x70, 0xb6, 0x08, 0x87,
+	0x8c, 0x2c, 0x08, 0x66, 0x4e, 0x85, 0xfc, 0x6c, 0x6e, 0x86, 0x64, 0xe1, 0x2f, 0x71, 0xe7, 0x38,
+	0xbe, 0xc1, 0x02, 0xc9, 0x92, 0x3f, 0x6b, 0x00, 0x69, 0xa4, 0x6e, 0x9b, 0xd2, 0x17, 0xef, 0x3d,
+	0x47, 0x7e, 0xa7, 0x16, 0x1e, 0x35, 0xe9, 0x76, 0xcc, 0x98, 0x04, 0x7f, 0xcf, 0x41, 0x06, 0xcf,
+	0xaf, 0x8c, 0x1d, 0xc9, 0x7b, 0xce, 0x3a, 0x4e, 0xc4, 0x7b, 0xce, 0x82, 0x60, 0xe6, 0x54, 0xcc,
+	0xdf, 0x1c, 0x17, 0xca, 0x4f, 0xee, 0xf2, 0xf0, 0x02, 0x8c, 0x6c, 0x58, 0xf5, 0x7b, 0xd2, 0xee,
+	0x57, 0xd0, 0x09, 0x67, 0x81, 0x63, 0x10, 0x6a, 0x07, 0xf1, 0x3f, 0x4a, 0xac, 0xe4, 0x7d, 0x30,
+	0xd4, 0x70, 0xc2, 0x70, 0xf8, 0x77, 0x0c, 0xa0, 0x4c, 0x8f, 0x0d, 0x9a, 0x2c, 0x76, 0x8c, 0x21,
+	0x25, 0x0e, 0x8c, 0x39, 0x52, 0x57, 0x28, 0xd5, 0x39, 0xef, 0x2a, 0x4a, 0x20, 0xd2, 0x39, 0x46,
+	0x9a, 0xce, 0xb0, 0x05, 0x23, 0x1a, 0x8c, 0x5e, 0xc2, 0xae, 0x56, 0x98, 0x5e, 0x64, 0x89, 0x50,
+	0xf3, 0xcf, 0x26, 0xad, 0x11, 0x94, 0x25, 0x51, 0xb6, 0x9d, 0x28, 0x3f, 0xdd, 0x33, 0x45, 0xa9,
+	0xdd, 0x61, 0x58, 0x62, 0x95, 0x20, 0xff, 0xe9, 0xa3, 0x44, 0x4e, 0x36, 0x60, 0x44, 0x84, 0xb7,
+	0x57, 0x46, 0x07, 0xb3, 0xeb, 0x88, 0x88, 0x79, 0xb1, 0x0d, 0xc4, 0xff, 0x28, 0x31, 0x93, 0x17,
+	0x99, 0x4a, 0x59, 0x7a, 0x5f, 0x8d, 0x0d, 0xb6, 0x74, 0x91, 0xeb, 0x95, 0x0c, 0xd6, 0x15, 0xbf,
+	0x30, 0xc2, 0x4f, 0x36, 0x60, 0xd4, 0x16, 0x71, 0xa6, 0x95, 0xf1, 0xe2, 0xdb, 0x4e, 0x86, 0xaa,
+	0x0a, 0xcd, 0x92, 0xfc, 0x81, 0x21, 0xe2, 0x3c, 0x17, 0x0b, 0xf8, 0x06, 0xba, 0x58, 0x90, 0x97,
+	0x00, 0x68, 0xa8, 0x33, 0xf6, 0x2b, 0x13, 0xc5, 0xb7, 0x8c, 0xa2, 0x79, 0x0e, 0x05, 0xa2, 0xa8,
+	0xc9, 0x47, 0x85, 0x08, 0xf9, 0xa0, 0xaa, 0xa4, 0x9a, 0x1c, 0x2c, 0x63, 0x47, 0x3a, 0x07, 0x4d,
+	0x6c, 0xab, 0x0a, 0x41, 0xbe, 0xa2, 0x3b, 0x32, 0x7f, 0x17, 0x00, 0x62, 0x73, 0x22, 0x4b, 0x2b,
+	0x15, 0x52, 0x19, 0x24, 0xad, 0xd4, 0x75, 0x09, 0x16, 0xdb, 0x2b, 0xfc, 0x85, 0x11, 0x6e, 0x16,
+	0x78, 0x93, 0xce, 0x0f, 0x16, 0x17, 0xf8, 0xed, 0x2f, 0x37, 0xd8, 0x4b, 0x00, 0xf5, 0x38, 0x47,
+	0xed, 0x50, 0xf1, 0x77, 0x15, 0xe5, 0xaf, 0x8d, 0xdf, 0x55, 0xd4, 0xe4, 0xa3, 0x42, 0x24, 0xc7,
+	0x09, 0x7b, 0xb8, 0x90, 0x13, 0xf6, 0x33, 0x70, 0x4a, 0x3a, 0xbd, 0x2d, 0x73, 0xb3, 0x5a, 0xb0,
+	0x2b, 0xa3, 0x2c, 0xb9, 0x3b, 0x64, 0x55, 0x07, 0x61, 0xb2, 0x2f, 0xf9, 0xc7, 0x06, 0x8b, 0x67,
+	0x15, 0x02, 0x5a, 0x65, 0xa4, 0x78, 0x4c, 0x79, 0xfc, 0xf6, 0xe7, 0x42, 0x79, 0x4f, 0x08, 0xf2,
+	0xcf, 0x85, 0x5c, 0x35, 0x6c, 0x3e, 0x22, 0x31, 0x3e, 0x9a, 0x35, 0xf9, 0x1d, 0xa6, 0x23, 0x68,
+	0xb5, 0xdc, 0xba, 0x15, 0xf0, 0x7c, 0x91, 0x22, 0xfc, 0xf3, 0xf6, 0x80, 0x4f, 0x31, 0x1f, 0x63,
+	0x14, 0x0f, 0xf2, 0xde, 0x48, 0x13, 0x10, 0x43, 0x8e, 0xe8, 0x59, 0xd4, 0xe9, 0x93, 0xff, 0xdd,
+	0x80, 0xc7, 0x44, 0xcc, 0x6d, 0x95, 0x7a, 0x81, 0xbd, 0x69, 0xd7, 0xad, 0x80, 0x8a, 0xb4, 0xb5,
+	0x61, 0xc4, 0x94, 0x70, 0x19, 0x1f, 0x3b, 0xb4, 0xcb, 0xf8, 0xe3, 0xfb, 0x7b, 0xb3, 0x8f, 0x55,
+	0xfb, 0xc0, 0x8d, 0x7d, 0xcd, 0x80, 0xd9, 0x1b, 0x5b, 0x6a, 0xfa, 0xf4, 0xca, 0x78, 0x71, 0x7b,
+	0xa3, 0x96, 0x87, 0x5d, 0x28, 0x18, 0xb4, 0x26, 0xd4, 0x49, 0xcd, 0xdc, 0x83, 0x29, 0x6d, 0xa3,
+	0x1d, 0xab, 0xa6, 0xd6, 0x81, 0xd3, 0xc9, 0xfd, 0x70, 0xac, 0xf7, 0xbc, 0x9b, 0x30, 0x1e, 0x09,
+	0x0b, 0xe4, 0x11, 0x85, 0x50, 0xda, 0x97, 0x8c, 0x99, 0x11, 0x15, 0x1d, 0x8a, 0x30, 0x23, 0xf2,
+	0x74, 0x9e, 0x12, 0xa1, 0xf9, 0x7b, 0xd2, 0x8c, 0x78, 0x87, 0xb6, 0x3b, 0x2d, 0x2b, 0xa0, 0xaf,
+	0x7c, 0xff, 0x17, 0xf3, 0xdf, 0x1a, 0xe2, 0xbc, 0x11, 0xa2, 0x0d, 0xb1, 0x60, 0xa2, 0x2d, 0x6a,
+	0xf7, 0xf1, 0xe4, 0x9f, 0x46, 0xf1, 0xb4, 0xa3, 0xab, 0x31, 0x1a, 0x54, 0x71, 0x92, 0xfb, 0x30,
+	0x1e, 0x0a, 0x83, 0xa1, 0xa6, 0xe6, 0xda, 0x60, 0xc2, 0x59, 0x24, 0x77, 0x46, 0x47, 0x6b, 0xd8,
+	0xe2, 0x63, 0x4c, 0xcb, 0xb4, 0x80, 0xa4, 0xc7, 0x30, 0x3d, 0x53, 0x18, 0xbe, 0x65, 0xe8, 0xd5,
+	0x76, 0x52, 0x21, 0x5c, 0xa1, 0x92, 0xb5, 0x94, 0xa7, 0x64, 0x35, 0x7f, 0xa3, 0x04, 0xe7, 0xe4,
+	0xd5, 0x73, 0xbe, 0x5e, 0x77, 0xbb, 0x4e, 0x10, 0xc7, 0x04, 0x8b, 0x40, 0x7b, 0x49, 0x84, 0x8b,
+	0x93, 0x22, 0x0a, 0x1f, 0x25, 0x84, 0x65, 0xb4, 0xe0, 0x52, 0x48, 0x83, 0xe7, 0x00, 0x8c, 0xb9,
+	0x84, 0x9a, 0xd1, 0x62, 0x29, 0xab, 0x03, 0x66, 0x8f, 0x23, 0xdb, 0x40, 0xda, 0xd6, 0x4e, 0x12,
+	0x5b, 0xb1, 0x1a, 0x6e, 0xfc, 0xbe, 0xb8, 0x9a, 0xc2, 0x86, 0x19, 0x14, 0xd8, 0x41, 0xca, 0x24,
+	0xb9, 0x4e, 0x40, 0x1b, 0xe2, 0x11, 0x43, 0x2f, 0x06, 0x7e, 0x90, 0xce, 0xeb, 0x20, 0x4c, 0xf6,
+	0x35, 0xbf, 0x77, 0x04, 0x2e, 0xe9, 0x8b, 0xc8, 0xbe, 0xd0, 0x30, 0x16, 0xfe, 0xd9, 0x30, 0x54,
+	0x4a, 0x2c, 0xe4, 0x13, 0xc9, 0x50, 0xa9, 0x8a, 0xea, 0x25, 0x23, 0x07, 0x69, 0x61, 0x53, 0xdf,
+	0x80, 0xc0, 0xf6, 0x9c, 0x58, 0xe0, 0xa1, 0x63, 0x0d, 0xe0, 0xff, 0x94, 0x01, 0x33, 0x7a, 0xf3,
+	0x35, 0xdb, 0xb1, 0xfd, 0x2d, 0x59, 0xb6, 0xe5, 0xf0, 0x91, 0x5a, 0xbc, 0xf0, 0xf3, 0x4a, 0x2e,
+	0x46, 0xec, 0x41, 0x8d, 0x7c, 0xda, 0x80, 0x87, 0x12, 0xeb, 0xa2, 0x15, 0x91, 0x39, 0x7c, 0xd0,
+	0x16, 0xcf, 0xc4, 0xb2, 0x92, 0x8f, 0x12, 0x7b, 0xd1, 0x63, 0x2a, 0x8d, 0x0b, 0x9d, 0xac, 0xc0,
+	0xee, 0xf0, 0x5a, 0x5a, 0x48, 0x85, 0x96, 0x19, 0x2a, 0xbe, 0x70, 0x59, 0x6e, 0xd1, 0x0b, 0x99,
+	0x60, 0x1f, 0x73, 0x26, 0x62, 0xfe, 0x3f, 0x25, 0x28, 0x73, 0x47, 0xa1, 0x57, 0x46, 0x7c, 0x0d,
+	0x9f, 0x6a, 0xae, 0x9f, 0x65, 0x33, 0xe1, 0x67, 0xf9, 0x6c, 0x71, 0x12, 0xbd, 0x1d, 0x2d, 0xdf,
+	0x0b, 0x17, 0x78, 0xb7, 0xf9, 0x06, 0x57, 0xb4, 0xf9, 0xb4, 0x31, 0xdf, 0x68, 0xf0, 0xeb, 0xed,
+	0xa0, 0xa9, 0x69, 0x4d, 0x96, 0xc4, 0x8d, 0xe3, 0x56, 0x58, 0x0c, 0xd9, 0x86, 0x31, 0x4f, 0xb2,
+	0x19, 0xf9, 0x6e, 0x56, 0x0a, 0x3f, 0x5a, 0x06, 0xeb, 0x12, 0x37, 0xb6, 0xf0, 0x17, 0x46, 0xb4,
+	0xcc, 0xaf, 0x8e, 0x40, 0x25, 0x6f, 0x10, 0x4b, 0xe5, 0x72, 0xa1, 0x1e, 0x4b, 0x9c, 0x2c, 0xa7,
+	0x85, 0xeb, 0x89, 0x14, 0xed, 0x46, 0x71, 0x75, 0x48, 0x75, 0x3e, 0x9a, 0x15, 0xaf, 0x82, 0x52,
+	0xcd, 0xa4, 0x80, 0x39, 0x94, 0x59, 0x19, 0x6d, 0x76, 0x67, 0xac, 0xab, 0x4e, 0x63, 0x37, 0x0b,
+	0xaf, 0xd5, 0xcd, 0x08, 0x55, 0x34, 0xa9, 0x28, 0xa1, 0xa4, 0x6c, 0x57, 0xc8, 0x31, 0xe2, 0xbe,
+	0xbf, 0x75, 0x93, 0xee, 0x76, 0x2c, 0x3b, 0xf4, 0x93, 0x2a, 0x4e, 0xbc, 0x56, 0xbb, 0x21, 0x51,
+	0xe9, 0xc4, 0x95, 0x76, 0x85, 0x1c, 0x33, 0x6c, 0x4e, 0xb9, 0x6a, 0x66, 0x97, 0x41, 0x3c, 0xd8,
+	0x33, 0x53, 0xc4, 0x08, 0x31, 0x5f, 0x07, 0xe9, 0x24, 0xd9, 0x9e, 0x38, 0xe3, 0x27, 0x8f, 0x55,
+	0xc9, 0x78, 0x57, 0x8b, 0x09, 0x60, 0x39, 0x67, 0xb4, 0x50, 0x19, 0xa4, 0xc1, 0x69, 0xf2, 0x7c,
+	0x52, 0x34, 0xa8, 0x37, 0x96, 0x9c, 0xba, 0xb7, 0xcb, 0xa3, 0xf1, 0xd9, 0xa4, 0x46, 0x8a, 0x4f,
+	0x8a, 0xd5, 0x09, 0xd4, 0x90, 0xe9, 0x93, 0x4a, 0x83, 0xd3, 0xe4, 0x59, 0x7d, 0x99, 0x8b, 0x39,
+	0x7b, 0xec, 0xef, 0x4d, 0x2a, 0x1e, 0x16, 0x0f, 0xc9, 0xd7, 0xe0, 0x15, 0x12, 0x0f, 0xc9, 0xe7,
+	0x9a, 0xe3, 0x4e, 0xfc, 0x5b, 0x2c, 0x8a, 0x23, 0x59, 0x7f, 0xab, 0xaf, 0x68, 0xba, 0x13, 0xf3,
+	0x74, 0x7d, 0x4d, 0x5c, 0xf3, 0x73, 0x28, 0x4e, 0x22, 0x91, 0xac, 0xf7, 0x69, 0xde, 0x85, 0x29,
+	0xcd, 0x9b, 0x58, 0x49, 0x16, 0x99, 0x95, 0xe6, 0x52, 0xcd, 0x05, 0x59, 0xea, 0x95, 0xc5, 0x32,
+	0xde, 0xf2, 0x69, 0xce, 0xf6, 0xf7, 0x66, 0xcb, 0xff, 0xe9, 0x59, 0xb9, 0xe5, 0x43, 0x1b, 0x12,
+	0x4f, 0x41, 0xe9, 0x0f, 0x62, 0x43, 0xe2, 0xb9, 0x2c, 0x65, 0xca, 0x58, 0xf1, 0x3f, 0x4a, 0xac,
+	0xac, 0xfa, 0xbe, 0x9a, 0x98, 0xf5, 0x56, 0x7c, 0xb1, 0x3c, 0x97, 0x4c, 0xe3, 0xca, 0xb7, 0x64,
+	0xaa, 0x37, 0x41, 0x61, 0x85, 0x12, 0x67, 0x59, 0xa1, 0xea, 0x4a, 0xcc, 0x02, 0x35, 0xaa, 0x59,
+	0x9f, 0x74, 0x75, 0xfb, 0xf0, 0x49, 0xa8, 0xdb, 0x3d, 0x98, 0xd8, 0xb2, 0x99, 0x2a, 0x59, 0xc8,
+	0x50, 0xe5, 0xe2, 0xe2, 0xe1, 0x8d, 0x18, 0x8d, 0xd0, 0x41, 0x28, 0x0d, 0xa8, 0x12, 0x21, 0x9e,
+	0x96, 0xd6, 0x7a, 0xa4, 0xb8, 0x48, 0x14, 0xeb, 0xc5, 0xe3, 0xe7, 0xcc, 0x49, 0x69, 0xed, 0x00,
+	0x38, 0x51, 0xae, 0xd7, 0x41, 0xac, 0x52, 0x71, 0xc6, 0x58, 0x21, 0x74, 0xc4, 0xbf, 0x51, 0xa1,
+	0xc0, 0xd6, 0xb5, 0x1d, 0x97, 0xd7, 0xa8, 0x8c, 0x15, 0x5f, 0x57, 0xa5, 0x4a, 0x87, 0xd4, 0xed,
+	0xc4, 0x0d, 0xa8, 0x12, 0x61, 0xcf, 0xd8, 0x8e, 0x0a, 0x13, 0x54, 0xc6, 0x8b, 0x3f, 0x63, 0x5c,
+	0xde, 0x40, 0x3c, 0x63, 0xfc, 0x1b, 0x15, 0x0a, 0xcc, 0x02, 0x17, 0x19, 0x2f, 0xa1, 0xb8, 0x86,
+	0xac, 0x2f, 0xc3, 0xe5, 0x5b, 0x62, 0x45, 0xd1, 0x04, 0xff, 0x4e, 0x1f, 0x52, 0x94, 0x44, 0xbc,
+	0x58, 0x08, 0xe3, 0x1d, 0x29, 0xa5, 0x51, 0x1c, 0xc3, 0x30, 0xd9, 0x33, 0x86, 0xa1, 0x0a, 0x67,
+	0x44, 0xc4, 0x92, 0x0c, 0xc7, 0xe3, 0x0c, 0x61, 0x2a, 0xb6, 0xc0, 0xd4, 0x92, 0x40, 0x4c, 0xf7,
+	0x17, 0x0c, 0x9f, 0x36, 0xf8, 0xd8, 0x69, 0x95, 0xe1, 0x8b, 0x36, 0x8c, 0xa0, 0x64, 0x1b, 0x26,
+	0x7d, 0x25, 0x20, 0xa2, 0x72, 0x6a, 0x50, 0xfb, 0xa5, 0xc0, 0x23, 0x32, 0x52, 0xaa, 0x2d, 0xa8,
+	0xd1, 0xd1, 0x8d, 0x6b, 0xa7, 0x4f, 0xd6, 0xb8, 0xc6, 0x72, 0xab, 0xab, 0xbe, 0xce, 0x67, 0x8e,
+	0x24, 0x67, 0xca, 0x81, 0xbe, 0xd0, 0xec, 0xd5, 0xd2, 0x9d, 0x8e, 0xeb, 0x77, 0x3d, 0xca, 0x8b,
+	0x3b, 0xf1, 0xd7, 0x43, 0xe2, 0x57, 0xbb, 0x94, 0x04, 0x62, 0xba, 0x3f, 0xf9, 0x3e, 0x03, 0x4e,
+	0xfb, 0xbb, 0x7e, 0x40, 0xdb, 0xec, 0xd8, 0x72, 0x1d, 0xca, 0x4c, 0xe8, 0x67, 0x8b, 0x67, 0x10,
+	0xaf, 0x25, 0x70, 0x89, 0x63, 0x27, 0xd9, 0x8a, 0x29, 0x9a, 0x6c, 0xe7, 0xa8, 0x59, 0x57, 0x2a,
+	0xe7, 0x8a, 0xef, 0x1c, 0x35, 0xa3, 0x8b, 0xd8, 0x39, 0x6a, 0x0b, 0x6a, 0x74, 0x58, 0x00, 0x8d,
+	0x1f, 0xd6, 0x7f, 0xe7, 0x2b, 0x78, 0x3e, 0x4e, 0xeb, 0x59, 0x53, 0x01, 0xa8, 0xf7, 0x23, 0x1f,
+	0x81, 0x49, 0xf5, 0xec, 0xac, 0x5c, 0x38, 0xea, 0x04, 0xf7, 0x62, 0xe6, 0x2a, 0x48, 0x23, 0x48,
+	0x10, 0x2e, 0x28, 0xa1, 0x7a, 0xea, 0xf7, 0x7d, 0x91, 0x3f, 0x82, 0xb8, 0x4c, 0x67, 0xf6, 0xc0,
+	0x9c, 0x91, 0xe4, 0x27, 0xb3, 0x6d, 0xf5, 0x95, 0x2b, 0x43, 0x45, 0xcb, 0x6a, 0xa4, 0x0c, 0xf2,
+	0x77, 0xed, 0x60, 0xeb, 0x36, 0xbf, 0x14, 0xf9, 0x87, 0x36, 0xdb, 0x33, 0x57, 0x82, 0x7a, 0xd7,
+	0x0f, 0xdc, 0xb6, 0x28, 0xdd, 0x59, 0xdd, 0xa2, 0xf5, 0x7b, 0x7e, 0xe5, 0x52, 0x71, 0x57, 0x82,
+	0x6a, 0x12, 0x5b, 0x3c, 0xa7, 0x14, 0xc8, 0xc7, 0x0c, 0xe2, 0xe6, 0x1f, 0x30, 0x53, 0x47, 0xa8,
+	0x41, 0x3a, 0x09, 0xdb, 0x4d, 0x43, 0x53, 0xaa, 0x2d, 0x0c, 0xa4, 0xf1, 0xca, 0xad, 0xa9, 0x62,
+	0x7e, 0xc5, 0x80, 0xe9, 0xb8, 0xdb, 0x09, 0x5c, 0xd7, 0xea, 0xfa, 0x75, 0xed, 0x9d, 0x83, 0x3d,
+	0x57, 0xce, 0x9d, 0xed, 0x6f, 0x4a, 0xea, 0x53, 0x71, 0x89, 0x7c, 0x5b, 0xf3, 0x85, 0x60, 0xa4,
+	0x6f, 0x0c, 0xe2, 0x0b, 0xa1, 0x66, 0xdf, 0x88, 0x9f, 0x37, 0xc3, 0x37, 0xe2, 0xbb, 0x34, 0x99,
+	0x78, 0x80, 0xbc, 0x37, 0x91, 0x00, 0x1c, 0x92, 0x16, 0x0b, 0x70, 0x90, 0x80, 0xfc, 0x92, 0x7a,
+	0x64, 0x0e, 0x50, 0x07, 0x45, 0x7b, 0xe0, 0xde, 0x5e, 0x28, 0x3f, 0x78, 0x1a, 0x26, 0x14, 0x65,
+	0x6b, 0xc2, 0xb3, 0xc3, 0x38, 0x09, 0xcf, 0x8e, 0x00, 0x26, 0xea, 0x51, 0x79, 0xd5, 0x70, 0xd9,
+	0x07, 0xa4, 0x19, 0x1d, 0xd5, 0x71, 0xe1, 0x56, 0x1f, 0x55, 0x32, 0x4c, 0xa0, 0x8c, 0xf6, 0xd8,
+	0xd0, 0x11, 0xf8, 0xdb, 0xf4, 0xda, 0x57, 0x6f, 0x06, 0x08, 0xef, 0x24, 0xb4, 0x21, 0xf3, 0xcb,
+	0x47, 0xbe, 0xe4, 0xcb, 0xfe, 0x8d, 0x08, 0x86, 0x4a, 0xbf, 0xb4, 0xa7, 0x40, 0xf9, 0xc4, 0x3c,
+	0x05, 0xd8, 0x36, 0x60, 0x0d, 0x4b, 0x9e, 0xe7, 0x7a, 0x03, 0xf9, 0xef, 0xad, 0x84, 0x58, 0xe2,
+	0x6d, 0x10, 0x35, 0xf9, 0xa8, 0x10, 0xc9, 0x71, 0xf0, 0x19, 0x2d, 0xe4, 0xe0, 0xd3, 0x85, 0xb3,
+	0x1e, 0x0d, 0xbc, 0xdd, 0xea, 0x6e, 0x9d, 0x17, 0x66, 0xf1, 0x02, 0xae, 0x55, 0x18, 0x2b, 0x96,
+	0x30, 0x11, 0xd3, 0xa8, 0x30, 0x0b, 0xbf, 0x26, 0x94, 0x8f, 0xf7, 0x14, 0xca, 0xdf, 0x02, 0x13,
+	0x01, 0xad, 0x6f, 0x39, 0xcc, 0x3d, 0x78, 0x79, 0x51, 0x66, 0x1f, 0x8f, 0xe5, 0xcb, 0x18, 0x84,
+	0x6a, 0x3f, 0xb2, 0x00, 0x43, 0x5d, 0xbb, 0x21, 0x6f, 0x25, 0xdf, 0x1a, 0x99, 0x2d, 0x96, 0x17,
+	0x1f, 0xec, 0xcd, 0xbe, 0x3a, 0xf6, 0x98, 0x89, 0x9e, 0xea, 0x6a, 0xe7, 0x5e, 0xf3, 0x2a, 0x8b,
+	0xe5, 0xf6, 0xe7, 0xd6, 0x97, 0x17, 0x91, 0x0d, 0xce, 0x72, 0x7e, 0x9a, 0x3c, 0x84, 0xf3, 0xd3,
+	0x67, 0x0d, 0x38, 0x6b, 0x25, 0x2d, 0x2e, 0xd4, 0xaf, 0x4c, 0x15, 0xe7, 0x96, 0xd9, 0x56, 0x9c,
+	0x85, 0x87, 0xe4, 0xf3, 0x9d, 0x9d, 0x4f, 0x93, 0xc3, 0xac, 0x39, 0x30, 0x5d, 0x52, 0xdb, 0x6e,
+	0x8a, 0x3d, 0x10, 0xbf, 0xf5, 0xe9, 0x62, 0xba, 0xa4, 0xd5, 0x14, 0x26, 0xcc, 0xc0, 0x4e, 0xee,
+	0xc3, 0x84, 0x22, 0xb8, 0x55, 0x4e, 0x0d, 0x20, 0xa7, 0x27, 0x6c, 0x3c, 0xe2, 0x06, 0xae, 0x34,
+	0xa0, 0x4a, 0x29, 0xb2, 0xfa, 0x2a, 0xaa, 0x0f, 0x69, 0xf9, 0xe4, 0x4f, 0x7d, 0xba, 0xb8, 0xd5,
+	0x37, 0x1b, 0x23, 0xf6, 0xa0, 0xc6, 0xd3, 0x14, 0x32, 0xb0, 0xa2, 0x2f, 0xa8, 0x9c, 0x29, 0x9e,
+	0xf0, 0x64, 0x45, 0x47, 0x25, 0xb6, 0x66, 0xa2, 0x11, 0x93, 0x04, 0xc9, 0x35, 0x20, 0x54, 0xa8,
+	0xf7, 0xe3, 0x0b, 0xa3, 0x5f, 0x21, 0xdc, 0x21, 0x81, 0xbf, 0xd2, 0xa5, 0x14, 0x14, 0x33, 0x46,
+	0x90, 0x40, 0xd3, 0xdf, 0x0c, 0x70, 0xf3, 0x4a, 0x56, 0xfc, 0xe9, 0xa9, 0xc5, 0xf9, 0x1e, 0x23,
+	0x55, 0x58, 0x5c, 0x5c, 0xb8, 0x6e, 0x0c, 0x5e, 0x58, 0x5c, 0x92, 0xef, 0xa3, 0xbc, 0xb8, 0xf9,
+	0xe7, 0xcc, 0xf3, 0x89, 0x0b, 0x04, 0xdd, 0x76, 0xdb, 0xf2, 0x76, 0x4f, 0x40, 0x7a, 0x76, 0x22,
+	0x8b, 0xf1, 0x00, 0x65, 0xe7, 0xd4, 0x39, 0x1f, 0x60, 0x38, 0xfe, 0xa3, 0xd0, 0xba, 0x2b, 0xbb,
+	0x9f, 0x80, 0x24, 0x4d, 0x75, 0x49, 0xfa, 0x5d, 0x83, 0x3e, 0x61, 0x8e, 0x2c, 0xfd, 0xa5, 0x32,
+	0x90, 0xf4, 0x42, 0x68, 0xe7, 0x92, 0xd1, 0xf3, 0x5c, 0x7a, 0x0a, 0x26, 0xd5, 0x9c, 0x14, 0xa1,
+	0x9e, 0x3a, 0x94, 0x55, 0xd4, 0xf4, 0x15, 0xa8, 0xf5, 0x54, 0x95, 0x66, 0x43, 0x87, 0x50, 0x9a,
+	0x65, 0x96, 0x2b, 0x16, 0xd9, 0xb5, 0x0f, 0x57, 0xae, 0x78, 0x1e, 0x4e, 0x85, 0xeb, 0x17, 0xa2,
+	0x11, 0x3e, 0xbd, 0x17, 0x25, 0x9a, 0x53, 0xd7, 0x75, 0x30, 0x26, 0xfb, 0x93, 0x27, 0x35, 0x31,
+	0x4d, 0x94, 0x50, 0x8f, 0x76, 0x6e, 0x8e, 0x90, 0xf6, 0x3e, 0x38, 0xdb, 0x75, 0xb6, 0xf8, 0xe5,
+	0x73, 0x37, 0x96, 0x6f, 0xb9, 0x3f, 0xed, 0x38, 0xf7, 0x1d, 0x3d, 0xbb, 0x9e, 0x06, 0x3f, 0xd8,
+	0x9b, 0x9d, 0x8a, 0x7e, 0xf1, 0xb5, 0xcc, 0x42, 0x92, 0x16, 0x00, 0xc7, 0x4e, 0x4e, 0x00, 0xf4,
+	0x80, 0xb8, 0x1d, 0x9a, 0x38, 0xec, 0x2a, 0xe3, 0x87, 0x3e, 0x54, 0x38, 0xdf, 0xbd, 0x9d, 0xc2,
+	0x84, 0x19, 0xd8, 0xcd, 0xdf, 0x37, 0xa4, 0xd1, 0xeb, 0x04, 0xbd, 0x2e, 0x8f, 0xdb, 0x1d, 0xc6,
+	0xfc, 0x55, 0x96, 0x92, 0xaa, 0x65, 0xd5, 0xef, 0xa5, 0x6a, 0x77, 0x6c, 0xc3, 0x88, 0xd5, 0xb1,
+	0x59, 0xbd, 0x9e, 0xe3, 0x29, 0xdb, 0x11, 0xb1, 0xbf, 0xf9, 0xb5, 0x65, 0xe6, 0xd9, 0x22, 0xa9,
+	0x31, 0x0b, 0x64, 0x7d, 0xcb, 0x72, 0x1c, 0x1a, 0xf9, 0xbf, 0xf0, 0x8c, 0x1f, 0xa2, 0x09, 0x43,
+	0x98, 0x79, 0x17, 0x2a, 0xb5, 0x30, 0xe7, 0x71, 0x23, 0x51, 0x98, 0xe5, 0x1d, 0x30, 0x25, 0xac,
+	0xe5, 0xab, 0x56, 0x47, 0xe1, 0x2a, 0x51, 0x7e, 0x9c, 0xaa, 0x0a, 0x44, 0xbd, 0xaf, 0xf9, 0x35,
+	0x03, 0x2e, 0xea, 0x98, 0x5d, 0xcf, 0x7e, 0x79, 0x70, 0xc4, 0xe4, 0x13, 0x06, 0x4c, 0xc4, 0x8e,
+	0x20, 0x21, 0xaf, 0x2d, 0x14, 0x52, 0x17, 0xce, 0x8a, 0x7a, 0x8a, 0x67, 0x40, 0xba, 0xda, 0x6f,
+	0x0c, 0xf4, 0x51, 0x25, 0x6d, 0xfe, 0x07, 0x76, 0xc4, 0x24, 0xf5, 0xa9, 0x1b, 0x2c, 0x9d, 0x8b,
+	0x47, 0x59, 0x8d, 0x31, 0xa3, 0x78, 0x64, 0x4f, 0x55, 0xa0, 0x90, 0x6f, 0x4d, 0xfc, 0xc0, 0x10,
+	0x31, 0xd3, 0xd9, 0x3a, 0x4a, 0xd5, 0x36, 0xb9, 0xaf, 0x0b, 0x9d, 0x37, 0x6a, 0xf5, 0x37, 0xa1,
+	0xf9, 0x54, 0x5b, 0x50, 0xa3, 0x63, 0xae, 0x00, 0xc4, 0x5a, 0xf1, 0x81, 0xdd, 0xaf, 0xff, 0xe5,
+	0x59, 0x38, 0x3f, 0x70, 0xb0, 0xf8, 0xc7, 0x0d, 0xb8, 0x40, 0xb7, 0xed, 0x7a, 0x30, 0xbf, 0x19,
+	0x50, 0xef, 0xf6, 0xed, 0xd5, 0x3b, 0x5b, 0x1e, 0xf5, 0xb7, 0xdc, 0x56, 0xa3, 0x1f, 0x67, 0xf3,
+	0x0c, 0xcf, 0x58, 0xae, 0xbd, 0x5d, 0xca, 0xc4, 0x88, 0x39, 0x94, 0xb8, 0x45, 0x60, 0x5b, 0xe8,
+	0x4a, 0xd1, 0x0a, 0xe8, 0x42, 0xd7, 0xf3, 0x03, 0x99, 0x4f, 0x54, 0x58, 0x04, 0x92, 0x40, 0x4c,
+	0xf7, 0x4f, 0x22, 0xe1, 0x25, 0x49, 0xf9, 0x21, 0x69, 0xa4, 0x91, 0x70, 0x20, 0xa6, 0xfb, 0xab,
+	0x48, 0xc4, 0x9b, 0x62, 0x32, 0x7a, 0x39, 0x8d, 0x24, 0x02, 0x62, 0xba, 0x3f, 0x69, 0xc0, 0xc3,
+	0x1e, 0xad, 0xbb, 0xed, 0x36, 0x75, 0x1a, 0x7c, 0x51, 0x56, 0x2d, 0xaf, 0x69, 0x3b, 0xd7, 0x3c,
+	0x8b, 0x77, 0xe4, 0x87, 0xa6, 0xc1, 0x2b, 0xec, 0x3f, 0x8c, 0x3d, 0xfa, 0x61, 0x4f, 0x2c, 0xa4,
+	0x0d, 0xa7, 0xba, 0x5c, 0x1c, 0xf5, 0x78, 0xbe, 0xc1, 0x6d, 0xab, 0x55, 0x19, 0x2d, 0xf4, 0xc6,
+	0xf8, 0xbd, 0x61, 0x5d, 0x47, 0x85, 0x49, 0xdc, 0x64, 0x17, 0xce, 0x46, 0xd3, 0x51, 0x48, 0x8e,
+	0x15, 0x22, 0x29, 0x35, 0x06, 0x29, 0x74, 0x98, 0x45, 0x83, 0xa5, 0xec, 0x0e, 0x2c, 0xaf, 0x49,
+	0x83, 0xea, 0xda, 0xfa, 0x1a, 0xf5, 0xea, 0x8c, 0xc7, 0xb6, 0xc4, 0x39, 0x6b, 0x08, 0x54, 0x77,
+	0xd2, 0x60, 0xcc, 0x1a, 0x43, 0x3e, 0x02, 0xaf, 0xd1, 0x17, 0x75, 0xc5, 0xbd, 0x4f, 0xbd, 0x05,
+	0xb7, 0xeb, 0x34, 0x74, 0xe4, 0xc0, 0x91, 0x3f, 0xb1, 0xbf, 0x37, 0xfb, 0x1a, 0xec, 0x67, 0x00,
+	0xf6, 0x87, 0x37, 0x3d, 0x81, 0xf5, 0x4e, 0x27, 0x73, 0x02, 0x13, 0x79, 0x13, 0xc8, 0x19, 0x80,
+	0xfd, 0xe1, 0x65, 0xd6, 0x17, 0xb1, 0x30, 0xab, 0xb4, 0xed, 0x7a, 0xbb, 0x0a, 0xc5, 0x49, 0x4e,
+	0x91, 0x7f, 0xbf, 0x77, 0x32, 0x7b, 0x60, 0xce, 0x48, 0x76, 0xa6, 0x3c, 0x9e, 0xf7, 0xf8, 0x29,
+	0x32, 0x53, 0x9c, 0xcc, 0xeb, 0xf7, 0xf7, 0x66, 0x1f, 0xc7, 0x3e, 0xc7, 0x60, 0xdf, 0xd8, 0x33,
+	0xa6, 0x12, 0x2f, 0x44, 0x6a, 0x2a, 0xd3, 0x79, 0x53, 0xc9, 0x1f, 0x83, 0x7d, 0x63, 0x27, 0xdf,
+	0x6f, 0xc0, 0xa5, 0x7a, 0xa7, 0x7b, 0xc3, 0xf6, 0x03, 0xb7, 0xe9, 0x59, 0xed, 0x45, 0x5a, 0xb7,
+	0x76, 0x6f, 0x58, 0xad, 0x4d, 0x96, 0x44, 0xbe, 0x72, 0xaa, 0xd0, 0x87, 0xc3, 0x13, 0x23, 0x54,
+	0xd7, 0xd6, 0xb3, 0x91, 0x62, 0x3e, 0x3d, 0xf2, 0xa3, 0x06, 0x3c, 0xdc, 0xe6, 0x53, 0xcc, 0x99,
+	0xd0, 0xe9, 0x42, 0x13, 0xe2, 0x5c, 0x6c, 0xb5, 0x07, 0x5e, 0xec, 0x49, 0x95, 0x2f, 0x92, 0xe8,
+	0x30, 0xdf, 0x6c, 0x7a, 0xb4, 0xc9, 0xb1, 0x46, 0xdc, 0xe5, 0x4c, 0xf1, 0x45, 0x5a, 0xcd, 0x43,
+	0x8a, 0xf9, 0xf4, 0xc8, 0x8b, 0x70, 0x39, 0x17, 0x58, 0x65, 0x4e, 0x9a, 0xdc, 0x4e, 0x3d, 0xb4,
+	0x60, 0xee, 0xef, 0xcd, 0x5e, 0x5e, 0xed, 0xd9, 0x13, 0x0f, 0xc0, 0x44, 0x7e, 0x2c, 0x99, 0xa6,
+	0xe5, 0x2c, 0x97, 0xc4, 0xde, 0x5f, 0xa8, 0xb6, 0xf9, 0x11, 0xe6, 0x66, 0xf9, 0x8a, 0x9e, 0x9b,
+	0xe5, 0x1c, 0x9f, 0xd5, 0x7b, 0x8f, 0x6e, 0x56, 0xff, 0x90, 0x90, 0xa5, 0x9f, 0x40, 0xbd, 0xcf,
+	0x1a, 0x20, 0xe3, 0xeb, 0x99, 0x53, 0xa3, 0xe2, 0x99, 0x39, 0x96, 0xf0, 0xca, 0x0c, 0x4b, 0x8c,
+	0x97, 0x32, 0x4b, 0x8c, 0xbf, 0x56, 0x49, 0xce, 0x3e, 0x1e, 0xdf, 0xf2, 0x04, 0xe6, 0x38, 0x3b,
+	0x3b, 0x2b, 0x90, 0x15, 0xe9, 0xf8, 0xa4, 0xed, 0x85, 0x17, 0xc8, 0x8a, 0x95, 0x81, 0x31, 0x9c,
+	0x25, 0xeb, 0x87, 0xb8, 0x72, 0x3e, 0x79, 0x14, 0xca, 0x75, 0xe6, 0x97, 0x21, 0x27, 0x18, 0x29,
+	0x5d, 0xb8, 0xb3, 0x06, 0x0a, 0xd8, 0xc1, 0xc1, 0x62, 0x2c, 0x26, 0xac, 0xcb, 0x6b, 0x05, 0xcb,
+	0x00, 0x2f, 0xee, 0x25, 0xb8, 0xce, 0x5b, 0x50, 0x42, 0xc8, 0x3a, 0x8c, 0xb6, 0x6d, 0x87, 0xc7,
+	0xe2, 0x0d, 0x17, 0x8a, 0xc5, 0xe3, 0xf7, 0x81, 0x55, 0x81, 0x02, 0x43, 0x5c, 0xe6, 0xaf, 0x18,
+	0x70, 0x4a, 0xcf, 0x96, 0xef, 0xb3, 0x0b, 0xa0, 0x2c, 0x2d, 0x24, 0x6b, 0x83, 0xf0, 0xa1, 0x32,
+	0x2b, 0x2d, 0x86, 0x30, 0xdd, 0x81, 0x67, 0x00, 0x63, 0x68, 0x76, 0xd2, 0xfe, 0x03, 0xec, 0x92,
+	0x9f, 0x30, 0xe0, 0xe2, 0x5d, 0xba, 0xb1, 0xe5, 0xba, 0xe9, 0x8b, 0x73, 0x5b, 0x04, 0x6f, 0x1c,
+	0xcf, 0xad, 0x39, 0x1d, 0x0c, 0xf2, 0xe3, 0x67, 0x61, 0x44, 0x44, 0xec, 0xb0, 0x2b, 0x45, 0x46,
+	0x62, 0xc0, 0x9b, 0xc5, 0x2b, 0xf1, 0x14, 0x49, 0x9e, 0xa6, 0x56, 0x53, 0x2e, 0xf5, 0xac, 0xa6,
+	0x8c, 0x30, 0x54, 0xf7, 0xec, 0x41, 0xfc, 0x46, 0xab, 0xb8, 0x2c, 0xfc, 0x46, 0xab, 0xb8, 0x8c,
+	0x0c, 0x19, 0x53, 0x8e, 0x2b, 0x0e, 0x95, 0xc3, 0xc5, 0x95, 0xe3, 0x62, 0x01, 0x14, 0xb7, 0xca,
+	0xe9, 0x9e, 0x2e, 0x95, 0x61, 0x0d, 0x92, 0x72, 0xf1, 0x38, 0x52, 0xb9, 0xe4, 0xfd, 0xd4, 0x20,
+	0x09, 0xbf, 0xe9, 0x91, 0xdc, 0x6f, 0x7a, 0x13, 0x46, 0xe5, 0x57, 0x59, 0x19, 0x2d, 0x7e, 0x99,
+	0x97, 0x7e, 0xea, 0x4a, 0x7d, 0x3f, 0xd1, 0x80, 0x21, 0x72, 0x76, 0xe1, 0x6d, 0x5b, 0x3b, 0x2c,
+	0xa6, 0x96, 0x5f, 0x48, 0xca, 0x6a, 0x57, 0xde, 0x8c, 0x21, 0x9c, 0x77, 0x15, 0xe1, 0xb7, 0x95,
+	0xf1, 0x44, 0x57, 0xd1, 0x8c, 0x21, 0x9c, 0xbc, 0x0f, 0xc6, 0xda, 0xd6, 0x4e, 0xad, 0xeb, 0x35,
+	0x69, 0x05, 0x0e, 0x50, 0xac, 0x75, 0x03, 0xbb, 0x35, 0xc7, 0x6c, 0xe6, 0x81, 0x37, 0xb7, 0xec,
+	0x04, 0xb7, 0xbd, 0x5a, 0xc0, 0xdd, 0x35, 0xf9, 0xae, 0x5b, 0x95, 0x58, 0x30, 0xc2, 0x47, 0x5a,
+	0x30, 0xdd, 0xb6, 0x76, 0xd6, 0x1d, 0x4b, 0x94, 0x97, 0x91, 0x02, 0x7f, 0x11, 0x0a, 0xdc, 0x5e,
+	0xb1, 0xaa, 0xe1, 0xc2, 0x04, 0xee, 0x0c, 0xd7, 0xfd, 0xc9, 0xe3, 0x72, 0xdd, 0x9f, 0x8f, 0x12,
+	0xda, 0x08, 0x63, 0xe7, 0xa5, 0xcc, 0xdc, 0xa9, 0x3d, 0x93, 0xd5, 0xbc, 0x10, 0x25, 0xab, 0x99,
+	0x2e, 0xee, 0x6b, 0xde, 0x23, 0x51, 0x4d, 0x17, 0x26, 0x1a, 0x56, 0x60, 0x89, 0x56, 0x66, 0x8d,
+	0x2c, 0xec, 0xb7, 0xb3, 0x18, 0xa1, 0x89, 0x59, 0x52, 0xdc, 0xe6, 0xa3, 0x4a, 0x87, 0x05, 0x34,
+	0xb3, 0x8f, 0xb5, 0x45, 0x83, 0xb8, 0x0b, 0x57, 0xdf, 0x9d, 0x16, 0x9a, 0x7c, 0x16, 0xd0, 0x7c,
+	0x33, 0xab, 0x03, 0x66, 0x8f, 0x8b, 0xf3, 0x7c, 0x9f, 0xc9, 0xce, 0xf3, 0x4d, 0x7e, 0x20, 0xcb,
+	0x49, 0x92, 0x5c, 0x31, 0x8a, 0x1e, 0x52, 0x82, 0x37, 0x14, 0x76, 0x95, 0xfc, 0x55, 0x03, 0x2a,
+	0x72, 0x97, 0x49, 0xc7, 0xc6, 0x16, 0xf5, 0x56, 0x2d, 0xc7, 0x6a, 0x52, 0xaf, 0x72, 0xb6, 0x78,
+	0xfe, 0xb1, 0xd5, 0x1c, 0x9c, 0x51, 0x16, 0xa1, 0xc7, 0xf6, 0xf7, 0x66, 0xaf, 0x1c, 0xd4, 0x0b,
+	0x73, 0xe7, 0x46, 0x3c, 0x18, 0xf5, 0x77, 0xfd, 0x7a, 0xd0, 0xf2, 0xa5, 0x38, 0x7c, 0x7d, 0x00,
+	0xce, 0x5a, 0x13, 0x98, 0x04, 0x6b, 0x8d, 0xab, 0xca, 0x8a, 0x56, 0x0c, 0x09, 0x31, 0x97, 0xc1,
+	0x33, 0xd2, 0xad, 0x40, 0xc9, 0xd2, 0x76, 0xbe, 0x78, 0x48, 0x65, 0x35, 0x89, 0x2c, 0x74, 0x66,
+	0xe4, 0x8a, 0xad, 0x14, 0x14, 0xd3, 0xd4, 0xd9, 0xa1, 0xda, 0xf1, 0x6c, 0xd7, 0x63, 0xee, 0x10,
+	0x17, 0x38, 0xf3, 0x94, 0x45, 0x36, 0x44, 0x1b, 0x46, 0x50, 0x52, 0x83, 0x69, 0xa1, 0x40, 0xaa,
+	0x05, 0x9e, 0x15, 0xd0, 0xe6, 0xae, 0xf4, 0xed, 0x7c, 0x1d, 0xaf, 0x1d, 0xaf, 0x41, 0x1e, 0xec,
+	0xcd, 0x9e, 0x97, 0xef, 0x46, 0x07, 0x60, 0x02, 0x05, 0xf9, 0x50, 0xc2, 0xd5, 0xb6, 0x52, 0xdc,
+	0x10, 0x2a, 0xde, 0xc5, 0x61, 0x1c, 0x6e, 0x07, 0x4d, 0x3c, 0x3a, 0x40, 0x75, 0xac, 0x99, 0xa7,
+	0x61, 0x52, 0xdd, 0x35, 0x87, 0x19, 0x6b, 0x06, 0x40, 0xd2, 0x0f, 0x7b, 0xdc, 0x29, 0xe1, 0xcc,
+	0x9f, 0x31, 0xe0, 0x74, 0x52, 0x76, 0x21, 0x5b, 0x30, 0x2a, 0x19, 0x59, 0xc5, 0x28, 0x6e, 0xa4,
+	0x93, 0x2c, 0x52, 0x26, 0x63, 0xe7, 0x52, 0xb9, 0x6c, 0xc2, 0x10, 0xbd, 0x1a, 0x3f, 0x56, 0xea,
+	0x11, 0x3f, 0xf6, 0x0c, 0x5c, 0xc8, 0x66, 0x69, 0xec, 0x4e, 0xc3, 0x52, 0xe7, 0xdc, 0x97, 0xfa,
+	0xf3, 0xe8, 0x4e, 0xc3, 0xef, 0x7f, 0x28, 0x60, 0xe6, 0x87, 0x21, 0x59, 0x15, 0x92, 0xbc, 0x08,
+	0xe3, 0xbe, 0xbf, 0x25, 0xbc, 0x82, 0x2b, 0xc6, 0x00, 0xe6, 0xb2, 0xb0, 0x54, 0x96, 0xb8, 0x86,
+	0x45, 0x3f, 0x31, 0x46, 0xbf, 0xf0, 0xfc, 0x97, 0xbf, 0x76, 0xf9, 0x55, 0xbf, 0xf7, 0xb5, 0xcb,
+	0xaf, 0xfa, 0xea, 0xd7, 0x2e, 0xbf, 0xea, 0xa3, 0xfb, 0x97, 0x8d, 0x2f, 0xef, 0x5f, 0x36, 0x7e,
+	0x6f, 0xff, 0xb2, 0xf1, 0xd5, 0xfd, 0xcb, 0xc6, 0x9f, 0xef, 0x5f, 0x36, 0x7e, 0xe8, 0x5f, 0x5f,
+	0x7e, 0xd5, 0xfb, 0x9e, 0x8c, 0xa9, 0x5f, 0x0d, 0x89, 0xc6, 0xff, 0x30, 0xd7, 0x27, 0x46, 0x3d,
+	0xbc, 0x40, 0x73, 0xea, 0xff, 0x63, 0x00, 0xfe, 0x6e, 0xa1, 0x5d, 0xa5, 0x32, 0x01, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -7129,6 +7559,32 @@ func (m *Alerting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rules != nil {
+		{
+			size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.EmailReceivers) > 0 {
 		for iNdEx := len(m.EmailReceivers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EmailReceivers[iNdEx])
@@ -7141,6 +7597,126 @@ func (m *Alerting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlertingReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertingReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertingReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PagerDuty != nil {
+		{
+			size, err := m.PagerDuty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Slack != nil {
+		{
+			size, err := m.Slack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SendResolved != nil {
+		i--
+		if *m.SendResolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Components[iNdEx])
+			copy(dAtA[i:], m.Components[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Components[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Severities) > 0 {
+		for iNdEx := len(m.Severities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Severities[iNdEx])
+			copy(dAtA[i:], m.Severities[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Severities[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AlertingRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertingRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertingRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ConfigMapName)
+	copy(dAtA[i:], m.ConfigMapName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMapName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AuditConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9684,6 +10260,115 @@ func (m *CoreDNSRewriting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CustomHealthCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomHealthCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomHealthCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		i -= len(*m.Message)
+		copy(dAtA[i:], *m.Message)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomHealthCheckResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomHealthCheckResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomHealthCheckResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LabelSelector != nil {
+		{
+			size, err := m.LabelSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Name != nil {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIVersion)
+	copy(dAtA[i:], m.APIVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DNS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13268,6 +13953,20 @@ func (m *Monitoring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemoteWrites) > 0 {
+		for iNdEx := len(m.RemoteWrites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteWrites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Alerting != nil {
 		{
 			size, err := m.Alerting.MarshalToSizedBuffer(dAtA[:i])
@@ -13283,6 +13982,115 @@ func (m *Monitoring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MonitoringRemoteWrite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonitoringRemoteWrite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonitoringRemoteWrite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keep) > 0 {
+		for iNdEx := len(m.Keep) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keep[iNdEx])
+			copy(dAtA[i:], m.Keep[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Keep[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BearerToken != nil {
+		{
+			size, err := m.BearerToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BasicAuth != nil {
+		{
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MonitoringRemoteWriteBasicAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonitoringRemoteWriteBasicAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonitoringRemoteWriteBasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Username.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NamedResourceReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14121,6 +14929,46 @@ func (m *OpenIDConnectClientAuthentication) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *PagerDutyAlertingReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PagerDutyAlertingReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PagerDutyAlertingReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.URL != nil {
+		i -= len(*m.URL)
+		copy(dAtA[i:], *m.URL)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.RoutingKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PendingWorkerUpdates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14933,6 +15781,39 @@ func (m *ResourceData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceSecretKeyReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceSecretKeyReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceSecretKeyReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ResourceName)
+	copy(dAtA[i:], m.ResourceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceWatchCacheSize) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17265,6 +18146,22 @@ func (m *ShootSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CustomHealthChecks) > 0 {
+		for iNdEx := len(m.CustomHealthChecks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomHealthChecks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
 	if len(m.AccessRestrictions) > 0 {
 		for iNdEx := len(m.AccessRestrictions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -17930,6 +18827,189 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShootSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ShootSummaryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootSummaryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootSummaryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ShootSummaryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootSummaryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootSummaryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationStartTime != nil {
+		{
+			size, err := m.OperationStartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastOperation != nil {
+		{
+			size, err := m.LastOperation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UnhealthyConditions) > 0 {
+		for iNdEx := len(m.UnhealthyConditions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnhealthyConditions[iNdEx])
+			copy(dAtA[i:], m.UnhealthyConditions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.UnhealthyConditions[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i--
+	if m.Hibernated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.GardenerVersion)
+	copy(dAtA[i:], m.GardenerVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GardenerVersion)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.KubernetesVersion)
+	copy(dAtA[i:], m.KubernetesVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KubernetesVersion)))
+	i--
+	dAtA[i] = 0x22
+	if m.Purpose != nil {
+		i -= len(*m.Purpose)
+		copy(dAtA[i:], *m.Purpose)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Purpose)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.ProviderType)
+	copy(dAtA[i:], m.ProviderType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProviderType)))
+	i--
+	dAtA[i] = 0x12
+	if m.SeedName != nil {
+		i -= len(*m.SeedName)
+		copy(dAtA[i:], *m.SeedName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SeedName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ShootTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17973,6 +19053,46 @@ func (m *ShootTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlackAlertingReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlackAlertingReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlackAlertingReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Channel != nil {
+		i -= len(*m.Channel)
+		copy(dAtA[i:], *m.Channel)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.APIURL.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *StructuredAuthentication) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18507,6 +19627,39 @@ func (m *WatchCacheSizes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WebhookAlertingReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookAlertingReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookAlertingReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.URL.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Worker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -19099,6 +20252,65 @@ func (m *Alerting) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Receivers) > 0 {
+		for _, e := range m.Receivers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Rules != nil {
+		l = m.Rules.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AlertingReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Severities) > 0 {
+		for _, s := range m.Severities {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Components) > 0 {
+		for _, s := range m.Components {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.SendResolved != nil {
+		n += 2
+	}
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Slack != nil {
+		l = m.Slack.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PagerDuty != nil {
+		l = m.PagerDuty.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *AlertingRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigMapName)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20059,6 +21271,50 @@ func (m *CoreDNSRewriting) Size() (n int) {
 	return n
 }
 
+func (m *CustomHealthCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Resource.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CustomHealthCheckResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LabelSelector != nil {
+		l = m.LabelSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *DNS) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21400,6 +22656,52 @@ func (m *Monitoring) Size() (n int) {
 		l = m.Alerting.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.RemoteWrites) > 0 {
+		for _, e := range m.RemoteWrites {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MonitoringRemoteWrite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BearerToken != nil {
+		l = m.BearerToken.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Keep) > 0 {
+		for _, s := range m.Keep {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MonitoringRemoteWriteBasicAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Username.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Password.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -21743,6 +23045,21 @@ func (m *OpenIDConnectClientAuthentication) Size() (n int) {
 	return n
 }
 
+func (m *PagerDutyAlertingReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RoutingKey.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.URL != nil {
+		l = len(*m.URL)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *PendingWorkerUpdates) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22046,6 +23363,19 @@ func (m *ResourceData) Size() (n int) {
 	return n
 }
 
+func (m *ResourceSecretKeyReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ResourceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ResourceWatchCacheSize) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22995,6 +24325,12 @@ func (m *ShootSpec) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.CustomHealthChecks) > 0 {
+		for _, e := range m.CustomHealthChecks {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -23142,6 +24478,74 @@ func (m *ShootStatus) Size() (n int) {
 	return n
 }
 
+func (m *ShootSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ShootSummaryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShootSummaryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeedName != nil {
+		l = len(*m.SeedName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ProviderType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Purpose != nil {
+		l = len(*m.Purpose)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.KubernetesVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GardenerVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.UnhealthyConditions) > 0 {
+		for _, s := range m.UnhealthyConditions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LastOperation != nil {
+		l = m.LastOperation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OperationStartTime != nil {
+		l = m.OperationStartTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ShootTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23155,6 +24559,21 @@ func (m *ShootTemplate) Size() (n int) {
 	return n
 }
 
+func (m *SlackAlertingReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.APIURL.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Channel != nil {
+		l = len(*m.Channel)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *StructuredAuthentication) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23359,6 +24778,17 @@ func (m *WatchCacheSizes) Size() (n int) {
 	return n
 }
 
+func (m *WebhookAlertingReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.URL.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Worker) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23621,8 +25051,41 @@ func (this *Alerting) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForReceivers := "[]AlertingReceiver{"
+	for _, f := range this.Receivers {
+		repeatedStringForReceivers += strings.Replace(strings.Replace(f.String(), "AlertingReceiver", "AlertingReceiver", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReceivers += "}"
 	s := strings.Join([]string{`&Alerting{`,
 		`EmailReceivers:` + fmt.Sprintf("%v", this.EmailReceivers) + `,`,
+		`Receivers:` + repeatedStringForReceivers + `,`,
+		`Rules:` + strings.Replace(this.Rules.String(), "AlertingRules", "AlertingRules", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingReceiver) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingReceiver{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Severities:` + fmt.Sprintf("%v", this.Severities) + `,`,
+		`Components:` + fmt.Sprintf("%v", this.Components) + `,`,
+		`SendResolved:` + valueToStringGenerated(this.SendResolved) + `,`,
+		`Webhook:` + strings.Replace(this.Webhook.String(), "WebhookAlertingReceiver", "WebhookAlertingReceiver", 1) + `,`,
+		`Slack:` + strings.Replace(this.Slack.String(), "SlackAlertingReceiver", "SlackAlertingReceiver", 1) + `,`,
+		`PagerDuty:` + strings.Replace(this.PagerDuty.String(), "PagerDutyAlertingReceiver", "PagerDutyAlertingReceiver", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlertingRules) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AlertingRules{`,
+		`ConfigMapName:` + fmt.Sprintf("%v", this.ConfigMapName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24288,6 +25751,33 @@ func (this *CoreDNSRewriting) String() string {
 	}, "")
 	return s
 }
+func (this *CustomHealthCheck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomHealthCheck{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Resource:` + strings.Replace(strings.Replace(this.Resource.String(), "CustomHealthCheckResource", "CustomHealthCheckResource", 1), `&`, ``, 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Message:` + valueToStringGenerated(this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomHealthCheckResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomHealthCheckResource{`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + valueToStringGenerated(this.Namespace) + `,`,
+		`Name:` + valueToStringGenerated(this.Name) + `,`,
+		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DNS) String() string {
 	if this == nil {
 		return "nil"
@@ -25152,8 +26642,39 @@ func (this *Monitoring) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRemoteWrites := "[]MonitoringRemoteWrite{"
+	for _, f := range this.RemoteWrites {
+		repeatedStringForRemoteWrites += strings.Replace(strings.Replace(f.String(), "MonitoringRemoteWrite", "MonitoringRemoteWrite", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRemoteWrites += "}"
 	s := strings.Join([]string{`&Monitoring{`,
 		`Alerting:` + strings.Replace(this.Alerting.String(), "Alerting", "Alerting", 1) + `,`,
+		`RemoteWrites:` + repeatedStringForRemoteWrites + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MonitoringRemoteWrite) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MonitoringRemoteWrite{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`BasicAuth:` + strings.Replace(this.BasicAuth.String(), "MonitoringRemoteWriteBasicAuth", "MonitoringRemoteWriteBasicAuth", 1) + `,`,
+		`BearerToken:` + strings.Replace(this.BearerToken.String(), "ResourceSecretKeyReference", "ResourceSecretKeyReference", 1) + `,`,
+		`Keep:` + fmt.Sprintf("%v", this.Keep) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MonitoringRemoteWriteBasicAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MonitoringRemoteWriteBasicAuth{`,
+		`Username:` + strings.Replace(strings.Replace(this.Username.String(), "ResourceSecretKeyReference", "ResourceSecretKeyReference", 1), `&`, ``, 1) + `,`,
+		`Password:` + strings.Replace(strings.Replace(this.Password.String(), "ResourceSecretKeyReference", "ResourceSecretKeyReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25379,6 +26900,17 @@ func (this *OpenIDConnectClientAuthentication) String() string {
 	}, "")
 	return s
 }
+func (this *PagerDutyAlertingReceiver) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PagerDutyAlertingReceiver{`,
+		`RoutingKey:` + strings.Replace(strings.Replace(this.RoutingKey.String(), "ResourceSecretKeyReference", "ResourceSecretKeyReference", 1), `&`, ``, 1) + `,`,
+		`URL:` + valueToStringGenerated(this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PendingWorkerUpdates) String() string {
 	if this == nil {
 		return "nil"
@@ -25615,6 +27147,17 @@ func (this *ResourceData) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceSecretKeyReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceSecretKeyReference{`,
+		`ResourceName:` + fmt.Sprintf("%v", this.ResourceName) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceWatchCacheSize) String() string {
 	if this == nil {
 		return "nil"
@@ -26283,6 +27826,11 @@ func (this *ShootSpec) String() string {
 		repeatedStringForAccessRestrictions += strings.Replace(strings.Replace(f.String(), "AccessRestrictionWithOptions", "AccessRestrictionWithOptions", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForAccessRestrictions += "}"
+	repeatedStringForCustomHealthChecks := "[]CustomHealthCheck{"
+	for _, f := range this.CustomHealthChecks {
+		repeatedStringForCustomHealthChecks += strings.Replace(strings.Replace(f.String(), "CustomHealthCheck", "CustomHealthCheck", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCustomHealthChecks += "}"
 	s := strings.Join([]string{`&ShootSpec{`,
 		`Addons:` + strings.Replace(this.Addons.String(), "Addons", "Addons", 1) + `,`,
 		`CloudProfileName:` + valueToStringGenerated(this.CloudProfileName) + `,`,
@@ -26308,6 +27856,7 @@ func (this *ShootSpec) String() string {
 		`CloudProfile:` + strings.Replace(this.CloudProfile.String(), "CloudProfileReference", "CloudProfileReference", 1) + `,`,
 		`CredentialsBindingName:` + valueToStringGenerated(this.CredentialsBindingName) + `,`,
 		`AccessRestrictions:` + repeatedStringForAccessRestrictions + `,`,
+		`CustomHealthChecks:` + repeatedStringForCustomHealthChecks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26415,6 +27964,51 @@ func (this *ShootStatus) String() string {
 	}, "")
 	return s
 }
+func (this *ShootSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootSummary{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ShootSummaryStatus", "ShootSummaryStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootSummaryList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ShootSummary{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ShootSummary", "ShootSummary", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ShootSummaryList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShootSummaryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootSummaryStatus{`,
+		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
+		`ProviderType:` + fmt.Sprintf("%v", this.ProviderType) + `,`,
+		`Purpose:` + valueToStringGenerated(this.Purpose) + `,`,
+		`KubernetesVersion:` + fmt.Sprintf("%v", this.KubernetesVersion) + `,`,
+		`GardenerVersion:` + fmt.Sprintf("%v", this.GardenerVersion) + `,`,
+		`Hibernated:` + fmt.Sprintf("%v", this.Hibernated) + `,`,
+		`UnhealthyConditions:` + fmt.Sprintf("%v", this.UnhealthyConditions) + `,`,
+		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
+		`OperationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.OperationStartTime), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ShootTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -26426,6 +28020,17 @@ func (this *ShootTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *SlackAlertingReceiver) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SlackAlertingReceiver{`,
+		`APIURL:` + strings.Replace(strings.Replace(this.APIURL.String(), "ResourceSecretKeyReference", "ResourceSecretKeyReference", 1), `&`, ``, 1) + `,`,
+		`Channel:` + valueToStringGenerated(this.Channel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StructuredAuthentication) String() string {
 	if this == nil {
 		return "nil"
@@ -26565,6 +28170,16 @@ func (this *WatchCacheSizes) String() string {
 	}, "")
 	return s
 }
+func (this *WebhookAlertingReceiver) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookAlertingReceiver{`,
+		`URL:` + strings.Replace(strings.Replace(this.URL.String(), "ResourceSecretKeyReference", "ResourceSecretKeyReference", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Worker) String() string {
 	if this == nil {
 		return "nil"
@@ -27584,59 +29199,43 @@ func (m *Alerting) Unmarshal(dAtA []byte) error {
 			}
 			m.EmailReceivers = append(m.EmailReceivers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AuditConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Receivers = append(m.Receivers, AlertingReceiver{})
+			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuditConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuditConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuditPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27663,10 +29262,10 @@ func (m *AuditConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuditPolicy == nil {
-				m.AuditPolicy = &AuditPolicy{}
+			if m.Rules == nil {
+				m.Rules = &AlertingRules{}
 			}
-			if err := m.AuditPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27691,7 +29290,7 @@ func (m *AuditConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
+func (m *AlertingReceiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27714,17 +29313,17 @@ func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuditPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlertingReceiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuditPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlertingReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27734,81 +29333,59 @@ func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapRef == nil {
-				m.ConfigMapRef = &v1.ObjectReference{}
-			}
-			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severities", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizerKubeconfigReference: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizerKubeconfigReference: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Severities = append(m.Severities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27836,13 +29413,13 @@ func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizerName = string(dAtA[iNdEx:postIndex])
+			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendResolved", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27852,79 +29429,18 @@ func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SecretName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			b := bool(v != 0)
+			m.SendResolved = &b
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27934,29 +29450,33 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Webhook == nil {
+				m.Webhook = &WebhookAlertingReceiver{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27966,29 +29486,33 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
+			if m.Slack == nil {
+				m.Slack = &SlackAlertingReceiver{}
+			}
+			if err := m.Slack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PagerDuty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27998,23 +29522,27 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
+			if m.PagerDuty == nil {
+				m.PagerDuty = &PagerDutyAlertingReceiver{}
+			}
+			if err := m.PagerDuty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28037,7 +29565,7 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backup) Unmarshal(dAtA []byte) error {
+func (m *AlertingRules) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28060,15 +29588,15 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlertingRules: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlertingRules: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28096,80 +29624,61 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Region = &s
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28196,10 +29705,10 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialsRef == nil {
-				m.CredentialsRef = &v1.ObjectReference{}
+			if m.AuditPolicy == nil {
+				m.AuditPolicy = &AuditPolicy{}
 			}
-			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuditPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28224,7 +29733,7 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucket) Unmarshal(dAtA []byte) error {
+func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28247,48 +29756,15 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28315,40 +29791,10 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.ConfigMapRef == nil {
+				m.ConfigMapRef = &v1.ObjectReference{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28373,7 +29819,7 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
+func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28396,17 +29842,17 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizerKubeconfigReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizerKubeconfigReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28416,30 +29862,29 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthorizerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28449,25 +29894,23 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, BackupBucket{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28490,7 +29933,7 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
+func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28513,15 +29956,15 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28549,11 +29992,11 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28581,7 +30024,39 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28604,7 +30079,7 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
+func (m *Backup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28627,17 +30102,17 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28647,24 +30122,23 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -28702,9 +30176,9 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28733,7 +30207,7 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.SeedName = &s
+			m.Region = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -28792,7 +30266,7 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
+func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28815,15 +30289,15 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28850,16 +30324,13 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderStatus == nil {
-				m.ProviderStatus = &runtime.RawExtension{}
-			}
-			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28886,71 +30357,13 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastOperation == nil {
-				m.LastOperation = &LastOperation{}
-			}
-			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastError == nil {
-				m.LastError = &LastError{}
-			}
-			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedSecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28977,10 +30390,7 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GeneratedSecretRef == nil {
-				m.GeneratedSecretRef = &v1.SecretReference{}
-			}
-			if err := m.GeneratedSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29005,7 +30415,7 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntry) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29028,15 +30438,15 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29063,46 +30473,13 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29129,7 +30506,8 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, BackupBucket{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29154,7 +30532,7 @@ func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29177,17 +30555,17 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29197,30 +30575,29 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29230,25 +30607,23 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, BackupEntry{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29271,7 +30646,7 @@ func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29294,17 +30669,17 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntrySpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntrySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29314,25 +30689,62 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
 			}
@@ -29365,6 +30777,42 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.SeedName = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialsRef == nil {
+				m.CredentialsRef = &v1.ObjectReference{}
+			}
+			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29386,7 +30834,7 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29409,15 +30857,15 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupEntryStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupEntryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29444,16 +30892,16 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastOperation == nil {
-				m.LastOperation = &LastOperation{}
+			if m.ProviderStatus == nil {
+				m.ProviderStatus = &runtime.RawExtension{}
 			}
-			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29480,18 +30928,18 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastError == nil {
-				m.LastError = &LastError{}
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
 			}
-			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			m.ObservedGeneration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29501,16 +30949,33 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastError == nil {
+				m.LastError = &LastError{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			var stringLen uint64
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29520,28 +30985,14 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SeedName = &s
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedSecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29568,10 +31019,10 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
+			if m.GeneratedSecretRef == nil {
+				m.GeneratedSecretRef = &v1.SecretReference{}
 			}
-			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeneratedSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29596,7 +31047,7 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bastion) Unmarshal(dAtA []byte) error {
+func (m *BackupEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29619,15 +31070,15 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bastion: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bastion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29654,16 +31105,13 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineImage == nil {
-				m.MachineImage = &BastionMachineImage{}
-			}
-			if err := m.MachineImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29690,10 +31138,40 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MachineType == nil {
-				m.MachineType = &BastionMachineType{}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.MachineType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29718,7 +31196,7 @@ func (m *Bastion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
+func (m *BackupEntryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29741,17 +31219,17 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BastionMachineImage: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BastionMachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29761,29 +31239,30 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29793,24 +31272,25 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
+			m.Items = append(m.Items, BackupEntry{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29833,7 +31313,7 @@ func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
+func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29856,15 +31336,15 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BastionMachineType: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntrySpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BastionMachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntrySpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29892,7 +31372,40 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29915,7 +31428,7 @@ func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CARotation) Unmarshal(dAtA []byte) error {
+func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29938,17 +31451,17 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CARotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEntryStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CARotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEntryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29958,27 +31471,31 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
+			}
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30005,18 +31522,37 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+			if m.LastError == nil {
+				m.LastError = &LastError{}
 			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30026,31 +31562,537 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationStartTime == nil {
+				m.MigrationStartTime = &v11.Time{}
+			}
+			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bastion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bastion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bastion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MachineImage == nil {
+				m.MachineImage = &BastionMachineImage{}
+			}
+			if err := m.MachineImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MachineType == nil {
+				m.MachineType = &BastionMachineType{}
+			}
+			if err := m.MachineType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionMachineImage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionMachineImage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionMachineImage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Version = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BastionMachineType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BastionMachineType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BastionMachineType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CARotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CARotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CARotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
+			}
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34941,7 +36983,7 @@ func (m *CoreDNSRewriting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DNS) Unmarshal(dAtA []byte) error {
+func (m *CustomHealthCheck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34964,15 +37006,15 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DNS: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomHealthCheck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomHealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35000,12 +37042,11 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Domain = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35032,64 +37073,13 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, DNSProvider{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DNSIncludeExclude: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNSIncludeExclude: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35117,11 +37107,11 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35149,7 +37139,8 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Message = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35172,7 +37163,7 @@ func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DNSProvider) Unmarshal(dAtA []byte) error {
+func (m *CustomHealthCheckResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35195,17 +37186,17 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DNSProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomHealthCheckResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomHealthCheckResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35215,33 +37206,29 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Domains == nil {
-				m.Domains = &DNSIncludeExclude{}
-			}
-			if err := m.Domains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35251,16 +37238,27 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Primary = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35289,11 +37287,11 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.SecretName = &s
+			m.Namespace = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35322,11 +37320,11 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Type = &s
+			m.Name = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35353,10 +37351,10 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Zones == nil {
-				m.Zones = &DNSIncludeExclude{}
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v11.LabelSelector{}
 			}
-			if err := m.Zones.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35381,7 +37379,7 @@ func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataVolume) Unmarshal(dAtA []byte) error {
+func (m *DNS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35404,15 +37402,15 @@ func (m *DataVolume) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataVolume: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35440,13 +37438,14 @@ func (m *DataVolume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Domain = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35456,78 +37455,26 @@ func (m *DataVolume) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Type = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Providers = append(m.Providers, DNSProvider{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.VolumeSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Encrypted = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35549,7 +37496,7 @@ func (m *DataVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
+func (m *DNSIncludeExclude) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35572,15 +37519,15 @@ func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeploymentRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNSIncludeExclude: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeploymentRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNSIncludeExclude: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35608,61 +37555,11 @@ func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DualApprovalForDeletion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DualApprovalForDeletion: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DualApprovalForDeletion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35690,62 +37587,8 @@ func (m *DualApprovalForDeletion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeServiceAccounts", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.IncludeServiceAccounts = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35767,7 +37610,7 @@ func (m *DualApprovalForDeletion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ETCD) Unmarshal(dAtA []byte) error {
+func (m *DNSProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35790,15 +37633,15 @@ func (m *ETCD) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ETCD: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNSProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ETCD: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Main", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35825,18 +37668,39 @@ func (m *ETCD) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Main == nil {
-				m.Main = &ETCDConfig{}
+			if m.Domains == nil {
+				m.Domains = &DNSIncludeExclude{}
 			}
-			if err := m.Main.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Domains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Primary = &b
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35846,81 +37710,61 @@ func (m *ETCD) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &ETCDConfig{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SecretName = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ETCDConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ETCDConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ETCDConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			s := string(dAtA[iNdEx:postIndex])
+			m.Type = &s
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35947,10 +37791,10 @@ func (m *ETCDConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Autoscaling == nil {
-				m.Autoscaling = &ControlPlaneAutoscaling{}
+			if m.Zones == nil {
+				m.Zones = &DNSIncludeExclude{}
 			}
-			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Zones.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35975,7 +37819,7 @@ func (m *ETCDConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
+func (m *DataVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35998,15 +37842,15 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ETCDEncryptionKeyRotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ETCDEncryptionKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36034,13 +37878,13 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36050,33 +37894,30 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Type = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36086,69 +37927,29 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VolumeSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
-			}
-			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36158,28 +37959,13 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Encrypted = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36201,7 +37987,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
+func (m *DeploymentRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36224,15 +38010,15 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptionConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeploymentRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeploymentRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36260,7 +38046,7 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36283,7 +38069,7 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
+func (m *DualApprovalForDeletion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36306,15 +38092,15 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: DualApprovalForDeletion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DualApprovalForDeletion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36342,11 +38128,11 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36373,18 +38159,15 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = &v11.Time{}
-			}
-			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeServiceAccounts", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36394,25 +38177,13 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := VersionClassification(dAtA[iNdEx:postIndex])
-			m.Classification = &s
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.IncludeServiceAccounts = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36434,7 +38205,7 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClass) Unmarshal(dAtA []byte) error {
+func (m *ETCD) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36457,15 +38228,15 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: ETCD: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ETCD: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Main", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36492,45 +38263,16 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Main == nil {
+				m.Main = &ETCDConfig{}
+			}
+			if err := m.Main.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Handler = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36557,10 +38299,10 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scheduling == nil {
-				m.Scheduling = &ExposureClassScheduling{}
+			if m.Events == nil {
+				m.Events = &ETCDConfig{}
 			}
-			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -36585,7 +38327,7 @@ func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
+func (m *ETCDConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36608,15 +38350,15 @@ func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClassList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ETCDConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClassList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ETCDConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36643,41 +38385,10 @@ func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Autoscaling == nil {
+				m.Autoscaling = &ControlPlaneAutoscaling{}
 			}
-			m.Items = append(m.Items, ExposureClass{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -36702,7 +38413,7 @@ func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
+func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36725,15 +38436,47 @@ func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExposureClassScheduling: wiretype end group for non-group")
+			return fmt.Errorf("proto: ETCDEncryptionKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExposureClassScheduling: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ETCDEncryptionKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = CredentialsRotationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36760,16 +38503,16 @@ func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SeedSelector == nil {
-				m.SeedSelector = &SeedSelector{}
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
 			}
-			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36796,18 +38539,92 @@ func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationFinishedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationFinishedTime == nil {
+				m.LastInitiationFinishedTime = &v11.Time{}
+			}
+			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTriggeredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTriggeredTime == nil {
+				m.LastCompletionTriggeredTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
@@ -36822,7 +38639,7 @@ func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Extension) Unmarshal(dAtA []byte) error {
+func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36845,15 +38662,15 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Extension: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptionConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Extension: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36881,11 +38698,93 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpirableVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpirableVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36912,18 +38811,18 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = &v11.Time{}
 			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36933,13 +38832,25 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Disabled = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := VersionClassification(dAtA[iNdEx:postIndex])
+			m.Classification = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36961,7 +38872,7 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
+func (m *ExposureClass) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36984,17 +38895,17 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionResourceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExposureClass: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionResourceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExposureClass: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37004,27 +38915,28 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37052,14 +38964,13 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Handler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37069,28 +38980,81 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Purpose = &s
+			if m.Scheduling == nil {
+				m.Scheduling = &ExposureClassScheduling{}
+			}
+			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExposureClassList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExposureClassList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExposureClassList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37117,16 +39081,13 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &runtime.RawExtension{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37153,8 +39114,8 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, NamedResourceReference{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ExposureClass{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37179,7 +39140,7 @@ func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
+func (m *ExposureClassScheduling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37202,17 +39163,17 @@ func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FailureTolerance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExposureClassScheduling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailureTolerance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExposureClassScheduling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37222,23 +39183,61 @@ func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = FailureToleranceType(dAtA[iNdEx:postIndex])
+			if m.SeedSelector == nil {
+				m.SeedSelector = &SeedSelector{}
+			}
+			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37261,7 +39260,7 @@ func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Gardener) Unmarshal(dAtA []byte) error {
+func (m *Extension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37284,15 +39283,15 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gardener: wiretype end group for non-group")
+			return fmt.Errorf("proto: Extension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gardener: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Extension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37320,13 +39319,13 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37336,29 +39335,33 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37368,24 +39371,13 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Disabled = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37407,7 +39399,7 @@ func (m *Gardener) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
+func (m *ExtensionResourceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37430,15 +39422,15 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GardenerResourceData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtensionResourceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GardenerResourceData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtensionResourceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37466,11 +39458,11 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37498,11 +39490,45 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Purpose = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37529,13 +39555,16 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &runtime.RawExtension{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37562,32 +39591,441 @@ func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Resources = append(m.Resources, NamedResourceReference{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailureTolerance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailureTolerance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailureTolerance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = FailureToleranceType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gardener) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gardener: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gardener: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GardenerResourceData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GardenerResourceData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GardenerResourceData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
 						}
@@ -45567,6 +48005,40 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteWrites", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteWrites = append(m.RemoteWrites, MonitoringRemoteWrite{})
+			if err := m.RemoteWrites[len(m.RemoteWrites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45588,7 +48060,7 @@ func (m *Monitoring) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
+func (m *MonitoringRemoteWrite) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45611,10 +48083,10 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonitoringRemoteWrite: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonitoringRemoteWrite: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -45651,9 +48123,9 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45663,78 +48135,27 @@ func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45761,13 +48182,16 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BasicAuth == nil {
+				m.BasicAuth = &MonitoringRemoteWriteBasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45794,15 +48218,18 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BearerToken == nil {
+				m.BearerToken = &ResourceSecretKeyReference{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keep", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45812,24 +48239,23 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keep = append(m.Keep, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45852,7 +48278,7 @@ func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
+func (m *MonitoringRemoteWriteBasicAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45875,15 +48301,15 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfileList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonitoringRemoteWriteBasicAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonitoringRemoteWriteBasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45910,13 +48336,13 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45943,8 +48369,7 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, NamespacedCloudProfile{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -45969,7 +48394,7 @@ func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
+func (m *NamedResourceReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45992,15 +48417,15 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespacedCloudProfileSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamedResourceReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespacedCloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamedResourceReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46028,12 +48453,11 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46060,84 +48484,63 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kubernetes == nil {
-				m.Kubernetes = &KubernetesSettings{}
-			}
-			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MachineImages = append(m.MachineImages, MachineImage{})
-			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MachineTypes = append(m.MachineTypes, MachineType{})
-			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46164,14 +48567,417 @@ func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeTypes = append(m.VolumeTypes, VolumeType{})
-			if err := m.VolumeTypes[len(m.VolumeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfileList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfileList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, NamespacedCloudProfile{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespacedCloudProfileSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespacedCloudProfileSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespacedCloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kubernetes == nil {
+				m.Kubernetes = &KubernetesSettings{}
+			}
+			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineImages = append(m.MachineImages, MachineImage{})
+			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineTypes = append(m.MachineTypes, MachineType{})
+			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeTypes = append(m.VolumeTypes, VolumeType{})
+			if err := m.VolumeTypes[len(m.VolumeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48259,7 +51065,7 @@ func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
+func (m *PagerDutyAlertingReceiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48282,17 +51088,17 @@ func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingWorkerUpdates: wiretype end group for non-group")
+			return fmt.Errorf("proto: PagerDutyAlertingReceiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingWorkerUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PagerDutyAlertingReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoInPlaceUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48302,27 +51108,28 @@ func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoInPlaceUpdate = append(m.AutoInPlaceUpdate, string(dAtA[iNdEx:postIndex]))
+			if err := m.RoutingKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualInPlaceUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48350,7 +51157,8 @@ func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManualInPlaceUpdate = append(m.ManualInPlaceUpdate, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.URL = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48373,7 +51181,7 @@ func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
+func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48396,15 +51204,15 @@ func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingWorkersRollout: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingWorkerUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingWorkersRollout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingWorkerUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoInPlaceUpdate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48432,13 +51240,13 @@ func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.AutoInPlaceUpdate = append(m.AutoInPlaceUpdate, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualInPlaceUpdate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48448,27 +51256,23 @@ func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ManualInPlaceUpdate = append(m.ManualInPlaceUpdate, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48491,7 +51295,7 @@ func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48514,17 +51318,17 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingWorkersRollout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingWorkersRollout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48534,28 +51338,27 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48582,40 +51385,10 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -48640,7 +51413,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48663,15 +51436,15 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48698,13 +51471,13 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48731,8 +51504,40 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Project{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -48757,7 +51562,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectMember) Unmarshal(dAtA []byte) error {
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48780,15 +51585,15 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48815,15 +51620,15 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48833,55 +51638,25 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48904,7 +51679,7 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
+func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48927,15 +51702,15 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48962,16 +51737,13 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedBy == nil {
-				m.CreatedBy = &v14.Subject{}
-			}
-			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48999,48 +51771,198 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Owner == nil {
-				m.Owner = &v14.Subject{}
-			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedBy == nil {
+				m.CreatedBy = &v14.Subject{}
+			}
+			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Description = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &v14.Subject{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50638,7 +53560,7 @@ func (m *ResourceData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
+func (m *ResourceSecretKeyReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50661,15 +53583,15 @@ func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceWatchCacheSize: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceSecretKeyReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceWatchCacheSize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceSecretKeyReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50697,12 +53619,126 @@ func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.APIGroup = &s
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceWatchCacheSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceWatchCacheSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.APIGroup = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -58397,92 +61433,9 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShootState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShootState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHealthChecks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -58509,7 +61462,8 @@ func (m *ShootState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CustomHealthChecks = append(m.CustomHealthChecks, CustomHealthCheck{})
+			if err := m.CustomHealthChecks[len(m.CustomHealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -58534,7 +61488,7 @@ func (m *ShootState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootStateList) Unmarshal(dAtA []byte) error {
+func (m *ShootState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -58557,15 +61511,15 @@ func (m *ShootStateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootStateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootStateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -58592,13 +61546,13 @@ func (m *ShootStateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -58625,8 +61579,7 @@ func (m *ShootStateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ShootState{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -58651,7 +61604,7 @@ func (m *ShootStateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootStateSpec) Unmarshal(dAtA []byte) error {
+func (m *ShootStateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -58674,15 +61627,15 @@ func (m *ShootStateSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootStateSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootStateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootStateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootStateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gardener", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -58709,48 +61662,13 @@ func (m *ShootStateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gardener = append(m.Gardener, GardenerResourceData{})
-			if err := m.Gardener[len(m.Gardener)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Extensions = append(m.Extensions, ExtensionResourceState{})
-			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -58777,8 +61695,8 @@ func (m *ShootStateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceData{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ShootState{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -58803,7 +61721,7 @@ func (m *ShootStateSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootStatus) Unmarshal(dAtA []byte) error {
+func (m *ShootStateSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -58826,81 +61744,233 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootStateSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootStateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Constraints = append(m.Constraints, Condition{})
-			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gardener", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gardener = append(m.Gardener, GardenerResourceData{})
+			if err := m.Gardener[len(m.Gardener)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, ExtensionResourceState{})
+			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceData{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, Condition{})
+			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Gardener", wireType)
 			}
@@ -59205,14 +62275,709 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ClusterIdentity = &s
+			s := string(dAtA[iNdEx:postIndex])
+			m.ClusterIdentity = &s
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvertisedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdvertisedAddresses = append(m.AdvertisedAddresses, ShootAdvertisedAddress{})
+			if err := m.AdvertisedAddresses[len(m.AdvertisedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationStartTime == nil {
+				m.MigrationStartTime = &v11.Time{}
+			}
+			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &ShootCredentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHibernationTriggerTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHibernationTriggerTime == nil {
+				m.LastHibernationTriggerTime = &v11.Time{}
+			}
+			if err := m.LastHibernationTriggerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMaintenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastMaintenance == nil {
+				m.LastMaintenance = &LastMaintenance{}
+			}
+			if err := m.LastMaintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedResources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Networking == nil {
+				m.Networking = &NetworkingStatus{}
+			}
+			if err := m.Networking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InPlaceUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InPlaceUpdates == nil {
+				m.InPlaceUpdates = &InPlaceUpdatesStatus{}
+			}
+			if err := m.InPlaceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootSummaryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootSummaryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootSummaryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ShootSummary{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootSummaryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootSummaryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootSummaryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SeedName = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ShootPurpose(dAtA[iNdEx:postIndex])
+			m.Purpose = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdvertisedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GardenerVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -59222,31 +62987,29 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdvertisedAddresses = append(m.AdvertisedAddresses, ShootAdvertisedAddress{})
-			if err := m.AdvertisedAddresses[len(m.AdvertisedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GardenerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hibernated", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -59256,33 +63019,17 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
-			}
-			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
+			m.Hibernated = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhealthyConditions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -59292,31 +63039,27 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &ShootCredentials{}
-			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UnhealthyConditions = append(m.UnhealthyConditions, ConditionType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 16:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHibernationTriggerTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -59343,16 +63086,16 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHibernationTriggerTime == nil {
-				m.LastHibernationTriggerTime = &v11.Time{}
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
 			}
-			if err := m.LastHibernationTriggerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastMaintenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationStartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -59379,48 +63122,66 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastMaintenance == nil {
-				m.LastMaintenance = &LastMaintenance{}
+			if m.OperationStartTime == nil {
+				m.OperationStartTime = &v11.Time{}
 			}
-			if err := m.LastMaintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OperationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedResources", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 19:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Networking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -59447,16 +63208,13 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Networking == nil {
-				m.Networking = &NetworkingStatus{}
-			}
-			if err := m.Networking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InPlaceUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -59483,10 +63241,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InPlaceUpdates == nil {
-				m.InPlaceUpdates = &InPlaceUpdatesStatus{}
-			}
-			if err := m.InPlaceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -59511,7 +63266,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
+func (m *SlackAlertingReceiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -59534,15 +63289,15 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlackAlertingReceiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlackAlertingReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIURL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -59569,15 +63324,15 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.APIURL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -59587,24 +63342,24 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Channel = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -61184,6 +64939,89 @@ func (m *WatchCacheSizes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WebhookAlertingReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookAlertingReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookAlertingReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.URL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Worker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,7 +54,11 @@ func ShootIgnoresAlerts(shoot *gardencorev1beta1.Shoot) bool {
 
 // ShootWantsAlertManager checks if the given shoot specification requires an alert manager.
 func ShootWantsAlertManager(shoot *gardencorev1beta1.Shoot) bool {
-	return !ShootIgnoresAlerts(shoot) && shoot.Spec.Monitoring != nil && shoot.Spec.Monitoring.Alerting != nil && len(shoot.Spec.Monitoring.Alerting.EmailReceivers) > 0
+	if ShootIgnoresAlerts(shoot) || shoot.Spec.Monitoring == nil || shoot.Spec.Monitoring.Alerting == nil {
+		return false
+	}
+
+	return len(shoot.Spec.Monitoring.Alerting.EmailReceivers) > 0 || len(shoot.Spec.Monitoring.Alerting.Receivers) > 0
 }
 
 // ShootUsesUnmanagedDNS returns true if the shoot's DNS section is marked as 'unmanaged'.
@@ -353,6 +357,14 @@ func GetShootAuthorizationConfigurationConfigMapName(apiServerConfig *gardencore
 	return ""
 }
 
+// GetShootAlertingRulesConfigMapName returns the name of the Shoot's ConfigMap containing the additional alerting rules.
+func GetShootAlertingRulesConfigMapName(monitoring *gardencorev1beta1.Monitoring) string {
+	if monitoring != nil && monitoring.Alerting != nil && monitoring.Alerting.Rules != nil {
+		return monitoring.Alerting.Rules.ConfigMapName
+	}
+	return ""
+}
+
 // GetShootAuthorizationConfiguration returns the Shoot's authorization configuration.
 func GetShootAuthorizationConfiguration(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) *gardencorev1beta1.StructuredAuthorization {
 	if apiServerConfig != nil {
@@ -583,6 +595,26 @@ func GetResourceByName(resources []gardencorev1beta1.NamedResourceReference, nam
 	return nil
 }
 
+// GetReferencedConfigMapName returns the name of the ConfigMap referenced by the NamedResourceReference with the given
+// name in the given slice, or an empty string if there is no such reference to a ConfigMap.
+func GetReferencedConfigMapName(resources []gardencorev1beta1.NamedResourceReference, name string) string {
+	resource := GetResourceByName(resources, name)
+	if resource == nil || resource.ResourceRef.APIVersion != "v1" || resource.ResourceRef.Kind != "ConfigMap" {
+		return ""
+	}
+	return resource.ResourceRef.Name
+}
+
+// GetReferencedSecretName returns the name of the Secret referenced by the NamedResourceReference with the given name
+// in the given slice, or an empty string if there is no such reference to a Secret.
+func GetReferencedSecretName(resources []gardencorev1beta1.NamedResourceReference, name string) string {
+	resource := GetResourceByName(resources, name)
+	if resource == nil || resource.ResourceRef.APIVersion != "v1" || resource.ResourceRef.Kind != "Secret" {
+		return ""
+	}
+	return resource.ResourceRef.Name
+}
+
 // AccessRestrictionsAreSupported returns true when all the given access restrictions are supported.
 func AccessRestrictionsAreSupported(seedAccessRestrictions []gardencorev1beta1.AccessRestriction, shootAccessRestrictions []gardencorev1beta1.AccessRestrictionWithOptions) bool {
 	if len(shootAccessRestrictions) == 0 {
@@ -159,6 +159,16 @@ var _ = Describe("Helper", func() {
 				}
 				Expect(ShootWantsAlertManager(shoot)).To(BeTrue())
 			})
+			It("should want alert manager because alert receivers are configured", func() {
+				shoot.Spec = gardencorev1beta1.ShootSpec{
+					Monitoring: &gardencorev1beta1.Monitoring{
+						Alerting: &gardencorev1beta1.Alerting{
+							Receivers: []gardencorev1beta1.AlertingReceiver{{Name: "slack"}},
+						},
+					},
+				}
+				Expect(ShootWantsAlertManager(shoot)).To(BeTrue())
+			})
 		})
 	})
 
@@ -751,6 +761,21 @@ var _ = Describe("Helper", func() {
 		}, "foo"),
 	)
 
+	DescribeTable("#GetShootAlertingRulesConfigMapName",
+		func(monitoring *gardencorev1beta1.Monitoring, expectedName string) {
+			Expect(GetShootAlertingRulesConfigMapName(monitoring)).To(Equal(expectedName))
+		},
+
+		Entry("Monitoring = nil", nil, ""),
+		Entry("Alerting = nil", &gardencorev1beta1.Monitoring{}, ""),
+		Entry("Rules = nil", &gardencorev1beta1.Monitoring{Alerting: &gardencorev1beta1.Alerting{}}, ""),
+		Entry("ConfigMapName set", &gardencorev1beta1.Monitoring{
+			Alerting: &gardencorev1beta1.Alerting{
+				Rules: &gardencorev1beta1.AlertingRules{ConfigMapName: "foo"},
+			},
+		}, "foo"),
+	)
+
 	DescribeTable("#GetShootAuthorizationConfiguration",
 		func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedResult *gardencorev1beta1.StructuredAuthorization) {
 			Expect(GetShootAuthorizationConfiguration(kubeAPIServerConfig)).To(Equal(expectedResult))
@@ -1326,6 +1351,52 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	DescribeTable("#GetReferencedConfigMapName",
+		func(resources []gardencorev1beta1.NamedResourceReference, expected string) {
+			Expect(GetReferencedConfigMapName(resources, "foo")).To(Equal(expected))
+		},
+
+		Entry("resources is nil", nil, ""),
+		Entry("resources doesn't contain a resource with the given name",
+			[]gardencorev1beta1.NamedResourceReference{{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "bar"}}},
+			"",
+		),
+		Entry("resource with the given name is not a ConfigMap",
+			[]gardencorev1beta1.NamedResourceReference{{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "foo"}}},
+			"",
+		),
+		Entry("resource with the given name is a ConfigMap",
+			[]gardencorev1beta1.NamedResourceReference{
+				{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "bar"}},
+				{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "baz"}},
+			},
+			"baz",
+		),
+	)
+
+	DescribeTable("#GetReferencedSecretName",
+		func(resources []gardencorev1beta1.NamedResourceReference, expected string) {
+			Expect(GetReferencedSecretName(resources, "foo")).To(Equal(expected))
+		},
+
+		Entry("resources is nil", nil, ""),
+		Entry("resources doesn't contain a resource with the given name",
+			[]gardencorev1beta1.NamedResourceReference{{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "bar"}}},
+			"",
+		),
+		Entry("resource with the given name is not a Secret",
+			[]gardencorev1beta1.NamedResourceReference{{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "foo"}}},
+			"",
+		),
+		Entry("resource with the given name is a Secret",
+			[]gardencorev1beta1.NamedResourceReference{
+				{Name: "bar", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "bar"}},
+				{Name: "foo", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "baz"}},
+			},
+			"baz",
+		),
+	)
+
 	DescribeTable("#AccessRestrictionsAreSupported",
 		func(seedAccessRestrictions []gardencorev1beta1.AccessRestriction, shootAccessRestrictions []gardencorev1beta1.AccessRestrictionWithOptions, expectation bool) {
 			Expect(AccessRestrictionsAreSupported(seedAccessRestrictions, shootAccessRestrictions)).To(Equal(expectation))
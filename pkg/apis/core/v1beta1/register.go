@@ -67,6 +67,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ShootList{},
 		&ShootState{},
 		&ShootStateList{},
+		&ShootSummary{},
+		&ShootSummaryList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
@@ -77,3 +77,7 @@ const (
 	// still required on the seed cluster as corresponding extension resources still exist.
 	ControllerInstallationRequired ConditionType = "Required"
 )
+
+// ControllerInstallationReasonHeartbeatStale is the reason of the Healthy condition of ControllerInstallations whose
+// extension did not renew its heartbeat Lease in time.
+const ControllerInstallationReasonHeartbeatStale = "ExtensionHeartbeatStale"
@@ -474,6 +474,9 @@ const (
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
 	// SeedEmergencyStopShootReconciliations is a constant for a condition type indicating disabled shoot reconciliations.
 	SeedEmergencyStopShootReconciliations ConditionType = "EmergencyStopShootReconciliations"
+	// SeedShootMonitoringWithinLimits is a constant for a condition type indicating that the monitoring stacks of the
+	// shoots hosted by the seed stay within the configured sample limits.
+	SeedShootMonitoringWithinLimits ConditionType = "ShootMonitoringWithinLimits"
 )
 
 // Resource constants for Gardener object types
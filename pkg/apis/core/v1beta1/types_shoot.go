@@ -146,6 +146,12 @@ type ShootSpec struct {
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	// +optional
 	AccessRestrictions []AccessRestrictionWithOptions `json:"accessRestrictions,omitempty" protobuf:"bytes,24,rep,name=accessRestrictions"`
+	// CustomHealthChecks contains health checks for objects in the Shoot cluster which are evaluated by the gardenlet.
+	// Their result is reported in the `CustomHealthChecksPassed` condition.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	CustomHealthChecks []CustomHealthCheck `json:"customHealthChecks,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,25,rep,name=customHealthChecks"`
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -1607,6 +1613,39 @@ type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
 	// +optional
 	Alerting *Alerting `json:"alerting,omitempty" protobuf:"bytes,1,opt,name=alerting"`
+	// RemoteWrites is a list of remote write targets to which the shoot Prometheus forwards selected metrics. They are
+	// only considered if the seed operator allows shoot owners to configure remote write targets.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	RemoteWrites []MonitoringRemoteWrite `json:"remoteWrites,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=remoteWrites"`
+}
+
+// MonitoringRemoteWrite is a remote write target of the shoot Prometheus. At most one of basicAuth or bearerToken can be
+// set.
+type MonitoringRemoteWrite struct {
+	// Name is the unique name of the remote write target.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// URL is the https URL of the remote write endpoint.
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// BasicAuth configures the basic authentication credentials sent to the endpoint.
+	// +optional
+	BasicAuth *MonitoringRemoteWriteBasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,3,opt,name=basicAuth"`
+	// BearerToken references the secret key containing the bearer token sent to the endpoint.
+	// +optional
+	BearerToken *ResourceSecretKeyReference `json:"bearerToken,omitempty" protobuf:"bytes,4,opt,name=bearerToken"`
+	// Keep is the list of metric names which are forwarded to the endpoint. All other metrics are dropped. Regular
+	// expressions are only supported as long as they match a limited set of metric names, e.g., `etcd_(disk|mvcc)_.*`
+	// is rejected while `kube_pod_(info|labels)` is allowed.
+	Keep []string `json:"keep" protobuf:"bytes,5,rep,name=keep"`
+}
+
+// MonitoringRemoteWriteBasicAuth configures basic authentication for a remote write target.
+type MonitoringRemoteWriteBasicAuth struct {
+	// Username references the secret key containing the username.
+	Username ResourceSecretKeyReference `json:"username" protobuf:"bytes,1,opt,name=username"`
+	// Password references the secret key containing the password.
+	Password ResourceSecretKeyReference `json:"password" protobuf:"bytes,2,opt,name=password"`
 }
 
 // Alerting contains information about how alerting will be done (i.e. who will receive alerts and how).
@@ -1614,6 +1653,81 @@ type Alerting struct {
 	// MonitoringEmailReceivers is a list of recipients for alerts
 	// +optional
 	EmailReceivers []string `json:"emailReceivers,omitempty" protobuf:"bytes,1,rep,name=emailReceivers"`
+	// Receivers is a list of additional alert receivers, e.g. webhooks, Slack channels or PagerDuty services, which
+	// get the alerts of the shoot cluster.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Receivers []AlertingReceiver `json:"receivers,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=receivers"`
+	// Rules contains the alerting rules which are evaluated by the shoot Prometheus in addition to the rules provided
+	// by Gardener.
+	// +optional
+	Rules *AlertingRules `json:"rules,omitempty" protobuf:"bytes,3,opt,name=rules"`
+}
+
+// AlertingRules contains a reference to additional alerting rules for the shoot cluster.
+type AlertingRules struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains the alerting rules in the
+	// format of the spec of a PrometheusRule.
+	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,opt,name=configMapName"`
+}
+
+// AlertingReceiver is an additional alert receiver. Exactly one of webhook, slack or pagerDuty must be set.
+type AlertingReceiver struct {
+	// Name is the unique name of the receiver.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Severities restricts the alerts routed to this receiver to the given severities. If empty, alerts of all
+	// severities are routed.
+	// +optional
+	Severities []string `json:"severities,omitempty" protobuf:"bytes,2,rep,name=severities"`
+	// Components restricts the alerts routed to this receiver to the given components (the `service` label of the
+	// alerts). If empty, alerts of all components are routed.
+	// +optional
+	Components []string `json:"components,omitempty" protobuf:"bytes,3,rep,name=components"`
+	// SendResolved specifies whether notifications about resolved alerts are sent.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty" protobuf:"varint,4,opt,name=sendResolved"`
+	// Webhook configures a generic webhook receiver.
+	// +optional
+	Webhook *WebhookAlertingReceiver `json:"webhook,omitempty" protobuf:"bytes,5,opt,name=webhook"`
+	// Slack configures a Slack-compatible receiver.
+	// +optional
+	Slack *SlackAlertingReceiver `json:"slack,omitempty" protobuf:"bytes,6,opt,name=slack"`
+	// PagerDuty configures a PagerDuty-compatible receiver.
+	// +optional
+	PagerDuty *PagerDutyAlertingReceiver `json:"pagerDuty,omitempty" protobuf:"bytes,7,opt,name=pagerDuty"`
+}
+
+// WebhookAlertingReceiver configures a generic webhook receiver.
+type WebhookAlertingReceiver struct {
+	// URL references the secret key containing the URL of the webhook.
+	URL ResourceSecretKeyReference `json:"url" protobuf:"bytes,1,opt,name=url"`
+}
+
+// SlackAlertingReceiver configures a Slack-compatible receiver.
+type SlackAlertingReceiver struct {
+	// APIURL references the secret key containing the URL of the incoming webhook.
+	APIURL ResourceSecretKeyReference `json:"apiURL" protobuf:"bytes,1,opt,name=apiURL"`
+	// Channel is the channel or user to send notifications to.
+	// +optional
+	Channel *string `json:"channel,omitempty" protobuf:"bytes,2,opt,name=channel"`
+}
+
+// PagerDutyAlertingReceiver configures a PagerDuty-compatible receiver.
+type PagerDutyAlertingReceiver struct {
+	// RoutingKey references the secret key containing the routing key of the PagerDuty Events API v2 integration.
+	RoutingKey ResourceSecretKeyReference `json:"routingKey" protobuf:"bytes,1,opt,name=routingKey"`
+	// URL is the URL of the events API. If not set, the default PagerDuty URL is used.
+	// +optional
+	URL *string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+}
+
+// ResourceSecretKeyReference references a key of a Secret which is referenced in the `.spec.resources` of the Shoot.
+type ResourceSecretKeyReference struct {
+	// ResourceName is the name of the resource reference in the `.spec.resources` of the Shoot.
+	ResourceName string `json:"resourceName" protobuf:"bytes,1,opt,name=resourceName"`
+	// Key is the key in the data of the Secret.
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
 // Provider contains provider-specific information that are handed-over to the provider-specific
@@ -1982,6 +2096,38 @@ type NodeLocalDNS struct {
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
 }
 
+// CustomHealthCheck is a health check for objects in the Shoot cluster expressed in CEL.
+type CustomHealthCheck struct {
+	// Name is the unique name of the health check.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Resource selects the objects in the Shoot cluster the expression is evaluated for.
+	Resource CustomHealthCheckResource `json:"resource" protobuf:"bytes,2,opt,name=resource"`
+	// Expression is a CEL expression which must evaluate to true for each selected object in order to consider the
+	// check as passed. The object is bound to the `object` variable.
+	Expression string `json:"expression" protobuf:"bytes,3,opt,name=expression"`
+	// Message is reported if the check fails.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// CustomHealthCheckResource selects the objects in the Shoot cluster a custom health check is evaluated for.
+type CustomHealthCheckResource struct {
+	// APIVersion is the API version of the objects.
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	// Kind is the kind of the objects.
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace is the namespace of the objects. It must not be set for cluster-scoped objects.
+	// +optional
+	Namespace *string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// Name is the name of the object. Exactly one of name or labelSelector must be set.
+	// +optional
+	Name *string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// LabelSelector selects the objects by their labels. Exactly one of name or labelSelector must be set. It must not be
+	// empty if namespace is not set. At least one object must match the selector.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,5,opt,name=labelSelector"`
+}
+
 const (
 	// ShootMaintenanceFailed indicates that a shoot maintenance operation failed.
 	ShootMaintenanceFailed = "MaintenanceFailed"
@@ -2002,6 +2148,9 @@ const (
 const (
 	// ShootAPIServerAvailable is a constant for a condition type indicating that the Shoot cluster's API server is available.
 	ShootAPIServerAvailable ConditionType = "APIServerAvailable"
+	// ShootAPIServerExternallyReachable is a constant for a condition type indicating that the Shoot cluster's API
+	// server is reachable via its external endpoint from outside the seed.
+	ShootAPIServerExternallyReachable ConditionType = "APIServerExternallyReachable"
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the health of core control plane components.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
 	// ShootObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
@@ -2010,6 +2159,9 @@ const (
 	ShootEveryNodeReady ConditionType = "EveryNodeReady"
 	// ShootSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	ShootSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// ShootCustomHealthChecksPassed is a constant for a condition type indicating that all custom health checks declared
+	// by the Shoot owner passed.
+	ShootCustomHealthChecksPassed ConditionType = "CustomHealthChecksPassed"
 	// ShootHibernationPossible is a constant for a condition type indicating whether the Shoot can be hibernated.
 	ShootHibernationPossible ConditionType = "HibernationPossible"
 	// ShootMaintenancePreconditionsSatisfied is a constant for a condition type indicating whether all preconditions
@@ -2024,6 +2176,12 @@ const (
 	// ShootManualInPlaceWorkersUpdated is a constant for a condition type indicating that the Shoot cluster does not have
 	// any worker pools with update strategy "ManualInPlaceUpdate" and pending update.
 	ShootManualInPlaceWorkersUpdated ConditionType = "ManualInPlaceWorkersUpdated"
+	// ShootUpgradeReadiness is a constant for a condition type indicating that the Shoot cluster does not use any APIs
+	// which are removed in the next Kubernetes minor version.
+	ShootUpgradeReadiness ConditionType = "UpgradeReadiness"
+	// ShootNodeDrainPossible is a constant for a condition type indicating that no PodDisruptionBudgets in the Shoot
+	// cluster block the drain of nodes.
+	ShootNodeDrainPossible ConditionType = "NodeDrainPossible"
 	// ShootReadyForMigration is a constant for a condition type indicating whether the Shoot can be migrated.
 	ShootReadyForMigration ConditionType = "ReadyForMigration"
 	// ShootDualStackNodesMigrationReady is a constant for a condition type indicating whether all nodes are migrated to dual-stack .
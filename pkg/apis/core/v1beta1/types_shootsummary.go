@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:onlyVerbs=get,list
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootSummary is a read-only summary of the health, the versions and the last operation of a Shoot. It is computed
+// by the gardener-apiserver from the Shoot on request and not persisted.
+type ShootSummary struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Status contains the summarized status of the Shoot.
+	// +optional
+	Status ShootSummaryStatus `json:"status,omitempty" protobuf:"bytes,2,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootSummaryList is a list of ShootSummary objects.
+type ShootSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is the list of ShootSummaries.
+	Items []ShootSummary `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ShootSummaryStatus contains the summarized status of a Shoot.
+type ShootSummaryStatus struct {
+	// SeedName is the name of the seed cluster that runs the control plane of the Shoot.
+	// +optional
+	SeedName *string `json:"seedName,omitempty" protobuf:"bytes,1,opt,name=seedName"`
+	// ProviderType is the type of the infrastructure provider of the Shoot.
+	ProviderType string `json:"providerType" protobuf:"bytes,2,opt,name=providerType"`
+	// Purpose is the purpose of the Shoot.
+	// +optional
+	Purpose *ShootPurpose `json:"purpose,omitempty" protobuf:"bytes,3,opt,name=purpose,casttype=ShootPurpose"`
+	// KubernetesVersion is the Kubernetes version of the Shoot.
+	KubernetesVersion string `json:"kubernetesVersion" protobuf:"bytes,4,opt,name=kubernetesVersion"`
+	// GardenerVersion is the version of the Gardener which last acted on the Shoot.
+	// +optional
+	GardenerVersion string `json:"gardenerVersion,omitempty" protobuf:"bytes,5,opt,name=gardenerVersion"`
+	// Hibernated indicates whether the Shoot is currently hibernated.
+	Hibernated bool `json:"hibernated" protobuf:"varint,6,opt,name=hibernated"`
+	// UnhealthyConditions contains the types of the conditions of the Shoot whose status is False or Unknown.
+	// +optional
+	UnhealthyConditions []ConditionType `json:"unhealthyConditions,omitempty" protobuf:"bytes,7,rep,name=unhealthyConditions,casttype=ConditionType"`
+	// LastOperation holds information about the last operation on the Shoot.
+	// +optional
+	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,8,opt,name=lastOperation"`
+	// OperationStartTime is the time when the current operation on the Shoot started. It is unset once the
+	// operation succeeded.
+	// +optional
+	OperationStartTime *metav1.Time `json:"operationStartTime,omitempty" protobuf:"bytes,9,opt,name=operationStartTime"`
+}
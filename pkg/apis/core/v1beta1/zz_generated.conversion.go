@@ -111,6 +111,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AlertingReceiver)(nil), (*core.AlertingReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlertingReceiver_To_core_AlertingReceiver(a.(*AlertingReceiver), b.(*core.AlertingReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlertingReceiver)(nil), (*AlertingReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlertingReceiver_To_v1beta1_AlertingReceiver(a.(*core.AlertingReceiver), b.(*AlertingReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertingRules)(nil), (*core.AlertingRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlertingRules_To_core_AlertingRules(a.(*AlertingRules), b.(*core.AlertingRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlertingRules)(nil), (*AlertingRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlertingRules_To_v1beta1_AlertingRules(a.(*core.AlertingRules), b.(*AlertingRules), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditConfig)(nil), (*core.AuditConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuditConfig_To_core_AuditConfig(a.(*AuditConfig), b.(*core.AuditConfig), scope)
 	}); err != nil {
@@ -561,6 +581,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CustomHealthCheck)(nil), (*core.CustomHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomHealthCheck_To_core_CustomHealthCheck(a.(*CustomHealthCheck), b.(*core.CustomHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CustomHealthCheck)(nil), (*CustomHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CustomHealthCheck_To_v1beta1_CustomHealthCheck(a.(*core.CustomHealthCheck), b.(*CustomHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomHealthCheckResource)(nil), (*core.CustomHealthCheckResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomHealthCheckResource_To_core_CustomHealthCheckResource(a.(*CustomHealthCheckResource), b.(*core.CustomHealthCheckResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CustomHealthCheckResource)(nil), (*CustomHealthCheckResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CustomHealthCheckResource_To_v1beta1_CustomHealthCheckResource(a.(*core.CustomHealthCheckResource), b.(*CustomHealthCheckResource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*core.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNS_To_core_DNS(a.(*DNS), b.(*core.DNS), scope)
 	}); err != nil {
@@ -1136,6 +1176,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MonitoringRemoteWrite)(nil), (*core.MonitoringRemoteWrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite(a.(*MonitoringRemoteWrite), b.(*core.MonitoringRemoteWrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MonitoringRemoteWrite)(nil), (*MonitoringRemoteWrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite(a.(*core.MonitoringRemoteWrite), b.(*MonitoringRemoteWrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MonitoringRemoteWriteBasicAuth)(nil), (*core.MonitoringRemoteWriteBasicAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MonitoringRemoteWriteBasicAuth_To_core_MonitoringRemoteWriteBasicAuth(a.(*MonitoringRemoteWriteBasicAuth), b.(*core.MonitoringRemoteWriteBasicAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MonitoringRemoteWriteBasicAuth)(nil), (*MonitoringRemoteWriteBasicAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MonitoringRemoteWriteBasicAuth_To_v1beta1_MonitoringRemoteWriteBasicAuth(a.(*core.MonitoringRemoteWriteBasicAuth), b.(*MonitoringRemoteWriteBasicAuth), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedResourceReference)(nil), (*core.NamedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_NamedResourceReference_To_core_NamedResourceReference(a.(*NamedResourceReference), b.(*core.NamedResourceReference), scope)
 	}); err != nil {
@@ -1266,6 +1326,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PagerDutyAlertingReceiver)(nil), (*core.PagerDutyAlertingReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PagerDutyAlertingReceiver_To_core_PagerDutyAlertingReceiver(a.(*PagerDutyAlertingReceiver), b.(*core.PagerDutyAlertingReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PagerDutyAlertingReceiver)(nil), (*PagerDutyAlertingReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PagerDutyAlertingReceiver_To_v1beta1_PagerDutyAlertingReceiver(a.(*core.PagerDutyAlertingReceiver), b.(*PagerDutyAlertingReceiver), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PendingWorkerUpdates)(nil), (*core.PendingWorkerUpdates)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PendingWorkerUpdates_To_core_PendingWorkerUpdates(a.(*PendingWorkerUpdates), b.(*core.PendingWorkerUpdates), scope)
 	}); err != nil {
@@ -1386,6 +1456,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ResourceSecretKeyReference)(nil), (*core.ResourceSecretKeyReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(a.(*ResourceSecretKeyReference), b.(*core.ResourceSecretKeyReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ResourceSecretKeyReference)(nil), (*ResourceSecretKeyReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(a.(*core.ResourceSecretKeyReference), b.(*ResourceSecretKeyReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceWatchCacheSize)(nil), (*core.ResourceWatchCacheSize)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ResourceWatchCacheSize_To_core_ResourceWatchCacheSize(a.(*ResourceWatchCacheSize), b.(*core.ResourceWatchCacheSize), scope)
 	}); err != nil {
@@ -1846,6 +1926,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootSummary)(nil), (*core.ShootSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootSummary_To_core_ShootSummary(a.(*ShootSummary), b.(*core.ShootSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootSummary)(nil), (*ShootSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootSummary_To_v1beta1_ShootSummary(a.(*core.ShootSummary), b.(*ShootSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootSummaryList)(nil), (*core.ShootSummaryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootSummaryList_To_core_ShootSummaryList(a.(*ShootSummaryList), b.(*core.ShootSummaryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootSummaryList)(nil), (*ShootSummaryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootSummaryList_To_v1beta1_ShootSummaryList(a.(*core.ShootSummaryList), b.(*ShootSummaryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootSummaryStatus)(nil), (*core.ShootSummaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootSummaryStatus_To_core_ShootSummaryStatus(a.(*ShootSummaryStatus), b.(*core.ShootSummaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootSummaryStatus)(nil), (*ShootSummaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootSummaryStatus_To_v1beta1_ShootSummaryStatus(a.(*core.ShootSummaryStatus), b.(*ShootSummaryStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootTemplate)(nil), (*core.ShootTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootTemplate_To_core_ShootTemplate(a.(*ShootTemplate), b.(*core.ShootTemplate), scope)
 	}); err != nil {
@@ -1856,6 +1966,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SlackAlertingReceiver)(nil), (*core.SlackAlertingReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SlackAlertingReceiver_To_core_SlackAlertingReceiver(a.(*SlackAlertingReceiver), b.(*core.SlackAlertingReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SlackAlertingReceiver)(nil), (*SlackAlertingReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SlackAlertingReceiver_To_v1beta1_SlackAlertingReceiver(a.(*core.SlackAlertingReceiver), b.(*SlackAlertingReceiver), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StructuredAuthentication)(nil), (*core.StructuredAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(a.(*StructuredAuthentication), b.(*core.StructuredAuthentication), scope)
 	}); err != nil {
@@ -1936,6 +2056,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WebhookAlertingReceiver)(nil), (*core.WebhookAlertingReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WebhookAlertingReceiver_To_core_WebhookAlertingReceiver(a.(*WebhookAlertingReceiver), b.(*core.WebhookAlertingReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WebhookAlertingReceiver)(nil), (*WebhookAlertingReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WebhookAlertingReceiver_To_v1beta1_WebhookAlertingReceiver(a.(*core.WebhookAlertingReceiver), b.(*WebhookAlertingReceiver), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Worker)(nil), (*core.Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Worker_To_core_Worker(a.(*Worker), b.(*core.Worker), scope)
 	}); err != nil {
@@ -2194,6 +2324,8 @@ func Convert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *core.AdmissionP
 
 func autoConvert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
+	out.Receivers = *(*[]core.AlertingReceiver)(unsafe.Pointer(&in.Receivers))
+	out.Rules = (*core.AlertingRules)(unsafe.Pointer(in.Rules))
 	return nil
 }
 
@@ -2204,6 +2336,8 @@ func Convert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting,
 
 func autoConvert_core_Alerting_To_v1beta1_Alerting(in *core.Alerting, out *Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
+	out.Receivers = *(*[]AlertingReceiver)(unsafe.Pointer(&in.Receivers))
+	out.Rules = (*AlertingRules)(unsafe.Pointer(in.Rules))
 	return nil
 }
 
@@ -2212,6 +2346,58 @@ func Convert_core_Alerting_To_v1beta1_Alerting(in *core.Alerting, out *Alerting,
 	return autoConvert_core_Alerting_To_v1beta1_Alerting(in, out, s)
 }
 
+func autoConvert_v1beta1_AlertingReceiver_To_core_AlertingReceiver(in *AlertingReceiver, out *core.AlertingReceiver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.Components = *(*[]string)(unsafe.Pointer(&in.Components))
+	out.SendResolved = (*bool)(unsafe.Pointer(in.SendResolved))
+	out.Webhook = (*core.WebhookAlertingReceiver)(unsafe.Pointer(in.Webhook))
+	out.Slack = (*core.SlackAlertingReceiver)(unsafe.Pointer(in.Slack))
+	out.PagerDuty = (*core.PagerDutyAlertingReceiver)(unsafe.Pointer(in.PagerDuty))
+	return nil
+}
+
+// Convert_v1beta1_AlertingReceiver_To_core_AlertingReceiver is an autogenerated conversion function.
+func Convert_v1beta1_AlertingReceiver_To_core_AlertingReceiver(in *AlertingReceiver, out *core.AlertingReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertingReceiver_To_core_AlertingReceiver(in, out, s)
+}
+
+func autoConvert_core_AlertingReceiver_To_v1beta1_AlertingReceiver(in *core.AlertingReceiver, out *AlertingReceiver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.Components = *(*[]string)(unsafe.Pointer(&in.Components))
+	out.SendResolved = (*bool)(unsafe.Pointer(in.SendResolved))
+	out.Webhook = (*WebhookAlertingReceiver)(unsafe.Pointer(in.Webhook))
+	out.Slack = (*SlackAlertingReceiver)(unsafe.Pointer(in.Slack))
+	out.PagerDuty = (*PagerDutyAlertingReceiver)(unsafe.Pointer(in.PagerDuty))
+	return nil
+}
+
+// Convert_core_AlertingReceiver_To_v1beta1_AlertingReceiver is an autogenerated conversion function.
+func Convert_core_AlertingReceiver_To_v1beta1_AlertingReceiver(in *core.AlertingReceiver, out *AlertingReceiver, s conversion.Scope) error {
+	return autoConvert_core_AlertingReceiver_To_v1beta1_AlertingReceiver(in, out, s)
+}
+
+func autoConvert_v1beta1_AlertingRules_To_core_AlertingRules(in *AlertingRules, out *core.AlertingRules, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_v1beta1_AlertingRules_To_core_AlertingRules is an autogenerated conversion function.
+func Convert_v1beta1_AlertingRules_To_core_AlertingRules(in *AlertingRules, out *core.AlertingRules, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertingRules_To_core_AlertingRules(in, out, s)
+}
+
+func autoConvert_core_AlertingRules_To_v1beta1_AlertingRules(in *core.AlertingRules, out *AlertingRules, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_core_AlertingRules_To_v1beta1_AlertingRules is an autogenerated conversion function.
+func Convert_core_AlertingRules_To_v1beta1_AlertingRules(in *core.AlertingRules, out *AlertingRules, s conversion.Scope) error {
+	return autoConvert_core_AlertingRules_To_v1beta1_AlertingRules(in, out, s)
+}
+
 func autoConvert_v1beta1_AuditConfig_To_core_AuditConfig(in *AuditConfig, out *core.AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*core.AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
 	return nil
@@ -3389,6 +3575,64 @@ func Convert_core_CoreDNSRewriting_To_v1beta1_CoreDNSRewriting(in *core.CoreDNSR
 	return autoConvert_core_CoreDNSRewriting_To_v1beta1_CoreDNSRewriting(in, out, s)
 }
 
+func autoConvert_v1beta1_CustomHealthCheck_To_core_CustomHealthCheck(in *CustomHealthCheck, out *core.CustomHealthCheck, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_CustomHealthCheckResource_To_core_CustomHealthCheckResource(&in.Resource, &out.Resource, s); err != nil {
+		return err
+	}
+	out.Expression = in.Expression
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1beta1_CustomHealthCheck_To_core_CustomHealthCheck is an autogenerated conversion function.
+func Convert_v1beta1_CustomHealthCheck_To_core_CustomHealthCheck(in *CustomHealthCheck, out *core.CustomHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomHealthCheck_To_core_CustomHealthCheck(in, out, s)
+}
+
+func autoConvert_core_CustomHealthCheck_To_v1beta1_CustomHealthCheck(in *core.CustomHealthCheck, out *CustomHealthCheck, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_CustomHealthCheckResource_To_v1beta1_CustomHealthCheckResource(&in.Resource, &out.Resource, s); err != nil {
+		return err
+	}
+	out.Expression = in.Expression
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_core_CustomHealthCheck_To_v1beta1_CustomHealthCheck is an autogenerated conversion function.
+func Convert_core_CustomHealthCheck_To_v1beta1_CustomHealthCheck(in *core.CustomHealthCheck, out *CustomHealthCheck, s conversion.Scope) error {
+	return autoConvert_core_CustomHealthCheck_To_v1beta1_CustomHealthCheck(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomHealthCheckResource_To_core_CustomHealthCheckResource(in *CustomHealthCheckResource, out *core.CustomHealthCheckResource, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.LabelSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	return nil
+}
+
+// Convert_v1beta1_CustomHealthCheckResource_To_core_CustomHealthCheckResource is an autogenerated conversion function.
+func Convert_v1beta1_CustomHealthCheckResource_To_core_CustomHealthCheckResource(in *CustomHealthCheckResource, out *core.CustomHealthCheckResource, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomHealthCheckResource_To_core_CustomHealthCheckResource(in, out, s)
+}
+
+func autoConvert_core_CustomHealthCheckResource_To_v1beta1_CustomHealthCheckResource(in *core.CustomHealthCheckResource, out *CustomHealthCheckResource, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.LabelSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	return nil
+}
+
+// Convert_core_CustomHealthCheckResource_To_v1beta1_CustomHealthCheckResource is an autogenerated conversion function.
+func Convert_core_CustomHealthCheckResource_To_v1beta1_CustomHealthCheckResource(in *core.CustomHealthCheckResource, out *CustomHealthCheckResource, s conversion.Scope) error {
+	return autoConvert_core_CustomHealthCheckResource_To_v1beta1_CustomHealthCheckResource(in, out, s)
+}
+
 func autoConvert_v1beta1_DNS_To_core_DNS(in *DNS, out *core.DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Providers = *(*[]core.DNSProvider)(unsafe.Pointer(&in.Providers))
@@ -5005,6 +5249,7 @@ func Convert_core_MemorySwapConfiguration_To_v1beta1_MemorySwapConfiguration(in
 
 func autoConvert_v1beta1_Monitoring_To_core_Monitoring(in *Monitoring, out *core.Monitoring, s conversion.Scope) error {
 	out.Alerting = (*core.Alerting)(unsafe.Pointer(in.Alerting))
+	out.RemoteWrites = *(*[]core.MonitoringRemoteWrite)(unsafe.Pointer(&in.RemoteWrites))
 	return nil
 }
 
@@ -5015,6 +5260,7 @@ func Convert_v1beta1_Monitoring_To_core_Monitoring(in *Monitoring, out *core.Mon
 
 func autoConvert_core_Monitoring_To_v1beta1_Monitoring(in *core.Monitoring, out *Monitoring, s conversion.Scope) error {
 	out.Alerting = (*Alerting)(unsafe.Pointer(in.Alerting))
+	out.RemoteWrites = *(*[]MonitoringRemoteWrite)(unsafe.Pointer(&in.RemoteWrites))
 	return nil
 }
 
@@ -5023,6 +5269,64 @@ func Convert_core_Monitoring_To_v1beta1_Monitoring(in *core.Monitoring, out *Mon
 	return autoConvert_core_Monitoring_To_v1beta1_Monitoring(in, out, s)
 }
 
+func autoConvert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite(in *MonitoringRemoteWrite, out *core.MonitoringRemoteWrite, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.BasicAuth = (*core.MonitoringRemoteWriteBasicAuth)(unsafe.Pointer(in.BasicAuth))
+	out.BearerToken = (*core.ResourceSecretKeyReference)(unsafe.Pointer(in.BearerToken))
+	out.Keep = *(*[]string)(unsafe.Pointer(&in.Keep))
+	return nil
+}
+
+// Convert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite is an autogenerated conversion function.
+func Convert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite(in *MonitoringRemoteWrite, out *core.MonitoringRemoteWrite, s conversion.Scope) error {
+	return autoConvert_v1beta1_MonitoringRemoteWrite_To_core_MonitoringRemoteWrite(in, out, s)
+}
+
+func autoConvert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite(in *core.MonitoringRemoteWrite, out *MonitoringRemoteWrite, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.BasicAuth = (*MonitoringRemoteWriteBasicAuth)(unsafe.Pointer(in.BasicAuth))
+	out.BearerToken = (*ResourceSecretKeyReference)(unsafe.Pointer(in.BearerToken))
+	out.Keep = *(*[]string)(unsafe.Pointer(&in.Keep))
+	return nil
+}
+
+// Convert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite is an autogenerated conversion function.
+func Convert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite(in *core.MonitoringRemoteWrite, out *MonitoringRemoteWrite, s conversion.Scope) error {
+	return autoConvert_core_MonitoringRemoteWrite_To_v1beta1_MonitoringRemoteWrite(in, out, s)
+}
+
+func autoConvert_v1beta1_MonitoringRemoteWriteBasicAuth_To_core_MonitoringRemoteWriteBasicAuth(in *MonitoringRemoteWriteBasicAuth, out *core.MonitoringRemoteWriteBasicAuth, s conversion.Scope) error {
+	if err := Convert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(&in.Username, &out.Username, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(&in.Password, &out.Password, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MonitoringRemoteWriteBasicAuth_To_core_MonitoringRemoteWriteBasicAuth is an autogenerated conversion function.
+func Convert_v1beta1_MonitoringRemoteWriteBasicAuth_To_core_MonitoringRemoteWriteBasicAuth(in *MonitoringRemoteWriteBasicAuth, out *core.MonitoringRemoteWriteBasicAuth, s conversion.Scope) error {
+	return autoConvert_v1beta1_MonitoringRemoteWriteBasicAuth_To_core_MonitoringRemoteWriteBasicAuth(in, out, s)
+}
+
+func autoConvert_core_MonitoringRemoteWriteBasicAuth_To_v1beta1_MonitoringRemoteWriteBasicAuth(in *core.MonitoringRemoteWriteBasicAuth, out *MonitoringRemoteWriteBasicAuth, s conversion.Scope) error {
+	if err := Convert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(&in.Username, &out.Username, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(&in.Password, &out.Password, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_MonitoringRemoteWriteBasicAuth_To_v1beta1_MonitoringRemoteWriteBasicAuth is an autogenerated conversion function.
+func Convert_core_MonitoringRemoteWriteBasicAuth_To_v1beta1_MonitoringRemoteWriteBasicAuth(in *core.MonitoringRemoteWriteBasicAuth, out *MonitoringRemoteWriteBasicAuth, s conversion.Scope) error {
+	return autoConvert_core_MonitoringRemoteWriteBasicAuth_To_v1beta1_MonitoringRemoteWriteBasicAuth(in, out, s)
+}
+
 func autoConvert_v1beta1_NamedResourceReference_To_core_NamedResourceReference(in *NamedResourceReference, out *core.NamedResourceReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ResourceRef = in.ResourceRef
@@ -5385,6 +5689,32 @@ func Convert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClie
 	return autoConvert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClientAuthentication(in, out, s)
 }
 
+func autoConvert_v1beta1_PagerDutyAlertingReceiver_To_core_PagerDutyAlertingReceiver(in *PagerDutyAlertingReceiver, out *core.PagerDutyAlertingReceiver, s conversion.Scope) error {
+	if err := Convert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(&in.RoutingKey, &out.RoutingKey, s); err != nil {
+		return err
+	}
+	out.URL = (*string)(unsafe.Pointer(in.URL))
+	return nil
+}
+
+// Convert_v1beta1_PagerDutyAlertingReceiver_To_core_PagerDutyAlertingReceiver is an autogenerated conversion function.
+func Convert_v1beta1_PagerDutyAlertingReceiver_To_core_PagerDutyAlertingReceiver(in *PagerDutyAlertingReceiver, out *core.PagerDutyAlertingReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_PagerDutyAlertingReceiver_To_core_PagerDutyAlertingReceiver(in, out, s)
+}
+
+func autoConvert_core_PagerDutyAlertingReceiver_To_v1beta1_PagerDutyAlertingReceiver(in *core.PagerDutyAlertingReceiver, out *PagerDutyAlertingReceiver, s conversion.Scope) error {
+	if err := Convert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(&in.RoutingKey, &out.RoutingKey, s); err != nil {
+		return err
+	}
+	out.URL = (*string)(unsafe.Pointer(in.URL))
+	return nil
+}
+
+// Convert_core_PagerDutyAlertingReceiver_To_v1beta1_PagerDutyAlertingReceiver is an autogenerated conversion function.
+func Convert_core_PagerDutyAlertingReceiver_To_v1beta1_PagerDutyAlertingReceiver(in *core.PagerDutyAlertingReceiver, out *PagerDutyAlertingReceiver, s conversion.Scope) error {
+	return autoConvert_core_PagerDutyAlertingReceiver_To_v1beta1_PagerDutyAlertingReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_PendingWorkerUpdates_To_core_PendingWorkerUpdates(in *PendingWorkerUpdates, out *core.PendingWorkerUpdates, s conversion.Scope) error {
 	out.AutoInPlaceUpdate = *(*[]string)(unsafe.Pointer(&in.AutoInPlaceUpdate))
 	out.ManualInPlaceUpdate = *(*[]string)(unsafe.Pointer(&in.ManualInPlaceUpdate))
@@ -5778,6 +6108,28 @@ func Convert_core_ResourceData_To_v1beta1_ResourceData(in *core.ResourceData, ou
 	return autoConvert_core_ResourceData_To_v1beta1_ResourceData(in, out, s)
 }
 
+func autoConvert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(in *ResourceSecretKeyReference, out *core.ResourceSecretKeyReference, s conversion.Scope) error {
+	out.ResourceName = in.ResourceName
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference is an autogenerated conversion function.
+func Convert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(in *ResourceSecretKeyReference, out *core.ResourceSecretKeyReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(in, out, s)
+}
+
+func autoConvert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(in *core.ResourceSecretKeyReference, out *ResourceSecretKeyReference, s conversion.Scope) error {
+	out.ResourceName = in.ResourceName
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference is an autogenerated conversion function.
+func Convert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(in *core.ResourceSecretKeyReference, out *ResourceSecretKeyReference, s conversion.Scope) error {
+	return autoConvert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(in, out, s)
+}
+
 func autoConvert_v1beta1_ResourceWatchCacheSize_To_core_ResourceWatchCacheSize(in *ResourceWatchCacheSize, out *core.ResourceWatchCacheSize, s conversion.Scope) error {
 	out.APIGroup = (*string)(unsafe.Pointer(in.APIGroup))
 	out.Resource = in.Resource
@@ -6871,6 +7223,7 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]core.AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.CustomHealthChecks = *(*[]core.CustomHealthCheck)(unsafe.Pointer(&in.CustomHealthChecks))
 	return nil
 }
 
@@ -6908,6 +7261,7 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.CustomHealthChecks = *(*[]CustomHealthCheck)(unsafe.Pointer(&in.CustomHealthChecks))
 	return nil
 }
 
@@ -7050,6 +7404,90 @@ func Convert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, out *
 	return autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootSummary_To_core_ShootSummary(in *ShootSummary, out *core.ShootSummary, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootSummaryStatus_To_core_ShootSummaryStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootSummary_To_core_ShootSummary is an autogenerated conversion function.
+func Convert_v1beta1_ShootSummary_To_core_ShootSummary(in *ShootSummary, out *core.ShootSummary, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootSummary_To_core_ShootSummary(in, out, s)
+}
+
+func autoConvert_core_ShootSummary_To_v1beta1_ShootSummary(in *core.ShootSummary, out *ShootSummary, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootSummaryStatus_To_v1beta1_ShootSummaryStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootSummary_To_v1beta1_ShootSummary is an autogenerated conversion function.
+func Convert_core_ShootSummary_To_v1beta1_ShootSummary(in *core.ShootSummary, out *ShootSummary, s conversion.Scope) error {
+	return autoConvert_core_ShootSummary_To_v1beta1_ShootSummary(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootSummaryList_To_core_ShootSummaryList(in *ShootSummaryList, out *core.ShootSummaryList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ShootSummary)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ShootSummaryList_To_core_ShootSummaryList is an autogenerated conversion function.
+func Convert_v1beta1_ShootSummaryList_To_core_ShootSummaryList(in *ShootSummaryList, out *core.ShootSummaryList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootSummaryList_To_core_ShootSummaryList(in, out, s)
+}
+
+func autoConvert_core_ShootSummaryList_To_v1beta1_ShootSummaryList(in *core.ShootSummaryList, out *ShootSummaryList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootSummary)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_ShootSummaryList_To_v1beta1_ShootSummaryList is an autogenerated conversion function.
+func Convert_core_ShootSummaryList_To_v1beta1_ShootSummaryList(in *core.ShootSummaryList, out *ShootSummaryList, s conversion.Scope) error {
+	return autoConvert_core_ShootSummaryList_To_v1beta1_ShootSummaryList(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootSummaryStatus_To_core_ShootSummaryStatus(in *ShootSummaryStatus, out *core.ShootSummaryStatus, s conversion.Scope) error {
+	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.ProviderType = in.ProviderType
+	out.Purpose = (*core.ShootPurpose)(unsafe.Pointer(in.Purpose))
+	out.KubernetesVersion = in.KubernetesVersion
+	out.GardenerVersion = in.GardenerVersion
+	out.Hibernated = in.Hibernated
+	out.UnhealthyConditions = *(*[]core.ConditionType)(unsafe.Pointer(&in.UnhealthyConditions))
+	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.OperationStartTime = (*metav1.Time)(unsafe.Pointer(in.OperationStartTime))
+	return nil
+}
+
+// Convert_v1beta1_ShootSummaryStatus_To_core_ShootSummaryStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootSummaryStatus_To_core_ShootSummaryStatus(in *ShootSummaryStatus, out *core.ShootSummaryStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootSummaryStatus_To_core_ShootSummaryStatus(in, out, s)
+}
+
+func autoConvert_core_ShootSummaryStatus_To_v1beta1_ShootSummaryStatus(in *core.ShootSummaryStatus, out *ShootSummaryStatus, s conversion.Scope) error {
+	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.ProviderType = in.ProviderType
+	out.Purpose = (*ShootPurpose)(unsafe.Pointer(in.Purpose))
+	out.KubernetesVersion = in.KubernetesVersion
+	out.GardenerVersion = in.GardenerVersion
+	out.Hibernated = in.Hibernated
+	out.UnhealthyConditions = *(*[]ConditionType)(unsafe.Pointer(&in.UnhealthyConditions))
+	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.OperationStartTime = (*metav1.Time)(unsafe.Pointer(in.OperationStartTime))
+	return nil
+}
+
+// Convert_core_ShootSummaryStatus_To_v1beta1_ShootSummaryStatus is an autogenerated conversion function.
+func Convert_core_ShootSummaryStatus_To_v1beta1_ShootSummaryStatus(in *core.ShootSummaryStatus, out *ShootSummaryStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootSummaryStatus_To_v1beta1_ShootSummaryStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootTemplate_To_core_ShootTemplate(in *ShootTemplate, out *core.ShootTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ShootSpec_To_core_ShootSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -7076,6 +7514,32 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 	return autoConvert_core_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_SlackAlertingReceiver_To_core_SlackAlertingReceiver(in *SlackAlertingReceiver, out *core.SlackAlertingReceiver, s conversion.Scope) error {
+	if err := Convert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(&in.APIURL, &out.APIURL, s); err != nil {
+		return err
+	}
+	out.Channel = (*string)(unsafe.Pointer(in.Channel))
+	return nil
+}
+
+// Convert_v1beta1_SlackAlertingReceiver_To_core_SlackAlertingReceiver is an autogenerated conversion function.
+func Convert_v1beta1_SlackAlertingReceiver_To_core_SlackAlertingReceiver(in *SlackAlertingReceiver, out *core.SlackAlertingReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_SlackAlertingReceiver_To_core_SlackAlertingReceiver(in, out, s)
+}
+
+func autoConvert_core_SlackAlertingReceiver_To_v1beta1_SlackAlertingReceiver(in *core.SlackAlertingReceiver, out *SlackAlertingReceiver, s conversion.Scope) error {
+	if err := Convert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(&in.APIURL, &out.APIURL, s); err != nil {
+		return err
+	}
+	out.Channel = (*string)(unsafe.Pointer(in.Channel))
+	return nil
+}
+
+// Convert_core_SlackAlertingReceiver_To_v1beta1_SlackAlertingReceiver is an autogenerated conversion function.
+func Convert_core_SlackAlertingReceiver_To_v1beta1_SlackAlertingReceiver(in *core.SlackAlertingReceiver, out *SlackAlertingReceiver, s conversion.Scope) error {
+	return autoConvert_core_SlackAlertingReceiver_To_v1beta1_SlackAlertingReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(in *StructuredAuthentication, out *core.StructuredAuthentication, s conversion.Scope) error {
 	out.ConfigMapName = in.ConfigMapName
 	return nil
@@ -7294,6 +7758,30 @@ func Convert_core_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in *core.WatchCache
 	return autoConvert_core_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in, out, s)
 }
 
+func autoConvert_v1beta1_WebhookAlertingReceiver_To_core_WebhookAlertingReceiver(in *WebhookAlertingReceiver, out *core.WebhookAlertingReceiver, s conversion.Scope) error {
+	if err := Convert_v1beta1_ResourceSecretKeyReference_To_core_ResourceSecretKeyReference(&in.URL, &out.URL, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_WebhookAlertingReceiver_To_core_WebhookAlertingReceiver is an autogenerated conversion function.
+func Convert_v1beta1_WebhookAlertingReceiver_To_core_WebhookAlertingReceiver(in *WebhookAlertingReceiver, out *core.WebhookAlertingReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_WebhookAlertingReceiver_To_core_WebhookAlertingReceiver(in, out, s)
+}
+
+func autoConvert_core_WebhookAlertingReceiver_To_v1beta1_WebhookAlertingReceiver(in *core.WebhookAlertingReceiver, out *WebhookAlertingReceiver, s conversion.Scope) error {
+	if err := Convert_core_ResourceSecretKeyReference_To_v1beta1_ResourceSecretKeyReference(&in.URL, &out.URL, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_WebhookAlertingReceiver_To_v1beta1_WebhookAlertingReceiver is an autogenerated conversion function.
+func Convert_core_WebhookAlertingReceiver_To_v1beta1_WebhookAlertingReceiver(in *core.WebhookAlertingReceiver, out *WebhookAlertingReceiver, s conversion.Scope) error {
+	return autoConvert_core_WebhookAlertingReceiver_To_v1beta1_WebhookAlertingReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
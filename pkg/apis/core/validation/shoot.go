@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"net/netip"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -39,6 +40,8 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/customhealthchecks"
+	"github.com/gardener/gardener/pkg/utils/gardener/metricnames"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
 	admissionpluginsvalidation "github.com/gardener/gardener/pkg/utils/validation/admissionplugins"
@@ -125,6 +128,14 @@ var (
 		v1beta1constants.ReferenceProtectionFinalizerName,
 	)
 	availableUpdateStrategies = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
+	// reservedAlertingReceiverNames are the names of the receivers which are configured by Gardener in the shoot
+	// Alertmanager.
+	reservedAlertingReceiverNames = sets.New("dev-null", "email-kubernetes-ops")
+	availableAlertSeverities      = sets.New("info", "warning", "critical", "blocker")
+	alertComponentRegex           = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	// sharedAddressSpace is the carrier-grade NAT range (RFC 6598) which is used for cluster networks as well.
+	sharedAddressSpace   = netip.MustParsePrefix("100.64.0.0/10")
+	internalHostSuffixes = []string{".localhost", ".local", ".internal", ".svc"}
 
 	// asymmetric algorithms from https://datatracker.ietf.org/doc/html/rfc7518#section-3.1
 	availableOIDCSigningAlgs = sets.New(
@@ -150,6 +161,7 @@ func ValidateShoot(shoot *core.Shoot) field.ErrorList {
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shoot.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateNameConsecutiveHyphens(shoot.Name, field.NewPath("metadata", "name"))...)
 	allErrs = append(allErrs, validateShootOperation(shoot.Annotations[v1beta1constants.GardenerOperation], shoot.Annotations[v1beta1constants.GardenerMaintenanceOperation], shoot, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateLoggingRetentionPeriod(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, ValidateShootSpec(shoot.ObjectMeta, &shoot.Spec, field.NewPath("spec"), false)...)
 	allErrs = append(allErrs, ValidateShootHAConfig(shoot)...)
 
@@ -246,7 +258,8 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, workerless, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
-	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
+	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, spec.Resources, fldPath.Child("monitoring"))...)
+	allErrs = append(allErrs, validateCustomHealthChecks(spec.CustomHealthChecks, fldPath.Child("customHealthChecks"))...)
 	allErrs = append(allErrs, ValidateHibernation(meta.Annotations, spec.Hibernation, fldPath.Child("hibernation"))...)
 
 	if len(spec.Region) == 0 {
@@ -1775,15 +1788,18 @@ func validateKubeProxy(kp *core.KubeProxyConfig, version string, fldPath *field.
 	return allErrs
 }
 
-func validateMonitoring(monitoring *core.Monitoring, fldPath *field.Path) field.ErrorList {
+func validateMonitoring(monitoring *core.Monitoring, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if monitoring != nil && monitoring.Alerting != nil {
-		allErrs = append(allErrs, validateAlerting(monitoring.Alerting, fldPath.Child("alerting"))...)
+		allErrs = append(allErrs, validateAlerting(monitoring.Alerting, resources, fldPath.Child("alerting"))...)
+	}
+	if monitoring != nil {
+		allErrs = append(allErrs, validateMonitoringRemoteWrites(monitoring.RemoteWrites, resources, fldPath.Child("remoteWrites"))...)
 	}
 	return allErrs
 }
 
-func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorList {
+func validateAlerting(alerting *core.Alerting, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	emails := sets.New[string]()
 	for i, email := range alerting.EmailReceivers {
@@ -1797,6 +1813,265 @@ func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorL
 			emails.Insert(email)
 		}
 	}
+	allErrs = append(allErrs, validateAlertingReceivers(alerting.Receivers, resources, fldPath.Child("receivers"))...)
+	if alerting.Rules != nil && len(alerting.Rules.ConfigMapName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("rules", "configMapName"), "must provide a name"))
+	}
+	return allErrs
+}
+
+// maxAlertingReceivers is the maximum number of additional alert receivers which can be configured for a Shoot.
+const maxAlertingReceivers = 10
+
+func validateAlertingReceivers(receivers []core.AlertingReceiver, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	if len(receivers) > maxAlertingReceivers {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(receivers), maxAlertingReceivers))
+	}
+
+	for i, receiver := range receivers {
+		idxPath := fldPath.Index(i)
+
+		if receiver.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(receiver.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), receiver.Name, msg))
+			}
+			if reservedAlertingReceiverNames.Has(receiver.Name) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("name %q is reserved", receiver.Name)))
+			} else if names.Has(receiver.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), receiver.Name))
+			}
+			names.Insert(receiver.Name)
+		}
+
+		for j, severity := range receiver.Severities {
+			if !availableAlertSeverities.Has(severity) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("severities").Index(j), severity, sets.List(availableAlertSeverities)))
+			}
+		}
+
+		for j, component := range receiver.Components {
+			if !alertComponentRegex.MatchString(component) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("components").Index(j), component, "must consist of lower case alphanumeric characters or '-'"))
+			}
+		}
+
+		var configured int
+		if receiver.Webhook != nil {
+			configured++
+			allErrs = append(allErrs, validateResourceSecretKeyReference(receiver.Webhook.URL, resources, idxPath.Child("webhook", "url"))...)
+		}
+		if receiver.Slack != nil {
+			configured++
+			allErrs = append(allErrs, validateResourceSecretKeyReference(receiver.Slack.APIURL, resources, idxPath.Child("slack", "apiURL"))...)
+		}
+		if receiver.PagerDuty != nil {
+			configured++
+			allErrs = append(allErrs, validateResourceSecretKeyReference(receiver.PagerDuty.RoutingKey, resources, idxPath.Child("pagerDuty", "routingKey"))...)
+			if receiver.PagerDuty.URL != nil {
+				if err := ValidateAlertingReceiverURL(*receiver.PagerDuty.URL); err != nil {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("pagerDuty", "url"), *receiver.PagerDuty.URL, err.Error()))
+				}
+			}
+		}
+		if configured != 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath, receiver.Name, "exactly one of webhook, slack or pagerDuty must be set"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateResourceSecretKeyReference(ref core.ResourceSecretKeyReference, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ref.ResourceName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceName"), "must provide a resource name"))
+	} else if helper.GetReferencedSecretName(resources, ref.ResourceName) == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceName"), ref.ResourceName, "must refer to a Secret in the .spec.resources of the Shoot"))
+	}
+
+	if ref.Key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "must provide a key"))
+	}
+
+	return allErrs
+}
+
+// ValidateAlertingReceiverURL validates that the given URL of an alert receiver uses the https scheme and does not point
+// to a private, loopback or link-local address or to a cluster-internal host name. The Alertmanager runs in the seed
+// cluster, hence, such URLs could be used by shoot owners to reach endpoints which are not meant to be accessible.
+func ValidateAlertingReceiverURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if u.Scheme != "https" {
+		return fmt.Errorf("URL must use the https scheme")
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "" {
+		return fmt.Errorf("URL must contain a host")
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addr = addr.Unmap()
+		if !addr.IsGlobalUnicast() || addr.IsPrivate() || sharedAddressSpace.Contains(addr) {
+			return fmt.Errorf("URL must not point to a private, loopback or link-local address")
+		}
+		return nil
+	}
+
+	if !strings.Contains(host, ".") || slices.ContainsFunc(internalHostSuffixes, func(suffix string) bool { return strings.HasSuffix(host, suffix) }) {
+		return fmt.Errorf("URL must not point to a cluster-internal host")
+	}
+
+	return nil
+}
+
+const (
+	// maxMonitoringRemoteWrites is the maximum number of remote write targets which can be configured for a Shoot.
+	maxMonitoringRemoteWrites = 10
+	// maxMonitoringRemoteWriteKeptMetrics is the maximum number of metric names which can be forwarded to a remote write
+	// target. Seed operators can restrict this further in the gardenlet configuration.
+	maxMonitoringRemoteWriteKeptMetrics = 1000
+)
+
+func validateMonitoringRemoteWrites(remoteWrites []core.MonitoringRemoteWrite, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	if len(remoteWrites) > maxMonitoringRemoteWrites {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(remoteWrites), maxMonitoringRemoteWrites))
+	}
+
+	for i, remoteWrite := range remoteWrites {
+		idxPath := fldPath.Index(i)
+
+		if remoteWrite.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(remoteWrite.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), remoteWrite.Name, msg))
+			}
+			if names.Has(remoteWrite.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), remoteWrite.Name))
+			}
+			names.Insert(remoteWrite.Name)
+		}
+
+		if u, err := url.Parse(remoteWrite.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), remoteWrite.URL, "must be an https URL"))
+		}
+
+		if remoteWrite.BasicAuth != nil && remoteWrite.BearerToken != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("bearerToken"), "must not be set together with basicAuth"))
+		}
+		if remoteWrite.BasicAuth != nil {
+			allErrs = append(allErrs, validateResourceSecretKeyReference(remoteWrite.BasicAuth.Username, resources, idxPath.Child("basicAuth", "username"))...)
+			allErrs = append(allErrs, validateResourceSecretKeyReference(remoteWrite.BasicAuth.Password, resources, idxPath.Child("basicAuth", "password"))...)
+		}
+		if remoteWrite.BearerToken != nil {
+			allErrs = append(allErrs, validateResourceSecretKeyReference(*remoteWrite.BearerToken, resources, idxPath.Child("bearerToken"))...)
+		}
+
+		keepPath := idxPath.Child("keep")
+		if len(remoteWrite.Keep) == 0 {
+			allErrs = append(allErrs, field.Required(keepPath, "must provide the metrics to forward"))
+		}
+
+		keptMetrics := 0
+		for j, metric := range remoteWrite.Keep {
+			if metric == "" {
+				allErrs = append(allErrs, field.Required(keepPath.Index(j), "must not be empty"))
+				continue
+			}
+
+			count, err := metricnames.Count(metric, maxMonitoringRemoteWriteKeptMetrics+1)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(keepPath.Index(j), metric, err.Error()))
+				continue
+			}
+			keptMetrics += count
+		}
+
+		if keptMetrics > maxMonitoringRemoteWriteKeptMetrics {
+			allErrs = append(allErrs, field.Invalid(keepPath, keptMetrics, fmt.Sprintf("must not match more than %d metric names", maxMonitoringRemoteWriteKeptMetrics)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCustomHealthChecks(checks []core.CustomHealthCheck, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	if len(checks) > customhealthchecks.MaxChecks {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(checks), customhealthchecks.MaxChecks))
+	}
+
+	for i, check := range checks {
+		idxPath := fldPath.Index(i)
+
+		if check.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(check.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), check.Name))
+		}
+		names.Insert(check.Name)
+
+		allErrs = append(allErrs, validateCustomHealthCheckResource(check.Resource, idxPath.Child("resource"))...)
+
+		if check.Expression == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("expression"), "must provide an expression"))
+		} else if _, err := customhealthchecks.Compile(check.Expression); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("expression"), check.Expression, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCustomHealthCheckResource(resource core.CustomHealthCheckResource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if resource.APIVersion == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiVersion"), "must provide an apiVersion"))
+	} else if _, err := schema.ParseGroupVersion(resource.APIVersion); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("apiVersion"), resource.APIVersion, err.Error()))
+	}
+	if resource.Kind == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kind"), "must provide a kind"))
+	}
+	if resource.Namespace != nil && *resource.Namespace == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), *resource.Namespace, "must not be empty if set"))
+	}
+	if (ptr.Deref(resource.Name, "") == "") == (resource.LabelSelector == nil) {
+		allErrs = append(allErrs, field.Invalid(fldPath, resource, "exactly one of name or labelSelector must be set"))
+	}
+
+	if resource.LabelSelector != nil {
+		// Listing all objects of a kind in the whole cluster is expensive, hence the objects must be narrowed down.
+		if resource.Namespace == nil && len(resource.LabelSelector.MatchLabels) == 0 && len(resource.LabelSelector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("labelSelector"), resource.LabelSelector, "must not be empty if no namespace is set"))
+		} else {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(resource.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("labelSelector"))...)
+		}
+	}
+
 	return allErrs
 }
 
@@ -2747,6 +3022,26 @@ func ValidateCoreDNSRewritingCommonSuffixes(commonSuffixes []string, fldPath *fi
 	return allErrs
 }
 
+func validateLoggingRetentionPeriod(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	value, ok := annotations[v1beta1constants.AnnotationShootLoggingRetentionPeriod]
+	if !ok {
+		return allErrs
+	}
+
+	idxPath := fldPath.Key(v1beta1constants.AnnotationShootLoggingRetentionPeriod)
+	retentionPeriod, err := time.ParseDuration(value)
+	if err != nil {
+		return append(allErrs, field.Invalid(idxPath, value, fmt.Sprintf("not a valid duration: %v", err)))
+	}
+	if retentionPeriod < 24*time.Hour || retentionPeriod%(24*time.Hour) != 0 {
+		allErrs = append(allErrs, field.Invalid(idxPath, value, "must be a positive multiple of 24h"))
+	}
+
+	return allErrs
+}
+
 func validateShootOperation(operation, maintenanceOperation string, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs            = field.ErrorList{}
@@ -1022,6 +1022,250 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		Context("alert receivers", func() {
+			BeforeEach(func() {
+				shoot.Spec.Resources = []core.NamedResourceReference{
+					{Name: "slack", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "slack-credentials"}},
+					{Name: "config", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "config"}},
+				}
+			})
+
+			It("should allow valid alert receivers", func() {
+				shoot.Spec.Monitoring.Alerting.Receivers = []core.AlertingReceiver{
+					{
+						Name:       "slack",
+						Severities: []string{"critical", "blocker"},
+						Components: []string{"kube-apiserver"},
+						Slack:      &core.SlackAlertingReceiver{APIURL: core.ResourceSecretKeyReference{ResourceName: "slack", Key: "url"}, Channel: ptr.To("#alerts")},
+					},
+					{
+						Name:      "pagerduty",
+						PagerDuty: &core.PagerDutyAlertingReceiver{RoutingKey: core.ResourceSecretKeyReference{ResourceName: "slack", Key: "routingKey"}, URL: ptr.To("https://events.example.com/v2/enqueue")},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid alert receivers", func() {
+				shoot.Spec.Monitoring.Alerting.Receivers = []core.AlertingReceiver{
+					{
+						Name:       "dev-null",
+						Severities: []string{"urgent"},
+						Components: []string{"Kube_APIServer"},
+						Webhook:    &core.WebhookAlertingReceiver{URL: core.ResourceSecretKeyReference{ResourceName: "config", Key: "url"}},
+					},
+					{
+						Name: "foo",
+					},
+					{
+						Name:      "foo",
+						Slack:     &core.SlackAlertingReceiver{APIURL: core.ResourceSecretKeyReference{ResourceName: "slack"}},
+						PagerDuty: &core.PagerDutyAlertingReceiver{RoutingKey: core.ResourceSecretKeyReference{ResourceName: "unknown", Key: "key"}, URL: ptr.To("https://10.0.0.1/events")},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.monitoring.alerting.receivers[0].name"),
+						"Detail": Equal(`name "dev-null" is reserved`),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.monitoring.alerting.receivers[0].severities[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.monitoring.alerting.receivers[0].components[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.monitoring.alerting.receivers[0].webhook.url.resourceName"),
+						"Detail": Equal("must refer to a Secret in the .spec.resources of the Shoot"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.monitoring.alerting.receivers[1]"),
+						"Detail": Equal("exactly one of webhook, slack or pagerDuty must be set"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.monitoring.alerting.receivers[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.monitoring.alerting.receivers[2].slack.apiURL.key"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.monitoring.alerting.receivers[2].pagerDuty.routingKey.resourceName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.monitoring.alerting.receivers[2].pagerDuty.url"),
+						"Detail": Equal("URL must not point to a private, loopback or link-local address"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.monitoring.alerting.receivers[2]"),
+						"Detail": Equal("exactly one of webhook, slack or pagerDuty must be set"),
+					})),
+				))
+			})
+
+			It("should forbid too many alert receivers", func() {
+				for i := range 11 {
+					shoot.Spec.Monitoring.Alerting.Receivers = append(shoot.Spec.Monitoring.Alerting.Receivers, core.AlertingReceiver{
+						Name:  fmt.Sprintf("receiver-%d", i),
+						Slack: &core.SlackAlertingReceiver{APIURL: core.ResourceSecretKeyReference{ResourceName: "slack", Key: "url"}},
+					})
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooMany),
+						"Field": Equal("spec.monitoring.alerting.receivers"),
+					})),
+				))
+			})
+		})
+
+		It("should require the name of the ConfigMap containing the alerting rules", func() {
+			shoot.Spec.Monitoring.Alerting.Rules = &core.AlertingRules{}
+
+			Expect(ValidateShoot(shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.monitoring.alerting.rules.configMapName"),
+				})),
+			))
+		})
+
+		Context("remote write targets", func() {
+			BeforeEach(func() {
+				shoot.Spec.Resources = []core.NamedResourceReference{
+					{Name: "credentials", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "remote-write-credentials"}},
+				}
+			})
+
+			It("should allow valid remote write targets", func() {
+				shoot.Spec.Monitoring.RemoteWrites = []core.MonitoringRemoteWrite{
+					{
+						Name: "thanos",
+						URL:  "https://thanos.example.com/api/v1/receive",
+						BasicAuth: &core.MonitoringRemoteWriteBasicAuth{
+							Username: core.ResourceSecretKeyReference{ResourceName: "credentials", Key: "username"},
+							Password: core.ResourceSecretKeyReference{ResourceName: "credentials", Key: "password"},
+						},
+						Keep: []string{"apiserver_request_total", "etcd_(disk_wal_fsync|disk_backend_commit)_duration_seconds_bucket"},
+					},
+					{
+						Name:        "mimir",
+						URL:         "https://mimir.example.com/api/v1/push",
+						BearerToken: &core.ResourceSecretKeyReference{ResourceName: "credentials", Key: "token"},
+						Keep:        []string{"up"},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid remote write targets", func() {
+				shoot.Spec.Monitoring.RemoteWrites = []core.MonitoringRemoteWrite{
+					{
+						Name:        "Thanos",
+						URL:         "http://thanos.example.com/api/v1/receive",
+						BearerToken: &core.ResourceSecretKeyReference{ResourceName: "credentials", Key: "token"},
+						BasicAuth: &core.MonitoringRemoteWriteBasicAuth{
+							Username: core.ResourceSecretKeyReference{ResourceName: "unknown", Key: "username"},
+							Password: core.ResourceSecretKeyReference{ResourceName: "credentials"},
+						},
+					},
+					{
+						Name: "mimir",
+						URL:  "https://mimir.example.com/api/v1/push",
+						Keep: []string{"", "etcd_.+", "("},
+					},
+					{
+						Name: "mimir",
+						URL:  "https://mimir.example.com/api/v1/push",
+						Keep: []string{"[a-z][a-z]", "[a-z][a-z]"},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.monitoring.remoteWrites[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.monitoring.remoteWrites[0].url"),
+						"Detail": Equal("must be an https URL"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.monitoring.remoteWrites[0].bearerToken"),
+						"Detail": Equal("must not be set together with basicAuth"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.monitoring.remoteWrites[0].basicAuth.username.resourceName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.monitoring.remoteWrites[0].basicAuth.password.key"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeRequired),
+						"Field":  Equal("spec.monitoring.remoteWrites[0].keep"),
+						"Detail": Equal("must provide the metrics to forward"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.monitoring.remoteWrites[1].keep[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.monitoring.remoteWrites[1].keep[1]"),
+						"Detail": ContainSubstring("wildcards, anchors and unbounded repetitions are not supported"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.monitoring.remoteWrites[1].keep[2]"),
+						"Detail": ContainSubstring("must be a valid regular expression"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.monitoring.remoteWrites[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.monitoring.remoteWrites[2].keep"),
+						"Detail": Equal("must not match more than 1000 metric names"),
+					})),
+				))
+			})
+
+			It("should forbid too many remote write targets", func() {
+				for i := range 11 {
+					shoot.Spec.Monitoring.RemoteWrites = append(shoot.Spec.Monitoring.RemoteWrites, core.MonitoringRemoteWrite{
+						Name: fmt.Sprintf("target-%d", i),
+						URL:  "https://thanos.example.com/api/v1/receive",
+						Keep: []string{"up"},
+					})
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooMany),
+						"Field": Equal("spec.monitoring.remoteWrites"),
+					})),
+				))
+			})
+		})
+
 		It("should forbid invalid tolerations", func() {
 			shoot.Spec.Tolerations = []core.Toleration{
 				{},
@@ -1192,6 +1436,139 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
+		Context("CustomHealthChecks validation", func() {
+			It("should allow valid custom health checks", func() {
+				shoot.Spec.CustomHealthChecks = []core.CustomHealthCheck{
+					{
+						Name: "ingress-ready",
+						Resource: core.CustomHealthCheckResource{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Namespace:  ptr.To("ingress"),
+							Name:       ptr.To("ingress-controller"),
+						},
+						Expression: "object.status.readyReplicas >= 2",
+						Message:    ptr.To("Less than two ingress controller replicas are ready."),
+					},
+					{
+						Name: "pods-running",
+						Resource: core.CustomHealthCheckResource{
+							APIVersion:    "v1",
+							Kind:          "Pod",
+							Namespace:     ptr.To("foo"),
+							LabelSelector: &metav1.LabelSelector{},
+						},
+						Expression: "object.status.phase == 'Running'",
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid custom health checks", func() {
+				shoot.Spec.CustomHealthChecks = []core.CustomHealthCheck{
+					{
+						Name: "foo",
+						Resource: core.CustomHealthCheckResource{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+						},
+						Expression: "object.data.foo == 'bar'",
+					},
+					{
+						Name: "foo",
+						Resource: core.CustomHealthCheckResource{
+							Kind:          "ConfigMap",
+							Name:          ptr.To("bar"),
+							LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						},
+						Expression: "'foo' +",
+					},
+					{
+						Name: "bar",
+						Resource: core.CustomHealthCheckResource{
+							APIVersion:    "v1",
+							Kind:          "Pod",
+							LabelSelector: &metav1.LabelSelector{},
+						},
+						Expression: "'foo'",
+					},
+					{
+						Resource: core.CustomHealthCheckResource{
+							APIVersion: "v1",
+							Namespace:  ptr.To(""),
+							Name:       ptr.To("bar"),
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.customHealthChecks[0].resource"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.customHealthChecks[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customHealthChecks[1].resource.apiVersion"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.customHealthChecks[1].resource"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.customHealthChecks[1].expression"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.customHealthChecks[2].resource.labelSelector"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.customHealthChecks[2].expression"),
+						"Detail": ContainSubstring("expression must evaluate to bool"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customHealthChecks[3].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customHealthChecks[3].resource.kind"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.customHealthChecks[3].resource.namespace"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customHealthChecks[3].expression"),
+					})),
+				))
+			})
+
+			It("should forbid too many custom health checks", func() {
+				for i := range 21 {
+					shoot.Spec.CustomHealthChecks = append(shoot.Spec.CustomHealthChecks, core.CustomHealthCheck{
+						Name:       fmt.Sprintf("check-%d", i),
+						Resource:   core.CustomHealthCheckResource{APIVersion: "v1", Kind: "Node", Name: ptr.To("foo")},
+						Expression: "true",
+					})
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooMany),
+						"Field": Equal("spec.customHealthChecks"),
+					})),
+				))
+			})
+		})
+
 		Context("Resources validation", func() {
 			It("should forbid resources w/o names or w/ invalid references", func() {
 				ref := core.NamedResourceReference{}
@@ -4373,6 +4750,37 @@ var _ = Describe("Shoot Validation Tests", func() {
 			)
 		})
 
+		DescribeTable("logging retention period annotation",
+			func(value string, matcher gomegatypes.GomegaMatcher) {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/logging-retention-period", value)
+
+				Expect(ValidateShoot(shoot)).To(matcher)
+			},
+
+			Entry("should allow a multiple of 24h", "72h", BeEmpty()),
+			Entry("should forbid an unparsable duration", "3d", ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("metadata.annotations[shoot.gardener.cloud/logging-retention-period]"),
+					"Detail": ContainSubstring("not a valid duration"),
+				})),
+			)),
+			Entry("should forbid a duration shorter than 24h", "12h", ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("metadata.annotations[shoot.gardener.cloud/logging-retention-period]"),
+					"Detail": Equal("must be a positive multiple of 24h"),
+				})),
+			)),
+			Entry("should forbid a duration which is not a multiple of 24h", "36h", ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("metadata.annotations[shoot.gardener.cloud/logging-retention-period]"),
+					"Detail": Equal("must be a positive multiple of 24h"),
+				})),
+			)),
+		)
+
 		Context("operation validation", func() {
 			It("should do nothing if the operation annotation is not set", func() {
 				Expect(ValidateShoot(shoot)).To(BeEmpty())
@@ -8281,6 +8689,28 @@ var _ = Describe("Shoot Validation Tests", func() {
 		)
 	})
 
+	DescribeTable("#ValidateAlertingReceiverURL",
+		func(rawURL string, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateAlertingReceiverURL(rawURL)).To(matcher)
+		},
+
+		Entry("public host", "https://hooks.example.com/services/foo", Succeed()),
+		Entry("public IP address", "https://203.0.113.10:8443/alerts", Succeed()),
+		Entry("public IPv6 address", "https://[2001:db8::1]/alerts", Succeed()),
+		Entry("http scheme", "http://hooks.example.com", MatchError("URL must use the https scheme")),
+		Entry("missing host", "https:///alerts", MatchError("URL must contain a host")),
+		Entry("private IP address", "https://10.0.0.1/alerts", MatchError(ContainSubstring("must not point to a private"))),
+		Entry("loopback IP address", "https://127.0.0.1/alerts", MatchError(ContainSubstring("must not point to a private"))),
+		Entry("link-local IP address", "https://169.254.169.254/latest/meta-data", MatchError(ContainSubstring("must not point to a private"))),
+		Entry("shared address space", "https://100.64.0.10/alerts", MatchError(ContainSubstring("must not point to a private"))),
+		Entry("IPv4-mapped IPv6 address", "https://[::ffff:10.0.0.1]/alerts", MatchError(ContainSubstring("must not point to a private"))),
+		Entry("unique local IPv6 address", "https://[fd00::1]/alerts", MatchError(ContainSubstring("must not point to a private"))),
+		Entry("single-label host", "https://kube-apiserver/alerts", MatchError("URL must not point to a cluster-internal host")),
+		Entry("localhost", "https://localhost/alerts", MatchError("URL must not point to a cluster-internal host")),
+		Entry("service host", "https://prometheus-shoot.shoot--foo--bar.svc/alerts", MatchError("URL must not point to a cluster-internal host")),
+		Entry("cluster-local host", "https://prometheus-shoot.shoot--foo--bar.svc.cluster.local./alerts", MatchError("URL must not point to a cluster-internal host")),
+	)
+
 	Describe("#ValidateControlPlaneAutoscaling", func() {
 		It("should succeed for valid resources", func() {
 			autoscaling := &core.ControlPlaneAutoscaling{
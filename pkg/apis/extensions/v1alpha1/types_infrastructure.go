@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var _ Object = (*Infrastructure)(nil)
@@ -70,6 +71,13 @@ type InfrastructureSpec struct {
 	// SSHPublicKey is the public SSH key that should be used with this infrastructure.
 	// +optional
 	SSHPublicKey []byte `json:"sshPublicKey,omitempty"`
+	// PlannedProviderConfig is the provider specific configuration the changes shall be planned for if the
+	// Infrastructure is annotated with `gardener.cloud/operation=plan`. It is not applied by reconciliations, i.e., the
+	// rest of the spec is only changed once the planned changes have been approved.
+	// +kubebuilder:validation:XPreserveUnknownFields
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PlannedProviderConfig *runtime.RawExtension `json:"plannedProviderConfig,omitempty"`
 }
 
 // InfrastructureStatus is the status for an Infrastructure resource.
@@ -89,8 +97,49 @@ type InfrastructureStatus struct {
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *InfrastructureStatusNetworking `json:"networking,omitempty"`
+	// PlannedChanges contains the changes which the extension controller would apply to the infrastructure during the
+	// next reconciliation. It is only set if a plan was requested with the `gardener.cloud/operation=plan` annotation
+	// and the extension controller supports planning.
+	// +optional
+	PlannedChanges *InfrastructurePlannedChanges `json:"plannedChanges,omitempty"`
+}
+
+// InfrastructurePlannedChanges contains the changes which would be applied to the infrastructure.
+type InfrastructurePlannedChanges struct {
+	// ObservedGeneration is the generation of the Infrastructure the changes were planned for.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// LastUpdateTime is the time when the changes were planned.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Changes is the list of planned changes. It is empty if the infrastructure is up-to-date.
+	// +optional
+	Changes []InfrastructureChange `json:"changes,omitempty"`
 }
 
+// InfrastructureChange is a planned change of a single infrastructure resource.
+type InfrastructureChange struct {
+	// Resource identifies the infrastructure resource, e.g. `aws_subnet.nodes_z0`.
+	Resource string `json:"resource"`
+	// Action is the action which would be performed for the resource.
+	Action InfrastructureChangeAction `json:"action"`
+	// Reason optionally describes why the change is required, e.g. the attributes forcing a replacement.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
+}
+
+// InfrastructureChangeAction is the action which would be performed for an infrastructure resource.
+type InfrastructureChangeAction string
+
+const (
+	// InfrastructureChangeActionCreate indicates that the resource would be created.
+	InfrastructureChangeActionCreate InfrastructureChangeAction = "Create"
+	// InfrastructureChangeActionUpdate indicates that the resource would be updated in-place.
+	InfrastructureChangeActionUpdate InfrastructureChangeAction = "Update"
+	// InfrastructureChangeActionReplace indicates that the resource would be deleted and recreated.
+	InfrastructureChangeActionReplace InfrastructureChangeAction = "Replace"
+	// InfrastructureChangeActionDelete indicates that the resource would be deleted.
+	InfrastructureChangeActionDelete InfrastructureChangeAction = "Delete"
+)
+
 // InfrastructureStatusNetworking is a structure containing information about the node, service and pod network ranges.
 type InfrastructureStatusNetworking struct {
 	// Pods are the CIDRs of the pod network.
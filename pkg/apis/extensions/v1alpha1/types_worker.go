@@ -258,6 +258,13 @@ type WorkerStatus struct {
 	// InPlaceUpdates contains the status for in-place updates.
 	// +optional
 	InPlaceUpdates *InPlaceUpdatesWorkerStatus `json:"inPlaceUpdates,omitempty"`
+	// OperatingSystemConfigRollout contains the rollout status of the operating system config per worker pool. It is
+	// maintained by gardenlet based on the results reported by gardener-node-agent on the nodes, i.e., extensions must
+	// not modify it.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	OperatingSystemConfigRollout []WorkerPoolOperatingSystemConfigRollout `json:"operatingSystemConfigRollout,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 }
 
 // InPlaceUpdatesWorkerStatus contains the configuration for in-place updates.
@@ -267,6 +274,34 @@ type InPlaceUpdatesWorkerStatus struct {
 	WorkerPoolToHashMap map[string]string `json:"workerPoolToHashMap,omitempty"`
 }
 
+// WorkerPoolOperatingSystemConfigRollout describes the rollout of the operating system config of a worker pool.
+type WorkerPoolOperatingSystemConfigRollout struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// Nodes is the number of nodes of the worker pool which are not about to be deleted.
+	Nodes int32 `json:"nodes"`
+	// UpToDate is the number of nodes which have successfully applied the desired operating system config.
+	UpToDate int32 `json:"upToDate"`
+	// Pending is the number of nodes which have neither applied the desired operating system config nor reported a
+	// failure.
+	Pending int32 `json:"pending"`
+	// Failures contains the reasons reported by gardener-node-agent for failing to apply the desired operating system
+	// config together with the number of affected nodes.
+	// +optional
+	Failures []OperatingSystemConfigRolloutFailure `json:"failures,omitempty"`
+	// LastUpdateTime is the timestamp when the rollout status was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// OperatingSystemConfigRolloutFailure describes nodes which failed to apply the desired operating system config for
+// the same reason.
+type OperatingSystemConfigRolloutFailure struct {
+	// Reason is the reason reported by gardener-node-agent.
+	Reason string `json:"reason"`
+	// Nodes is the number of affected nodes.
+	Nodes int32 `json:"nodes"`
+}
+
 // MachineDeployment is a created machine deployment.
 type MachineDeployment struct {
 	// Name is the name of the `MachineDeployment` resource.
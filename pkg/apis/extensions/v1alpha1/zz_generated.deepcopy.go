@@ -1332,6 +1332,27 @@ func (in *Infrastructure) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureChange) DeepCopyInto(out *InfrastructureChange) {
+	*out = *in
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureChange.
+func (in *InfrastructureChange) DeepCopy() *InfrastructureChange {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureList) DeepCopyInto(out *InfrastructureList) {
 	*out = *in
@@ -1365,6 +1386,30 @@ func (in *InfrastructureList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructurePlannedChanges) DeepCopyInto(out *InfrastructurePlannedChanges) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]InfrastructureChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructurePlannedChanges.
+func (in *InfrastructurePlannedChanges) DeepCopy() *InfrastructurePlannedChanges {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructurePlannedChanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureSpec) DeepCopyInto(out *InfrastructureSpec) {
 	*out = *in
@@ -1375,6 +1420,11 @@ func (in *InfrastructureSpec) DeepCopyInto(out *InfrastructureSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.PlannedProviderConfig != nil {
+		in, out := &in.PlannedProviderConfig, &out.PlannedProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1407,6 +1457,11 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 		*out = new(InfrastructureStatusNetworking)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PlannedChanges != nil {
+		in, out := &in.PlannedChanges, &out.PlannedChanges
+		*out = new(InfrastructurePlannedChanges)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1700,6 +1755,22 @@ func (in *OperatingSystemConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemConfigRolloutFailure) DeepCopyInto(out *OperatingSystemConfigRolloutFailure) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemConfigRolloutFailure.
+func (in *OperatingSystemConfigRolloutFailure) DeepCopy() *OperatingSystemConfigRolloutFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemConfigRolloutFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec) {
 	*out = *in
@@ -2133,6 +2204,28 @@ func (in *WorkerPool) DeepCopy() *WorkerPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolOperatingSystemConfigRollout) DeepCopyInto(out *WorkerPoolOperatingSystemConfigRollout) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]OperatingSystemConfigRolloutFailure, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolOperatingSystemConfigRollout.
+func (in *WorkerPoolOperatingSystemConfigRollout) DeepCopy() *WorkerPoolOperatingSystemConfigRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolOperatingSystemConfigRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 	*out = *in
@@ -2186,6 +2279,13 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 		*out = new(InPlaceUpdatesWorkerStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OperatingSystemConfigRollout != nil {
+		in, out := &in.OperatingSystemConfigRollout, &out.OperatingSystemConfigRollout
+		*out = make([]WorkerPoolOperatingSystemConfigRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -32,6 +32,7 @@ import (
 	seedstore "github.com/gardener/gardener/pkg/apiserver/registry/core/seed/storage"
 	shootstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot/storage"
 	shootstatestore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootstate/storage"
+	shootsummarystore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootsummary/storage"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 )
 
@@ -131,5 +132,7 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 	storage["shoots/viewerkubeconfig"] = shootStorage.ViewerKubeconfig
 
+	storage["shootsummaries"] = shootsummarystore.NewREST(shootStorage.Shoot)
+
 	return storage
 }
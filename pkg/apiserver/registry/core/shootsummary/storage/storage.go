@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// shootReader reads Shoots from the storage of the shoots resource.
+type shootReader interface {
+	Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error)
+	List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error)
+}
+
+// REST implements a read-only RESTStorage for shootsummaries. The summaries are not persisted but computed from the
+// Shoots on request, hence get and list requests are served from the watch cache of the shoots resource.
+type REST struct {
+	shootStorage shootReader
+	rest.TableConvertor
+}
+
+var (
+	_ rest.Storage              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.Getter               = &REST{}
+	_ rest.Lister               = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+// NewREST returns a RESTStorage object that will work against shootsummaries.
+func NewREST(shootStorage shootReader) *REST {
+	return &REST{
+		shootStorage:   shootStorage,
+		TableConvertor: newTableConvertor(),
+	}
+}
+
+// New returns an empty ShootSummary object.
+func (r *REST) New() runtime.Object {
+	return &core.ShootSummary{}
+}
+
+// NewList returns an empty ShootSummaryList object.
+func (r *REST) NewList() runtime.Object {
+	return &core.ShootSummaryList{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *REST) Destroy() {
+	// Given that the underlying store is owned by the shoots resource, we don't destroy it here explicitly.
+}
+
+// NamespaceScoped returns true because ShootSummaries live in the namespaces of their Shoots.
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+// GetSingularName returns the singular name of the resource.
+func (r *REST) GetSingularName() string {
+	return "shootsummary"
+}
+
+// Get returns the summary of the Shoot with the given name.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := r.shootStorage.Get(ctx, name, options)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, apierrors.NewNotFound(core.Resource("shootsummaries"), name)
+		}
+		return nil, err
+	}
+
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T, expected Shoot", obj)
+	}
+
+	return Summarize(shoot), nil
+}
+
+// List returns the summaries of the Shoots matching the given options.
+func (r *REST) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	obj, err := r.shootStorage.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	shootList, ok := obj.(*core.ShootList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T, expected ShootList", obj)
+	}
+
+	list := &core.ShootSummaryList{
+		ListMeta: shootList.ListMeta,
+		Items:    make([]core.ShootSummary, 0, len(shootList.Items)),
+	}
+	for i := range shootList.Items {
+		list.Items = append(list.Items, *Summarize(&shootList.Items[i]))
+	}
+
+	return list, nil
+}
+
+// Summarize computes the summary of the given Shoot.
+func Summarize(shoot *core.Shoot) *core.ShootSummary {
+	summary := &core.ShootSummary{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              shoot.Name,
+			Namespace:         shoot.Namespace,
+			UID:               shoot.UID,
+			ResourceVersion:   shoot.ResourceVersion,
+			Generation:        shoot.Generation,
+			CreationTimestamp: shoot.CreationTimestamp,
+			DeletionTimestamp: shoot.DeletionTimestamp,
+			Labels:            shoot.Labels,
+		},
+		Status: core.ShootSummaryStatus{
+			SeedName:           shoot.Spec.SeedName,
+			ProviderType:       shoot.Spec.Provider.Type,
+			Purpose:            shoot.Spec.Purpose,
+			KubernetesVersion:  shoot.Spec.Kubernetes.Version,
+			GardenerVersion:    shoot.Status.Gardener.Version,
+			Hibernated:         shoot.Status.IsHibernated,
+			LastOperation:      shoot.Status.LastOperation,
+			OperationStartTime: shoot.Status.RetryCycleStartTime,
+		},
+	}
+
+	for _, condition := range shoot.Status.Conditions {
+		if condition.Status == core.ConditionFalse || condition.Status == core.ConditionUnknown {
+			summary.Status.UnhealthyConditions = append(summary.Status.UnhealthyConditions, condition.Type)
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/core/shootsummary/storage"
+)
+
+var _ = Describe("ShootSummary", func() {
+	var (
+		ctx = context.TODO()
+
+		shootStorage *fakeShootStorage
+		storage      *REST
+
+		now   metav1.Time
+		shoot *core.Shoot
+	)
+
+	BeforeEach(func() {
+		now = metav1.NewTime(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC))
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "foo",
+				Namespace:         "garden-bar",
+				UID:               "1234",
+				ResourceVersion:   "42",
+				Generation:        2,
+				CreationTimestamp: now,
+				Labels:            map[string]string{"shoot.gardener.cloud/status": "unhealthy"},
+			},
+			Spec: core.ShootSpec{
+				SeedName:   ptr.To("seed"),
+				Provider:   core.Provider{Type: "aws"},
+				Purpose:    ptr.To(core.ShootPurposeProduction),
+				Kubernetes: core.Kubernetes{Version: "1.31.1"},
+			},
+			Status: core.ShootStatus{
+				Gardener:     core.Gardener{Version: "v1.130.0"},
+				IsHibernated: true,
+				Conditions: []core.Condition{
+					{Type: core.ShootAPIServerAvailable, Status: core.ConditionTrue},
+					{Type: core.ShootControlPlaneHealthy, Status: core.ConditionFalse},
+					{Type: core.ShootEveryNodeReady, Status: core.ConditionProgressing},
+					{Type: core.ShootSystemComponentsHealthy, Status: core.ConditionUnknown},
+				},
+				LastOperation: &core.LastOperation{
+					Type:     core.LastOperationTypeReconcile,
+					State:    core.LastOperationStateError,
+					Progress: 60,
+				},
+				RetryCycleStartTime: &now,
+			},
+		}
+
+		shootStorage = &fakeShootStorage{shoots: []core.Shoot{*shoot}}
+		storage = NewREST(shootStorage)
+	})
+
+	Describe("#Summarize", func() {
+		It("should summarize the shoot", func() {
+			Expect(Summarize(shoot)).To(Equal(&core.ShootSummary{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "foo",
+					Namespace:         "garden-bar",
+					UID:               "1234",
+					ResourceVersion:   "42",
+					Generation:        2,
+					CreationTimestamp: now,
+					Labels:            map[string]string{"shoot.gardener.cloud/status": "unhealthy"},
+				},
+				Status: core.ShootSummaryStatus{
+					SeedName:            ptr.To("seed"),
+					ProviderType:        "aws",
+					Purpose:             ptr.To(core.ShootPurposeProduction),
+					KubernetesVersion:   "1.31.1",
+					GardenerVersion:     "v1.130.0",
+					Hibernated:          true,
+					UnhealthyConditions: []core.ConditionType{core.ShootControlPlaneHealthy, core.ShootSystemComponentsHealthy},
+					LastOperation: &core.LastOperation{
+						Type:     core.LastOperationTypeReconcile,
+						State:    core.LastOperationStateError,
+						Progress: 60,
+					},
+					OperationStartTime: &now,
+				},
+			}))
+		})
+
+		It("should not report unhealthy conditions for a healthy shoot", func() {
+			shoot.Status.Conditions = []core.Condition{{Type: core.ShootAPIServerAvailable, Status: core.ConditionTrue}}
+
+			Expect(Summarize(shoot).Status.UnhealthyConditions).To(BeEmpty())
+		})
+	})
+
+	Describe("#Get", func() {
+		It("should return the summary of the shoot", func() {
+			obj, err := storage.Get(ctx, "foo", &metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj).To(Equal(Summarize(shoot)))
+		})
+
+		It("should return a NotFound error for the shootsummaries resource if the shoot does not exist", func() {
+			_, err := storage.Get(ctx, "baz", &metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`shootsummaries.core.gardener.cloud "baz" not found`)))
+		})
+	})
+
+	Describe("#List", func() {
+		It("should pass the list options to the shoot storage and summarize the listed shoots", func() {
+			other := shoot.DeepCopy()
+			other.Name = "other"
+			other.Labels = nil
+			shootStorage.shoots = append(shootStorage.shoots, *other)
+
+			obj, err := storage.List(ctx, &metainternalversion.ListOptions{LabelSelector: labels.SelectorFromSet(labels.Set{"shoot.gardener.cloud/status": "unhealthy"})})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shootStorage.listOptions.LabelSelector.String()).To(Equal("shoot.gardener.cloud/status=unhealthy"))
+			Expect(obj).To(Equal(&core.ShootSummaryList{
+				ListMeta: metav1.ListMeta{ResourceVersion: "100", Continue: "next"},
+				Items:    []core.ShootSummary{*Summarize(shoot), *Summarize(other)},
+			}))
+		})
+	})
+
+	Describe("#ConvertToTable", func() {
+		It("should convert the summary to a table", func() {
+			table, err := storage.ConvertToTable(ctx, Summarize(shoot), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(table.Rows).To(HaveLen(1))
+			Expect(table.Rows[0].Cells).To(HaveExactElements(
+				"foo", "aws", "seed", "1.31.1", true, "Reconcile Error (60%)", "ControlPlaneHealthy,SystemComponentsHealthy",
+				"production", "v1.130.0", Not(BeEmpty()),
+			))
+		})
+	})
+})
+
+type fakeShootStorage struct {
+	shoots      []core.Shoot
+	listOptions *metainternalversion.ListOptions
+}
+
+func (f *fakeShootStorage) Get(_ context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	for _, shoot := range f.shoots {
+		if shoot.Name == name {
+			return shoot.DeepCopy(), nil
+		}
+	}
+	return nil, apierrors.NewNotFound(core.Resource("shoots"), name)
+}
+
+func (f *fakeShootStorage) List(_ context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	f.listOptions = options
+	return &core.ShootList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "100", Continue: "next"},
+		Items:    f.shoots,
+	}, nil
+}
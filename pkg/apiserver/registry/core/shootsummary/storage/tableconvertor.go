@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+type convertor struct {
+	headers []metav1beta1.TableColumnDefinition
+}
+
+func newTableConvertor() rest.TableConvertor {
+	return &convertor{
+		headers: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Provider", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["provider"]},
+			{Name: "Seed", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["seed"]},
+			{Name: "K8S Version", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["k8sVersion"]},
+			{Name: "Hibernated", Type: "boolean", Description: swaggerMetadataDescriptions["hibernated"]},
+			{Name: "Last Operation", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["lastoperation"]},
+			{Name: "Unhealthy Conditions", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["unhealthyConditions"]},
+
+			{Name: "Purpose", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["purpose"], Priority: 1},
+			{Name: "Gardener Version", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["gardenerVersion"], Priority: 1},
+			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+}
+
+// ConvertToTable converts the output to a table.
+func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1beta1.Table, error) {
+	var (
+		err   error
+		table = &metav1beta1.Table{
+			ColumnDefinitions: c.headers,
+		}
+	)
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, _, _ string) ([]any, error) {
+		var (
+			summary = obj.(*core.ShootSummary)
+			cells   = []any{}
+		)
+
+		cells = append(cells, summary.Name)
+		cells = append(cells, summary.Status.ProviderType)
+		if seed := summary.Status.SeedName; seed != nil {
+			cells = append(cells, *seed)
+		} else {
+			cells = append(cells, "<none>")
+		}
+		cells = append(cells, summary.Status.KubernetesVersion)
+		cells = append(cells, summary.Status.Hibernated)
+		if lastOp := summary.Status.LastOperation; lastOp != nil {
+			cells = append(cells, fmt.Sprintf("%s %s (%d%%)", lastOp.Type, lastOp.State, lastOp.Progress))
+		} else {
+			cells = append(cells, "<pending>")
+		}
+		if len(summary.Status.UnhealthyConditions) > 0 {
+			conditionTypes := make([]string, 0, len(summary.Status.UnhealthyConditions))
+			for _, conditionType := range summary.Status.UnhealthyConditions {
+				conditionTypes = append(conditionTypes, string(conditionType))
+			}
+			cells = append(cells, strings.Join(conditionTypes, ","))
+		} else {
+			cells = append(cells, "<none>")
+		}
+
+		if purpose := summary.Status.Purpose; purpose != nil {
+			cells = append(cells, string(*purpose))
+		} else {
+			cells = append(cells, "<none>")
+		}
+		if len(summary.Status.GardenerVersion) != 0 {
+			cells = append(cells, summary.Status.GardenerVersion)
+		} else {
+			cells = append(cells, "<unknown>")
+		}
+		cells = append(cells, metatable.ConvertToHumanReadableDateType(summary.CreationTimestamp))
+
+		return cells, nil
+	})
+
+	return table, err
+}
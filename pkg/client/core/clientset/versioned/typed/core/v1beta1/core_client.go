@@ -31,6 +31,7 @@ type CoreV1beta1Interface interface {
 	SeedsGetter
 	ShootsGetter
 	ShootStatesGetter
+	ShootSummariesGetter
 }
 
 // CoreV1beta1Client is used to interact with features provided by the core.gardener.cloud group.
@@ -98,6 +99,10 @@ func (c *CoreV1beta1Client) ShootStates(namespace string) ShootStateInterface {
 	return newShootStates(c, namespace)
 }
 
+func (c *CoreV1beta1Client) ShootSummaries(namespace string) ShootSummaryInterface {
+	return newShootSummaries(c, namespace)
+}
+
 // NewForConfig creates a new CoreV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
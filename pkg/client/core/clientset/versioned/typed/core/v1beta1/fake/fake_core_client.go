@@ -76,6 +76,10 @@ func (c *FakeCoreV1beta1) ShootStates(namespace string) v1beta1.ShootStateInterf
 	return newFakeShootStates(c, namespace)
 }
 
+func (c *FakeCoreV1beta1) ShootSummaries(namespace string) v1beta1.ShootSummaryInterface {
+	return newFakeShootSummaries(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCoreV1beta1) RESTClient() rest.Interface {
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1beta1 "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeShootSummaries implements ShootSummaryInterface
+type fakeShootSummaries struct {
+	*gentype.FakeClientWithList[*v1beta1.ShootSummary, *v1beta1.ShootSummaryList]
+	Fake *FakeCoreV1beta1
+}
+
+func newFakeShootSummaries(fake *FakeCoreV1beta1, namespace string) corev1beta1.ShootSummaryInterface {
+	return &fakeShootSummaries{
+		gentype.NewFakeClientWithList[*v1beta1.ShootSummary, *v1beta1.ShootSummaryList](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("shootsummaries"),
+			v1beta1.SchemeGroupVersion.WithKind("ShootSummary"),
+			func() *v1beta1.ShootSummary { return &v1beta1.ShootSummary{} },
+			func() *v1beta1.ShootSummaryList { return &v1beta1.ShootSummaryList{} },
+			func(dst, src *v1beta1.ShootSummaryList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.ShootSummaryList) []*v1beta1.ShootSummary {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.ShootSummaryList, items []*v1beta1.ShootSummary) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -35,3 +35,5 @@ type SeedExpansion interface{}
 type ShootExpansion interface{}
 
 type ShootStateExpansion interface{}
+
+type ShootSummaryExpansion interface{}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	scheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ShootSummariesGetter has a method to return a ShootSummaryInterface.
+// A group's client should implement this interface.
+type ShootSummariesGetter interface {
+	ShootSummaries(namespace string) ShootSummaryInterface
+}
+
+// ShootSummaryInterface has methods to work with ShootSummary resources.
+type ShootSummaryInterface interface {
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*corev1beta1.ShootSummary, error)
+	List(ctx context.Context, opts v1.ListOptions) (*corev1beta1.ShootSummaryList, error)
+	ShootSummaryExpansion
+}
+
+// shootSummaries implements ShootSummaryInterface
+type shootSummaries struct {
+	*gentype.ClientWithList[*corev1beta1.ShootSummary, *corev1beta1.ShootSummaryList]
+}
+
+// newShootSummaries returns a ShootSummaries
+func newShootSummaries(c *CoreV1beta1Client, namespace string) *shootSummaries {
+	return &shootSummaries{
+		gentype.NewClientWithList[*corev1beta1.ShootSummary, *corev1beta1.ShootSummaryList](
+			"shootsummaries",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *corev1beta1.ShootSummary { return &corev1beta1.ShootSummary{} },
+			func() *corev1beta1.ShootSummaryList { return &corev1beta1.ShootSummaryList{} },
+		),
+	}
+}
@@ -93,3 +93,11 @@ type ShootStateListerExpansion interface{}
 // ShootStateNamespaceListerExpansion allows custom methods to be added to
 // ShootStateNamespaceLister.
 type ShootStateNamespaceListerExpansion interface{}
+
+// ShootSummaryListerExpansion allows custom methods to be added to
+// ShootSummaryLister.
+type ShootSummaryListerExpansion interface{}
+
+// ShootSummaryNamespaceListerExpansion allows custom methods to be added to
+// ShootSummaryNamespaceLister.
+type ShootSummaryNamespaceListerExpansion interface{}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ShootSummaryLister helps list ShootSummaries.
+// All objects returned here must be treated as read-only.
+type ShootSummaryLister interface {
+	// List lists all ShootSummaries in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*corev1beta1.ShootSummary, err error)
+	// ShootSummaries returns an object that can list and get ShootSummaries.
+	ShootSummaries(namespace string) ShootSummaryNamespaceLister
+	ShootSummaryListerExpansion
+}
+
+// shootSummaryLister implements the ShootSummaryLister interface.
+type shootSummaryLister struct {
+	listers.ResourceIndexer[*corev1beta1.ShootSummary]
+}
+
+// NewShootSummaryLister returns a new ShootSummaryLister.
+func NewShootSummaryLister(indexer cache.Indexer) ShootSummaryLister {
+	return &shootSummaryLister{listers.New[*corev1beta1.ShootSummary](indexer, corev1beta1.Resource("shootsummary"))}
+}
+
+// ShootSummaries returns an object that can list and get ShootSummaries.
+func (s *shootSummaryLister) ShootSummaries(namespace string) ShootSummaryNamespaceLister {
+	return shootSummaryNamespaceLister{listers.NewNamespaced[*corev1beta1.ShootSummary](s.ResourceIndexer, namespace)}
+}
+
+// ShootSummaryNamespaceLister helps list and get ShootSummaries.
+// All objects returned here must be treated as read-only.
+type ShootSummaryNamespaceLister interface {
+	// List lists all ShootSummaries in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*corev1beta1.ShootSummary, err error)
+	// Get retrieves the ShootSummary from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*corev1beta1.ShootSummary, error)
+	ShootSummaryNamespaceListerExpansion
+}
+
+// shootSummaryNamespaceLister implements the ShootSummaryNamespaceLister
+// interface.
+type shootSummaryNamespaceLister struct {
+	listers.ResourceIndexer[*corev1beta1.ShootSummary]
+}
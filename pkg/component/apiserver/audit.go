@@ -18,6 +18,7 @@ import (
 	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener/pkg/utils"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -117,6 +118,10 @@ func InjectAuditSettings(deployment *appsv1.Deployment, configMapAuditPolicy *co
 		return
 	}
 
+	if len(auditConfig.Webhook.PodLabels) > 0 {
+		deployment.Spec.Template.Labels = utils.MergeStringMaps(deployment.Spec.Template.Labels, auditConfig.Webhook.PodLabels)
+	}
+
 	if len(auditConfig.Webhook.Kubeconfig) > 0 {
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--audit-webhook-config-file=%s/%s", volumeMountPathAuditWebhookKubeconfig, SecretWebhookKubeconfigDataKey))
 		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
@@ -138,6 +143,14 @@ func InjectAuditSettings(deployment *appsv1.Deployment, configMapAuditPolicy *co
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--audit-webhook-batch-max-size=%d", *v))
 	}
 
+	if v := auditConfig.Webhook.BatchMaxWait; v != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-webhook-batch-max-wait="+v.String())
+	}
+
+	if v := auditConfig.Webhook.BatchBufferSize; v != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--audit-webhook-batch-buffer-size=%d", *v))
+	}
+
 	if v := auditConfig.Webhook.Version; v != nil {
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-webhook-version="+*v)
 	}
@@ -6,6 +6,7 @@ package apiserver_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -217,20 +218,28 @@ rules:
 			secretWebhookKubeconfig := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "audit-webhook"}}
 
 			InjectAuditSettings(deployment, configMapAuditPolicy, secretWebhookKubeconfig, &AuditConfig{Webhook: &AuditWebhook{
-				Kubeconfig:   []byte("foo"),
-				BatchMaxSize: ptr.To[int32](2),
-				Version:      ptr.To("bar"),
+				Kubeconfig:      []byte("foo"),
+				BatchMaxSize:    ptr.To[int32](2),
+				BatchMaxWait:    ptr.To(30 * time.Second),
+				BatchBufferSize: ptr.To[int32](1000),
+				Version:         ptr.To("bar"),
+				PodLabels:       map[string]string{"to-audit-sink": "allowed"},
 			}})
 
 			Expect(deployment).To(Equal(&appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"to-audit-sink": "allowed"},
+						},
 						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{{
 								Args: []string{
 									"--audit-policy-file=/etc/kubernetes/audit/audit-policy.yaml",
 									"--audit-webhook-config-file=/etc/kubernetes/webhook/audit/kubeconfig.yaml",
 									"--audit-webhook-batch-max-size=2",
+									"--audit-webhook-batch-max-wait=30s",
+									"--audit-webhook-batch-buffer-size=1000",
 									"--audit-webhook-version=bar",
 								},
 								VolumeMounts: []corev1.VolumeMount{
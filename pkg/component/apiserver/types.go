@@ -5,6 +5,8 @@
 package apiserver
 
 import (
+	"time"
+
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 
@@ -73,6 +75,12 @@ type AuditWebhook struct {
 	Kubeconfig []byte
 	// BatchMaxSize is the maximum size of a batch.
 	BatchMaxSize *int32
+	// BatchMaxWait is the amount of time to wait before force writing the batch that hadn't reached the max size.
+	BatchMaxWait *time.Duration
+	// BatchBufferSize is the size of the buffer to store events before batching and writing.
+	BatchBufferSize *int32
+	// PodLabels are additional labels for the API server pods, e.g., to allow the network traffic to the webhook.
+	PodLabels map[string]string
 	// Version is the API group and version used for serializing audit events written to webhook.
 	Version *string
 }
@@ -340,6 +340,11 @@ func (e *etcd) Deploy(ctx context.Context) error {
 			},
 		}
 
+		// TODO: Enable the distributed tracing of etcd (`--experimental-enable-distributed-tracing`) and send the spans to
+		//  the OpenTelemetry Collector of the control plane like the kube-apiserver does once the `Etcd` API of etcd-druid
+		//  allows configuring it. Neither `EtcdConfig` nor the etcd-druid configuration offer extra arguments as of
+		//  etcd-druid v0.31.0, and etcd ignores flags and environment variables when started with a config file.
+
 		// TODO(timuthy): Once https://github.com/gardener/etcd-backup-restore/issues/538 is resolved we can enable PeerUrlTLS for all remaining clusters as well.
 		if e.values.HighAvailabilityEnabled {
 			e.etcd.Spec.Etcd.PeerUrlTLS = &druidcorev1alpha1.TLSConfig{
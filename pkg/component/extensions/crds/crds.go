@@ -43,6 +43,11 @@ var (
 
 // NewCRD can be used to deploy extensions CRDs.
 func NewCRD(client client.Client, applier kubernetes.Applier, includeGeneralCRDs, includeShootCRDs bool) (component.DeployWaiter, error) {
+	return crddeployer.New(client, applier, Manifests(includeGeneralCRDs, includeShootCRDs), true)
+}
+
+// Manifests returns the manifests of the extensions CRDs.
+func Manifests(includeGeneralCRDs, includeShootCRDs bool) []string {
 	var (
 		crds        []string
 		generalCRDs = []string{
@@ -71,5 +76,5 @@ func NewCRD(client client.Client, applier kubernetes.Applier, includeGeneralCRDs
 		crds = append(crds, shootCRDs...)
 	}
 
-	return crddeployer.New(client, applier, crds, true)
+	return crds
 }
@@ -6,6 +6,7 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -53,6 +54,9 @@ type Interface interface {
 	PodsCIDRs() []string
 	// EgressCIDRs returns a list of CIDRs used as source IP by any traffic originating from the shoot's worker nodes.
 	EgressCIDRs() []string
+	// PlannedChanges returns the changes planned by the extension if the Infrastructure was deployed in plan mode.
+	// It returns nil if the changes were applied.
+	PlannedChanges() *extensionsv1alpha1.InfrastructurePlannedChanges
 }
 
 // Values contains the values used to create an Infrastructure resources.
@@ -74,6 +78,10 @@ type Values struct {
 	// then the Infrastructure object will be created/updated but the extension controller will not
 	// act upon it.
 	AnnotateOperation bool
+	// Plan indicates that the Infrastructure resource shall be annotated with "gardener.cloud/operation=plan" instead
+	// of "reconcile", i.e., the extension only plans the changes without applying them. It only takes effect if the
+	// Infrastructure has been reconciled successfully before, otherwise the shoot could not be reconciled further.
+	Plan bool
 }
 
 // New creates a new instance of Interface.
@@ -116,6 +124,8 @@ type infrastructure struct {
 	servicesCIDRs  []string
 	podsCIDRs      []string
 	egressCIDRs    []string
+	planned        bool
+	plannedChanges *extensionsv1alpha1.InfrastructurePlannedChanges
 }
 
 // Deploy uses the seed client to create or update the Infrastructure resource.
@@ -133,6 +143,21 @@ func (i *infrastructure) deploy(ctx context.Context, operation string) (extensio
 	}
 
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, i.client, i.infrastructure, func() error {
+		i.planned, i.plannedChanges = false, nil
+		if i.values.Plan && operation == v1beta1constants.GardenerOperationReconcile && i.lastOperationSuccessful() {
+			if i.values.AnnotateOperation || i.isTimestampInvalidOrAfterLastUpdateTime() {
+				// The timestamp annotation is not updated since it is used to detect whether a reconciliation is
+				// outstanding.
+				metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationPlan)
+				i.planned = true
+			}
+
+			// The spec is kept until the planned changes have been approved, otherwise they would be applied by the next
+			// reconciliation of the extension, e.g. because of a restart or a changed generation.
+			i.infrastructure.Spec.PlannedProviderConfig = providerConfig
+			return nil
+		}
+
 		if i.values.AnnotateOperation || i.lastOperationNotSuccessful() || i.isTimestampInvalidOrAfterLastUpdateTime() {
 			// Check if gardener timestamp is in an invalid format or is after status.LastOperation.LastUpdateTime.
 			// If that is the case health checks for the infrastructure will fail so we request a reconciliation to correct the current state.
@@ -187,8 +212,13 @@ func (i *infrastructure) Destroy(ctx context.Context) error {
 	)
 }
 
-// Wait waits until the Infrastructure resource is ready.
+// Wait waits until the Infrastructure resource is ready. If the Infrastructure was deployed in plan mode, it waits
+// until the extension has planned the changes and extracts the status of the last reconciliation.
 func (i *infrastructure) Wait(ctx context.Context) error {
+	if i.planned {
+		return i.waitPlanned(ctx)
+	}
+
 	return extensions.WaitUntilExtensionObjectReady(
 		ctx,
 		i.client,
@@ -204,6 +234,36 @@ func (i *infrastructure) Wait(ctx context.Context) error {
 		})
 }
 
+func (i *infrastructure) waitPlanned(ctx context.Context) error {
+	return extensions.WaitUntilObjectReadyWithHealthFunction(
+		ctx,
+		i.client,
+		i.log,
+		checkPlanned,
+		i.infrastructure,
+		extensionsv1alpha1.InfrastructureResource,
+		i.waitInterval,
+		i.waitSevereThreshold,
+		i.waitTimeout,
+		func() error {
+			plannedChanges := i.infrastructure.Status.PlannedChanges
+			if plannedChanges == nil || plannedChanges.ObservedGeneration != i.infrastructure.Generation {
+				return fmt.Errorf("extension of type %q does not support planning infrastructure changes, remove the %q annotation from the shoot to apply them", i.values.Type, v1beta1constants.AnnotationShootInfrastructurePlan)
+			}
+
+			i.extractStatus(i.infrastructure.Status)
+			i.plannedChanges = plannedChanges.DeepCopy()
+			return nil
+		})
+}
+
+func checkPlanned(obj client.Object) error {
+	if obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan {
+		return fmt.Errorf("changes of %s have not been planned yet", client.ObjectKeyFromObject(obj))
+	}
+	return nil
+}
+
 // WaitMigrate waits until the Infrastructure resources are migrated successfully.
 func (i *infrastructure) WaitMigrate(ctx context.Context) error {
 	return extensions.WaitUntilExtensionObjectMigrated(
@@ -269,6 +329,12 @@ func (i *infrastructure) EgressCIDRs() []string {
 	return i.egressCIDRs
 }
 
+// PlannedChanges returns the changes planned by the extension if the Infrastructure was deployed in plan mode.
+// It returns nil if the changes were applied.
+func (i *infrastructure) PlannedChanges() *extensionsv1alpha1.InfrastructurePlannedChanges {
+	return i.plannedChanges
+}
+
 func (i *infrastructure) extractStatus(status extensionsv1alpha1.InfrastructureStatus) {
 	i.providerStatus = status.ProviderStatus
 	if status.NodesCIDR != nil {
@@ -291,6 +357,10 @@ func (i *infrastructure) extractStatus(status extensionsv1alpha1.InfrastructureS
 	copy(i.egressCIDRs, status.EgressCIDRs)
 }
 
+func (i *infrastructure) lastOperationSuccessful() bool {
+	return i.infrastructure.Status.LastOperation != nil && i.infrastructure.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
+
 func (i *infrastructure) lastOperationNotSuccessful() bool {
 	return i.infrastructure.Status.LastOperation != nil && i.infrastructure.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded
 }
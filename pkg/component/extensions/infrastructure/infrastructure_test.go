@@ -257,6 +257,106 @@ var _ = Describe("#Interface", func() {
 		})
 	})
 
+	Describe("#Deploy (Plan=true)", func() {
+		var existingInfra *extensionsv1alpha1.Infrastructure
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithVars(
+				&infrastructure.TimeNow, mockNow.Do,
+			))
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			existingInfra = expected.DeepCopy()
+			delete(existingInfra.Annotations, v1beta1constants.GardenerOperation)
+			metav1.SetMetaDataAnnotation(&existingInfra.ObjectMeta, v1beta1constants.GardenerTimestamp, now.UTC().Add(-time.Minute).Format(time.RFC3339Nano))
+			existingInfra.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				State:          gardencorev1beta1.LastOperationStateSucceeded,
+				LastUpdateTime: metav1.NewTime(now.UTC()),
+			}
+			existingInfra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"old":"config"}`)}
+			existingInfra.Status.ProviderStatus = providerStatus
+			existingInfra.Status.EgressCIDRs = egressCIDRs
+
+			values.AnnotateOperation = true
+			values.Plan = true
+			deployWaiter.SetSSHPublicKey(sshPublicKey)
+		})
+
+		It("should annotate the Infrastructure with the reconcile operation if it does not exist yet", func() {
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expected), actual)).To(Succeed())
+			Expect(actual.Annotations).To(Equal(expected.Annotations))
+		})
+
+		It("should annotate the Infrastructure with the plan operation and keep the timestamp", func() {
+			Expect(c.Create(ctx, existingInfra)).To(Succeed())
+
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expected), actual)).To(Succeed())
+			Expect(actual.Annotations).To(Equal(map[string]string{
+				v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationPlan,
+				v1beta1constants.GardenerTimestamp: existingInfra.Annotations[v1beta1constants.GardenerTimestamp],
+			}))
+			expectedSpec := existingInfra.Spec.DeepCopy()
+			expectedSpec.PlannedProviderConfig = expected.Spec.ProviderConfig
+			Expect(actual.Spec).To(Equal(*expectedSpec))
+		})
+
+		It("should apply the spec and remove the planned provider config once the changes are approved", func() {
+			Expect(c.Create(ctx, existingInfra)).To(Succeed())
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			values.Plan = false
+			deployWaiter = infrastructure.New(log, c, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			deployWaiter.SetSSHPublicKey(sshPublicKey)
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expected), actual)).To(Succeed())
+			Expect(actual.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			Expect(actual.Spec).To(Equal(expected.Spec))
+		})
+
+		It("should wait until the changes have been planned", func() {
+			Expect(c.Create(ctx, existingInfra)).To(Succeed())
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			Expect(deployWaiter.Wait(ctx)).To(MatchError(ContainSubstring("have not been planned yet")))
+
+			changes := []extensionsv1alpha1.InfrastructureChange{{Resource: "subnet-a", Action: extensionsv1alpha1.InfrastructureChangeActionReplace}}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(existingInfra), existingInfra)).To(Succeed())
+			patch := client.MergeFrom(existingInfra.DeepCopy())
+			delete(existingInfra.Annotations, v1beta1constants.GardenerOperation)
+			existingInfra.Status.PlannedChanges = &extensionsv1alpha1.InfrastructurePlannedChanges{
+				ObservedGeneration: existingInfra.Generation,
+				Changes:            changes,
+			}
+			Expect(c.Patch(ctx, existingInfra, patch)).To(Succeed())
+
+			Expect(deployWaiter.Wait(ctx)).To(Succeed())
+			Expect(deployWaiter.PlannedChanges().Changes).To(Equal(changes))
+			Expect(deployWaiter.ProviderStatus()).To(Equal(providerStatus))
+			Expect(deployWaiter.EgressCIDRs()).To(Equal(egressCIDRs))
+		})
+
+		It("should fail if the extension does not support planning changes", func() {
+			Expect(c.Create(ctx, existingInfra)).To(Succeed())
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(existingInfra), existingInfra)).To(Succeed())
+			patch := client.MergeFrom(existingInfra.DeepCopy())
+			delete(existingInfra.Annotations, v1beta1constants.GardenerOperation)
+			Expect(c.Patch(ctx, existingInfra, patch)).To(Succeed())
+
+			Expect(deployWaiter.Wait(ctx)).To(MatchError(ContainSubstring(`extension of type "foo" does not support planning infrastructure changes`)))
+			Expect(deployWaiter.PlannedChanges()).To(BeNil())
+		})
+	})
+
 	Describe("#Wait", func() {
 		It("should return error when it's not found", func() {
 			Expect(deployWaiter.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
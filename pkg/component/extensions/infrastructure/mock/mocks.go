@@ -128,6 +128,20 @@ func (mr *MockInterfaceMockRecorder) NodesCIDRs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodesCIDRs", reflect.TypeOf((*MockInterface)(nil).NodesCIDRs))
 }
 
+// PlannedChanges mocks base method.
+func (m *MockInterface) PlannedChanges() *v1alpha1.InfrastructurePlannedChanges {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlannedChanges")
+	ret0, _ := ret[0].(*v1alpha1.InfrastructurePlannedChanges)
+	return ret0
+}
+
+// PlannedChanges indicates an expected call of PlannedChanges.
+func (mr *MockInterfaceMockRecorder) PlannedChanges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlannedChanges", reflect.TypeOf((*MockInterface)(nil).PlannedChanges))
+}
+
 // PodsCIDRs mocks base method.
 func (m *MockInterface) PodsCIDRs() []string {
 	m.ctrl.T.Helper()
@@ -94,6 +94,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  healthCheck: {}
   operatingSystemConfig:
     kubernetesVersion: ` + kubernetesVersion.String() + `
     secretName: ` + oscSecretName + `
@@ -190,6 +191,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  healthCheck: {}
   operatingSystemConfig:
     kubernetesVersion: ` + kubernetesVersion.String() + `
     secretName: ` + oscSecretName + `
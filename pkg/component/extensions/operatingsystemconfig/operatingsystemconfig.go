@@ -37,6 +37,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/features"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -152,6 +153,12 @@ type OriginalValues struct {
 	PrimaryIPFamily gardencorev1beta1.IPFamily
 	// KubeProxyConfig is the configuration for kube-proxy.
 	KubeProxyConfig *gardencorev1beta1.KubeProxyConfig
+	// NodeAgentHealthCheck is the configuration for the health checks of the gardener-node-agent.
+	NodeAgentHealthCheck *nodeagentconfigv1alpha1.HealthCheckControllerConfig
+	// NodeAgentDriftDetection is the configuration for the drift detection of the gardener-node-agent.
+	NodeAgentDriftDetection *nodeagentconfigv1alpha1.DriftDetectionConfig
+	// NodeAgentReboot is the configuration for coordinated reboots by the gardener-node-agent.
+	NodeAgentReboot *nodeagentconfigv1alpha1.RebootControllerConfig
 }
 
 // New creates a new instance of Interface.
@@ -768,6 +775,9 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		nodeLocalDNSEnabled:          o.values.NodeLocalDNSEnabled,
 		primaryIPFamily:              o.values.PrimaryIPFamily,
 		taints:                       taints,
+		nodeAgentHealthCheck:         o.values.NodeAgentHealthCheck,
+		nodeAgentDriftDetection:      o.values.NodeAgentDriftDetection,
+		nodeAgentReboot:              o.values.NodeAgentReboot,
 		caRotationLastInitiationTime: caRotationLastInitiationTime,
 		serviceAccountKeyRotationLastInitiationTime: serviceAccountKeyRotationLastInitiationTime,
 	}, nil
@@ -837,6 +847,9 @@ type deployer struct {
 	nodeMonitorGracePeriod                      metav1.Duration
 	primaryIPFamily                             gardencorev1beta1.IPFamily
 	taints                                      []corev1.Taint
+	nodeAgentHealthCheck                        *nodeagentconfigv1alpha1.HealthCheckControllerConfig
+	nodeAgentDriftDetection                     *nodeagentconfigv1alpha1.DriftDetectionConfig
+	nodeAgentReboot                             *nodeagentconfigv1alpha1.RebootControllerConfig
 	caRotationLastInitiationTime                *metav1.Time
 	serviceAccountKeyRotationLastInitiationTime *metav1.Time
 }
@@ -879,6 +892,9 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		Sysctls:                 d.worker.Sysctls,
 		PreferIPv6:              d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
 		Taints:                  d.taints,
+		NodeAgentHealthCheck:    d.nodeAgentHealthCheck,
+		NodeAgentDriftDetection: d.nodeAgentDriftDetection,
+		NodeAgentReboot:         d.nodeAgentReboot,
 	}
 
 	switch d.purpose {
@@ -66,7 +66,14 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		})
 	}
 
-	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, additionalTokenSyncConfigs))
+	config := ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, additionalTokenSyncConfigs)
+	if ctx.NodeAgentHealthCheck != nil {
+		config.Controllers.HealthCheck = *ctx.NodeAgentHealthCheck
+	}
+	config.Controllers.OperatingSystemConfig.DriftDetection = ctx.NodeAgentDriftDetection
+	config.Controllers.Reboot = ctx.NodeAgentReboot
+
+	files, err := Files(config)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed generating files: %w", err)
 	}
@@ -87,6 +87,86 @@ WantedBy=multi-user.target`),
 				},
 			})))
 		})
+
+		It("should write the configured health checks into the component config", func() {
+			key := "key"
+			healthCheck := &nodeagentconfigv1alpha1.HealthCheckControllerConfig{
+				SyncPeriod: &metav1.Duration{Duration: time.Minute},
+				Checks: []nodeagentconfigv1alpha1.HealthCheck{{
+					Name:        "sshd",
+					SystemdUnit: &nodeagentconfigv1alpha1.SystemdUnitHealthCheck{UnitName: "sshd.service"},
+					Remediation: &nodeagentconfigv1alpha1.HealthCheckRemediation{Action: nodeagentconfigv1alpha1.RemediationActionRestartUnit},
+				}},
+			}
+
+			config := ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, nil)
+			config.Controllers.HealthCheck = *healthCheck
+			expectedFiles, err := Files(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, files, err := component.Config(components.Context{
+				Key:                  key,
+				KubernetesVersion:    kubernetesVersion,
+				APIServerURL:         apiServerURL,
+				CABundle:             string(caBundle),
+				Images:               map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: ptr.To("gardener-node-agent"), Tag: ptr.To("v1")}},
+				NodeAgentHealthCheck: healthCheck,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ContainElements(expectedFiles))
+		})
+
+		It("should write the configured drift detection into the component config", func() {
+			key := "key"
+			driftDetection := &nodeagentconfigv1alpha1.DriftDetectionConfig{
+				SyncPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+				Reapply:    true,
+			}
+
+			config := ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, nil)
+			config.Controllers.OperatingSystemConfig.DriftDetection = driftDetection
+			expectedFiles, err := Files(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, files, err := component.Config(components.Context{
+				Key:                     key,
+				KubernetesVersion:       kubernetesVersion,
+				APIServerURL:            apiServerURL,
+				CABundle:                string(caBundle),
+				Images:                  map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: ptr.To("gardener-node-agent"), Tag: ptr.To("v1")}},
+				NodeAgentDriftDetection: driftDetection,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ContainElements(expectedFiles))
+		})
+
+		It("should write the configured coordinated reboots into the component config", func() {
+			key := "key"
+			reboot := &nodeagentconfigv1alpha1.RebootControllerConfig{
+				MaxConcurrentReboots: ptr.To[int32](2),
+				MaintenanceWindow:    &nodeagentconfigv1alpha1.MaintenanceWindow{Begin: "220000+0100", End: "230000+0100"},
+				DrainTimeout:         &metav1.Duration{Duration: 10 * time.Minute},
+			}
+
+			config := ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, nil)
+			config.Controllers.Reboot = reboot
+			expectedFiles, err := Files(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, files, err := component.Config(components.Context{
+				Key:               key,
+				KubernetesVersion: kubernetesVersion,
+				APIServerURL:      apiServerURL,
+				CABundle:          string(caBundle),
+				Images:            map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: ptr.To("gardener-node-agent"), Tag: ptr.To("v1")}},
+				NodeAgentReboot:   reboot,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ContainElements(expectedFiles))
+		})
 	})
 
 	Describe("#UnitContent", func() {
@@ -150,6 +230,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  healthCheck: {}
   operatingSystemConfig:
     kubernetesVersion: null
     secretName: ` + oscSecretName + `
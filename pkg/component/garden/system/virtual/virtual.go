@@ -255,6 +255,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shootsummaries"},
+					Verbs:     []string{"get", "list"},
+				},
 				{
 					APIGroups: []string{securityv1alpha1.GroupName},
 					Resources: []string{"credentialsbindings"},
@@ -469,6 +474,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shootsummaries"},
+					Verbs:     []string{"get", "list"},
+				},
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{"namespacedcloudprofiles"},
@@ -577,6 +587,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{"shoots/viewerkubeconfig"},
 					Verbs:     []string{"create"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shootsummaries"},
+					Verbs:     []string{"get", "list"},
+				},
 			},
 		}
 		clusterRoleProjectViewerAggregated = &rbacv1.ClusterRole{
@@ -276,6 +276,11 @@ var _ = Describe("Virtual", func() {
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shootsummaries"},
+					Verbs:     []string{"get", "list"},
+				},
 				{
 					APIGroups: []string{"security.gardener.cloud"},
 					Resources: []string{"credentialsbindings"},
@@ -489,6 +494,11 @@ var _ = Describe("Virtual", func() {
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shootsummaries"},
+					Verbs:     []string{"get", "list"},
+				},
 				{
 					APIGroups: []string{"core.gardener.cloud"},
 					Resources: []string{"namespacedcloudprofiles"},
@@ -595,6 +605,11 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{"shoots/viewerkubeconfig"},
 					Verbs:     []string{"create"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shootsummaries"},
+					Verbs:     []string{"get", "list"},
+				},
 			},
 		}
 		clusterRoleProjectViewerAggregated = &rbacv1.ClusterRole{
@@ -175,7 +175,7 @@ var _ = Describe("GardenerControllerManager", func() {
 					MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
 						SourceLabels: []monitoringv1.LabelName{"__name__"},
 						Action:       "keep",
-						Regex:        `^(rest_client_.+|controller_runtime_.+|workqueue_.+|go_.+)$`,
+						Regex:        `^(rest_client_.+|controller_runtime_.+|workqueue_.+|go_.+|gardener_controller_manager_.+)$`,
 					}},
 				}},
 			},
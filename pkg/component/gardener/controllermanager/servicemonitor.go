@@ -24,6 +24,7 @@ func (g *gardenerControllerManager) serviceMonitor() *monitoringv1.ServiceMonito
 					"controller_runtime_.+",
 					"workqueue_.+",
 					"go_.+",
+					"gardener_controller_manager_.+",
 				),
 			}},
 		},
@@ -65,6 +65,9 @@ type Interface interface {
 	AppendAuthorizationWebhook(AuthorizationWebhook, logr.Logger)
 	// EnableStaticTokenKubeconfig enables the static token kubeconfig.
 	EnableStaticTokenKubeconfig()
+	// SetAuditWebhookConfig sets the Webhook field of the Audit configuration in the Values of the deployer. It has no
+	// effect if no audit configuration is present.
+	SetAuditWebhookConfig(*apiserver.AuditWebhook)
 	// SetExternalHostname sets the ExternalHostname field in the Values of the deployer.
 	SetExternalHostname(string)
 	// SetNodeNetworkCIDRs sets the node CIDRs of the shoot network.
@@ -81,6 +84,8 @@ type Interface interface {
 	SetServiceAccountConfig(ServiceAccountConfig)
 	// SetSNIConfig sets the SNI field in the Values of the deployer.
 	SetSNIConfig(SNIConfig)
+	// SetTracingConfig sets the Tracing field in the Values of the deployer.
+	SetTracingConfig(*TracingConfig)
 }
 
 // Values contains configuration values for the kube-apiserver resources.
@@ -135,6 +140,8 @@ type Values struct {
 	SNI SNIConfig
 	// StaticTokenKubeconfigEnabled indicates whether static token kubeconfig secret will be created for shoot.
 	StaticTokenKubeconfigEnabled *bool
+	// Tracing contains information for configuring the export of traces by the kube-apiserver.
+	Tracing *TracingConfig
 	// Version is the Kubernetes version for the kube-apiserver.
 	Version *semver.Version
 	// VPN contains information for configuring the VPN settings for the kube-apiserver.
@@ -298,6 +305,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		configMapAuthorizationConfig           = k.emptyConfigMap(configMapAuthorizationConfigNamePrefix)
 		configMapEgressSelector                = k.emptyConfigMap(configMapEgressSelectorNamePrefix)
 		configMapEnvoyConfig                   = k.emptyConfigMap(configMapEnvoyConfigPrefix)
+		configMapTracingConfig                 = k.emptyConfigMap(configMapTracingConfigNamePrefix)
 	)
 
 	if err := k.reconcilePodDisruptionBudget(ctx, podDisruptionBudget); err != nil {
@@ -389,6 +397,10 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	if err := k.reconcileConfigMapTracingConfig(ctx, configMapTracingConfig); err != nil {
+		return err
+	}
+
 	secretHAVPNSeedClient, err := k.reconcileSecretHAVPNSeedClient(ctx)
 	if err != nil {
 		return err
@@ -440,6 +452,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		secretAdmissionKubeconfigs,
 		configMapEgressSelector,
 		configMapEnvoyConfig,
+		configMapTracingConfig,
 		secretETCDEncryptionConfiguration,
 		secretOIDCCABundle,
 		secretServiceAccountKey,
@@ -596,6 +609,12 @@ func (k *kubeAPIServer) EnableStaticTokenKubeconfig() {
 	k.values.StaticTokenKubeconfigEnabled = ptr.To(true)
 }
 
+func (k *kubeAPIServer) SetAuditWebhookConfig(config *apiserver.AuditWebhook) {
+	if k.values.Audit != nil {
+		k.values.Audit.Webhook = config
+	}
+}
+
 func (k *kubeAPIServer) SetAutoscalingReplicas(replicas *int32) {
 	k.values.Autoscaling.Replicas = replicas
 }
@@ -638,6 +657,10 @@ func (k *kubeAPIServer) SetSNIConfig(config SNIConfig) {
 	k.values.SNI = config
 }
 
+func (k *kubeAPIServer) SetTracingConfig(config *TracingConfig) {
+	k.values.Tracing = config
+}
+
 func (k *kubeAPIServer) prometheusAccessSecretName() string {
 	if k.values.NamePrefix != "" {
 		return garden.AccessSecretName
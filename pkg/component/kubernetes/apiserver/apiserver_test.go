@@ -512,7 +512,7 @@ var _ = Describe("KubeAPIServer", func() {
 							MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
 								SourceLabels: []monitoringv1.LabelName{"__name__"},
 								Action:       "keep",
-								Regex:        `^(authentication_attempts|authenticated_user_requests|apiserver_admission_controller_admission_duration_seconds_.+|apiserver_admission_webhook_admission_duration_seconds_.+|apiserver_admission_step_admission_duration_seconds_.+|apiserver_admission_webhook_request_total|apiserver_admission_webhook_rejection_count|apiserver_audit_event_total|apiserver_audit_error_total|apiserver_audit_requests_rejected_total|apiserver_cache_list_.+|apiserver_crd_webhook_conversion_duration_seconds_.+|apiserver_current_inflight_requests|apiserver_current_inqueue_requests|apiserver_init_events_total|apiserver_latency|apiserver_latency_seconds|apiserver_longrunning_requests|apiserver_request_duration_seconds_.+|apiserver_request_duration_seconds_bucket|apiserver_request_duration_seconds_count|apiserver_request_terminations_total|apiserver_response_sizes_.+|apiserver_storage_list_.+|apiserver_storage_objects|apiserver_storage_transformation_duration_seconds_.+|apiserver_storage_transformation_operations_total|apiserver_storage_size_bytes|apiserver_registered_watchers|apiserver_request_count|apiserver_request_total|apiserver_validating_admission_policy_check_total|apiserver_watch_duration|apiserver_watch_events_sizes_.+|apiserver_watch_events_total|etcd_request_duration_seconds_.+|go_.+|process_max_fds|process_open_fds|watch_cache_capacity_increase_total|watch_cache_capacity_decrease_total|watch_cache_capacity)$`,
+								Regex:        `^(authentication_attempts|authenticated_user_requests|apiserver_admission_controller_admission_duration_seconds_.+|apiserver_admission_webhook_admission_duration_seconds_.+|apiserver_admission_step_admission_duration_seconds_.+|apiserver_admission_webhook_request_total|apiserver_admission_webhook_rejection_count|apiserver_audit_event_total|apiserver_audit_error_total|apiserver_audit_requests_rejected_total|apiserver_cache_list_.+|apiserver_crd_webhook_conversion_duration_seconds_.+|apiserver_current_inflight_requests|apiserver_current_inqueue_requests|apiserver_init_events_total|apiserver_latency|apiserver_latency_seconds|apiserver_longrunning_requests|apiserver_request_duration_seconds_.+|apiserver_request_duration_seconds_bucket|apiserver_request_duration_seconds_count|apiserver_request_terminations_total|apiserver_response_sizes_.+|apiserver_storage_list_.+|apiserver_storage_objects|apiserver_storage_transformation_duration_seconds_.+|apiserver_storage_transformation_operations_total|apiserver_storage_size_bytes|apiserver_registered_watchers|apiserver_request_count|apiserver_request_total|apiserver_requested_deprecated_apis|apiserver_validating_admission_policy_check_total|apiserver_watch_duration|apiserver_watch_events_sizes_.+|apiserver_watch_events_total|etcd_request_duration_seconds_.+|go_.+|process_max_fds|process_open_fds|watch_cache_capacity_increase_total|watch_cache_capacity_decrease_total|watch_cache_capacity)$`,
 							}},
 						}},
 					},
@@ -699,6 +699,21 @@ var _ = Describe("KubeAPIServer", func() {
 										"description": "The API servers cumulative failure rate in logging audit events is greater than 2%.",
 									},
 								},
+								{
+									Alert: "KubeApiServerAuditSinkDeliveryFailures",
+									Expr:  intstr.FromString(`sum(rate(apiserver_audit_error_total{plugin="webhook",job="kube-apiserver"}[5m])) > 0`),
+									For:   ptr.To(monitoringv1.Duration("15m")),
+									Labels: map[string]string{
+										"service":    "auditlog",
+										"severity":   "warning",
+										"type":       "seed",
+										"visibility": "owner",
+									},
+									Annotations: map[string]string{
+										"summary":     "Audit events cannot be delivered to the configured audit sink",
+										"description": "The API server fails to deliver audit events to the configured audit sink or drops them because its buffer is full.",
+									},
+								},
 								{
 									Record: "shoot:apiserver_audit_event_total:sum",
 									Expr:   intstr.FromString(`sum(rate(apiserver_audit_event_total{job="kube-apiserver"}[5m]))`),
@@ -2706,6 +2721,56 @@ kind: AuthorizationConfiguration
 					Entry("VPN is enabled but HA is disabled", VPNConfig{Enabled: true, HighAvailabilityEnabled: false}),
 				)
 			})
+
+			Context("tracing configuration", func() {
+				It("should not deploy the configmap when tracing is not configured", func() {
+					kapi = New(kubernetesInterface, namespace, sm, Values{Version: version})
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+
+					configMapList := &corev1.ConfigMapList{}
+					Expect(c.List(ctx, configMapList, client.InNamespace(namespace))).To(Succeed())
+					for _, configMap := range configMapList.Items {
+						Expect(configMap.Name).NotTo(HavePrefix("kube-apiserver-tracing-config"))
+					}
+				})
+
+				It("should successfully deploy the configmap resource", func() {
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							RuntimeVersion: runtimeVersion,
+						},
+						Tracing: &TracingConfig{
+							Endpoint:               "opentelemetry-collector-collector:4320",
+							SamplingRatePerMillion: ptr.To[int32](1000),
+						},
+						Version: version,
+					})
+
+					configMapTracing := &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-tracing-config", Namespace: namespace},
+						Data: map[string]string{"config.yaml": `apiVersion: apiserver.config.k8s.io/v1beta1
+endpoint: opentelemetry-collector-collector:4320
+kind: TracingConfiguration
+samplingRatePerMillion: 1000
+`},
+					}
+					Expect(kubernetesutils.MakeUnique(configMapTracing)).To(Succeed())
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapTracing), configMapTracing)).To(BeNotFoundError())
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapTracing), configMapTracing)).To(Succeed())
+					Expect(configMapTracing).To(DeepEqual(&corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            configMapTracing.Name,
+							Namespace:       configMapTracing.Namespace,
+							Labels:          map[string]string{"resources.gardener.cloud/garbage-collectable-reference": "true"},
+							ResourceVersion: "1",
+						},
+						Immutable: ptr.To(true),
+						Data:      configMapTracing.Data,
+					}))
+				})
+			})
 		})
 
 		Describe("Deployment", func() {
@@ -4712,6 +4777,56 @@ kind: AuthenticationConfiguration
 						))
 					})
 				})
+
+				Context("tracing settings", func() {
+					It("should not configure tracing when it is not configured", func() {
+						kapi = New(kubernetesInterface, namespace, sm, values)
+						deployAndRead()
+
+						Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement(HavePrefix("--tracing-config-file=")))
+					})
+
+					It("should properly configure the tracing settings", func() {
+						values.Tracing = &TracingConfig{
+							Endpoint:  "opentelemetry-collector-collector:4320",
+							PodLabels: map[string]string{"networking.resources.gardener.cloud/to-opentelemetry-collector-collector-tcp-4320": "allowed"},
+						}
+						kapi = New(kubernetesInterface, namespace, sm, values)
+						deployAndRead()
+
+						configMapList := &corev1.ConfigMapList{}
+						Expect(c.List(ctx, configMapList, client.InNamespace(namespace))).To(Succeed())
+						var configMapName string
+						for _, configMap := range configMapList.Items {
+							if strings.HasPrefix(configMap.Name, "kube-apiserver-tracing-config") {
+								configMapName = configMap.Name
+							}
+						}
+						Expect(configMapName).NotTo(BeEmpty())
+
+						Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("networking.resources.gardener.cloud/to-opentelemetry-collector-collector-tcp-4320", "allowed"))
+						Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement(
+							"--tracing-config-file=/etc/kubernetes/tracing/config.yaml",
+						))
+						Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(
+							corev1.VolumeMount{
+								Name:      "tracing-config",
+								MountPath: "/etc/kubernetes/tracing",
+								ReadOnly:  true,
+							},
+						))
+						Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(
+							corev1.Volume{
+								Name: "tracing-config",
+								VolumeSource: corev1.VolumeSource{
+									ConfigMap: &corev1.ConfigMapVolumeSource{
+										LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+									},
+								},
+							},
+						))
+					})
+				})
 			})
 		})
 
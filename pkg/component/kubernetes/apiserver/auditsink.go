@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// AuditSinkDataKey is the key in the data of the ConfigMap containing the audit sink configuration.
+	AuditSinkDataKey = "sink.yaml"
+
+	maxAuditSinkBatchMaxSize    = 10000
+	maxAuditSinkBatchBufferSize = 100000
+	maxAuditSinkBatchMaxWait    = 5 * time.Minute
+)
+
+// AuditSink contains the configuration for shipping the audit events of the kube-apiserver to a sink defined by the
+// shoot owner. Exactly one of webhook or otlp must be set.
+type AuditSink struct {
+	// Webhook configures a webhook the audit events are sent to in batches.
+	Webhook *AuditSinkWebhook `json:"webhook,omitempty"`
+	// OTLP configures an OTLP/HTTP endpoint the audit events are sent to via the OpenTelemetry Collector of the
+	// control plane.
+	OTLP *AuditSinkOTLP `json:"otlp,omitempty"`
+	// Batch configures the batching and buffering of the audit events in the kube-apiserver.
+	Batch *AuditSinkBatch `json:"batch,omitempty"`
+}
+
+// AuditSinkWebhook configures a webhook audit sink.
+type AuditSinkWebhook struct {
+	// Kubeconfig references the secret key containing the kubeconfig which defines the webhook endpoint and credentials.
+	Kubeconfig gardenerutils.SecretKeyReference `json:"kubeconfig"`
+	// Version is the API group and version used for serializing the audit events sent to the webhook.
+	Version *string `json:"version,omitempty"`
+}
+
+// AuditSinkOTLP configures an OTLP audit sink.
+type AuditSinkOTLP struct {
+	// Endpoint is the https URL of the OTLP/HTTP endpoint.
+	Endpoint string `json:"endpoint"`
+	// Authorization references the secret key containing the value of the `Authorization` header sent to the endpoint.
+	Authorization *gardenerutils.SecretKeyReference `json:"authorization,omitempty"`
+}
+
+// AuditSinkBatch configures the batching and buffering of the audit events.
+type AuditSinkBatch struct {
+	// MaxSize is the maximum number of audit events in a batch.
+	MaxSize *int32 `json:"maxSize,omitempty"`
+	// MaxWait is the amount of time to wait before sending a batch that hasn't reached the maximum size.
+	MaxWait *metav1.Duration `json:"maxWait,omitempty"`
+	// BufferSize is the number of audit events buffered before they are batched and sent. Audit events are dropped
+	// when the buffer is full.
+	BufferSize *int32 `json:"bufferSize,omitempty"`
+}
+
+// ParseAuditSink parses and validates the given audit sink configuration. Secret references are validated against the
+// given resource references of the Shoot.
+func ParseAuditSink(data []byte, resources []gardencorev1beta1.NamedResourceReference) (*AuditSink, error) {
+	sink := &AuditSink{}
+	if err := yaml.UnmarshalStrict(data, sink); err != nil {
+		return nil, fmt.Errorf("failed parsing audit sink: %w", err)
+	}
+
+	if errs := validateAuditSink(sink, resources); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid audit sink: %w", errs.ToAggregate())
+	}
+
+	return sink, nil
+}
+
+func validateAuditSink(sink *AuditSink, resources []gardencorev1beta1.NamedResourceReference) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case sink.Webhook != nil && sink.OTLP != nil:
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("otlp"), "must not be set together with webhook"))
+	case sink.Webhook == nil && sink.OTLP == nil:
+		allErrs = append(allErrs, field.Required(field.NewPath(""), "exactly one of webhook or otlp must be set"))
+	}
+
+	if sink.Webhook != nil {
+		fldPath := field.NewPath("webhook")
+		allErrs = append(allErrs, gardenerutils.ValidateSecretKeyReference(sink.Webhook.Kubeconfig, resources, fldPath.Child("kubeconfig"))...)
+		if sink.Webhook.Version != nil && *sink.Webhook.Version != "audit.k8s.io/v1" {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("version"), *sink.Webhook.Version, []string{"audit.k8s.io/v1"}))
+		}
+	}
+
+	if sink.OTLP != nil {
+		fldPath := field.NewPath("otlp")
+		if !strings.HasPrefix(sink.OTLP.Endpoint, "https://") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), sink.OTLP.Endpoint, "must be an https URL"))
+		}
+		if sink.OTLP.Authorization != nil {
+			allErrs = append(allErrs, gardenerutils.ValidateSecretKeyReference(*sink.OTLP.Authorization, resources, fldPath.Child("authorization"))...)
+		}
+	}
+
+	if sink.Batch != nil {
+		fldPath := field.NewPath("batch")
+		if v := sink.Batch.MaxSize; v != nil && (*v <= 0 || *v > maxAuditSinkBatchMaxSize) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), *v, fmt.Sprintf("must be between 1 and %d", maxAuditSinkBatchMaxSize)))
+		}
+		if v := sink.Batch.BufferSize; v != nil && (*v <= 0 || *v > maxAuditSinkBatchBufferSize) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bufferSize"), *v, fmt.Sprintf("must be between 1 and %d", maxAuditSinkBatchBufferSize)))
+		}
+		if v := sink.Batch.MaxWait; v != nil && (v.Duration <= 0 || v.Duration > maxAuditSinkBatchMaxWait) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxWait"), v.Duration.String(), fmt.Sprintf("must be positive and at most %s", maxAuditSinkBatchMaxWait)))
+		}
+	}
+
+	return allErrs
+}
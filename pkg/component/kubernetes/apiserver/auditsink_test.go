@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("AuditSink", func() {
+	var resources []gardencorev1beta1.NamedResourceReference
+
+	BeforeEach(func() {
+		resources = []gardencorev1beta1.NamedResourceReference{
+			{Name: "audit-sink", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-audit-sink"}},
+			{Name: "credentials", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-credentials"}},
+		}
+	})
+
+	Describe("#ParseAuditSink", func() {
+		It("should parse a webhook sink", func() {
+			sink, err := ParseAuditSink([]byte(`webhook:
+  kubeconfig:
+    resourceName: credentials
+    key: kubeconfig
+  version: audit.k8s.io/v1
+batch:
+  maxSize: 100
+  maxWait: 5s
+  bufferSize: 1000
+`), resources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink).To(Equal(&AuditSink{
+				Webhook: &AuditSinkWebhook{
+					Kubeconfig: gardenerutils.SecretKeyReference{ResourceName: "credentials", Key: "kubeconfig"},
+					Version:    ptr.To("audit.k8s.io/v1"),
+				},
+				Batch: &AuditSinkBatch{
+					MaxSize:    ptr.To[int32](100),
+					MaxWait:    &metav1.Duration{Duration: 5 * time.Second},
+					BufferSize: ptr.To[int32](1000),
+				},
+			}))
+		})
+
+		It("should parse an OTLP sink", func() {
+			sink, err := ParseAuditSink([]byte(`otlp:
+  endpoint: https://otlp.example.com
+  authorization:
+    resourceName: credentials
+    key: authorization
+`), resources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink).To(Equal(&AuditSink{
+				OTLP: &AuditSinkOTLP{
+					Endpoint:      "https://otlp.example.com",
+					Authorization: &gardenerutils.SecretKeyReference{ResourceName: "credentials", Key: "authorization"},
+				},
+			}))
+		})
+
+		It("should fail for unknown fields", func() {
+			_, err := ParseAuditSink([]byte("foo: bar\n"), resources)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing audit sink")))
+		})
+
+		DescribeTable("should fail for invalid sinks",
+			func(data, errorSubstring string) {
+				_, err := ParseAuditSink([]byte(data), resources)
+				Expect(err).To(MatchError(ContainSubstring(errorSubstring)))
+			},
+
+			Entry("no sink", "batch:\n  maxSize: 1\n", "exactly one of webhook or otlp must be set"),
+			Entry("both sinks", "webhook:\n  kubeconfig:\n    resourceName: credentials\n    key: kubeconfig\notlp:\n  endpoint: https://otlp.example.com\n", "must not be set together with webhook"),
+			Entry("unknown secret", "webhook:\n  kubeconfig:\n    resourceName: foo\n    key: kubeconfig\n", "must refer to a secret in the .spec.resources of the Shoot"),
+			Entry("reference to ConfigMap", "webhook:\n  kubeconfig:\n    resourceName: audit-sink\n    key: kubeconfig\n", "must refer to a secret in the .spec.resources of the Shoot"),
+			Entry("missing key", "webhook:\n  kubeconfig:\n    resourceName: credentials\n", "must provide a key"),
+			Entry("unsupported version", "webhook:\n  kubeconfig:\n    resourceName: credentials\n    key: kubeconfig\n  version: audit.k8s.io/v1beta1\n", "Unsupported value"),
+			Entry("http endpoint", "otlp:\n  endpoint: http://otlp.example.com\n", "must be an https URL"),
+			Entry("batch size too large", "otlp:\n  endpoint: https://otlp.example.com\nbatch:\n  maxSize: 10001\n", "must be between 1 and 10000"),
+			Entry("buffer size not positive", "otlp:\n  endpoint: https://otlp.example.com\nbatch:\n  bufferSize: 0\n", "must be between 1 and 100000"),
+			Entry("max wait too long", "otlp:\n  endpoint: https://otlp.example.com\nbatch:\n  maxWait: 10m\n", "must be positive and at most 5m0s"),
+		)
+	})
+})
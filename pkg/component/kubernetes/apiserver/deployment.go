@@ -114,6 +114,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 	secretAdmissionKubeconfigs *corev1.Secret,
 	configMapEgressSelector *corev1.ConfigMap,
 	configMapEnvoyConfig *corev1.ConfigMap,
+	configMapTracingConfig *corev1.ConfigMap,
 	secretETCDEncryptionConfiguration *corev1.Secret,
 	secretOIDCCABundle *corev1.Secret,
 	secretServiceAccountKey *corev1.Secret,
@@ -389,6 +390,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 		}
 		k.handleAuthenticationWebhookSettings(deployment, secretAuthenticationWebhookKubeconfig)
 		k.handleAuthorizationSettings(deployment, configMapAuthorizationConfig, secretAuthorizationWebhooksKubeconfigs)
+		k.handleTracingSettings(deployment, configMapTracingConfig)
 		if err := k.handleVPNSettings(deployment, serviceAccount, configMapEgressSelector, configMapEnvoyConfig, secretHTTPProxyClient, secretHTTPProxy, secretHAVPNSeedClient, secretHAVPNSeedClientSeedTLSAuth); err != nil {
 			return err
 		}
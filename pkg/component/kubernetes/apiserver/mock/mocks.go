@@ -125,6 +125,18 @@ func (mr *MockInterfaceMockRecorder) GetValues() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValues", reflect.TypeOf((*MockInterface)(nil).GetValues))
 }
 
+// SetAuditWebhookConfig mocks base method.
+func (m *MockInterface) SetAuditWebhookConfig(arg0 *apiserver.AuditWebhook) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAuditWebhookConfig", arg0)
+}
+
+// SetAuditWebhookConfig indicates an expected call of SetAuditWebhookConfig.
+func (mr *MockInterfaceMockRecorder) SetAuditWebhookConfig(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuditWebhookConfig", reflect.TypeOf((*MockInterface)(nil).SetAuditWebhookConfig), arg0)
+}
+
 // SetAutoscalingAPIServerResources mocks base method.
 func (m *MockInterface) SetAutoscalingAPIServerResources(arg0 v1.ResourceRequirements) {
 	m.ctrl.T.Helper()
@@ -257,6 +269,18 @@ func (mr *MockInterfaceMockRecorder) SetServiceNetworkCIDRs(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServiceNetworkCIDRs", reflect.TypeOf((*MockInterface)(nil).SetServiceNetworkCIDRs), arg0)
 }
 
+// SetTracingConfig mocks base method.
+func (m *MockInterface) SetTracingConfig(arg0 *apiserver0.TracingConfig) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTracingConfig", arg0)
+}
+
+// SetTracingConfig indicates an expected call of SetTracingConfig.
+func (mr *MockInterfaceMockRecorder) SetTracingConfig(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTracingConfig", reflect.TypeOf((*MockInterface)(nil).SetTracingConfig), arg0)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -151,6 +151,21 @@ func (k *kubeAPIServer) reconcilePrometheusRule(ctx context.Context, prometheusR
 							"description": "The API servers cumulative failure rate in logging audit events is greater than 2%.",
 						},
 					},
+					{
+						Alert: "KubeApiServerAuditSinkDeliveryFailures",
+						Expr:  intstr.FromString(`sum(rate(apiserver_audit_error_total{plugin="webhook",job="kube-apiserver"}[5m])) > 0`),
+						For:   ptr.To(monitoringv1.Duration("15m")),
+						Labels: map[string]string{
+							"service":    "auditlog",
+							"severity":   "warning",
+							"type":       "seed",
+							"visibility": "owner",
+						},
+						Annotations: map[string]string{
+							"summary":     "Audit events cannot be delivered to the configured audit sink",
+							"description": "The API server fails to deliver audit events to the configured audit sink or drops them because its buffer is full.",
+						},
+					},
 					{
 						Record: "shoot:apiserver_audit_event_total:sum",
 						Expr:   intstr.FromString(`sum(rate(apiserver_audit_event_total{job="kube-apiserver"}[5m]))`),
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+	tracingapiv1 "k8s.io/component-base/tracing/api/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	configMapTracingConfigNamePrefix = "kube-apiserver-tracing-config"
+
+	volumeNameTracingConfig      = "tracing-config"
+	volumeMountPathTracingConfig = "/etc/kubernetes/tracing"
+
+	// DataKeyConfigMapTracingConfig is the key of the ConfigMap containing the tracing configuration.
+	DataKeyConfigMapTracingConfig = "config.yaml"
+)
+
+// TracingConfig contains information for configuring the export of traces by the kube-apiserver.
+type TracingConfig struct {
+	// Endpoint is the address of the OTLP/gRPC receiver the spans are sent to.
+	Endpoint string
+	// SamplingRatePerMillion is the number of samples to collect per million spans.
+	SamplingRatePerMillion *int32
+	// PodLabels are additional labels for the kube-apiserver pods, e.g. for allowing network traffic to the receiver.
+	PodLabels map[string]string
+}
+
+func (k *kubeAPIServer) reconcileConfigMapTracingConfig(ctx context.Context, configMap *corev1.ConfigMap) error {
+	if k.values.Tracing == nil {
+		// We don't delete the configmap here as we don't know its name (as it's unique). Instead, we rely on the usual
+		// garbage collection for unique secrets/configmaps.
+		return nil
+	}
+
+	tracingConfiguration := &apiserverv1beta1.TracingConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiserverv1beta1.ConfigSchemeGroupVersion.String(),
+			Kind:       "TracingConfiguration",
+		},
+		TracingConfiguration: tracingapiv1.TracingConfiguration{
+			Endpoint:               ptr.To(k.values.Tracing.Endpoint),
+			SamplingRatePerMillion: k.values.Tracing.SamplingRatePerMillion,
+		},
+	}
+
+	data, err := runtime.Encode(ConfigCodec, tracingConfiguration)
+	if err != nil {
+		return fmt.Errorf("unable to encode tracing configuration: %w", err)
+	}
+
+	configMap.Data = map[string]string{DataKeyConfigMapTracingConfig: string(data)}
+	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
+	return client.IgnoreAlreadyExists(k.client.Client().Create(ctx, configMap))
+}
+
+func (k *kubeAPIServer) handleTracingSettings(deployment *appsv1.Deployment, configMapTracingConfig *corev1.ConfigMap) {
+	if k.values.Tracing == nil {
+		return
+	}
+
+	for key, value := range k.values.Tracing.PodLabels {
+		metav1.SetMetaDataLabel(&deployment.Spec.Template.ObjectMeta, key, value)
+	}
+
+	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--tracing-config-file=%s/%s", volumeMountPathTracingConfig, DataKeyConfigMapTracingConfig))
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      volumeNameTracingConfig,
+		MountPath: volumeMountPathTracingConfig,
+		ReadOnly:  true,
+	})
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeNameTracingConfig,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{
+				Name: configMapTracingConfig.Name,
+			}},
+		},
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins"
 	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins/filter"
 	fluentbitv1alpha2parser "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins/parser"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -93,3 +94,36 @@ func generateClusterParsers() []*fluentbitv1alpha2.ClusterParser {
 		},
 	}
 }
+
+// getThrottleClusterFilter returns a fluent-bit filter which limits the number of log lines forwarded to the Vali of a
+// shoot. It returns nil if no limit is configured.
+func (v *vali) getThrottleClusterFilter() *fluentbitv1alpha2.ClusterFilter {
+	if v.values.ClusterType != component.ClusterTypeShoot || v.values.Limits == nil || v.values.Limits.MaxLinesPerSecond == nil {
+		return nil
+	}
+
+	return &fluentbitv1alpha2.ClusterFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			// This filter runs before the parsers of fluent-bit because the operator orders them by name. Hence, dropped
+			// log lines are not parsed unnecessarily.
+			Name:   "01-throttle--" + v.namespace,
+			Labels: map[string]string{v1beta1constants.LabelKeyCustomLoggingResource: v1beta1constants.LabelValueCustomLoggingResource},
+		},
+		Spec: fluentbitv1alpha2.FilterSpec{
+			// The tags of the container logs have the format kubernetes.var.log.containers.<pod>_<namespace>_<container>-<id>.log
+			Match: fmt.Sprintf("kubernetes.*_%s_*", v.namespace),
+			FilterItems: []fluentbitv1alpha2.FilterItem{
+				{
+					Throttle: &fluentbitv1alpha2filter.Throttle{
+						// The alias is the value of the name label of the fluentbit_filter_drop_records_total metric.
+						CommonParams: plugins.CommonParams{Alias: "throttle-" + v.namespace},
+						Rate:         ptr.To(int64(*v.values.Limits.MaxLinesPerSecond)),
+						Window:       ptr.To[int64](5),
+						Interval:     "1s",
+						PrintStatus:  ptr.To(false),
+					},
+				},
+			},
+		},
+	}
+}
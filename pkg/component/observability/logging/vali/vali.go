@@ -10,6 +10,7 @@ import (
 	_ "embed"
 	"fmt"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig/v3"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -81,6 +82,8 @@ const (
 	kubeRBACProxyPort int32 = 8080
 
 	initLargeDirName = "init-large-dir"
+
+	defaultRetentionPeriod = 360 * time.Hour
 )
 
 var (
@@ -90,8 +93,9 @@ var (
 	//go:embed templates/vali-init.sh
 	valiInitScript string
 
-	//go:embed templates/vali-config.yaml
-	valiConfig string
+	//go:embed templates/vali-config.yaml.tpl
+	valiConfigTplContent string
+	valiConfigTemplate   *template.Template
 
 	//go:embed templates/telegraf-config.tpl
 	telegrafConfigTplContent string
@@ -103,6 +107,7 @@ var (
 )
 
 func init() {
+	valiConfigTemplate = template.Must(template.New("vali-config").Parse(valiConfigTplContent))
 	telegrafStartScriptTemplate = template.Must(template.New("telegraf-config").Funcs(sprig.TxtFuncMap()).Parse(telegrafStartScriptTplContent))
 	telegrafConfigTemplate = template.Must(template.New("telegraf-start").Funcs(sprig.TxtFuncMap()).Parse(telegrafConfigTplContent))
 }
@@ -122,6 +127,21 @@ type Values struct {
 	IngressHost             string
 	ShootNodeLoggingEnabled bool
 	Storage                 *resource.Quantity
+	Limits                  *Limits
+}
+
+// Limits are the log retention and ingestion limits of the Vali.
+type Limits struct {
+	// RetentionPeriod is the period after which logs are deleted. It must be a multiple of 24h. Defaults to 360h.
+	RetentionPeriod *metav1.Duration
+	// IngestionRateMB is the rate in megabytes per second with which Vali accepts logs. Logs exceeding the rate are
+	// discarded.
+	IngestionRateMB *int32
+	// IngestionBurstSizeMB is the burst size in megabytes with which Vali accepts logs.
+	IngestionBurstSizeMB *int32
+	// MaxLinesPerSecond is the average number of log lines per second which fluent-bit forwards to the Vali of a
+	// shoot. Log lines exceeding the rate are dropped by fluent-bit. It is only considered for shoot Valis.
+	MaxLinesPerSecond *int32
 }
 
 // Interface is the interface for the Vali deployer.
@@ -240,7 +260,14 @@ func (v *vali) Deploy(ctx context.Context) error {
 		}
 	}
 
-	valiConfigMap := v.getValiConfigMap()
+	valiConfigMap, err := v.getValiConfigMap()
+	if err != nil {
+		return err
+	}
+
+	if throttleFilter := v.getThrottleClusterFilter(); throttleFilter != nil {
+		resources = append(resources, throttleFilter)
+	}
 
 	resources = append(resources,
 		valiConfigMap,
@@ -457,7 +484,29 @@ func (v *vali) getService() *corev1.Service {
 	return service
 }
 
-func (v *vali) getValiConfigMap() *corev1.ConfigMap {
+func (v *vali) getValiConfigMap() (*corev1.ConfigMap, error) {
+	retentionPeriod := defaultRetentionPeriod
+	if v.values.Limits != nil && v.values.Limits.RetentionPeriod != nil {
+		retentionPeriod = v.values.Limits.RetentionPeriod.Duration
+	}
+
+	var ingestionRateMB, ingestionBurstSizeMB int32
+	if v.values.Limits != nil {
+		ingestionRateMB = ptr.Deref(v.values.Limits.IngestionRateMB, 0)
+		ingestionBurstSizeMB = ptr.Deref(v.values.Limits.IngestionBurstSizeMB, 0)
+	}
+
+	var valiConfig bytes.Buffer
+	if err := valiConfigTemplate.Execute(&valiConfig, map[string]any{
+		// Vali only accepts retention periods which are a multiple of the index period (24h), hence the period is
+		// always rendered in hours.
+		"RetentionPeriod":      fmt.Sprintf("%dh", int64(retentionPeriod.Hours())),
+		"IngestionRateMB":      ingestionRateMB,
+		"IngestionBurstSizeMB": ingestionBurstSizeMB,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to render vali configuration: %w", err)
+	}
+
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "vali-config",
@@ -465,14 +514,14 @@ func (v *vali) getValiConfigMap() *corev1.ConfigMap {
 			Labels:    getLabels(),
 		},
 		Data: map[string]string{
-			valiDataKeyConfig:     valiConfig,
+			valiDataKeyConfig:     valiConfig.String(),
 			curatorDataKeyConfig:  curatorConfig,
 			valiDataKeyInitScript: valiInitScript,
 		},
 	}
 
 	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
-	return configMap
+	return configMap, nil
 }
 
 func (v *vali) getTelegrafConfigMap() (*corev1.ConfigMap, error) {
@@ -906,6 +955,8 @@ func (v *vali) getServiceMonitor() *monitoringv1.ServiceMonitor {
 					"vali_ingester_samples_per_chunk_count",
 					"vali_ingester_sent_chunks",
 					"vali_panic_total",
+					"vali_discarded_samples_total",
+					"vali_discarded_bytes_total",
 					"vali_logql_querystats_duplicates_total",
 					"vali_logql_querystats_ingester_sent_lines_total",
 					"prometheus_target_scrapes_sample_out_of_order_total",
@@ -954,21 +1005,38 @@ func (v *vali) getPrometheusRule() *monitoringv1.PrometheusRule {
 		Spec: monitoringv1.PrometheusRuleSpec{
 			Groups: []monitoringv1.RuleGroup{{
 				Name: "vali.rules",
-				Rules: []monitoringv1.Rule{{
-					Alert: "ValiDown",
-					Expr:  intstr.FromString(`absent(up{job="vali"} == 1)`),
-					For:   ptr.To(monitoringv1.Duration("30m")),
-					Labels: map[string]string{
-						"service":    "logging",
-						"severity":   "warning",
-						"type":       "seed",
-						"visibility": "operator",
+				Rules: []monitoringv1.Rule{
+					{
+						Alert: "ValiDown",
+						Expr:  intstr.FromString(`absent(up{job="vali"} == 1)`),
+						For:   ptr.To(monitoringv1.Duration("30m")),
+						Labels: map[string]string{
+							"service":    "logging",
+							"severity":   "warning",
+							"type":       "seed",
+							"visibility": "operator",
+						},
+						Annotations: map[string]string{
+							"description": description,
+							"summary":     "Vali is down",
+						},
 					},
-					Annotations: map[string]string{
-						"description": description,
-						"summary":     "Vali is down",
+					{
+						Alert: "ValiLogsDiscarded",
+						Expr:  intstr.FromString(`sum by (reason) (rate(vali_discarded_samples_total{job="vali"}[5m])) > 0`),
+						For:   ptr.To(monitoringv1.Duration("15m")),
+						Labels: map[string]string{
+							"service":    "logging",
+							"severity":   "info",
+							"type":       "seed",
+							"visibility": "operator",
+						},
+						Annotations: map[string]string{
+							"description": "Vali discards log lines for reason {{ $labels.reason }}. Logs exceeding the configured ingestion limits are lost.",
+							"summary":     "Vali discards log lines",
+						},
 					},
-				}},
+				},
 			}},
 		},
 	}
@@ -7,7 +7,12 @@ package vali_test
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins"
+	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins/filter"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
@@ -78,6 +83,7 @@ var _ = Describe("Vali", func() {
 			managedResourceTarget       *resourcesv1alpha1.ManagedResource
 			managedResourceSecretTarget *corev1.Secret
 			consistOf                   func(...client.Object) gomegatypes.GomegaMatcher
+			contain                     func(...client.Object) gomegatypes.GomegaMatcher
 
 			fakeSecretManager secretsmanager.Interface
 			storage           = resource.MustParse("60Gi")
@@ -88,6 +94,7 @@ var _ = Describe("Vali", func() {
 			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 			fakeSecretManager = fakesecretsmanager.New(c, namespace)
 			consistOf = NewManagedResourceConsistOfObjectsMatcher(c)
+			contain = NewManagedResourceContainsObjectsMatcher(c)
 
 			Expect(err).ToNot(HaveOccurred())
 
@@ -393,6 +400,118 @@ var _ = Describe("Vali", func() {
 
 			test.PrometheusRule(getPrometheusRule("aggregate"), "testdata/aggregate-vali.prometheusrule.test.yaml")
 		})
+
+		Context("limits", func() {
+			var throttleClusterFilter *fluentbitv1alpha2.ClusterFilter
+
+			BeforeEach(func() {
+				throttleClusterFilter = &fluentbitv1alpha2.ClusterFilter{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "01-throttle--" + namespace,
+						Labels: map[string]string{"fluentbit.gardener/type": "seed"},
+					},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: "kubernetes.*_" + namespace + "_*",
+						FilterItems: []fluentbitv1alpha2.FilterItem{{
+							Throttle: &fluentbitv1alpha2filter.Throttle{
+								CommonParams: plugins.CommonParams{Alias: "throttle-" + namespace},
+								Rate:         ptr.To[int64](500),
+								Window:       ptr.To[int64](5),
+								Interval:     "1s",
+								PrintStatus:  ptr.To(false),
+							},
+						}},
+					},
+				}
+			})
+
+			It("should successfully deploy the limits for shoot", func() {
+				valiDeployer := New(
+					c,
+					namespace,
+					fakeSecretManager,
+					Values{
+						Replicas:          1,
+						ValiImage:         valiImage,
+						CuratorImage:      curatorImage,
+						InitLargeDirImage: initLargeDirImage,
+						PriorityClassName: priorityClassName,
+						ClusterType:       "shoot",
+						Limits: &Limits{
+							RetentionPeriod:      &metav1.Duration{Duration: 7 * 24 * time.Hour},
+							IngestionRateMB:      ptr.To[int32](2),
+							IngestionBurstSizeMB: ptr.To[int32](4),
+							MaxLinesPerSecond:    ptr.To[int32](500),
+						},
+					},
+				)
+
+				Expect(valiDeployer.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+
+				configMap := getValiConfigMap()
+				configMap.Name = "vali-config"
+				configMap.Data["vali.yaml"] = strings.NewReplacer(
+					"reject_old_samples_max_age: 168h\n", "reject_old_samples_max_age: 168h\n  ingestion_rate_mb: 2\n  ingestion_burst_size_mb: 4\n",
+					"360h", "168h",
+				).Replace(configMap.Data["vali.yaml"])
+				utilruntime.Must(kubernetesutils.MakeUnique(configMap))
+
+				Expect(managedResource).To(contain(
+					configMap,
+					throttleClusterFilter,
+				))
+			})
+
+			It("should not deploy a throttle filter for shoot if no line limit is configured", func() {
+				valiDeployer := New(
+					c,
+					namespace,
+					fakeSecretManager,
+					Values{
+						Replicas:          1,
+						ValiImage:         valiImage,
+						CuratorImage:      curatorImage,
+						InitLargeDirImage: initLargeDirImage,
+						PriorityClassName: priorityClassName,
+						ClusterType:       "shoot",
+						Limits: &Limits{
+							RetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+						},
+					},
+				)
+
+				Expect(valiDeployer.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource).NotTo(contain(throttleClusterFilter))
+			})
+
+			It("should not deploy a throttle filter for seed", func() {
+				valiDeployer := New(
+					c,
+					namespace,
+					fakeSecretManager,
+					Values{
+						Replicas:          1,
+						ValiImage:         valiImage,
+						CuratorImage:      curatorImage,
+						InitLargeDirImage: initLargeDirImage,
+						PriorityClassName: priorityClassName,
+						ClusterType:       "seed",
+						Limits: &Limits{
+							MaxLinesPerSecond: ptr.To[int32](500),
+						},
+					},
+				)
+
+				Expect(valiDeployer.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource).NotTo(contain(throttleClusterFilter))
+			})
+		})
 	})
 
 	Describe("#ResizeOrDeleteValiDataVolumeIfStorageNotTheSame", func() {
@@ -921,7 +1040,7 @@ func getServiceMonitor(label string, withTelegraf bool) *monitoringv1.ServiceMon
 				MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
 					SourceLabels: []monitoringv1.LabelName{"__name__"},
 					Action:       "keep",
-					Regex:        `^(vali_ingester_blocks_per_chunk_sum|vali_ingester_blocks_per_chunk_count|vali_ingester_chunk_age_seconds_sum|vali_ingester_chunk_age_seconds_count|vali_ingester_chunk_bounds_hours_sum|vali_ingester_chunk_bounds_hours_count|vali_ingester_chunk_compression_ratio_sum|vali_ingester_chunk_compression_ratio_count|vali_ingester_chunk_encode_time_seconds_sum|vali_ingester_chunk_encode_time_seconds_count|vali_ingester_chunk_entries_sum|vali_ingester_chunk_entries_count|vali_ingester_chunk_size_bytes_sum|vali_ingester_chunk_size_bytes_count|vali_ingester_chunk_utilization_sum|vali_ingester_chunk_utilization_count|vali_ingester_memory_chunks|vali_ingester_received_chunks|vali_ingester_samples_per_chunk_sum|vali_ingester_samples_per_chunk_count|vali_ingester_sent_chunks|vali_panic_total|vali_logql_querystats_duplicates_total|vali_logql_querystats_ingester_sent_lines_total|prometheus_target_scrapes_sample_out_of_order_total)$`,
+					Regex:        `^(vali_ingester_blocks_per_chunk_sum|vali_ingester_blocks_per_chunk_count|vali_ingester_chunk_age_seconds_sum|vali_ingester_chunk_age_seconds_count|vali_ingester_chunk_bounds_hours_sum|vali_ingester_chunk_bounds_hours_count|vali_ingester_chunk_compression_ratio_sum|vali_ingester_chunk_compression_ratio_count|vali_ingester_chunk_encode_time_seconds_sum|vali_ingester_chunk_encode_time_seconds_count|vali_ingester_chunk_entries_sum|vali_ingester_chunk_entries_count|vali_ingester_chunk_size_bytes_sum|vali_ingester_chunk_size_bytes_count|vali_ingester_chunk_utilization_sum|vali_ingester_chunk_utilization_count|vali_ingester_memory_chunks|vali_ingester_received_chunks|vali_ingester_samples_per_chunk_sum|vali_ingester_samples_per_chunk_count|vali_ingester_sent_chunks|vali_panic_total|vali_discarded_samples_total|vali_discarded_bytes_total|vali_logql_querystats_duplicates_total|vali_logql_querystats_ingester_sent_lines_total|prometheus_target_scrapes_sample_out_of_order_total)$`,
 				}},
 			}},
 		},
@@ -969,21 +1088,38 @@ func getPrometheusRule(label string) *monitoringv1.PrometheusRule {
 		Spec: monitoringv1.PrometheusRuleSpec{
 			Groups: []monitoringv1.RuleGroup{{
 				Name: "vali.rules",
-				Rules: []monitoringv1.Rule{{
-					Alert: "ValiDown",
-					Expr:  intstr.FromString(`absent(up{job="vali"} == 1)`),
-					For:   ptr.To(monitoringv1.Duration("30m")),
-					Labels: map[string]string{
-						"service":    "logging",
-						"severity":   "warning",
-						"type":       "seed",
-						"visibility": "operator",
+				Rules: []monitoringv1.Rule{
+					{
+						Alert: "ValiDown",
+						Expr:  intstr.FromString(`absent(up{job="vali"} == 1)`),
+						For:   ptr.To(monitoringv1.Duration("30m")),
+						Labels: map[string]string{
+							"service":    "logging",
+							"severity":   "warning",
+							"type":       "seed",
+							"visibility": "operator",
+						},
+						Annotations: map[string]string{
+							"description": description,
+							"summary":     "Vali is down",
+						},
 					},
-					Annotations: map[string]string{
-						"description": description,
-						"summary":     "Vali is down",
+					{
+						Alert: "ValiLogsDiscarded",
+						Expr:  intstr.FromString(`sum by (reason) (rate(vali_discarded_samples_total{job="vali"}[5m])) > 0`),
+						For:   ptr.To(monitoringv1.Duration("15m")),
+						Labels: map[string]string{
+							"service":    "logging",
+							"severity":   "info",
+							"type":       "seed",
+							"visibility": "operator",
+						},
+						Annotations: map[string]string{
+							"description": "Vali discards log lines for reason {{ $labels.reason }}. Logs exceeding the configured ingestion limits are lost.",
+							"summary":     "Vali discards log lines",
+						},
 					},
-				}},
+				},
 			}},
 		},
 	}
@@ -60,7 +60,7 @@ func (a *alertManager) alertManager() *monitoringv1.Alertmanager {
 		},
 	}
 
-	if a.hasSMTPSecret() {
+	if a.hasConfig() {
 		obj.Spec.AlertmanagerConfiguration = &monitoringv1.AlertmanagerConfiguration{Name: a.name()}
 	}
 
@@ -76,6 +76,12 @@ func (a *alertManager) alertManager() *monitoringv1.Alertmanager {
 		obj.Spec.PodMetadata.Labels[v1beta1constants.GardenRole] = v1beta1constants.GardenRoleMonitoring
 	}
 
+	// The receivers are configured by the shoot owner, hence, the Alertmanager must not be able to reach private
+	// networks, e.g. host names resolving to addresses of the seed cluster.
+	if len(a.values.Receivers) > 0 {
+		delete(obj.Spec.PodMetadata.Labels, v1beta1constants.LabelNetworkPolicyToPrivateNetworks)
+	}
+
 	if a.values.Ingress != nil {
 		obj.Spec.ExternalURL = "https://" + a.values.Ingress.Host
 	}
@@ -571,6 +571,77 @@ var _ = Describe("Alertmanager", func() {
 					smtpSecret,
 				))
 			})
+
+			When("additional receivers are configured", func() {
+				BeforeEach(func() {
+					values.Receivers = []gardencorev1beta1.AlertingReceiver{
+						{
+							Name:       "oncall",
+							Severities: []string{"critical", "blocker"},
+							Components: []string{"kube-apiserver", "etcd"},
+							PagerDuty:  &gardencorev1beta1.PagerDutyAlertingReceiver{RoutingKey: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "pagerduty", Key: "routingKey"}},
+						},
+						{
+							Name:         "chat",
+							SendResolved: ptr.To(true),
+							Slack:        &gardencorev1beta1.SlackAlertingReceiver{APIURL: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "slack", Key: "url"}, Channel: ptr.To("#alerts")},
+						},
+						{
+							Name:    "tooling",
+							Webhook: &gardencorev1beta1.WebhookAlertingReceiver{URL: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "webhook", Key: "url"}},
+						},
+					}
+					values.ReferencedResources = []gardencorev1beta1.NamedResourceReference{
+						{Name: "pagerduty", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "pd"}},
+						{Name: "slack", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "slack"}},
+						{Name: "webhook", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "hook"}},
+					}
+
+					delete(alertManager.Spec.PodMetadata.Labels, "networking.gardener.cloud/to-private-networks")
+
+					config.Spec.Route.Routes = []apiextensionsv1.JSON{
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"},{"matchType":"=~","name":"severity","value":"critical|blocker"},{"matchType":"=~","name":"service","value":"kube-apiserver|etcd"}],"receiver":"oncall"}`)},
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"}],"receiver":"chat"}`)},
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"}],"receiver":"tooling"}`)},
+						config.Spec.Route.Routes[0],
+					}
+					config.Spec.Receivers = []monitoringv1alpha1.Receiver{
+						config.Spec.Receivers[0],
+						{Name: "oncall", PagerDutyConfigs: []monitoringv1alpha1.PagerDutyConfig{{RoutingKey: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ref-pd"}, Key: "routingKey"}}}},
+						{Name: "chat", SlackConfigs: []monitoringv1alpha1.SlackConfig{{SendResolved: ptr.To(true), APIURL: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ref-slack"}, Key: "url"}, Channel: "#alerts"}}},
+						{Name: "tooling", WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{URLSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ref-hook"}, Key: "url"}}}},
+						config.Spec.Receivers[1],
+					}
+				})
+
+				It("should successfully deploy all resources", func() {
+					Expect(managedResource).To(consistOf(
+						service,
+						alertManager,
+						vpa,
+						config,
+						smtpSecret,
+					))
+				})
+
+				When("no alerting smtp secret is configured", func() {
+					BeforeEach(func() {
+						values.AlertingSMTPSecret = nil
+
+						config.Spec.Route.Routes = config.Spec.Route.Routes[:3]
+						config.Spec.Receivers = config.Spec.Receivers[:4]
+					})
+
+					It("should successfully deploy all resources", func() {
+						Expect(managedResource).To(consistOf(
+							service,
+							alertManager,
+							vpa,
+							config,
+						))
+					})
+				})
+			})
 		})
 	})
 
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
@@ -34,6 +35,8 @@ type Interface interface {
 	SetIngressAuthSecret(*corev1.Secret)
 	// SetIngressWildcardCertSecret sets the ingress wildcard certificate secret name.
 	SetIngressWildcardCertSecret(*corev1.Secret)
+	// SetReceivers sets the additional receivers and the resources referenced by the Shoot.
+	SetReceivers([]gardencorev1beta1.AlertingReceiver, []gardencorev1beta1.NamedResourceReference)
 }
 
 // Values contains configuration values for the AlertManager resources.
@@ -59,6 +62,11 @@ type Values struct {
 	// EmailReceivers is a list of email addresses to which alerts should be sent. If this list is empty, the alerts
 	// will be sent to the email address in `.data.to` in the alerting SMTP secret.
 	EmailReceivers []string
+	// Receivers is a list of additional receivers to which alerts should be sent.
+	Receivers []gardencorev1beta1.AlertingReceiver
+	// ReferencedResources are the resources referenced by the Shoot. The credentials of the receivers are read from
+	// the copies of the referenced secrets in the namespace of the AlertManager.
+	ReferencedResources []gardencorev1beta1.NamedResourceReference
 	// Ingress contains configuration for exposing this AlertManager instance via an Ingress resource.
 	Ingress *IngressValues
 }
@@ -105,12 +113,17 @@ func (a *alertManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	config, err := a.config()
+	if err != nil {
+		return err
+	}
+
 	resources, err := registry.AddAllAndSerialize(
 		a.service(),
 		a.alertManager(),
 		a.vpa(),
 		a.podDisruptionBudget(),
-		a.config(),
+		config,
 		a.smtpSecret(),
 		ingress,
 	)
@@ -155,6 +168,11 @@ func (a *alertManager) SetIngressWildcardCertSecret(secret *corev1.Secret) {
 	}
 }
 
+func (a *alertManager) SetReceivers(receivers []gardencorev1beta1.AlertingReceiver, referencedResources []gardencorev1beta1.NamedResourceReference) {
+	a.values.Receivers = receivers
+	a.values.ReferencedResources = referencedResources
+}
+
 func (a *alertManager) name() string {
 	return "alertmanager-" + a.values.Name
 }
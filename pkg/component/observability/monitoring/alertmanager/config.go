@@ -13,22 +13,43 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 )
 
-const dataKeyAuthPassword = "auth_password"
+const (
+	dataKeyAuthPassword = "auth_password"
 
-func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
-	if !a.hasSMTPSecret() {
-		return nil
-	}
+	receiverNameDevNull = "dev-null"
+	receiverNameEmail   = "email-kubernetes-ops"
+)
 
-	var (
-		emailReceiverName = "email-kubernetes-ops"
-		visibility        = "operator"
-	)
+func (a *alertManager) config() (*monitoringv1alpha1.AlertmanagerConfig, error) {
+	if !a.hasConfig() {
+		return nil, nil
+	}
 
+	visibility := "operator"
 	if a.values.ClusterType == component.ClusterTypeShoot {
 		visibility = "owner"
 	}
 
+	receivers, routes, err := a.receiverConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	receivers = append([]monitoringv1alpha1.Receiver{{Name: receiverNameDevNull}}, receivers...)
+
+	if a.hasSMTPSecret() {
+		receivers = append(receivers, monitoringv1alpha1.Receiver{
+			Name:         receiverNameEmail,
+			EmailConfigs: a.emailConfigs(),
+		})
+		// email only for critical and blocker
+		routes = append(routes, apiextensionsv1.JSON{Raw: []byte(`
+				  {"matchers": [{"name": "visibility",
+				                 "matchType": "=~",
+				                 "value": "all|` + visibility + `"}],
+				   "receiver": "` + receiverNameEmail + `"}`)})
+	}
+
 	return &monitoringv1alpha1.AlertmanagerConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      a.name(),
@@ -50,13 +71,8 @@ func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
 				// If an alert has successfully been sent, wait 'repeat_interval' to resend them.
 				RepeatInterval: "72h",
 				// Send alerts by default to nowhere
-				Receiver: "dev-null",
-				// email only for critical and blocker
-				Routes: []apiextensionsv1.JSON{{Raw: []byte(`
-				  {"matchers": [{"name": "visibility",
-				                 "matchType": "=~",
-				                 "value": "all|` + visibility + `"}],
-				   "receiver": "` + emailReceiverName + `"}`)}},
+				Receiver: receiverNameDevNull,
+				Routes:   routes,
 			},
 			InhibitRules: []monitoringv1alpha1.InhibitRule{
 				// Apply inhibition if the alert name is the same.
@@ -97,15 +113,9 @@ func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
 					Equal:       []string{"cluster"},
 				},
 			},
-			Receivers: []monitoringv1alpha1.Receiver{
-				{Name: "dev-null"},
-				{
-					Name:         emailReceiverName,
-					EmailConfigs: a.emailConfigs(),
-				},
-			},
+			Receivers: receivers,
 		},
-	}
+	}, nil
 }
 
 func (a *alertManager) smtpSecret() *corev1.Secret {
@@ -123,6 +133,11 @@ func (a *alertManager) smtpSecret() *corev1.Secret {
 	}
 }
 
+// hasConfig returns whether an AlertmanagerConfig is deployed, i.e., whether alerts are sent to any receiver.
+func (a *alertManager) hasConfig() bool {
+	return a.hasSMTPSecret() || len(a.values.Receivers) > 0
+}
+
 func (a *alertManager) hasSMTPSecret() bool {
 	return a.values.AlertingSMTPSecret != nil && string(a.values.AlertingSMTPSecret.Data["auth_type"]) == "smtp"
 }
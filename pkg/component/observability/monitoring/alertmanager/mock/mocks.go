@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gomock "go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 )
@@ -93,6 +94,18 @@ func (mr *MockInterfaceMockRecorder) SetIngressWildcardCertSecret(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIngressWildcardCertSecret", reflect.TypeOf((*MockInterface)(nil).SetIngressWildcardCertSecret), arg0)
 }
 
+// SetReceivers mocks base method.
+func (m *MockInterface) SetReceivers(arg0 []v1beta1.AlertingReceiver, arg1 []v1beta1.NamedResourceReference) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetReceivers", arg0, arg1)
+}
+
+// SetReceivers indicates an expected call of SetReceivers.
+func (mr *MockInterfaceMockRecorder) SetReceivers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReceivers", reflect.TypeOf((*MockInterface)(nil).SetReceivers), arg0, arg1)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(ctx context.Context) error {
 	m.ctrl.T.Helper()
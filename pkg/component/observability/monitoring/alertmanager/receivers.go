@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// EventReasonReceiversSkipped is the reason of the event which is recorded on the Shoot if alert receivers are skipped
+// because they are invalid.
+const EventReasonReceiversSkipped = "AlertingReceiversSkipped"
+
+func (a *alertManager) receiverConfigs() ([]monitoringv1alpha1.Receiver, []apiextensionsv1.JSON, error) {
+	var (
+		receivers []monitoringv1alpha1.Receiver
+		routes    []apiextensionsv1.JSON
+	)
+
+	for _, receiver := range a.values.Receivers {
+		config := monitoringv1alpha1.Receiver{Name: receiver.Name}
+
+		switch {
+		case receiver.Webhook != nil:
+			config.WebhookConfigs = []monitoringv1alpha1.WebhookConfig{{
+				SendResolved: receiver.SendResolved,
+				URLSecret:    a.secretKeySelector(receiver.Webhook.URL),
+			}}
+		case receiver.Slack != nil:
+			config.SlackConfigs = []monitoringv1alpha1.SlackConfig{{
+				SendResolved: receiver.SendResolved,
+				APIURL:       a.secretKeySelector(receiver.Slack.APIURL),
+				Channel:      ptr.Deref(receiver.Slack.Channel, ""),
+			}}
+		case receiver.PagerDuty != nil:
+			config.PagerDutyConfigs = []monitoringv1alpha1.PagerDutyConfig{{
+				SendResolved: receiver.SendResolved,
+				RoutingKey:   a.secretKeySelector(receiver.PagerDuty.RoutingKey),
+				URL:          ptr.Deref(receiver.PagerDuty.URL, ""),
+			}}
+		}
+		receivers = append(receivers, config)
+
+		matchers := []monitoringv1alpha1.Matcher{{Name: "visibility", MatchType: monitoringv1alpha1.MatchRegexp, Value: "all|owner"}}
+		if len(receiver.Severities) > 0 {
+			matchers = append(matchers, monitoringv1alpha1.Matcher{Name: "severity", MatchType: monitoringv1alpha1.MatchRegexp, Value: strings.Join(receiver.Severities, "|")})
+		}
+		if len(receiver.Components) > 0 {
+			matchers = append(matchers, monitoringv1alpha1.Matcher{Name: "service", MatchType: monitoringv1alpha1.MatchRegexp, Value: strings.Join(receiver.Components, "|")})
+		}
+
+		// Continue matching subsequent routes so that an alert can be sent to multiple receivers.
+		route, err := json.Marshal(monitoringv1alpha1.Route{Receiver: receiver.Name, Matchers: matchers, Continue: true})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed marshalling route for receiver %q: %w", receiver.Name, err)
+		}
+		routes = append(routes, apiextensionsv1.JSON{Raw: route})
+	}
+
+	return receivers, routes, nil
+}
+
+func (a *alertManager) secretKeySelector(ref gardencorev1beta1.ResourceSecretKeyReference) *corev1.SecretKeySelector {
+	return gardenerutils.ReferencedSecretKeySelector(ref, a.values.ReferencedResources)
+}
@@ -31,6 +31,7 @@ func CentralScrapeConfigs() []*monitoringv1alpha1.ScrapeConfig {
 						`{job="kube-state-metrics",namespace=""}`,
 						`{job="cadvisor",namespace=~"garden|extension-.+|istio-(.+)"}`,
 						`{job="etcd-druid",namespace="garden"}`,
+						`{job="gardenlet",__name__="gardenlet_shoot_last_operation_duration_seconds"}`,
 					},
 				},
 				KubernetesSDConfigs: []monitoringv1alpha1.KubernetesSDConfig{{
@@ -13,8 +13,9 @@ import (
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
 )
 
-// CentralServiceMonitors returns the central ServiceMonitor resources for the aggregate prometheus.
-func CentralServiceMonitors() []*monitoringv1.ServiceMonitor {
+// CentralServiceMonitors returns the central ServiceMonitor resources for the aggregate prometheus. If a federation
+// sample limit is given, federation scrapes of shoot prometheis returning more samples fail.
+func CentralServiceMonitors(federationSampleLimit *uint64) []*monitoringv1.ServiceMonitor {
 	return []*monitoringv1.ServiceMonitor{
 		{
 			ObjectMeta: metav1.ObjectMeta{Name: "shoot-prometheus"},
@@ -24,6 +25,7 @@ func CentralServiceMonitors() []*monitoringv1.ServiceMonitor {
 					v1beta1constants.LabelRole: v1beta1constants.LabelMonitoring,
 				}},
 				NamespaceSelector: monitoringv1.NamespaceSelector{Any: true},
+				SampleLimit:       federationSampleLimit,
 				Endpoints: []monitoringv1.Endpoint{{
 					Path:            "/federate",
 					HonorTimestamps: ptr.To(false),
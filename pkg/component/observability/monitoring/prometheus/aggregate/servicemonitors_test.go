@@ -7,6 +7,7 @@ package aggregate_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -17,7 +18,7 @@ import (
 var _ = Describe("ServiceMonitors", func() {
 	Describe("#CentralServiceMonitors", func() {
 		It("should return the expected objects", func() {
-			Expect(aggregate.CentralServiceMonitors()).To(HaveExactElements(&monitoringv1.ServiceMonitor{
+			Expect(aggregate.CentralServiceMonitors(nil)).To(HaveExactElements(&monitoringv1.ServiceMonitor{
 				ObjectMeta: metav1.ObjectMeta{Name: "shoot-prometheus"},
 				Spec: monitoringv1.ServiceMonitorSpec{
 					Selector: metav1.LabelSelector{MatchLabels: map[string]string{
@@ -57,5 +58,12 @@ var _ = Describe("ServiceMonitors", func() {
 				},
 			}))
 		})
+
+		It("should set the federation sample limit", func() {
+			serviceMonitors := aggregate.CentralServiceMonitors(ptr.To[uint64](10000))
+
+			Expect(serviceMonitors).To(HaveLen(1))
+			Expect(serviceMonitors[0].Spec.SampleLimit).To(PointTo(Equal(uint64(10000))))
+		})
 	})
 })
@@ -51,6 +51,10 @@ type Interface interface {
 	SetCentralScrapeConfigs([]*monitoringv1alpha1.ScrapeConfig)
 	// SetNamespaceUID sets the namespace UID.
 	SetNamespaceUID(name types.UID)
+	// SetAdditionalRemoteWrites sets the additional remote write configurations.
+	SetAdditionalRemoteWrites([]monitoringv1.RemoteWriteSpec)
+	// SetAdditionalPrometheusRules sets the additional PrometheusRule objects.
+	SetAdditionalPrometheusRules([]*monitoringv1.PrometheusRule)
 }
 
 // Values contains configuration values for the prometheus resources.
@@ -77,6 +81,9 @@ type Values struct {
 	RuntimeVersion *semver.Version
 	// ScrapeTimeout is the timeout duration when scraping targets.
 	ScrapeTimeout monitoringv1.Duration
+	// EnforcedSampleLimit is the maximum number of samples accepted per scrape of a target. It overrides the sample
+	// limits of the scrape configurations if they are higher or unset.
+	EnforcedSampleLimit *uint64
 	// VPAMinAllowed defines the resource list for the minAllowed field for the prometheus container resource policy.
 	VPAMinAllowed *corev1.ResourceList
 	// ExternalLabels is the set of external labels for the Prometheus configuration.
@@ -94,6 +101,12 @@ type Values struct {
 	Alerting *AlertingValues
 	// RemoteWrite contains remote write configuration for this Prometheus instance.
 	RemoteWrite *RemoteWriteValues
+	// AdditionalRemoteWrites contains additional remote write configurations, e.g., the ones configured by the shoot
+	// owner. Pods are allowed to reach public networks if additional remote writes are configured.
+	AdditionalRemoteWrites []monitoringv1.RemoteWriteSpec
+	// AdditionalPrometheusRules contains additional PrometheusRule objects, e.g., the ones configured by the shoot owner.
+	// They are treated like the central PrometheusRule objects.
+	AdditionalPrometheusRules []*monitoringv1.PrometheusRule
 	// AdditionalResources contains any additional resources which get added to the ManagedResource.
 	AdditionalResources []client.Object
 	// Cortex contains configuration for the cortex frontend sidecar container.
@@ -335,6 +348,14 @@ func (p *prometheus) SetNamespaceUID(uid types.UID) {
 	p.values.NamespaceUID = &uid
 }
 
+func (p *prometheus) SetAdditionalRemoteWrites(remoteWrites []monitoringv1.RemoteWriteSpec) {
+	p.values.AdditionalRemoteWrites = remoteWrites
+}
+
+func (p *prometheus) SetAdditionalPrometheusRules(rules []*monitoringv1.PrometheusRule) {
+	p.values.AdditionalPrometheusRules = rules
+}
+
 func (p *prometheus) name() string {
 	return "prometheus-" + p.values.Name
 }
@@ -361,6 +382,9 @@ func (p *prometheus) addCentralConfigsToRegistry(registry *managedresources.Regi
 	for _, obj := range p.values.CentralConfigs.PrometheusRules {
 		add(obj)
 	}
+	for _, obj := range p.values.AdditionalPrometheusRules {
+		add(obj)
+	}
 	for _, obj := range p.values.CentralConfigs.ScrapeConfigs {
 		add(obj)
 	}
@@ -27,6 +27,10 @@ var (
 	etcdYAML []byte
 	etcd     *monitoringv1.PrometheusRule
 
+	//go:embed assets/prometheusrules/fleet.yaml
+	fleetYAML []byte
+	fleet     *monitoringv1.PrometheusRule
+
 	//go:embed assets/prometheusrules/metering-meta.yaml
 	meteringYAML []byte
 	metering     *monitoringv1.PrometheusRule
@@ -51,6 +55,9 @@ func init() {
 	etcd = &monitoringv1.PrometheusRule{}
 	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, etcdYAML, etcd))
 
+	fleet = &monitoringv1.PrometheusRule{}
+	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, fleetYAML, fleet))
+
 	metering = &monitoringv1.PrometheusRule{}
 	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, meteringYAML, metering))
 
@@ -69,6 +76,7 @@ func CentralPrometheusRules(isGardenerDiscoveryServerEnabled bool) []*monitoring
 	return []*monitoringv1.PrometheusRule{
 		auditLog.DeepCopy(),
 		etcd.DeepCopy(),
+		fleet.DeepCopy(),
 		gardenPrometheusRule(isGardenerDiscoveryServerEnabled).DeepCopy(),
 		metering.DeepCopy(),
 		recording.DeepCopy(),
@@ -26,6 +26,10 @@ var _ = ginkgo.Describe("PrometheusRules", func() {
 					"TypeMeta":   MatchFields(IgnoreExtras, Fields{"APIVersion": Equal("monitoring.coreos.com/v1"), "Kind": Equal("PrometheusRule")}),
 					"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("etcd")}),
 				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"TypeMeta":   MatchFields(IgnoreExtras, Fields{"APIVersion": Equal("monitoring.coreos.com/v1"), "Kind": Equal("PrometheusRule")}),
+					"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("fleet")}),
+				})),
 				PointTo(MatchFields(IgnoreExtras, Fields{
 					"TypeMeta":   MatchFields(IgnoreExtras, Fields{"APIVersion": Equal("monitoring.coreos.com/v1"), "Kind": Equal("PrometheusRule")}),
 					"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("garden")}),
@@ -54,6 +58,7 @@ var _ = ginkgo.Describe("PrometheusRules", func() {
 			test.PrometheusRule(seed, "testdata/seed.prometheusrule.test.yaml")
 			test.PrometheusRule(shoot, "testdata/shoot.prometheusrule.test.yaml")
 			test.PrometheusRule(etcd, "testdata/etcd.prometheusrule.test.yaml")
+			test.PrometheusRule(fleet, "testdata/fleet.prometheusrule.test.yaml")
 		},
 			ginkgo.Entry("when gardener discovery server is enabled", true),
 			ginkgo.Entry("when gardener discovery server is disabled", false),
@@ -115,6 +115,7 @@ metric_relabel_configs:
 									`{__name__=~"shoot:(.+):(.+)",__name__!="shoot:apiserver_storage_objects:sum_by_resource",__name__!="shoot:apiserver_watch_duration:quantile"}`,
 									`{__name__="ALERTS"}`,
 									`{__name__="shoot:availability"}`,
+									`{__name__="gardenlet_shoot_last_operation_duration_seconds"}`,
 									`{__name__="prometheus_tsdb_lowest_timestamp"}`,
 									`{__name__="prometheus_tsdb_storage_blocks_bytes"}`,
 									`{__name__="seed:persistentvolume:inconsistent_size"}`,
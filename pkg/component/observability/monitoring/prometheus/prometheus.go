@@ -105,6 +105,10 @@ func (p *prometheus) prometheus(cortexConfigMap *corev1.ConfigMap) *monitoringv1
 		obj.Spec.Retention = *p.values.Retention
 	}
 
+	if p.values.EnforcedSampleLimit != nil {
+		obj.Spec.EnforcedSampleLimit = p.values.EnforcedSampleLimit
+	}
+
 	if p.values.Alerting != nil {
 		if len(p.values.Alerting.Alertmanagers) > 0 {
 			obj.Spec.Alerting = &monitoringv1.AlertingSpec{}
@@ -156,6 +160,11 @@ func (p *prometheus) prometheus(cortexConfigMap *corev1.ConfigMap) *monitoringv1
 		obj.Spec.RemoteWrite = append(obj.Spec.RemoteWrite, spec)
 	}
 
+	if len(p.values.AdditionalRemoteWrites) > 0 {
+		obj.Spec.RemoteWrite = append(obj.Spec.RemoteWrite, p.values.AdditionalRemoteWrites...)
+		obj.Spec.PodMetadata.Labels[v1beta1constants.LabelNetworkPolicyToPublicNetworks] = v1beta1constants.LabelNetworkPolicyAllowed
+	}
+
 	if p.values.Cortex != nil {
 		obj.Spec.Containers = append(obj.Spec.Containers, p.cortexContainer())
 		obj.Spec.Volumes = append(obj.Spec.Volumes, p.cortexVolume(cortexConfigMap.Name))
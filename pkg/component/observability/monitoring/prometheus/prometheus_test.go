@@ -1113,6 +1113,37 @@ tls_config:
 				})
 			})
 
+			When("sample limit is enforced", func() {
+				BeforeEach(func() {
+					values.EnforcedSampleLimit = ptr.To[uint64](50000)
+				})
+
+				It("should successfully deploy all resources", func() {
+					prometheusObj := prometheusFor(nil, false)
+					prometheusObj.Spec.EnforcedSampleLimit = ptr.To[uint64](50000)
+
+					prometheusRule.Namespace = namespace
+					metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&scrapeConfig.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&podMonitor.ObjectMeta, "prometheus", name)
+
+					Expect(managedResource).To(contain(
+						serviceAccount,
+						service,
+						clusterRoleBinding,
+						prometheusObj,
+						vpa,
+						prometheusRule,
+						scrapeConfig,
+						serviceMonitor,
+						podMonitor,
+						secretAdditionalScrapeConfigs,
+						additionalConfigMap,
+					))
+				})
+			})
+
 			When("cortex sidecar is enabled", func() {
 				var (
 					cortexImage   = "cortex-image"
@@ -1319,6 +1350,88 @@ query_range:
 				})
 			})
 
+			When("additional remote writes are provided", func() {
+				var additionalRemoteWrite monitoringv1.RemoteWriteSpec
+
+				BeforeEach(func() {
+					additionalRemoteWrite = monitoringv1.RemoteWriteSpec{
+						Name: ptr.To("thanos"),
+						URL:  "https://thanos.example.com/api/v1/receive",
+						WriteRelabelConfigs: []monitoringv1.RelabelConfig{{
+							SourceLabels: []monitoringv1.LabelName{"__name__"},
+							Action:       "keep",
+							Regex:        `^(up)$`,
+						}},
+					}
+					values.AdditionalRemoteWrites = []monitoringv1.RemoteWriteSpec{additionalRemoteWrite}
+				})
+
+				It("should add the remote write and allow egress to public networks", func() {
+					prometheusObj := prometheusFor(nil, false)
+					prometheusObj.Spec.RemoteWrite = []monitoringv1.RemoteWriteSpec{additionalRemoteWrite}
+					prometheusObj.Spec.PodMetadata.Labels["networking.gardener.cloud/to-public-networks"] = "allowed"
+
+					prometheusRule.Namespace = namespace
+					metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&scrapeConfig.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&podMonitor.ObjectMeta, "prometheus", name)
+
+					Expect(managedResource).To(consistOf(
+						serviceAccount,
+						service,
+						clusterRoleBinding,
+						prometheusObj,
+						vpa,
+						prometheusRule,
+						scrapeConfig,
+						serviceMonitor,
+						podMonitor,
+						secretAdditionalScrapeConfigs,
+						additionalConfigMap,
+					))
+				})
+			})
+
+			When("additional prometheus rules are provided", func() {
+				var additionalPrometheusRule *monitoringv1.PrometheusRule
+
+				BeforeEach(func() {
+					additionalPrometheusRule = &monitoringv1.PrometheusRule{
+						ObjectMeta: metav1.ObjectMeta{Name: "owner-rule"},
+						Spec:       monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{{Name: "bar"}}},
+					}
+					values.AdditionalPrometheusRules = []*monitoringv1.PrometheusRule{additionalPrometheusRule}
+				})
+
+				It("should add the prometheus rules like the central ones", func() {
+					prometheusRule.Namespace = namespace
+					metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&scrapeConfig.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&podMonitor.ObjectMeta, "prometheus", name)
+
+					Expect(additionalPrometheusRule.Name).To(Equal(name + "-owner-rule"))
+					Expect(additionalPrometheusRule.Namespace).To(Equal(namespace))
+					Expect(additionalPrometheusRule.Labels).To(HaveKeyWithValue("prometheus", name))
+
+					Expect(managedResource).To(consistOf(
+						serviceAccount,
+						service,
+						clusterRoleBinding,
+						prometheusFor(nil, false),
+						vpa,
+						prometheusRule,
+						additionalPrometheusRule,
+						scrapeConfig,
+						serviceMonitor,
+						podMonitor,
+						secretAdditionalScrapeConfigs,
+						additionalConfigMap,
+					))
+				})
+			})
+
 			When("target cluster is configured", func() {
 				var (
 					managedResourceTarget       *resourcesv1alpha1.ManagedResource
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// AlertingRulesDataKey is the key in the data of the ConfigMap containing the alerting rules.
+	AlertingRulesDataKey = "rules.yaml"
+	// AlertingRulesName is the name of the PrometheusRule containing the alerting rules configured by the shoot owner.
+	AlertingRulesName = "owner-alerting-rules"
+	// MaxAlertingRulesSize is the maximum size in bytes of the alerting rules configured by the shoot owner.
+	MaxAlertingRulesSize = 64 * 1024
+
+	maxAlertingRuleGroups        = 10
+	maxAlertingRules             = 50
+	minAlertingRuleGroupInterval = 30 * time.Second
+)
+
+var (
+	// enforcedAlertingRuleLabels are the labels added to all alerting rules configured by the shoot owner. They make
+	// sure that the alerts are only routed to the receivers of the shoot owner.
+	enforcedAlertingRuleLabels = map[string]string{
+		"visibility": "owner",
+		"origin":     "shoot-owner",
+	}
+	// reservedAlertingRuleLabels are the labels which are either enforced or used by Gardener for routing, inhibiting
+	// or identifying alerts.
+	reservedAlertingRuleLabels = sets.New(
+		"visibility", "origin", "type", "ignoreAlerts",
+		"cluster", "project", "shoot_name", "name", "seed_api", "seed_region", "seed_provider", "shoot_infra",
+	)
+)
+
+// ParseAlertingRules parses and validates the given alerting rules which have the format of the spec of a
+// PrometheusRule. The enforced labels are added to all rules.
+func ParseAlertingRules(data []byte) (*monitoringv1.PrometheusRule, error) {
+	if len(data) > MaxAlertingRulesSize {
+		return nil, fmt.Errorf("alerting rules must not exceed %d bytes, got %d bytes", MaxAlertingRulesSize, len(data))
+	}
+
+	spec := monitoringv1.PrometheusRuleSpec{}
+	if err := yaml.UnmarshalStrict(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed parsing alerting rules: %w", err)
+	}
+
+	if errs := validateAlertingRules(spec); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid alerting rules: %w", errs.ToAggregate())
+	}
+
+	for i := range spec.Groups {
+		for j := range spec.Groups[i].Rules {
+			rule := &spec.Groups[i].Rules[j]
+			if rule.Labels == nil {
+				rule.Labels = make(map[string]string, len(enforcedAlertingRuleLabels))
+			}
+			for k, v := range enforcedAlertingRuleLabels {
+				rule.Labels[k] = v
+			}
+		}
+	}
+
+	prometheusRule := &monitoringv1.PrometheusRule{Spec: spec}
+	prometheusRule.Name = AlertingRulesName
+	return prometheusRule, nil
+}
+
+func validateAlertingRules(spec monitoringv1.PrometheusRuleSpec) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		fldPath    = field.NewPath("groups")
+		groupNames = sets.New[string]()
+		numRules   int
+	)
+
+	if len(spec.Groups) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must provide at least one rule group"))
+	} else if len(spec.Groups) > maxAlertingRuleGroups {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(spec.Groups), maxAlertingRuleGroups))
+	}
+
+	for i, group := range spec.Groups {
+		idxPath := fldPath.Index(i)
+
+		if group.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if groupNames.Has(group.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+		}
+		groupNames.Insert(group.Name)
+
+		if group.Interval != nil {
+			if interval, err := model.ParseDuration(string(*group.Interval)); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("interval"), *group.Interval, err.Error()))
+			} else if time.Duration(interval) < minAlertingRuleGroupInterval {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("interval"), *group.Interval, fmt.Sprintf("must be at least %s", minAlertingRuleGroupInterval)))
+			}
+		}
+		allErrs = append(allErrs, validateAlertingRuleLabels(group.Labels, idxPath.Child("labels"))...)
+		if group.QueryOffset != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("query_offset"), "must not be set"))
+		}
+		if group.Limit != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("limit"), "must not be set"))
+		}
+
+		numRules += len(group.Rules)
+
+		for j, rule := range group.Rules {
+			rulePath := idxPath.Child("rules").Index(j)
+
+			if rule.Record != "" {
+				allErrs = append(allErrs, field.Forbidden(rulePath.Child("record"), "recording rules are not supported"))
+			}
+			if rule.Alert == "" {
+				allErrs = append(allErrs, field.Required(rulePath.Child("alert"), "must provide the name of the alert"))
+			}
+			if expr := rule.Expr.String(); expr == "" {
+				allErrs = append(allErrs, field.Required(rulePath.Child("expr"), "must provide an expression"))
+			} else if _, err := parser.ParseExpr(expr); err != nil {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("expr"), expr, fmt.Sprintf("must be a valid PromQL expression: %v", err)))
+			}
+			if rule.For != nil {
+				if _, err := model.ParseDuration(string(*rule.For)); err != nil {
+					allErrs = append(allErrs, field.Invalid(rulePath.Child("for"), *rule.For, err.Error()))
+				}
+			}
+			if rule.KeepFiringFor != nil {
+				if _, err := model.ParseDuration(string(*rule.KeepFiringFor)); err != nil {
+					allErrs = append(allErrs, field.Invalid(rulePath.Child("keep_firing_for"), *rule.KeepFiringFor, err.Error()))
+				}
+			}
+			allErrs = append(allErrs, validateAlertingRuleLabels(rule.Labels, rulePath.Child("labels"))...)
+		}
+	}
+
+	if numRules > maxAlertingRules {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("rules"), numRules, maxAlertingRules))
+	}
+
+	return allErrs
+}
+
+func validateAlertingRuleLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name := range labels {
+		if reservedAlertingRuleLabels.Has(name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(name), "label is reserved by Gardener"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+)
+
+var _ = Describe("AlertingRules", func() {
+	Describe("#ParseAlertingRules", func() {
+		It("should parse the alerting rules and add the enforced labels", func() {
+			prometheusRule, err := shoot.ParseAlertingRules([]byte(`groups:
+- name: slo
+  interval: 1m
+  rules:
+  - alert: ApiServerLatencyHigh
+    expr: histogram_quantile(0.99, sum(rate(apiserver_request_duration_seconds_bucket[5m])) by (le)) > 1
+    for: 10m
+    labels:
+      severity: warning
+    annotations:
+      summary: API server latency is high
+  - alert: ApiServerDown
+    expr: absent(up{job="kube-apiserver"} == 1)
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(prometheusRule).To(Equal(&monitoringv1.PrometheusRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "owner-alerting-rules"},
+				Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{{
+					Name:     "slo",
+					Interval: ptr.To(monitoringv1.Duration("1m")),
+					Rules: []monitoringv1.Rule{
+						{
+							Alert:       "ApiServerLatencyHigh",
+							Expr:        intstr.FromString("histogram_quantile(0.99, sum(rate(apiserver_request_duration_seconds_bucket[5m])) by (le)) > 1"),
+							For:         ptr.To(monitoringv1.Duration("10m")),
+							Labels:      map[string]string{"severity": "warning", "visibility": "owner", "origin": "shoot-owner"},
+							Annotations: map[string]string{"summary": "API server latency is high"},
+						},
+						{
+							Alert:  "ApiServerDown",
+							Expr:   intstr.FromString(`absent(up{job="kube-apiserver"} == 1)`),
+							Labels: map[string]string{"visibility": "owner", "origin": "shoot-owner"},
+						},
+					},
+				}}},
+			}))
+		})
+
+		It("should fail for unknown fields", func() {
+			_, err := shoot.ParseAlertingRules([]byte("foo: bar\n"))
+			Expect(err).To(MatchError(ContainSubstring("failed parsing alerting rules")))
+		})
+
+		It("should fail if the alerting rules are too large", func() {
+			_, err := shoot.ParseAlertingRules([]byte("groups: []\n#" + strings.Repeat("x", 64*1024)))
+			Expect(err).To(MatchError(ContainSubstring("alerting rules must not exceed 65536 bytes")))
+		})
+
+		DescribeTable("should fail for invalid alerting rules",
+			func(data, errorSubstring string) {
+				_, err := shoot.ParseAlertingRules([]byte(data))
+				Expect(err).To(MatchError(ContainSubstring(errorSubstring)))
+			},
+
+			Entry("no groups", "groups: []\n", "must provide at least one rule group"),
+			Entry("too many groups", "groups:"+strings.Repeat("\n- name: a", 11)+"\n", "groups: Too many: 11"),
+			Entry("too many rules", "groups:\n- name: a\n  rules:"+strings.Repeat("\n  - alert: A\n    expr: up", 51)+"\n", "groups.rules: Too many: 51"),
+			Entry("missing group name", "groups:\n- rules:\n  - alert: A\n    expr: up\n", "groups[0].name: Required value"),
+			Entry("duplicate group name", "groups:\n- name: a\n- name: a\n", "groups[1].name: Duplicate value"),
+			Entry("invalid interval", "groups:\n- name: a\n  interval: foo\n", "groups[0].interval: Invalid value"),
+			Entry("too short interval", "groups:\n- name: a\n  interval: 10s\n", "must be at least 30s"),
+			Entry("limit", "groups:\n- name: a\n  limit: 10\n", "groups[0].limit: Forbidden"),
+			Entry("recording rule", "groups:\n- name: a\n  rules:\n  - record: a\n    expr: up\n", "recording rules are not supported"),
+			Entry("missing expression", "groups:\n- name: a\n  rules:\n  - alert: A\n    expr: ''\n", "must provide an expression"),
+			Entry("invalid expression", "groups:\n- name: a\n  rules:\n  - alert: A\n    expr: 'sum(rate(up[5m]) >'\n", "groups[0].rules[0].expr: Invalid value"),
+			Entry("invalid for", "groups:\n- name: a\n  rules:\n  - alert: A\n    expr: up\n    for: 5 minutes\n", "groups[0].rules[0].for: Invalid value"),
+			Entry("invalid keep_firing_for", "groups:\n- name: a\n  rules:\n  - alert: A\n    expr: up\n    keep_firing_for: foo\n", "groups[0].rules[0].keep_firing_for: Invalid value"),
+			Entry("reserved rule label", "groups:\n- name: a\n  rules:\n  - alert: A\n    expr: up\n    labels:\n      visibility: operator\n", "groups[0].rules[0].labels[visibility]: Forbidden"),
+			Entry("reserved group label", "groups:\n- name: a\n  labels:\n    shoot_name: foo\n", "groups[0].labels[shoot_name]: Forbidden"),
+		)
+	})
+})
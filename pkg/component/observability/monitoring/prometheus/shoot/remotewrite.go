@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/metricnames"
+)
+
+// RemoteWriteLimits contains the limits for remote write targets configured by the shoot owner.
+type RemoteWriteLimits struct {
+	// MaxTargets is the maximum number of remote write targets.
+	MaxTargets int
+	// MaxKeptMetrics is the maximum number of metric names matched by the keep list of a remote write target.
+	MaxKeptMetrics int
+	// MaxShards is the maximum number of parallel connections to a remote write target.
+	MaxShards int
+	// MaxSamplesPerSend is the maximum number of samples sent to a remote write target in one request.
+	MaxSamplesPerSend int
+}
+
+// RemoteWriteSpecs checks the remote write targets configured in the `.spec.monitoring.remoteWrites` of the Shoot
+// against the given limits and converts them to remote write configurations of the shoot Prometheus. Secret references
+// point to the copies of the secrets referenced in the `.spec.resources` of the Shoot in the control plane namespace.
+func RemoteWriteSpecs(remoteWrites []gardencorev1beta1.MonitoringRemoteWrite, resources []gardencorev1beta1.NamedResourceReference, limits RemoteWriteLimits) ([]monitoringv1.RemoteWriteSpec, error) {
+	if len(remoteWrites) > limits.MaxTargets {
+		return nil, fmt.Errorf("must not configure more than %d remote write targets", limits.MaxTargets)
+	}
+
+	var specs []monitoringv1.RemoteWriteSpec
+	for _, remoteWrite := range remoteWrites {
+		keptMetrics := 0
+		for _, metric := range remoteWrite.Keep {
+			count, err := metricnames.Count(metric, limits.MaxKeptMetrics+1)
+			if err != nil {
+				return nil, fmt.Errorf("invalid kept metric %q of remote write target %q: %w", metric, remoteWrite.Name, err)
+			}
+			keptMetrics += count
+		}
+
+		if keptMetrics > limits.MaxKeptMetrics {
+			return nil, fmt.Errorf("remote write target %q must not keep more than %d metric names", remoteWrite.Name, limits.MaxKeptMetrics)
+		}
+
+		spec := monitoringv1.RemoteWriteSpec{
+			Name:                ptr.To("owner-" + remoteWrite.Name),
+			URL:                 remoteWrite.URL,
+			WriteRelabelConfigs: monitoringutils.StandardMetricRelabelConfig(remoteWrite.Keep...),
+			QueueConfig: &monitoringv1.QueueConfig{
+				MaxShards:         limits.MaxShards,
+				MaxSamplesPerSend: limits.MaxSamplesPerSend,
+			},
+		}
+
+		if remoteWrite.BasicAuth != nil {
+			spec.BasicAuth = &monitoringv1.BasicAuth{
+				Username: *gardenerutils.ReferencedSecretKeySelector(remoteWrite.BasicAuth.Username, resources),
+				Password: *gardenerutils.ReferencedSecretKeySelector(remoteWrite.BasicAuth.Password, resources),
+			}
+		}
+
+		if remoteWrite.BearerToken != nil {
+			spec.Authorization = &monitoringv1.Authorization{SafeAuthorization: monitoringv1.SafeAuthorization{
+				Credentials: gardenerutils.ReferencedSecretKeySelector(*remoteWrite.BearerToken, resources),
+			}}
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+)
+
+var _ = Describe("RemoteWrite", func() {
+	var (
+		resources    []gardencorev1beta1.NamedResourceReference
+		limits       shoot.RemoteWriteLimits
+		remoteWrites []gardencorev1beta1.MonitoringRemoteWrite
+	)
+
+	BeforeEach(func() {
+		resources = []gardencorev1beta1.NamedResourceReference{
+			{Name: "credentials", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-credentials"}},
+		}
+		limits = shoot.RemoteWriteLimits{MaxTargets: 2, MaxKeptMetrics: 3, MaxShards: 3, MaxSamplesPerSend: 400}
+		remoteWrites = []gardencorev1beta1.MonitoringRemoteWrite{
+			{
+				Name: "basic",
+				URL:  "https://basic.example.com/api/v1/write",
+				BasicAuth: &gardencorev1beta1.MonitoringRemoteWriteBasicAuth{
+					Username: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "credentials", Key: "username"},
+					Password: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "credentials", Key: "password"},
+				},
+				Keep: []string{"apiserver_request_total", "etcd_(disk|mvcc)_total"},
+			},
+			{
+				Name:        "token",
+				URL:         "https://token.example.com/api/v1/write",
+				BearerToken: &gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "credentials", Key: "token"},
+				Keep:        []string{"up"},
+			},
+		}
+	})
+
+	Describe("#RemoteWriteSpecs", func() {
+		It("should convert the remote write targets", func() {
+			specs, err := shoot.RemoteWriteSpecs(remoteWrites, resources, limits)
+			Expect(err).NotTo(HaveOccurred())
+
+			queueConfig := &monitoringv1.QueueConfig{MaxShards: 3, MaxSamplesPerSend: 400}
+			Expect(specs).To(Equal([]monitoringv1.RemoteWriteSpec{
+				{
+					Name: ptr.To("owner-basic"),
+					URL:  "https://basic.example.com/api/v1/write",
+					BasicAuth: &monitoringv1.BasicAuth{
+						Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ref-my-credentials"}, Key: "username"},
+						Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ref-my-credentials"}, Key: "password"},
+					},
+					WriteRelabelConfigs: []monitoringv1.RelabelConfig{{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Action:       "keep",
+						Regex:        `^(apiserver_request_total|etcd_(disk|mvcc)_total)$`,
+					}},
+					QueueConfig: queueConfig,
+				},
+				{
+					Name: ptr.To("owner-token"),
+					URL:  "https://token.example.com/api/v1/write",
+					Authorization: &monitoringv1.Authorization{SafeAuthorization: monitoringv1.SafeAuthorization{
+						Credentials: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ref-my-credentials"}, Key: "token"},
+					}},
+					WriteRelabelConfigs: []monitoringv1.RelabelConfig{{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Action:       "keep",
+						Regex:        `^(up)$`,
+					}},
+					QueueConfig: queueConfig,
+				},
+			}))
+		})
+
+		It("should fail if there are more targets than allowed", func() {
+			limits.MaxTargets = 1
+
+			_, err := shoot.RemoteWriteSpecs(remoteWrites, resources, limits)
+			Expect(err).To(MatchError("must not configure more than 1 remote write targets"))
+		})
+
+		It("should fail if a target keeps more metric names than allowed", func() {
+			remoteWrites[1].Keep = []string{"kube_[a-d]"}
+
+			_, err := shoot.RemoteWriteSpecs(remoteWrites, resources, limits)
+			Expect(err).To(MatchError(`remote write target "token" must not keep more than 3 metric names`))
+		})
+
+		It("should fail if a kept metric matches an unlimited set of metric names", func() {
+			remoteWrites[1].Keep = []string{"etcd_.+"}
+
+			_, err := shoot.RemoteWriteSpecs(remoteWrites, resources, limits)
+			Expect(err).To(MatchError(ContainSubstring("wildcards, anchors and unbounded repetitions are not supported")))
+		})
+	})
+})
@@ -167,6 +167,7 @@ func CentralScrapeConfigs(namespace, clusterCASecretName string, isWorkerless bo
 					"prometheus_rule_group_duration_seconds",
 					"prometheus_rule_group_iterations_missed_total",
 					"prometheus_rule_group_iterations_total",
+					"prometheus_target_scrapes_exceeded_sample_limit_total",
 					"prometheus_tsdb_blocks_loaded",
 					"prometheus_tsdb_compactions_failed_total",
 					"prometheus_tsdb_compactions_total",
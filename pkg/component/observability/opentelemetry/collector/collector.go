@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
 	collectorconstants "github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector/constants"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -34,10 +36,23 @@ const (
 	managedResourceName = "opentelemetry-collector"
 	scrapeJobName       = "opentelemetry-collector"
 	serviceMonitorName  = "opentelemetry-collector"
+	prometheusRuleName  = "opentelemetry-collector"
 
 	otelCollectorConfigName = "opentelemetry-collector-config"
 	kubeRBACProxyName       = "kube-rbac-proxy"
 
+	auditWebhookReceiverName = "webhookevent/audit"
+	auditExporterName        = "otlphttp/audit"
+	auditPipelineName        = "logs/audit"
+	auditPortName            = "audit"
+	envVarAuditAuthorization = "AUDIT_LOGS_AUTHORIZATION" // #nosec G101 -- No credential.
+
+	otlpReceiverName          = "otlp"
+	otlpPortName              = "otlp"
+	exportExporterName        = "otlphttp/export"
+	tracesPipelineName        = "traces"
+	envVarExportAuthorization = "EXPORT_AUTHORIZATION" // #nosec G101 -- No credential.
+
 	metricsEndpointName            = "metrics"
 	metricsPort                    = 8888
 	timeoutWaitForManagedResources = 2 * time.Minute
@@ -55,6 +70,33 @@ type Values struct {
 	LokiEndpoint string
 	// Replicas is the number of replicas for the OpenTelemetry Collector deployment.
 	Replicas int32
+	// AuditLogs configures the export of the kube-apiserver audit logs. If nil, audit logs are not exported.
+	AuditLogs *AuditLogs
+	// Export configures the export of the control plane logs and traces to an OTLP backend. If nil, logs are only sent
+	// to Vali and traces are not received.
+	Export *Export
+}
+
+// Export contains the configuration for exporting the control plane logs and traces via OTLP.
+type Export struct {
+	// Endpoint is the OTLP/HTTP endpoint the logs and traces are sent to.
+	Endpoint string
+	// Authorization references the secret key containing the value of the `Authorization` header sent to the endpoint.
+	Authorization *corev1.SecretKeySelector
+	// Logs states whether the control plane logs are exported.
+	Logs bool
+	// Traces states whether the traces received via OTLP are exported.
+	Traces bool
+}
+
+// AuditLogs contains the configuration for exporting the kube-apiserver audit logs via OTLP.
+type AuditLogs struct {
+	// Endpoint is the OTLP/HTTP endpoint the audit logs are sent to.
+	Endpoint string
+	// Authorization references the secret key containing the value of the `Authorization` header sent to the endpoint.
+	Authorization *corev1.SecretKeySelector
+	// QueueSize is the number of batches buffered by the collector while the endpoint is not reachable.
+	QueueSize *int32
 }
 
 type otelCollector struct {
@@ -69,6 +111,10 @@ type Interface interface {
 	component.DeployWaiter
 	// WithAuthenticationProxy acts as a setter for the WithRBACProxy field in Values.
 	WithAuthenticationProxy(bool)
+	// SetAuditLogs sets the AuditLogs field in Values.
+	SetAuditLogs(*AuditLogs)
+	// SetExport sets the Export field in Values.
+	SetExport(*Export)
 }
 
 // New creates a new instance of OpenTelemetry Collector deployer.
@@ -90,6 +136,14 @@ func (o *otelCollector) WithAuthenticationProxy(b bool) {
 	o.values.WithRBACProxy = b
 }
 
+func (o *otelCollector) SetAuditLogs(auditLogs *AuditLogs) {
+	o.values.AuditLogs = auditLogs
+}
+
+func (o *otelCollector) SetExport(export *Export) {
+	o.values.Export = export
+}
+
 func (o *otelCollector) newKubeRBACProxyShootAccessSecret() *gardenerutils.AccessSecret {
 	return gardenerutils.NewShootAccessSecret(kubeRBACProxyName, o.namespace)
 }
@@ -118,6 +172,10 @@ func (o *otelCollector) Deploy(ctx context.Context) error {
 	objects = append(objects, o.serviceMonitor())
 	objects = append(objects, o.serviceAccount())
 
+	if prometheusRule := o.prometheusRule(); len(prometheusRule.Spec.Groups[0].Rules) > 0 {
+		objects = append(objects, prometheusRule)
+	}
+
 	serializedResources, err := registry.AddAllAndSerialize(objects...)
 	if err != nil {
 		return err
@@ -379,9 +437,187 @@ func (o *otelCollector) openTelemetryCollector(namespace, lokiEndpoint, genericT
 		obj.Spec.AdditionalContainers[0].VolumeMounts = []corev1.VolumeMount{gardenerutils.GenerateGenericKubeconfigVolumeMount("kubeconfig", gardenerutils.VolumeMountPathGenericKubeconfig)}
 	}
 
+	if o.values.AuditLogs != nil || o.values.Export != nil {
+		// The OTLP endpoints the audit logs, logs and traces are exported to are located outside of the control plane.
+		obj.Labels = utils.MergeStringMaps(obj.Labels, map[string]string{
+			v1beta1constants.LabelNetworkPolicyToPublicNetworks:  v1beta1constants.LabelNetworkPolicyAllowed,
+			v1beta1constants.LabelNetworkPolicyToPrivateNetworks: v1beta1constants.LabelNetworkPolicyAllowed,
+		})
+	}
+
+	if o.values.AuditLogs != nil {
+		o.injectAuditLogsPipeline(obj)
+	}
+
+	if o.values.Export != nil {
+		o.injectExportPipelines(obj)
+	}
+
 	return obj
 }
 
+// injectAuditLogsPipeline adds a pipeline which receives the audit events sent by the kube-apiserver audit webhook
+// backend and exports them to the configured OTLP endpoint. The sending queue buffers the audit logs while the endpoint
+// is not reachable.
+func (o *otelCollector) injectAuditLogsPipeline(obj *otelv1beta1.OpenTelemetryCollector) {
+	obj.Spec.Ports = append(obj.Spec.Ports, otelv1beta1.PortsSpec{
+		ServicePort: corev1.ServicePort{
+			Name: auditPortName,
+			Port: collectorconstants.AuditWebhookPort,
+		},
+	})
+
+	obj.Spec.Config.Receivers.Object[auditWebhookReceiverName] = map[string]any{
+		"endpoint": "0.0.0.0:" + strconv.Itoa(collectorconstants.AuditWebhookPort),
+		"path":     collectorconstants.AuditWebhookPath,
+	}
+
+	sendingQueue := map[string]any{"enabled": true}
+	if o.values.AuditLogs.QueueSize != nil {
+		sendingQueue["queue_size"] = *o.values.AuditLogs.QueueSize
+	}
+
+	exporter := map[string]any{
+		"endpoint":         o.values.AuditLogs.Endpoint,
+		"sending_queue":    sendingQueue,
+		"retry_on_failure": map[string]any{"enabled": true},
+	}
+
+	if o.values.AuditLogs.Authorization != nil {
+		exporter["headers"] = map[string]any{"Authorization": "${env:" + envVarAuditAuthorization + "}"}
+		obj.Spec.Env = append(obj.Spec.Env, corev1.EnvVar{
+			Name:      envVarAuditAuthorization,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: o.values.AuditLogs.Authorization},
+		})
+	}
+
+	obj.Spec.Config.Exporters.Object[auditExporterName] = exporter
+
+	obj.Spec.Config.Service.Pipelines[auditPipelineName] = &otelv1beta1.Pipeline{
+		Receivers:  []string{auditWebhookReceiverName},
+		Processors: []string{"batch"},
+		Exporters:  []string{auditExporterName},
+	}
+}
+
+// injectExportPipelines adds an exporter which sends the control plane logs and the traces to the configured OTLP
+// endpoint. Traces are received via OTLP/gRPC, e.g., from the kube-apiserver.
+func (o *otelCollector) injectExportPipelines(obj *otelv1beta1.OpenTelemetryCollector) {
+	exporter := map[string]any{
+		"endpoint":         o.values.Export.Endpoint,
+		"sending_queue":    map[string]any{"enabled": true},
+		"retry_on_failure": map[string]any{"enabled": true},
+	}
+
+	if o.values.Export.Authorization != nil {
+		exporter["headers"] = map[string]any{"Authorization": "${env:" + envVarExportAuthorization + "}"}
+		obj.Spec.Env = append(obj.Spec.Env, corev1.EnvVar{
+			Name:      envVarExportAuthorization,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: o.values.Export.Authorization},
+		})
+	}
+
+	obj.Spec.Config.Exporters.Object[exportExporterName] = exporter
+
+	if o.values.Export.Logs {
+		obj.Spec.Config.Service.Pipelines["logs"].Exporters = append(obj.Spec.Config.Service.Pipelines["logs"].Exporters, exportExporterName)
+	}
+
+	if o.values.Export.Traces {
+		obj.Spec.Ports = append(obj.Spec.Ports, otelv1beta1.PortsSpec{
+			ServicePort: corev1.ServicePort{
+				Name: otlpPortName,
+				Port: collectorconstants.OTLPPort,
+			},
+		})
+
+		obj.Spec.Config.Receivers.Object[otlpReceiverName] = map[string]any{
+			"protocols": map[string]any{
+				"grpc": map[string]any{
+					"endpoint": "0.0.0.0:" + strconv.Itoa(collectorconstants.OTLPPort),
+				},
+			},
+		}
+
+		obj.Spec.Config.Service.Pipelines[tracesPipelineName] = &otelv1beta1.Pipeline{
+			Receivers:  []string{otlpReceiverName},
+			Processors: []string{"batch"},
+			Exporters:  []string{exportExporterName},
+		}
+	}
+}
+
+func (o *otelCollector) prometheusRule() *monitoringv1.PrometheusRule {
+	var rules []monitoringv1.Rule
+
+	if o.values.AuditLogs != nil {
+		rules = append(rules, auditLogsRules()...)
+	}
+
+	if o.values.Export != nil {
+		rules = append(rules, monitoringv1.Rule{
+			Alert: "TelemetryExportFailing",
+			Expr:  intstr.FromString(`sum(rate(otelcol_exporter_send_failed_log_records_total{job="opentelemetry-collector",exporter="` + exportExporterName + `"}[5m])) > 0 or sum(rate(otelcol_exporter_send_failed_spans{job="opentelemetry-collector",exporter="` + exportExporterName + `"}[5m])) > 0`),
+			For:   ptr.To(monitoringv1.Duration("15m")),
+			Labels: map[string]string{
+				"service":    "opentelemetry-collector",
+				"severity":   "warning",
+				"type":       "seed",
+				"visibility": "all",
+			},
+			Annotations: map[string]string{
+				"summary":     "Logs or traces cannot be delivered to the configured OTLP endpoint.",
+				"description": "The OpenTelemetry Collector fails to send logs or traces to the configured OTLP endpoint for at least 15 minutes.",
+			},
+		})
+	}
+
+	return &monitoringv1.PrometheusRule{
+		ObjectMeta: monitoringutils.ConfigObjectMeta(prometheusRuleName, o.namespace, shoot.Label),
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{{
+				Name:  "opentelemetry-collector.rules",
+				Rules: rules,
+			}},
+		},
+	}
+}
+
+func auditLogsRules() []monitoringv1.Rule {
+	return []monitoringv1.Rule{
+		{
+			Alert: "AuditLogExportFailing",
+			Expr:  intstr.FromString(`sum(rate(otelcol_exporter_send_failed_log_records_total{job="opentelemetry-collector",exporter="` + auditExporterName + `"}[5m])) > 0`),
+			For:   ptr.To(monitoringv1.Duration("15m")),
+			Labels: map[string]string{
+				"service":    "auditlog",
+				"severity":   "warning",
+				"type":       "seed",
+				"visibility": "owner",
+			},
+			Annotations: map[string]string{
+				"summary":     "Audit logs cannot be delivered to the configured OTLP endpoint.",
+				"description": "The OpenTelemetry Collector fails to send audit logs to the configured OTLP endpoint for at least 15 minutes.",
+			},
+		},
+		{
+			Alert: "AuditLogExportQueueNearlyFull",
+			Expr:  intstr.FromString(`max(otelcol_exporter_queue_size{job="opentelemetry-collector",exporter="` + auditExporterName + `"} / otelcol_exporter_queue_capacity{job="opentelemetry-collector",exporter="` + auditExporterName + `"}) > 0.8`),
+			For:   ptr.To(monitoringv1.Duration("10m")),
+			Labels: map[string]string{
+				"service":    "auditlog",
+				"severity":   "warning",
+				"type":       "seed",
+				"visibility": "owner",
+			},
+			Annotations: map[string]string{
+				"summary":     "The buffer for audit logs is nearly full.",
+				"description": "More than 80% of the sending queue for audit logs is used. Audit logs will be dropped once the queue is full.",
+			},
+		},
+	}
+}
+
 func getLabels() map[string]string {
 	return map[string]string{
 		v1beta1constants.LabelRole:  v1beta1constants.LabelObservability,
@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -452,6 +453,175 @@ var _ = Describe("OpenTelemetry Collector", func() {
 			Expect(customResourcesManagedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 		})
 
+		It("should successfully deploy all resources with the audit logs pipeline", func() {
+			values.AuditLogs = &AuditLogs{
+				Endpoint: "https://otlp.example.com",
+				Authorization: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "ref-otlp-credentials"},
+					Key:                  "authorization",
+				},
+				QueueSize: ptr.To[int32](500),
+			}
+			component = New(c, namespace, values, fakeSecretManager)
+			DeferCleanup(func() { values.AuditLogs = nil })
+
+			component.WithAuthenticationProxy(false)
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(customResourcesManagedResource), customResourcesManagedResource)).To(Succeed())
+
+			openTelemetryCollector.Labels["networking.gardener.cloud/to-public-networks"] = "allowed"
+			openTelemetryCollector.Labels["networking.gardener.cloud/to-private-networks"] = "allowed"
+			openTelemetryCollector.Spec.Ports = append(openTelemetryCollector.Spec.Ports, otelv1beta1.PortsSpec{
+				ServicePort: corev1.ServicePort{Name: "audit", Port: 4319},
+			})
+			openTelemetryCollector.Spec.Env = []corev1.EnvVar{{
+				Name:      "AUDIT_LOGS_AUTHORIZATION",
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: values.AuditLogs.Authorization},
+			}}
+			openTelemetryCollector.Spec.Config.Receivers.Object["webhookevent/audit"] = map[string]any{
+				"endpoint": "0.0.0.0:4319",
+				"path":     "/audit",
+			}
+			openTelemetryCollector.Spec.Config.Exporters.Object["otlphttp/audit"] = map[string]any{
+				"endpoint": "https://otlp.example.com",
+				"headers":  map[string]any{"Authorization": "${env:AUDIT_LOGS_AUTHORIZATION}"},
+				// Field needs to be cast to `float64` due to an issue with serialization during tests.
+				"sending_queue":    map[string]any{"enabled": true, "queue_size": float64(500)},
+				"retry_on_failure": map[string]any{"enabled": true},
+			}
+			openTelemetryCollector.Spec.Config.Service.Pipelines["logs/audit"] = &otelv1beta1.Pipeline{
+				Receivers:  []string{"webhookevent/audit"},
+				Processors: []string{"batch"},
+				Exporters:  []string{"otlphttp/audit"},
+			}
+
+			Expect(customResourcesManagedResource).To(consistOf(
+				openTelemetryCollector,
+				serviceMonitor,
+				serviceAccount,
+				&monitoringv1.PrometheusRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shoot-opentelemetry-collector",
+						Namespace: namespace,
+						Labels:    map[string]string{"prometheus": "shoot"},
+					},
+					Spec: monitoringv1.PrometheusRuleSpec{
+						Groups: []monitoringv1.RuleGroup{{
+							Name: "opentelemetry-collector.rules",
+							Rules: []monitoringv1.Rule{
+								{
+									Alert: "AuditLogExportFailing",
+									Expr:  intstr.FromString(`sum(rate(otelcol_exporter_send_failed_log_records_total{job="opentelemetry-collector",exporter="otlphttp/audit"}[5m])) > 0`),
+									For:   ptr.To(monitoringv1.Duration("15m")),
+									Labels: map[string]string{
+										"service":    "auditlog",
+										"severity":   "warning",
+										"type":       "seed",
+										"visibility": "owner",
+									},
+									Annotations: map[string]string{
+										"summary":     "Audit logs cannot be delivered to the configured OTLP endpoint.",
+										"description": "The OpenTelemetry Collector fails to send audit logs to the configured OTLP endpoint for at least 15 minutes.",
+									},
+								},
+								{
+									Alert: "AuditLogExportQueueNearlyFull",
+									Expr:  intstr.FromString(`max(otelcol_exporter_queue_size{job="opentelemetry-collector",exporter="otlphttp/audit"} / otelcol_exporter_queue_capacity{job="opentelemetry-collector",exporter="otlphttp/audit"}) > 0.8`),
+									For:   ptr.To(monitoringv1.Duration("10m")),
+									Labels: map[string]string{
+										"service":    "auditlog",
+										"severity":   "warning",
+										"type":       "seed",
+										"visibility": "owner",
+									},
+									Annotations: map[string]string{
+										"summary":     "The buffer for audit logs is nearly full.",
+										"description": "More than 80% of the sending queue for audit logs is used. Audit logs will be dropped once the queue is full.",
+									},
+								},
+							},
+						}},
+					},
+				},
+			))
+		})
+		It("should successfully deploy all resources with the logs and traces export pipelines", func() {
+			values.Export = &Export{
+				Endpoint: "https://otlp.example.com",
+				Authorization: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "ref-otlp-credentials"},
+					Key:                  "authorization",
+				},
+				Logs:   true,
+				Traces: true,
+			}
+			component = New(c, namespace, values, fakeSecretManager)
+			DeferCleanup(func() { values.Export = nil })
+
+			component.WithAuthenticationProxy(false)
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(customResourcesManagedResource), customResourcesManagedResource)).To(Succeed())
+
+			openTelemetryCollector.Labels["networking.gardener.cloud/to-public-networks"] = "allowed"
+			openTelemetryCollector.Labels["networking.gardener.cloud/to-private-networks"] = "allowed"
+			openTelemetryCollector.Spec.Ports = append(openTelemetryCollector.Spec.Ports, otelv1beta1.PortsSpec{
+				ServicePort: corev1.ServicePort{Name: "otlp", Port: 4320},
+			})
+			openTelemetryCollector.Spec.Env = []corev1.EnvVar{{
+				Name:      "EXPORT_AUTHORIZATION",
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: values.Export.Authorization},
+			}}
+			openTelemetryCollector.Spec.Config.Receivers.Object["otlp"] = map[string]any{
+				"protocols": map[string]any{"grpc": map[string]any{"endpoint": "0.0.0.0:4320"}},
+			}
+			openTelemetryCollector.Spec.Config.Exporters.Object["otlphttp/export"] = map[string]any{
+				"endpoint":         "https://otlp.example.com",
+				"headers":          map[string]any{"Authorization": "${env:EXPORT_AUTHORIZATION}"},
+				"sending_queue":    map[string]any{"enabled": true},
+				"retry_on_failure": map[string]any{"enabled": true},
+			}
+			openTelemetryCollector.Spec.Config.Service.Pipelines["logs"].Exporters = []string{"loki", "otlphttp/export"}
+			openTelemetryCollector.Spec.Config.Service.Pipelines["traces"] = &otelv1beta1.Pipeline{
+				Receivers:  []string{"otlp"},
+				Processors: []string{"batch"},
+				Exporters:  []string{"otlphttp/export"},
+			}
+
+			Expect(customResourcesManagedResource).To(consistOf(
+				openTelemetryCollector,
+				serviceMonitor,
+				serviceAccount,
+				&monitoringv1.PrometheusRule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shoot-opentelemetry-collector",
+						Namespace: namespace,
+						Labels:    map[string]string{"prometheus": "shoot"},
+					},
+					Spec: monitoringv1.PrometheusRuleSpec{
+						Groups: []monitoringv1.RuleGroup{{
+							Name: "opentelemetry-collector.rules",
+							Rules: []monitoringv1.Rule{{
+								Alert: "TelemetryExportFailing",
+								Expr:  intstr.FromString(`sum(rate(otelcol_exporter_send_failed_log_records_total{job="opentelemetry-collector",exporter="otlphttp/export"}[5m])) > 0 or sum(rate(otelcol_exporter_send_failed_spans{job="opentelemetry-collector",exporter="otlphttp/export"}[5m])) > 0`),
+								For:   ptr.To(monitoringv1.Duration("15m")),
+								Labels: map[string]string{
+									"service":    "opentelemetry-collector",
+									"severity":   "warning",
+									"type":       "seed",
+									"visibility": "all",
+								},
+								Annotations: map[string]string{
+									"summary":     "Logs or traces cannot be delivered to the configured OTLP endpoint.",
+									"description": "The OpenTelemetry Collector fails to send logs or traces to the configured OTLP endpoint for at least 15 minutes.",
+								},
+							}},
+						}},
+					},
+				},
+			))
+		})
 	})
 
 	Describe("#Destroy", func() {
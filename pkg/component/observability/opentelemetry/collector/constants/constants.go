@@ -19,6 +19,12 @@ const (
 	PushEndpoint = "/loki/api/v1/push"
 	// PushPort is the port that the Loki receiver listens on in the OpenTelemetry Collector deployment.
 	PushPort = 4317
+	// AuditWebhookPort is the port that the audit webhook receiver listens on in the OpenTelemetry Collector deployment.
+	AuditWebhookPort = 4319
+	// AuditWebhookPath is the path where the OpenTelemetry Collector receives audit events from the kube-apiserver.
+	AuditWebhookPath = "/audit"
+	// OTLPPort is the port that the OTLP/gRPC receiver listens on for traces in the OpenTelemetry Collector deployment.
+	OTLPPort = 4320
 	// KubeRBACProxyPort is the port that the KubeRBACProxy listens on in the OpenTelemetry Collector deployment.
 	KubeRBACProxyPort = 8080
 )
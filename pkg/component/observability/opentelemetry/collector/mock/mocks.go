@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	collector "github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -68,6 +69,30 @@ func (mr *MockInterfaceMockRecorder) Destroy(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), ctx)
 }
 
+// SetAuditLogs mocks base method.
+func (m *MockInterface) SetAuditLogs(arg0 *collector.AuditLogs) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAuditLogs", arg0)
+}
+
+// SetAuditLogs indicates an expected call of SetAuditLogs.
+func (mr *MockInterfaceMockRecorder) SetAuditLogs(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuditLogs", reflect.TypeOf((*MockInterface)(nil).SetAuditLogs), arg0)
+}
+
+// SetExport mocks base method.
+func (m *MockInterface) SetExport(arg0 *collector.Export) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetExport", arg0)
+}
+
+// SetExport indicates an expected call of SetExport.
+func (mr *MockInterfaceMockRecorder) SetExport(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExport", reflect.TypeOf((*MockInterface)(nil).SetExport), arg0)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(ctx context.Context) error {
 	m.ctrl.T.Helper()
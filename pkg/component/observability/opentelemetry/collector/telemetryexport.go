@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// TelemetryExportDataKey is the key in the data of the ConfigMap containing the telemetry export configuration.
+	TelemetryExportDataKey = "export.yaml"
+
+	// SignalLogs is the signal for the logs of the control plane components.
+	SignalLogs = "logs"
+	// SignalTraces is the signal for the traces of the kube-apiserver.
+	SignalTraces = "traces"
+
+	// DefaultSamplingRatePerMillion is the default number of spans per million which are sampled by the kube-apiserver.
+	DefaultSamplingRatePerMillion int32 = 1000
+)
+
+var availableSignals = sets.New(SignalLogs, SignalTraces)
+
+// TelemetryExport contains the configuration for exporting the control plane logs and traces to an OTLP endpoint
+// defined by the shoot owner.
+type TelemetryExport struct {
+	// Endpoint is the https URL of the OTLP/HTTP endpoint.
+	Endpoint string `json:"endpoint"`
+	// Authorization references the secret key containing the value of the `Authorization` header sent to the endpoint.
+	Authorization *gardenerutils.SecretKeyReference `json:"authorization,omitempty"`
+	// Signals is the list of signals which are exported. Supported values are `logs` and `traces`. If empty, both logs
+	// and traces are exported.
+	Signals []string `json:"signals,omitempty"`
+	// Tracing configures the traces recorded by the kube-apiserver.
+	Tracing *TelemetryExportTracing `json:"tracing,omitempty"`
+}
+
+// TelemetryExportTracing configures the traces recorded by the kube-apiserver.
+type TelemetryExportTracing struct {
+	// SamplingRatePerMillion is the number of spans per million which are sampled.
+	SamplingRatePerMillion *int32 `json:"samplingRatePerMillion,omitempty"`
+}
+
+// ExportsSignal returns whether the given signal is exported.
+func (t *TelemetryExport) ExportsSignal(signal string) bool {
+	return len(t.Signals) == 0 || sets.New(t.Signals...).Has(signal)
+}
+
+// ParseTelemetryExport parses and validates the given telemetry export configuration. Secret references are validated
+// against the given resource references of the Shoot.
+func ParseTelemetryExport(data []byte, resources []gardencorev1beta1.NamedResourceReference) (*TelemetryExport, error) {
+	export := &TelemetryExport{}
+	if err := yaml.UnmarshalStrict(data, export); err != nil {
+		return nil, fmt.Errorf("failed parsing telemetry export: %w", err)
+	}
+
+	if errs := validateTelemetryExport(export, resources); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid telemetry export: %w", errs.ToAggregate())
+	}
+
+	return export, nil
+}
+
+func validateTelemetryExport(export *TelemetryExport, resources []gardencorev1beta1.NamedResourceReference) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !strings.HasPrefix(export.Endpoint, "https://") {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("endpoint"), export.Endpoint, "must be an https URL"))
+	}
+
+	if export.Authorization != nil {
+		allErrs = append(allErrs, gardenerutils.ValidateSecretKeyReference(*export.Authorization, resources, field.NewPath("authorization"))...)
+	}
+
+	for i, signal := range export.Signals {
+		if !availableSignals.Has(signal) {
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("signals").Index(i), signal, sets.List(availableSignals)))
+		}
+	}
+
+	if export.Tracing != nil {
+		if v := export.Tracing.SamplingRatePerMillion; v != nil && (*v <= 0 || *v > 1000000) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("tracing", "samplingRatePerMillion"), *v, "must be between 1 and 1000000"))
+		}
+	}
+
+	return allErrs
+}
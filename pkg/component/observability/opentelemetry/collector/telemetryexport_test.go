@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package collector_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("TelemetryExport", func() {
+	var resources []gardencorev1beta1.NamedResourceReference
+
+	BeforeEach(func() {
+		resources = []gardencorev1beta1.NamedResourceReference{
+			{Name: "telemetry-export", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-telemetry-export"}},
+			{Name: "credentials", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-credentials"}},
+		}
+	})
+
+	Describe("#ParseTelemetryExport", func() {
+		It("should parse a full configuration", func() {
+			export, err := ParseTelemetryExport([]byte(`endpoint: https://otlp.example.com
+authorization:
+  resourceName: credentials
+  key: authorization
+signals:
+- traces
+tracing:
+  samplingRatePerMillion: 10000
+`), resources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(export).To(Equal(&TelemetryExport{
+				Endpoint:      "https://otlp.example.com",
+				Authorization: &gardenerutils.SecretKeyReference{ResourceName: "credentials", Key: "authorization"},
+				Signals:       []string{"traces"},
+				Tracing:       &TelemetryExportTracing{SamplingRatePerMillion: ptr.To[int32](10000)},
+			}))
+			Expect(export.ExportsSignal(SignalLogs)).To(BeFalse())
+			Expect(export.ExportsSignal(SignalTraces)).To(BeTrue())
+		})
+
+		It("should export all signals if none are specified", func() {
+			export, err := ParseTelemetryExport([]byte(`endpoint: https://otlp.example.com`), resources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(export.ExportsSignal(SignalLogs)).To(BeTrue())
+			Expect(export.ExportsSignal(SignalTraces)).To(BeTrue())
+		})
+
+		It("should fail for unknown fields", func() {
+			_, err := ParseTelemetryExport([]byte(`endpoint: https://otlp.example.com
+foo: bar
+`), resources)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing telemetry export")))
+		})
+
+		It("should fail for an invalid configuration", func() {
+			_, err := ParseTelemetryExport([]byte(`endpoint: http://otlp.example.com
+authorization:
+  resourceName: unknown
+signals:
+- metrics
+tracing:
+  samplingRatePerMillion: 0
+`), resources)
+			Expect(err).To(MatchError(And(
+				ContainSubstring("endpoint: Invalid value"),
+				ContainSubstring("authorization.resourceName: Invalid value"),
+				ContainSubstring("authorization.key: Required value"),
+				ContainSubstring("signals[0]: Unsupported value"),
+				ContainSubstring("tracing.samplingRatePerMillion: Invalid value"),
+			)))
+		})
+	})
+})
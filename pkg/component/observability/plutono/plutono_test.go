@@ -680,7 +680,7 @@ status:
 				})
 
 				It("should successfully deploy all resources", func() {
-					checkDeployedResources("plutono-dashboards", 23)
+					checkDeployedResources("plutono-dashboards", 24)
 				})
 
 				Context("w/ enabled vpa", func() {
@@ -689,7 +689,7 @@ status:
 					})
 
 					It("should successfully deploy all resources", func() {
-						checkDeployedResources("plutono-dashboards", 26)
+						checkDeployedResources("plutono-dashboards", 27)
 					})
 				})
 			})
@@ -705,7 +705,7 @@ status:
 					})
 
 					It("should successfully deploy all resources", func() {
-						checkDeployedResources("plutono-dashboards-garden", 31)
+						checkDeployedResources("plutono-dashboards-garden", 32)
 					})
 				})
 
@@ -716,7 +716,7 @@ status:
 
 					It("should successfully deploy all resources", func() {
 						dashboardConfigMapName := "plutono-dashboards-garden"
-						dashboardCount := 28
+						dashboardCount := 29
 
 						Expect(manifests).To(ConsistOf(
 							dataSourceConfigMapYAMLFor(values),
@@ -735,7 +735,7 @@ status:
 				})
 
 				It("should successfully deploy all resources", func() {
-					checkDeployedResources("plutono-dashboards-garden", 28)
+					checkDeployedResources("plutono-dashboards-garden", 29)
 				})
 			})
 		})
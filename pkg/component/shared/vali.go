@@ -25,6 +25,7 @@ func NewVali(
 	priorityClassName string,
 	storage *resource.Quantity,
 	ingressHost string,
+	limits *vali.Limits,
 ) (
 	vali.Interface,
 	error,
@@ -66,6 +67,7 @@ func NewVali(
 		Storage:                 storage,
 		ClusterType:             clusterType,
 		IngressHost:             ingressHost,
+		Limits:                  limits,
 	})
 
 	return deployer, nil
@@ -283,6 +283,26 @@ func SetDefaults_EventControllerConfiguration(obj *EventControllerConfiguration)
 	}
 }
 
+// SetDefaults_EventArchiveControllerConfiguration sets defaults for the EventArchiveControllerConfiguration.
+func SetDefaults_EventArchiveControllerConfiguration(obj *EventArchiveControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if len(obj.Kinds) == 0 {
+		obj.Kinds = []string{"Shoot", "Seed", "Project", "BackupEntry"}
+	}
+	if obj.HistoryLimit == nil {
+		obj.HistoryLimit = ptr.To[int32](100)
+	}
+}
+
+// SetDefaults_EventArchiveOTLPConfiguration sets defaults for the EventArchiveOTLPConfiguration.
+func SetDefaults_EventArchiveOTLPConfiguration(obj *EventArchiveOTLPConfiguration) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
 // SetDefaults_ShootStatusLabelControllerConfiguration sets defaults for the ShootStatusLabelControllerConfiguration.
 func SetDefaults_ShootStatusLabelControllerConfiguration(obj *ShootStatusLabelControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -290,6 +310,22 @@ func SetDefaults_ShootStatusLabelControllerConfiguration(obj *ShootStatusLabelCo
 	}
 }
 
+// SetDefaults_ShootAPIServerProbeControllerConfiguration sets defaults for the ShootAPIServerProbeControllerConfiguration.
+func SetDefaults_ShootAPIServerProbeControllerConfiguration(obj *ShootAPIServerProbeControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+	if obj.FailureThreshold == nil {
+		obj.FailureThreshold = ptr.To[int32](3)
+	}
+}
+
 // SetDefaults_ShootMigrationControllerConfiguration sets defaults for the ShootMigrationControllerConfiguration.
 func SetDefaults_ShootMigrationControllerConfiguration(obj *ShootMigrationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -749,6 +749,99 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootAPIServerProbeControllerConfiguration defaulting", func() {
+		It("should default ShootAPIServerProbeControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootAPIServerProbe: &ShootAPIServerProbeControllerConfiguration{},
+				},
+			}
+			expected := &ShootAPIServerProbeControllerConfiguration{
+				ConcurrentSyncs:  ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:       &metav1.Duration{Duration: time.Minute},
+				Timeout:          &metav1.Duration{Duration: 10 * time.Second},
+				FailureThreshold: ptr.To[int32](3),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAPIServerProbe).To(Equal(expected))
+		})
+
+		It("should not default ShootAPIServerProbeControllerConfiguration if not set", func() {
+			var expected *ShootAPIServerProbeControllerConfiguration
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAPIServerProbe).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootAPIServerProbe: &ShootAPIServerProbeControllerConfiguration{
+						ConcurrentSyncs:  ptr.To(10),
+						SyncPeriod:       &metav1.Duration{Duration: 30 * time.Second},
+						Timeout:          &metav1.Duration{Duration: 5 * time.Second},
+						FailureThreshold: ptr.To[int32](1),
+					},
+				},
+			}
+			expected := obj.Controllers.ShootAPIServerProbe.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAPIServerProbe).To(Equal(expected))
+		})
+	})
+
+	Describe("EventArchiveControllerConfiguration defaulting", func() {
+		It("should default EventArchiveControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					EventArchive: &EventArchiveControllerConfiguration{
+						OTLP: &EventArchiveOTLPConfiguration{Endpoint: "https://otel-collector.example.com:4318"},
+					},
+				},
+			}
+			expected := &EventArchiveControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				Kinds:           []string{"Shoot", "Seed", "Project", "BackupEntry"},
+				HistoryLimit:    ptr.To[int32](100),
+				OTLP: &EventArchiveOTLPConfiguration{
+					Endpoint: "https://otel-collector.example.com:4318",
+					Timeout:  &metav1.Duration{Duration: 10 * time.Second},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.EventArchive).To(Equal(expected))
+		})
+
+		It("should not default EventArchiveControllerConfiguration if not set", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.EventArchive).To(BeNil())
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					EventArchive: &EventArchiveControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						Kinds:           []string{"Shoot"},
+						HistoryLimit:    ptr.To[int32](0),
+						OTLP: &EventArchiveOTLPConfiguration{
+							Endpoint: "https://otel-collector.example.com:4318",
+							Timeout:  &metav1.Duration{Duration: time.Second},
+						},
+					},
+				},
+			}
+			expected := obj.Controllers.EventArchive.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.EventArchive).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootStatusLabelControllerConfiguration defaulting", func() {
 		It("should default ShootStatusLabelControllerConfiguration correctly", func() {
 			expected := &ShootStatusLabelControllerConfiguration{
@@ -64,6 +64,9 @@ type ControllerManagerControllerConfiguration struct {
 	// Event defines the configuration of the Event controller.  If unset, the event controller will be disabled.
 	// +optional
 	Event *EventControllerConfiguration `json:"event,omitempty"`
+	// EventArchive defines the configuration of the EventArchive controller. If unset, the controller is disabled.
+	// +optional
+	EventArchive *EventArchiveControllerConfiguration `json:"eventArchive,omitempty"`
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	// +optional
 	ExposureClass *ExposureClassControllerConfiguration `json:"exposureClass,omitempty"`
@@ -110,6 +113,10 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	// +optional
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration `json:"shootStatusLabel,omitempty"`
+	// ShootAPIServerProbe defines the configuration of the ShootAPIServerProbe controller. If unset, the controller is
+	// disabled.
+	// +optional
+	ShootAPIServerProbe *ShootAPIServerProbeControllerConfiguration `json:"shootAPIServerProbe,omitempty"`
 	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
@@ -190,6 +197,38 @@ type EventControllerConfiguration struct {
 	TTLNonShootEvents *metav1.Duration `json:"ttlNonShootEvents,omitempty"`
 }
 
+// EventArchiveControllerConfiguration defines the configuration of the EventArchive controller.
+type EventArchiveControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// Kinds is the list of kinds in the `core.gardener.cloud` API group whose events are archived.
+	// Defaults to `Shoot`, `Seed`, `Project`, and `BackupEntry`.
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+	// OTLP configures an OTLP/HTTP endpoint to which the events are exported as log records. If unset, the events are
+	// written as structured entries to the log of the gardener-controller-manager, from where they are shipped by the
+	// logging stack of the runtime cluster.
+	// +optional
+	OTLP *EventArchiveOTLPConfiguration `json:"otlp,omitempty"`
+	// HistoryLimit is the number of most recent events per resource which are additionally kept in a ConfigMap in the
+	// namespace of the resource, so that project members can query the event history of their resources. A value of
+	// `0` disables the history. Defaults to 100.
+	// +optional
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+}
+
+// EventArchiveOTLPConfiguration defines the OTLP/HTTP endpoint to which archived events are exported.
+type EventArchiveOTLPConfiguration struct {
+	// Endpoint is the base URL of the OTLP/HTTP endpoint, e.g. `https://otel-collector.example.com:4318`. The log
+	// records are sent to the `/v1/logs` path of this URL.
+	Endpoint string `json:"endpoint"`
+	// Timeout is the timeout for exporting an event. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
 // ExposureClassControllerConfiguration defines the configuration of the
 // ExposureClass controller.
 type ExposureClassControllerConfiguration struct {
@@ -337,6 +376,11 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// RespectUpgradeReadiness configures whether forceful updates of the Kubernetes version to the next minor version
+	// are refused as long as the shoot reports that APIs removed in this version are still in use (i.e., its
+	// UpgradeReadiness constraint is not satisfied).
+	// +optional
+	RespectUpgradeReadiness *bool `json:"respectUpgradeReadiness,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -410,6 +454,25 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootAPIServerProbeControllerConfiguration defines the configuration of the
+// ShootAPIServerProbe controller.
+type ShootAPIServerProbeControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the API servers of the shoots are probed. Defaults to 1m.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Timeout is the timeout of a single probe. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// FailureThreshold is the number of consecutive failed probes after which the API server is considered to be
+	// unreachable. Defaults to 3.
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
 // ShootMigrationControllerConfiguration defines the configuration of the
 // ShootMigration controller.
 type ShootMigrationControllerConfiguration struct {
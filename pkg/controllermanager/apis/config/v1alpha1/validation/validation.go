@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"net/url"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -51,6 +53,14 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 		allErrs = append(allErrs, validateShootStateControllerConfiguration(conf.ShootState, shootStateFldPath)...)
 	}
 
+	if conf.ShootAPIServerProbe != nil {
+		allErrs = append(allErrs, validateShootAPIServerProbeControllerConfiguration(conf.ShootAPIServerProbe, fldPath.Child("shootAPIServerProbe"))...)
+	}
+
+	if conf.EventArchive != nil {
+		allErrs = append(allErrs, validateEventArchiveControllerConfiguration(conf.EventArchive, fldPath.Child("eventArchive"))...)
+	}
+
 	return allErrs
 }
 
@@ -77,3 +87,58 @@ func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1al
 	}
 	return allErrs
 }
+
+func validateShootAPIServerProbeControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootAPIServerProbeControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if conf.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration.String(), "must be positive"))
+	}
+	if conf.Timeout != nil {
+		if conf.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), conf.Timeout.Duration.String(), "must be positive"))
+		} else if conf.SyncPeriod != nil && conf.Timeout.Duration > conf.SyncPeriod.Duration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), conf.Timeout.Duration.String(), "must not be greater than the sync period"))
+		}
+	}
+	if conf.FailureThreshold != nil && *conf.FailureThreshold <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureThreshold"), *conf.FailureThreshold, "must be positive"))
+	}
+	return allErrs
+}
+
+func validateEventArchiveControllerConfiguration(conf *controllermanagerconfigv1alpha1.EventArchiveControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if conf.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	kinds := sets.New[string]()
+	for i, kind := range conf.Kinds {
+		idxPath := fldPath.Child("kinds").Index(i)
+		if kind == "" {
+			allErrs = append(allErrs, field.Required(idxPath, "kind must not be empty"))
+		} else if kinds.Has(kind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, kind))
+		}
+		kinds.Insert(kind)
+	}
+
+	if conf.HistoryLimit != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.HistoryLimit), fldPath.Child("historyLimit"))...)
+	}
+
+	if conf.OTLP != nil {
+		otlpFldPath := fldPath.Child("otlp")
+		if u, err := url.Parse(conf.OTLP.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(otlpFldPath.Child("endpoint"), conf.OTLP.Endpoint, "must be a valid http or https URL"))
+		}
+		if conf.OTLP.Timeout != nil && conf.OTLP.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(otlpFldPath.Child("timeout"), conf.OTLP.Timeout.Duration.String(), "must be positive"))
+		}
+	}
+
+	return allErrs
+}
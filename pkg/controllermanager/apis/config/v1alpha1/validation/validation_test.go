@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -193,4 +195,114 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 			})
 		})
 	})
+
+	Context("ShootAPIServerProbeControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootAPIServerProbe = &controllermanagerconfigv1alpha1.ShootAPIServerProbeControllerConfiguration{
+				ConcurrentSyncs:  ptr.To(5),
+				SyncPeriod:       &metav1.Duration{Duration: time.Minute},
+				Timeout:          &metav1.Duration{Duration: 10 * time.Second},
+				FailureThreshold: ptr.To[int32](3),
+			}
+		})
+
+		It("should allow valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid configuration", func() {
+			conf.Controllers.ShootAPIServerProbe.ConcurrentSyncs = ptr.To(-1)
+			conf.Controllers.ShootAPIServerProbe.SyncPeriod = &metav1.Duration{Duration: 0}
+			conf.Controllers.ShootAPIServerProbe.Timeout = &metav1.Duration{Duration: -time.Second}
+			conf.Controllers.ShootAPIServerProbe.FailureThreshold = ptr.To[int32](0)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootAPIServerProbe.concurrentSyncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootAPIServerProbe.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootAPIServerProbe.timeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootAPIServerProbe.failureThreshold"),
+				})),
+			))
+		})
+
+		It("should forbid a timeout greater than the sync period", func() {
+			conf.Controllers.ShootAPIServerProbe.Timeout = &metav1.Duration{Duration: 2 * time.Minute}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootAPIServerProbe.timeout"),
+				})),
+			))
+		})
+	})
+
+	Context("EventArchiveControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.EventArchive = &controllermanagerconfigv1alpha1.EventArchiveControllerConfiguration{
+				ConcurrentSyncs: ptr.To(5),
+				Kinds:           []string{"Shoot", "Seed"},
+				OTLP: &controllermanagerconfigv1alpha1.EventArchiveOTLPConfiguration{
+					Endpoint: "https://otel-collector.example.com:4318",
+					Timeout:  &metav1.Duration{Duration: 10 * time.Second},
+				},
+			}
+		})
+
+		It("should allow valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should allow configuration without OTLP endpoint", func() {
+			conf.Controllers.EventArchive.OTLP = nil
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid configuration", func() {
+			conf.Controllers.EventArchive.ConcurrentSyncs = ptr.To(-1)
+			conf.Controllers.EventArchive.Kinds = []string{"Shoot", "", "Shoot"}
+			conf.Controllers.EventArchive.HistoryLimit = ptr.To[int32](-1)
+			conf.Controllers.EventArchive.OTLP.Endpoint = "otel-collector:4318"
+			conf.Controllers.EventArchive.OTLP.Timeout = &metav1.Duration{Duration: 0}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.eventArchive.concurrentSyncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.eventArchive.kinds[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.eventArchive.kinds[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.eventArchive.historyLimit"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.eventArchive.otlp.endpoint"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.eventArchive.otlp.timeout"),
+				})),
+			))
+		})
+	})
 })
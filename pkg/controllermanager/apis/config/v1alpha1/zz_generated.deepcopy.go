@@ -204,6 +204,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(EventControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EventArchive != nil {
+		in, out := &in.EventArchive, &out.EventArchive
+		*out = new(EventArchiveControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExposureClass != nil {
 		in, out := &in.ExposureClass, &out.ExposureClass
 		*out = new(ExposureClassControllerConfiguration)
@@ -276,6 +281,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootStatusLabelControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootAPIServerProbe != nil {
+		in, out := &in.ShootAPIServerProbe, &out.ShootAPIServerProbe
+		*out = new(ShootAPIServerProbeControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootMigration != nil {
 		in, out := &in.ShootMigration, &out.ShootMigration
 		*out = new(ShootMigrationControllerConfiguration)
@@ -346,6 +356,63 @@ func (in *CredentialsBindingControllerConfiguration) DeepCopy() *CredentialsBind
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventArchiveControllerConfiguration) DeepCopyInto(out *EventArchiveControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(EventArchiveOTLPConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventArchiveControllerConfiguration.
+func (in *EventArchiveControllerConfiguration) DeepCopy() *EventArchiveControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EventArchiveControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventArchiveOTLPConfiguration) DeepCopyInto(out *EventArchiveOTLPConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventArchiveOTLPConfiguration.
+func (in *EventArchiveOTLPConfiguration) DeepCopy() *EventArchiveOTLPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EventArchiveOTLPConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventControllerConfiguration) DeepCopyInto(out *EventControllerConfiguration) {
 	*out = *in
@@ -714,6 +781,42 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAPIServerProbeControllerConfiguration) DeepCopyInto(out *ShootAPIServerProbeControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAPIServerProbeControllerConfiguration.
+func (in *ShootAPIServerProbeControllerConfiguration) DeepCopy() *ShootAPIServerProbeControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAPIServerProbeControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootConditionsControllerConfiguration) DeepCopyInto(out *ShootConditionsControllerConfiguration) {
 	*out = *in
@@ -779,6 +882,11 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RespectUpgradeReadiness != nil {
+		in, out := &in.RespectUpgradeReadiness, &out.RespectUpgradeReadiness
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -48,6 +48,12 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.Event != nil {
 		SetDefaults_EventControllerConfiguration(in.Controllers.Event)
 	}
+	if in.Controllers.EventArchive != nil {
+		SetDefaults_EventArchiveControllerConfiguration(in.Controllers.EventArchive)
+		if in.Controllers.EventArchive.OTLP != nil {
+			SetDefaults_EventArchiveOTLPConfiguration(in.Controllers.EventArchive.OTLP)
+		}
+	}
 	if in.Controllers.ExposureClass != nil {
 		SetDefaults_ExposureClassControllerConfiguration(in.Controllers.ExposureClass)
 	}
@@ -92,6 +98,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootStatusLabel != nil {
 		SetDefaults_ShootStatusLabelControllerConfiguration(in.Controllers.ShootStatusLabel)
 	}
+	if in.Controllers.ShootAPIServerProbe != nil {
+		SetDefaults_ShootAPIServerProbeControllerConfiguration(in.Controllers.ShootAPIServerProbe)
+	}
 	if in.Controllers.ShootMigration != nil {
 		SetDefaults_ShootMigrationControllerConfiguration(in.Controllers.ShootMigration)
 	}
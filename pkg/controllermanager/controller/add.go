@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/credentialsbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/event"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/eventarchive"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/exposureclass"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/namespacedcloudprofile"
@@ -86,6 +87,14 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		}
 	}
 
+	if config := cfg.Controllers.EventArchive; config != nil {
+		if err := (&eventarchive.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding EventArchive controller: %w", err)
+		}
+	}
+
 	if err := (&exposureclass.Reconciler{
 		Config: *cfg.Controllers.ExposureClass,
 	}).AddToManager(mgr); err != nil {
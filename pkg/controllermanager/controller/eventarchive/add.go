@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventarchive
+
+import (
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "event-archive"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Sink == nil {
+		if r.Config.OTLP != nil {
+			r.Sink = NewOTLPSink(r.Config.OTLP.Endpoint, &http.Client{Timeout: r.Config.OTLP.Timeout.Duration})
+		} else {
+			r.Sink = NewLogSink(mgr.GetLogger().WithName(ControllerName))
+		}
+
+		if historyLimit := ptr.Deref(r.Config.HistoryLimit, 0); historyLimit > 0 {
+			r.Sink = NewMultiSink(r.Sink, NewHistorySink(r.Client, mgr.GetAPIReader(), int(historyLimit)))
+		}
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&corev1.Event{}, builder.WithPredicates(r.EventPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Complete(r)
+}
+
+// EventPredicate returns a predicate which filters for events about resources of the configured kinds in the
+// 'core.gardener.cloud' API group. Updates are only considered if the event occurred again.
+func (r *Reconciler) EventPredicate() predicate.Predicate {
+	kinds := sets.New(r.Config.Kinds...)
+
+	isRelevant := func(obj client.Object) bool {
+		event, ok := obj.(*corev1.Event)
+		if !ok {
+			return false
+		}
+
+		gv, err := schema.ParseGroupVersion(event.InvolvedObject.APIVersion)
+		return err == nil && gv.Group == gardencorev1beta1.GroupName && kinds.Has(event.InvolvedObject.Kind)
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return isRelevant(e.Object) },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldEvent, ok := e.ObjectOld.(*corev1.Event)
+			if !ok {
+				return false
+			}
+			newEvent, ok := e.ObjectNew.(*corev1.Event)
+			if !ok {
+				return false
+			}
+
+			return isRelevant(newEvent) && count(oldEvent) != count(newEvent)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
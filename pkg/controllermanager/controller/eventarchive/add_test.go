@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventarchive_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/eventarchive"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#EventPredicate", func() {
+		var (
+			p          predicate.Predicate
+			shootEvent *corev1.Event
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{Config: controllermanagerconfigv1alpha1.EventArchiveControllerConfiguration{Kinds: []string{"Shoot", "Seed"}}}).EventPredicate()
+			shootEvent = &corev1.Event{
+				InvolvedObject: corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot"},
+				Count:          1,
+			}
+		})
+
+		Describe("#Create", func() {
+			It("should return true for events about configured kinds", func() {
+				Expect(p.Create(event.CreateEvent{Object: shootEvent})).To(BeTrue())
+			})
+
+			It("should return false for events about other kinds", func() {
+				shootEvent.InvolvedObject.Kind = "Project"
+				Expect(p.Create(event.CreateEvent{Object: shootEvent})).To(BeFalse())
+			})
+
+			It("should return false for events about resources of other API groups", func() {
+				shootEvent.InvolvedObject.APIVersion = "v1"
+				Expect(p.Create(event.CreateEvent{Object: shootEvent})).To(BeFalse())
+			})
+
+			It("should return false for other objects", func() {
+				Expect(p.Create(event.CreateEvent{Object: &corev1.Pod{}})).To(BeFalse())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false if the event did not occur again", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: shootEvent, ObjectNew: shootEvent})).To(BeFalse())
+			})
+
+			It("should return true if the event occurred again", func() {
+				newEvent := shootEvent.DeepCopy()
+				newEvent.Count++
+				Expect(p.Update(event.UpdateEvent{ObjectOld: shootEvent, ObjectNew: newEvent})).To(BeTrue())
+			})
+
+			It("should return true if the event series was updated", func() {
+				newEvent := shootEvent.DeepCopy()
+				newEvent.Series = &corev1.EventSeries{Count: 2}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: shootEvent, ObjectNew: newEvent})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{Object: shootEvent})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{Object: shootEvent})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventarchive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+const (
+	// LabelValueEventHistory is the value of the 'gardener.cloud/role' label of the ConfigMaps containing the event
+	// history of a resource.
+	LabelValueEventHistory = "event-history"
+	// DataKeyEventHistory is the key in the data of the ConfigMaps which contains the event history of a resource.
+	DataKeyEventHistory = "events.yaml"
+)
+
+// HistoryConfigMapName returns the name of the ConfigMap containing the event history of the resource with the given
+// kind and name.
+func HistoryConfigMapName(kind, name string) string {
+	return "event-history-" + strings.ToLower(kind) + "-" + name
+}
+
+// HistoryEntry is an event in the history of a resource.
+type HistoryEntry struct {
+	// Time is the time of the last occurrence of the event.
+	Time metav1.Time `json:"time"`
+	// UID is the UID of the event.
+	UID types.UID `json:"uid"`
+	// Type is the type of the event, i.e. 'Normal' or 'Warning'.
+	Type string `json:"type"`
+	// Reason is the reason of the event.
+	Reason string `json:"reason"`
+	// Message is the message of the event.
+	Message string `json:"message"`
+	// Count is the number of occurrences of the event.
+	Count int32 `json:"count"`
+	// Source is the component which reported the event.
+	Source string `json:"source,omitempty"`
+}
+
+// NewHistorySink returns a Sink which keeps the given number of most recent records per resource in a ConfigMap in the
+// namespace of the resource. Project members can read these ConfigMaps, hence they serve as the query path for the
+// event history of their resources. Records about resources which do not belong to a project are skipped.
+func NewHistorySink(client client.Client, reader client.Reader, limit int) Sink {
+	return &historySink{client: client, reader: reader, limit: limit}
+}
+
+type historySink struct {
+	client client.Client
+	reader client.Reader
+	limit  int
+}
+
+func (s *historySink) Archive(ctx context.Context, record Record) error {
+	if record.Project == "" || record.Namespace == "" {
+		return nil
+	}
+
+	entry := HistoryEntry{
+		Time:    metav1.NewTime(record.Time),
+		UID:     record.UID,
+		Type:    record.Type,
+		Reason:  record.Reason,
+		Message: record.Message,
+		Count:   record.Count,
+		Source:  record.Source,
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: HistoryConfigMapName(record.Kind, record.Name), Namespace: record.Namespace}}
+	// The ConfigMaps are read directly from the API server to avoid caching all ConfigMaps of the garden cluster.
+	if err := s.reader.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading event history ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+		}
+
+		configMap.Labels = map[string]string{v1beta1constants.GardenRole: LabelValueEventHistory}
+		if err := s.setEntries(configMap, []HistoryEntry{entry}); err != nil {
+			return err
+		}
+		// A conflict with a concurrent creation is resolved by retrying the event.
+		return s.client.Create(ctx, configMap)
+	}
+
+	// Entries which cannot be decoded are dropped, so that a corrupted history does not block archiving new events.
+	var entries []HistoryEntry
+	_ = yaml.Unmarshal([]byte(configMap.Data[DataKeyEventHistory]), &entries)
+
+	for _, e := range entries {
+		if e.UID == entry.UID && e.Count >= entry.Count {
+			// The event was already added to the history before, e.g. before the controller restarted.
+			return nil
+		}
+	}
+
+	entries = append(entries, entry)
+	if len(entries) > s.limit {
+		entries = entries[len(entries)-s.limit:]
+	}
+
+	patch := client.MergeFromWithOptions(configMap.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	if err := s.setEntries(configMap, entries); err != nil {
+		return err
+	}
+	return s.client.Patch(ctx, configMap, patch)
+}
+
+func (s *historySink) setEntries(configMap *corev1.ConfigMap, entries []HistoryEntry) error {
+	data, err := yaml.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed marshalling event history: %w", err)
+	}
+
+	configMap.Data = map[string]string{DataKeyEventHistory: string(data)}
+	return nil
+}
+
+// NewMultiSink returns a Sink which archives the records in all given sinks.
+func NewMultiSink(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+type multiSink []Sink
+
+func (m multiSink) Archive(ctx context.Context, record Record) error {
+	for _, sink := range m {
+		if err := sink.Archive(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
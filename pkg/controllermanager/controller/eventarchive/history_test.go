@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventarchive_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/eventarchive"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("History", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		sink       Sink
+
+		record    Record
+		configMap *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		sink = NewHistorySink(fakeClient, fakeClient, 2)
+
+		record = Record{
+			Time:      time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+			UID:       "uid",
+			Type:      corev1.EventTypeWarning,
+			Reason:    "ReconcileError",
+			Message:   "Flow failed",
+			Count:     3,
+			Source:    "gardenlet",
+			Kind:      "Shoot",
+			Namespace: "garden-foo",
+			Name:      "bar",
+			Project:   "foo",
+		}
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "event-history-shoot-bar", Namespace: "garden-foo"}}
+	})
+
+	historyEntries := func() []HistoryEntry {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+
+		var entries []HistoryEntry
+		ExpectWithOffset(1, yaml.Unmarshal([]byte(configMap.Data["events.yaml"]), &entries)).To(Succeed())
+		return entries
+	}
+
+	Describe("#HistoryConfigMapName", func() {
+		It("should return the name of the ConfigMap", func() {
+			Expect(HistoryConfigMapName("BackupEntry", "foo")).To(Equal("event-history-backupentry-foo"))
+		})
+	})
+
+	Describe("#NewHistorySink", func() {
+		It("should create the history of the resource", func() {
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+
+			Expect(historyEntries()).To(ConsistOf(HistoryEntry{
+				Time:    metav1.NewTime(record.Time.Local()),
+				UID:     "uid",
+				Type:    corev1.EventTypeWarning,
+				Reason:  "ReconcileError",
+				Message: "Flow failed",
+				Count:   3,
+				Source:  "gardenlet",
+			}))
+			Expect(configMap.Labels).To(HaveKeyWithValue("gardener.cloud/role", "event-history"))
+		})
+
+		It("should not add an event which is already part of the history with its current count", func() {
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+
+			Expect(historyEntries()).To(HaveLen(1))
+		})
+
+		It("should only keep the most recent events", func() {
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+			record.Count = 4
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+			record.UID, record.Count = "other", 1
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+
+			entries := historyEntries()
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].UID).To(BeEquivalentTo("uid"))
+			Expect(entries[0].Count).To(Equal(int32(4)))
+			Expect(entries[1].UID).To(BeEquivalentTo("other"))
+		})
+
+		It("should replace a history which cannot be decoded", func() {
+			configMap.Data = map[string]string{"events.yaml": "{"}
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+			Expect(historyEntries()).To(HaveLen(1))
+		})
+
+		It("should skip resources which do not belong to a project", func() {
+			record.Project = ""
+
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+		})
+
+		It("should skip cluster-scoped resources", func() {
+			record.Namespace = ""
+
+			Expect(sink.Archive(ctx, record)).To(Succeed())
+
+			configMapList := &corev1.ConfigMapList{}
+			Expect(fakeClient.List(ctx, configMapList)).To(Succeed())
+			Expect(configMapList.Items).To(BeEmpty())
+		})
+	})
+
+	Describe("#NewMultiSink", func() {
+		It("should archive the record in all sinks", func() {
+			first, second := &fakeSink{}, &fakeSink{}
+
+			Expect(NewMultiSink(first, second).Archive(ctx, record)).To(Succeed())
+			Expect(first.records).To(ConsistOf(record))
+			Expect(second.records).To(ConsistOf(record))
+		})
+
+		It("should return the error of a sink", func() {
+			first, second := &fakeSink{err: errors.New("fake")}, &fakeSink{}
+
+			Expect(NewMultiSink(first, second).Archive(ctx, record)).To(MatchError("fake"))
+			Expect(second.records).To(BeEmpty())
+		})
+	})
+})
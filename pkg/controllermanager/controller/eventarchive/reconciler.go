@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventarchive
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// archivedCountsCacheSize is the number of events whose archived count is remembered.
+const archivedCountsCacheSize = 10000
+
+// Reconciler archives Events about Gardener resources.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.EventArchiveControllerConfiguration
+	Sink   Sink
+
+	// archivedCounts maps the UIDs of events to their count when they were archived last. It is only kept in memory,
+	// i.e. events are archived again when the controller restarts. The sinks must cope with this at-least-once delivery,
+	// the records can be deduplicated by the UID and count of the event.
+	archivedCounts     *lru.Cache
+	archivedCountsOnce sync.Once
+}
+
+// Reconcile archives the Event.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	event := &corev1.Event{}
+	if err := r.Client.Get(ctx, req.NamespacedName, event); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if r.isArchived(event) {
+		log.V(1).Info("Event is already archived, nothing to do", "count", count(event))
+		return reconcile.Result{}, nil
+	}
+
+	record := Record{
+		Time:      lastObservedTime(event).UTC(),
+		UID:       event.UID,
+		Type:      event.Type,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		Count:     count(event),
+		Source:    source(event),
+		Kind:      event.InvolvedObject.Kind,
+		Namespace: event.InvolvedObject.Namespace,
+		Name:      event.InvolvedObject.Name,
+	}
+
+	if event.InvolvedObject.Kind == "Project" {
+		record.Project = event.InvolvedObject.Name
+	} else if event.InvolvedObject.Namespace != "" {
+		project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, event.InvolvedObject.Namespace)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("failed determining project for namespace %q: %w", event.InvolvedObject.Namespace, err)
+		}
+		if project != nil {
+			record.Project = project.Name
+		}
+	}
+
+	log.V(1).Info("Archiving event", "kind", record.Kind, "reason", record.Reason, "count", record.Count)
+	if err := r.Sink.Archive(ctx, record); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed archiving event: %w", err)
+	}
+	r.getArchivedCounts().Add(event.UID, record.Count)
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) getArchivedCounts() *lru.Cache {
+	r.archivedCountsOnce.Do(func() {
+		r.archivedCounts = lru.New(archivedCountsCacheSize)
+	})
+	return r.archivedCounts
+}
+
+// isArchived returns true if the event was already archived with its current count since the controller started.
+func (r *Reconciler) isArchived(event *corev1.Event) bool {
+	archivedCount, ok := r.getArchivedCounts().Get(event.UID)
+	return ok && archivedCount.(int32) >= count(event)
+}
+
+// lastObservedTime returns the time of the last occurrence of the event. Events created via the events.k8s.io API
+// group only set the event time and the series, while events created via the core API group set the last timestamp.
+func lastObservedTime(event *corev1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+func count(event *corev1.Event) int32 {
+	if event.Series != nil {
+		return event.Series.Count
+	}
+	return max(event.Count, 1)
+}
+
+func source(event *corev1.Event) string {
+	if event.ReportingController != "" {
+		return event.ReportingController
+	}
+	return event.Source.Component
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventarchive_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/eventarchive"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		sink       *fakeSink
+		reconciler *Reconciler
+
+		lastTimestamp = metav1.NewTime(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC))
+		event         *corev1.Event
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+		sink = &fakeSink{}
+		reconciler = &Reconciler{Client: fakeClient, Sink: sink}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		})).To(Succeed())
+
+		event = &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar.1234", Namespace: "garden-foo", UID: "uid"},
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion: "core.gardener.cloud/v1beta1",
+				Kind:       "Shoot",
+				Namespace:  "garden-foo",
+				Name:       "bar",
+			},
+			Type:          corev1.EventTypeWarning,
+			Reason:        "ReconcileError",
+			Message:       "Flow failed",
+			Count:         3,
+			LastTimestamp: lastTimestamp,
+			Source:        corev1.EventSource{Component: "gardenlet"},
+		}
+	})
+
+	reconcileEvent := func() (reconcile.Result, error) {
+		Expect(fakeClient.Create(ctx, event)).To(Succeed())
+		return reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(event)})
+	}
+
+	It("should do nothing if the event is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(event)})).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(BeEmpty())
+	})
+
+	It("should archive the event together with the project of the resource", func() {
+		Expect(reconcileEvent()).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(ConsistOf(Record{
+			Time:      lastTimestamp.Time,
+			UID:       "uid",
+			Type:      corev1.EventTypeWarning,
+			Reason:    "ReconcileError",
+			Message:   "Flow failed",
+			Count:     3,
+			Source:    "gardenlet",
+			Kind:      "Shoot",
+			Namespace: "garden-foo",
+			Name:      "bar",
+			Project:   "foo",
+		}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(event), event)).To(Succeed())
+		Expect(event.Annotations).To(BeEmpty())
+	})
+
+	It("should not archive the event again if it was already archived with its current count", func() {
+		Expect(reconcileEvent()).To(Equal(reconcile.Result{}))
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(event)})).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(HaveLen(1))
+	})
+
+	It("should archive the event again if it occurred again since it was archived", func() {
+		Expect(reconcileEvent()).To(Equal(reconcile.Result{}))
+
+		event.Count = 4
+		Expect(fakeClient.Update(ctx, event)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(event)})).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(HaveLen(2))
+		Expect(sink.records[1].Count).To(Equal(int32(4)))
+	})
+
+	It("should archive the event again after a restart", func() {
+		Expect(reconcileEvent()).To(Equal(reconcile.Result{}))
+
+		reconciler = &Reconciler{Client: fakeClient, Sink: sink}
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(event)})).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(HaveLen(2))
+	})
+
+	It("should archive events of the events.k8s.io API group", func() {
+		lastObservedTime := metav1.NewMicroTime(lastTimestamp.Add(time.Minute))
+		event.Count = 0
+		event.LastTimestamp = metav1.Time{}
+		event.EventTime = metav1.NewMicroTime(lastTimestamp.Time)
+		event.Series = &corev1.EventSeries{Count: 5, LastObservedTime: lastObservedTime}
+		event.ReportingController = "gardener.cloud/gardenlet"
+
+		Expect(reconcileEvent()).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(HaveLen(1))
+		Expect(sink.records[0].Time).To(Equal(lastObservedTime.Time))
+		Expect(sink.records[0].Count).To(Equal(int32(5)))
+		Expect(sink.records[0].Source).To(Equal("gardener.cloud/gardenlet"))
+	})
+
+	It("should use the project name for events about projects", func() {
+		event.Namespace = "default"
+		event.InvolvedObject = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project", Name: "foo"}
+
+		Expect(reconcileEvent()).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(HaveLen(1))
+		Expect(sink.records[0].Project).To(Equal("foo"))
+	})
+
+	It("should not set a project for events about cluster-scoped resources", func() {
+		event.Namespace = "default"
+		event.InvolvedObject = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Seed", Name: "seed"}
+
+		Expect(reconcileEvent()).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(HaveLen(1))
+		Expect(sink.records[0].Project).To(BeEmpty())
+	})
+
+	It("should not set a project for resources in namespaces without project", func() {
+		event.InvolvedObject.Namespace = "garden"
+
+		Expect(reconcileEvent()).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(HaveLen(1))
+		Expect(sink.records[0].Project).To(BeEmpty())
+	})
+
+	It("should return an error if the event cannot be archived", func() {
+		sink.err = errors.New("fake")
+
+		_, err := reconcileEvent()
+		Expect(err).To(MatchError(ContainSubstring("fake")))
+
+		sink.err = nil
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(event)})).To(Equal(reconcile.Result{}))
+		Expect(sink.records).To(HaveLen(1))
+	})
+})
+
+type fakeSink struct {
+	records []Record
+	err     error
+}
+
+func (s *fakeSink) Archive(_ context.Context, record Record) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, record)
+	return nil
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventarchive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Record is an archived event together with the resource it is about.
+type Record struct {
+	// Time is the time of the last occurrence of the event.
+	Time time.Time
+	// UID is the UID of the event.
+	UID types.UID
+	// Type is the type of the event, i.e. 'Normal' or 'Warning'.
+	Type string
+	// Reason is the reason of the event.
+	Reason string
+	// Message is the message of the event.
+	Message string
+	// Count is the number of occurrences of the event.
+	Count int32
+	// Source is the component which reported the event.
+	Source string
+
+	// Kind is the kind of the resource the event is about.
+	Kind string
+	// Namespace is the namespace of the resource the event is about. It is empty for cluster-scoped resources.
+	Namespace string
+	// Name is the name of the resource the event is about.
+	Name string
+	// Project is the name of the project the resource belongs to. It is empty if the resource does not belong to a
+	// project.
+	Project string
+}
+
+// Sink archives events.
+type Sink interface {
+	// Archive archives the given record.
+	Archive(ctx context.Context, record Record) error
+}
+
+// NewLogSink returns a Sink which writes the records as structured entries to the given logger. The entries are
+// shipped to the long-term storage by the logging stack.
+func NewLogSink(log logr.Logger) Sink {
+	return &logSink{log: log}
+}
+
+type logSink struct {
+	log logr.Logger
+}
+
+func (s *logSink) Archive(_ context.Context, record Record) error {
+	s.log.Info(record.Message,
+		"eventTime", record.Time.UTC().Format(time.RFC3339),
+		"eventUID", record.UID,
+		"eventType", record.Type,
+		"eventReason", record.Reason,
+		"eventCount", record.Count,
+		"eventSource", record.Source,
+		"objectKind", record.Kind,
+		"objectNamespace", record.Namespace,
+		"objectName", record.Name,
+		"project", record.Project,
+	)
+	return nil
+}
+
+// NewOTLPSink returns a Sink which exports the records as log records to the given OTLP/HTTP endpoint.
+func NewOTLPSink(endpoint string, httpClient *http.Client) Sink {
+	return &otlpSink{
+		url:        strings.TrimSuffix(endpoint, "/") + "/v1/logs",
+		httpClient: httpClient,
+	}
+}
+
+type otlpSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (s *otlpSink) Archive(ctx context.Context, record Record) error {
+	body, err := proto.Marshal(exportLogsServiceRequest(record))
+	if err != nil {
+		return fmt.Errorf("failed marshalling OTLP export request: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/x-protobuf")
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed exporting event to %s: %w", s.url, err)
+	}
+	defer response.Body.Close()
+	// Drain the body to allow reusing the connection.
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("failed exporting event to %s: unexpected response status %q", s.url, response.Status)
+	}
+	return nil
+}
+
+// exportLogsServiceRequest converts the given record into an OTLP export request. The resource the event is about is
+// described by the resource attributes, so that the archive can be queried (and access to it can be restricted) per
+// project and resource.
+func exportLogsServiceRequest(record Record) *collogspb.ExportLogsServiceRequest {
+	severityNumber, severityText := logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "Info"
+	if record.Type == corev1.EventTypeWarning {
+		severityNumber, severityText = logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "Warning"
+	}
+
+	resourceAttributes := []*commonpb.KeyValue{
+		stringAttribute("gardener.resource.kind", record.Kind),
+		stringAttribute("gardener.resource.name", record.Name),
+	}
+	if record.Namespace != "" {
+		resourceAttributes = append(resourceAttributes, stringAttribute("k8s.namespace.name", record.Namespace))
+	}
+	if record.Project != "" {
+		resourceAttributes = append(resourceAttributes, stringAttribute("gardener.project.name", record.Project))
+	}
+
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: &resourcepb.Resource{Attributes: resourceAttributes},
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope: &commonpb.InstrumentationScope{Name: "gardener-controller-manager/" + ControllerName},
+				LogRecords: []*logspb.LogRecord{{
+					TimeUnixNano:   uint64(record.Time.UnixNano()), // #nosec G115 -- event timestamps are after 1970.
+					SeverityNumber: severityNumber,
+					SeverityText:   severityText,
+					Body:           &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: record.Message}},
+					Attributes: []*commonpb.KeyValue{
+						stringAttribute("k8s.event.uid", string(record.UID)),
+						stringAttribute("k8s.event.reason", record.Reason),
+						stringAttribute("k8s.event.type", record.Type),
+						stringAttribute("k8s.event.source", record.Source),
+						{Key: "k8s.event.count", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(record.Count)}}},
+					},
+				}},
+			}},
+		}},
+	}
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventarchive_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/eventarchive"
+)
+
+var _ = Describe("Sink", func() {
+	var (
+		ctx    = context.TODO()
+		record Record
+	)
+
+	BeforeEach(func() {
+		record = Record{
+			Time:      time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+			UID:       "uid",
+			Type:      corev1.EventTypeWarning,
+			Reason:    "ReconcileError",
+			Message:   "Flow failed",
+			Count:     3,
+			Source:    "gardenlet",
+			Kind:      "Shoot",
+			Namespace: "garden-foo",
+			Name:      "bar",
+			Project:   "foo",
+		}
+	})
+
+	Describe("#NewLogSink", func() {
+		It("should write the record as structured log entry", func() {
+			var output string
+			log := funcr.New(func(_, args string) { output = args }, funcr.Options{})
+
+			Expect(NewLogSink(log).Archive(ctx, record)).To(Succeed())
+			Expect(output).To(And(
+				ContainSubstring(`"msg"="Flow failed"`),
+				ContainSubstring(`"eventReason"="ReconcileError"`),
+				ContainSubstring(`"eventCount"=3`),
+				ContainSubstring(`"objectKind"="Shoot"`),
+				ContainSubstring(`"project"="foo"`),
+			))
+		})
+	})
+
+	Describe("#NewOTLPSink", func() {
+		var (
+			server   *httptest.Server
+			status   int
+			requests []*collogspb.ExportLogsServiceRequest
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			requests = nil
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.URL.Path).To(Equal("/v1/logs"))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/x-protobuf"))
+
+				body, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				request := &collogspb.ExportLogsServiceRequest{}
+				Expect(proto.Unmarshal(body, request)).To(Succeed())
+				requests = append(requests, request)
+
+				w.WriteHeader(status)
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		It("should export the record as log record", func() {
+			Expect(NewOTLPSink(server.URL+"/", server.Client()).Archive(ctx, record)).To(Succeed())
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].ResourceLogs).To(HaveLen(1))
+
+			resourceAttributes := map[string]string{}
+			for _, attribute := range requests[0].ResourceLogs[0].Resource.Attributes {
+				resourceAttributes[attribute.Key] = attribute.Value.GetStringValue()
+			}
+			Expect(resourceAttributes).To(Equal(map[string]string{
+				"gardener.resource.kind": "Shoot",
+				"gardener.resource.name": "bar",
+				"k8s.namespace.name":     "garden-foo",
+				"gardener.project.name":  "foo",
+			}))
+
+			logRecord := requests[0].ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+			Expect(logRecord.TimeUnixNano).To(Equal(uint64(record.Time.UnixNano())))
+			Expect(logRecord.SeverityNumber).To(Equal(logspb.SeverityNumber_SEVERITY_NUMBER_WARN))
+			Expect(logRecord.Body.GetStringValue()).To(Equal("Flow failed"))
+
+			attributes := map[string]any{}
+			for _, attribute := range logRecord.Attributes {
+				if attribute.Key == "k8s.event.count" {
+					attributes[attribute.Key] = attribute.Value.GetIntValue()
+				} else {
+					attributes[attribute.Key] = attribute.Value.GetStringValue()
+				}
+			}
+			Expect(attributes).To(Equal(map[string]any{
+				"k8s.event.uid":    "uid",
+				"k8s.event.reason": "ReconcileError",
+				"k8s.event.type":   "Warning",
+				"k8s.event.source": "gardenlet",
+				"k8s.event.count":  int64(3),
+			}))
+		})
+
+		It("should return an error if the endpoint rejects the request", func() {
+			status = http.StatusServiceUnavailable
+
+			Expect(NewOTLPSink(server.URL, server.Client()).Archive(ctx, record)).To(MatchError(ContainSubstring("503")))
+		})
+	})
+})
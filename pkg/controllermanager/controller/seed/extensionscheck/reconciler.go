@@ -61,10 +61,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	var (
-		notValid     = make(map[string]string)
-		notInstalled = make(map[string]string)
-		notHealthy   = make(map[string]string)
-		progressing  = make(map[string]string)
+		notValid       = make(map[string]string)
+		notInstalled   = make(map[string]string)
+		notHealthy     = make(map[string]string)
+		heartbeatStale = make(map[string]string)
+		progressing    = make(map[string]string)
 	)
 
 	for _, controllerInstallation := range controllerInstallationList.Items {
@@ -88,6 +89,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 				notValid[controllerInstallation.Name] = condition.Message
 			case condition.Type == gardencorev1beta1.ControllerInstallationInstalled && condition.Status != gardencorev1beta1.ConditionTrue:
 				notInstalled[controllerInstallation.Name] = condition.Message
+			case condition.Type == gardencorev1beta1.ControllerInstallationHealthy && condition.Status != gardencorev1beta1.ConditionTrue && condition.Reason == gardencorev1beta1.ControllerInstallationReasonHeartbeatStale:
+				heartbeatStale[controllerInstallation.Name] = condition.Message
 			case condition.Type == gardencorev1beta1.ControllerInstallationHealthy && condition.Status != gardencorev1beta1.ConditionTrue:
 				notHealthy[controllerInstallation.Name] = condition.Message
 			case condition.Type == gardencorev1beta1.ControllerInstallationProgressing && condition.Status != gardencorev1beta1.ConditionFalse:
@@ -97,7 +100,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 			conditionsReady++
 		}
 
-		if _, found := notHealthy[controllerInstallation.Name]; !found && conditionsReady != len(requiredConditions) {
+		_, found := notHealthy[controllerInstallation.Name]
+		_, stale := heartbeatStale[controllerInstallation.Name]
+		if !found && !stale && conditionsReady != len(requiredConditions) {
 			notHealthy[controllerInstallation.Name] = "not all required conditions found in ControllerInstallation"
 		}
 	}
@@ -112,6 +117,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, "NotAllExtensionsInstalled", fmt.Sprintf("Some extensions are not installed: %+v", notInstalled))
 	case len(notHealthy) != 0:
 		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, "NotAllExtensionsHealthy", fmt.Sprintf("Some extensions are not healthy: %+v", notHealthy))
+	case len(heartbeatStale) != 0:
+		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, "ExtensionHeartbeatsStale", fmt.Sprintf("Some extensions did not renew their heartbeat: %+v", heartbeatStale))
 	case len(progressing) != 0:
 		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, "SomeExtensionsProgressing", fmt.Sprintf("Some extensions are progressing: %+v", progressing))
 	default:
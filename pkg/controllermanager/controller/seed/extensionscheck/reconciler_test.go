@@ -183,6 +183,8 @@ var _ = Describe("Reconciler", func() {
 				for i, condition := range c2.Status.Conditions {
 					if condition.Type == failedCondition.Type {
 						c2.Status.Conditions[i].Status = failedCondition.Status
+						c2.Status.Conditions[i].Reason = failedCondition.Reason
+						c2.Status.Conditions[i].Message = failedCondition.Message
 					}
 				}
 
@@ -268,6 +270,14 @@ var _ = Describe("Reconciler", func() {
 			)
 		})
 
+		Context("one ControllerInstallation has a stale heartbeat", func() {
+			tests(
+				gardencorev1beta1.Condition{Type: gardencorev1beta1.ControllerInstallationHealthy, Status: gardencorev1beta1.ConditionFalse, Reason: gardencorev1beta1.ControllerInstallationReasonHeartbeatStale, Message: "stale"},
+				"ExtensionHeartbeatsStale",
+				`Some extensions did not renew their heartbeat: map[foo-2:stale]`,
+			)
+		})
+
 		Context("one ControllerInstallation is still progressing", func() {
 			tests(
 				gardencorev1beta1.Condition{Type: gardencorev1beta1.ControllerInstallationProgressing, Status: gardencorev1beta1.ConditionTrue},
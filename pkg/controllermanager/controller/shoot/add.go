@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/apiserverprobe"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
@@ -22,6 +23,14 @@ import (
 
 // AddToManager adds all Shoot controllers to the given manager.
 func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.ControllerManagerConfiguration) error {
+	if cfg.Controllers.ShootAPIServerProbe != nil {
+		if err := (&apiserverprobe.Reconciler{
+			Config: *cfg.Controllers.ShootAPIServerProbe,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding apiserverprobe reconciler: %w", err)
+		}
+	}
+
 	if err := (&conditions.Reconciler{
 		Config: *cfg.Controllers.ShootConditions,
 	}).AddToManager(mgr); err != nil {
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserverprobe
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener_controller_manager"
+	metricsSubsystem = "shoot_apiserver_probe"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// ProbeSuccess defines the gauge shoot_apiserver_probe_success.
+	ProbeSuccess = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "success",
+			Help:      "Whether the last probe of the shoot's API server via its external endpoint succeeded (1) or not (0).",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+
+	// ProbeDuration defines the gauge shoot_apiserver_probe_duration_seconds.
+	ProbeDuration = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "duration_seconds",
+			Help:      "Duration of the last probe of the shoot's API server via its external endpoint in seconds.",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+)
+
+func deleteMetrics(namespace, name string) {
+	ProbeSuccess.DeleteLabelValues(namespace, name)
+	ProbeDuration.DeleteLabelValues(namespace, name)
+}
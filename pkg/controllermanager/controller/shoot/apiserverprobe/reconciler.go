@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserverprobe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// ReasonAPIServerReachable is the reason of the condition if the API server is reachable via its external endpoint.
+	ReasonAPIServerReachable = "APIServerReachable"
+	// ReasonAPIServerUnreachable is the reason of the condition if the API server is not reachable via its external
+	// endpoint.
+	ReasonAPIServerUnreachable = "APIServerUnreachable"
+
+	// credentialsExpirationSeconds is the validity of the viewer kubeconfigs requested for probing.
+	credentialsExpirationSeconds int64 = 3600
+	// credentialsRenewalWindow is the duration before the expiration of a viewer kubeconfig in which it is renewed.
+	credentialsRenewalWindow = 10 * time.Minute
+)
+
+// Reconciler probes the API servers of Shoots via their external endpoints and maintains the
+// 'APIServerExternallyReachable' condition in the Shoot status.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ShootAPIServerProbeControllerConfiguration
+	Clock  clock.Clock
+	// Probe sends an authenticated request to the API server described by the given REST config.
+	Probe func(ctx context.Context, restConfig *rest.Config) error
+
+	lock   sync.Mutex
+	states map[types.NamespacedName]*probeState
+}
+
+// probeState is the state kept in memory for each probed Shoot.
+type probeState struct {
+	url                 string
+	kubeconfig          []byte
+	expirationTimestamp time.Time
+	consecutiveFailures int32
+}
+
+// Reconcile probes the API server of the Shoot via its external endpoint and updates the
+// 'APIServerExternallyReachable' condition in the Shoot status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			r.forget(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	requeueAfter := reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}
+
+	url := externalURL(shoot)
+	if url == "" || !shouldProbe(shoot) {
+		log.V(1).Info("Shoot's API server is not probed")
+		r.forget(request.NamespacedName)
+		return requeueAfter, r.removeCondition(ctx, shoot)
+	}
+
+	state := r.getState(request.NamespacedName, url)
+
+	restConfig, err := r.restConfig(ctx, shoot, state)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing REST config for probing the API server: %w", err)
+	}
+
+	probeCtx, probeCancel := context.WithTimeout(ctx, r.Config.Timeout.Duration)
+	defer probeCancel()
+
+	start := r.Clock.Now()
+	probeErr := r.Probe(probeCtx, restConfig)
+	ProbeDuration.WithLabelValues(shoot.Namespace, shoot.Name).Set(r.Clock.Since(start).Seconds())
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerExternallyReachable)
+
+	if probeErr == nil {
+		ProbeSuccess.WithLabelValues(shoot.Namespace, shoot.Name).Set(1)
+		state.consecutiveFailures = 0
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, ReasonAPIServerReachable,
+			fmt.Sprintf("API server is reachable via its external endpoint %s.", url))
+	} else {
+		ProbeSuccess.WithLabelValues(shoot.Namespace, shoot.Name).Set(0)
+		state.consecutiveFailures++
+		log.Info("Probing API server via its external endpoint failed", "url", url, "consecutiveFailures", state.consecutiveFailures, "error", probeErr.Error())
+
+		if apierrors.IsUnauthorized(probeErr) {
+			// The credentials might have been invalidated, e.g. by a CA rotation, hence request new ones for the next probe.
+			state.kubeconfig = nil
+		}
+
+		if state.consecutiveFailures >= ptr.Deref(r.Config.FailureThreshold, 1) {
+			condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, ReasonAPIServerUnreachable,
+				fmt.Sprintf("API server is not reachable via its external endpoint %s, the last %d probes failed: %v", url, state.consecutiveFailures, probeErr))
+		}
+	}
+
+	conditions := v1beta1helper.MergeConditions(shoot.Status.Conditions, condition)
+	if v1beta1helper.ConditionsNeedUpdate(shoot.Status.Conditions, conditions) {
+		log.V(1).Info("Updating condition", "type", condition.Type, "status", condition.Status)
+
+		patch := client.StrategicMergeFrom(shoot.DeepCopy())
+		shoot.Status.Conditions = conditions
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return requeueAfter, nil
+}
+
+// ProbeAPIServer sends an authenticated discovery request to the API server described by the given REST config.
+func ProbeAPIServer(ctx context.Context, restConfig *rest.Config) error {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	return discoveryClient.RESTClient().Get().AbsPath("/api").Do(ctx).Error()
+}
+
+func (r *Reconciler) restConfig(ctx context.Context, shoot *gardencorev1beta1.Shoot, state *probeState) (*rest.Config, error) {
+	if state.kubeconfig == nil || !r.Clock.Now().Add(credentialsRenewalWindow).Before(state.expirationTimestamp) {
+		viewerKubeconfigRequest := &authenticationv1alpha1.ViewerKubeconfigRequest{
+			Spec: authenticationv1alpha1.ViewerKubeconfigRequestSpec{
+				ExpirationSeconds: ptr.To(credentialsExpirationSeconds),
+			},
+		}
+		if err := r.Client.SubResource("viewerkubeconfig").Create(ctx, shoot, viewerKubeconfigRequest); err != nil {
+			return nil, fmt.Errorf("failed requesting viewer kubeconfig: %w", err)
+		}
+
+		state.kubeconfig = viewerKubeconfigRequest.Status.Kubeconfig
+		state.expirationTimestamp = viewerKubeconfigRequest.Status.ExpirationTimestamp.Time
+	}
+
+	kubeconfig, err := clientcmd.Load(state.kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading viewer kubeconfig: %w", err)
+	}
+
+	// The viewer kubeconfig contains one context per advertised address, named after the shoot and the address.
+	restConfig, err := clientcmd.NewDefaultClientConfig(*kubeconfig, &clientcmd.ConfigOverrides{
+		CurrentContext: fmt.Sprintf("%s--%s-%s", shoot.Namespace, shoot.Name, v1beta1constants.AdvertisedAddressExternal),
+	}).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed creating REST config from viewer kubeconfig: %w", err)
+	}
+
+	restConfig.Host = state.url
+	restConfig.Timeout = r.Config.Timeout.Duration
+	return restConfig, nil
+}
+
+func (r *Reconciler) removeCondition(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	if v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerExternallyReachable) == nil {
+		return nil
+	}
+
+	patch := client.StrategicMergeFrom(shoot.DeepCopy())
+	shoot.Status.Conditions = v1beta1helper.RemoveConditions(shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerExternallyReachable)
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+func (r *Reconciler) getState(key types.NamespacedName, url string) *probeState {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.states == nil {
+		r.states = make(map[types.NamespacedName]*probeState)
+	}
+
+	// Start from scratch if the external endpoint of the shoot has changed.
+	if state, ok := r.states[key]; ok && state.url == url {
+		return state
+	}
+
+	r.states[key] = &probeState{url: url}
+	return r.states[key]
+}
+
+func (r *Reconciler) forget(key types.NamespacedName) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.states, key)
+	deleteMetrics(key.Namespace, key.Name)
+}
+
+// shouldProbe returns true if the API server of the given shoot is expected to be running, i.e., the shoot is scheduled,
+// was created successfully, and is neither hibernated nor being deleted.
+func shouldProbe(shoot *gardencorev1beta1.Shoot) bool {
+	if shoot.DeletionTimestamp != nil || shoot.Spec.SeedName == nil {
+		return false
+	}
+
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		return false
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil &&
+		(lastOperation.Type != gardencorev1beta1.LastOperationTypeCreate || lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded)
+}
+
+func externalURL(shoot *gardencorev1beta1.Shoot) string {
+	for _, address := range shoot.Status.AdvertisedAddresses {
+		if address.Name == v1beta1constants.AdvertisedAddressExternal {
+			return address.URL
+		}
+	}
+	return ""
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserverprobe_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/apiserverprobe"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace   = "garden-foo"
+		name        = "bar"
+		externalURL = "https://api.bar.foo.example.com"
+	)
+
+	var (
+		ctx        = context.TODO()
+		fakeClock  *testclock.FakeClock
+		fakeClient client.Client
+
+		reconciler *Reconciler
+		request    reconcile.Request
+		shoot      *gardencorev1beta1.Shoot
+
+		kubeconfigRequests int
+		probedHosts        []string
+		probeErr           error
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		kubeconfigRequests = 0
+		probedHosts = nil
+		probeErr = nil
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceCreate: func(_ context.Context, _ client.Client, subResourceName string, _ client.Object, subResource client.Object, _ ...client.SubResourceCreateOption) error {
+					viewerKubeconfigRequest, ok := subResource.(*authenticationv1alpha1.ViewerKubeconfigRequest)
+					if !ok || subResourceName != "viewerkubeconfig" {
+						return apierrors.NewBadRequest(fmt.Sprintf("unexpected subresource %q of type %T", subResourceName, subResource))
+					}
+
+					kubeconfigRequests++
+					viewerKubeconfigRequest.Status.Kubeconfig = viewerKubeconfig(namespace, name, externalURL)
+					viewerKubeconfigRequest.Status.ExpirationTimestamp = metav1.Time{Time: fakeClock.Now().Add(time.Duration(*viewerKubeconfigRequest.Spec.ExpirationSeconds) * time.Second)}
+					return nil
+				},
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootAPIServerProbeControllerConfiguration{
+				SyncPeriod:       &metav1.Duration{Duration: time.Minute},
+				Timeout:          &metav1.Duration{Duration: 10 * time.Second},
+				FailureThreshold: ptr.To[int32](2),
+			},
+			Clock: fakeClock,
+			Probe: func(_ context.Context, restConfig *rest.Config) error {
+				probedHosts = append(probedHosts, restConfig.Host)
+				return probeErr
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+				AdvertisedAddresses: []gardencorev1beta1.ShootAdvertisedAddress{
+					{Name: "internal", URL: "https://api.internal.bar.foo.example.com"},
+					{Name: "external", URL: externalURL},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	reconcileAndGetCondition := func() *gardencorev1beta1.Condition {
+		GinkgoHelper()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		return v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerExternallyReachable)
+	}
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(probedHosts).To(BeEmpty())
+	})
+
+	It("should probe the external endpoint and set the condition to true", func() {
+		condition := reconcileAndGetCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonAPIServerReachable))
+
+		Expect(probedHosts).To(ConsistOf(externalURL))
+		Expect(testutil.ToFloat64(ProbeSuccess.WithLabelValues(namespace, name))).To(Equal(float64(1)))
+	})
+
+	It("should reuse the viewer kubeconfig until it is about to expire", func() {
+		reconcileAndGetCondition()
+		fakeClock.Step(30 * time.Minute)
+		reconcileAndGetCondition()
+		Expect(kubeconfigRequests).To(Equal(1))
+
+		fakeClock.Step(25 * time.Minute)
+		reconcileAndGetCondition()
+		Expect(kubeconfigRequests).To(Equal(2))
+		Expect(probedHosts).To(HaveLen(3))
+	})
+
+	It("should set the condition to false only after the failure threshold is reached", func() {
+		Expect(reconcileAndGetCondition().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		probeErr = errors.New("connection refused")
+		condition := reconcileAndGetCondition()
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(testutil.ToFloat64(ProbeSuccess.WithLabelValues(namespace, name))).To(Equal(float64(0)))
+
+		condition = reconcileAndGetCondition()
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonAPIServerUnreachable))
+		Expect(condition.Message).To(ContainSubstring("connection refused"))
+
+		probeErr = nil
+		Expect(reconcileAndGetCondition().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should request a new viewer kubeconfig if the probe was unauthorized", func() {
+		probeErr = apierrors.NewUnauthorized("certificate signed by unknown authority")
+		reconcileAndGetCondition()
+		reconcileAndGetCondition()
+		Expect(kubeconfigRequests).To(Equal(2))
+	})
+
+	Context("when the shoot is not probed", func() {
+		BeforeEach(func() {
+			shoot.Status.Conditions = []gardencorev1beta1.Condition{
+				{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionTrue},
+				{Type: gardencorev1beta1.ShootAPIServerExternallyReachable, Status: gardencorev1beta1.ConditionTrue},
+			}
+		})
+
+		AfterEach(func() {
+			Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconcileAndGetCondition()).To(BeNil())
+			Expect(v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerAvailable)).NotTo(BeNil())
+			Expect(probedHosts).To(BeEmpty())
+		})
+
+		It("should remove the condition because the shoot is hibernated", func() {
+			shoot.Status.IsHibernated = true
+		})
+
+		It("should remove the condition because the shoot is not created yet", func() {
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeCreate,
+				State: gardencorev1beta1.LastOperationStateProcessing,
+			}
+		})
+
+		It("should remove the condition because the shoot has no external address", func() {
+			shoot.Status.AdvertisedAddresses = shoot.Status.AdvertisedAddresses[:1]
+		})
+	})
+})
+
+func viewerKubeconfig(namespace, name, url string) []byte {
+	contextName := fmt.Sprintf("%s--%s-external", namespace, name)
+
+	kubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+		CurrentContext: contextName,
+		Clusters:       map[string]*clientcmdapi.Cluster{contextName: {Server: url}},
+		AuthInfos:      map[string]*clientcmdapi.AuthInfo{contextName: {Token: "token"}},
+		Contexts:       map[string]*clientcmdapi.Context{contextName: {Cluster: contextName, AuthInfo: contextName}},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return kubeconfig
+}
@@ -53,7 +53,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// First remove all existing seed conditions and then add the current seed conditions if the shoot is still registered as seed.
 	// The list of shoot conditions is well known (see contract https://github.com/gardener/gardener/blob/master/docs/extensions/shoot-health-status-conditions.md)
 	// as opposed to seed conditions. Thus, subtract all shoot conditions to filter out the seed conditions.
-	shootConditions := gardenerutils.GetShootConditionTypes(false)
+	// The condition maintained by the shoot-apiserver-probe controller is not a seed condition either.
+	shootConditions := append(gardenerutils.GetShootConditionTypes(false), gardencorev1beta1.ShootAPIServerExternallyReachable)
 
 	conditions := v1beta1helper.RetainConditions(shoot.Status.Conditions, shootConditions...)
 	if seed != nil {
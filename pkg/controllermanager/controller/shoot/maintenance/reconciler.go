@@ -129,6 +129,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	}
 
 	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		if ptr.Deref(r.Config.RespectUpgradeReadiness, false) {
+			if err := checkUpgradeReadiness(shoot, v); err != nil {
+				return "", err
+			}
+		}
+
 		maintainedShoot.Spec.Kubernetes.Version = v
 		return v, nil
 	})
@@ -654,6 +660,30 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 	}, nil
 }
 
+// checkUpgradeReadiness returns an error if the given version is a new minor version for the shoot while its
+// UpgradeReadiness constraint reports that APIs removed in this version are still in use.
+func checkUpgradeReadiness(shoot *gardencorev1beta1.Shoot, kubernetesVersion string) error {
+	currentVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return err
+	}
+	newVersion, err := semver.NewVersion(kubernetesVersion)
+	if err != nil {
+		return err
+	}
+
+	if currentVersion.Major() == newVersion.Major() && currentVersion.Minor() == newVersion.Minor() {
+		return nil
+	}
+
+	constraint := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootUpgradeReadiness)
+	if constraint == nil || (constraint.Status != gardencorev1beta1.ConditionFalse && constraint.Status != gardencorev1beta1.ConditionProgressing) {
+		return nil
+	}
+
+	return fmt.Errorf("update to version %q refused because the shoot is not ready for the upgrade: %s", kubernetesVersion, constraint.Message)
+}
+
 func determineKubernetesVersion(kubernetesVersion string, profile *gardencorev1beta1.CloudProfile, isExpired bool) (string, error) {
 	getHigherVersionAutoUpdate := v1beta1helper.GetLatestVersionForPatchAutoUpdate
 	getHigherVersionForceUpdate := v1beta1helper.GetVersionForForcefulUpdateToConsecutiveMinor
@@ -1361,6 +1361,51 @@ var _ = Describe("Shoot Maintenance", func() {
 		})
 	})
 
+	Describe("#checkUpgradeReadiness", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.2"},
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					Constraints: []gardencorev1beta1.Condition{{
+						Type:    gardencorev1beta1.ShootUpgradeReadiness,
+						Status:  gardencorev1beta1.ConditionFalse,
+						Message: "Some APIs which are removed in Kubernetes 1.32 are still in use",
+					}},
+				},
+			}
+		})
+
+		It("should refuse an update to the next minor version when the constraint is false", func() {
+			Expect(checkUpgradeReadiness(shoot, "1.32.0")).To(MatchError(`update to version "1.32.0" refused because the shoot is not ready for the upgrade: Some APIs which are removed in Kubernetes 1.32 are still in use`))
+		})
+
+		It("should refuse an update to the next minor version when the constraint is progressing", func() {
+			shoot.Status.Constraints[0].Status = gardencorev1beta1.ConditionProgressing
+
+			Expect(checkUpgradeReadiness(shoot, "1.32.0")).To(HaveOccurred())
+		})
+
+		It("should allow an update to the next patch version", func() {
+			Expect(checkUpgradeReadiness(shoot, "1.31.3")).To(Succeed())
+		})
+
+		It("should allow an update to the next minor version when the constraint is unknown", func() {
+			shoot.Status.Constraints[0].Status = gardencorev1beta1.ConditionUnknown
+
+			Expect(checkUpgradeReadiness(shoot, "1.32.0")).To(Succeed())
+		})
+
+		It("should allow an update to the next minor version when the constraint is not present", func() {
+			shoot.Status.Constraints = nil
+
+			Expect(checkUpgradeReadiness(shoot, "1.32.0")).To(Succeed())
+		})
+	})
+
 	Describe("#maintainFeatureGatesForShoot", func() {
 		var (
 			shoot                   *gardencorev1beta1.Shoot
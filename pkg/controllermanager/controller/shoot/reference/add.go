@@ -46,6 +46,7 @@ func Predicate(oldObj, newObj client.Object) bool {
 		!apiequality.Semantic.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig, newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig) ||
 		!apiequality.Semantic.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication, newShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication) ||
 		!apiequality.Semantic.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization, newShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization) ||
+		v1beta1helper.GetShootAlertingRulesConfigMapName(oldShoot.Spec.Monitoring) != v1beta1helper.GetShootAlertingRulesConfigMapName(newShoot.Spec.Monitoring) ||
 		!v1beta1helper.ResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources)
 }
 
@@ -79,6 +80,9 @@ func getReferencedConfigMapNames(obj client.Object) []string {
 	if configMapName := v1beta1helper.GetShootAuthorizationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); configMapName != "" {
 		out = append(out, configMapName)
 	}
+	if configMapName := v1beta1helper.GetShootAlertingRulesConfigMapName(shoot.Spec.Monitoring); configMapName != "" {
+		out = append(out, configMapName)
+	}
 	out = append(out, namesForReferencedResources(shoot, "ConfigMap")...)
 	return out
 }
@@ -81,6 +81,16 @@ var _ = Describe("Add", func() {
 			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
 		})
 
+		It("should return true because the alerting rules field changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Monitoring = &gardencorev1beta1.Monitoring{
+				Alerting: &gardencorev1beta1.Alerting{
+					Rules: &gardencorev1beta1.AlertingRules{ConfigMapName: "alerting-rules"},
+				},
+			}
+			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
+		})
+
 		It("should return true because the resources field changed", func() {
 			oldShoot := shoot.DeepCopy()
 			shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{{
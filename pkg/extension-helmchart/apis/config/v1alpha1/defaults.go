@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_Chart sets defaults for the Chart object.
+func SetDefaults_Chart(obj *Chart) {
+	if obj.Target == nil {
+		obj.Target = ptr.To(TargetShoot)
+	}
+	if obj.ReleaseName == nil {
+		obj.ReleaseName = ptr.To(obj.Type)
+	}
+
+	switch *obj.Target {
+	case TargetShoot:
+		if obj.Namespace == nil {
+			obj.Namespace = ptr.To(metav1.NamespaceSystem)
+		}
+		if obj.HealthConditionType == nil {
+			obj.HealthConditionType = ptr.To(string(gardencorev1beta1.ShootSystemComponentsHealthy))
+		}
+	case TargetSeed:
+		if obj.HealthConditionType == nil {
+			obj.HealthConditionType = ptr.To(string(gardencorev1beta1.ShootControlPlaneHealthy))
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("Chart", func() {
+		It("should default the fields for charts with target Shoot", func() {
+			obj := &ControllerConfiguration{Charts: []Chart{{Type: "foo"}}}
+			SetObjectDefaults_ControllerConfiguration(obj)
+
+			Expect(obj.Charts[0]).To(Equal(Chart{
+				Type:                "foo",
+				Target:              ptr.To(TargetShoot),
+				ReleaseName:         ptr.To("foo"),
+				Namespace:           ptr.To("kube-system"),
+				HealthConditionType: ptr.To("SystemComponentsHealthy"),
+			}))
+		})
+
+		It("should default the fields for charts with target Seed", func() {
+			obj := &ControllerConfiguration{Charts: []Chart{{Type: "foo", Target: ptr.To(TargetSeed)}}}
+			SetObjectDefaults_ControllerConfiguration(obj)
+
+			Expect(obj.Charts[0]).To(Equal(Chart{
+				Type:                "foo",
+				Target:              ptr.To(TargetSeed),
+				ReleaseName:         ptr.To("foo"),
+				HealthConditionType: ptr.To("ControlPlaneHealthy"),
+			}))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj := &ControllerConfiguration{Charts: []Chart{{
+				Type:                "foo",
+				Target:              ptr.To(TargetShoot),
+				ReleaseName:         ptr.To("bar"),
+				Namespace:           ptr.To("foo-system"),
+				HealthConditionType: ptr.To("FooHealthy"),
+			}}}
+			expected := obj.DeepCopy()
+			SetObjectDefaults_ControllerConfiguration(obj)
+
+			Expect(obj).To(Equal(expected))
+		})
+	})
+})
@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +k8s:defaulter-gen=TypeMeta
+
+// Package v1alpha1 contains the configuration of the generic Helm chart extension.
+package v1alpha1
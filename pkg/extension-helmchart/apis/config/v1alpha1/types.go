@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControllerConfiguration defines the configuration for the generic Helm chart extension.
+type ControllerConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Charts is the list of Helm charts which are deployed for Extension resources. Each chart is responsible for
+	// exactly one extension type.
+	Charts []Chart `json:"charts"`
+}
+
+// Chart maps an extension type to the Helm chart which is deployed for Extension resources of this type.
+type Chart struct {
+	// Type is the type of the Extension resources for which the chart is deployed.
+	Type string `json:"type"`
+	// OCIRepository defines where to pull the chart from.
+	OCIRepository gardencorev1.OCIRepository `json:"ociRepository"`
+	// Target is the cluster to which the rendered chart is applied. Defaults to `Shoot`.
+	// +optional
+	Target *Target `json:"target,omitempty"`
+	// ReleaseName is the name of the Helm release the chart is rendered with. Defaults to the extension type.
+	// +optional
+	ReleaseName *string `json:"releaseName,omitempty"`
+	// Namespace is the namespace the chart is rendered for. Defaults to `kube-system` for the `Shoot` target. For the
+	// `Seed` target, the chart is always rendered for the namespace of the Extension resource.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// Values are default values for the chart. They are overwritten by the provider config of the Extension resource.
+	// +optional
+	Values *apiextensionsv1.JSON `json:"values,omitempty"`
+	// AllowedProviderConfigKeys are the top-level keys of the values which may be set by the provider config of the
+	// Extension resource for charts with target `Seed`. The provider config is controlled by the shoot owner, hence,
+	// other keys are rejected for this target since they could be used to change the resources in the seed cluster
+	// arbitrarily. It must not be set for the `Shoot` target, where all keys are allowed.
+	// +optional
+	AllowedProviderConfigKeys []string `json:"allowedProviderConfigKeys,omitempty"`
+	// HealthConditionType is the type of the condition which reports the health of the resources of the chart.
+	// Defaults to `SystemComponentsHealthy` for the `Shoot` target and to `ControlPlaneHealthy` for the `Seed` target.
+	// +optional
+	HealthConditionType *string `json:"healthConditionType,omitempty"`
+}
+
+// Target is the cluster to which a rendered chart is applied.
+type Target string
+
+const (
+	// TargetShoot applies the rendered chart to the shoot cluster.
+	TargetShoot Target = "Shoot"
+	// TargetSeed applies the rendered chart to the control plane namespace in the seed cluster.
+	TargetSeed Target = "Seed"
+)
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	corevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
+	helmchartconfigv1alpha1 "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1"
+)
+
+var availableTargets = sets.New(helmchartconfigv1alpha1.TargetShoot, helmchartconfigv1alpha1.TargetSeed)
+
+// ValidateControllerConfiguration validates the given `ControllerConfiguration`.
+func ValidateControllerConfiguration(conf *helmchartconfigv1alpha1.ControllerConfiguration) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("charts")
+		types   = sets.New[string]()
+	)
+
+	if len(conf.Charts) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one chart must be configured"))
+	}
+
+	for i, chart := range conf.Charts {
+		idxPath := fldPath.Index(i)
+
+		if chart.Type == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "type must be provided"))
+		} else if types.Has(chart.Type) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("type"), chart.Type))
+		}
+		types.Insert(chart.Type)
+
+		allErrs = append(allErrs, validateOCIRepository(chart.OCIRepository, idxPath.Child("ociRepository"))...)
+
+		if chart.Target != nil && !availableTargets.Has(*chart.Target) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("target"), *chart.Target, sets.List(availableTargets)))
+		}
+
+		if chart.ReleaseName != nil {
+			for _, msg := range apivalidation.NameIsDNSLabel(*chart.ReleaseName, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("releaseName"), *chart.ReleaseName, msg))
+			}
+		}
+
+		if chart.Namespace != nil {
+			if ptr.Deref(chart.Target, helmchartconfigv1alpha1.TargetShoot) == helmchartconfigv1alpha1.TargetSeed {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("namespace"), "namespace must not be set for charts with target Seed"))
+			} else {
+				for _, msg := range apivalidation.ValidateNamespaceName(*chart.Namespace, false) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("namespace"), *chart.Namespace, msg))
+				}
+			}
+		}
+
+		if chart.Values != nil {
+			var values map[string]any
+			if err := json.Unmarshal(chart.Values.Raw, &values); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("values"), string(chart.Values.Raw), "values must be a JSON object"))
+			}
+		}
+
+		if len(chart.AllowedProviderConfigKeys) > 0 {
+			if ptr.Deref(chart.Target, helmchartconfigv1alpha1.TargetShoot) != helmchartconfigv1alpha1.TargetSeed {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("allowedProviderConfigKeys"), "allowedProviderConfigKeys must only be set for charts with target Seed"))
+			}
+			for j, key := range chart.AllowedProviderConfigKeys {
+				if key == "" || key == "gardener" {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("allowedProviderConfigKeys").Index(j), key, "key must not be empty or the reserved key gardener"))
+				}
+			}
+		}
+
+		if chart.HealthConditionType != nil && *chart.HealthConditionType == "" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("healthConditionType"), "", "healthConditionType must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateOCIRepository(oci gardencorev1.OCIRepository, fldPath *field.Path) field.ErrorList {
+	internal := &core.OCIRepository{}
+	if err := gardencorev1.Convert_v1_OCIRepository_To_core_OCIRepository(&oci, internal, nil); err != nil {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+
+	return corevalidation.ValidateOCIRepository(internal, fldPath)
+}
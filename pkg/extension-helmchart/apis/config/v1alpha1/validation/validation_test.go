@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	. "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1/validation"
+)
+
+var _ = Describe("#ValidateControllerConfiguration", func() {
+	var config *ControllerConfiguration
+
+	BeforeEach(func() {
+		config = &ControllerConfiguration{
+			Charts: []Chart{
+				{
+					Type:          "foo",
+					OCIRepository: gardencorev1.OCIRepository{Ref: ptr.To("example.com/charts/foo:1.0.0")},
+					Values:        &apiextensionsv1.JSON{Raw: []byte(`{"replicas":2}`)},
+				},
+				{
+					Type:          "bar",
+					OCIRepository: gardencorev1.OCIRepository{Repository: ptr.To("example.com/charts/bar"), Tag: ptr.To("1.0.0")},
+					Target:        ptr.To(TargetSeed),
+				},
+			},
+		}
+	})
+
+	It("should pass for a valid configuration", func() {
+		Expect(ValidateControllerConfiguration(config)).To(BeEmpty())
+	})
+
+	It("should pass for a defaulted configuration", func() {
+		SetObjectDefaults_ControllerConfiguration(config)
+		Expect(ValidateControllerConfiguration(config)).To(BeEmpty())
+	})
+
+	It("should forbid an empty list of charts", func() {
+		config.Charts = nil
+
+		Expect(ValidateControllerConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("charts"),
+			})),
+		))
+	})
+
+	It("should forbid missing and duplicate types", func() {
+		config.Charts = append(config.Charts, Chart{Type: "foo", OCIRepository: config.Charts[0].OCIRepository}, Chart{OCIRepository: config.Charts[0].OCIRepository})
+
+		Expect(ValidateControllerConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeDuplicate),
+				"Field":    Equal("charts[2].type"),
+				"BadValue": Equal("foo"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("charts[3].type"),
+			})),
+		))
+	})
+
+	It("should forbid invalid OCI repositories", func() {
+		config.Charts[0].OCIRepository = gardencorev1.OCIRepository{}
+		config.Charts[1].OCIRepository.Tag = nil
+
+		Expect(ValidateControllerConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("charts[0].ociRepository"),
+				"Detail": Equal("must provide either ref or repository"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("charts[1].ociRepository"),
+				"Detail": Equal("must provide either tag or digest"),
+			})),
+		))
+	})
+
+	It("should forbid unsupported targets", func() {
+		config.Charts[0].Target = ptr.To(Target("Garden"))
+
+		Expect(ValidateControllerConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("charts[0].target"),
+			})),
+		))
+	})
+
+	It("should forbid invalid release names and namespaces", func() {
+		config.Charts[0].ReleaseName = ptr.To("Foo_Bar")
+		config.Charts[0].Namespace = ptr.To("kube_system")
+		config.Charts[1].Namespace = ptr.To("kube-system")
+
+		Expect(ValidateControllerConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("charts[0].releaseName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("charts[0].namespace"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("charts[1].namespace"),
+			})),
+		))
+	})
+
+	It("should forbid allowed provider config keys for the shoot and invalid keys", func() {
+		config.Charts[0].AllowedProviderConfigKeys = []string{"replicas"}
+		config.Charts[1].AllowedProviderConfigKeys = []string{"replicas", "", "gardener"}
+
+		Expect(ValidateControllerConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("charts[0].allowedProviderConfigKeys"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("charts[1].allowedProviderConfigKeys[1]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("charts[1].allowedProviderConfigKeys[2]"),
+			})),
+		))
+	})
+
+	It("should forbid values which are not a JSON object and empty health condition types", func() {
+		config.Charts[0].Values = &apiextensionsv1.JSON{Raw: []byte(`["foo"]`)}
+		config.Charts[1].HealthConditionType = ptr.To("")
+
+		Expect(ValidateControllerConfiguration(config)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("charts[0].values"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("charts[1].healthConditionType"),
+			})),
+		))
+	})
+})
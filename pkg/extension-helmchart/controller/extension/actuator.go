@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/extension"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	helmchartconfigv1alpha1 "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+// ManagedResourceNamePrefix is the prefix of the names of the ManagedResources containing the rendered charts.
+const ManagedResourceNamePrefix = "extension-helmchart-"
+
+// ManagedResourceName returns the name of the ManagedResource containing the rendered chart for the given extension
+// type.
+func ManagedResourceName(extensionType string) string {
+	return ManagedResourceNamePrefix + extensionType
+}
+
+type actuator struct {
+	client            client.Client
+	registry          oci.Interface
+	seedChartRenderer chartrenderer.Interface
+	chart             helmchartconfigv1alpha1.Chart
+	// pullSecretNamespace is the namespace the pull secret of the chart's OCI repository is read from.
+	pullSecretNamespace string
+}
+
+// NewActuator returns an actuator responsible for Extension resources whose type is handled by the given chart. The
+// seed chart renderer is used for rendering charts with target `Seed`, charts with target `Shoot` are rendered for the
+// Kubernetes version of the shoot cluster.
+func NewActuator(c client.Client, registry oci.Interface, seedChartRenderer chartrenderer.Interface, chart helmchartconfigv1alpha1.Chart, pullSecretNamespace string) extension.Actuator {
+	return &actuator{
+		client:              c,
+		registry:            registry,
+		seedChartRenderer:   seedChartRenderer,
+		chart:               chart,
+		pullSecretNamespace: pullSecretNamespace,
+	}
+}
+
+// Reconcile the extension resource.
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	cluster, err := extensionscontroller.GetCluster(ctx, a.client, ex.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed reading Cluster: %w", err)
+	}
+
+	// The resources cannot be applied to the shoot cluster while its API server is scaled down. Charts with target
+	// `Seed` are still deployed so that they can scale down their workload during the hibernation.
+	if a.targetsShoot() && extensionscontroller.IsHibernationEnabled(cluster) {
+		log.Info("Skipping deployment of chart since the shoot is hibernated")
+		return nil
+	}
+
+	archive, err := a.registry.Pull(context.WithValue(ctx, oci.ContextKeyPullSecretNamespace, a.pullSecretNamespace), &a.chart.OCIRepository)
+	if err != nil {
+		return fmt.Errorf("failed pulling chart for extension type %q: %w", a.chart.Type, err)
+	}
+
+	values, err := a.computeValues(ex, cluster)
+	if err != nil {
+		return err
+	}
+
+	renderer, namespace := a.seedChartRenderer, ex.Namespace
+	if a.targetsShoot() {
+		renderer, err = shootChartRenderer(cluster)
+		if err != nil {
+			return err
+		}
+		namespace = ptr.Deref(a.chart.Namespace, "")
+	}
+
+	release, err := renderer.RenderArchive(archive, ptr.Deref(a.chart.ReleaseName, a.chart.Type), namespace, values)
+	if err != nil {
+		return fmt.Errorf("failed rendering chart for extension type %q: %w", a.chart.Type, err)
+	}
+
+	if a.targetsShoot() {
+		return managedresources.CreateForShoot(ctx, a.client, ex.Namespace, ManagedResourceName(a.chart.Type), managedresources.LabelValueGardener, false, release.AsSecretData())
+	}
+	return managedresources.CreateForSeed(ctx, a.client, ex.Namespace, ManagedResourceName(a.chart.Type), false, release.AsSecretData())
+}
+
+// Delete the extension resource.
+func (a *actuator) Delete(ctx context.Context, _ logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	if err := managedresources.Delete(ctx, a.client, ex.Namespace, ManagedResourceName(a.chart.Type), true); err != nil {
+		return err
+	}
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, a.client, ex.Namespace, ManagedResourceName(a.chart.Type))
+}
+
+// ForceDelete force deletes the extension resource.
+func (a *actuator) ForceDelete(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	return a.Delete(ctx, log, ex)
+}
+
+// Migrate the extension resource.
+func (a *actuator) Migrate(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	// Keep objects of the ManagedResource so that they are not deleted from the shoot during the migration. Objects in
+	// the seed are deleted together with the control plane namespace anyway.
+	if err := managedresources.SetKeepObjects(ctx, a.client, ex.Namespace, ManagedResourceName(a.chart.Type), true); err != nil {
+		return err
+	}
+
+	return a.Delete(ctx, log, ex)
+}
+
+// Restore the extension resource.
+func (a *actuator) Restore(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	return a.Reconcile(ctx, log, ex)
+}
+
+func (a *actuator) targetsShoot() bool {
+	return ptr.Deref(a.chart.Target, helmchartconfigv1alpha1.TargetShoot) == helmchartconfigv1alpha1.TargetShoot
+}
+
+// computeValues merges the values of the chart configuration with the provider config of the Extension resource. The
+// `gardener` key is reserved for information about the cluster and always overwrites user-provided values. For charts
+// with target `Seed`, the provider config may only contain the allowed keys.
+func (a *actuator) computeValues(ex *extensionsv1alpha1.Extension, cluster *extensionscontroller.Cluster) (map[string]any, error) {
+	values := map[string]any{}
+
+	if a.chart.Values != nil {
+		if err := json.Unmarshal(a.chart.Values.Raw, &values); err != nil {
+			return nil, fmt.Errorf("failed decoding configured values for extension type %q: %w", a.chart.Type, err)
+		}
+	}
+
+	if ex.Spec.ProviderConfig != nil && len(ex.Spec.ProviderConfig.Raw) > 0 {
+		providerConfigValues := map[string]any{}
+		if err := json.Unmarshal(ex.Spec.ProviderConfig.Raw, &providerConfigValues); err != nil {
+			return nil, fmt.Errorf("failed decoding provider config of extension: %w", err)
+		}
+
+		if !a.targetsShoot() {
+			allowedKeys := sets.New(a.chart.AllowedProviderConfigKeys...)
+			if forbiddenKeys := sets.KeySet(providerConfigValues).Difference(allowedKeys); forbiddenKeys.Len() > 0 {
+				return nil, fmt.Errorf("provider config of extension contains keys which are not allowed for extension type %q: %s", a.chart.Type, strings.Join(sets.List(forbiddenKeys), ", "))
+			}
+		}
+		values = utils.MergeMaps(values, providerConfigValues)
+	}
+
+	values["gardener"] = gardenerValues(cluster)
+	return values, nil
+}
+
+func gardenerValues(cluster *extensionscontroller.Cluster) map[string]any {
+	values := map[string]any{}
+
+	if shoot := cluster.Shoot; shoot != nil {
+		values["shoot"] = map[string]any{
+			"name":              shoot.Name,
+			"namespace":         shoot.Namespace,
+			"technicalID":       shoot.Status.TechnicalID,
+			"kubernetesVersion": shoot.Spec.Kubernetes.Version,
+			"provider":          shoot.Spec.Provider.Type,
+			"region":            shoot.Spec.Region,
+			"hibernated":        extensionscontroller.IsHibernationEnabled(cluster),
+		}
+	}
+
+	if seed := cluster.Seed; seed != nil {
+		values["seed"] = map[string]any{
+			"name":     seed.Name,
+			"provider": seed.Spec.Provider.Type,
+			"region":   seed.Spec.Provider.Region,
+		}
+	}
+
+	return values
+}
+
+func shootChartRenderer(cluster *extensionscontroller.Cluster) (chartrenderer.Interface, error) {
+	if cluster.Shoot == nil {
+		return nil, fmt.Errorf("cluster does not contain a shoot")
+	}
+
+	kubernetesVersion, err := semver.NewVersion(cluster.Shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing Kubernetes version of shoot: %w", err)
+	}
+
+	return chartrenderer.NewWithServerVersion(&version.Info{
+		GitVersion: "v" + kubernetesVersion.String(),
+		Major:      strconv.FormatUint(kubernetesVersion.Major(), 10),
+		Minor:      strconv.FormatUint(kubernetesVersion.Minor(), 10),
+	}), nil
+}
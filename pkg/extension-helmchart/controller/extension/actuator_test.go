@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extension_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/extension"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	helmchartconfigv1alpha1 "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/extension-helmchart/controller/extension"
+	ocifake "github.com/gardener/gardener/pkg/utils/oci/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Actuator", func() {
+	const (
+		namespace     = "shoot--foo--bar"
+		extensionType = "foo"
+	)
+
+	var (
+		ctx        = context.Background()
+		log        = logr.Discard()
+		fakeClient client.Client
+		registry   *ocifake.Registry
+
+		chart   helmchartconfigv1alpha1.Chart
+		shoot   *gardencorev1beta1.Shoot
+		cluster *extensionsv1alpha1.Cluster
+		ex      *extensionsv1alpha1.Extension
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		registry = ocifake.NewRegistry()
+
+		chart = helmchartconfigv1alpha1.Chart{
+			Type:          extensionType,
+			OCIRepository: gardencorev1.OCIRepository{Ref: ptr.To("example.com/charts/foo:1.0.0")},
+			Values:        &apiextensionsv1.JSON{Raw: []byte(`{"replicas":1,"image":"foo:v1"}`)},
+		}
+		helmchartconfigv1alpha1.SetDefaults_Chart(&chart)
+		registry.AddArtifact(&chart.OCIRepository, chartArchive())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.33.1"},
+				Provider:   gardencorev1beta1.Provider{Type: "local"},
+				Region:     "local",
+			},
+			Status: gardencorev1beta1.ShootStatus{TechnicalID: namespace},
+		}
+		cluster = &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec: extensionsv1alpha1.ClusterSpec{
+				Shoot:        runtime.RawExtension{Raw: encode(shoot)},
+				Seed:         runtime.RawExtension{Raw: encode(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "local"}})},
+				CloudProfile: runtime.RawExtension{Raw: encode(&gardencorev1beta1.CloudProfile{})},
+			},
+		}
+		Expect(fakeClient.Create(ctx, cluster)).To(Succeed())
+
+		ex = &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: extensionType, Namespace: namespace},
+			Spec: extensionsv1alpha1.ExtensionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           extensionType,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"replicas":3,"gardener":{"shoot":{"name":"overwritten"}}}`)},
+				},
+			},
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "extension-helmchart-foo", Namespace: namespace}}
+	})
+
+	newActuator := func() extension.Actuator {
+		return NewActuator(fakeClient, registry, chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.32.0", Major: "1", Minor: "32"}), chart, "garden")
+	}
+
+	renderedManifest := func() string {
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+		secret := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveLen(1))
+		for _, data := range secret.Data {
+			return string(data)
+		}
+		return ""
+	}
+
+	Describe("#Reconcile", func() {
+		It("should render the chart and deploy it to the shoot", func() {
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(Succeed())
+
+			Expect(renderedManifest()).To(Equal(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: kube-system
+data:
+  replicas: "3"
+  image: foo:v1
+  shoot: bar
+  technicalID: shoot--foo--bar
+  kubernetesVersion: v1.33.1`))
+			Expect(managedResource.Spec.Class).To(BeNil())
+			Expect(managedResource.Labels).To(HaveKeyWithValue("origin", "gardener"))
+		})
+
+		It("should render the chart and deploy it to the seed", func() {
+			chart.Target = ptr.To(helmchartconfigv1alpha1.TargetSeed)
+			chart.Namespace = nil
+			ex.Spec.ProviderConfig = nil
+
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(Succeed())
+
+			Expect(renderedManifest()).To(ContainSubstring("namespace: shoot--foo--bar\n"))
+			Expect(renderedManifest()).To(ContainSubstring("replicas: \"1\"\n"))
+			Expect(renderedManifest()).To(HaveSuffix("kubernetesVersion: v1.32.0"))
+			Expect(managedResource.Spec.Class).To(PointTo(Equal("seed")))
+		})
+
+		It("should merge the allowed keys of the provider config for the seed", func() {
+			chart.Target = ptr.To(helmchartconfigv1alpha1.TargetSeed)
+			chart.Namespace = nil
+			chart.AllowedProviderConfigKeys = []string{"replicas"}
+			ex.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}
+
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(Succeed())
+
+			Expect(renderedManifest()).To(ContainSubstring("replicas: \"3\"\n"))
+		})
+
+		It("should fail if the provider config contains keys which are not allowed for the seed", func() {
+			chart.Target = ptr.To(helmchartconfigv1alpha1.TargetSeed)
+			chart.Namespace = nil
+			chart.AllowedProviderConfigKeys = []string{"replicas"}
+			ex.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"replicas":3,"image":"evil:v1","gardener":{}}`)}
+
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(MatchError(`provider config of extension contains keys which are not allowed for extension type "foo": gardener, image`))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+
+		It("should skip the deployment to the shoot if it is hibernated", func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			cluster.Spec.Shoot.Raw = encode(shoot)
+			Expect(fakeClient.Update(ctx, cluster)).To(Succeed())
+
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+
+		It("should deploy the chart to the seed if the shoot is hibernated", func() {
+			chart.Target = ptr.To(helmchartconfigv1alpha1.TargetSeed)
+			chart.Namespace = nil
+			ex.Spec.ProviderConfig = nil
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			cluster.Spec.Shoot.Raw = encode(shoot)
+			Expect(fakeClient.Update(ctx, cluster)).To(Succeed())
+
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(Succeed())
+
+			Expect(renderedManifest()).To(ContainSubstring("namespace: shoot--foo--bar\n"))
+		})
+
+		It("should fail if the chart cannot be pulled", func() {
+			chart.OCIRepository = gardencorev1.OCIRepository{Ref: ptr.To("example.com/charts/unknown:1.0.0")}
+
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(MatchError(ContainSubstring(`failed pulling chart for extension type "foo"`)))
+		})
+
+		It("should fail if the provider config is not a JSON object", func() {
+			ex.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`["foo"]`)}
+
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(MatchError(ContainSubstring("failed decoding provider config of extension")))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the managed resource", func() {
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(Succeed())
+			Expect(newActuator().Delete(ctx, log, ex)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+			secretList := &corev1.SecretList{}
+			Expect(fakeClient.List(ctx, secretList, client.InNamespace(namespace))).To(Succeed())
+			Expect(secretList.Items).To(BeEmpty())
+		})
+	})
+
+	Describe("#Migrate", func() {
+		It("should keep the objects and delete the managed resource", func() {
+			Expect(newActuator().Reconcile(ctx, log, ex)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			// Add a finalizer so that the keepObjects flag can be checked after the deletion was triggered.
+			managedResource.Finalizers = []string{"resources.gardener.cloud/gardener-resource-manager"}
+			Expect(fakeClient.Update(ctx, managedResource)).To(Succeed())
+
+			// The managed resource is not removed, hence waiting for its deletion is cancelled right away.
+			cancelledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			Expect(newActuator().Migrate(cancelledCtx, log, ex)).To(MatchError(ContainSubstring("context canceled")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Spec.KeepObjects).To(PointTo(BeTrue()))
+			Expect(managedResource.DeletionTimestamp).NotTo(BeNil())
+		})
+	})
+})
+
+func encode(obj runtime.Object) []byte {
+	data, err := json.Marshal(obj)
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+func chartArchive() []byte {
+	files := map[string]string{
+		"foo/Chart.yaml": `apiVersion: v2
+name: foo
+version: 1.0.0
+`,
+		"foo/values.yaml": `replicas: 1
+`,
+		"foo/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+data:
+  replicas: {{ .Values.replicas | quote }}
+  {{- if .Values.image }}
+  image: {{ .Values.image }}
+  {{- end }}
+  {{- if .Values.gardener.shoot }}
+  shoot: {{ .Values.gardener.shoot.name }}
+  technicalID: {{ .Values.gardener.shoot.technicalID }}
+  {{- end }}
+  kubernetesVersion: {{ .Capabilities.KubeVersion.Version }}
+`,
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, name := range []string{"foo/Chart.yaml", "foo/values.yaml", "foo/templates/configmap.yaml"} {
+		Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(files[name]))})).To(Succeed())
+		_, err := tarWriter.Write([]byte(files[name]))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+	return buf.Bytes()
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extension
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/extension"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	helmchartconfigv1alpha1 "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "helmchart"
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{Controller: controller.Options{MaxConcurrentReconciles: 5}}
+)
+
+// AddOptions are options to apply when adding the extension controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+	// ExtensionClass defines the extension class this extension is responsible for.
+	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// Config is the configuration of the charts which are deployed for Extension resources.
+	Config *helmchartconfigv1alpha1.ControllerConfiguration
+	// PullSecretNamespace is the namespace the pull secrets of the charts' OCI repositories are read from.
+	PullSecretNamespace string
+}
+
+// AddToManagerWithOptions adds one controller per configured chart with the given Options to the given manager.
+func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
+	if opts.Config == nil {
+		return fmt.Errorf("no configuration provided for the %s controller", ControllerName)
+	}
+
+	seedChartRenderer, err := chartrenderer.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed creating chart renderer: %w", err)
+	}
+	registry := oci.NewHelmRegistry(mgr.GetClient())
+
+	for _, chart := range opts.Config.Charts {
+		if err := extension.Add(mgr, extension.AddArgs{
+			Actuator:          NewActuator(mgr.GetClient(), registry, seedChartRenderer, chart, opts.PullSecretNamespace),
+			ControllerOptions: opts.Controller,
+			ExtensionClasses:  []extensionsv1alpha1.ExtensionClass{opts.ExtensionClass},
+			Name:              ControllerName + "-" + chart.Type,
+			FinalizerSuffix:   chart.Type,
+			Resync:            60 * time.Minute,
+			Predicates:        extension.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
+			Type:              chart.Type,
+		}); err != nil {
+			return fmt.Errorf("failed adding controller for extension type %q: %w", chart.Type, err)
+		}
+	}
+
+	return nil
+}
+
+// AddToManager adds the controllers with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
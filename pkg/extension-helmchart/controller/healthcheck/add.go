@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	helmchartconfigv1alpha1 "github.com/gardener/gardener/pkg/extension-helmchart/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/extension-helmchart/controller/extension"
+)
+
+var (
+	defaultSyncPeriod = time.Second * 30
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		DefaultAddArgs: healthcheck.DefaultAddArgs{
+			HealthCheckConfig: extensionsconfigv1alpha1.HealthCheckConfig{
+				SyncPeriod: metav1.Duration{Duration: defaultSyncPeriod},
+			},
+		},
+	}
+)
+
+// AddOptions are options to apply when adding the health check controllers to the manager.
+type AddOptions struct {
+	healthcheck.DefaultAddArgs
+	// Config is the configuration of the charts which are deployed for Extension resources.
+	Config *helmchartconfigv1alpha1.ControllerConfiguration
+}
+
+// RegisterHealthChecks registers a health check for the ManagedResource of each configured chart. Its result is
+// reported with the condition type configured for the chart.
+func RegisterHealthChecks(mgr manager.Manager, opts AddOptions) error {
+	if opts.Config == nil {
+		return fmt.Errorf("no configuration provided for the health check controllers")
+	}
+
+	for _, chart := range opts.Config.Charts {
+		healthChecks := []healthcheck.ConditionTypeToHealthCheck{{
+			ConditionType: ptr.Deref(chart.HealthConditionType, ""),
+			HealthCheck:   general.CheckManagedResource(extension.ManagedResourceName(chart.Type)),
+		}}
+
+		if err := healthcheck.DefaultRegistration(
+			chart.Type,
+			extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.ExtensionResource),
+			func() client.ObjectList { return &extensionsv1alpha1.ExtensionList{} },
+			func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Extension{} },
+			mgr,
+			opts.DefaultAddArgs,
+			nil,
+			healthChecks,
+			nil,
+		); err != nil {
+			return fmt.Errorf("failed registering health checks for extension type %q: %w", chart.Type, err)
+		}
+	}
+
+	return nil
+}
+
+// AddToManager adds the health check controllers with the default Options.
+func AddToManager(_ context.Context, mgr manager.Manager) error {
+	return RegisterHealthChecks(mgr, DefaultAddOptions)
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/nodeagent/supportbundle"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Output is the path of the file the support bundle is written to. If it is "-", the support bundle is written to
+	// stdout. If it is empty, a file name containing the node name and the current time is chosen.
+	Output string
+	// JournalLines is the number of journal lines collected per systemd unit.
+	JournalLines int
+	// SkipClusterResources specifies whether collecting cluster resources should be skipped.
+	SkipClusterResources bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.JournalLines < 0 {
+		return fmt.Errorf("journal-lines must not be negative")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Output, "output", "o", "", "Path of the file the support bundle is written to (use '-' for stdout). Defaults to 'support-bundle-<node>-<timestamp>.tar.gz' in the current directory")
+	fs.IntVar(&o.JournalLines, "journal-lines", supportbundle.DefaultJournalLines, "Number of journal lines collected per systemd unit")
+	fs.BoolVar(&o.SkipClusterResources, "skip-cluster-resources", false, "Skip collecting cluster resources (Node, Pods) from the autonomous shoot cluster")
+}
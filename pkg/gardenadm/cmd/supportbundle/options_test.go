@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/supportbundle"
+)
+
+var _ = Describe("Options", func() {
+	var options *Options
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should pass for valid options", func() {
+			options.JournalLines = 100
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail because journal lines are negative", func() {
+			options.JournalLines = -1
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("journal-lines must not be negative")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
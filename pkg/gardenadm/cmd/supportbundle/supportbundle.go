@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/supportbundle"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "support-bundle",
+		Short: "Collect diagnostic information of this node into a redacted tarball",
+		Long: `The "support-bundle" command collects diagnostic information of this node into a redacted, gzip-compressed
+tarball. It contains the statuses of all systemd units, the last applied OperatingSystemConfig and its checksum, the
+static pod manifests, the containerd configuration, journal excerpts of the relevant units and the cluster resources
+related to this node (if the autonomous shoot cluster is reachable).
+
+Sensitive information like private keys, tokens, passwords and the contents of files embedded in the
+OperatingSystemConfig is redacted.`,
+
+		Example: `# Collect a support bundle into the current directory
+gardenadm support-bundle
+
+# Collect a support bundle and stream it to stdout
+gardenadm support-bundle -o - > bundle.tar.gz`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+var (
+	// CreateClientSet creates a new client set using the AutonomousBotanist to create the client set.
+	// Exposed for unit testing.
+	CreateClientSet = func(ctx context.Context, log logr.Logger) (kubernetes.Interface, error) {
+		return (&botanist.AutonomousBotanist{Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}}}).CreateClientSet(ctx)
+	}
+	// NewAferoFs is an alias for returning an afero.NewOsFs.
+	// Exposed for unit testing.
+	NewAferoFs = func() afero.Afero { return afero.Afero{Fs: afero.NewOsFs()} }
+	// NewDBus is an alias for dbus.New.
+	// Exposed for unit testing.
+	NewDBus = dbus.New
+	// GetHostName is an alias for nodeagent.GetHostName.
+	// Exposed for unit testing.
+	GetHostName = nodeagent.GetHostName
+	// Clock is the clock used for timestamping the support bundle.
+	// Exposed for unit testing.
+	Clock clock.Clock = clock.RealClock{}
+)
+
+func run(ctx context.Context, opts *Options) error {
+	fs := NewAferoFs()
+
+	collector := &supportbundle.Collector{
+		Log:          opts.Log,
+		FS:           fs,
+		DBus:         NewDBus(opts.Log),
+		Clock:        Clock,
+		JournalUnits: supportbundle.DefaultJournalUnits,
+		JournalLines: opts.JournalLines,
+	}
+
+	if !opts.SkipClusterResources {
+		if err := completeClusterAccess(ctx, opts, collector); err != nil {
+			opts.Log.Info("Cluster resources will not be collected", "reason", err.Error())
+		}
+	}
+
+	if opts.Output == "-" {
+		return collector.Collect(ctx, opts.Out)
+	}
+
+	output := opts.Output
+	if output == "" {
+		output = supportbundle.FileName(collector.NodeName, Clock.Now())
+	}
+
+	file, err := fs.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed creating support bundle file %q: %w", output, err)
+	}
+
+	if err := collectAndClose(ctx, collector, file); err != nil {
+		return fmt.Errorf("failed collecting support bundle: %w", err)
+	}
+
+	fmt.Fprintf(opts.Out, "Support bundle written to %s\n", output)
+	return nil
+}
+
+func completeClusterAccess(ctx context.Context, opts *Options, collector *supportbundle.Collector) error {
+	clientSet, err := CreateClientSet(ctx, opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	hostName, err := GetHostName()
+	if err != nil {
+		return fmt.Errorf("failed fetching hostname: %w", err)
+	}
+
+	node, err := nodeagent.FetchNodeByHostName(ctx, clientSet.Client(), hostName)
+	if err != nil {
+		return fmt.Errorf("failed fetching node for hostname %q: %w", hostName, err)
+	}
+	if node == nil {
+		return fmt.Errorf("no node found for hostname %q", hostName)
+	}
+
+	collector.Client = clientSet.Client()
+	collector.NodeName = node.Name
+	return nil
+}
+
+func collectAndClose(ctx context.Context, collector *supportbundle.Collector, w io.WriteCloser) error {
+	if err := collector.Collect(ctx, w); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	testclock "k8s.io/utils/clock/testing"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/supportbundle"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/nodeagent/supportbundle"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("SupportBundle", func() {
+	var (
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fs afero.Afero
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		DeferCleanup(test.WithVars(
+			&NewAferoFs, func() afero.Afero { return fs },
+			&NewDBus, func(logr.Logger) dbus.DBus { return fakedbus.New() },
+			&Clock, testclock.NewFakeClock(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+			&CreateClientSet, func(context.Context, logr.Logger) (kubernetes.Interface, error) {
+				return nil, errors.New("cluster not reachable")
+			},
+			&supportbundle.ReadJournal, func(context.Context, string, int) ([]byte, error) { return nil, nil },
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should write the support bundle to a file with the default name", func() {
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("Support bundle written to support-bundle-node-20250102-030405.tar.gz"))
+			Expect(fs.Exists("support-bundle-node-20250102-030405.tar.gz")).To(BeTrue())
+		})
+
+		It("should write the support bundle to the given file", func() {
+			Expect(command.Flags().Set("output", "/tmp/bundle.tar.gz")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("Support bundle written to /tmp/bundle.tar.gz"))
+			Expect(fs.Exists("/tmp/bundle.tar.gz")).To(BeTrue())
+		})
+
+		It("should stream the support bundle to stdout", func() {
+			Expect(command.Flags().Set("output", "-")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(stdOut.Contents()[:2]).To(Equal([]byte{0x1f, 0x8b}))
+		})
+	})
+})
@@ -245,6 +245,35 @@ func SetDefaults_ShootMonitoringConfig(obj *ShootMonitoringConfig) {
 	}
 }
 
+// SetDefaults_OwnerRemoteWriteConfig sets the defaults for the remote write targets configured by shoot owners.
+func SetDefaults_OwnerRemoteWriteConfig(obj *OwnerRemoteWriteConfig) {
+	if obj.MaxTargets == nil {
+		obj.MaxTargets = ptr.To[int32](2)
+	}
+	if obj.MaxKeptMetrics == nil {
+		obj.MaxKeptMetrics = ptr.To[int32](100)
+	}
+	if obj.MaxShards == nil {
+		obj.MaxShards = ptr.To[int32](2)
+	}
+	if obj.MaxSamplesPerSend == nil {
+		obj.MaxSamplesPerSend = ptr.To[int32](500)
+	}
+}
+
+// SetDefaults_CardinalityGuardConfig sets the defaults for the sample limits of shoot monitoring stacks.
+func SetDefaults_CardinalityGuardConfig(obj *CardinalityGuardConfig) {
+	if obj.SampleLimit == nil {
+		obj.SampleLimit = ptr.To[int64](50000)
+	}
+	if obj.FederationSampleLimit == nil {
+		obj.FederationSampleLimit = ptr.To[int64](10000)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
 // SetDefaults_BastionControllerConfiguration sets defaults for the bastion controller.
 func SetDefaults_BastionControllerConfiguration(obj *BastionControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -272,6 +301,10 @@ func SetDefaults_ControllerInstallationCareControllerConfiguration(obj *Controll
 		v := metav1.Duration{Duration: 30 * time.Second}
 		obj.SyncPeriod = &v
 	}
+
+	if obj.HeartbeatStaleThreshold == nil {
+		obj.HeartbeatStaleThreshold = &metav1.Duration{Duration: 5 * time.Minute}
+	}
 }
 
 // SetDefaults_ControllerInstallationRequiredControllerConfiguration sets defaults for the ControllerInstallationRequired controller.
@@ -373,6 +406,13 @@ func SetDefaults_StaleExtensionHealthChecks(obj *StaleExtensionHealthChecks) {
 	}
 }
 
+// SetDefaults_ShootAvailabilityTracking sets defaults for the shoot availability tracking.
+func SetDefaults_ShootAvailabilityTracking(obj *ShootAvailabilityTracking) {
+	if obj.Window == nil {
+		obj.Window = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+}
+
 // SetDefaults_ShootStateControllerConfiguration sets defaults for the shoot state controller.
 func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
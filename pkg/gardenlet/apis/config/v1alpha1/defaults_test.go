@@ -258,20 +258,24 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.Controllers.ControllerInstallationCare.ConcurrentSyncs).To(PointTo(Equal(20)))
 			Expect(obj.Controllers.ControllerInstallationCare.SyncPeriod).To(PointTo(Equal(v)))
+			Expect(obj.Controllers.ControllerInstallationCare.HeartbeatStaleThreshold).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.Controllers.ControllerInstallationCare.Remediation).To(BeNil())
 		})
 
 		It("should not overwrite already set values for the controller installation care controller configuration", func() {
 			v := metav1.Duration{Duration: 2 * time.Minute}
 			obj.Controllers = &GardenletControllerConfiguration{
 				ControllerInstallationCare: &ControllerInstallationCareControllerConfiguration{
-					ConcurrentSyncs: ptr.To(10),
-					SyncPeriod:      &v,
+					ConcurrentSyncs:         ptr.To(10),
+					SyncPeriod:              &v,
+					HeartbeatStaleThreshold: &v,
 				},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
 
 			Expect(obj.Controllers.ControllerInstallationCare.ConcurrentSyncs).To(PointTo(Equal(10)))
 			Expect(obj.Controllers.ControllerInstallationCare.SyncPeriod).To(PointTo(Equal(v)))
+			Expect(obj.Controllers.ControllerInstallationCare.HeartbeatStaleThreshold).To(PointTo(Equal(v)))
 		})
 	})
 
@@ -418,6 +422,25 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootAvailabilityTracking defaulting", func() {
+		It("should not enable the availability tracking by default", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.AvailabilityTracking).To(BeNil())
+		})
+
+		It("should default the window of the availability tracking", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					AvailabilityTracking: &ShootAvailabilityTracking{},
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.AvailabilityTracking.Window).To(PointTo(Equal(metav1.Duration{Duration: 30 * 24 * time.Hour})))
+		})
+	})
+
 	Describe("StaleExtensionHealthChecks defaulting", func() {
 		It("should default the stale extension health checks", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -862,6 +885,77 @@ var _ = Describe("Defaults", func() {
 			Expect(*obj.Monitoring.Shoot.Enabled).To(BeFalse())
 		})
 	})
+
+	Describe("OwnerRemoteWriteConfig defaulting", func() {
+		It("should not enable remote write targets for shoot owners", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Monitoring.Shoot.OwnerRemoteWrite).To(BeNil())
+		})
+
+		It("should default the limits", func() {
+			obj.Monitoring = &MonitoringConfig{Shoot: &ShootMonitoringConfig{OwnerRemoteWrite: &OwnerRemoteWriteConfig{}}}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Monitoring.Shoot.OwnerRemoteWrite).To(Equal(&OwnerRemoteWriteConfig{
+				MaxTargets:        ptr.To[int32](2),
+				MaxKeptMetrics:    ptr.To[int32](100),
+				MaxShards:         ptr.To[int32](2),
+				MaxSamplesPerSend: ptr.To[int32](500),
+			}))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.Monitoring = &MonitoringConfig{Shoot: &ShootMonitoringConfig{OwnerRemoteWrite: &OwnerRemoteWriteConfig{
+				MaxTargets:        ptr.To[int32](1),
+				MaxKeptMetrics:    ptr.To[int32](10),
+				MaxShards:         ptr.To[int32](1),
+				MaxSamplesPerSend: ptr.To[int32](100),
+			}}}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Monitoring.Shoot.OwnerRemoteWrite).To(Equal(&OwnerRemoteWriteConfig{
+				MaxTargets:        ptr.To[int32](1),
+				MaxKeptMetrics:    ptr.To[int32](10),
+				MaxShards:         ptr.To[int32](1),
+				MaxSamplesPerSend: ptr.To[int32](100),
+			}))
+		})
+	})
+
+	Describe("CardinalityGuardConfig defaulting", func() {
+		It("should not enforce sample limits", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Monitoring.Shoot.CardinalityGuard).To(BeNil())
+		})
+
+		It("should default the limits", func() {
+			obj.Monitoring = &MonitoringConfig{Shoot: &ShootMonitoringConfig{CardinalityGuard: &CardinalityGuardConfig{}}}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Monitoring.Shoot.CardinalityGuard).To(Equal(&CardinalityGuardConfig{
+				SampleLimit:           ptr.To[int64](50000),
+				FederationSampleLimit: ptr.To[int64](10000),
+				SyncPeriod:            &metav1.Duration{Duration: 5 * time.Minute},
+			}))
+		})
+
+		It("should not overwrite already set values", func() {
+			obj.Monitoring = &MonitoringConfig{Shoot: &ShootMonitoringConfig{CardinalityGuard: &CardinalityGuardConfig{
+				SampleLimit:           ptr.To[int64](1000),
+				FederationSampleLimit: ptr.To[int64](500),
+				SyncPeriod:            &metav1.Duration{Duration: time.Minute},
+			}}}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Monitoring.Shoot.CardinalityGuard).To(Equal(&CardinalityGuardConfig{
+				SampleLimit:           ptr.To[int64](1000),
+				FederationSampleLimit: ptr.To[int64](500),
+				SyncPeriod:            &metav1.Duration{Duration: time.Minute},
+			}))
+		})
+	})
 })
 
 var _ = Describe("Constants", func() {
@@ -64,6 +64,15 @@ func IsMonitoringEnabled(c *gardenletconfigv1alpha1.GardenletConfiguration) bool
 	return true
 }
 
+// GetCardinalityGuardConfig returns the cardinality guard configuration of the shoot monitoring stack if set, otherwise
+// it returns nil.
+func GetCardinalityGuardConfig(c *gardenletconfigv1alpha1.GardenletConfiguration) *gardenletconfigv1alpha1.CardinalityGuardConfig {
+	if c != nil && c.Monitoring != nil && c.Monitoring.Shoot != nil {
+		return c.Monitoring.Shoot.CardinalityGuard
+	}
+	return nil
+}
+
 // GetManagedResourceProgressingThreshold returns ManagedResourceProgressingThreshold if set otherwise it returns nil.
 func GetManagedResourceProgressingThreshold(c *gardenletconfigv1alpha1.GardenletConfiguration) *metav1.Duration {
 	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && c.Controllers.ShootCare.ManagedResourceProgressingThreshold != nil {
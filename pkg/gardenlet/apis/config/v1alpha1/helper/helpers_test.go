@@ -104,6 +104,23 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	Describe("#GetCardinalityGuardConfig", func() {
+		It("should return nil when nothing is set", func() {
+			Expect(GetCardinalityGuardConfig(nil)).To(BeNil())
+			Expect(GetCardinalityGuardConfig(&gardenletconfigv1alpha1.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should return the cardinality guard configuration", func() {
+			cardinalityGuard := &gardenletconfigv1alpha1.CardinalityGuardConfig{SampleLimit: ptr.To[int64](1000)}
+			gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{
+				Monitoring: &gardenletconfigv1alpha1.MonitoringConfig{
+					Shoot: &gardenletconfigv1alpha1.ShootMonitoringConfig{CardinalityGuard: cardinalityGuard},
+				},
+			}
+			Expect(GetCardinalityGuardConfig(gardenletConfig)).To(Equal(cardinalityGuard))
+		})
+	})
+
 	Describe("#LoggingConfiguration", func() {
 		It("should return false when the GardenletConfiguration is nil", func() {
 			Expect(IsLoggingEnabled(nil)).To(BeFalse())
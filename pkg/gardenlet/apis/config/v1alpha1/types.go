@@ -13,6 +13,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -85,6 +86,9 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// NodeAgent contains optional settings for the gardener-node-agent running on the nodes of the shoot clusters.
+	// +optional
+	NodeAgent *NodeAgentConfig `json:"nodeAgent,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -261,6 +265,27 @@ type ControllerInstallationCareControllerConfiguration struct {
 	// often the health check of ControllerInstallations is performed.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// HeartbeatStaleThreshold is the duration after which the heartbeat of an extension is considered stale if its
+	// `gardener-extension-heartbeat` Lease was not renewed. ControllerInstallations of extensions with stale heartbeats
+	// are reported as unhealthy. Extensions which do not maintain a heartbeat Lease are not checked.
+	// +optional
+	HeartbeatStaleThreshold *metav1.Duration `json:"heartbeatStaleThreshold,omitempty"`
+	// Remediation configures how gardenlet reacts on unhealthy extensions.
+	// +optional
+	Remediation *ControllerInstallationRemediation `json:"remediation,omitempty"`
+}
+
+// ControllerInstallationRemediation configures how gardenlet reacts on unhealthy extensions.
+type ControllerInstallationRemediation struct {
+	// RestartOnStaleHeartbeat specifies whether the pods of the extension Deployment maintaining the heartbeat are
+	// restarted when its heartbeat is stale. The pods are restarted at most once per heartbeat stale threshold.
+	// +optional
+	RestartOnStaleHeartbeat *bool `json:"restartOnStaleHeartbeat,omitempty"`
+	// RollbackTimeout is the duration a new revision of an extension deployment may be failing, i.e. not installed or
+	// not healthy. If it fails for longer, it is rolled back to the last revision which was healthy. Revisions which are
+	// only progressing are not rolled back. If it is not set, extension deployments are not rolled back.
+	// +optional
+	RollbackTimeout *metav1.Duration `json:"rollbackTimeout,omitempty"`
 }
 
 // ControllerInstallationRequiredControllerConfiguration defines the configuration of the ControllerInstallationRequired
@@ -350,6 +375,18 @@ type ShootCareControllerConfiguration struct {
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// AvailabilityTracking defines the configuration for tracking the availability of the shoot conditions over a
+	// rolling time window. If the field is not specified, the availability is not tracked.
+	// +optional
+	AvailabilityTracking *ShootAvailabilityTracking `json:"availabilityTracking,omitempty"`
+}
+
+// ShootAvailabilityTracking defines the configuration for tracking the availability of the shoot conditions.
+type ShootAvailabilityTracking struct {
+	// Window is the rolling time window for which the availability of the shoot conditions is computed.
+	// Defaults to 720h (30d).
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -491,6 +528,9 @@ type Vali struct {
 	// Garden contains configuration for the Vali in garden namespace.
 	// +optional
 	Garden *GardenVali `json:"garden,omitempty" yaml:"garden,omitempty"`
+	// Shoot contains configuration for the Valis in the control plane namespaces of shoots.
+	// +optional
+	Shoot *ShootVali `json:"shoot,omitempty" yaml:"shoot,omitempty"`
 }
 
 // GardenVali contains configuration for the Vali in garden namespace.
@@ -501,6 +541,38 @@ type GardenVali struct {
 	Storage *resource.Quantity `json:"storage,omitempty" yaml:"storage,omitempty"`
 }
 
+// ShootVali contains configuration for the Valis in the control plane namespaces of shoots.
+type ShootVali struct {
+	// Limits are the log retention and ingestion limits of the shoot Valis. The first entry whose shoot purposes
+	// contain the purpose of the shoot is applied. An entry without shoot purposes applies to shoots of all purposes.
+	// +optional
+	Limits []ValiLimits `json:"limits,omitempty" yaml:"limits,omitempty"`
+}
+
+// ValiLimits contains the log retention and ingestion limits for the Valis of shoots.
+type ValiLimits struct {
+	// ShootPurposes are the purposes of the shoots to which the limits apply. If empty, the limits apply to shoots of
+	// all purposes.
+	// +optional
+	ShootPurposes []gardencorev1beta1.ShootPurpose `json:"shootPurposes,omitempty" yaml:"shootPurposes,omitempty"`
+	// RetentionPeriod is the period after which logs are deleted. It must be a multiple of 24h.
+	// Defaults to 360h.
+	// +optional
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty" yaml:"retentionPeriod,omitempty"`
+	// IngestionRateMB is the rate in megabytes per second with which Vali accepts logs. Logs exceeding the rate are
+	// discarded by Vali.
+	// +optional
+	IngestionRateMB *int32 `json:"ingestionRateMB,omitempty" yaml:"ingestionRateMB,omitempty"`
+	// IngestionBurstSizeMB is the burst size in megabytes with which Vali accepts logs. It must not be smaller than the
+	// ingestion rate.
+	// +optional
+	IngestionBurstSizeMB *int32 `json:"ingestionBurstSizeMB,omitempty" yaml:"ingestionBurstSizeMB,omitempty"`
+	// MaxLinesPerSecond is the average number of log lines per second which fluent-bit forwards from the control plane
+	// of a shoot to its Vali. Log lines exceeding the rate are dropped by fluent-bit.
+	// +optional
+	MaxLinesPerSecond *int32 `json:"maxLinesPerSecond,omitempty" yaml:"maxLinesPerSecond,omitempty"`
+}
+
 // ShootNodeLogging contains configuration for the shoot node logging.
 type ShootNodeLogging struct {
 	// ShootPurposes determines which shoots can have node logging by their purpose
@@ -529,6 +601,26 @@ type Logging struct {
 	// ShootEventLogging contains configurations for the shoot event logger.
 	// +optional
 	ShootEventLogging *ShootEventLogging `json:"shootEventLogging,omitempty" yaml:"shootEventLogging,omitempty"`
+	// ShootTelemetryExport contains the default configuration for exporting the logs and traces of the shoot control
+	// planes via OTLP. Shoot owners can override it with their own configuration.
+	// +optional
+	ShootTelemetryExport *ShootTelemetryExport `json:"shootTelemetryExport,omitempty" yaml:"shootTelemetryExport,omitempty"`
+}
+
+// ShootTelemetryExport contains the configuration for exporting the logs and traces of the shoot control planes via
+// OTLP. It requires the OpenTelemetry Collector to be enabled for the shoot control planes.
+type ShootTelemetryExport struct {
+	// Endpoint is the URL of the OTLP/HTTP endpoint the logs and traces are sent to. The endpoint must accept data
+	// without authentication, e.g., a gateway running in the seed cluster.
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// Signals is the list of signals which are exported. Supported values are `logs` and `traces`.
+	// If not set, both logs and traces are exported.
+	// +optional
+	Signals []string `json:"signals,omitempty" yaml:"signals,omitempty"`
+	// SamplingRatePerMillion is the number of spans per million which are sampled by the kube-apiserver.
+	// If not set, 1000 spans per million are sampled.
+	// +optional
+	SamplingRatePerMillion *int32 `json:"samplingRatePerMillion,omitempty" yaml:"samplingRatePerMillion,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -692,6 +784,55 @@ type ShootMonitoringConfig struct {
 	// ExternalLabels is optional and sets additional external labels for the monitoring stack.
 	// +optional
 	ExternalLabels map[string]string `json:"externalLabels,omitempty"`
+	// OwnerRemoteWrite is optional and contains the limits for remote write targets configured by shoot owners. If not
+	// set, shoot owners cannot configure remote write targets.
+	// +optional
+	OwnerRemoteWrite *OwnerRemoteWriteConfig `json:"ownerRemoteWrite,omitempty"`
+	// CardinalityGuard is optional and contains the settings for limiting the number of samples scraped by the shoot
+	// Prometheus and federated by the aggregate Prometheus. If not set, no limits are enforced.
+	// +optional
+	CardinalityGuard *CardinalityGuardConfig `json:"cardinalityGuard,omitempty"`
+}
+
+// CardinalityGuardConfig contains the settings for limiting the number of samples of shoot monitoring stacks.
+type CardinalityGuardConfig struct {
+	// SampleLimit is the maximum number of samples the shoot Prometheus accepts per scrape of a target. Scrapes of
+	// targets exceeding the limit fail.
+	// Defaults to 50000.
+	// +optional
+	SampleLimit *int64 `json:"sampleLimit,omitempty"`
+	// FederationSampleLimit is the maximum number of samples the aggregate Prometheus accepts per federation scrape of
+	// a shoot Prometheus.
+	// Defaults to 10000.
+	// +optional
+	FederationSampleLimit *int64 `json:"federationSampleLimit,omitempty"`
+	// SyncPeriod is the duration how often the shoots exceeding the limits are determined.
+	// Defaults to 5m.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// OwnerRemoteWriteConfig contains the limits for remote write targets configured by shoot owners.
+type OwnerRemoteWriteConfig struct {
+	// MaxTargets is the maximum number of remote write targets per shoot.
+	// Defaults to 2.
+	// +optional
+	MaxTargets *int32 `json:"maxTargets,omitempty"`
+	// MaxKeptMetrics is the maximum number of metric names which can be forwarded to a remote write target. Regular
+	// expressions in the keep list of a target are only accepted if they match a limited set of metric names, each of
+	// which is counted. It does not limit the number of series per metric name.
+	// Defaults to 100.
+	// +optional
+	MaxKeptMetrics *int32 `json:"maxKeptMetrics,omitempty"`
+	// MaxShards is the maximum number of parallel connections to a remote write target. Together with
+	// MaxSamplesPerSend, it caps the bandwidth used per shoot.
+	// Defaults to 2.
+	// +optional
+	MaxShards *int32 `json:"maxShards,omitempty"`
+	// MaxSamplesPerSend is the maximum number of samples sent to a remote write target in one request.
+	// Defaults to 500.
+	// +optional
+	MaxSamplesPerSend *int32 `json:"maxSamplesPerSend,omitempty"`
 }
 
 // RemoteWriteMonitoringConfig contains settings for the remote write setting for monitoring stack.
@@ -759,3 +900,20 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// NodeAgentConfig contains settings for the gardener-node-agent running on the nodes of the shoot clusters. They are
+// written into the configuration of the gardener-node-agent which is part of the operating system config.
+type NodeAgentConfig struct {
+	// HealthCheck is the configuration for the health checks of the gardener-node-agent. The configured checks are
+	// executed in addition to the built-in kubelet and containerd health checks.
+	// +optional
+	HealthCheck *nodeagentconfigv1alpha1.HealthCheckControllerConfig `json:"healthCheck,omitempty"`
+	// DriftDetection is the configuration for detecting drift of the files and units on the nodes from the last applied
+	// operating system config. If not set, drift detection is disabled.
+	// +optional
+	DriftDetection *nodeagentconfigv1alpha1.DriftDetectionConfig `json:"driftDetection,omitempty"`
+	// Reboot is the configuration for coordinated reboots of the nodes. If not set, nodes are rebooted immediately when
+	// required.
+	// +optional
+	Reboot *nodeagentconfigv1alpha1.RebootControllerConfig `json:"reboot,omitempty"`
+}
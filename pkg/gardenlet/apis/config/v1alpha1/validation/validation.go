@@ -7,6 +7,7 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -21,6 +22,7 @@ import (
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	nodeagentvalidation "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1/validation"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
 
@@ -49,6 +51,9 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		if cfg.Controllers.Bastion != nil {
 			allErrs = append(allErrs, validateBastionControllerConfiguration(cfg.Controllers.Bastion, fldPath.Child("controllers", "bastion"))...)
 		}
+		if cfg.Controllers.ControllerInstallationCare != nil {
+			allErrs = append(allErrs, validateControllerInstallationCareControllerConfiguration(cfg.Controllers.ControllerInstallationCare, fldPath.Child("controllers", "controllerInstallationCare"))...)
+		}
 		if cfg.Controllers.Shoot != nil {
 			allErrs = append(allErrs, validateShootControllerConfiguration(cfg.Controllers.Shoot, fldPath.Child("controllers", "shoot"))...)
 		}
@@ -126,6 +131,22 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		}
 	}
 
+	if cfg.Logging != nil && cfg.Logging.Vali != nil && cfg.Logging.Vali.Shoot != nil {
+		allErrs = append(allErrs, validateShootValiConfig(cfg.Logging.Vali.Shoot, fldPath.Child("logging", "vali", "shoot"))...)
+	}
+
+	if cfg.Logging != nil && cfg.Logging.ShootTelemetryExport != nil {
+		allErrs = append(allErrs, validateShootTelemetryExport(cfg.Logging.ShootTelemetryExport, fldPath.Child("logging", "shootTelemetryExport"))...)
+	}
+
+	if cfg.Monitoring != nil && cfg.Monitoring.Shoot != nil && cfg.Monitoring.Shoot.OwnerRemoteWrite != nil {
+		allErrs = append(allErrs, validateOwnerRemoteWriteConfig(cfg.Monitoring.Shoot.OwnerRemoteWrite, fldPath.Child("monitoring", "shoot", "ownerRemoteWrite"))...)
+	}
+
+	if cfg.Monitoring != nil && cfg.Monitoring.Shoot != nil && cfg.Monitoring.Shoot.CardinalityGuard != nil {
+		allErrs = append(allErrs, validateCardinalityGuardConfig(cfg.Monitoring.Shoot.CardinalityGuard, fldPath.Child("monitoring", "shoot", "cardinalityGuard"))...)
+	}
+
 	if nodeTolerationCfg := cfg.NodeToleration; nodeTolerationCfg != nil {
 		nodeTolerationConfigPath := fldPath.Child("nodeToleration")
 
@@ -133,6 +154,10 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if cfg.NodeAgent != nil {
+		allErrs = append(allErrs, validateNodeAgentConfig(cfg.NodeAgent, fldPath.Child("nodeAgent"))...)
+	}
+
 	return allErrs
 }
 
@@ -243,6 +268,20 @@ func validateShootControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCont
 	return allErrs
 }
 
+func validateControllerInstallationCareControllerConfiguration(cfg *gardenletconfigv1alpha1.ControllerInstallationCareControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.HeartbeatStaleThreshold != nil && cfg.HeartbeatStaleThreshold.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("heartbeatStaleThreshold"), cfg.HeartbeatStaleThreshold.Duration.String(), "must be positive"))
+	}
+
+	if cfg.Remediation != nil && cfg.Remediation.RollbackTimeout != nil && cfg.Remediation.RollbackTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("remediation", "rollbackTimeout"), cfg.Remediation.RollbackTimeout.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
 func validateShootCareControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCareControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -266,6 +305,10 @@ func validateShootCareControllerConfiguration(cfg *gardenletconfigv1alpha1.Shoot
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ConditionThresholds[i].Duration.Duration), fldPath.Child("conditionThresholds").Index(i).Child("duration"))...)
 	}
 
+	if cfg.AvailabilityTracking != nil && cfg.AvailabilityTracking.Window != nil && cfg.AvailabilityTracking.Window.Duration < time.Hour {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("availabilityTracking", "window"), cfg.AvailabilityTracking.Window.Duration.String(), "must be at least 1h"))
+	}
+
 	return allErrs
 }
 
@@ -327,6 +370,124 @@ func validateBackupEntryControllerConfiguration(cfg *gardenletconfigv1alpha1.Bac
 	return allErrs
 }
 
+func validateShootValiConfig(cfg *gardenletconfigv1alpha1.ShootVali, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, limits := range cfg.Limits {
+		idxPath := fldPath.Child("limits").Index(i)
+
+		for j, purpose := range limits.ShootPurposes {
+			if !availableShootPurposes.Has(string(purpose)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("shootPurposes").Index(j), purpose, sets.List(availableShootPurposes)))
+			}
+		}
+
+		if limits.RetentionPeriod != nil {
+			// Vali deletes logs per index table which covers a period of 24h.
+			if retentionPeriod := limits.RetentionPeriod.Duration; retentionPeriod < 24*time.Hour || retentionPeriod%(24*time.Hour) != 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("retentionPeriod"), retentionPeriod.String(), "must be a positive multiple of 24h"))
+			}
+		}
+
+		for _, limit := range []struct {
+			name  string
+			value *int32
+		}{
+			{"ingestionRateMB", limits.IngestionRateMB},
+			{"ingestionBurstSizeMB", limits.IngestionBurstSizeMB},
+			{"maxLinesPerSecond", limits.MaxLinesPerSecond},
+		} {
+			if limit.value != nil && *limit.value <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child(limit.name), *limit.value, "must be positive"))
+			}
+		}
+
+		if limits.IngestionRateMB != nil && limits.IngestionBurstSizeMB != nil && *limits.IngestionBurstSizeMB < *limits.IngestionRateMB {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ingestionBurstSizeMB"), *limits.IngestionBurstSizeMB, "must not be smaller than ingestionRateMB"))
+		}
+	}
+
+	return allErrs
+}
+
+var availableTelemetrySignals = sets.New("logs", "traces")
+
+func validateShootTelemetryExport(cfg *gardenletconfigv1alpha1.ShootTelemetryExport, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), "must provide an endpoint"))
+	} else if u, err := url.Parse(cfg.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), cfg.Endpoint, "must be an http or https URL"))
+	}
+
+	for i, signal := range cfg.Signals {
+		if !availableTelemetrySignals.Has(signal) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("signals").Index(i), signal, sets.List(availableTelemetrySignals)))
+		}
+	}
+
+	if v := cfg.SamplingRatePerMillion; v != nil && (*v <= 0 || *v > 1000000) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("samplingRatePerMillion"), *v, "must be between 1 and 1000000"))
+	}
+
+	return allErrs
+}
+
+func validateOwnerRemoteWriteConfig(cfg *gardenletconfigv1alpha1.OwnerRemoteWriteConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, limit := range []struct {
+		name  string
+		value *int32
+	}{
+		{"maxTargets", cfg.MaxTargets},
+		{"maxKeptMetrics", cfg.MaxKeptMetrics},
+		{"maxShards", cfg.MaxShards},
+		{"maxSamplesPerSend", cfg.MaxSamplesPerSend},
+	} {
+		if limit.value != nil && *limit.value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(limit.name), *limit.value, "must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCardinalityGuardConfig(cfg *gardenletconfigv1alpha1.CardinalityGuardConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, limit := range []struct {
+		name  string
+		value *int64
+	}{
+		{"sampleLimit", cfg.SampleLimit},
+		{"federationSampleLimit", cfg.FederationSampleLimit},
+	} {
+		if limit.value != nil && *limit.value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(limit.name), *limit.value, "must be positive"))
+		}
+	}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateNodeAgentConfig(cfg *gardenletconfigv1alpha1.NodeAgentConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.HealthCheck != nil {
+		allErrs = append(allErrs, nodeagentvalidation.ValidateHealthCheckControllerConfiguration(*cfg.HealthCheck, fldPath.Child("healthCheck"))...)
+	}
+	allErrs = append(allErrs, nodeagentvalidation.ValidateDriftDetectionConfiguration(cfg.DriftDetection, fldPath.Child("driftDetection"))...)
+	allErrs = append(allErrs, nodeagentvalidation.ValidateRebootControllerConfiguration(cfg.Reboot, fldPath.Child("reboot"))...)
+
+	return allErrs
+}
+
 func validateBastionControllerConfiguration(cfg *gardenletconfigv1alpha1.BastionControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
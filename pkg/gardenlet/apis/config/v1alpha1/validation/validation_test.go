@@ -20,6 +20,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/validation"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 )
 
 var _ = Describe("GardenletConfiguration", func() {
@@ -347,6 +348,232 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("controllerInstallationCare controller", func() {
+			It("should allow valid configuration", func() {
+				cfg.Controllers.ControllerInstallationCare = &gardenletconfigv1alpha1.ControllerInstallationCareControllerConfiguration{
+					HeartbeatStaleThreshold: &metav1.Duration{Duration: 5 * time.Minute},
+					Remediation: &gardenletconfigv1alpha1.ControllerInstallationRemediation{
+						RestartOnStaleHeartbeat: ptr.To(true),
+						RollbackTimeout:         &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ControllerInstallationCare = &gardenletconfigv1alpha1.ControllerInstallationCareControllerConfiguration{
+					HeartbeatStaleThreshold: &metav1.Duration{},
+					Remediation: &gardenletconfigv1alpha1.ControllerInstallationRemediation{
+						RollbackTimeout: &metav1.Duration{Duration: -1},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.controllerInstallationCare.heartbeatStaleThreshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.controllerInstallationCare.remediation.rollbackTimeout"),
+					})),
+				))
+			})
+		})
+
+		Context("owner remote write", func() {
+			It("should allow valid configuration", func() {
+				cfg.Monitoring = &gardenletconfigv1alpha1.MonitoringConfig{Shoot: &gardenletconfigv1alpha1.ShootMonitoringConfig{
+					OwnerRemoteWrite: &gardenletconfigv1alpha1.OwnerRemoteWriteConfig{
+						MaxTargets:        ptr.To[int32](2),
+						MaxKeptMetrics:    ptr.To[int32](100),
+						MaxShards:         ptr.To[int32](2),
+						MaxSamplesPerSend: ptr.To[int32](500),
+					},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid non-positive limits", func() {
+				cfg.Monitoring = &gardenletconfigv1alpha1.MonitoringConfig{Shoot: &gardenletconfigv1alpha1.ShootMonitoringConfig{
+					OwnerRemoteWrite: &gardenletconfigv1alpha1.OwnerRemoteWriteConfig{
+						MaxTargets:        ptr.To[int32](0),
+						MaxKeptMetrics:    ptr.To[int32](-1),
+						MaxShards:         ptr.To[int32](1),
+						MaxSamplesPerSend: ptr.To[int32](0),
+					},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.ownerRemoteWrite.maxTargets"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.ownerRemoteWrite.maxKeptMetrics"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.ownerRemoteWrite.maxSamplesPerSend"),
+					})),
+				))
+			})
+		})
+
+		Context("cardinality guard", func() {
+			It("should allow valid configuration", func() {
+				cfg.Monitoring = &gardenletconfigv1alpha1.MonitoringConfig{Shoot: &gardenletconfigv1alpha1.ShootMonitoringConfig{
+					CardinalityGuard: &gardenletconfigv1alpha1.CardinalityGuardConfig{
+						SampleLimit:           ptr.To[int64](50000),
+						FederationSampleLimit: ptr.To[int64](10000),
+						SyncPeriod:            &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid non-positive values", func() {
+				cfg.Monitoring = &gardenletconfigv1alpha1.MonitoringConfig{Shoot: &gardenletconfigv1alpha1.ShootMonitoringConfig{
+					CardinalityGuard: &gardenletconfigv1alpha1.CardinalityGuardConfig{
+						SampleLimit:           ptr.To[int64](0),
+						FederationSampleLimit: ptr.To[int64](-1),
+						SyncPeriod:            &metav1.Duration{},
+					},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.cardinalityGuard.sampleLimit"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.cardinalityGuard.federationSampleLimit"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("monitoring.shoot.cardinalityGuard.syncPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot vali limits", func() {
+			It("should allow valid configuration", func() {
+				cfg.Logging = &gardenletconfigv1alpha1.Logging{Vali: &gardenletconfigv1alpha1.Vali{Shoot: &gardenletconfigv1alpha1.ShootVali{
+					Limits: []gardenletconfigv1alpha1.ValiLimits{
+						{
+							ShootPurposes:        []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation},
+							RetentionPeriod:      &metav1.Duration{Duration: 72 * time.Hour},
+							IngestionRateMB:      ptr.To[int32](1),
+							IngestionBurstSizeMB: ptr.To[int32](2),
+							MaxLinesPerSecond:    ptr.To[int32](200),
+						},
+						{
+							RetentionPeriod: &metav1.Duration{Duration: 336 * time.Hour},
+						},
+					},
+				}}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Logging = &gardenletconfigv1alpha1.Logging{Vali: &gardenletconfigv1alpha1.Vali{Shoot: &gardenletconfigv1alpha1.ShootVali{
+					Limits: []gardenletconfigv1alpha1.ValiLimits{
+						{
+							ShootPurposes:        []gardencorev1beta1.ShootPurpose{"does-not-exist"},
+							RetentionPeriod:      &metav1.Duration{Duration: 36 * time.Hour},
+							IngestionRateMB:      ptr.To[int32](4),
+							IngestionBurstSizeMB: ptr.To[int32](2),
+							MaxLinesPerSecond:    ptr.To[int32](0),
+						},
+						{
+							RetentionPeriod: &metav1.Duration{Duration: 12 * time.Hour},
+							IngestionRateMB: ptr.To[int32](-1),
+						},
+					},
+				}}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("logging.vali.shoot.limits[0].shootPurposes[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.vali.shoot.limits[0].retentionPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.vali.shoot.limits[0].maxLinesPerSecond"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.vali.shoot.limits[0].ingestionBurstSizeMB"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.vali.shoot.limits[1].retentionPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.vali.shoot.limits[1].ingestionRateMB"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot telemetry export", func() {
+			It("should allow valid configuration", func() {
+				cfg.Logging = &gardenletconfigv1alpha1.Logging{ShootTelemetryExport: &gardenletconfigv1alpha1.ShootTelemetryExport{
+					Endpoint:               "http://otel-gateway.telemetry.svc.cluster.local:4318",
+					Signals:                []string{"traces"},
+					SamplingRatePerMillion: ptr.To[int32](10000),
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Logging = &gardenletconfigv1alpha1.Logging{ShootTelemetryExport: &gardenletconfigv1alpha1.ShootTelemetryExport{
+					Endpoint:               "otel-gateway:4318",
+					Signals:                []string{"logs", "metrics"},
+					SamplingRatePerMillion: ptr.To[int32](1000001),
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.shootTelemetryExport.endpoint"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("logging.shootTelemetryExport.signals[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.shootTelemetryExport.samplingRatePerMillion"),
+					})),
+				))
+			})
+
+			It("should require the endpoint", func() {
+				cfg.Logging = &gardenletconfigv1alpha1.Logging{ShootTelemetryExport: &gardenletconfigv1alpha1.ShootTelemetryExport{}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("logging.shootTelemetryExport.endpoint"),
+					})),
+				))
+			})
+		})
+
 		Context("shootCare controller", func() {
 			It("should forbid invalid configuration", func() {
 				invalidConcurrentSyncs := -1
@@ -356,6 +583,7 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.ShootCare.StaleExtensionHealthChecks = &gardenletconfigv1alpha1.StaleExtensionHealthChecks{Threshold: &metav1.Duration{Duration: -1}}
 				cfg.Controllers.ShootCare.ManagedResourceProgressingThreshold = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ShootCare.ConditionThresholds = []gardenletconfigv1alpha1.ConditionThreshold{{Duration: metav1.Duration{Duration: -1}}}
+				cfg.Controllers.ShootCare.AvailabilityTracking = &gardenletconfigv1alpha1.ShootAvailabilityTracking{Window: &metav1.Duration{Duration: 30 * time.Minute}}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil, false)
 
@@ -380,6 +608,10 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.shootCare.conditionThresholds[0].duration"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.availabilityTracking.window"),
+					})),
 				))
 			})
 		})
@@ -719,6 +951,104 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("nodeAgent", func() {
+			It("should pass with valid health checks", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfig{
+					HealthCheck: &nodeagentconfigv1alpha1.HealthCheckControllerConfig{
+						Checks: []nodeagentconfigv1alpha1.HealthCheck{{
+							Name:        "sshd",
+							SystemdUnit: &nodeagentconfigv1alpha1.SystemdUnitHealthCheck{UnitName: "sshd.service"},
+							Remediation: &nodeagentconfigv1alpha1.HealthCheckRemediation{Action: nodeagentconfigv1alpha1.RemediationActionRestartUnit},
+						}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid health checks", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfig{
+					HealthCheck: &nodeagentconfigv1alpha1.HealthCheckControllerConfig{
+						SyncPeriod: &metav1.Duration{Duration: time.Second},
+						Checks: []nodeagentconfigv1alpha1.HealthCheck{{
+							Name: "data",
+							Path: &nodeagentconfigv1alpha1.PathHealthCheck{Path: "data"},
+						}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.healthCheck.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.healthCheck.checks[0].path.path"),
+					})),
+				))
+			})
+
+			It("should pass with a valid drift detection", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfig{
+					DriftDetection: &nodeagentconfigv1alpha1.DriftDetectionConfig{
+						SyncPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+						Reapply:    true,
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with a too short drift detection sync period", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfig{
+					DriftDetection: &nodeagentconfigv1alpha1.DriftDetectionConfig{
+						SyncPeriod: &metav1.Duration{Duration: 30 * time.Second},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.driftDetection.syncPeriod"),
+					})),
+				))
+			})
+
+			It("should pass with valid coordinated reboots", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfig{
+					Reboot: &nodeagentconfigv1alpha1.RebootControllerConfig{
+						MaxConcurrentReboots: ptr.To[int32](2),
+						MaintenanceWindow:    &nodeagentconfigv1alpha1.MaintenanceWindow{Begin: "220000+0100", End: "230000+0100"},
+						DrainTimeout:         &metav1.Duration{Duration: 10 * time.Minute},
+						LeaseDuration:        &metav1.Duration{Duration: 30 * time.Minute},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid coordinated reboots", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfig{
+					Reboot: &nodeagentconfigv1alpha1.RebootControllerConfig{
+						MaxConcurrentReboots: ptr.To[int32](0),
+						MaintenanceWindow:    &nodeagentconfigv1alpha1.MaintenanceWindow{Begin: "foo", End: "230000+0100"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.reboot.maxConcurrentReboots"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.reboot.maintenanceWindow"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
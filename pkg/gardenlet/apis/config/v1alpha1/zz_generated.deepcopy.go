@@ -11,6 +11,7 @@ package v1alpha1
 
 import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	apisconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -131,6 +132,37 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CardinalityGuardConfig) DeepCopyInto(out *CardinalityGuardConfig) {
+	*out = *in
+	if in.SampleLimit != nil {
+		in, out := &in.SampleLimit, &out.SampleLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FederationSampleLimit != nil {
+		in, out := &in.FederationSampleLimit, &out.FederationSampleLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CardinalityGuardConfig.
+func (in *CardinalityGuardConfig) DeepCopy() *CardinalityGuardConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CardinalityGuardConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -161,6 +193,16 @@ func (in *ControllerInstallationCareControllerConfiguration) DeepCopyInto(out *C
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.HeartbeatStaleThreshold != nil {
+		in, out := &in.HeartbeatStaleThreshold, &out.HeartbeatStaleThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(ControllerInstallationRemediation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -195,6 +237,32 @@ func (in *ControllerInstallationControllerConfiguration) DeepCopy() *ControllerI
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerInstallationRemediation) DeepCopyInto(out *ControllerInstallationRemediation) {
+	*out = *in
+	if in.RestartOnStaleHeartbeat != nil {
+		in, out := &in.RestartOnStaleHeartbeat, &out.RestartOnStaleHeartbeat
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RollbackTimeout != nil {
+		in, out := &in.RollbackTimeout, &out.RollbackTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerInstallationRemediation.
+func (in *ControllerInstallationRemediation) DeepCopy() *ControllerInstallationRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerInstallationRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerInstallationRequiredControllerConfiguration) DeepCopyInto(out *ControllerInstallationRequiredControllerConfiguration) {
 	*out = *in
@@ -501,6 +569,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeAgent != nil {
+		in, out := &in.NodeAgent, &out.NodeAgent
+		*out = new(NodeAgentConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -726,6 +799,11 @@ func (in *Logging) DeepCopyInto(out *Logging) {
 		*out = new(ShootEventLogging)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootTelemetryExport != nil {
+		in, out := &in.ShootTelemetryExport, &out.ShootTelemetryExport
+		*out = new(ShootTelemetryExport)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -829,6 +907,37 @@ func (in *NetworkPolicyControllerConfiguration) DeepCopy() *NetworkPolicyControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAgentConfig) DeepCopyInto(out *NodeAgentConfig) {
+	*out = *in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(apisconfigv1alpha1.HealthCheckControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(apisconfigv1alpha1.DriftDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reboot != nil {
+		in, out := &in.Reboot, &out.Reboot
+		*out = new(apisconfigv1alpha1.RebootControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAgentConfig.
+func (in *NodeAgentConfig) DeepCopy() *NodeAgentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAgentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeToleration) DeepCopyInto(out *NodeToleration) {
 	*out = *in
@@ -855,6 +964,42 @@ func (in *NodeToleration) DeepCopy() *NodeToleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnerRemoteWriteConfig) DeepCopyInto(out *OwnerRemoteWriteConfig) {
+	*out = *in
+	if in.MaxTargets != nil {
+		in, out := &in.MaxTargets, &out.MaxTargets
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxKeptMetrics != nil {
+		in, out := &in.MaxKeptMetrics, &out.MaxKeptMetrics
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxShards != nil {
+		in, out := &in.MaxShards, &out.MaxShards
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSamplesPerSend != nil {
+		in, out := &in.MaxSamplesPerSend, &out.MaxSamplesPerSend
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnerRemoteWriteConfig.
+func (in *OwnerRemoteWriteConfig) DeepCopy() *OwnerRemoteWriteConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnerRemoteWriteConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteWriteMonitoringConfig) DeepCopyInto(out *RemoteWriteMonitoringConfig) {
 	*out = *in
@@ -1098,6 +1243,27 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAvailabilityTracking) DeepCopyInto(out *ShootAvailabilityTracking) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAvailabilityTracking.
+func (in *ShootAvailabilityTracking) DeepCopy() *ShootAvailabilityTracking {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAvailabilityTracking)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
@@ -1131,6 +1297,11 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AvailabilityTracking != nil {
+		in, out := &in.AvailabilityTracking, &out.AvailabilityTracking
+		*out = new(ShootAvailabilityTracking)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1253,6 +1424,16 @@ func (in *ShootMonitoringConfig) DeepCopyInto(out *ShootMonitoringConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.OwnerRemoteWrite != nil {
+		in, out := &in.OwnerRemoteWrite, &out.OwnerRemoteWrite
+		*out = new(OwnerRemoteWriteConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CardinalityGuard != nil {
+		in, out := &in.CardinalityGuard, &out.CardinalityGuard
+		*out = new(CardinalityGuardConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1334,6 +1515,55 @@ func (in *ShootStatusControllerConfiguration) DeepCopy() *ShootStatusControllerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootTelemetryExport) DeepCopyInto(out *ShootTelemetryExport) {
+	*out = *in
+	if in.Signals != nil {
+		in, out := &in.Signals, &out.Signals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SamplingRatePerMillion != nil {
+		in, out := &in.SamplingRatePerMillion, &out.SamplingRatePerMillion
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootTelemetryExport.
+func (in *ShootTelemetryExport) DeepCopy() *ShootTelemetryExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootTelemetryExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootVali) DeepCopyInto(out *ShootVali) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]ValiLimits, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootVali.
+func (in *ShootVali) DeepCopy() *ShootVali {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootVali)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaleExtensionHealthChecks) DeepCopyInto(out *StaleExtensionHealthChecks) {
 	*out = *in
@@ -1431,6 +1661,11 @@ func (in *Vali) DeepCopyInto(out *Vali) {
 		*out = new(GardenVali)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(ShootVali)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1443,3 +1678,44 @@ func (in *Vali) DeepCopy() *Vali {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValiLimits) DeepCopyInto(out *ValiLimits) {
+	*out = *in
+	if in.ShootPurposes != nil {
+		in, out := &in.ShootPurposes, &out.ShootPurposes
+		*out = make([]v1beta1.ShootPurpose, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IngestionRateMB != nil {
+		in, out := &in.IngestionRateMB, &out.IngestionRateMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IngestionBurstSizeMB != nil {
+		in, out := &in.IngestionBurstSizeMB, &out.IngestionBurstSizeMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLinesPerSecond != nil {
+		in, out := &in.MaxLinesPerSecond, &out.MaxLinesPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValiLimits.
+func (in *ValiLimits) DeepCopy() *ValiLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ValiLimits)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -73,6 +73,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			if in.Controllers.ShootCare.StaleExtensionHealthChecks != nil {
 				SetDefaults_StaleExtensionHealthChecks(in.Controllers.ShootCare.StaleExtensionHealthChecks)
 			}
+			if in.Controllers.ShootCare.AvailabilityTracking != nil {
+				SetDefaults_ShootAvailabilityTracking(in.Controllers.ShootCare.AvailabilityTracking)
+			}
 		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
@@ -131,6 +134,12 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		SetDefaults_MonitoringConfig(in.Monitoring)
 		if in.Monitoring.Shoot != nil {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
+			if in.Monitoring.Shoot.OwnerRemoteWrite != nil {
+				SetDefaults_OwnerRemoteWriteConfig(in.Monitoring.Shoot.OwnerRemoteWrite)
+			}
+			if in.Monitoring.Shoot.CardinalityGuard != nil {
+				SetDefaults_CardinalityGuardConfig(in.Monitoring.Shoot.CardinalityGuard)
+			}
 		}
 	}
 }
@@ -7,11 +7,18 @@ package care
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -20,8 +27,12 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
 )
 
 // Reconciler reconciles ControllerInstallations, checks their health status and reports it via conditions.
@@ -107,6 +118,32 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionFalse, "ControllerRolledOut", "The controller has been rolled out successfully.")
 	}
 
+	heartbeatStale, err := r.checkHeartbeat(seedCtx, log, controllerInstallation, managedResource)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if heartbeatStale != "" {
+		conditionControllerInstallationHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationHealthy, gardencorev1beta1.ConditionFalse, gardencorev1beta1.ControllerInstallationReasonHeartbeatStale, heartbeatStale)
+	}
+
+	if conditionControllerInstallationInstalled.Status == gardencorev1beta1.ConditionTrue &&
+		conditionControllerInstallationHealthy.Status == gardencorev1beta1.ConditionTrue &&
+		conditionControllerInstallationProgressing.Status == gardencorev1beta1.ConditionFalse {
+		if err := r.snapshotRevision(seedCtx, controllerInstallation, managedResource); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to snapshot healthy revision: %w", err)
+		}
+	} else if since := failingSince(conditionControllerInstallationInstalled, conditionControllerInstallationHealthy); since != nil && r.Config.Remediation != nil && r.Config.Remediation.RollbackTimeout != nil {
+		if err := r.rollbackRevision(seedCtx, log, controllerInstallation, managedResource, *since); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to roll back revision: %w", err)
+		}
+	}
+
+	if msg, err := r.rolledBackMessage(seedCtx, controllerInstallation, managedResource); err != nil {
+		return reconcile.Result{}, err
+	} else if msg != "" {
+		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionTrue, utils.ReasonControllerRolledBack, msg)
+	}
+
 	patch := client.StrategicMergeFrom(controllerInstallation.DeepCopy())
 	controllerInstallation.Status.Conditions = v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, conditionControllerInstallationHealthy, conditionControllerInstallationInstalled, conditionControllerInstallationProgressing)
 	if err := r.GardenClient.Status().Patch(gardenCtx, controllerInstallation, patch); err != nil {
@@ -115,3 +152,238 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
+
+// checkHeartbeat checks whether the heartbeat Lease of the extension was renewed within the configured threshold. It
+// returns a message describing the problem if the heartbeat is stale. Extensions which do not maintain a heartbeat
+// Lease are not checked. If configured, the pods of the Deployment maintaining a stale heartbeat are restarted.
+func (r *Reconciler) checkHeartbeat(ctx context.Context, log logr.Logger, controllerInstallation *gardencorev1beta1.ControllerInstallation, managedResource *resourcesv1alpha1.ManagedResource) (string, error) {
+	if r.Config.HeartbeatStaleThreshold == nil {
+		return "", nil
+	}
+
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      extensions.HeartBeatResourceName,
+			Namespace: gardenerutils.NamespaceNameForControllerInstallation(controllerInstallation),
+		},
+	}
+
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get heartbeat Lease %q: %w", client.ObjectKeyFromObject(lease), err)
+	}
+
+	renewTime := lease.CreationTimestamp.Time
+	if lease.Spec.RenewTime != nil {
+		renewTime = lease.Spec.RenewTime.Time
+	}
+
+	threshold := r.Config.HeartbeatStaleThreshold.Duration
+	if r.Clock.Since(renewTime) <= threshold {
+		return "", nil
+	}
+
+	msg := fmt.Sprintf("The extension did not renew its heartbeat Lease %q since %s (threshold: %s).", client.ObjectKeyFromObject(lease), renewTime.UTC().Format(time.RFC3339), threshold)
+
+	if r.Config.Remediation == nil || !ptr.Deref(r.Config.Remediation.RestartOnStaleHeartbeat, false) {
+		return msg, nil
+	}
+
+	if restartedAt, err := time.Parse(time.RFC3339, lease.Annotations[utils.AnnotationKeyRestartedAt]); err == nil && r.Clock.Since(restartedAt) < threshold {
+		return msg + fmt.Sprintf(" The extension pods were restarted at %s.", restartedAt.Format(time.RFC3339)), nil
+	}
+
+	deployment, err := r.heartbeatDeployment(ctx, managedResource, lease)
+	if err != nil {
+		return "", err
+	}
+	if deployment == nil {
+		return msg + " The extension pods were not restarted since the Deployment maintaining the heartbeat could not be determined.", nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil || selector.Empty() {
+		return msg + fmt.Sprintf(" The extension pods were not restarted since the Deployment %q has no valid pod selector.", client.ObjectKeyFromObject(deployment)), nil
+	}
+
+	log.Info("Restarting extension pods because of stale heartbeat", "deployment", client.ObjectKeyFromObject(deployment))
+	if err := r.SeedClient.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(deployment.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return "", fmt.Errorf("failed to restart pods of extension Deployment %q: %w", client.ObjectKeyFromObject(deployment), err)
+	}
+
+	now := r.Clock.Now().UTC()
+	patch := client.MergeFrom(lease.DeepCopy())
+	metav1.SetMetaDataAnnotation(&lease.ObjectMeta, utils.AnnotationKeyRestartedAt, now.Format(time.RFC3339))
+	if err := r.SeedClient.Patch(ctx, lease, patch); err != nil {
+		return "", fmt.Errorf("failed to record restart in heartbeat Lease %q: %w", client.ObjectKeyFromObject(lease), err)
+	}
+
+	return msg + fmt.Sprintf(" The extension pods were restarted at %s.", now.Format(time.RFC3339)), nil
+}
+
+// heartbeatDeployment returns the Deployment of the extension which maintains the given heartbeat Lease. It is
+// determined from the Deployments managed by the ManagedResource in the namespace of the Lease. If there are multiple,
+// the one named after the holder identity of the Lease is chosen. It returns nil if the Deployment cannot be determined.
+func (r *Reconciler) heartbeatDeployment(ctx context.Context, managedResource *resourcesv1alpha1.ManagedResource, lease *coordinationv1.Lease) (*appsv1.Deployment, error) {
+	var names []string
+	for _, ref := range managedResource.Status.Resources {
+		if ref.GroupVersionKind().GroupKind() == appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind() && ref.Namespace == lease.Namespace {
+			names = append(names, ref.Name)
+		}
+	}
+
+	if holderIdentity := ptr.Deref(lease.Spec.HolderIdentity, ""); len(names) > 1 && holderIdentity != "" {
+		names = slices.DeleteFunc(names, func(name string) bool {
+			return name != holderIdentity && !strings.HasSuffix(name, "-"+holderIdentity)
+		})
+	}
+
+	if len(names) != 1 {
+		return nil, nil
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: names[0], Namespace: lease.Namespace}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get extension Deployment %q: %w", client.ObjectKey{Name: names[0], Namespace: lease.Namespace}, err)
+	}
+
+	return deployment, nil
+}
+
+// failingSince returns the earliest time since which one of the given conditions is False, i.e. the controller is not
+// installed or not healthy. It returns nil if none of the conditions is False, e.g. while a new revision is only
+// progressing.
+func failingSince(conditions ...gardencorev1beta1.Condition) *time.Time {
+	var since *time.Time
+	for _, condition := range conditions {
+		if condition.Status != gardencorev1beta1.ConditionFalse {
+			continue
+		}
+		if since == nil || condition.LastTransitionTime.Time.Before(*since) {
+			since = ptr.To(condition.LastTransitionTime.Time)
+		}
+	}
+	return since
+}
+
+// snapshotRevision stores the data of the current revision of the ManagedResource in the last-healthy Secret so that
+// it can be rolled back to if a later revision does not become healthy.
+func (r *Reconciler) snapshotRevision(ctx context.Context, controllerInstallation *gardencorev1beta1.ControllerInstallation, managedResource *resourcesv1alpha1.ManagedResource) error {
+	if len(managedResource.Spec.SecretRefs) == 0 {
+		return nil
+	}
+
+	var (
+		revision    = managedResource.Spec.SecretRefs[0].Name
+		lastHealthy = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: utils.LastHealthySecretName(controllerInstallation.Name), Namespace: r.GardenNamespace}}
+	)
+
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(lastHealthy), lastHealthy); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else if lastHealthy.Annotations[utils.AnnotationKeyRevision] == revision {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: revision, Namespace: managedResource.Namespace}, secret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.SeedClient, lastHealthy, func() error {
+		lastHealthy.Labels = map[string]string{utils.LabelKeyControllerInstallationName: controllerInstallation.Name}
+		lastHealthy.Annotations = map[string]string{utils.AnnotationKeyRevision: revision}
+		lastHealthy.Type = corev1.SecretTypeOpaque
+		lastHealthy.Data = secret.Data
+		return nil
+	})
+	return err
+}
+
+// rollbackRevision rolls back the ManagedResource to the revision stored in the last-healthy Secret if the current
+// revision exists and has been failing for at least the configured rollback timeout.
+func (r *Reconciler) rollbackRevision(ctx context.Context, log logr.Logger, controllerInstallation *gardencorev1beta1.ControllerInstallation, managedResource *resourcesv1alpha1.ManagedResource, failingSince time.Time) error {
+	if len(managedResource.Spec.SecretRefs) == 0 {
+		return nil
+	}
+
+	var (
+		revision    = managedResource.Spec.SecretRefs[0].Name
+		lastHealthy = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: utils.LastHealthySecretName(controllerInstallation.Name), Namespace: r.GardenNamespace}}
+	)
+
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(lastHealthy), lastHealthy); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if lastHealthy.Annotations[utils.AnnotationKeyRevision] == revision {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: revision, Namespace: managedResource.Namespace}, secret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	// Failures which started before the revision was created are not caused by it, hence the timeout starts at the later
+	// of both times.
+	if secret.CreationTimestamp.After(failingSince) {
+		failingSince = secret.CreationTimestamp.Time
+	}
+	if r.Clock.Since(failingSince) < r.Config.Remediation.RollbackTimeout.Duration {
+		return nil
+	}
+
+	log.Info("Revision did not become healthy in time, rolling back to last healthy revision", "revision", revision, "lastHealthyRevision", lastHealthy.Annotations[utils.AnnotationKeyRevision])
+
+	// Record the rolled back revision first so that the ControllerInstallation reconciler does not re-apply it.
+	patch := client.MergeFrom(lastHealthy.DeepCopy())
+	metav1.SetMetaDataAnnotation(&lastHealthy.ObjectMeta, utils.AnnotationKeyRolledBackRevision, revision)
+	if err := r.SeedClient.Patch(ctx, lastHealthy, patch); err != nil {
+		return err
+	}
+
+	if err := managedresources.Update(
+		ctx,
+		r.SeedClient,
+		managedResource.Namespace,
+		managedResource.Name,
+		managedResource.Labels,
+		false,
+		ptr.Deref(managedResource.Spec.Class, ""),
+		lastHealthy.Data,
+		managedResource.Spec.KeepObjects,
+		managedResource.Spec.InjectLabels,
+		managedResource.Spec.ForceOverwriteAnnotations,
+	); err != nil {
+		return err
+	}
+
+	return r.SeedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)
+}
+
+// rolledBackMessage returns a message describing the rollback if the ManagedResource currently runs the last healthy
+// revision because a later revision was rolled back.
+func (r *Reconciler) rolledBackMessage(ctx context.Context, controllerInstallation *gardencorev1beta1.ControllerInstallation, managedResource *resourcesv1alpha1.ManagedResource) (string, error) {
+	if len(managedResource.Spec.SecretRefs) == 0 {
+		return "", nil
+	}
+
+	lastHealthy := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: utils.LastHealthySecretName(controllerInstallation.Name), Namespace: r.GardenNamespace}}
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(lastHealthy), lastHealthy); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+
+	rolledBackRevision, ok := lastHealthy.Annotations[utils.AnnotationKeyRolledBackRevision]
+	if !ok || lastHealthy.Annotations[utils.AnnotationKeyRevision] != managedResource.Spec.SecretRefs[0].Name {
+		return "", nil
+	}
+
+	return utils.RolledBackMessage(rolledBackRevision, lastHealthy.Annotations[utils.AnnotationKeyRevision]), nil
+}
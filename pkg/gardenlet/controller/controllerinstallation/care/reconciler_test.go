@@ -11,10 +11,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -24,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/care"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -152,6 +156,239 @@ var _ = Describe("Reconciler", func() {
 				),
 			),
 		)
+
+		Context("heartbeat", func() {
+			var lease *coordinationv1.Lease
+
+			BeforeEach(func() {
+				reconciler.(*Reconciler).Config.HeartbeatStaleThreshold = &metav1.Duration{Duration: 5 * time.Minute}
+
+				lease = &coordinationv1.Lease{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gardener-extension-heartbeat",
+						Namespace: "extension-" + controllerInstallationName,
+					},
+				}
+
+				Expect(seedClient.Create(ctx, healthyManagedResource())).To(Succeed())
+			})
+
+			It("should not check the heartbeat if the extension does not maintain a heartbeat lease", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				Expect(controllerInstallation.Status.Conditions).To(ContainElement(conditionWithTypeStatusAndReason(gardencorev1beta1.ControllerInstallationHealthy, gardencorev1beta1.ConditionTrue, "ControllerHealthy")))
+			})
+
+			It("should keep the controller healthy if the heartbeat is fresh", func() {
+				lease.Spec.RenewTime = &metav1.MicroTime{Time: fakeClock.Now().Add(-time.Minute)}
+				Expect(seedClient.Create(ctx, lease)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				Expect(controllerInstallation.Status.Conditions).To(ContainElement(conditionWithTypeStatusAndReason(gardencorev1beta1.ControllerInstallationHealthy, gardencorev1beta1.ConditionTrue, "ControllerHealthy")))
+			})
+
+			It("should set the controller unhealthy if the heartbeat is stale", func() {
+				lease.Spec.RenewTime = &metav1.MicroTime{Time: fakeClock.Now().Add(-10 * time.Minute)}
+				Expect(seedClient.Create(ctx, lease)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				Expect(controllerInstallation.Status.Conditions).To(ContainElement(conditionWithTypeStatusReasonAndMessage(gardencorev1beta1.ControllerInstallationHealthy, gardencorev1beta1.ConditionFalse, "ExtensionHeartbeatStale", "did not renew its heartbeat Lease")))
+			})
+
+			Context("restart on stale heartbeat", func() {
+				var (
+					pod        *corev1.Pod
+					otherPod   *corev1.Pod
+					deployment *appsv1.Deployment
+				)
+
+				BeforeEach(func() {
+					reconciler.(*Reconciler).Config.Remediation = &gardenletconfigv1alpha1.ControllerInstallationRemediation{RestartOnStaleHeartbeat: ptr.To(true)}
+
+					deployment = &appsv1.Deployment{
+						ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-foo", Namespace: lease.Namespace},
+						Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}},
+					}
+					Expect(seedClient.Create(ctx, deployment)).To(Succeed())
+
+					pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "extension", Namespace: lease.Namespace, Labels: map[string]string{"app": "foo"}}}
+					Expect(seedClient.Create(ctx, pod)).To(Succeed())
+					otherPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: lease.Namespace}}
+					Expect(seedClient.Create(ctx, otherPod)).To(Succeed())
+
+					lease.Spec.HolderIdentity = ptr.To("foo")
+					lease.Spec.RenewTime = &metav1.MicroTime{Time: fakeClock.Now().Add(-10 * time.Minute)}
+				})
+
+				setManagedResourceDeployments := func(names ...string) {
+					managedResource := &resourcesv1alpha1.ManagedResource{}
+					Expect(seedClient.Get(ctx, client.ObjectKey{Name: controllerInstallationName, Namespace: gardenNamespace}, managedResource)).To(Succeed())
+					for _, name := range names {
+						managedResource.Status.Resources = append(managedResource.Status.Resources, resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: name, Namespace: lease.Namespace}})
+					}
+					Expect(seedClient.Update(ctx, managedResource)).To(Succeed())
+				}
+
+				It("should restart the pods of the extension Deployment", func() {
+					setManagedResourceDeployments(deployment.Name)
+					Expect(seedClient.Create(ctx, lease)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(otherPod), otherPod)).To(Succeed())
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+					Expect(lease.Annotations).To(HaveKeyWithValue("controllerinstallation.gardener.cloud/restarted-at", fakeClock.Now().UTC().Format(time.RFC3339)))
+				})
+
+				It("should choose the Deployment named after the lease holder if there are multiple Deployments", func() {
+					setManagedResourceDeployments("admission", deployment.Name)
+					Expect(seedClient.Create(ctx, lease)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(otherPod), otherPod)).To(Succeed())
+				})
+
+				It("should not restart any pods if the extension Deployment cannot be determined", func() {
+					Expect(seedClient.Create(ctx, lease)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(otherPod), otherPod)).To(Succeed())
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+					Expect(controllerInstallation.Status.Conditions).To(ContainElement(conditionWithTypeStatusReasonAndMessage(gardencorev1beta1.ControllerInstallationHealthy, gardencorev1beta1.ConditionFalse, "ExtensionHeartbeatStale", "Deployment maintaining the heartbeat could not be determined")))
+				})
+
+				It("should not restart the extension pods again within the threshold", func() {
+					setManagedResourceDeployments(deployment.Name)
+					metav1.SetMetaDataAnnotation(&lease.ObjectMeta, "controllerinstallation.gardener.cloud/restarted-at", fakeClock.Now().Add(-time.Minute).UTC().Format(time.RFC3339))
+					Expect(seedClient.Create(ctx, lease)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				})
+			})
+		})
+
+		Context("rollback", func() {
+			var (
+				managedResource *resourcesv1alpha1.ManagedResource
+				healthySecret   *corev1.Secret
+				newSecret       *corev1.Secret
+				lastHealthy     *corev1.Secret
+			)
+
+			BeforeEach(func() {
+				reconciler.(*Reconciler).Config.Remediation = &gardenletconfigv1alpha1.ControllerInstallationRemediation{RollbackTimeout: &metav1.Duration{Duration: 10 * time.Minute}}
+
+				healthySecret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: controllerInstallationName, Namespace: gardenNamespace},
+					Data:       map[string][]byte{"config.yaml": []byte("healthy")},
+				}
+				Expect(kubernetesutils.MakeUnique(healthySecret)).To(Succeed())
+				Expect(seedClient.Create(ctx, healthySecret)).To(Succeed())
+
+				newSecret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: controllerInstallationName, Namespace: gardenNamespace},
+					Data:       map[string][]byte{"config.yaml": []byte("broken")},
+				}
+				Expect(kubernetesutils.MakeUnique(newSecret)).To(Succeed())
+
+				lastHealthy = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: controllerInstallationName + "-last-healthy", Namespace: gardenNamespace}}
+			})
+
+			It("should snapshot the revision if the controller is healthy", func() {
+				managedResource = healthyManagedResource()
+				managedResource.Spec.SecretRefs = []corev1.LocalObjectReference{{Name: healthySecret.Name}}
+				Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(lastHealthy), lastHealthy)).To(Succeed())
+				Expect(lastHealthy.Annotations).To(Equal(map[string]string{"controllerinstallation.gardener.cloud/revision": healthySecret.Name}))
+				Expect(lastHealthy.Data).To(Equal(healthySecret.Data))
+			})
+
+			Context("new revision is not healthy", func() {
+				BeforeEach(func() {
+					lastHealthy.Annotations = map[string]string{"controllerinstallation.gardener.cloud/revision": healthySecret.Name}
+					lastHealthy.Data = healthySecret.Data
+					Expect(seedClient.Create(ctx, lastHealthy)).To(Succeed())
+
+					managedResource = notHealthyManagedResource()
+					managedResource.Spec.SecretRefs = []corev1.LocalObjectReference{{Name: newSecret.Name}}
+					Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+				})
+
+				It("should not roll back if the rollback timeout has not yet expired", func() {
+					newSecret.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-time.Minute))
+					Expect(seedClient.Create(ctx, newSecret)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					Expect(managedResource.Spec.SecretRefs).To(ConsistOf(corev1.LocalObjectReference{Name: newSecret.Name}))
+				})
+
+				It("should not roll back if the revision is old but has only been failing for a short time", func() {
+					controllerInstallation.Status.Conditions = []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ControllerInstallationHealthy, Status: gardencorev1beta1.ConditionFalse, LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-time.Minute))}}
+					Expect(gardenClient.Status().Update(ctx, controllerInstallation)).To(Succeed())
+					newSecret.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+					Expect(seedClient.Create(ctx, newSecret)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					Expect(managedResource.Spec.SecretRefs).To(ConsistOf(corev1.LocalObjectReference{Name: newSecret.Name}))
+				})
+
+				It("should roll back to the last healthy revision if the rollback timeout has expired", func() {
+					controllerInstallation.Status.Conditions = []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ControllerInstallationHealthy, Status: gardencorev1beta1.ConditionFalse, LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour))}}
+					Expect(gardenClient.Status().Update(ctx, controllerInstallation)).To(Succeed())
+					newSecret.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+					Expect(seedClient.Create(ctx, newSecret)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					Expect(managedResource.Spec.SecretRefs).To(ConsistOf(corev1.LocalObjectReference{Name: healthySecret.Name}))
+
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(lastHealthy), lastHealthy)).To(Succeed())
+					Expect(lastHealthy.Annotations).To(HaveKeyWithValue("controllerinstallation.gardener.cloud/rolled-back-revision", newSecret.Name))
+
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+					Expect(controllerInstallation.Status.Conditions).To(ContainElement(conditionWithTypeStatusReasonAndMessage(gardencorev1beta1.ControllerInstallationProgressing, gardencorev1beta1.ConditionTrue, "ControllerRolledBack", "was rolled back to the last healthy revision")))
+				})
+			})
+
+			It("should not roll back a new revision which is only progressing", func() {
+				lastHealthy.Annotations = map[string]string{"controllerinstallation.gardener.cloud/revision": healthySecret.Name}
+				lastHealthy.Data = healthySecret.Data
+				Expect(seedClient.Create(ctx, lastHealthy)).To(Succeed())
+
+				newSecret.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+				Expect(seedClient.Create(ctx, newSecret)).To(Succeed())
+
+				managedResource = healthyManagedResource()
+				managedResource.Status.Conditions[2].Status = gardencorev1beta1.ConditionTrue
+				managedResource.Spec.SecretRefs = []corev1.LocalObjectReference{{Name: newSecret.Name}}
+				Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource.Spec.SecretRefs).To(ConsistOf(corev1.LocalObjectReference{Name: newSecret.Name}))
+			})
+		})
 	})
 })
 
@@ -354,6 +354,21 @@ func (r *Reconciler) reconcile(
 		return reconcile.Result{}, fmt.Errorf("failed to inject garden access secrets: %w", err)
 	}
 
+	rolledBackMessage, err := r.rolledBackMessage(seedCtx, controllerInstallation, secretData)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether revision was rolled back: %w", err)
+	}
+	if rolledBackMessage != "" {
+		log.Info("Skipping deployment because the rendered revision was rolled back after it did not become healthy in time")
+
+		conditionProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationProgressing)
+		conditionProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionProgressing, gardencorev1beta1.ConditionTrue, ctrlinstutils.ReasonControllerRolledBack, rolledBackMessage)
+		if err := patchConditions(gardenCtx, r.GardenClient, controllerInstallation, conditionProgressing); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to patch conditions: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if err := managedresources.Create(
 		seedCtx,
 		r.SeedClientSet.Client(),
@@ -434,6 +449,12 @@ func (r *Reconciler) delete(
 		return reconcile.Result{}, err
 	}
 
+	lastHealthySecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ctrlinstutils.LastHealthySecretName(controllerInstallation.Name), Namespace: r.GardenNamespace}}
+	if err := r.SeedClientSet.Client().Delete(seedCtx, lastHealthySecret); client.IgnoreNotFound(err) != nil {
+		conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "DeletionFailed", fmt.Sprintf("Deletion of Secret %q failed: %+v", client.ObjectKeyFromObject(lastHealthySecret), err))
+		return reconcile.Result{}, err
+	}
+
 	namespace := getNamespaceForControllerInstallation(controllerInstallation)
 	if err := r.SeedClientSet.Client().Delete(seedCtx, namespace); err == nil || apierrors.IsConflict(err) {
 		log.Info("Deletion of Namespace is still pending", "namespace", client.ObjectKeyFromObject(namespace))
@@ -473,6 +494,27 @@ func patchConditions(ctx context.Context, c client.StatusClient, controllerInsta
 	return c.Status().Patch(ctx, controllerInstallation, patch)
 }
 
+// rolledBackMessage returns a message describing the rollback if the revision resulting from the given secret data was
+// rolled back by the care controller because it did not become healthy in time. Such revisions are not re-applied
+// until the rendered data changes, e.g. because a new ControllerDeployment is referenced.
+func (r *Reconciler) rolledBackMessage(ctx context.Context, controllerInstallation *gardencorev1beta1.ControllerInstallation, secretData map[string][]byte) (string, error) {
+	lastHealthySecret := &corev1.Secret{}
+	if err := r.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: ctrlinstutils.LastHealthySecretName(controllerInstallation.Name), Namespace: r.GardenNamespace}, lastHealthySecret); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+
+	rolledBackRevision, ok := lastHealthySecret.Annotations[ctrlinstutils.AnnotationKeyRolledBackRevision]
+	if !ok {
+		return "", nil
+	}
+
+	if revision, _ := managedresources.NewSecret(r.SeedClientSet.Client(), r.GardenNamespace, controllerInstallation.Name, secretData, false); revision != rolledBackRevision {
+		return "", nil
+	}
+
+	return ctrlinstutils.RolledBackMessage(rolledBackRevision, lastHealthySecret.Annotations[ctrlinstutils.AnnotationKeyRevision]), nil
+}
+
 func getNamespaceForControllerInstallation(controllerInstallation *gardencorev1beta1.ControllerInstallation) *corev1.Namespace {
 	return &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
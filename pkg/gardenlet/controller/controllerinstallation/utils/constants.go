@@ -4,6 +4,37 @@
 
 package utils
 
+import "fmt"
+
 // LabelKeyControllerInstallationName is a constant for a label key on ManagedResource objects whose value contains the
 // name of the ControllerInstallation the ManagedResource was created for.
 const LabelKeyControllerInstallationName = "controllerinstallation-name"
+
+const (
+	// AnnotationKeyRevision is a constant for an annotation key on the last-healthy Secret whose value contains the name
+	// of the ManagedResource secret (the revision) the snapshot was taken from.
+	AnnotationKeyRevision = "controllerinstallation.gardener.cloud/revision"
+	// AnnotationKeyRolledBackRevision is a constant for an annotation key on the last-healthy Secret whose value
+	// contains the name of the ManagedResource secret (the revision) which was rolled back because it did not become
+	// healthy in time.
+	AnnotationKeyRolledBackRevision = "controllerinstallation.gardener.cloud/rolled-back-revision"
+	// AnnotationKeyRestartedAt is a constant for an annotation key on the heartbeat Lease of an extension whose value
+	// contains the time when the pods of the extension were last restarted because of a stale heartbeat.
+	AnnotationKeyRestartedAt = "controllerinstallation.gardener.cloud/restarted-at"
+)
+
+// ReasonControllerRolledBack is the reason of the Progressing condition of a ControllerInstallation whose ManagedResource
+// was rolled back to the last healthy revision.
+const ReasonControllerRolledBack = "ControllerRolledBack"
+
+// LastHealthySecretName returns the name of the Secret containing the data of the last revision of the
+// ManagedResource for the given ControllerInstallation which was healthy.
+func LastHealthySecretName(controllerInstallationName string) string {
+	return controllerInstallationName + "-last-healthy"
+}
+
+// RolledBackMessage returns the message of the Progressing condition of a ControllerInstallation whose ManagedResource
+// was rolled back from the given revision to the last healthy revision.
+func RolledBackMessage(rolledBackRevision, lastHealthyRevision string) string {
+	return fmt.Sprintf("Revision %q did not become healthy in time and was rolled back to the last healthy revision %q. It is not deployed again until the rendered chart changes.", rolledBackRevision, lastHealthyRevision)
+}
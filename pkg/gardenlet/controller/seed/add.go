@@ -14,6 +14,8 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/cardinality"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/lease"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
@@ -59,6 +61,15 @@ func AddToManager(
 		return fmt.Errorf("failed adding lease reconciler: %w", err)
 	}
 
+	if cardinalityGuard := gardenlethelper.GetCardinalityGuardConfig(&cfg); cardinalityGuard != nil && gardenlethelper.IsMonitoringEnabled(&cfg) {
+		if err := (&cardinality.Reconciler{
+			Config:   *cardinalityGuard,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster); err != nil {
+			return fmt.Errorf("failed adding cardinality reconciler: %w", err)
+		}
+	}
+
 	if err := (&seed.Reconciler{
 		SeedClientSet:         seedClientSet,
 		Config:                cfg,
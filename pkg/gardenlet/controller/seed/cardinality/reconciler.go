@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cardinality
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+)
+
+const (
+	// ReasonSampleLimitExceeded is the reason used for events and conditions if the Prometheus of a shoot rejected
+	// scrapes of targets exceeding the sample limit.
+	ReasonSampleLimitExceeded = "SampleLimitExceeded"
+	// ReasonFederationSampleLimitExceeded is the reason used for events and conditions if the aggregate Prometheus
+	// rejected the federation of the metrics of a shoot because they exceed the federation sample limit.
+	ReasonFederationSampleLimitExceeded = "FederationSampleLimitExceeded"
+	// ReasonShootsWithinLimits is the reason used for conditions if no shoot exceeds the sample limits.
+	ReasonShootsWithinLimits = "ShootsWithinLimits"
+	// ReasonShootsExceedLimits is the reason used for conditions if shoots exceed the sample limits.
+	ReasonShootsExceedLimits = "ShootsExceedLimits"
+
+	maxShootsInConditionMessage = 10
+)
+
+// Querier executes instant queries against a Prometheus.
+type Querier interface {
+	Query(ctx context.Context, query string, ts time.Time, opts ...promv1.Option) (model.Value, promv1.Warnings, error)
+}
+
+// Reconciler determines the shoots whose monitoring stacks exceed the configured sample limits by querying the
+// aggregate Prometheus. It reports them via events on the Shoots and via a condition of the Seed.
+type Reconciler struct {
+	GardenClient client.Client
+	Config       gardenletconfigv1alpha1.CardinalityGuardConfig
+	Clock        clock.Clock
+	Recorder     record.EventRecorder
+	Querier      Querier
+	SeedName     string
+
+	lock sync.Mutex
+	// reportedShoots contains the namespaces of the shoots and the reasons which were reported in the previous run.
+	// Events are only emitted for shoots which newly exceed a limit.
+	reportedShoots sets.Set[string]
+}
+
+// Reconcile determines the shoots exceeding the sample limits and reports them.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedShootMonitoringWithinLimits)
+
+	exceedingShoots, err := r.shootsExceedingLimits(ctx)
+	if err != nil {
+		log.Error(err, "Failed determining shoots exceeding the sample limits")
+		condition = v1beta1helper.UpdatedConditionUnknownErrorWithClock(r.Clock, condition, err)
+	} else {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.GardenClient.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: r.SeedName}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+		}
+
+		shoots := make(map[string]*gardencorev1beta1.Shoot, len(shootList.Items))
+		for i, shoot := range shootList.Items {
+			shoots[shoot.Status.TechnicalID] = &shootList.Items[i]
+		}
+
+		r.recordEvents(shoots, exceedingShoots)
+		condition = r.updatedCondition(condition, shoots, exceedingShoots)
+	}
+
+	if conditions := v1beta1helper.MergeConditions(seed.Status.Conditions, condition); v1beta1helper.ConditionsNeedUpdate(seed.Status.Conditions, conditions) {
+		log.Info("Updating seed status condition", "conditionStatus", condition.Status, "reason", condition.Reason)
+		patch := client.StrategicMergeFrom(seed.DeepCopy())
+		seed.Status.Conditions = conditions
+		if err := r.GardenClient.Status().Patch(ctx, seed, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating seed status condition: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// shootsExceedingLimits returns a map from the control plane namespaces of the shoots exceeding the sample limits to
+// the reasons.
+func (r *Reconciler) shootsExceedingLimits(ctx context.Context) (map[string][]string, error) {
+	out := map[string][]string{}
+
+	for _, q := range []struct {
+		reason string
+		query  string
+	}{
+		{
+			reason: ReasonSampleLimitExceeded,
+			query:  `max by (namespace) (shoot:prometheus_target_scrapes_exceeded_sample_limit:increase1h) > 0`,
+		},
+		{
+			reason: ReasonFederationSampleLimitExceeded,
+			query:  fmt.Sprintf(`max by (namespace) (scrape_samples_scraped{job="shoot-prometheus"}) > %d`, ptr.Deref(r.Config.FederationSampleLimit, 0)),
+		},
+	} {
+		result, _, err := r.Querier.Query(ctx, q.query, r.Clock.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed querying shoots with reason %s: %w", q.reason, err)
+		}
+
+		vector, ok := result.(model.Vector)
+		if !ok {
+			return nil, fmt.Errorf("unexpected result type %s for query of shoots with reason %s", result.Type(), q.reason)
+		}
+
+		for _, sample := range vector {
+			if namespace := string(sample.Metric["namespace"]); namespace != "" {
+				out[namespace] = append(out[namespace], q.reason)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// recordEvents emits warning events on the Shoots which newly exceed a sample limit.
+func (r *Reconciler) recordEvents(shoots map[string]*gardencorev1beta1.Shoot, exceedingShoots map[string][]string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	reported := sets.New[string]()
+	for namespace, reasons := range exceedingShoots {
+		for _, reason := range reasons {
+			shoot, ok := shoots[namespace]
+			if !ok {
+				continue
+			}
+
+			key := namespace + "/" + reason
+			reported.Insert(key)
+			if r.reportedShoots.Has(key) {
+				continue
+			}
+
+			switch reason {
+			case ReasonSampleLimitExceeded:
+				r.Recorder.Eventf(shoot, corev1.EventTypeWarning, reason, "The Prometheus of the shoot rejects scrapes of targets exposing more than %d samples, their metrics are missing", ptr.Deref(r.Config.SampleLimit, 0))
+			case ReasonFederationSampleLimitExceeded:
+				r.Recorder.Eventf(shoot, corev1.EventTypeWarning, reason, "The metrics of the shoot exceed the limit of %d samples and are not federated to the aggregate Prometheus", ptr.Deref(r.Config.FederationSampleLimit, 0))
+			}
+		}
+	}
+
+	r.reportedShoots = reported
+}
+
+func (r *Reconciler) updatedCondition(condition gardencorev1beta1.Condition, shoots map[string]*gardencorev1beta1.Shoot, exceedingShoots map[string][]string) gardencorev1beta1.Condition {
+	if len(exceedingShoots) == 0 {
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, ReasonShootsWithinLimits, "The monitoring stacks of all shoots stay within the sample limits.")
+	}
+
+	// Shoots are identified by their namespace and name in the garden cluster if they are known, otherwise by the
+	// namespace of their control plane.
+	names := make([]string, 0, len(exceedingShoots))
+	for namespace, reasons := range exceedingShoots {
+		name := namespace
+		if shoot, ok := shoots[namespace]; ok {
+			name = client.ObjectKeyFromObject(shoot).String()
+		}
+		names = append(names, fmt.Sprintf("%s (%s)", name, strings.Join(reasons, ", ")))
+	}
+	slices.Sort(names)
+
+	if len(names) > maxShootsInConditionMessage {
+		names = append(names[:maxShootsInConditionMessage], fmt.Sprintf("and %d more", len(names)-maxShootsInConditionMessage))
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, ReasonShootsExceedLimits,
+		fmt.Sprintf("The monitoring stacks of %d shoots exceed the sample limits: %s", len(exceedingShoots), strings.Join(names, ", ")))
+}
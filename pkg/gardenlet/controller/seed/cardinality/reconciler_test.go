@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cardinality_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/cardinality"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.TODO()
+		fakeClock    *testclock.FakeClock
+		gardenClient client.Client
+		querier      *fakeQuerier
+		recorder     *record.FakeRecorder
+		reconciler   *Reconciler
+
+		seed    *gardencorev1beta1.Seed
+		shoot   *gardencorev1beta1.Shoot
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC))
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			Build()
+		querier = &fakeQuerier{results: map[string]model.Vector{}}
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			Config: gardenletconfigv1alpha1.CardinalityGuardConfig{
+				SampleLimit:           ptr.To[int64](50000),
+				FederationSampleLimit: ptr.To[int64](10000),
+				SyncPeriod:            &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+			Querier:  querier,
+			SeedName: "seed",
+		}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Status: gardencorev1beta1.SeedStatus{Conditions: []gardencorev1beta1.Condition{
+				{Type: gardencorev1beta1.SeedSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+			}},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--foo--bar"},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	condition := func() *gardencorev1beta1.Condition {
+		ExpectWithOffset(1, gardenClient.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		return v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedShootMonitoringWithinLimits)
+	}
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(gardenClient.Delete(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(querier.queries).To(BeEmpty())
+	})
+
+	It("should set the condition to true if no shoot exceeds the limits", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+
+		Expect(querier.queries).To(ConsistOf(
+			`max by (namespace) (shoot:prometheus_target_scrapes_exceeded_sample_limit:increase1h) > 0`,
+			`max by (namespace) (scrape_samples_scraped{job="shoot-prometheus"}) > 10000`,
+		))
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+			"Reason": Equal("ShootsWithinLimits"),
+		})))
+		Expect(v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedSystemComponentsHealthy)).NotTo(BeNil())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report the shoots exceeding the limits", func() {
+		querier.results["increase1h"] = model.Vector{{Metric: model.Metric{"namespace": "shoot--foo--bar"}, Value: 3}}
+		querier.results["scrape_samples_scraped"] = model.Vector{
+			{Metric: model.Metric{"namespace": "shoot--foo--bar"}, Value: 12000},
+			{Metric: model.Metric{"namespace": "shoot--foo--unknown"}, Value: 15000},
+		}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("ShootsExceedLimits"),
+			"Message": Equal("The monitoring stacks of 2 shoots exceed the sample limits: garden-foo/bar (SampleLimitExceeded, FederationSampleLimitExceeded), shoot--foo--unknown (FederationSampleLimitExceeded)"),
+		})))
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(Equal("Warning SampleLimitExceeded The Prometheus of the shoot rejects scrapes of targets exposing more than 50000 samples, their metrics are missing"))
+		Expect(<-recorder.Events).To(Equal("Warning FederationSampleLimitExceeded The metrics of the shoot exceed the limit of 10000 samples and are not federated to the aggregate Prometheus"))
+
+		By("Reconcile again and verify that no events are emitted for already reported shoots")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+		Expect(recorder.Events).To(BeEmpty())
+
+		By("Reconcile after the shoot stayed within the limits and verify that events are emitted again")
+		delete(querier.results, "increase1h")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+		Expect(recorder.Events).To(BeEmpty())
+
+		querier.results["increase1h"] = model.Vector{{Metric: model.Metric{"namespace": "shoot--foo--bar"}, Value: 1}}
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(HavePrefix("Warning SampleLimitExceeded"))
+	})
+
+	It("should limit the number of shoots in the condition message", func() {
+		for i := range 12 {
+			querier.results["increase1h"] = append(querier.results["increase1h"], &model.Sample{Metric: model.Metric{"namespace": model.LabelValue(fmt.Sprintf("shoot--foo--%02d", i))}, Value: 1})
+		}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+
+		Expect(condition().Message).To(And(
+			HavePrefix("The monitoring stacks of 12 shoots exceed the sample limits: shoot--foo--00 (SampleLimitExceeded), "),
+			ContainSubstring("shoot--foo--09 (SampleLimitExceeded), and 2 more"),
+			Not(ContainSubstring("shoot--foo--10")),
+		))
+	})
+
+	It("should set the condition to unknown if the aggregate Prometheus cannot be queried", func() {
+		querier.err = errors.New("fake")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": ContainSubstring("fake"),
+		})))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
+
+type fakeQuerier struct {
+	// results maps a substring of a query to the result.
+	results map[string]model.Vector
+	err     error
+	queries []string
+}
+
+func (q *fakeQuerier) Query(_ context.Context, query string, _ time.Time, _ ...promv1.Option) (model.Value, promv1.Warnings, error) {
+	q.queries = append(q.queries, query)
+	if q.err != nil {
+		return nil, nil, q.err
+	}
+
+	for substring, result := range q.results {
+		if strings.Contains(query, substring) {
+			return result, nil, nil
+		}
+	}
+	return model.Vector{}, nil, nil
+}
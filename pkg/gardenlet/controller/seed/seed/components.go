@@ -525,6 +525,7 @@ func (r *Reconciler) newVali() (component.Deployer, error) {
 		v1beta1constants.PriorityClassNameSeedSystem600,
 		storage,
 		"",
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -620,6 +621,11 @@ func (r *Reconciler) newSeedPrometheus(log logr.Logger, seed *seedpkg.Seed) (com
 }
 
 func (r *Reconciler) newAggregatePrometheus(log logr.Logger, seed *seedpkg.Seed, seedIsGarden bool, secretsManager secretsmanager.Interface, globalMonitoringSecret, wildcardCertSecret, alertingSMTPSecret *corev1.Secret) (component.DeployWaiter, error) {
+	var federationSampleLimit *uint64
+	if cardinalityGuard := gardenlethelper.GetCardinalityGuardConfig(&r.Config); cardinalityGuard != nil && cardinalityGuard.FederationSampleLimit != nil {
+		federationSampleLimit = ptr.To(uint64(*cardinalityGuard.FederationSampleLimit))
+	}
+
 	values := prometheus.Values{
 		Name:              "aggregate",
 		PriorityClassName: v1beta1constants.PriorityClassNameSeedSystem600,
@@ -632,7 +638,7 @@ func (r *Reconciler) newAggregatePrometheus(log logr.Logger, seed *seedpkg.Seed,
 		CentralConfigs: prometheus.CentralConfigs{
 			PrometheusRules: aggregateprometheus.CentralPrometheusRules(seedIsGarden),
 			ScrapeConfigs:   aggregateprometheus.CentralScrapeConfigs(),
-			ServiceMonitors: aggregateprometheus.CentralServiceMonitors(),
+			ServiceMonitors: aggregateprometheus.CentralServiceMonitors(federationSampleLimit),
 		},
 		AdditionalPodLabels: map[string]string{
 			"networking.resources.gardener.cloud/to-" + v1beta1constants.LabelNetworkPolicySeedScrapeTargets:                                                                       v1beta1constants.LabelNetworkPolicyAllowed,
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/availability"
+)
+
+// maxAvailabilityIncidents is the maximum number of incidents kept per condition. If there are more incidents in the
+// tracking window, the oldest ones are dropped and no longer accounted for in the availability percentage.
+const maxAvailabilityIncidents = 100
+
+// AvailabilityTracker maintains the rolling availability record of the conditions of a shoot.
+type AvailabilityTracker struct {
+	gardenClient client.Client
+	clock        clock.Clock
+	shoot        *gardencorev1beta1.Shoot
+	window       time.Duration
+}
+
+// NewAvailabilityTracker creates a new AvailabilityTracker instance.
+func NewAvailabilityTracker(gardenClient client.Client, clock clock.Clock, shoot *gardencorev1beta1.Shoot, window time.Duration) *AvailabilityTracker {
+	return &AvailabilityTracker{
+		gardenClient: gardenClient,
+		clock:        clock,
+		shoot:        shoot,
+		window:       window,
+	}
+}
+
+// Track records the given conditions in the availability ConfigMap of the shoot.
+// The ConfigMap is only written if the record changed, i.e., if incidents started, ended, or were pruned, or if the set
+// of tracked conditions changed.
+func (a *AvailabilityTracker) Track(ctx context.Context, conditions []gardencorev1beta1.Condition) error {
+	var (
+		now       = a.clock.Now()
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      gardenerutils.ComputeShootProjectResourceName(a.shoot.Name, gardenerutils.ShootProjectConfigMapSuffixAvailability),
+				Namespace: a.shoot.Namespace,
+			},
+		}
+		existing = &availability.Record{}
+		exists   = true
+	)
+
+	if err := a.gardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading availability record: %w", err)
+		}
+		exists = false
+	}
+
+	if _, ok := configMap.Data[availability.DataKey]; ok {
+		record, err := availability.FromConfigMap(configMap)
+		if err != nil {
+			// A corrupted record is not recoverable, hence start tracking from scratch.
+			record, exists = &availability.Record{}, false
+		}
+		existing = record
+	}
+
+	record := UpdateAvailabilityRecord(existing, conditions, a.window, now)
+
+	if !exists || !apiequality.Semantic.DeepEqual(existing, record) {
+		if err := a.writeRecord(ctx, configMap, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *AvailabilityTracker) writeRecord(ctx context.Context, configMap *corev1.ConfigMap, record *availability.Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed marshalling availability record: %w", err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, a.gardenClient, configMap, func() error {
+		configMap.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(a.shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot")),
+		}
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleAvailability)
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.LabelShootName, a.shoot.Name)
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.LabelShootUID, string(a.shoot.UID))
+		configMap.Data = map[string]string{availability.DataKey: string(data)}
+		return nil
+	}); err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+			// The shoot might have been deleted in the meantime.
+			return nil
+		}
+		return fmt.Errorf("failed updating availability record: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAvailabilityRecord computes the new availability record based on the existing record and the current
+// conditions. Only a status of False is considered as downtime, i.e., Progressing and Unknown conditions (e.g., during
+// shoot creation or when the health could not be determined) do not reduce the availability.
+func UpdateAvailabilityRecord(existing *availability.Record, conditions []gardencorev1beta1.Condition, window time.Duration, now time.Time) *availability.Record {
+	var (
+		record      = &availability.Record{Window: metav1.Duration{Duration: window}}
+		windowStart = now.Add(-window)
+		existingFor = make(map[gardencorev1beta1.ConditionType]availability.ConditionAvailability, len(existing.Conditions))
+	)
+
+	for _, conditionAvailability := range existing.Conditions {
+		existingFor[conditionAvailability.Type] = conditionAvailability
+	}
+
+	for _, condition := range conditions {
+		conditionAvailability, ok := existingFor[condition.Type]
+		if !ok {
+			conditionAvailability = availability.ConditionAvailability{
+				Type:         condition.Type,
+				TrackedSince: metav1.Time{Time: now},
+			}
+		}
+		// The incidents are modified below, hence they must not be shared with the existing record.
+		conditionAvailability.Incidents = slices.Clone(conditionAvailability.Incidents)
+
+		var ongoing *availability.Incident
+		if n := len(conditionAvailability.Incidents); n > 0 && conditionAvailability.Incidents[n-1].End == nil {
+			ongoing = &conditionAvailability.Incidents[n-1]
+		}
+
+		switch {
+		case condition.Status == gardencorev1beta1.ConditionFalse && ongoing == nil:
+			conditionAvailability.Incidents = append(conditionAvailability.Incidents, availability.Incident{
+				Start:  metav1.Time{Time: transitionTime(condition, lastIncidentEnd(conditionAvailability), now)},
+				Reason: condition.Reason,
+			})
+		case condition.Status != gardencorev1beta1.ConditionFalse && ongoing != nil:
+			ongoing.End = &metav1.Time{Time: transitionTime(condition, ongoing.Start.Time, now)}
+		}
+
+		conditionAvailability.Incidents = pruneIncidents(conditionAvailability.Incidents, windowStart)
+		record.Conditions = append(record.Conditions, conditionAvailability)
+	}
+
+	return record
+}
+
+// transitionTime returns the last transition time of the condition if it lies between notBefore and now, otherwise
+// it returns now.
+func transitionTime(condition gardencorev1beta1.Condition, notBefore, now time.Time) time.Time {
+	if t := condition.LastTransitionTime.Time; !t.IsZero() && !t.Before(notBefore) && !t.After(now) {
+		return t
+	}
+	return now
+}
+
+func lastIncidentEnd(conditionAvailability availability.ConditionAvailability) time.Time {
+	if n := len(conditionAvailability.Incidents); n > 0 && conditionAvailability.Incidents[n-1].End != nil {
+		return conditionAvailability.Incidents[n-1].End.Time
+	}
+	return conditionAvailability.TrackedSince.Time
+}
+
+func pruneIncidents(incidents []availability.Incident, windowStart time.Time) []availability.Incident {
+	var out []availability.Incident
+	for _, incident := range incidents {
+		if incident.End != nil && incident.End.Time.Before(windowStart) {
+			continue
+		}
+		out = append(out, incident)
+	}
+
+	if len(out) > maxAvailabilityIncidents {
+		out = out[len(out)-maxAvailabilityIncidents:]
+	}
+	return out
+}
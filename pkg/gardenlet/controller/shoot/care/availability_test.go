@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/utils/gardener/availability"
+)
+
+var _ = Describe("Availability", func() {
+	var (
+		now    time.Time
+		window time.Duration
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+		window = 30 * 24 * time.Hour
+	})
+
+	condition := func(status gardencorev1beta1.ConditionStatus, lastTransitionTime time.Time) gardencorev1beta1.Condition {
+		return gardencorev1beta1.Condition{
+			Type:               gardencorev1beta1.ShootAPIServerAvailable,
+			Status:             status,
+			Reason:             "HealthzRequestFailed",
+			LastTransitionTime: metav1.Time{Time: lastTransitionTime},
+		}
+	}
+
+	Describe("#UpdateAvailabilityRecord", func() {
+		It("should start tracking new conditions with full availability", func() {
+			record := UpdateAvailabilityRecord(&availability.Record{}, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now.Add(-time.Hour))}, window, now)
+
+			Expect(record.Window.Duration).To(Equal(window))
+			Expect(record.Conditions).To(ConsistOf(availability.ConditionAvailability{
+				Type:         gardencorev1beta1.ShootAPIServerAvailable,
+				TrackedSince: metav1.Time{Time: now},
+			}))
+			Expect(availability.ComputePercentage(record.Conditions[0], window, now)).To(Equal(100.0))
+		})
+
+		It("should open an incident when the condition becomes False and close it when it recovers", func() {
+			record := &availability.Record{Conditions: []availability.ConditionAvailability{{
+				Type:         gardencorev1beta1.ShootAPIServerAvailable,
+				TrackedSince: metav1.Time{Time: now.Add(-10 * time.Hour)},
+			}}}
+
+			record = UpdateAvailabilityRecord(record, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionFalse, now.Add(-2*time.Hour))}, window, now.Add(-time.Hour))
+			Expect(record.Conditions[0].Incidents).To(ConsistOf(availability.Incident{
+				Start:  metav1.Time{Time: now.Add(-2 * time.Hour)},
+				Reason: "HealthzRequestFailed",
+			}))
+			Expect(availability.ComputePercentage(record.Conditions[0], window, now.Add(-time.Hour))).To(BeNumerically("~", 88.889, 0.001))
+
+			record = UpdateAvailabilityRecord(record, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now.Add(-time.Hour))}, window, now)
+			Expect(record.Conditions[0].Incidents).To(ConsistOf(availability.Incident{
+				Start:  metav1.Time{Time: now.Add(-2 * time.Hour)},
+				End:    &metav1.Time{Time: now.Add(-time.Hour)},
+				Reason: "HealthzRequestFailed",
+			}))
+			Expect(availability.ComputePercentage(record.Conditions[0], window, now)).To(BeNumerically("~", 90.0, 0.001))
+		})
+
+		It("should not count Progressing or Unknown conditions as downtime", func() {
+			record := &availability.Record{Conditions: []availability.ConditionAvailability{{
+				Type:         gardencorev1beta1.ShootAPIServerAvailable,
+				TrackedSince: metav1.Time{Time: now.Add(-10 * time.Hour)},
+			}}}
+
+			record = UpdateAvailabilityRecord(record, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionProgressing, now.Add(-time.Hour))}, window, now)
+			record = UpdateAvailabilityRecord(record, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionUnknown, now)}, window, now)
+
+			Expect(record.Conditions[0].Incidents).To(BeEmpty())
+			Expect(availability.ComputePercentage(record.Conditions[0], window, now)).To(Equal(100.0))
+		})
+
+		It("should only consider the part of incidents within the window and prune incidents outside of it", func() {
+			record := &availability.Record{Conditions: []availability.ConditionAvailability{{
+				Type:         gardencorev1beta1.ShootAPIServerAvailable,
+				TrackedSince: metav1.Time{Time: now.Add(-60 * 24 * time.Hour)},
+				Incidents: []availability.Incident{
+					{Start: metav1.Time{Time: now.Add(-40 * 24 * time.Hour)}, End: &metav1.Time{Time: now.Add(-35 * 24 * time.Hour)}},
+					{Start: metav1.Time{Time: now.Add(-31 * 24 * time.Hour)}, End: &metav1.Time{Time: now.Add(-27 * 24 * time.Hour)}},
+				},
+			}}}
+
+			record = UpdateAvailabilityRecord(record, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now.Add(-27*24*time.Hour))}, window, now)
+
+			Expect(record.Conditions[0].Incidents).To(HaveLen(1))
+			Expect(availability.ComputePercentage(record.Conditions[0], window, now)).To(BeNumerically("~", 90.0, 0.001))
+		})
+
+		It("should not modify the existing record", func() {
+			existing := &availability.Record{Conditions: []availability.ConditionAvailability{{
+				Type:         gardencorev1beta1.ShootAPIServerAvailable,
+				TrackedSince: metav1.Time{Time: now.Add(-10 * time.Hour)},
+				Incidents:    []availability.Incident{{Start: metav1.Time{Time: now.Add(-2 * time.Hour)}}},
+			}}}
+
+			record := UpdateAvailabilityRecord(existing, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now.Add(-time.Hour))}, window, now)
+
+			Expect(record.Conditions[0].Incidents[0].End).NotTo(BeNil())
+			Expect(existing.Conditions[0].Incidents[0].End).To(BeNil())
+		})
+
+		It("should drop conditions which are no longer reported", func() {
+			record := &availability.Record{Conditions: []availability.ConditionAvailability{{
+				Type:         gardencorev1beta1.ShootEveryNodeReady,
+				TrackedSince: metav1.Time{Time: now.Add(-time.Hour)},
+			}}}
+
+			record = UpdateAvailabilityRecord(record, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now)}, window, now)
+
+			Expect(record.Conditions).To(HaveLen(1))
+			Expect(record.Conditions[0].Type).To(Equal(gardencorev1beta1.ShootAPIServerAvailable))
+		})
+	})
+
+	Describe("#AvailabilityTracker", func() {
+		var (
+			ctx          = context.Background()
+			gardenClient client.Client
+			fakeClock    *testclock.FakeClock
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			fakeClock = testclock.NewFakeClock(now)
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar", UID: "1234"}}
+		})
+
+		It("should maintain the availability ConfigMap", func() {
+			tracker := NewAvailabilityTracker(gardenClient, fakeClock, shoot, window)
+			Expect(tracker.Track(ctx, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now)})).To(Succeed())
+
+			fakeClock.Step(time.Hour)
+			Expect(tracker.Track(ctx, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionFalse, now.Add(30*time.Minute))})).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: "foo.availability"}, configMap)).To(Succeed())
+			Expect(configMap.Labels).To(Equal(map[string]string{
+				"gardener.cloud/role":       "availability",
+				"shoot.gardener.cloud/name": "foo",
+				"shoot.gardener.cloud/uid":  "1234",
+			}))
+			Expect(configMap.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion:         "core.gardener.cloud/v1beta1",
+				Kind:               "Shoot",
+				Name:               "foo",
+				UID:                "1234",
+				Controller:         ptr.To(true),
+				BlockOwnerDeletion: ptr.To(true),
+			}))
+
+			record, err := availability.FromConfigMap(configMap)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record.Conditions).To(HaveLen(1))
+			Expect(record.Conditions[0].Incidents).To(HaveLen(1))
+			Expect(availability.ComputePercentage(record.Conditions[0], window, fakeClock.Now())).To(Equal(50.0))
+		})
+
+		It("should only update the ConfigMap if incidents start or end", func() {
+			tracker := NewAvailabilityTracker(gardenClient, fakeClock, shoot, window)
+			Expect(tracker.Track(ctx, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now)})).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: "foo.availability"}, configMap)).To(Succeed())
+			resourceVersion := configMap.ResourceVersion
+
+			By("Track unchanged condition")
+			fakeClock.Step(time.Hour)
+			Expect(tracker.Track(ctx, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now)})).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.ResourceVersion).To(Equal(resourceVersion))
+
+			By("Track start of incident")
+			fakeClock.Step(time.Hour)
+			Expect(tracker.Track(ctx, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionFalse, fakeClock.Now())})).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.ResourceVersion).NotTo(Equal(resourceVersion))
+			resourceVersion = configMap.ResourceVersion
+
+			By("Track ongoing incident")
+			fakeClock.Step(time.Hour)
+			Expect(tracker.Track(ctx, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionFalse, fakeClock.Now().Add(-time.Hour))})).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.ResourceVersion).To(Equal(resourceVersion))
+
+			By("Track end of incident")
+			fakeClock.Step(time.Hour)
+			Expect(tracker.Track(ctx, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, fakeClock.Now())})).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.ResourceVersion).NotTo(Equal(resourceVersion))
+		})
+
+		It("should start tracking from scratch if the existing record is corrupted", func() {
+			Expect(gardenClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo.availability", Namespace: shoot.Namespace},
+				Data:       map[string]string{availability.DataKey: "{"},
+			})).To(Succeed())
+
+			Expect(NewAvailabilityTracker(gardenClient, fakeClock, shoot, window).Track(ctx, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, now)})).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: "foo.availability"}, configMap)).To(Succeed())
+			record, err := availability.FromConfigMap(configMap)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record.Conditions[0].TrackedSince.Time).To(BeTemporally("==", now))
+		})
+	})
+})
@@ -7,16 +7,25 @@ package care
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
@@ -44,8 +53,51 @@ const (
 	// Any webhook on lease resources in kube-system namespace with a larger timeout can break leader election of essential
 	// control plane controllers.
 	WebhookMaximumTimeoutSecondsNotProblematicForLeases = 3
+
+	// metricRequestedDeprecatedAPIs is the name of the kube-apiserver metric which indicates deprecated APIs that have
+	// been requested, broken out by API group, version, resource, subresource, and removed_release.
+	metricRequestedDeprecatedAPIs = "apiserver_requested_deprecated_apis"
+	// maxReportedConstraintItems is the maximum number of offending items (e.g., APIs, objects) reported in the message
+	// of a constraint.
+	maxReportedConstraintItems = 10
+
+	// defaultMachineDrainTimeout is the drain timeout used by machine-controller-manager if the Machine does not
+	// specify one.
+	defaultMachineDrainTimeout = 2 * time.Hour
+	// reasonDrainBlockedByPodDisruptionBudgets is the reason of the NodeDrainPossible constraint if PodDisruptionBudgets
+	// currently block the drain of nodes.
+	reasonDrainBlockedByPodDisruptionBudgets = "DrainBlockedByPodDisruptionBudgets"
+	// nodeDrainRemediationHint is appended to the message of the NodeDrainPossible constraint if it is not satisfied.
+	nodeDrainRemediationHint = "To unblock the drain, scale up the affected workloads, relax minAvailable/maxUnavailable of the PodDisruptionBudgets, or set .spec.unhealthyPodEvictionPolicy=AlwaysAllow if the selected pods are not ready. Nodes which cannot be drained within the machine drain timeout are drained forcefully, i.e., without respecting PodDisruptionBudgets."
 )
 
+// requestedDeprecatedAPIsLookback is the time range in which requests of deprecated APIs are considered by the upgrade
+// readiness check. It spans restarts of kube-apiserver instances which reset the metric.
+const requestedDeprecatedAPIsLookback = 24 * time.Hour
+
+// Querier executes instant queries against a Prometheus.
+type Querier interface {
+	Query(ctx context.Context, query string, ts time.Time, opts ...promv1.Option) (model.Value, promv1.Warnings, error)
+}
+
+// NewShootPrometheusQuerier returns a Querier for the Prometheus of the shoot in the given control plane namespace.
+func NewShootPrometheusQuerier(controlPlaneNamespace string) (Querier, error) {
+	prometheusClient, err := promapi.NewClient(promapi.Config{Address: fmt.Sprintf("http://prometheus-shoot.%s.svc:80", controlPlaneNamespace)})
+	if err != nil {
+		return nil, err
+	}
+	return promv1.NewAPI(prometheusClient), nil
+}
+
+// removedAPI describes an API version of a resource which is removed with a certain Kubernetes minor version.
+type removedAPI struct {
+	groupVersionResource schema.GroupVersionResource
+}
+
+func (r removedAPI) String() string {
+	return formatAPI(r.groupVersionResource.GroupVersion().String(), r.groupVersionResource.Resource)
+}
+
 func shootHibernatedConstraints(clock clock.Clock, conditions ...gardencorev1beta1.Condition) []gardencorev1beta1.Condition {
 	hibernationConditions := make([]gardencorev1beta1.Condition, 0, len(conditions))
 	for _, cond := range conditions {
@@ -73,6 +125,7 @@ type Constraint struct {
 	seedClient             client.Client
 	initializeShootClients ShootClientInit
 	shootClient            client.Client
+	prometheusQuerier      Querier
 
 	log   logr.Logger
 	clock clock.Clock
@@ -84,6 +137,7 @@ func NewConstraint(
 	shoot *shoot.Shoot,
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
+	prometheusQuerier Querier,
 	clock clock.Clock,
 ) *Constraint {
 	return &Constraint{
@@ -91,6 +145,7 @@ func NewConstraint(
 		shoot:                  shoot,
 		seedClient:             seedClient,
 		initializeShootClients: shootClientInit,
+		prometheusQuerier:      prometheusQuerier,
 		log:                    log,
 	}
 }
@@ -164,9 +219,23 @@ func (c *Constraint) constraintsChecks(
 		constraints.crdsWithProblematicConversionWebhooks = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.crdsWithProblematicConversionWebhooks, status, reason, message)
 	}
 
+	status, reason, message, err = c.checkUpgradeReadiness(ctx)
+	if err != nil {
+		constraints.upgradeReadiness = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.upgradeReadiness, err)
+	} else {
+		constraints.upgradeReadiness = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.upgradeReadiness, status, reason, message)
+	}
+
+	status, reason, message, err = c.checkNodeDrainPossible(ctx)
+	if err != nil {
+		constraints.nodeDrainPossible = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.nodeDrainPossible, err)
+	} else {
+		constraints.nodeDrainPossible = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.nodeDrainPossible, status, reason, message)
+	}
+
 	return filterOptionalConstraints(
 		[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks, constraints.manualInPlaceWorkersUpdated},
+		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks, constraints.manualInPlaceWorkersUpdated, constraints.upgradeReadiness, constraints.nodeDrainPossible},
 	)
 }
 
@@ -297,6 +366,311 @@ func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx con
 		nil
 }
 
+// checkUpgradeReadiness checks whether APIs which are removed in the next Kubernetes minor version are still in use.
+// The removed APIs are determined from the deprecated API requests reported by the kube-apiserver metrics. For each of
+// them, it also considers the objects which were last written by clients via such APIs (according to their managed
+// fields).
+func (c *Constraint) checkUpgradeReadiness(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	nextMinorVersion := fmt.Sprintf("%d.%d", c.shoot.KubernetesVersion.Major(), c.shoot.KubernetesVersion.Minor()+1)
+
+	if c.prometheusQuerier == nil {
+		return gardencorev1beta1.ConditionTrue,
+			"ConstraintNotChecked",
+			"Shoot monitoring is disabled, hence the requested APIs cannot be determined.",
+			nil
+	}
+
+	requestedAPIs, err := c.requestedRemovedAPIs(ctx, nextMinorVersion)
+	if err != nil {
+		return "", "", "", fmt.Errorf("could not determine requested deprecated APIs from kube-apiserver metrics: %w", err)
+	}
+
+	var (
+		requested []string
+		objects   []string
+	)
+	for _, api := range requestedAPIs {
+		requested = append(requested, api.String())
+
+		objectsWrittenViaAPI, err := c.objectsWrittenViaRemovedAPI(ctx, api)
+		if err != nil {
+			return "", "", "", err
+		}
+		objects = append(objects, objectsWrittenViaAPI...)
+	}
+
+	if len(requested) == 0 {
+		return gardencorev1beta1.ConditionTrue,
+			"NoRemovedAPIsInUse",
+			fmt.Sprintf("No APIs which are removed in Kubernetes %s are in use.", nextMinorVersion),
+			nil
+	}
+
+	details := []string{"requested APIs: " + joinLimited(requested)}
+	if len(objects) > 0 {
+		details = append(details, "objects written via removed APIs: "+joinLimited(objects))
+	}
+
+	return gardencorev1beta1.ConditionFalse,
+		"RemovedAPIsInUse",
+		fmt.Sprintf("Some APIs which are removed in Kubernetes %s are still in use, please migrate the affected clients and objects before upgrading (%s). Please see https://kubernetes.io/docs/reference/using-api/deprecation-guide/ for more details.",
+			nextMinorVersion, strings.Join(details, "; ")),
+		nil
+}
+
+// requestedRemovedAPIs returns the APIs which are removed in the given Kubernetes minor version and were requested
+// within the lookback period. The metric is reported by each kube-apiserver instance separately and reset on restarts,
+// hence it is aggregated across instances and over time by the shoot Prometheus.
+func (c *Constraint) requestedRemovedAPIs(ctx context.Context, removedRelease string) ([]removedAPI, error) {
+	query := fmt.Sprintf(`max by (group, version, resource) (max_over_time(%s{removed_release=%q}[%s])) > 0`,
+		metricRequestedDeprecatedAPIs, removedRelease, model.Duration(requestedDeprecatedAPIsLookback))
+
+	result, _, err := c.prometheusQuerier.Query(ctx, query, c.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type %s", result.Type())
+	}
+
+	var apis []removedAPI
+	for _, sample := range vector {
+		apis = append(apis, removedAPI{groupVersionResource: schema.GroupVersionResource{
+			Group:    string(sample.Metric["group"]),
+			Version:  string(sample.Metric["version"]),
+			Resource: string(sample.Metric["resource"]),
+		}})
+	}
+
+	slices.SortFunc(apis, func(a, b removedAPI) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return apis, nil
+}
+
+// objectsWrittenViaRemovedAPI returns the objects of the given API which were last written by clients via the removed
+// API version together with the names of these clients.
+func (c *Constraint) objectsWrittenViaRemovedAPI(ctx context.Context, api removedAPI) ([]string, error) {
+	gvk, err := c.shootClient.RESTMapper().KindFor(api.groupVersionResource)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			// The API is not served (anymore), hence there cannot be any objects written via it.
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not determine kind of %s: %w", api, err)
+	}
+
+	objectList := &metav1.PartialObjectMetadataList{}
+	objectList.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := c.shootClient.List(ctx, objectList); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			// The API is not served (anymore), hence there cannot be any clients using it.
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not list %s in the shoot: %w", api, err)
+	}
+
+	var (
+		apiVersion = gvk.GroupVersion().String()
+		objects    []string
+	)
+
+	for _, obj := range objectList.Items {
+		clients := sets.New[string]()
+		for _, managedField := range obj.ManagedFields {
+			if managedField.APIVersion == apiVersion {
+				clients.Insert(managedField.Manager)
+			}
+		}
+
+		if clients.Len() == 0 {
+			continue
+		}
+
+		name := obj.Name
+		if obj.Namespace != "" {
+			name = client.ObjectKeyFromObject(&obj).String()
+		}
+		objects = append(objects, fmt.Sprintf("%s %s (clients: %s)", api, name, strings.Join(sets.List(clients), ", ")))
+	}
+
+	return objects, nil
+}
+
+func formatAPI(groupVersion, resource string) string {
+	return groupVersion + "/" + resource
+}
+
+func joinLimited(items []string) string {
+	if len(items) <= maxReportedConstraintItems {
+		return strings.Join(items, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(items[:maxReportedConstraintItems], ", "), len(items)-maxReportedConstraintItems)
+}
+
+// checkNodeDrainPossible checks whether PodDisruptionBudgets in the shoot block the drain of nodes. It reports the
+// PodDisruptionBudgets which currently block the eviction of pods on cordoned nodes, and otherwise those which never
+// allow any disruption (e.g., for single-replica workloads with minAvailable=1) and will block the next drain.
+func (c *Constraint) checkNodeDrainPossible(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	if v1beta1helper.IsWorkerless(c.shoot.GetInfo()) {
+		return gardencorev1beta1.ConditionTrue,
+			"NoDrainBlockingPodDisruptionBudgets",
+			"Shoot is workerless",
+			nil
+	}
+
+	pdbList := &policyv1.PodDisruptionBudgetList{}
+	if err := c.shootClient.List(ctx, pdbList, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(notManagedByGardener)}); err != nil {
+		return "", "", "", fmt.Errorf("could not list PodDisruptionBudgets in the shoot: %w", err)
+	}
+
+	var exhaustedPDBs []policyv1.PodDisruptionBudget
+	for _, pdb := range pdbList.Items {
+		if pdb.Status.DisruptionsAllowed == 0 && pdb.Status.ExpectedPods > 0 {
+			exhaustedPDBs = append(exhaustedPDBs, pdb)
+		}
+	}
+
+	blockedEvictions, err := c.evictionsBlockedByPodDisruptionBudgets(ctx, exhaustedPDBs)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if len(blockedEvictions) > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			reasonDrainBlockedByPodDisruptionBudgets,
+			fmt.Sprintf("Some PodDisruptionBudgets currently block the drain of nodes: %s. %s", joinLimited(blockedEvictions), nodeDrainRemediationHint),
+			nil
+	}
+
+	var strictPDBs []string
+	for _, pdb := range exhaustedPDBs {
+		if pdb.Status.DesiredHealthy >= pdb.Status.ExpectedPods {
+			strictPDBs = append(strictPDBs, client.ObjectKeyFromObject(&pdb).String())
+		}
+	}
+
+	if len(strictPDBs) > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			"PodDisruptionBudgetsPreventingDrain",
+			fmt.Sprintf("Some PodDisruptionBudgets do not allow any disruption of the pods they select and will block the drain of nodes, e.g., during rolling updates of worker pools: %s. %s", joinLimited(strictPDBs), nodeDrainRemediationHint),
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		"NoDrainBlockingPodDisruptionBudgets",
+		"No PodDisruptionBudgets block the drain of nodes.",
+		nil
+}
+
+// evictionsBlockedByPodDisruptionBudgets returns a description of all pods on cordoned nodes whose eviction is blocked
+// by one of the given PodDisruptionBudgets.
+func (c *Constraint) evictionsBlockedByPodDisruptionBudgets(ctx context.Context, pdbs []policyv1.PodDisruptionBudget) ([]string, error) {
+	if len(pdbs) == 0 {
+		return nil, nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.shootClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("could not list nodes in the shoot: %w", err)
+	}
+
+	cordonedNodes := sets.New[string]()
+	for _, node := range nodeList.Items {
+		if node.Spec.Unschedulable {
+			cordonedNodes.Insert(node.Name)
+		}
+	}
+
+	if cordonedNodes.Len() == 0 {
+		return nil, nil
+	}
+
+	drainDeadlines, err := c.machineDrainDeadlines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		blockedEvictions []string
+		podsInNamespace  = map[string][]corev1.Pod{}
+	)
+
+	for _, pdb := range pdbs {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			c.log.Error(err, "Could not parse selector of PodDisruptionBudget", "podDisruptionBudget", client.ObjectKeyFromObject(&pdb))
+			continue
+		}
+
+		pods, ok := podsInNamespace[pdb.Namespace]
+		if !ok {
+			podList := &corev1.PodList{}
+			if err := c.shootClient.List(ctx, podList, client.InNamespace(pdb.Namespace)); err != nil {
+				return nil, fmt.Errorf("could not list pods in namespace %q in the shoot: %w", pdb.Namespace, err)
+			}
+			pods = podList.Items
+			podsInNamespace[pdb.Namespace] = pods
+		}
+
+		for _, pod := range pods {
+			if !cordonedNodes.Has(pod.Spec.NodeName) || !isEvictedDuringDrain(pod) || !selector.Matches(labels.Set(pod.Labels)) {
+				continue
+			}
+
+			blockedEviction := fmt.Sprintf("%s blocks eviction of pod %s on node %s", client.ObjectKeyFromObject(&pdb), pod.Name, pod.Spec.NodeName)
+			if deadline, ok := drainDeadlines[pod.Spec.NodeName]; ok {
+				blockedEviction += fmt.Sprintf(" (drain timeout expires at %s)", deadline.UTC().Format(time.RFC3339))
+			}
+			blockedEvictions = append(blockedEvictions, blockedEviction)
+		}
+	}
+
+	return blockedEvictions, nil
+}
+
+// machineDrainDeadlines returns the times after which the nodes of machines in deletion are drained forcefully.
+func (c *Constraint) machineDrainDeadlines(ctx context.Context) (map[string]time.Time, error) {
+	machineList := &machinev1alpha1.MachineList{}
+	if err := c.seedClient.List(ctx, machineList, client.InNamespace(c.shoot.ControlPlaneNamespace)); err != nil {
+		return nil, fmt.Errorf("could not list machines in shoot namespace in seed: %w", err)
+	}
+
+	deadlines := make(map[string]time.Time, len(machineList.Items))
+	for _, machine := range machineList.Items {
+		nodeName := machine.Labels["node"]
+		if machine.DeletionTimestamp == nil || nodeName == "" {
+			continue
+		}
+
+		drainTimeout := defaultMachineDrainTimeout
+		if machine.Spec.MachineConfiguration != nil && machine.Spec.MachineConfiguration.MachineDrainTimeout != nil {
+			drainTimeout = machine.Spec.MachineConfiguration.MachineDrainTimeout.Duration
+		}
+		deadlines[nodeName] = machine.DeletionTimestamp.Add(drainTimeout)
+	}
+
+	return deadlines, nil
+}
+
+// isEvictedDuringDrain returns whether the given pod is evicted when its node is drained. Terminated pods, pods in
+// deletion, mirror pods and pods managed by DaemonSets are not evicted.
+func isEvictedDuringDrain(pod corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if owner := metav1.GetControllerOf(&pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
+
 // CheckForProblematicWebhooks checks the Shoot for problematic webhooks which could prevent shoot worker nodes from
 // joining the cluster.
 func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, []gardencorev1beta1.ErrorCode, error) {
@@ -453,6 +827,8 @@ type ShootConstraints struct {
 	caCertificateValiditiesAcceptable     gardencorev1beta1.Condition
 	crdsWithProblematicConversionWebhooks gardencorev1beta1.Condition
 	manualInPlaceWorkersUpdated           gardencorev1beta1.Condition
+	upgradeReadiness                      gardencorev1beta1.Condition
+	nodeDrainPossible                     gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot constraints as a slice.
@@ -463,6 +839,8 @@ func (g ShootConstraints) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.caCertificateValiditiesAcceptable,
 		g.crdsWithProblematicConversionWebhooks,
 		g.manualInPlaceWorkersUpdated,
+		g.upgradeReadiness,
+		g.nodeDrainPossible,
 	}
 }
 
@@ -474,6 +852,8 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 		g.caCertificateValiditiesAcceptable.Type,
 		g.crdsWithProblematicConversionWebhooks.Type,
 		g.manualInPlaceWorkersUpdated.Type,
+		g.upgradeReadiness.Type,
+		g.nodeDrainPossible.Type,
 	}
 }
 
@@ -486,5 +866,7 @@ func NewShootConstraints(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoo
 		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
 		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
 		manualInPlaceWorkersUpdated:           v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootManualInPlaceWorkersUpdated),
+		upgradeReadiness:                      v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootUpgradeReadiness),
+		nodeDrainPossible:                     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootNodeDrainPossible),
 	}
 }
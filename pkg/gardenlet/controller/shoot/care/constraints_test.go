@@ -6,13 +6,18 @@ package care_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	appsv1beta1 "k8s.io/api/apps/v1beta1"
@@ -22,8 +27,10 @@ import (
 	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	rbacv1alpha1 "k8s.io/api/rbac/v1alpha1"
 	rbacv1beta1 "k8s.io/api/rbac/v1beta1"
@@ -32,8 +39,10 @@ import (
 	schedulingv1beta1 "k8s.io/api/scheduling/v1beta1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
 	"k8s.io/utils/clock"
@@ -445,8 +454,11 @@ var _ = Describe("Constraints", func() {
 		var (
 			ctx                   = context.Background()
 			controlPlaneNamespace = "shoot--foo--bar"
+			kubernetesVersion     = semver.MustParse("1.31.2")
 			seedClient            client.Client
 			shootClient           client.Client
+			shootClientSet        kubernetes.Interface
+			querier               *fakeQuerier
 
 			constraint *Constraint
 
@@ -469,10 +481,15 @@ var _ = Describe("Constraints", func() {
 
 		BeforeEach(func() {
 			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
-			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			restMapper.Add(flowcontrolv1beta3.SchemeGroupVersion.WithKind("FlowSchema"), meta.RESTScopeRoot)
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithRESTMapper(restMapper).Build()
+			querier = &fakeQuerier{}
+			shootClientSet = fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build()
 
 			shoot := &shootpkg.Shoot{
 				ControlPlaneNamespace: controlPlaneNamespace,
+				KubernetesVersion:     kubernetesVersion,
 			}
 			shoot.SetInfo(&gardencorev1beta1.Shoot{})
 
@@ -481,8 +498,9 @@ var _ = Describe("Constraints", func() {
 				shoot,
 				seedClient,
 				func() (kubernetes.Interface, bool, error) {
-					return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+					return shootClientSet, true, nil
 				},
+				querier,
 				clock,
 			)
 		})
@@ -496,6 +514,7 @@ var _ = Describe("Constraints", func() {
 							{Type: gardencorev1beta1.ShootMaintenancePreconditionsSatisfied},
 							{Type: gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks},
 							{Type: gardencorev1beta1.ShootManualInPlaceWorkersUpdated},
+							{Type: gardencorev1beta1.ShootUpgradeReadiness},
 						},
 					},
 				}
@@ -563,6 +582,233 @@ var _ = Describe("Constraints", func() {
 				))
 			})
 
+			Context("#UpgradeReadiness", func() {
+				It("should not keep the 'UpgradeReadiness' constraint when it's true", func() {
+					Expect(shootClient.Create(ctx, &flowcontrolv1beta3.FlowSchema{ObjectMeta: metav1.ObjectMeta{
+						Name:          "foo",
+						ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "bar", APIVersion: "flowcontrol.apiserver.k8s.io/v1beta3"}},
+					}})).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+					))
+					Expect(querier.queries).To(ConsistOf(
+						`max by (group, version, resource) (max_over_time(apiserver_requested_deprecated_apis{removed_release="1.32"}[1d])) > 0`,
+					))
+				})
+
+				It("should keep the 'UpgradeReadiness' constraint when APIs removed in the next minor version are requested", func() {
+					querier.result = model.Vector{
+						{Metric: model.Metric{"group": "flowcontrol.apiserver.k8s.io", "version": "v1beta3", "resource": "prioritylevelconfigurations"}, Value: 1},
+						{Metric: model.Metric{"group": "flowcontrol.apiserver.k8s.io", "version": "v1beta3", "resource": "flowschemas"}, Value: 1},
+					}
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("RemovedAPIsInUse"),
+						WithMessage("Some APIs which are removed in Kubernetes 1.32 are still in use, please migrate the affected clients and objects before upgrading (requested APIs: flowcontrol.apiserver.k8s.io/v1beta3/flowschemas, flowcontrol.apiserver.k8s.io/v1beta3/prioritylevelconfigurations)."),
+					))
+				})
+
+				It("should report the objects written via APIs removed in the next minor version", func() {
+					querier.result = model.Vector{
+						{Metric: model.Metric{"group": "flowcontrol.apiserver.k8s.io", "version": "v1beta3", "resource": "flowschemas"}, Value: 1},
+						{Metric: model.Metric{"group": "unknown.gardener.cloud", "version": "v1beta1", "resource": "foos"}, Value: 1},
+					}
+
+					Expect(shootClient.Create(ctx, &flowcontrolv1beta3.FlowSchema{ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: "helm", APIVersion: "flowcontrol.apiserver.k8s.io/v1beta3"},
+							{Manager: "kubectl-client-side-apply", APIVersion: "flowcontrol.apiserver.k8s.io/v1beta3"},
+							{Manager: "kube-apiserver", APIVersion: "flowcontrol.apiserver.k8s.io/v1"},
+						},
+					}})).To(Succeed())
+					Expect(shootClient.Create(ctx, &flowcontrolv1beta3.FlowSchema{ObjectMeta: metav1.ObjectMeta{
+						Name:          "bar",
+						ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kube-apiserver", APIVersion: "flowcontrol.apiserver.k8s.io/v1"}},
+					}})).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("RemovedAPIsInUse"),
+						WithMessage("(requested APIs: flowcontrol.apiserver.k8s.io/v1beta3/flowschemas, unknown.gardener.cloud/v1beta1/foos; objects written via removed APIs: flowcontrol.apiserver.k8s.io/v1beta3/flowschemas foo (clients: helm, kubectl-client-side-apply))."),
+					))
+				})
+
+				It("should set the 'UpgradeReadiness' constraint to unknown when the shoot Prometheus cannot be queried", func() {
+					querier.err = errors.New("fake")
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+						WithStatus(gardencorev1beta1.ConditionUnknown),
+						WithMessage("could not determine requested deprecated APIs from kube-apiserver metrics"),
+					))
+				})
+
+				It("should not check the 'UpgradeReadiness' constraint when shoot monitoring is disabled", func() {
+					shoot := &shootpkg.Shoot{
+						ControlPlaneNamespace: controlPlaneNamespace,
+						KubernetesVersion:     kubernetesVersion,
+					}
+					shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+					constraint = NewConstraint(
+						logr.Discard(),
+						shoot,
+						seedClient,
+						func() (kubernetes.Interface, bool, error) {
+							return shootClientSet, true, nil
+						},
+						nil,
+						clock,
+					)
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+					))
+				})
+			})
+
+			Context("#NodeDrainPossible", func() {
+				var pdb *policyv1.PodDisruptionBudget
+
+				BeforeEach(func() {
+					shootPkg := &shootpkg.Shoot{
+						ControlPlaneNamespace: controlPlaneNamespace,
+						KubernetesVersion:     kubernetesVersion,
+					}
+					shootPkg.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{Name: "worker1"}},
+					}}})
+
+					constraint = NewConstraint(
+						logr.Discard(),
+						shootPkg,
+						seedClient,
+						func() (kubernetes.Interface, bool, error) {
+							return shootClientSet, true, nil
+						},
+						querier,
+						clock,
+					)
+
+					pdb = &policyv1.PodDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+						Spec: policyv1.PodDisruptionBudgetSpec{
+							MinAvailable: ptr.To(intstr.FromInt32(1)),
+							Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "app"}},
+						},
+						Status: policyv1.PodDisruptionBudgetStatus{
+							DisruptionsAllowed: 0,
+							CurrentHealthy:     1,
+							DesiredHealthy:     1,
+							ExpectedPods:       1,
+						},
+					}
+				})
+
+				It("should not keep the 'NodeDrainPossible' constraint when no PodDisruptionBudgets block drains", func() {
+					pdb.Status.DisruptionsAllowed, pdb.Status.CurrentHealthy, pdb.Status.ExpectedPods = 1, 2, 2
+					Expect(shootClient.Create(ctx, pdb)).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootNodeDrainPossible),
+					))
+				})
+
+				It("should not consider PodDisruptionBudgets managed by Gardener", func() {
+					metav1.SetMetaDataLabel(&pdb.ObjectMeta, "resources.gardener.cloud/managed-by", "gardener")
+					Expect(shootClient.Create(ctx, pdb)).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootNodeDrainPossible),
+					))
+				})
+
+				It("should keep the 'NodeDrainPossible' constraint when PodDisruptionBudgets never allow disruptions", func() {
+					Expect(shootClient.Create(ctx, pdb)).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootNodeDrainPossible),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("PodDisruptionBudgetsPreventingDrain"),
+						WithMessage("Some PodDisruptionBudgets do not allow any disruption of the pods they select and will block the drain of nodes, e.g., during rolling updates of worker pools: default/app."),
+					))
+				})
+
+				It("should keep the 'NodeDrainPossible' constraint when PodDisruptionBudgets block the drain of cordoned nodes", func() {
+					Expect(shootClient.Create(ctx, pdb)).To(Succeed())
+					Expect(shootClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}, Spec: corev1.NodeSpec{Unschedulable: true}})).To(Succeed())
+					Expect(shootClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node2"}})).To(Succeed())
+					Expect(shootClient.Create(ctx, &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: "app-0", Namespace: "default", Labels: map[string]string{"app": "app"}},
+						Spec:       corev1.PodSpec{NodeName: "node1"},
+					})).To(Succeed())
+					Expect(shootClient.Create(ctx, &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: "app-1", Namespace: "default", Labels: map[string]string{"app": "app"}},
+						Spec:       corev1.PodSpec{NodeName: "node2"},
+					})).To(Succeed())
+					Expect(shootClient.Create(ctx, &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "app-ds",
+							Namespace:       "default",
+							Labels:          map[string]string{"app": "app"},
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "app", UID: "1", Controller: ptr.To(true)}},
+						},
+						Spec: corev1.PodSpec{NodeName: "node1"},
+					})).To(Succeed())
+					machine := &machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:       "machine1",
+							Namespace:  controlPlaneNamespace,
+							Labels:     map[string]string{"node": "node1"},
+							Finalizers: []string{"machine.sapcloud.io/machine-controller-manager"},
+						},
+						Spec: machinev1alpha1.MachineSpec{MachineConfiguration: &machinev1alpha1.MachineConfiguration{
+							MachineDrainTimeout: &metav1.Duration{Duration: time.Hour},
+						}},
+					}
+					Expect(seedClient.Create(ctx, machine)).To(Succeed())
+					Expect(seedClient.Delete(ctx, machine)).To(Succeed())
+					Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(machine), machine)).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootNodeDrainPossible),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("DrainBlockedByPodDisruptionBudgets"),
+						WithMessage("Some PodDisruptionBudgets currently block the drain of nodes: default/app blocks eviction of pod app-0 on node node1 (drain timeout expires at "+machine.DeletionTimestamp.Add(time.Hour).UTC().Format(time.RFC3339)+"). To unblock the drain"),
+					))
+				})
+
+				It("should set the 'NodeDrainPossible' constraint to true for workerless shoots", func() {
+					Expect(shootClient.Create(ctx, pdb)).To(Succeed())
+
+					shootPkg := &shootpkg.Shoot{
+						ControlPlaneNamespace: controlPlaneNamespace,
+						KubernetesVersion:     kubernetesVersion,
+					}
+					shootPkg.SetInfo(&gardencorev1beta1.Shoot{})
+
+					constraint = NewConstraint(
+						logr.Discard(),
+						shootPkg,
+						seedClient,
+						func() (kubernetes.Interface, bool, error) {
+							return shootClientSet, true, nil
+						},
+						querier,
+						clock,
+					)
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootNodeDrainPossible),
+					))
+				})
+			})
+
 			Context("#ManualInPlaceWorkersUpdated", func() {
 				BeforeEach(func() {
 					shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
@@ -578,6 +824,7 @@ var _ = Describe("Constraints", func() {
 					}
 					shootPkg := &shootpkg.Shoot{
 						ControlPlaneNamespace: controlPlaneNamespace,
+						KubernetesVersion:     kubernetesVersion,
 					}
 					shootPkg.SetInfo(shoot)
 
@@ -586,8 +833,9 @@ var _ = Describe("Constraints", func() {
 						shootPkg,
 						seedClient,
 						func() (kubernetes.Interface, bool, error) {
-							return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+							return shootClientSet, true, nil
 						},
+						querier,
 						clock,
 					)
 				})
@@ -597,6 +845,7 @@ var _ = Describe("Constraints", func() {
 
 					shootPkg := &shootpkg.Shoot{
 						ControlPlaneNamespace: controlPlaneNamespace,
+						KubernetesVersion:     kubernetesVersion,
 					}
 					shootPkg.SetInfo(shoot)
 
@@ -605,8 +854,9 @@ var _ = Describe("Constraints", func() {
 						shootPkg,
 						seedClient,
 						func() (kubernetes.Interface, bool, error) {
-							return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+							return shootClientSet, true, nil
 						},
+						querier,
 						clock,
 					)
 
@@ -620,6 +870,7 @@ var _ = Describe("Constraints", func() {
 
 					shootPkg := &shootpkg.Shoot{
 						ControlPlaneNamespace: controlPlaneNamespace,
+						KubernetesVersion:     kubernetesVersion,
 					}
 					shootPkg.SetInfo(shoot)
 
@@ -628,8 +879,9 @@ var _ = Describe("Constraints", func() {
 						shootPkg,
 						seedClient,
 						func() (kubernetes.Interface, bool, error) {
-							return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+							return shootClientSet, true, nil
 						},
+						querier,
 						clock,
 					)
 
@@ -655,6 +907,7 @@ var _ = Describe("Constraints", func() {
 
 					shootPkg := &shootpkg.Shoot{
 						ControlPlaneNamespace: controlPlaneNamespace,
+						KubernetesVersion:     kubernetesVersion,
 					}
 					shootPkg.SetInfo(shoot)
 
@@ -663,8 +916,9 @@ var _ = Describe("Constraints", func() {
 						shootPkg,
 						seedClient,
 						func() (kubernetes.Interface, bool, error) {
-							return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+							return shootClientSet, true, nil
 						},
+						querier,
 						clock,
 					)
 
@@ -758,6 +1012,8 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -778,6 +1034,8 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -792,6 +1050,8 @@ var _ = Describe("Constraints", func() {
 					OfType("CACertificateValiditiesAcceptable"),
 					OfType("CRDsWithProblematicConversionWebhooks"),
 					OfType("ManualInPlaceWorkersUpdated"),
+					OfType("UpgradeReadiness"),
+					OfType("NodeDrainPossible"),
 				))
 			})
 		})
@@ -806,8 +1066,24 @@ var _ = Describe("Constraints", func() {
 					gardencorev1beta1.ConditionType("CACertificateValiditiesAcceptable"),
 					gardencorev1beta1.ConditionType("CRDsWithProblematicConversionWebhooks"),
 					gardencorev1beta1.ConditionType("ManualInPlaceWorkersUpdated"),
+					gardencorev1beta1.ConditionType("UpgradeReadiness"),
+					gardencorev1beta1.ConditionType("NodeDrainPossible"),
 				))
 			})
 		})
 	})
 })
+
+type fakeQuerier struct {
+	result  model.Vector
+	err     error
+	queries []string
+}
+
+func (q *fakeQuerier) Query(_ context.Context, query string, _ time.Time, _ ...promv1.Option) (model.Value, promv1.Warnings, error) {
+	q.queries = append(q.queries, query)
+	if q.err != nil {
+		return nil, nil, q.err
+	}
+	return q.result, nil, nil
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/common/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils/gardener/customhealthchecks"
+)
+
+// CheckCustomHealthChecks evaluates the custom health checks declared for the Shoot.
+func (h *Health) CheckCustomHealthChecks(ctx context.Context, shootClient client.Client, condition gardencorev1beta1.Condition) (*gardencorev1beta1.Condition, error) {
+	customHealthChecks := h.shoot.GetInfo().Spec.CustomHealthChecks
+	if len(customHealthChecks) == 0 {
+		return nil, errors.New("no custom health checks are declared")
+	}
+
+	var failedChecks []string
+	for _, check := range customHealthChecks {
+		if err := evaluateCustomHealthCheck(ctx, shootClient, check); err != nil {
+			failedChecks = append(failedChecks, fmt.Sprintf("%s (%s)", check.Name, err.Error()))
+		}
+	}
+
+	if len(failedChecks) > 0 {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "CustomHealthChecksFailed",
+			fmt.Sprintf("Some custom health checks failed: %s.", joinLimited(failedChecks)))
+		return &c, nil
+	}
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "CustomHealthChecksPassed",
+		fmt.Sprintf("All %d custom health checks passed.", len(customHealthChecks)))
+	return &c, nil
+}
+
+// evaluateCustomHealthCheck returns an error describing why the given check failed, or nil if it passed.
+func evaluateCustomHealthCheck(ctx context.Context, shootClient client.Client, check gardencorev1beta1.CustomHealthCheck) error {
+	program, err := customhealthchecks.Compile(check.Expression)
+	if err != nil {
+		return fmt.Errorf("failed compiling expression: %w", err)
+	}
+
+	var (
+		gvk       = schema.FromAPIVersionAndKind(check.Resource.APIVersion, check.Resource.Kind)
+		namespace = ptr.Deref(check.Resource.Namespace, "")
+		objects   []unstructured.Unstructured
+	)
+
+	if name := ptr.Deref(check.Resource.Name, ""); name != "" {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		if err := shootClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("%s %s not found", check.Resource.Kind, client.ObjectKey{Namespace: namespace, Name: name})
+			}
+			return err
+		}
+		objects = append(objects, *obj)
+	} else {
+		selector, err := metav1.LabelSelectorAsSelector(check.Resource.LabelSelector)
+		if err != nil {
+			return err
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := shootClient.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return err
+		}
+		if len(list.Items) == 0 {
+			return fmt.Errorf("no %s objects match the label selector", check.Resource.Kind)
+		}
+		objects = list.Items
+	}
+
+	for _, obj := range objects {
+		result, _, err := program.Eval(map[string]any{customhealthchecks.ObjectVariable: obj.Object})
+		if err != nil {
+			return fmt.Errorf("failed evaluating expression for %s %s: %w", check.Resource.Kind, client.ObjectKeyFromObject(&obj), err)
+		}
+
+		if result != types.True {
+			if check.Message != nil {
+				return fmt.Errorf("%s %s: %s", check.Resource.Kind, client.ObjectKeyFromObject(&obj), *check.Message)
+			}
+			return fmt.Errorf("expression evaluated to %v for %s %s", result, check.Resource.Kind, client.ObjectKeyFromObject(&obj))
+		}
+	}
+
+	return nil
+}
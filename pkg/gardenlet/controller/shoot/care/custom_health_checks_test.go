@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("CustomHealthChecks", func() {
+	Describe("#CheckCustomHealthChecks", func() {
+		var (
+			ctx          = context.Background()
+			fakeClock    *testclock.FakeClock
+			gardenClient client.Client
+			shootClient  client.Client
+			shootObj     *shootpkg.Shoot
+			health       *Health
+			condition    gardencorev1beta1.Condition
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Now())
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			condition = gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootCustomHealthChecksPassed}
+
+			shootObj = &shootpkg.Shoot{}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+				Spec: gardencorev1beta1.ShootSpec{CustomHealthChecks: []gardencorev1beta1.CustomHealthCheck{
+					{
+						Name: "ingress-ready",
+						Resource: gardencorev1beta1.CustomHealthCheckResource{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Namespace:  ptr.To("ingress"),
+							Name:       ptr.To("ingress-controller"),
+						},
+						Expression: "object.status.readyReplicas >= 2",
+						Message:    ptr.To("Less than two replicas are ready."),
+					},
+					{
+						Name: "apps-available",
+						Resource: gardencorev1beta1.CustomHealthCheckResource{
+							APIVersion:    "apps/v1",
+							Kind:          "Deployment",
+							Namespace:     ptr.To("apps"),
+							LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}},
+						},
+						Expression: "object.status.availableReplicas == object.spec.replicas",
+					},
+				}},
+			})
+			seedObj := &seedpkg.Seed{}
+			seedObj.SetInfo(&gardencorev1beta1.Seed{})
+
+			health = NewHealth(
+				logr.Discard(),
+				shootObj,
+				seedObj,
+				fakekubernetes.NewClientSetBuilder().Build(),
+				gardenClient,
+				nil,
+				fakeClock,
+				nil,
+				nil,
+			)
+		})
+
+		deployment := func(namespace, name string, labels map[string]string, replicas, ready int32) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+				Status:     appsv1.DeploymentStatus{ReadyReplicas: ready, AvailableReplicas: ready},
+			}
+		}
+
+		It("should report that all checks passed", func() {
+			Expect(shootClient.Create(ctx, deployment("ingress", "ingress-controller", nil, 2, 2))).To(Succeed())
+			Expect(shootClient.Create(ctx, deployment("apps", "app", map[string]string{"team": "foo"}, 3, 3))).To(Succeed())
+
+			newCondition, err := health.CheckCustomHealthChecks(ctx, shootClient, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newCondition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(newCondition.Reason).To(Equal("CustomHealthChecksPassed"))
+			Expect(newCondition.Message).To(Equal("All 2 custom health checks passed."))
+		})
+
+		It("should report the failed checks", func() {
+			Expect(shootClient.Create(ctx, deployment("ingress", "ingress-controller", nil, 2, 1))).To(Succeed())
+
+			newCondition, err := health.CheckCustomHealthChecks(ctx, shootClient, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(newCondition.Reason).To(Equal("CustomHealthChecksFailed"))
+			Expect(newCondition.Message).To(Equal("Some custom health checks failed: " +
+				"ingress-ready (Deployment ingress/ingress-controller: Less than two replicas are ready.), " +
+				"apps-available (no Deployment objects match the label selector)."))
+		})
+
+		It("should report missing objects as failed checks", func() {
+			Expect(shootClient.Create(ctx, deployment("apps", "app", map[string]string{"team": "foo"}, 3, 2))).To(Succeed())
+
+			newCondition, err := health.CheckCustomHealthChecks(ctx, shootClient, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(newCondition.Message).To(Equal("Some custom health checks failed: " +
+				"ingress-ready (Deployment ingress/ingress-controller not found), " +
+				"apps-available (expression evaluated to false for Deployment apps/app)."))
+		})
+
+		It("should report checks with invalid expressions as failed checks", func() {
+			shootObj.GetInfo().Spec.CustomHealthChecks[0].Expression = "'foo'"
+			Expect(shootClient.Create(ctx, deployment("apps", "app", map[string]string{"team": "foo"}, 3, 3))).To(Succeed())
+
+			newCondition, err := health.CheckCustomHealthChecks(ctx, shootClient, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(newCondition.Message).To(ContainSubstring("ingress-ready (failed compiling expression: expression must evaluate to bool"))
+		})
+
+		It("should return an error if no custom health checks are declared", func() {
+			shootObj.GetInfo().Spec.CustomHealthChecks = nil
+
+			_, err := health.CheckCustomHealthChecks(ctx, shootClient, condition)
+			Expect(err).To(MatchError("no custom health checks are declared"))
+		})
+	})
+})
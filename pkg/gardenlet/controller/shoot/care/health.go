@@ -7,14 +7,18 @@ package care
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,6 +43,7 @@ import (
 	"github.com/gardener/gardener/pkg/extensions"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
@@ -177,6 +182,15 @@ func (h *Health) Check(
 					return nil
 				})
 		}
+		if conditions.customHealthChecksPassed != nil {
+			taskFns = append(taskFns,
+				func(ctx context.Context) error {
+					newCustomHealthChecks, err := h.CheckCustomHealthChecks(ctx, shootClient.Client(), *conditions.customHealthChecksPassed)
+					customHealthChecksCondition := v1beta1helper.NewConditionOrError(h.clock, *conditions.customHealthChecksPassed, newCustomHealthChecks, err)
+					conditions.customHealthChecksPassed = &customHealthChecksCondition
+					return nil
+				})
+		}
 	} else {
 		// Some health checks cannot be executed when the API server is not running.
 		// Maintain the affected conditions here.
@@ -192,6 +206,10 @@ func (h *Health) Check(
 			nodeCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.everyNodeReady, message)
 			conditions.everyNodeReady = &nodeCondition
 		}
+		if conditions.customHealthChecksPassed != nil {
+			customHealthChecksCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.customHealthChecksPassed, message)
+			conditions.customHealthChecksPassed = &customHealthChecksCondition
+		}
 	}
 
 	// Execute all relevant health checks.
@@ -593,6 +611,86 @@ func ComputeRequiredMonitoringSeedDeployments(shoot *gardencorev1beta1.Shoot) se
 	return requiredDeployments
 }
 
+// recordOperatingSystemConfigRolloutMetrics exposes the rollout status of the operating system config per worker pool
+// as metrics, so that the rollout can be observed without parsing the EveryNodeReady condition message.
+func recordOperatingSystemConfigRolloutMetrics(shoot *gardencorev1beta1.Shoot, statuses []botanist.OperatingSystemConfigRolloutStatus) {
+	// Reset the metrics of the shoot to drop states which no longer apply, e.g., failure reasons of previous syncs.
+	DeleteOperatingSystemConfigRolloutMetrics(shoot.Namespace, shoot.Name)
+
+	for _, status := range statuses {
+		set := func(state string, nodes int) {
+			gardenletmetrics.ShootOperatingSystemConfigRolloutNodes.With(prometheus.Labels{
+				"name":        shoot.Name,
+				"namespace":   shoot.Namespace,
+				"worker_pool": status.WorkerPool,
+				"state":       state,
+			}).Set(float64(nodes))
+		}
+
+		set("UpToDate", status.UpToDate)
+		set("Pending", status.Pending())
+		for reason, nodes := range status.Failed {
+			set(reason, nodes)
+		}
+	}
+}
+
+// updateOperatingSystemConfigRolloutStatus writes the rollout status of the operating system config per worker pool to
+// the status of the Worker resource of the shoot. The last update time of a worker pool is only changed if its rollout
+// status changes, hence the Worker is not patched with every health check.
+func (h *Health) updateOperatingSystemConfigRolloutStatus(ctx context.Context, statuses []botanist.OperatingSystemConfigRolloutStatus) error {
+	worker := &extensionsv1alpha1.Worker{}
+	if err := h.seedClient.Client().Get(ctx, client.ObjectKey{Name: h.shoot.GetInfo().Name, Namespace: h.shoot.ControlPlaneNamespace}, worker); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	var (
+		now     = metav1.NewTime(h.clock.Now())
+		rollout = make([]extensionsv1alpha1.WorkerPoolOperatingSystemConfigRollout, 0, len(statuses))
+	)
+
+	for _, status := range statuses {
+		poolRollout := extensionsv1alpha1.WorkerPoolOperatingSystemConfigRollout{
+			Name:     status.WorkerPool,
+			Nodes:    int32(status.Nodes),     // #nosec G115 -- the number of nodes of a worker pool is limited by its int32 maximum.
+			UpToDate: int32(status.UpToDate),  // #nosec G115 -- the number of nodes of a worker pool is limited by its int32 maximum.
+			Pending:  int32(status.Pending()), // #nosec G115 -- the number of nodes of a worker pool is limited by its int32 maximum.
+		}
+		for _, reason := range slices.Sorted(maps.Keys(status.Failed)) {
+			poolRollout.Failures = append(poolRollout.Failures, extensionsv1alpha1.OperatingSystemConfigRolloutFailure{
+				Reason: reason,
+				Nodes:  int32(status.Failed[reason]), // #nosec G115 -- the number of nodes of a worker pool is limited by its int32 maximum.
+			})
+		}
+
+		poolRollout.LastUpdateTime = now
+		if i := slices.IndexFunc(worker.Status.OperatingSystemConfigRollout, func(r extensionsv1alpha1.WorkerPoolOperatingSystemConfigRollout) bool {
+			return r.Name == poolRollout.Name
+		}); i >= 0 {
+			oldPoolRollout := worker.Status.OperatingSystemConfigRollout[i]
+			poolRollout.LastUpdateTime = oldPoolRollout.LastUpdateTime
+			if !apiequality.Semantic.DeepEqual(oldPoolRollout, poolRollout) {
+				poolRollout.LastUpdateTime = now
+			}
+		}
+
+		rollout = append(rollout, poolRollout)
+	}
+
+	if apiequality.Semantic.DeepEqual(worker.Status.OperatingSystemConfigRollout, rollout) {
+		return nil
+	}
+
+	patch := client.MergeFrom(worker.DeepCopy())
+	worker.Status.OperatingSystemConfigRollout = rollout
+	return h.seedClient.Client().Status().Patch(ctx, worker, patch)
+}
+
+// DeleteOperatingSystemConfigRolloutMetrics removes all operating system config rollout metrics of the given shoot.
+func DeleteOperatingSystemConfigRolloutMetrics(namespace, name string) {
+	gardenletmetrics.ShootOperatingSystemConfigRolloutNodes.DeletePartialMatch(prometheus.Labels{"name": name, "namespace": namespace})
+}
+
 // annotationKeyNotManagedByMCM is a constant for an annotation on the node resource that indicates that the node is not
 // handled by machine-controller-manager.
 const annotationKeyNotManagedByMCM = "node.machine.sapcloud.io/not-managed-by-mcm"
@@ -635,7 +733,12 @@ func (h *Health) CheckClusterNodes(
 		}
 	}
 
-	if err := botanist.OperatingSystemConfigUpdatedForAllWorkerPools(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta); err != nil {
+	rolloutStatuses, err := botanist.OperatingSystemConfigRolloutStatuses(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta)
+	recordOperatingSystemConfigRolloutMetrics(h.shoot.GetInfo(), rolloutStatuses)
+	if err := h.updateOperatingSystemConfigRolloutStatus(ctx, rolloutStatuses); err != nil {
+		h.log.Error(err, "Failed updating operating system config rollout status of Worker")
+	}
+	if err != nil {
 		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "OperatingSystemConfigOutdated", err.Error())
 		return &c, nil
 	}
@@ -992,6 +1095,7 @@ type ShootConditions struct {
 	observabilityComponentsHealthy gardencorev1beta1.Condition
 	systemComponentsHealthy        gardencorev1beta1.Condition
 	everyNodeReady                 *gardencorev1beta1.Condition
+	customHealthChecksPassed       *gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot conditions as a slice.
@@ -1006,7 +1110,13 @@ func (s ShootConditions) ConvertToSlice() []gardencorev1beta1.Condition {
 		conditions = append(conditions, *s.everyNodeReady)
 	}
 
-	return append(conditions, s.systemComponentsHealthy)
+	conditions = append(conditions, s.systemComponentsHealthy)
+
+	if s.customHealthChecksPassed != nil {
+		conditions = append(conditions, *s.customHealthChecksPassed)
+	}
+
+	return conditions
 }
 
 // ConditionTypes returns all shoot condition types.
@@ -1021,7 +1131,13 @@ func (s ShootConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
 		types = append(types, gardencorev1beta1.ShootEveryNodeReady)
 	}
 
-	return append(types, s.systemComponentsHealthy.Type)
+	types = append(types, s.systemComponentsHealthy.Type)
+
+	if s.customHealthChecksPassed != nil {
+		types = append(types, gardencorev1beta1.ShootCustomHealthChecksPassed)
+	}
+
+	return types
 }
 
 // NewShootConditions returns a new instance of ShootConditions.
@@ -1039,5 +1155,10 @@ func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoot
 		shootConditions.everyNodeReady = &nodeCondition
 	}
 
+	if len(shoot.Spec.CustomHealthChecks) > 0 {
+		customHealthChecksCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootCustomHealthChecksPassed)
+		shootConditions.customHealthChecksPassed = &customHealthChecksCondition
+	}
+
 	return shootConditions
 }
@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
@@ -28,10 +29,12 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -420,6 +423,94 @@ var _ = Describe("health check", func() {
 				},
 				PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "OperatingSystemConfigOutdated", fmt.Sprintf("the last successfully applied operating system config on node %q is outdated", nodeName)))),
 		)
+
+		It("should expose the operating system config rollout as metrics", func() {
+			node := newNode(labels.Set{"worker.gardener.cloud/pool": workerPoolName1, "worker.gardener.cloud/kubernetes-version": kubernetesVersion.Original()}, map[string]string{
+				nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig:  "outdated",
+				nodeagentconfigv1alpha1.AnnotationKeyChecksumReportedOperatingSystemConfig: cloudConfigSecretChecksum1,
+			}, kubernetesVersion.Original())
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: "OperatingSystemConfigApplied", Status: corev1.ConditionFalse, Reason: "ApplyFailed"})
+
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.NodeList{})).DoAndReturn(func(_ context.Context, list *corev1.NodeList, _ ...client.ListOption) error {
+				*list = corev1.NodeList{Items: []corev1.Node{node}}
+				return nil
+			})
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.SecretList{}), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, list *corev1.SecretList, _ ...client.ListOption) error {
+				*list = corev1.SecretList{Items: []corev1.Secret{{ObjectMeta: oscSecretMeta[workerPoolName1]}}}
+				return nil
+			})
+
+			shootObj := &shootpkg.Shoot{ControlPlaneNamespace: controlPlaneNamespace, KubernetesVersion: kubernetesVersion}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+				Spec:       gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Name: workerPoolName1, Maximum: 10, Minimum: 1}}}},
+			})
+			seedObj := &seedpkg.Seed{}
+			seedObj.SetInfo(&gardencorev1beta1.Seed{})
+
+			health := NewHealth(logr.Discard(), shootObj, seedObj, fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), nil, nil, fakeClock, nil, nil)
+			DeferCleanup(func() { DeleteOperatingSystemConfigRolloutMetrics("garden-bar", "foo") })
+
+			exitCondition, err := health.CheckClusterNodes(ctx, fakekubernetes.NewClientSetBuilder().WithClient(c).Build(), condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "OperatingSystemConfigOutdated", "0/1 nodes have applied the latest operating system config, 1 failing (ApplyFailed: 1)")))
+
+			Expect(testutil.ToFloat64(gardenletmetrics.ShootOperatingSystemConfigRolloutNodes.WithLabelValues("foo", "garden-bar", workerPoolName1, "UpToDate"))).To(Equal(0.0))
+			Expect(testutil.ToFloat64(gardenletmetrics.ShootOperatingSystemConfigRolloutNodes.WithLabelValues("foo", "garden-bar", workerPoolName1, "Pending"))).To(Equal(0.0))
+			Expect(testutil.ToFloat64(gardenletmetrics.ShootOperatingSystemConfigRolloutNodes.WithLabelValues("foo", "garden-bar", workerPoolName1, "ApplyFailed"))).To(Equal(1.0))
+		})
+
+		It("should write the operating system config rollout to the Worker status", func() {
+			node := newNode(labels.Set{"worker.gardener.cloud/pool": workerPoolName1, "worker.gardener.cloud/kubernetes-version": kubernetesVersion.Original()}, map[string]string{
+				nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig:  "outdated",
+				nodeagentconfigv1alpha1.AnnotationKeyChecksumReportedOperatingSystemConfig: cloudConfigSecretChecksum1,
+			}, kubernetesVersion.Original())
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: "OperatingSystemConfigApplied", Status: corev1.ConditionFalse, Reason: "ApplyFailed"})
+
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.NodeList{})).DoAndReturn(func(_ context.Context, list *corev1.NodeList, _ ...client.ListOption) error {
+				*list = corev1.NodeList{Items: []corev1.Node{node}}
+				return nil
+			}).Times(2)
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.SecretList{}), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, list *corev1.SecretList, _ ...client.ListOption) error {
+				*list = corev1.SecretList{Items: []corev1.Secret{{ObjectMeta: oscSecretMeta[workerPoolName1]}}}
+				return nil
+			}).Times(2)
+
+			fakeClock.SetTime(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC))
+			worker := &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: controlPlaneNamespace}}
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(worker).WithStatusSubresource(worker).Build()
+
+			shootObj := &shootpkg.Shoot{ControlPlaneNamespace: controlPlaneNamespace, KubernetesVersion: kubernetesVersion}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+				Spec:       gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Name: workerPoolName1, Maximum: 10, Minimum: 1}}}},
+			})
+			seedObj := &seedpkg.Seed{}
+			seedObj.SetInfo(&gardencorev1beta1.Seed{})
+
+			health := NewHealth(logr.Discard(), shootObj, seedObj, fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), nil, nil, fakeClock, nil, nil)
+			DeferCleanup(func() { DeleteOperatingSystemConfigRolloutMetrics("garden-bar", "foo") })
+
+			_, err := health.CheckClusterNodes(ctx, fakekubernetes.NewClientSetBuilder().WithClient(c).Build(), condition)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedRollout := []extensionsv1alpha1.WorkerPoolOperatingSystemConfigRollout{{
+				Name:           workerPoolName1,
+				Nodes:          1,
+				Failures:       []extensionsv1alpha1.OperatingSystemConfigRolloutFailure{{Reason: "ApplyFailed", Nodes: 1}},
+				LastUpdateTime: metav1.NewTime(fakeClock.Now()),
+			}}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(worker), worker)).To(Succeed())
+			Expect(worker.Status.OperatingSystemConfigRollout).To(BeComparableTo(expectedRollout))
+
+			By("keeping the last update time if the rollout did not change")
+			fakeClock.Step(time.Minute)
+			_, err = health.CheckClusterNodes(ctx, fakekubernetes.NewClientSetBuilder().WithClient(c).Build(), condition)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(worker), worker)).To(Succeed())
+			Expect(worker.Status.OperatingSystemConfigRollout).To(BeComparableTo(expectedRollout))
+		})
 	})
 
 	Describe("#CheckNodesScaling", func() {
@@ -1057,6 +1148,22 @@ var _ = Describe("health check", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
+
+			It("should initialize the custom health checks condition if the shoot declares custom health checks", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						CustomHealthChecks: []gardencorev1beta1.CustomHealthCheck{{Name: "foo"}},
+					},
+				})
+
+				Expect(conditions.ConditionTypes()).To(HaveExactElements(
+					gardencorev1beta1.ConditionType("APIServerAvailable"),
+					gardencorev1beta1.ConditionType("ControlPlaneHealthy"),
+					gardencorev1beta1.ConditionType("ObservabilityComponentsHealthy"),
+					gardencorev1beta1.ConditionType("SystemComponentsHealthy"),
+					gardencorev1beta1.ConditionType("CustomHealthChecksPassed"),
+				))
+			})
 		})
 
 		Describe("#ConvertToSlice", func() {
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -52,6 +53,7 @@ type Reconciler struct {
 	ShootClientMap        clientmap.ClientMap
 	Config                gardenletconfigv1alpha1.GardenletConfiguration
 	Clock                 clock.Clock
+	Recorder              record.EventRecorder
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	SeedName              string
@@ -73,6 +75,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if err := r.GardenClient.Get(ctx, req.NamespacedName, shoot); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			DeleteOperatingSystemConfigRolloutMetrics(req.Namespace, req.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -87,6 +90,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	// if shoot is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue.
 	if ptr.Deref(shoot.Status.SeedName, "") != r.SeedName {
+		DeleteOperatingSystemConfigRolloutMetrics(shoot.Namespace, shoot.Name)
 		return reconcile.Result{}, nil
 	}
 
@@ -159,8 +163,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		prometheusQuerier                     Querier
 	)
 
+	if o.IsShootMonitoringEnabled() {
+		if prometheusQuerier, err = NewShootPrometheusQuerier(o.Shoot.ControlPlaneNamespace); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed creating client for shoot Prometheus: %w", err)
+		}
+	}
+
 	if err := flow.Parallel(
 		// Trigger health check
 		func(ctx context.Context) error {
@@ -188,6 +199,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 				o.Shoot,
 				r.SeedClientSet.Client(),
 				initializeShootClients,
+				prometheusQuerier,
 				clock.RealClock{},
 			).Check(
 				ctx,
@@ -218,6 +230,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
+	r.emitNodeDrainBlockedEvent(shoot, shootConstraints, updatedConstraints)
+
+	if tracking := r.Config.Controllers.ShootCare.AvailabilityTracking; tracking != nil && tracking.Window != nil {
+		if err := NewAvailabilityTracker(r.GardenClient, r.Clock, shoot, tracking.Window.Duration).Track(ctx, updatedConditions); err != nil {
+			// errors during availability tracking are only being logged and do not cause the care operation to fail
+			log.Error(err, "Error when trying to track the availability of the shoot conditions")
+		}
+	}
+
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.ShootCare.SyncPeriod.Duration}, nil
 }
 
@@ -231,13 +252,16 @@ func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1b
 
 func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition) error {
 	// Update Shoot status (conditions, constraints) only if necessary
-	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) && !v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) {
+	// The CustomHealthChecksPassed condition is removed as soon as the shoot no longer references custom health checks.
+	staleCustomHealthChecksCondition := existingConditions.customHealthChecksPassed == nil && v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootCustomHealthChecksPassed) != nil
+
+	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) && !v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) && !staleCustomHealthChecksCondition {
 		return nil
 	}
 
 	// Rebuild shoot conditions and constraints to ensure that only the conditions and constraints with the
 	// correct types will be updated, and any other conditions will remain intact
-	mergedConditions := v1beta1helper.BuildConditions(shoot.Status.Conditions, updatedConditions, existingConditions.ConditionTypes())
+	mergedConditions := v1beta1helper.BuildConditions(shoot.Status.Conditions, updatedConditions, append(existingConditions.ConditionTypes(), gardencorev1beta1.ShootCustomHealthChecksPassed))
 	mergedConstraints := v1beta1helper.BuildConditions(shoot.Status.Constraints, updatedConstraints, existingConstraints.ConstraintTypes())
 
 	log.V(1).Info("Updating status conditions and constraints")
@@ -248,6 +272,21 @@ func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *ga
 	return r.GardenClient.Status().Patch(ctx, shoot, patch)
 }
 
+// emitNodeDrainBlockedEvent emits a warning event for the shoot when PodDisruptionBudgets start blocking the drain of
+// nodes (or block other pods than before), so that the shoot owners can react before the machine drain timeout expires.
+func (r *Reconciler) emitNodeDrainBlockedEvent(shoot *gardencorev1beta1.Shoot, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition) {
+	constraint := v1beta1helper.GetCondition(updatedConstraints, gardencorev1beta1.ShootNodeDrainPossible)
+	if constraint == nil || constraint.Reason != reasonDrainBlockedByPodDisruptionBudgets {
+		return
+	}
+
+	if existingConstraints.nodeDrainPossible.Reason == constraint.Reason && existingConstraints.nodeDrainPossible.Message == constraint.Message {
+		return
+	}
+
+	r.Recorder.Event(shoot, corev1.EventTypeWarning, "NodeDrainBlocked", constraint.Message)
+}
+
 func (r *Reconciler) setStatusToUnknown(message string, conditions []gardencorev1beta1.Condition, constraints []gardencorev1beta1.Condition) ([]gardencorev1beta1.Condition, []gardencorev1beta1.Condition) {
 	updatedConditions := make([]gardencorev1beta1.Condition, 0, len(conditions))
 	for _, cond := range conditions {
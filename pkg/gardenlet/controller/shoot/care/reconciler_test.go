@@ -15,6 +15,7 @@ import (
 	"github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
@@ -36,6 +37,7 @@ import (
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/availability"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -246,6 +248,7 @@ var _ = Describe("Shoot Care Control", func() {
 				shootClientMap clientmap.ClientMap
 				managedSeed    *seedmanagementv1alpha1.ManagedSeed
 				operationFunc  NewOperationFunc
+				fakeRecorder   *record.FakeRecorder
 			)
 
 			JustBeforeEach(func() {
@@ -265,12 +268,14 @@ var _ = Describe("Shoot Care Control", func() {
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
 				))
+				fakeRecorder = record.NewFakeRecorder(1)
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
 					SeedClientSet:  fakekubernetes.NewClientSet(),
 					ShootClientMap: shootClientMap,
 					Config:         gardenletConf,
 					Clock:          fakeClock,
+					Recorder:       fakeRecorder,
 					SeedName:       seedName,
 				}
 			})
@@ -366,6 +371,63 @@ var _ = Describe("Shoot Care Control", func() {
 					Expect(updatedShoot.Status.Conditions).To(ConsistOf(apiServerCondition))
 					Expect(updatedShoot.Status.Constraints).To(ConsistOf(hibernationConstraint))
 				})
+
+				It("should remove the custom health checks condition if the shoot no longer declares custom health checks", func() {
+					apiServerCondition := gardencorev1beta1.Condition{
+						Type:   gardencorev1beta1.ShootAPIServerAvailable,
+						Status: gardencorev1beta1.ConditionTrue,
+					}
+
+					customHealthChecksCondition := gardencorev1beta1.Condition{
+						Type:   gardencorev1beta1.ShootCustomHealthChecksPassed,
+						Status: gardencorev1beta1.ConditionTrue,
+					}
+
+					shoot.Status.Conditions = []gardencorev1beta1.Condition{apiServerCondition, customHealthChecksCondition}
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Conditions).To(ContainElement(apiServerCondition))
+					Expect(updatedShoot.Status.Conditions).NotTo(ContainElement(OfType(gardencorev1beta1.ShootCustomHealthChecksPassed)))
+				})
+			})
+
+			Context("when PodDisruptionBudgets block the drain of nodes", func() {
+				var nodeDrainConstraint gardencorev1beta1.Condition
+
+				BeforeEach(func() {
+					nodeDrainConstraint = gardencorev1beta1.Condition{
+						Type:    gardencorev1beta1.ShootNodeDrainPossible,
+						Status:  gardencorev1beta1.ConditionFalse,
+						Reason:  "DrainBlockedByPodDisruptionBudgets",
+						Message: "Some PodDisruptionBudgets currently block the drain of nodes: default/app blocks eviction of pod app-0 on node node1.",
+					}
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition {
+							return []gardencorev1beta1.Condition{nodeDrainConstraint}
+						}),
+					))
+				})
+
+				It("should emit a warning event for the shoot", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					Expect(fakeRecorder.Events).To(Receive(Equal("Warning NodeDrainBlocked " + nodeDrainConstraint.Message)))
+				})
+
+				It("should not emit another event if the blocked drains did not change", func() {
+					shoot.Status.Constraints = []gardencorev1beta1.Condition{nodeDrainConstraint}
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					Expect(fakeRecorder.Events).NotTo(Receive())
+				})
 			})
 
 			Context("when conditions / constraints are changed", func() {
@@ -431,6 +493,26 @@ var _ = Describe("Shoot Care Control", func() {
 					Expect(updatedShoot.Status.Constraints).To(ConsistOf(constraints))
 				})
 
+				Context("when availability tracking is enabled", func() {
+					BeforeEach(func() {
+						gardenletConf.Controllers.ShootCare.AvailabilityTracking = &gardenletconfigv1alpha1.ShootAvailabilityTracking{
+							Window: &metav1.Duration{Duration: 24 * time.Hour},
+						}
+					})
+
+					It("should record the availability of the shoot conditions", func() {
+						Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+						configMap := &corev1.ConfigMap{}
+						Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Name + ".availability"}, configMap)).To(Succeed())
+
+						record, err := availability.FromConfigMap(configMap)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(record.Window.Duration).To(Equal(24 * time.Hour))
+						Expect(record.Conditions).To(HaveLen(len(conditions)))
+					})
+				})
+
 				Context("when shoot doesn't have a last operation", func() {
 					It("should update the shoot conditions", func() {
 						apiServerCondition := gardencorev1beta1.Condition{
@@ -579,6 +661,7 @@ func constraintCheckFunc(fn resultingConstraintFunc) NewConstraintCheckFunc {
 		_ *shootpkg.Shoot,
 		_ client.Client,
 		_ ShootClientInit,
+		_ Querier,
 		_ clock.Clock,
 	) ConstraintCheck {
 		return fn
@@ -652,7 +735,7 @@ func containConditionsInUnknownStatus(message string, isWorkerless bool) types.G
 }
 
 func containConstraintsInUnknownStatus(message string) types.GomegaMatcher {
-	var expectedLength = 6
+	var expectedLength = 8
 	matcher := And(
 		ContainCondition(
 			OfType(gardencorev1beta1.ShootHibernationPossible),
@@ -676,6 +759,14 @@ func containConstraintsInUnknownStatus(message string) types.GomegaMatcher {
 			OfType(gardencorev1beta1.ShootManualInPlaceWorkersUpdated),
 			WithStatus(gardencorev1beta1.ConditionUnknown),
 			WithMessage(message),
+		), ContainCondition(
+			OfType(gardencorev1beta1.ShootUpgradeReadiness),
+			WithStatus(gardencorev1beta1.ConditionUnknown),
+			WithMessage(message),
+		), ContainCondition(
+			OfType(gardencorev1beta1.ShootNodeDrainPossible),
+			WithStatus(gardencorev1beta1.ConditionUnknown),
+			WithMessage(message),
 		),
 	)
 
@@ -78,6 +78,7 @@ type NewConstraintCheckFunc func(
 	shoot *shoot.Shoot,
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
+	prometheusQuerier Querier,
 	clock clock.Clock,
 ) ConstraintCheck
 
@@ -87,6 +88,7 @@ var defaultNewConstraintCheck = func(
 	shoot *shoot.Shoot,
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
+	prometheusQuerier Querier,
 	clock clock.Clock,
 ) ConstraintCheck {
 	return NewConstraint(
@@ -94,6 +96,7 @@ var defaultNewConstraintCheck = func(
 		shoot,
 		seedClient,
 		shootClientInit,
+		prometheusQuerier,
 		clock,
 	)
 }
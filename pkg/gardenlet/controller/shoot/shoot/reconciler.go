@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -421,6 +422,7 @@ func (r *Reconciler) initializeOperation(
 	op, err := operation.
 		NewBuilder().
 		WithLogger(log).
+		WithRecorder(r.Recorder).
 		WithConfig(&r.Config).
 		WithGardenerInfo(r.Identity).
 		WithGardenClusterIdentity(r.GardenClusterIdentity).
@@ -741,6 +743,7 @@ func (r *Reconciler) patchShootStatusOperationSuccess(
 	}
 
 	patch := client.StrategicMergeFrom(shoot.DeepCopy())
+	operationStartTime := shoot.Status.RetryCycleStartTime
 
 	if seedName != nil {
 		isHibernated, err := r.isHibernationActive(ctx, shoot.Status.TechnicalID, seedName)
@@ -886,7 +889,12 @@ func (r *Reconciler) patchShootStatusOperationSuccess(
 		}
 	}
 
-	return r.GardenClient.Status().Patch(ctx, shoot, patch)
+	if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	reportLastOperationDuration(shoot, operationType, operationStartTime, now)
+	return nil
 }
 
 func (r *Reconciler) patchShootStatusOperationError(
@@ -1204,6 +1212,22 @@ func reportMetrics(shoot *gardencorev1beta1.Shoot, operationType gardencorev1bet
 		Observe(float64(duration.Seconds()))
 }
 
+// reportLastOperationDuration reports the duration of the last successful operation of the shoot. Shoots which are
+// deleted or migrated to another seed are no longer handled by this gardenlet, hence their metrics are removed.
+func reportLastOperationDuration(shoot *gardencorev1beta1.Shoot, operationType gardencorev1beta1.LastOperationType, operationStartTime *metav1.Time, now metav1.Time) {
+	gardenletmetrics.ShootLastOperationDurationSeconds.DeletePartialMatch(prometheus.Labels{"name": shoot.Name, "namespace": shoot.Namespace})
+
+	if operationType == gardencorev1beta1.LastOperationTypeDelete ||
+		operationType == gardencorev1beta1.LastOperationTypeMigrate ||
+		operationStartTime == nil {
+		return
+	}
+
+	gardenletmetrics.ShootLastOperationDurationSeconds.
+		WithLabelValues(shoot.Name, shoot.Namespace, string(operationType)).
+		Set(now.Sub(operationStartTime.Time).Seconds())
+}
+
 func manualInPlacePendingWorkersPresent(inPlaceUpdates *gardencorev1beta1.InPlaceUpdatesStatus) bool {
 	return inPlaceUpdates != nil && inPlaceUpdates.PendingWorkerUpdates != nil && len(inPlaceUpdates.PendingWorkerUpdates.ManualInPlaceUpdate) > 0
 }
@@ -215,6 +215,11 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			SkipIf:       !nonTerminatingNamespace,
 			Dependencies: flow.NewTaskIDs(deployNamespace),
 		})
+		resolveTelemetryConfiguration = g.Add(flow.Task{
+			Name:   "Resolving referenced audit sink and telemetry export configuration",
+			Fn:     flow.TaskFn(botanist.ResolveTelemetryConfiguration).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf: !cleanupShootResources,
+		})
 		deployInternalDomainDNSRecord = g.Add(flow.Task{
 			Name:         "Deploying internal domain DNS record",
 			Fn:           botanist.DeployOrDestroyInternalDNSRecord,
@@ -263,6 +268,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			SkipIf: !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(
 				initializeSecretsManagement,
+				resolveTelemetryConfiguration,
 				deployETCD,
 				waitUntilEtcdReady,
 				waitUntilKubeAPIServerServiceIsReady,
@@ -154,13 +154,18 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 			SkipIf:       !cleanupShootResources && !etcdSnapshotRequired,
 			Dependencies: flow.NewTaskIDs(deployETCD, scaleUpETCD),
 		})
+		resolveTelemetryConfiguration = g.Add(flow.Task{
+			Name:   "Resolving referenced audit sink and telemetry export configuration",
+			Fn:     flow.TaskFn(botanist.ResolveTelemetryConfiguration).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf: !wakeupRequired,
+		})
 		wakeUpKubeAPIServer = g.Add(flow.Task{
 			Name: "Scaling Kubernetes API Server up and waiting until ready",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.WakeUpKubeAPIServer(ctx, canEnableNodeAgentAuthorizerWebhook)
 			}),
 			SkipIf:       !wakeupRequired,
-			Dependencies: flow.NewTaskIDs(deployETCD, scaleUpETCD, initializeSecretsManagement),
+			Dependencies: flow.NewTaskIDs(deployETCD, scaleUpETCD, initializeSecretsManagement, resolveTelemetryConfiguration),
 		})
 		// Deploy gardener-resource-manager to re-run the bootstrap logic if needed (e.g. when the token is expired because of hibernation).
 		// This fixes https://github.com/gardener/gardener/issues/7606
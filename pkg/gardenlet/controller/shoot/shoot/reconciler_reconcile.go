@@ -183,10 +183,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Fn:           flow.TaskFn(botanist.InitializeSecretsManagement).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(deployNamespace, reconcileIstioInternalLoadbalancingConfigMap),
 		})
+		resolveTelemetryConfiguration = g.Add(flow.Task{
+			Name: "Resolving referenced audit sink and telemetry export configuration",
+			Fn:   flow.TaskFn(botanist.ResolveTelemetryConfiguration).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
 		initialValiDeployment = g.Add(flow.Task{
 			Name:         "Deploying initial shoot logging stack in Seed",
 			Fn:           flow.TaskFn(botanist.DeployLogging).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(deployNamespace, initializeSecretsManagement),
+			Dependencies: flow.NewTaskIDs(deployNamespace, initializeSecretsManagement, resolveTelemetryConfiguration),
 		})
 		deployReferencedResources = g.Add(flow.Task{
 			Name:         "Deploying referenced resources",
@@ -207,6 +211,11 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 						return err
 					}
 				}
+				if botanist.Shoot.Components.Extensions.Infrastructure.PlannedChanges() != nil {
+					// The planned changes have not been applied, i.e., the remaining steps continue with the previously
+					// applied infrastructure. Keep the task so that the changes are applied once the owner approved them.
+					return nil
+				}
 				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskDeployInfrastructure)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless,
@@ -334,6 +343,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			}).RetryUntilTimeout(defaultInterval, deployKubeAPIServerTaskTimeout),
 			Dependencies: flow.NewTaskIDs(
 				initializeSecretsManagement,
+				resolveTelemetryConfiguration,
 				deployETCD,
 				waitUntilEtcdReady,
 				waitUntilKubeAPIServerServiceIsReady,
@@ -10,6 +10,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	testclock "k8s.io/utils/clock/testing"
@@ -20,6 +21,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 )
 
 var _ = Describe("Reconciler", func() {
@@ -219,5 +221,40 @@ var _ = Describe("Reconciler", func() {
 			Expect(shoot.Status.Credentials.Rotation.ServiceAccountKey.Phase).To(Equal(gardencorev1beta1.RotationPrepared))
 			Expect(shoot.Status.Credentials.Rotation.ServiceAccountKey.LastInitiationFinishedTime.UTC()).To(Equal(fakeClock.Now()))
 		})
+
+		Context("last operation duration metric", func() {
+			BeforeEach(func() {
+				DeferCleanup(func() {
+					gardenletmetrics.ShootLastOperationDurationSeconds.Reset()
+				})
+			})
+
+			It("should report the duration since the start of the retry cycle", func() {
+				shoot.Status.RetryCycleStartTime = ptr.To(metav1.NewTime(fakeClock.Now().Add(-10 * time.Minute)))
+				Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+				gardenletmetrics.ShootLastOperationDurationSeconds.WithLabelValues(shoot.Name, shoot.Namespace, "Create").Set(1)
+
+				Expect(reconciler.patchShootStatusOperationSuccess(ctx, shoot, nil, gardencorev1beta1.LastOperationTypeReconcile)).To(Succeed())
+
+				Expect(testutil.CollectAndCount(gardenletmetrics.ShootLastOperationDurationSeconds)).To(Equal(1))
+				Expect(testutil.ToFloat64(gardenletmetrics.ShootLastOperationDurationSeconds.WithLabelValues(shoot.Name, shoot.Namespace, "Reconcile"))).To(Equal(600.0))
+			})
+
+			It("should not report a duration if the retry cycle start time is unknown", func() {
+				Expect(reconciler.patchShootStatusOperationSuccess(ctx, shoot, nil, gardencorev1beta1.LastOperationTypeReconcile)).To(Succeed())
+
+				Expect(testutil.CollectAndCount(gardenletmetrics.ShootLastOperationDurationSeconds)).To(Equal(0))
+			})
+
+			It("should remove the metric when the shoot was migrated", func() {
+				shoot.Status.RetryCycleStartTime = ptr.To(metav1.NewTime(fakeClock.Now().Add(-10 * time.Minute)))
+				Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+				gardenletmetrics.ShootLastOperationDurationSeconds.WithLabelValues(shoot.Name, shoot.Namespace, "Reconcile").Set(1)
+
+				Expect(reconciler.patchShootStatusOperationSuccess(ctx, shoot, nil, gardencorev1beta1.LastOperationTypeMigrate)).To(Succeed())
+
+				Expect(testutil.CollectAndCount(gardenletmetrics.ShootLastOperationDurationSeconds)).To(Equal(0))
+			})
+		})
 	})
 })
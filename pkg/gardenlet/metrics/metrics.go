@@ -29,4 +29,31 @@ var (
 			"hibernated",
 		},
 	)
+	// ShootLastOperationDurationSeconds defines the gauge shoot_last_operation_duration_seconds.
+	ShootLastOperationDurationSeconds = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "shoot_last_operation_duration_seconds",
+			Help:      "Duration of the last successful operation of a shoot in seconds, including all retries of the operation.",
+		},
+		[]string{
+			"name",
+			"namespace",
+			"operation",
+		},
+	)
+	// ShootOperatingSystemConfigRolloutNodes defines the gauge shoot_operating_system_config_rollout_nodes.
+	ShootOperatingSystemConfigRolloutNodes = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "shoot_operating_system_config_rollout_nodes",
+			Help:      "Number of nodes of a shoot worker pool per rollout state of the operating system config (UpToDate, Pending, or the failure reason reported by gardener-node-agent).",
+		},
+		[]string{
+			"name",
+			"namespace",
+			"worker_pool",
+			"state",
+		},
+	)
 )
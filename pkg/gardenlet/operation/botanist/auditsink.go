@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
+	"github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector"
+	collectorconstants "github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector/constants"
+	"github.com/gardener/gardener/pkg/features"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// auditSink reads and parses the audit sink configuration referenced in the `.spec.resources` of the Shoot. It returns
+// nil if no audit sink is configured.
+func (b *Botanist) auditSink(ctx context.Context) (*kubeapiserver.AuditSink, error) {
+	shoot := b.Shoot.GetInfo()
+
+	configMapName := v1beta1helper.GetReferencedConfigMapName(shoot.Spec.Resources, v1beta1constants.ShootResourceNameAuditSink)
+	if configMapName == "" {
+		return nil, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: configMapName}, configMap); err != nil {
+		return nil, fmt.Errorf("failed reading audit sink ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	data, ok := configMap.Data[kubeapiserver.AuditSinkDataKey]
+	if !ok {
+		return nil, fmt.Errorf("missing '.data.%s' in audit sink ConfigMap %s", kubeapiserver.AuditSinkDataKey, client.ObjectKeyFromObject(configMap))
+	}
+
+	return kubeapiserver.ParseAuditSink([]byte(data), shoot.Spec.Resources)
+}
+
+// computeKubeAPIServerAuditWebhookConfig computes the audit webhook configuration of the kube-apiserver for the audit
+// sink configured by the shoot owner. Audit events for OTLP sinks are sent to the OpenTelemetry Collector of the
+// control plane which exports them to the configured endpoint.
+func (b *Botanist) computeKubeAPIServerAuditWebhookConfig(ctx context.Context, sink *kubeapiserver.AuditSink) (*apiserver.AuditWebhook, error) {
+	if sink == nil {
+		return nil, nil
+	}
+
+	config := &apiserver.AuditWebhook{}
+	if sink.Batch != nil {
+		config.BatchMaxSize = sink.Batch.MaxSize
+		config.BatchBufferSize = sink.Batch.BufferSize
+		if sink.Batch.MaxWait != nil {
+			config.BatchMaxWait = &sink.Batch.MaxWait.Duration
+		}
+	}
+
+	if sink.Webhook != nil {
+		kubeconfig, err := b.referencedSecretData(ctx, sink.Webhook.Kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateAuditSinkKubeconfig(kubeconfig); err != nil {
+			return nil, fmt.Errorf("invalid kubeconfig for audit sink webhook: %w", err)
+		}
+
+		config.Kubeconfig = kubeconfig
+		config.Version = sink.Webhook.Version
+		return config, nil
+	}
+
+	if !b.isOtelCollectorEnabled() {
+		return nil, errors.New("audit sinks of type otlp require the OpenTelemetry Collector to be enabled for the shoot control plane")
+	}
+
+	collectorKubeconfig := kubernetesutils.NewKubeconfig("audit-sink", clientcmdv1.Cluster{}, clientcmdv1.AuthInfo{})
+	// The collector receives the audit events via plain HTTP in the control plane namespace.
+	collectorKubeconfig.Clusters[0].Cluster.Server = "http://" + collectorconstants.ServiceName + ":" + strconv.Itoa(collectorconstants.AuditWebhookPort) + collectorconstants.AuditWebhookPath
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, collectorKubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating audit webhook kubeconfig: %w", err)
+	}
+
+	config.Kubeconfig = kubeconfig
+	config.PodLabels = map[string]string{
+		gardenerutils.NetworkPolicyLabel(collectorconstants.ServiceName, collectorconstants.AuditWebhookPort): v1beta1constants.LabelNetworkPolicyAllowed,
+	}
+	return config, nil
+}
+
+// computeOtelCollectorAuditLogs computes the configuration of the OpenTelemetry Collector for exporting the audit logs
+// to the OTLP sink configured by the shoot owner.
+func (b *Botanist) computeOtelCollectorAuditLogs(sink *kubeapiserver.AuditSink) *collector.AuditLogs {
+	if !b.isOtelCollectorEnabled() || sink == nil || sink.OTLP == nil {
+		return nil
+	}
+
+	auditLogs := &collector.AuditLogs{Endpoint: sink.OTLP.Endpoint}
+	if sink.Batch != nil {
+		auditLogs.QueueSize = sink.Batch.BufferSize
+	}
+
+	if sink.OTLP.Authorization != nil {
+		auditLogs.Authorization = gardenerutils.ReferencedSecretKeySelector(*sink.OTLP.Authorization, b.Shoot.GetInfo().Spec.Resources)
+	}
+
+	return auditLogs
+}
+
+func (b *Botanist) isOtelCollectorEnabled() bool {
+	return features.DefaultFeatureGate.Enabled(features.OpenTelemetryCollector) && b.Shoot.IsShootControlPlaneLoggingEnabled(b.Config)
+}
+
+func (b *Botanist) referencedSecretData(ctx context.Context, ref gardenerutils.SecretKeyReference) ([]byte, error) {
+	shoot := b.Shoot.GetInfo()
+
+	secretName := v1beta1helper.GetReferencedSecretName(shoot.Spec.Resources, ref.ResourceName)
+	if secretName == "" {
+		return nil, fmt.Errorf("resource %q is not a secret referenced in the .spec.resources of the Shoot", ref.ResourceName)
+	}
+
+	secret := &corev1.Secret{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: secretName}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading secret %s referenced by resource %q: %w", client.ObjectKeyFromObject(secret), ref.ResourceName, err)
+	}
+
+	data, ok := secret.Data[ref.Key]
+	if !ok || len(data) == 0 {
+		return nil, fmt.Errorf("missing or empty '.data.%s' in secret %s referenced by resource %q", ref.Key, client.ObjectKeyFromObject(secret), ref.ResourceName)
+	}
+
+	return data, nil
+}
+
+// validateAuditSinkKubeconfig ensures that the kubeconfig provided by the shoot owner does not refer to files on the
+// file system of the kube-apiserver.
+func validateAuditSinkKubeconfig(kubeconfig []byte) error {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	for name, cluster := range config.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf("certificate authority files are not supported (cluster %q)", name)
+		}
+	}
+
+	return kubernetes.ValidateConfig(*config)
+}
@@ -7,10 +7,14 @@ package botanist
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/infrastructure"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
@@ -30,6 +34,7 @@ func (b *Botanist) DefaultInfrastructure() infrastructure.Interface {
 			ProviderConfig:    b.Shoot.GetInfo().Spec.Provider.InfrastructureConfig,
 			Region:            b.Shoot.GetInfo().Spec.Region,
 			AnnotateOperation: controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskDeployInfrastructure) || b.IsRestorePhase(),
+			Plan:              b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootInfrastructurePlan] == "true" && !b.IsRestorePhase(),
 		},
 		infrastructure.DefaultInterval,
 		infrastructure.DefaultSevereThreshold,
@@ -62,6 +67,10 @@ func (b *Botanist) WaitForInfrastructure(ctx context.Context) error {
 		return err
 	}
 
+	if err := b.reportPlannedInfrastructureChanges(ctx); err != nil {
+		return err
+	}
+
 	networkingStatus := &gardencorev1beta1.NetworkingStatus{}
 	if nodesCIDRs := b.Shoot.Components.Extensions.Infrastructure.NodesCIDRs(); len(nodesCIDRs) > 0 {
 		// Only update node CIDR if it's not already set.
@@ -105,3 +114,68 @@ func (b *Botanist) WaitForInfrastructure(ctx context.Context) error {
 	b.Shoot.Networks = networks
 	return nil
 }
+
+// maxPlannedInfrastructureChanges is the maximum number of changes listed in the planned changes annotation of the
+// Shoot. The complete list can be found in the status of the Infrastructure resource.
+const maxPlannedInfrastructureChanges = 10
+
+// reportPlannedInfrastructureChanges adds a summary of the infrastructure changes planned by the extension to the
+// Shoot's annotations or removes it if the changes have been applied.
+func (b *Botanist) reportPlannedInfrastructureChanges(ctx context.Context) error {
+	plannedChanges := b.Shoot.Components.Extensions.Infrastructure.PlannedChanges()
+
+	var summary string
+	if plannedChanges != nil {
+		summary = summarizeInfrastructureChanges(plannedChanges.Changes)
+	}
+
+	if b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootInfrastructurePlannedChanges] == summary {
+		return nil
+	}
+
+	return b.Shoot.UpdateInfo(ctx, b.GardenClient, false, false, func(shoot *gardencorev1beta1.Shoot) error {
+		if plannedChanges == nil {
+			delete(shoot.Annotations, v1beta1constants.AnnotationShootInfrastructurePlannedChanges)
+			return nil
+		}
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootInfrastructurePlannedChanges, summary)
+		return nil
+	})
+}
+
+// summarizeInfrastructureChanges returns a short human-readable summary of the given changes, e.g.
+// "1 to create, 1 to replace: create subnet-a, replace subnet-b".
+func summarizeInfrastructureChanges(changes []extensionsv1alpha1.InfrastructureChange) string {
+	if len(changes) == 0 {
+		return "no changes"
+	}
+
+	var (
+		counts    = map[extensionsv1alpha1.InfrastructureChangeAction]int{}
+		resources []string
+	)
+
+	for _, change := range changes {
+		counts[change.Action]++
+		if len(resources) < maxPlannedInfrastructureChanges {
+			resources = append(resources, strings.ToLower(string(change.Action))+" "+change.Resource)
+		}
+	}
+	if len(changes) > maxPlannedInfrastructureChanges {
+		resources = append(resources, fmt.Sprintf("and %d more", len(changes)-maxPlannedInfrastructureChanges))
+	}
+
+	var totals []string
+	for _, action := range []extensionsv1alpha1.InfrastructureChangeAction{
+		extensionsv1alpha1.InfrastructureChangeActionCreate,
+		extensionsv1alpha1.InfrastructureChangeActionUpdate,
+		extensionsv1alpha1.InfrastructureChangeActionReplace,
+		extensionsv1alpha1.InfrastructureChangeActionDelete,
+	} {
+		if counts[action] > 0 {
+			totals = append(totals, fmt.Sprintf("%d to %s", counts[action], strings.ToLower(string(action))))
+		}
+	}
+
+	return strings.Join(totals, ", ") + ": " + strings.Join(resources, ", ")
+}
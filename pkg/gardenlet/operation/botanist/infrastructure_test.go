@@ -167,6 +167,7 @@ var _ = Describe("Infrastructure", func() {
 
 		It("should successfully wait (w/ CIDRs)", func() {
 			infrastructure.EXPECT().Wait(ctx)
+			infrastructure.EXPECT().PlannedChanges()
 			infrastructure.EXPECT().NodesCIDRs().Return(nodesCIDRs)
 			infrastructure.EXPECT().PodsCIDRs().Return(podsCIDRs)
 			infrastructure.EXPECT().ServicesCIDRs().Return(servicesCIDRs)
@@ -197,6 +198,7 @@ var _ = Describe("Infrastructure", func() {
 
 		It("should successfully wait (w/o CIDRs)", func() {
 			infrastructure.EXPECT().Wait(ctx)
+			infrastructure.EXPECT().PlannedChanges()
 			infrastructure.EXPECT().NodesCIDRs()
 			infrastructure.EXPECT().PodsCIDRs()
 			infrastructure.EXPECT().ServicesCIDRs()
@@ -219,6 +221,40 @@ var _ = Describe("Infrastructure", func() {
 			Expect(botanist.Shoot.GetInfo()).To(Equal(updatedShoot2))
 		})
 
+		It("should successfully wait and report the planned changes", func() {
+			infrastructure.EXPECT().Wait(ctx)
+			infrastructure.EXPECT().PlannedChanges().Return(&extensionsv1alpha1.InfrastructurePlannedChanges{
+				Changes: []extensionsv1alpha1.InfrastructureChange{
+					{Resource: "subnet-a", Action: extensionsv1alpha1.InfrastructureChangeActionReplace},
+					{Resource: "route-table", Action: extensionsv1alpha1.InfrastructureChangeActionUpdate},
+					{Resource: "subnet-b", Action: extensionsv1alpha1.InfrastructureChangeActionReplace},
+				},
+			})
+			infrastructure.EXPECT().NodesCIDRs()
+			infrastructure.EXPECT().PodsCIDRs()
+			infrastructure.EXPECT().ServicesCIDRs()
+			infrastructure.EXPECT().EgressCIDRs()
+
+			updatedShoot := shoot.DeepCopy()
+			updatedShoot.Spec.Networking.Nodes = ptr.To(nodesCIDRs[0])
+			botanist.Shoot.SetInfo(updatedShoot)
+			updatedShoot2 := updatedShoot.DeepCopy()
+			metav1.SetMetaDataAnnotation(&updatedShoot2.ObjectMeta, "shoot.gardener.cloud/infrastructure-planned-changes", "1 to update, 2 to replace: replace subnet-a, update route-table, replace subnet-b")
+			test.EXPECTPatch(ctx, gardenClient, updatedShoot2, updatedShoot, types.MergePatchType)
+			updatedShoot3 := updatedShoot2.DeepCopy()
+			updatedShoot3.Status.Networking = &gardencorev1beta1.NetworkingStatus{}
+			gardenClient.EXPECT().Status().Return(mockStatusWriter)
+			test.EXPECTStatusPatch(ctx, mockStatusWriter, updatedShoot3, updatedShoot2, types.StrategicMergePatchType)
+
+			// cluster resource sync
+			seedClientSet.EXPECT().Client().Return(seedClient)
+			seedClient.EXPECT().Get(ctx, client.ObjectKey{Name: botanist.Shoot.ControlPlaneNamespace}, gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{}))
+			seedClient.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{}), gomock.Any())
+
+			Expect(botanist.WaitForInfrastructure(ctx)).To(Succeed())
+			Expect(botanist.Shoot.GetInfo()).To(Equal(updatedShoot3))
+		})
+
 		It("should return the error during wait", func() {
 			infrastructure.EXPECT().Wait(ctx).Return(fakeErr)
 
@@ -228,6 +264,7 @@ var _ = Describe("Infrastructure", func() {
 
 		It("should return the error during nodes cidr update", func() {
 			infrastructure.EXPECT().Wait(ctx)
+			infrastructure.EXPECT().PlannedChanges()
 			infrastructure.EXPECT().NodesCIDRs().Return(nodesCIDRs)
 
 			updatedShoot := shoot.DeepCopy()
@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,8 +32,11 @@ import (
 	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
 	"github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/apiserver"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	mockkubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/mock"
+	mockcollector "github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector/mock"
+	"github.com/gardener/gardener/pkg/features"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
@@ -41,6 +45,7 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("KubeAPIServer", func() {
@@ -292,6 +297,255 @@ var _ = Describe("KubeAPIServer", func() {
 		})
 	})
 
+	Describe("#ResolveTelemetryConfiguration", func() {
+		var otelCollector *mockcollector.MockInterface
+
+		BeforeEach(func() {
+			shoot := botanist.Shoot.GetInfo()
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				AuditConfig: &gardencorev1beta1.AuditConfig{
+					AuditPolicy: &gardencorev1beta1.AuditPolicy{
+						ConfigMapRef: &corev1.ObjectReference{Name: "audit-policy"},
+					},
+				},
+			}
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(gardenClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "audit-policy", Namespace: projectNamespace},
+				Data:       map[string]string{"policy": "some-policy"},
+			})).To(Succeed())
+
+			var err error
+			botanist.Shoot.Components.ControlPlane.KubeAPIServer, err = botanist.DefaultKubeAPIServer(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			otelCollector = mockcollector.NewMockInterface(ctrl)
+			otelCollector.EXPECT().SetAuditLogs(gomock.Any()).AnyTimes()
+			otelCollector.EXPECT().SetExport(gomock.Any()).AnyTimes()
+			botanist.Shoot.Components.ControlPlane.OtelCollector = otelCollector
+		})
+
+		It("should not read any referenced configuration when the deployer is created", func() {
+			shoot := botanist.Shoot.GetInfo()
+			shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{
+				{Name: "audit-sink", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-audit-sink"}},
+				{Name: "telemetry-export", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-telemetry-export"}},
+			}
+			botanist.Shoot.SetInfo(shoot)
+
+			kubeAPIServer, err := botanist.DefaultKubeAPIServer(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kubeAPIServer.GetValues().Audit.Webhook).To(BeNil())
+			Expect(kubeAPIServer.GetValues().Tracing).To(BeNil())
+		})
+
+		Describe("AuditWebhook", func() {
+			var webhookKubeconfig []byte
+
+			BeforeEach(func() {
+				webhookKubeconfig = []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: sink
+  cluster:
+    server: https://audit.example.com
+users:
+- name: sink
+  user:
+    token: foo
+contexts:
+- name: sink
+  context:
+    cluster: sink
+    user: sink
+current-context: sink
+`)
+
+				Expect(gardenClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "audit-webhook", Namespace: projectNamespace},
+					Data:       map[string][]byte{"kubeconfig": webhookKubeconfig},
+				})).To(Succeed())
+
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{
+					{Name: "audit-sink", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-audit-sink"}},
+					{Name: "webhook", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "audit-webhook"}},
+				}
+				botanist.Shoot.SetInfo(shoot)
+			})
+
+			It("should not configure an audit webhook if no audit sink is referenced", func() {
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Resources = nil
+				botanist.Shoot.SetInfo(shoot)
+
+				Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+				Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Audit.Webhook).To(BeNil())
+			})
+
+			It("should configure the audit webhook for a webhook sink", func() {
+				Expect(gardenClient.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-audit-sink", Namespace: projectNamespace},
+					Data: map[string]string{"sink.yaml": `webhook:
+  kubeconfig:
+    resourceName: webhook
+    key: kubeconfig
+batch:
+  maxSize: 100
+  maxWait: 10s
+  bufferSize: 5000
+`},
+				})).To(Succeed())
+
+				Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+				Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Audit.Webhook).To(Equal(&apiserver.AuditWebhook{
+					Kubeconfig:      webhookKubeconfig,
+					BatchMaxSize:    ptr.To[int32](100),
+					BatchMaxWait:    ptr.To(10 * time.Second),
+					BatchBufferSize: ptr.To[int32](5000),
+				}))
+			})
+
+			It("should fail if the webhook kubeconfig refers to files", func() {
+				Expect(gardenClient.Update(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "audit-webhook", Namespace: projectNamespace, ResourceVersion: "1"},
+					Data: map[string][]byte{"kubeconfig": []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: sink
+  cluster:
+    server: https://audit.example.com
+users:
+- name: sink
+  user:
+    tokenFile: /etc/kubernetes/token
+`)},
+				})).To(Succeed())
+				Expect(gardenClient.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-audit-sink", Namespace: projectNamespace},
+					Data:       map[string]string{"sink.yaml": "webhook:\n  kubeconfig:\n    resourceName: webhook\n    key: kubeconfig\n"},
+				})).To(Succeed())
+
+				Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(MatchError(ContainSubstring("token files are not supported")))
+			})
+
+			It("should fail for an OTLP sink if the OpenTelemetry Collector is disabled", func() {
+				Expect(gardenClient.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-audit-sink", Namespace: projectNamespace},
+					Data:       map[string]string{"sink.yaml": "otlp:\n  endpoint: https://otlp.example.com\n"},
+				})).To(Succeed())
+
+				Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(MatchError(ContainSubstring("require the OpenTelemetry Collector to be enabled")))
+			})
+
+			It("should fail if the audit sink ConfigMap does not exist", func() {
+				Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(MatchError(ContainSubstring("failed reading audit sink ConfigMap")))
+			})
+
+			It("should not configure an audit webhook if the audit sink ConfigMap does not exist while the Shoot is deleted", func() {
+				shoot := botanist.Shoot.GetInfo()
+				shoot.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				botanist.Shoot.SetInfo(shoot)
+
+				Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+				Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Audit.Webhook).To(BeNil())
+			})
+
+			It("should not configure an audit webhook if the webhook secret does not exist while the Shoot is deleted", func() {
+				Expect(gardenClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "audit-webhook", Namespace: projectNamespace}})).To(Succeed())
+				Expect(gardenClient.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-audit-sink", Namespace: projectNamespace},
+					Data:       map[string]string{"sink.yaml": "webhook:\n  kubeconfig:\n    resourceName: webhook\n    key: kubeconfig\n"},
+				})).To(Succeed())
+
+				shoot := botanist.Shoot.GetInfo()
+				shoot.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				botanist.Shoot.SetInfo(shoot)
+
+				Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+				Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Audit.Webhook).To(BeNil())
+			})
+		})
+
+		Describe("Tracing", func() {
+			BeforeEach(func() {
+				botanist.Config.Logging = &gardenletconfigv1alpha1.Logging{
+					Enabled: ptr.To(true),
+					ShootTelemetryExport: &gardenletconfigv1alpha1.ShootTelemetryExport{
+						Endpoint: "http://otel-gateway.telemetry.svc.cluster.local:4318",
+					},
+				}
+			})
+
+			It("should not configure tracing if the OpenTelemetry Collector is disabled", func() {
+				Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+				Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Tracing).To(BeNil())
+			})
+
+			Context("OpenTelemetry Collector is enabled", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.OpenTelemetryCollector, true))
+				})
+
+				It("should configure tracing with the default configuration of the gardenlet", func() {
+					Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+					Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Tracing).To(Equal(&kubeapiserver.TracingConfig{
+						Endpoint:               "opentelemetry-collector-collector:4320",
+						SamplingRatePerMillion: ptr.To[int32](1000),
+						PodLabels:              map[string]string{"networking.resources.gardener.cloud/to-opentelemetry-collector-collector-tcp-4320": "allowed"},
+					}))
+				})
+
+				It("should not configure tracing if only logs are exported", func() {
+					botanist.Config.Logging.ShootTelemetryExport.Signals = []string{"logs"}
+
+					Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+					Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Tracing).To(BeNil())
+				})
+
+				It("should prefer the configuration referenced by the shoot", func() {
+					Expect(gardenClient.Create(ctx, &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "my-telemetry-export", Namespace: projectNamespace},
+						Data:       map[string]string{"export.yaml": "endpoint: https://otlp.example.com\ntracing:\n  samplingRatePerMillion: 5000\n"},
+					})).To(Succeed())
+
+					shoot := botanist.Shoot.GetInfo()
+					shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{
+						{Name: "telemetry-export", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-telemetry-export"}},
+					}
+					botanist.Shoot.SetInfo(shoot)
+
+					Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+					Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Tracing.SamplingRatePerMillion).To(PointTo(Equal(int32(5000))))
+				})
+
+				It("should fail if the referenced ConfigMap does not exist", func() {
+					shoot := botanist.Shoot.GetInfo()
+					shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{
+						{Name: "telemetry-export", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-telemetry-export"}},
+					}
+					botanist.Shoot.SetInfo(shoot)
+
+					Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(MatchError(ContainSubstring("failed reading telemetry export ConfigMap")))
+				})
+
+				It("should not configure tracing if the referenced ConfigMap does not exist while the Shoot is migrated", func() {
+					shoot := botanist.Shoot.GetInfo()
+					shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{
+						{Name: "telemetry-export", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-telemetry-export"}},
+					}
+					shoot.Spec.SeedName = ptr.To("target-seed")
+					shoot.Status.SeedName = ptr.To("source-seed")
+					botanist.Shoot.SetInfo(shoot)
+
+					Expect(botanist.ResolveTelemetryConfiguration(ctx)).To(Succeed())
+					Expect(botanist.Shoot.Components.ControlPlane.KubeAPIServer.GetValues().Tracing).To(BeNil())
+				})
+			})
+		})
+	})
+
 	Describe("#DeployKubeAPIServer", func() {
 		Describe("SNIConfig", func() {
 			secret := &corev1.Secret{
@@ -7,7 +7,11 @@ package botanist
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/gardener/imagevector"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -126,9 +130,49 @@ func (b *Botanist) DefaultVali() (vali.Interface, error) {
 		v1beta1constants.PriorityClassNameShootControlPlane100,
 		nil,
 		b.ComputeValiHost(),
+		b.computeValiLimits(),
 	)
 }
 
+func (b *Botanist) computeValiLimits() *vali.Limits {
+	var limits *vali.Limits
+
+	if b.Config != nil && b.Config.Logging != nil && b.Config.Logging.Vali != nil && b.Config.Logging.Vali.Shoot != nil {
+		for _, valiLimits := range b.Config.Logging.Vali.Shoot.Limits {
+			if len(valiLimits.ShootPurposes) > 0 && !slices.Contains(valiLimits.ShootPurposes, b.Shoot.Purpose) {
+				continue
+			}
+
+			limits = &vali.Limits{
+				RetentionPeriod:      valiLimits.RetentionPeriod,
+				IngestionRateMB:      valiLimits.IngestionRateMB,
+				IngestionBurstSizeMB: valiLimits.IngestionBurstSizeMB,
+				MaxLinesPerSecond:    valiLimits.MaxLinesPerSecond,
+			}
+			break
+		}
+	}
+
+	// Shoot owners may only shorten the retention period configured by the operator. Invalid values are rejected by the
+	// gardener-apiserver, but they are still ignored here for shoots which were annotated before.
+	if value, ok := b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootLoggingRetentionPeriod]; ok {
+		retentionPeriod, err := time.ParseDuration(value)
+		if err != nil || retentionPeriod < 24*time.Hour || retentionPeriod%(24*time.Hour) != 0 {
+			b.Logger.Info("Ignoring invalid logging retention period", "annotation", v1beta1constants.AnnotationShootLoggingRetentionPeriod, "value", value)
+			return limits
+		}
+
+		if limits == nil {
+			limits = &vali.Limits{}
+		}
+		if limits.RetentionPeriod == nil || retentionPeriod < limits.RetentionPeriod.Duration {
+			limits.RetentionPeriod = &metav1.Duration{Duration: retentionPeriod}
+		}
+	}
+
+	return limits
+}
+
 // DefaultOtelCollector returns a deployer for the OpenTelemetry Collector.
 func (b *Botanist) DefaultOtelCollector() (collector.Interface, error) {
 	collectorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameOpentelemetryCollector)
@@ -8,18 +8,24 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
 	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -61,10 +67,68 @@ func (b *Botanist) DeployAlertManager(ctx context.Context) error {
 
 	b.Shoot.Components.ControlPlane.Alertmanager.SetIngressAuthSecret(ingressAuthSecret)
 	b.Shoot.Components.ControlPlane.Alertmanager.SetIngressWildcardCertSecret(b.ControlPlaneWildcardCert)
+	b.Shoot.Components.ControlPlane.Alertmanager.SetReceivers(b.alertingReceivers(ctx), b.Shoot.GetInfo().Spec.Resources)
 
 	return b.Shoot.Components.ControlPlane.Alertmanager.Deploy(ctx)
 }
 
+// alertingReceivers returns the additional alert receivers configured in the Shoot's `.spec.monitoring.alerting`. The
+// receivers are validated when the Shoot is admitted, however, the URLs stored in the referenced secrets can only be
+// validated here. Receivers with invalid URLs, e.g. pointing to a private address, are skipped and reported via an event
+// on the Shoot so that the remaining receivers still get alerts.
+func (b *Botanist) alertingReceivers(ctx context.Context) []gardencorev1beta1.AlertingReceiver {
+	shoot := b.Shoot.GetInfo()
+	if shoot.Spec.Monitoring == nil || shoot.Spec.Monitoring.Alerting == nil {
+		return nil
+	}
+
+	var (
+		validReceivers []gardencorev1beta1.AlertingReceiver
+		skipped        []string
+	)
+
+	for _, receiver := range shoot.Spec.Monitoring.Alerting.Receivers {
+		if err := b.validateAlertingReceiverURL(ctx, receiver); err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s: %s", receiver.Name, err))
+			continue
+		}
+		validReceivers = append(validReceivers, receiver)
+	}
+
+	if len(skipped) > 0 {
+		b.Recorder.Event(shoot, corev1.EventTypeWarning, alertmanager.EventReasonReceiversSkipped, fmt.Sprintf("Alert receivers are skipped: %s", strings.Join(skipped, "; ")))
+	}
+
+	return validReceivers
+}
+
+// validateAlertingReceiverURL validates the URL of the given receiver which is stored in a secret referenced in the
+// Shoot's `.spec.resources`.
+func (b *Botanist) validateAlertingReceiverURL(ctx context.Context, receiver gardencorev1beta1.AlertingReceiver) error {
+	var ref gardencorev1beta1.ResourceSecretKeyReference
+	switch {
+	case receiver.Webhook != nil:
+		ref = receiver.Webhook.URL
+	case receiver.Slack != nil:
+		ref = receiver.Slack.APIURL
+	default:
+		return nil
+	}
+
+	shoot := b.Shoot.GetInfo()
+	secret := &corev1.Secret{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Name: v1beta1helper.GetReferencedSecretName(shoot.Spec.Resources, ref.ResourceName), Namespace: shoot.Namespace}, secret); err != nil {
+		return fmt.Errorf("failed reading secret referenced by resource %q: %w", ref.ResourceName, err)
+	}
+
+	rawURL, ok := secret.Data[ref.Key]
+	if !ok {
+		return fmt.Errorf("secret referenced by resource %q does not contain key %q", ref.ResourceName, ref.Key)
+	}
+
+	return gardencorevalidation.ValidateAlertingReceiverURL(strings.TrimSpace(string(rawURL)))
+}
+
 // DefaultPrometheus creates a new prometheus deployer.
 func (b *Botanist) DefaultPrometheus() (prometheus.Interface, error) {
 	externalLabels := map[string]string{
@@ -144,6 +208,10 @@ func (b *Botanist) DefaultPrometheus() (prometheus.Interface, error) {
 		}
 	}
 
+	if cardinalityGuard := gardenlethelper.GetCardinalityGuardConfig(b.Config); cardinalityGuard != nil && cardinalityGuard.SampleLimit != nil {
+		values.EnforcedSampleLimit = ptr.To(uint64(*cardinalityGuard.SampleLimit))
+	}
+
 	return sharedcomponent.NewPrometheus(b.Logger, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, values)
 }
 
@@ -172,9 +240,67 @@ func (b *Botanist) DeployPrometheus(ctx context.Context) error {
 	}
 	b.Shoot.Components.ControlPlane.Prometheus.SetCentralScrapeConfigs(shootprometheus.CentralScrapeConfigs(b.Shoot.ControlPlaneNamespace, caSecret.Name, b.Shoot.IsWorkerless))
 
+	remoteWrites, err := b.ownerRemoteWrites()
+	if err != nil {
+		return err
+	}
+	b.Shoot.Components.ControlPlane.Prometheus.SetAdditionalRemoteWrites(remoteWrites)
+
+	alertingRules, err := b.ownerAlertingRules(ctx)
+	if err != nil {
+		return err
+	}
+	b.Shoot.Components.ControlPlane.Prometheus.SetAdditionalPrometheusRules(alertingRules)
+
 	return b.Shoot.Components.ControlPlane.Prometheus.Deploy(ctx)
 }
 
+// ownerAlertingRules reads the alerting rules from the ConfigMap referenced in the Shoot's
+// `.spec.monitoring.alerting.rules`.
+func (b *Botanist) ownerAlertingRules(ctx context.Context) ([]*monitoringv1.PrometheusRule, error) {
+	shoot := b.Shoot.GetInfo()
+
+	configMapName := v1beta1helper.GetShootAlertingRulesConfigMapName(shoot.Spec.Monitoring)
+	if configMapName == "" {
+		return nil, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Name: configMapName, Namespace: shoot.Namespace}, configMap); err != nil {
+		return nil, fmt.Errorf("failed reading ConfigMap %s/%s with alerting rules: %w", shoot.Namespace, configMapName, err)
+	}
+
+	prometheusRule, err := shootprometheus.ParseAlertingRules([]byte(configMap.Data[shootprometheus.AlertingRulesDataKey]))
+	if err != nil {
+		return nil, err
+	}
+
+	return []*monitoringv1.PrometheusRule{prometheusRule}, nil
+}
+
+// ownerRemoteWrites returns the remote write targets configured in the Shoot's `.spec.monitoring.remoteWrites`. The
+// targets are only considered if the seed operator allows shoot owners to configure remote write targets.
+func (b *Botanist) ownerRemoteWrites() ([]monitoringv1.RemoteWriteSpec, error) {
+	shoot := b.Shoot.GetInfo()
+
+	if shoot.Spec.Monitoring == nil || len(shoot.Spec.Monitoring.RemoteWrites) == 0 {
+		return nil, nil
+	}
+
+	if b.Config == nil || b.Config.Monitoring == nil || b.Config.Monitoring.Shoot == nil || b.Config.Monitoring.Shoot.OwnerRemoteWrite == nil {
+		b.Logger.Info("Ignoring remote write targets since they are not allowed for shoots on this seed")
+		return nil, nil
+	}
+
+	config := b.Config.Monitoring.Shoot.OwnerRemoteWrite
+	return shootprometheus.RemoteWriteSpecs(shoot.Spec.Monitoring.RemoteWrites, shoot.Spec.Resources, shootprometheus.RemoteWriteLimits{
+		MaxTargets:        int(ptr.Deref(config.MaxTargets, 0)),
+		MaxKeptMetrics:    int(ptr.Deref(config.MaxKeptMetrics, 0)),
+		MaxShards:         int(ptr.Deref(config.MaxShards, 0)),
+		MaxSamplesPerSend: int(ptr.Deref(config.MaxSamplesPerSend, 0)),
+	})
+}
+
 // DestroyPrometheus destroys the shoot Prometheus.
 func (b *Botanist) DestroyPrometheus(ctx context.Context) error {
 	if err := b.Shoot.Components.ControlPlane.Prometheus.Destroy(ctx); err != nil {
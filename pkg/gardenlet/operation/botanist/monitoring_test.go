@@ -10,8 +10,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -31,10 +33,12 @@ var _ = Describe("Monitoring", func() {
 		ctx  = context.TODO()
 		ctrl *gomock.Controller
 
+		fakeGardenClient  client.Client
 		fakeSeedClient    client.Client
 		fakeSecretManager secretsmanager.Interface
 
 		botanist              *Botanist
+		recorder              *record.FakeRecorder
 		alertManager          *mockalertmanager.MockInterface
 		controlPlaneNamespace = "shoot--foo--bar"
 
@@ -45,13 +49,17 @@ var _ = Describe("Monitoring", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
 		fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 		fakeSecretManager = fakesecretsmanager.New(fakeSeedClient, controlPlaneNamespace)
 
 		alertManager = mockalertmanager.NewMockInterface(ctrl)
+		recorder = record.NewFakeRecorder(10)
 
 		botanist = &Botanist{
 			Operation: &operation.Operation{
+				GardenClient:   fakeGardenClient,
+				Recorder:       recorder,
 				SecretsManager: fakeSecretManager,
 				SeedClientSet:  fakekubernetes.NewClientSetBuilder().WithClient(fakeSeedClient).Build(),
 				Shoot: &shootpkg.Shoot{
@@ -67,6 +75,7 @@ var _ = Describe("Monitoring", func() {
 				ControlPlaneWildcardCert: ingressWildcardSecret,
 			},
 		}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}})
 
 		ingressAuthSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "observability-ingress-users", Namespace: controlPlaneNamespace}}
 		ingressWildcardSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "wildcard"}}
@@ -106,10 +115,104 @@ var _ = Describe("Monitoring", func() {
 				alertManager.EXPECT().SetIngressWildcardCertSecret(gomock.AssignableToTypeOf(&corev1.Secret{})).Do(func(s *corev1.Secret) {
 					Expect(s.Name).To(Equal(ingressWildcardSecret.Name))
 				})
+				alertManager.EXPECT().SetReceivers(nil, nil)
 				alertManager.EXPECT().Deploy(ctx)
 
 				Expect(botanist.DeployAlertManager(ctx)).To(Succeed())
 			})
+
+			Context("with alert receivers", func() {
+				var (
+					resources    []gardencorev1beta1.NamedResourceReference
+					slackSecret  *corev1.Secret
+					oncall       gardencorev1beta1.AlertingReceiver
+					pagerDuty    gardencorev1beta1.AlertingReceiver
+					metadata     gardencorev1beta1.AlertingReceiver
+					missingKey   gardencorev1beta1.AlertingReceiver
+					setReceivers func(...gardencorev1beta1.AlertingReceiver)
+				)
+
+				BeforeEach(func() {
+					resources = []gardencorev1beta1.NamedResourceReference{
+						{Name: "slack", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "slack-credentials"}},
+						{Name: "webhook", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "webhook-credentials"}},
+					}
+
+					oncall = gardencorev1beta1.AlertingReceiver{
+						Name:       "oncall",
+						Severities: []string{"critical"},
+						Slack:      &gardencorev1beta1.SlackAlertingReceiver{APIURL: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "slack", Key: "url"}},
+					}
+					pagerDuty = gardencorev1beta1.AlertingReceiver{
+						Name:      "pagerduty",
+						PagerDuty: &gardencorev1beta1.PagerDutyAlertingReceiver{RoutingKey: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "slack", Key: "routingKey"}},
+					}
+					metadata = gardencorev1beta1.AlertingReceiver{
+						Name:    "metadata",
+						Webhook: &gardencorev1beta1.WebhookAlertingReceiver{URL: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "webhook", Key: "url"}},
+					}
+					missingKey = gardencorev1beta1.AlertingReceiver{
+						Name:    "missing-key",
+						Webhook: &gardencorev1beta1.WebhookAlertingReceiver{URL: gardencorev1beta1.ResourceSecretKeyReference{ResourceName: "slack", Key: "token"}},
+					}
+
+					setReceivers = func(receivers ...gardencorev1beta1.AlertingReceiver) {
+						botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+							ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+							Spec: gardencorev1beta1.ShootSpec{
+								Resources:  resources,
+								Monitoring: &gardencorev1beta1.Monitoring{Alerting: &gardencorev1beta1.Alerting{Receivers: receivers}},
+							},
+						})
+					}
+
+					slackSecret = &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "slack-credentials", Namespace: "garden-foo"},
+						Data:       map[string][]byte{"url": []byte("https://hooks.slack.com/services/foo\n")},
+					}
+					Expect(fakeGardenClient.Create(ctx, slackSecret)).To(Succeed())
+				})
+
+				It("should successfully deploy with the configured receivers", func() {
+					setReceivers(oncall, pagerDuty)
+
+					alertManager.EXPECT().SetIngressAuthSecret(gomock.Any())
+					alertManager.EXPECT().SetIngressWildcardCertSecret(gomock.Any())
+					alertManager.EXPECT().SetReceivers([]gardencorev1beta1.AlertingReceiver{oncall, pagerDuty}, resources)
+					alertManager.EXPECT().Deploy(ctx)
+
+					Expect(botanist.DeployAlertManager(ctx)).To(Succeed())
+					Expect(recorder.Events).To(BeEmpty())
+				})
+
+				It("should skip receivers with invalid URLs and record an event", func() {
+					Expect(fakeGardenClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "webhook-credentials", Namespace: "garden-foo"},
+						Data:       map[string][]byte{"url": []byte("https://169.254.169.254/latest/meta-data")},
+					})).To(Succeed())
+					setReceivers(oncall, metadata, missingKey)
+
+					alertManager.EXPECT().SetIngressAuthSecret(gomock.Any())
+					alertManager.EXPECT().SetIngressWildcardCertSecret(gomock.Any())
+					alertManager.EXPECT().SetReceivers([]gardencorev1beta1.AlertingReceiver{oncall}, resources)
+					alertManager.EXPECT().Deploy(ctx)
+
+					Expect(botanist.DeployAlertManager(ctx)).To(Succeed())
+					Expect(recorder.Events).To(Receive(Equal(`Warning AlertingReceiversSkipped Alert receivers are skipped: metadata: URL must not point to a private, loopback or link-local address; missing-key: secret referenced by resource "slack" does not contain key "token"`)))
+				})
+
+				It("should skip receivers whose referenced secret does not exist and record an event", func() {
+					setReceivers(metadata)
+
+					alertManager.EXPECT().SetIngressAuthSecret(gomock.Any())
+					alertManager.EXPECT().SetIngressWildcardCertSecret(gomock.Any())
+					alertManager.EXPECT().SetReceivers(nil, resources)
+					alertManager.EXPECT().Deploy(ctx)
+
+					Expect(botanist.DeployAlertManager(ctx)).To(Succeed())
+					Expect(recorder.Events).To(Receive(ContainSubstring(`Warning AlertingReceiversSkipped Alert receivers are skipped: metadata: failed reading secret referenced by resource "webhook"`)))
+				})
+			})
 		})
 	})
 })
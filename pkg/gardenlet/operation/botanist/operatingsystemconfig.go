@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
 	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/networking/nodelocaldns/constants"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -57,6 +58,17 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 		valitailEnabled, valiIngressHost = true, b.ComputeValiHost()
 	}
 
+	var (
+		nodeAgentHealthCheck    *nodeagentconfigv1alpha1.HealthCheckControllerConfig
+		nodeAgentDriftDetection *nodeagentconfigv1alpha1.DriftDetectionConfig
+		nodeAgentReboot         *nodeagentconfigv1alpha1.RebootControllerConfig
+	)
+	if b.Config != nil && b.Config.NodeAgent != nil {
+		nodeAgentHealthCheck = b.Config.NodeAgent.HealthCheck
+		nodeAgentDriftDetection = b.Config.NodeAgent.DriftDetection
+		nodeAgentReboot = b.Config.NodeAgent.Reboot
+	}
+
 	return operatingsystemconfig.New(
 		b.Logger,
 		b.SeedClientSet.Client(),
@@ -66,18 +78,21 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 			KubernetesVersion: b.Shoot.KubernetesVersion,
 			Workers:           b.Shoot.GetInfo().Spec.Provider.Workers,
 			OriginalValues: operatingsystemconfig.OriginalValues{
-				ClusterDomain:          gardencorev1beta1.DefaultDomain,
-				Images:                 oscImages,
-				KubeletConfig:          b.Shoot.GetInfo().Spec.Kubernetes.Kubelet,
-				KubeProxyEnabled:       v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy),
-				MachineTypes:           b.Shoot.CloudProfile.Spec.MachineTypes,
-				SSHAccessEnabled:       v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()),
-				ValitailEnabled:        valitailEnabled,
-				ValiIngressHostName:    valiIngressHost,
-				NodeLocalDNSEnabled:    v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
-				NodeMonitorGracePeriod: *b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod,
-				PrimaryIPFamily:        b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
-				KubeProxyConfig:        b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy,
+				ClusterDomain:           gardencorev1beta1.DefaultDomain,
+				Images:                  oscImages,
+				KubeletConfig:           b.Shoot.GetInfo().Spec.Kubernetes.Kubelet,
+				KubeProxyEnabled:        v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy),
+				MachineTypes:            b.Shoot.CloudProfile.Spec.MachineTypes,
+				SSHAccessEnabled:        v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()),
+				ValitailEnabled:         valitailEnabled,
+				ValiIngressHostName:     valiIngressHost,
+				NodeLocalDNSEnabled:     v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+				NodeMonitorGracePeriod:  *b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod,
+				PrimaryIPFamily:         b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
+				KubeProxyConfig:         b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy,
+				NodeAgentHealthCheck:    nodeAgentHealthCheck,
+				NodeAgentDriftDetection: nodeAgentDriftDetection,
+				NodeAgentReboot:         nodeAgentReboot,
 			},
 		},
 		operatingsystemconfig.DefaultInterval,
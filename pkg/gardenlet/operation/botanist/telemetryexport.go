@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
+	"github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector"
+	collectorconstants "github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// ResolveTelemetryConfiguration reads the audit sink and telemetry export configuration referenced in the
+// `.spec.resources` of the Shoot and passes it to the kube-apiserver and OpenTelemetry Collector deployers. It is part
+// of the reconcile, deletion and migration flows since all of them redeploy the kube-apiserver. While the Shoot is
+// deleted or migrated, referenced resources which do not exist anymore are ignored so that they cannot block these
+// operations.
+func (b *Botanist) ResolveTelemetryConfiguration(ctx context.Context) error {
+	sink, err := b.auditSink(ctx)
+	if err != nil {
+		if err := b.ignoreMissingReferenceDuringTeardown(err, "audit sink"); err != nil {
+			return err
+		}
+	}
+
+	auditWebhookConfig, err := b.computeKubeAPIServerAuditWebhookConfig(ctx, sink)
+	if err != nil {
+		if err := b.ignoreMissingReferenceDuringTeardown(err, "audit sink"); err != nil {
+			return err
+		}
+		sink = nil
+	}
+
+	export, err := b.telemetryExport(ctx)
+	if err != nil {
+		if err := b.ignoreMissingReferenceDuringTeardown(err, "telemetry export"); err != nil {
+			return err
+		}
+	}
+
+	b.Shoot.Components.ControlPlane.KubeAPIServer.SetAuditWebhookConfig(auditWebhookConfig)
+	b.Shoot.Components.ControlPlane.KubeAPIServer.SetTracingConfig(computeKubeAPIServerTracingConfig(export))
+	b.Shoot.Components.ControlPlane.OtelCollector.SetAuditLogs(b.computeOtelCollectorAuditLogs(sink))
+	b.Shoot.Components.ControlPlane.OtelCollector.SetExport(b.computeOtelCollectorExport(export))
+	return nil
+}
+
+// ignoreMissingReferenceDuringTeardown returns nil if the given error is caused by a referenced resource which does not
+// exist while the Shoot is deleted or migrated. Otherwise, the error is returned unchanged.
+func (b *Botanist) ignoreMissingReferenceDuringTeardown(err error, configuration string) error {
+	shoot := b.Shoot.GetInfo()
+	if !apierrors.IsNotFound(err) || (shoot.DeletionTimestamp == nil && !v1beta1helper.ShouldPrepareShootForMigration(shoot)) {
+		return err
+	}
+
+	b.Logger.Info("Referenced resource does not exist, continuing without the configuration", "configuration", configuration, "reason", err.Error())
+	return nil
+}
+
+// telemetryExport returns the configuration for exporting the control plane logs and traces. The configuration
+// referenced in the `.spec.resources` of the Shoot takes precedence over the default configuration of the gardenlet.
+// It returns nil if the export is not configured or the OpenTelemetry Collector is disabled.
+func (b *Botanist) telemetryExport(ctx context.Context) (*collector.TelemetryExport, error) {
+	shoot := b.Shoot.GetInfo()
+
+	configMapName := v1beta1helper.GetReferencedConfigMapName(shoot.Spec.Resources, v1beta1constants.ShootResourceNameTelemetryExport)
+	if configMapName == "" {
+		if !b.isOtelCollectorEnabled() || b.Config == nil || b.Config.Logging == nil || b.Config.Logging.ShootTelemetryExport == nil {
+			return nil, nil
+		}
+
+		config := b.Config.Logging.ShootTelemetryExport
+		export := &collector.TelemetryExport{Endpoint: config.Endpoint, Signals: config.Signals}
+		if config.SamplingRatePerMillion != nil {
+			export.Tracing = &collector.TelemetryExportTracing{SamplingRatePerMillion: config.SamplingRatePerMillion}
+		}
+		return export, nil
+	}
+
+	if !b.isOtelCollectorEnabled() {
+		return nil, errors.New("exporting logs and traces requires the OpenTelemetry Collector to be enabled for the shoot control plane")
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: configMapName}, configMap); err != nil {
+		return nil, fmt.Errorf("failed reading telemetry export ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	data, ok := configMap.Data[collector.TelemetryExportDataKey]
+	if !ok {
+		return nil, fmt.Errorf("missing '.data.%s' in telemetry export ConfigMap %s", collector.TelemetryExportDataKey, client.ObjectKeyFromObject(configMap))
+	}
+
+	return collector.ParseTelemetryExport([]byte(data), shoot.Spec.Resources)
+}
+
+// computeOtelCollectorExport computes the configuration of the OpenTelemetry Collector for exporting the control plane
+// logs and traces.
+func (b *Botanist) computeOtelCollectorExport(export *collector.TelemetryExport) *collector.Export {
+	if export == nil {
+		return nil
+	}
+
+	config := &collector.Export{
+		Endpoint: export.Endpoint,
+		Logs:     export.ExportsSignal(collector.SignalLogs),
+		Traces:   export.ExportsSignal(collector.SignalTraces),
+	}
+
+	if export.Authorization != nil {
+		config.Authorization = gardenerutils.ReferencedSecretKeySelector(*export.Authorization, b.Shoot.GetInfo().Spec.Resources)
+	}
+
+	return config
+}
+
+// computeKubeAPIServerTracingConfig computes the tracing configuration of the kube-apiserver. Spans are sent to the
+// OpenTelemetry Collector of the control plane which exports them to the configured endpoint.
+func computeKubeAPIServerTracingConfig(export *collector.TelemetryExport) *kubeapiserver.TracingConfig {
+	if export == nil || !export.ExportsSignal(collector.SignalTraces) {
+		return nil
+	}
+
+	samplingRatePerMillion := collector.DefaultSamplingRatePerMillion
+	if export.Tracing != nil && export.Tracing.SamplingRatePerMillion != nil {
+		samplingRatePerMillion = *export.Tracing.SamplingRatePerMillion
+	}
+
+	return &kubeapiserver.TracingConfig{
+		Endpoint:               collectorconstants.ServiceName + ":" + strconv.Itoa(collectorconstants.OTLPPort),
+		SamplingRatePerMillion: ptr.To(samplingRatePerMillion),
+		PodLabels: map[string]string{
+			gardenerutils.NetworkPolicyLabel(collectorconstants.ServiceName, collectorconstants.OTLPPort): v1beta1constants.LabelNetworkPolicyAllowed,
+		},
+	}
+}
@@ -6,7 +6,11 @@ package botanist
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -113,14 +117,75 @@ func WorkerPoolToOperatingSystemConfigSecretMetaMap(ctx context.Context, shootCl
 	return workerPoolToCloudConfigSecretMeta, nil
 }
 
+// OperatingSystemConfigRolloutStatus describes the rollout of the operating system config of a worker pool.
+type OperatingSystemConfigRolloutStatus struct {
+	// WorkerPool is the name of the worker pool.
+	WorkerPool string
+	// Nodes is the number of nodes of the worker pool which are not about to be deleted.
+	Nodes int
+	// UpToDate is the number of nodes which have successfully applied the desired operating system config.
+	UpToDate int
+	// Failed maps the reasons reported by gardener-node-agent for failing to apply the desired operating system config
+	// to the number of affected nodes.
+	Failed map[string]int
+}
+
+// Pending returns the number of nodes which have neither applied the desired operating system config nor reported a
+// failure.
+func (s OperatingSystemConfigRolloutStatus) Pending() int {
+	pending := s.Nodes - s.UpToDate
+	for _, count := range s.Failed {
+		pending -= count
+	}
+	return pending
+}
+
+func (s OperatingSystemConfigRolloutStatus) String() string {
+	summary := fmt.Sprintf("worker pool %q: %d/%d nodes have applied the latest operating system config", s.WorkerPool, s.UpToDate, s.Nodes)
+	if len(s.Failed) == 0 {
+		return summary
+	}
+
+	var (
+		failedNodes int
+		reasons     []string
+	)
+
+	for _, reason := range slices.Sorted(maps.Keys(s.Failed)) {
+		failedNodes += s.Failed[reason]
+		reasons = append(reasons, fmt.Sprintf("%s: %d", reason, s.Failed[reason]))
+	}
+
+	return fmt.Sprintf("%s, %d failing (%s)", summary, failedNodes, strings.Join(reasons, ", "))
+}
+
 // OperatingSystemConfigUpdatedForAllWorkerPools checks if all the nodes for all the provided worker pools have successfully
-// applied the desired version of their cloud-config user data.
+// applied the desired version of their cloud-config user data. The returned error summarizes the rollout per worker
+// pool, including the failure reasons reported by gardener-node-agent.
 func OperatingSystemConfigUpdatedForAllWorkerPools(
 	workers []gardencorev1beta1.Worker,
 	workerPoolToNodes map[string][]corev1.Node,
 	workerPoolToOperatingSystemConfigSecretMeta map[string]metav1.ObjectMeta,
 ) error {
-	var result error
+	_, err := OperatingSystemConfigRolloutStatuses(workers, workerPoolToNodes, workerPoolToOperatingSystemConfigSecretMeta)
+	return err
+}
+
+// OperatingSystemConfigRolloutStatuses computes the rollout status of the operating system config for all the provided
+// worker pools. Like OperatingSystemConfigUpdatedForAllWorkerPools, it returns an error if not all nodes have
+// successfully applied the desired version of their cloud-config user data.
+func OperatingSystemConfigRolloutStatuses(
+	workers []gardencorev1beta1.Worker,
+	workerPoolToNodes map[string][]corev1.Node,
+	workerPoolToOperatingSystemConfigSecretMeta map[string]metav1.ObjectMeta,
+) (
+	[]OperatingSystemConfigRolloutStatus,
+	error,
+) {
+	var (
+		statuses []OperatingSystemConfigRolloutStatus
+		result   error
+	)
 
 	for _, worker := range workers {
 		secretMeta, ok := workerPoolToOperatingSystemConfigSecretMeta[worker.Name]
@@ -132,24 +197,63 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 		var (
 			gardenerNodeAgentSecretName = secretMeta.Name
 			secretChecksum              = secretMeta.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+			status                      = OperatingSystemConfigRolloutStatus{WorkerPool: worker.Name, Failed: map[string]int{}}
+			nodeErrs                    []error
 		)
 
 		for _, node := range workerPoolToNodes[worker.Name] {
 			if nodeToBeDeleted(node, gardenerNodeAgentSecretName) {
 				continue
 			}
+			status.Nodes++
+
+			nodeChecksum, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]
+			if nodeChecksum == secretChecksum {
+				status.UpToDate++
+				continue
+			}
 
-			if nodeChecksum, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; nodeChecksum != secretChecksum {
-				if !ok {
-					result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q hasn't been reported yet", node.Name))
-				} else {
-					result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q is outdated (current: %s, desired: %s)", node.Name, nodeChecksum, secretChecksum))
-				}
+			var err error
+			if !ok {
+				err = fmt.Errorf("the last successfully applied operating system config on node %q hasn't been reported yet", node.Name)
+			} else {
+				err = fmt.Errorf("the last successfully applied operating system config on node %q is outdated (current: %s, desired: %s)", node.Name, nodeChecksum, secretChecksum)
 			}
+
+			if condition := operatingSystemConfigApplyFailedCondition(node, secretChecksum); condition != nil {
+				status.Failed[condition.Reason]++
+				err = fmt.Errorf("%w: %s", err, condition.Message)
+			}
+
+			nodeErrs = append(nodeErrs, err)
 		}
+
+		statuses = append(statuses, status)
+
+		if len(nodeErrs) > 0 {
+			result = multierror.Append(result, errors.New(status.String()))
+			result = multierror.Append(result, nodeErrs...)
+		}
+	}
+
+	return statuses, result
+}
+
+// operatingSystemConfigApplyFailedCondition returns the OperatingSystemConfigApplied condition of the given node if
+// gardener-node-agent reported that it failed to apply the operating system config with the given checksum. Failures
+// reported for other (i.e., previous) operating system configs are ignored.
+func operatingSystemConfigApplyFailedCondition(node corev1.Node, checksum string) *corev1.NodeCondition {
+	if node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumReportedOperatingSystemConfig] != checksum {
+		return nil
 	}
 
-	return result
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigApplied &&
+			(condition.Reason == nodeagentconfigv1alpha1.ConditionReasonOperatingSystemConfigApplyFailed || condition.Reason == nodeagentconfigv1alpha1.ConditionReasonInPlaceUpdateFailed) {
+			return &condition
+		}
+	}
+	return nil
 }
 
 func nodeToBeDeleted(node corev1.Node, gardenerNodeAgentSecretName string) bool {
@@ -302,6 +302,67 @@ var _ = Describe("Worker", func() {
 			}},
 			MatchError(ContainSubstring("is outdated")),
 		),
+		Entry("failures reported by gardener-node-agent",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "node1",
+						Annotations: map[string]string{"checksum/cloud-config-data": "foo"},
+						Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "node2",
+						Annotations: map[string]string{"checksum/cloud-config-data": "outdated", "checksum/cloud-config-data-reported": "foo"},
+						Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+					},
+					Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+						Type:    "OperatingSystemConfigApplied",
+						Status:  corev1.ConditionFalse,
+						Reason:  "ApplyFailed",
+						Message: "unable to restart unit \"foo.service\"",
+					}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "node3",
+						Annotations: map[string]string{"checksum/cloud-config-data": "outdated"},
+						Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+					},
+					Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+						Type:   "OperatingSystemConfigApplied",
+						Status: corev1.ConditionFalse,
+						Reason: "InPlaceUpdatePending",
+					}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "node4",
+						Annotations: map[string]string{"checksum/cloud-config-data": "outdated", "checksum/cloud-config-data-reported": "previous"},
+						Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+					},
+					Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+						Type:    "OperatingSystemConfigApplied",
+						Status:  corev1.ConditionFalse,
+						Reason:  "ApplyFailed",
+						Message: "failure of previous operating system config",
+					}}},
+				},
+			}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name:        "gardener-node-agent--c63c0",
+				Annotations: map[string]string{"checksum/data-script": "foo"},
+			}},
+			MatchError(And(
+				ContainSubstring(`worker pool "pool1": 1/4 nodes have applied the latest operating system config, 1 failing (ApplyFailed: 1)`),
+				ContainSubstring(`the last successfully applied operating system config on node "node2" is outdated (current: outdated, desired: foo): unable to restart unit "foo.service"`),
+				ContainSubstring(`the last successfully applied operating system config on node "node3" is outdated (current: outdated, desired: foo)`),
+				ContainSubstring(`the last successfully applied operating system config on node "node4" is outdated (current: outdated, desired: foo)`),
+				Not(ContainSubstring("failure of previous operating system config")),
+			)),
+		),
 		Entry("skip node marked by MCM for termination",
 			[]gardencorev1beta1.Worker{{Name: "pool1"}},
 			map[string][]corev1.Node{"pool1": {{
@@ -367,6 +428,34 @@ var _ = Describe("Worker", func() {
 		),
 	)
 
+	Describe("#OperatingSystemConfigRolloutStatuses", func() {
+		It("should compute the rollout status per worker pool", func() {
+			labels := map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"}
+
+			statuses, err := OperatingSystemConfigRolloutStatuses(
+				[]gardencorev1beta1.Worker{{Name: "pool1"}},
+				map[string][]corev1.Node{"pool1": {
+					{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: labels, Annotations: map[string]string{"checksum/cloud-config-data": "foo"}}},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "node2", Labels: labels, Annotations: map[string]string{"checksum/cloud-config-data-reported": "foo"}},
+						Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: "OperatingSystemConfigApplied", Status: corev1.ConditionFalse, Reason: "InPlaceUpdateFailed"}}},
+					},
+					{ObjectMeta: metav1.ObjectMeta{Name: "node3", Labels: labels}},
+				}},
+				map[string]metav1.ObjectMeta{"pool1": {Name: "gardener-node-agent--c63c0", Annotations: map[string]string{"checksum/data-script": "foo"}}},
+			)
+			Expect(err).To(HaveOccurred())
+
+			Expect(statuses).To(ConsistOf(OperatingSystemConfigRolloutStatus{
+				WorkerPool: "pool1",
+				Nodes:      3,
+				UpToDate:   1,
+				Failed:     map[string]int{"InPlaceUpdateFailed": 1},
+			}))
+			Expect(statuses[0].Pending()).To(Equal(1))
+		})
+	})
+
 	Describe("#WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools", func() {
 		var (
 			seedInterface  *kubernetesmock.MockInterface
@@ -16,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -43,6 +44,10 @@ func NewBuilder() *Builder {
 		clockFunc: func() clock.Clock {
 			return clock.RealClock{}
 		},
+		recorderFunc: func() record.EventRecorder {
+			// Events are discarded if no recorder is set.
+			return &record.FakeRecorder{}
+		},
 		configFunc: func() (*gardenletconfigv1alpha1.GardenletConfiguration, error) {
 			return nil, fmt.Errorf("config is required but not set")
 		},
@@ -118,6 +123,12 @@ func (b *Builder) WithLogger(log logr.Logger) *Builder {
 	return b
 }
 
+// WithRecorder sets the recorderFunc attribute at the Builder.
+func (b *Builder) WithRecorder(recorder record.EventRecorder) *Builder {
+	b.recorderFunc = func() record.EventRecorder { return recorder }
+	return b
+}
+
 // WithSecrets sets the secretsFunc attribute at the Builder.
 func (b *Builder) WithSecrets(secrets map[string]*corev1.Secret) *Builder {
 	b.secretsFunc = func() (map[string]*corev1.Secret, error) { return secrets, nil }
@@ -249,6 +260,7 @@ func (b *Builder) Build(
 		return nil, err
 	}
 	operation.Logger = logger
+	operation.Recorder = b.recorderFunc()
 
 	seed, err := b.seedFunc(ctx)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,6 +38,7 @@ type Builder struct {
 	seedFunc                  func(context.Context) (*seed.Seed, error)
 	shootFunc                 func(context.Context, client.Reader, *garden.Garden, *seed.Seed, *corev1.Secret) (*shoot.Shoot, error)
 	clockFunc                 func() clock.Clock
+	recorderFunc              func() record.EventRecorder
 }
 
 // Operation contains all data required to perform an operation on a Shoot cluster.
@@ -48,6 +50,7 @@ type Operation struct {
 	Clock                 clock.Clock
 	Config                *gardenletconfigv1alpha1.GardenletConfiguration
 	Logger                logr.Logger
+	Recorder              record.EventRecorder
 	GardenerInfo          *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	Garden                *garden.Garden
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -35,6 +36,13 @@ func SetDefaults_OperatingSystemConfigControllerConfig(obj *OperatingSystemConfi
 	}
 }
 
+// SetDefaults_DriftDetectionConfig sets defaults for the DriftDetectionConfig object.
+func SetDefaults_DriftDetectionConfig(obj *DriftDetectionConfig) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_TokenControllerConfig sets defaults for the TokenControllerConfig object.
 func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	if obj.SyncPeriod == nil {
@@ -42,6 +50,67 @@ func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	}
 }
 
+// SetDefaults_HealthCheckControllerConfig sets defaults for the HealthCheckControllerConfig object.
+func SetDefaults_HealthCheckControllerConfig(obj *HealthCheckControllerConfig) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+// SetDefaults_HealthCheck sets defaults for the HealthCheck object.
+func SetDefaults_HealthCheck(obj *HealthCheck) {
+	if obj.FailureDuration == nil {
+		obj.FailureDuration = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
+// SetDefaults_DiskPressureHealthCheck sets defaults for the DiskPressureHealthCheck object.
+func SetDefaults_DiskPressureHealthCheck(obj *DiskPressureHealthCheck) {
+	if obj.MinFreePercent == nil {
+		obj.MinFreePercent = ptr.To[int32](10)
+	}
+	if obj.MinFreeInodesPercent == nil {
+		obj.MinFreeInodesPercent = ptr.To[int32](5)
+	}
+}
+
+// SetDefaults_NTPHealthCheck sets defaults for the NTPHealthCheck object.
+func SetDefaults_NTPHealthCheck(obj *NTPHealthCheck) {
+	if obj.MaxDrift == nil {
+		obj.MaxDrift = &metav1.Duration{Duration: time.Second}
+	}
+}
+
+// SetDefaults_ScriptHealthCheck sets defaults for the ScriptHealthCheck object.
+func SetDefaults_ScriptHealthCheck(obj *ScriptHealthCheck) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
+// SetDefaults_HealthCheckRemediation sets defaults for the HealthCheckRemediation object.
+func SetDefaults_HealthCheckRemediation(obj *HealthCheckRemediation) {
+	if obj.MaxAttempts == nil {
+		obj.MaxAttempts = ptr.To[int32](3)
+	}
+	if obj.Period == nil {
+		obj.Period = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
+// SetDefaults_RebootControllerConfig sets defaults for the RebootControllerConfig object.
+func SetDefaults_RebootControllerConfig(obj *RebootControllerConfig) {
+	if obj.MaxConcurrentReboots == nil {
+		obj.MaxConcurrentReboots = ptr.To[int32](1)
+	}
+	if obj.DrainTimeout == nil {
+		obj.DrainTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.LeaseDuration == nil {
+		obj.LeaseDuration = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(obj)
@@ -63,3 +132,10 @@ func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 		obj.Metrics.Port = 2752
 	}
 }
+
+// SetDefaults_SupportBundleConfiguration sets defaults for the support bundle configuration.
+func SetDefaults_SupportBundleConfiguration(obj *SupportBundleConfiguration) {
+	if obj.Port == 0 {
+		obj.Port = 2753
+	}
+}
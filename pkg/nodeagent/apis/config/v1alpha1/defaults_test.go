@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -65,6 +66,24 @@ var _ = Describe("Defaults", func() {
 
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 				})
+
+				It("should default the drift detection configuration", func() {
+					obj := &DriftDetectionConfig{}
+
+					SetDefaults_DriftDetectionConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+				})
+
+				It("should not overwrite existing drift detection values", func() {
+					obj := &DriftDetectionConfig{
+						SyncPeriod: &metav1.Duration{Duration: time.Hour},
+					}
+
+					SetDefaults_DriftDetectionConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+				})
 			})
 
 			Describe("Token controller", func() {
@@ -86,6 +105,66 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 				})
 			})
+
+			Describe("Health check controller", func() {
+				It("should default the object", func() {
+					obj := &NodeAgentConfiguration{Controllers: ControllerConfiguration{HealthCheck: HealthCheckControllerConfig{
+						Checks: []HealthCheck{
+							{Name: "disk", DiskPressure: &DiskPressureHealthCheck{Path: "/var"}},
+							{Name: "ntp", NTP: &NTPHealthCheck{}},
+							{Name: "script", Script: &ScriptHealthCheck{Command: []string{"true"}}, Remediation: &HealthCheckRemediation{Action: RemediationActionReboot}},
+						},
+					}}}
+
+					SetObjectDefaults_NodeAgentConfiguration(obj)
+
+					healthCheck := obj.Controllers.HealthCheck
+					Expect(healthCheck.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+					Expect(healthCheck.Checks[0].FailureDuration).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(healthCheck.Checks[0].DiskPressure.MinFreePercent).To(PointTo(Equal(int32(10))))
+					Expect(healthCheck.Checks[0].DiskPressure.MinFreeInodesPercent).To(PointTo(Equal(int32(5))))
+					Expect(healthCheck.Checks[1].NTP.MaxDrift).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+					Expect(healthCheck.Checks[2].Script.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+					Expect(healthCheck.Checks[2].Remediation.MaxAttempts).To(PointTo(Equal(int32(3))))
+					Expect(healthCheck.Checks[2].Remediation.Period).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &HealthCheckControllerConfig{
+						SyncPeriod: &metav1.Duration{Duration: time.Minute},
+					}
+
+					SetDefaults_HealthCheckControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+				})
+			})
+
+			Describe("Reboot controller", func() {
+				It("should default the object", func() {
+					obj := &RebootControllerConfig{}
+
+					SetDefaults_RebootControllerConfig(obj)
+
+					Expect(obj.MaxConcurrentReboots).To(PointTo(Equal(int32(1))))
+					Expect(obj.DrainTimeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					Expect(obj.LeaseDuration).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Minute})))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &RebootControllerConfig{
+						MaxConcurrentReboots: ptr.To[int32](3),
+						DrainTimeout:         &metav1.Duration{Duration: time.Minute},
+						LeaseDuration:        &metav1.Duration{Duration: time.Hour},
+					}
+
+					SetDefaults_RebootControllerConfig(obj)
+
+					Expect(obj.MaxConcurrentReboots).To(PointTo(Equal(int32(3))))
+					Expect(obj.DrainTimeout).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(obj.LeaseDuration).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+				})
+			})
 		})
 
 		Describe("Server configuration", func() {
@@ -114,5 +193,23 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.Metrics.Port).To(Equal(7890))
 			})
 		})
+
+		Describe("SupportBundle configuration", func() {
+			It("should default the object", func() {
+				obj := &SupportBundleConfiguration{}
+
+				SetDefaults_SupportBundleConfiguration(obj)
+
+				Expect(obj.Port).To(Equal(2753))
+			})
+
+			It("should not overwrite existing values", func() {
+				obj := &SupportBundleConfiguration{Port: 1234}
+
+				SetDefaults_SupportBundleConfiguration(obj)
+
+				Expect(obj.Port).To(Equal(1234))
+			})
+		})
 	})
 })
@@ -8,6 +8,7 @@ import (
 	"regexp"
 
 	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -45,6 +46,31 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+	// AnnotationKeyChecksumReportedOperatingSystemConfig is a constant for an annotation key on a Node describing the
+	// checksum of the operating system configuration which the OperatingSystemConfigApplied condition refers to.
+	AnnotationKeyChecksumReportedOperatingSystemConfig = "checksum/cloud-config-data-reported"
+	// NodeConditionTypeOperatingSystemConfigDrift is a constant for the type of the Node condition describing whether
+	// the files and units on the node have drifted from the last applied operating system configuration.
+	NodeConditionTypeOperatingSystemConfigDrift corev1.NodeConditionType = "OperatingSystemConfigDrift"
+	// NodeConditionTypeOperatingSystemConfigApplied is a constant for the type of the Node condition describing the
+	// result of the last attempt to apply the operating system configuration.
+	NodeConditionTypeOperatingSystemConfigApplied corev1.NodeConditionType = "OperatingSystemConfigApplied"
+
+	// ConditionReasonOperatingSystemConfigApplied is the reason of the OperatingSystemConfigApplied condition when the
+	// operating system configuration was applied successfully.
+	ConditionReasonOperatingSystemConfigApplied = "Applied"
+	// ConditionReasonOperatingSystemConfigApplyFailed is the reason of the OperatingSystemConfigApplied condition when
+	// applying the operating system configuration failed.
+	ConditionReasonOperatingSystemConfigApplyFailed = "ApplyFailed"
+	// ConditionReasonInPlaceUpdatePending is the reason of the OperatingSystemConfigApplied condition when the
+	// operating system configuration requires an in-place update, but the node is not yet ready for it.
+	ConditionReasonInPlaceUpdatePending = "InPlaceUpdatePending"
+	// ConditionReasonInPlaceUpdateInProgress is the reason of the OperatingSystemConfigApplied condition when an
+	// in-place update is in progress.
+	ConditionReasonInPlaceUpdateInProgress = "InPlaceUpdateInProgress"
+	// ConditionReasonInPlaceUpdateFailed is the reason of the OperatingSystemConfigApplied condition when an in-place
+	// update failed.
+	ConditionReasonInPlaceUpdateFailed = "InPlaceUpdateFailed"
 )
 
 // OSVersionRegex is a regular expression to match operating system versions.
@@ -104,6 +130,13 @@ type ControllerConfiguration struct {
 	OperatingSystemConfig OperatingSystemConfigControllerConfig `json:"operatingSystemConfig"`
 	// Token is the configuration for the access token controller.
 	Token TokenControllerConfig `json:"token"`
+	// HealthCheck is the configuration for the health check controller.
+	// +optional
+	HealthCheck HealthCheckControllerConfig `json:"healthCheck"`
+	// Reboot is the configuration for coordinated reboots of the node. If not set, the node is rebooted immediately
+	// whenever a reboot is required.
+	// +optional
+	Reboot *RebootControllerConfig `json:"reboot,omitempty"`
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -117,6 +150,50 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
+	// DriftDetection is the configuration for detecting drift of the files and units on the node from the last applied
+	// operating system config. If not set, drift detection is disabled.
+	// +optional
+	DriftDetection *DriftDetectionConfig `json:"driftDetection,omitempty"`
+}
+
+// DriftDetectionConfig contains configuration for detecting drift of the files and units managed by the operating
+// system config.
+type DriftDetectionConfig struct {
+	// SyncPeriod is the duration how often the files and units are checked for drift.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Reapply specifies whether drifted files and units are re-applied from the last applied operating system config.
+	// If false, drift is only reported.
+	// +optional
+	Reapply bool `json:"reapply,omitempty"`
+}
+
+// RebootControllerConfig defines the configuration for coordinated reboots of the node. Reboots are only executed
+// within the maintenance time window, after the node was drained, and if not too many nodes of the same worker pool
+// are rebooting at the same time.
+type RebootControllerConfig struct {
+	// MaxConcurrentReboots is the maximum number of nodes of the same worker pool which may reboot concurrently.
+	// +optional
+	MaxConcurrentReboots *int32 `json:"maxConcurrentReboots,omitempty"`
+	// MaintenanceWindow is the time window in which reboots are allowed. If not set, the maintenance time window of
+	// the shoot is used. If the shoot does not have a maintenance time window, reboots are allowed at any time.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	// DrainTimeout is the maximum duration for evicting the pods from the node. If pods cannot be evicted in time,
+	// e.g. because of PodDisruptionBudgets, the reboot is postponed and the node is uncordoned again.
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+	// LeaseDuration is the duration after which a reboot slot held by a node which did not come back is released.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+}
+
+// MaintenanceWindow is a daily time window in the format used by the shoot maintenance time window, e.g. "220000+0100".
+type MaintenanceWindow struct {
+	// Begin is the beginning of the time window.
+	Begin string `json:"begin"`
+	// End is the end of the time window.
+	End string `json:"end"`
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
@@ -129,6 +206,123 @@ type TokenControllerConfig struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// HealthCheckControllerConfig defines the configuration of the health check controller.
+type HealthCheckControllerConfig struct {
+	// SyncPeriod is the duration how often the health checks are executed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Checks is the list of health checks which are executed in addition to the built-in kubelet and containerd
+	// health checks.
+	// +optional
+	Checks []HealthCheck `json:"checks,omitempty"`
+}
+
+// HealthCheck defines a health check and its remediation. Exactly one of the check types must be specified.
+type HealthCheck struct {
+	// Name is the name of the health check.
+	Name string `json:"name"`
+	// SystemdUnit checks that a systemd unit is active.
+	// +optional
+	SystemdUnit *SystemdUnitHealthCheck `json:"systemdUnit,omitempty"`
+	// Path checks that a file, directory or mount point is present.
+	// +optional
+	Path *PathHealthCheck `json:"path,omitempty"`
+	// DiskPressure checks that a file system has enough free space and inodes.
+	// +optional
+	DiskPressure *DiskPressureHealthCheck `json:"diskPressure,omitempty"`
+	// NTP checks that the system clock is synchronized and its estimated drift is within bounds.
+	// +optional
+	NTP *NTPHealthCheck `json:"ntp,omitempty"`
+	// Script checks that a command exits with code 0.
+	// +optional
+	Script *ScriptHealthCheck `json:"script,omitempty"`
+	// FailureDuration is the duration for which the health check must continuously fail before the remediation is
+	// executed.
+	// +optional
+	FailureDuration *metav1.Duration `json:"failureDuration,omitempty"`
+	// Remediation is the remediation which is executed when the health check fails for longer than FailureDuration.
+	// If not specified, failures are only reported via events.
+	// +optional
+	Remediation *HealthCheckRemediation `json:"remediation,omitempty"`
+}
+
+// SystemdUnitHealthCheck checks that a systemd unit is active.
+type SystemdUnitHealthCheck struct {
+	// UnitName is the name of the systemd unit.
+	UnitName string `json:"unitName"`
+}
+
+// PathHealthCheck checks that a file, directory or mount point is present.
+type PathHealthCheck struct {
+	// Path is the absolute path on the node.
+	Path string `json:"path"`
+	// Mount specifies whether the path must be a mount point.
+	// +optional
+	Mount bool `json:"mount,omitempty"`
+}
+
+// DiskPressureHealthCheck checks that a file system has enough free space and inodes.
+type DiskPressureHealthCheck struct {
+	// Path is an absolute path on the file system which is checked.
+	Path string `json:"path"`
+	// MinFreePercent is the minimum percentage of free space.
+	// +optional
+	MinFreePercent *int32 `json:"minFreePercent,omitempty"`
+	// MinFreeInodesPercent is the minimum percentage of free inodes.
+	// +optional
+	MinFreeInodesPercent *int32 `json:"minFreeInodesPercent,omitempty"`
+}
+
+// NTPHealthCheck checks that the system clock is synchronized and its estimated drift is within bounds.
+type NTPHealthCheck struct {
+	// MaxDrift is the maximum tolerated estimated drift of the system clock.
+	// +optional
+	MaxDrift *metav1.Duration `json:"maxDrift,omitempty"`
+}
+
+// ScriptHealthCheck checks that a command exits with code 0.
+type ScriptHealthCheck struct {
+	// Command is the command and its arguments.
+	Command []string `json:"command"`
+	// Timeout is the timeout for the command.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// HealthCheckRemediation defines the remediation of a failed health check.
+type HealthCheckRemediation struct {
+	// Action is the remediation action.
+	Action RemediationAction `json:"action"`
+	// UnitName is the name of the systemd unit which is restarted. Only relevant for the RestartUnit action, defaults
+	// to the unit of a SystemdUnit health check.
+	// +optional
+	UnitName *string `json:"unitName,omitempty"`
+	// ConditionType is the type of the Node condition which is set. Only relevant for the SetNodeCondition action.
+	// +optional
+	ConditionType *string `json:"conditionType,omitempty"`
+	// MaxAttempts is the maximum number of remediations within Period. Further remediations are skipped to avoid
+	// remediation loops (e.g., reboot loops).
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+	// Period is the time window for MaxAttempts.
+	// +optional
+	Period *metav1.Duration `json:"period,omitempty"`
+}
+
+// RemediationAction is a remediation action of a failed health check.
+type RemediationAction string
+
+const (
+	// RemediationActionRestartUnit restarts a systemd unit.
+	RemediationActionRestartUnit RemediationAction = "RestartUnit"
+	// RemediationActionCordon marks the node as unschedulable.
+	RemediationActionCordon RemediationAction = "Cordon"
+	// RemediationActionReboot reboots the node.
+	RemediationActionReboot RemediationAction = "Reboot"
+	// RemediationActionSetNodeCondition sets a Node condition with status 'True' while the health check fails.
+	RemediationActionSetNodeCondition RemediationAction = "SetNodeCondition"
+)
+
 // TokenSecretSyncConfig contains configurations for syncing access tokens.
 type TokenSecretSyncConfig struct {
 	// SecretName defines the name of the secret in the shoot cluster's kube-system namespace which contains the access
@@ -146,6 +340,22 @@ type ServerConfiguration struct {
 	// Metrics is the configuration for serving the metrics endpoint.
 	// +optional
 	Metrics *Server `json:"metrics,omitempty"`
+	// SupportBundle is the configuration for serving the support bundle endpoint. It is only served on the loopback
+	// interface of the node.
+	// +optional
+	SupportBundle *SupportBundleConfiguration `json:"supportBundle,omitempty"`
+}
+
+// SupportBundleConfiguration contains configuration for the support bundle endpoint.
+type SupportBundleConfiguration struct {
+	// Enabled specifies whether the support bundle endpoint is served.
+	Enabled bool `json:"enabled"`
+	// Port is the port on the loopback interface on which the support bundle endpoint is served. Defaults to 2753.
+	// +optional
+	Port int `json:"port,omitempty"`
+	// JournalLines is the number of journal lines collected per systemd unit.
+	// +optional
+	JournalLines *int `json:"journalLines,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -5,14 +5,18 @@
 package validation
 
 import (
+	"path"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
 )
@@ -31,12 +35,31 @@ func ValidateNodeAgentConfiguration(conf *nodeagentconfigv1alpha1.NodeAgentConfi
 		allErrs = append(allErrs, field.NotSupported(field.NewPath("logFormat"), conf.LogFormat, logger.AllLogFormats))
 	}
 
+	allErrs = append(allErrs, validateSupportBundleConfiguration(conf.Server.SupportBundle, field.NewPath("server", "supportBundle"))...)
 	allErrs = append(allErrs, validateBootstrapConfiguration(conf.Bootstrap, field.NewPath("bootstrap"))...)
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 
 	return allErrs
 }
 
+func validateSupportBundleConfiguration(conf *nodeagentconfigv1alpha1.SupportBundleConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.Port < 0 || conf.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), conf.Port, "must be a valid port number"))
+	}
+
+	if conf.JournalLines != nil && *conf.JournalLines < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("journalLines"), *conf.JournalLines, "must not be negative"))
+	}
+
+	return allErrs
+}
+
 func validateBootstrapConfiguration(_ *nodeagentconfigv1alpha1.BootstrapConfiguration, _ *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -48,6 +71,8 @@ func validateControllerConfiguration(conf nodeagentconfigv1alpha1.ControllerConf
 
 	allErrs = append(allErrs, validateOperatingSystemConfigControllerConfiguration(conf.OperatingSystemConfig, fldPath.Child("operatingSystemConfig"))...)
 	allErrs = append(allErrs, validateTokenControllerConfiguration(conf.Token, fldPath.Child("token"))...)
+	allErrs = append(allErrs, ValidateHealthCheckControllerConfiguration(conf.HealthCheck, fldPath.Child("healthCheck"))...)
+	allErrs = append(allErrs, ValidateRebootControllerConfiguration(conf.Reboot, fldPath.Child("reboot"))...)
 
 	return allErrs
 }
@@ -67,6 +92,52 @@ func validateOperatingSystemConfigControllerConfiguration(conf nodeagentconfigv1
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesVersion"), conf.KubernetesVersion, err.Error()))
 	}
 
+	allErrs = append(allErrs, ValidateDriftDetectionConfiguration(conf.DriftDetection, fldPath.Child("driftDetection"))...)
+
+	return allErrs
+}
+
+// ValidateDriftDetectionConfiguration validates the given `DriftDetectionConfig`.
+func ValidateDriftDetectionConfiguration(conf *nodeagentconfigv1alpha1.DriftDetectionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod, "must be at least 1m"))
+	}
+
+	return allErrs
+}
+
+// ValidateRebootControllerConfiguration validates the given `RebootControllerConfig`.
+func ValidateRebootControllerConfiguration(conf *nodeagentconfigv1alpha1.RebootControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.MaxConcurrentReboots != nil && *conf.MaxConcurrentReboots < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentReboots"), *conf.MaxConcurrentReboots, "must be at least 1"))
+	}
+
+	if conf.MaintenanceWindow != nil {
+		if _, err := timewindow.ParseMaintenanceTimeWindow(conf.MaintenanceWindow.Begin, conf.MaintenanceWindow.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maintenanceWindow"), *conf.MaintenanceWindow, err.Error()))
+		}
+	}
+
+	if conf.DrainTimeout != nil && conf.DrainTimeout.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("drainTimeout"), conf.DrainTimeout, "must be at least 1m"))
+	}
+
+	if conf.LeaseDuration != nil && conf.LeaseDuration.Duration < 5*time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("leaseDuration"), conf.LeaseDuration, "must be at least 5m"))
+	}
+
 	return allErrs
 }
 
@@ -98,6 +169,136 @@ func validateTokenControllerConfiguration(conf nodeagentconfigv1alpha1.TokenCont
 	return allErrs
 }
 
+var availableRemediationActions = sets.New(
+	nodeagentconfigv1alpha1.RemediationActionRestartUnit,
+	nodeagentconfigv1alpha1.RemediationActionCordon,
+	nodeagentconfigv1alpha1.RemediationActionReboot,
+	nodeagentconfigv1alpha1.RemediationActionSetNodeCondition,
+)
+
+// ValidateHealthCheckControllerConfiguration validates the given `HealthCheckControllerConfig`.
+func ValidateHealthCheckControllerConfiguration(conf nodeagentconfigv1alpha1.HealthCheckControllerConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration < 15*time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod, "must be at least 15s"))
+	}
+
+	for i, check := range conf.Checks {
+		idxPath := fldPath.Child("checks").Index(i)
+
+		if check.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name for the health check"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(check.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), check.Name, msg))
+			}
+			if names.Has(check.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), check.Name))
+			}
+			names.Insert(check.Name)
+		}
+
+		allErrs = append(allErrs, validateHealthCheck(check, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateHealthCheck(check nodeagentconfigv1alpha1.HealthCheck, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		checkTypes int
+	)
+
+	if check.SystemdUnit != nil {
+		checkTypes++
+		if check.SystemdUnit.UnitName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("systemdUnit", "unitName"), "must provide the name of the systemd unit"))
+		}
+	}
+
+	if check.Path != nil {
+		checkTypes++
+		if !path.IsAbs(check.Path.Path) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("path", "path"), check.Path.Path, "must be an absolute path"))
+		}
+	}
+
+	if check.DiskPressure != nil {
+		checkTypes++
+		if !path.IsAbs(check.DiskPressure.Path) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("diskPressure", "path"), check.DiskPressure.Path, "must be an absolute path"))
+		}
+		allErrs = append(allErrs, validatePercentage(check.DiskPressure.MinFreePercent, fldPath.Child("diskPressure", "minFreePercent"))...)
+		allErrs = append(allErrs, validatePercentage(check.DiskPressure.MinFreeInodesPercent, fldPath.Child("diskPressure", "minFreeInodesPercent"))...)
+	}
+
+	if check.NTP != nil {
+		checkTypes++
+		if check.NTP.MaxDrift != nil && check.NTP.MaxDrift.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ntp", "maxDrift"), check.NTP.MaxDrift, "must be positive"))
+		}
+	}
+
+	if check.Script != nil {
+		checkTypes++
+		if len(check.Script.Command) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("script", "command"), "must provide the command to execute"))
+		}
+		if check.Script.Timeout != nil && check.Script.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("script", "timeout"), check.Script.Timeout, "must be positive"))
+		}
+	}
+
+	if checkTypes != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, check.Name, "exactly one of systemdUnit, path, diskPressure, ntp or script must be specified"))
+	}
+
+	if check.FailureDuration != nil && check.FailureDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureDuration"), check.FailureDuration, "must not be negative"))
+	}
+
+	if remediation := check.Remediation; remediation != nil {
+		remediationPath := fldPath.Child("remediation")
+
+		if !availableRemediationActions.Has(remediation.Action) {
+			allErrs = append(allErrs, field.NotSupported(remediationPath.Child("action"), remediation.Action, sets.List(availableRemediationActions)))
+		}
+
+		if remediation.Action == nodeagentconfigv1alpha1.RemediationActionRestartUnit && remediation.UnitName == nil && check.SystemdUnit == nil {
+			allErrs = append(allErrs, field.Required(remediationPath.Child("unitName"), "must provide the name of the systemd unit to restart"))
+		}
+
+		if remediation.Action == nodeagentconfigv1alpha1.RemediationActionSetNodeCondition && ptr.Deref(remediation.ConditionType, "") == "" {
+			allErrs = append(allErrs, field.Required(remediationPath.Child("conditionType"), "must provide the type of the node condition"))
+		}
+
+		if remediation.MaxAttempts != nil && *remediation.MaxAttempts < 1 {
+			allErrs = append(allErrs, field.Invalid(remediationPath.Child("maxAttempts"), *remediation.MaxAttempts, "must be at least 1"))
+		}
+
+		if remediation.Period != nil && remediation.Period.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(remediationPath.Child("period"), remediation.Period, "must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePercentage(val *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if val != nil && (*val < 0 || *val > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *val, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
 func validateSyncPeriod(val *metav1.Duration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
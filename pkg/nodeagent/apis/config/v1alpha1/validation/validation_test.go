@@ -14,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1/validation"
@@ -74,6 +75,36 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 		})
 	})
 
+	Context("support bundle configuration", func() {
+		It("should allow an enabled support bundle endpoint", func() {
+			config.Server.SupportBundle = &SupportBundleConfiguration{Enabled: true, JournalLines: ptr.To(500)}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because journal lines are negative", func() {
+			config.Server.SupportBundle = &SupportBundleConfiguration{Enabled: true, JournalLines: ptr.To(-1)}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("server.supportBundle.journalLines"),
+				})),
+			))
+		})
+
+		It("should fail because the port is invalid", func() {
+			config.Server.SupportBundle = &SupportBundleConfiguration{Enabled: true, Port: 70000}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("server.supportBundle.port"),
+				})),
+			))
+		})
+	})
+
 	Context("Operating System Config Controller", func() {
 		It("should fail because kubernetes version is empty", func() {
 			config.Controllers.OperatingSystemConfig.KubernetesVersion = nil
@@ -107,6 +138,28 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 				})),
 			))
 		})
+
+		It("should allow valid drift detection configuration", func() {
+			config.Controllers.OperatingSystemConfig.DriftDetection = &DriftDetectionConfig{
+				SyncPeriod: &metav1.Duration{Duration: 5 * time.Minute},
+				Reapply:    true,
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because drift detection sync period is too small", func() {
+			config.Controllers.OperatingSystemConfig.DriftDetection = &DriftDetectionConfig{
+				SyncPeriod: &metav1.Duration{Duration: 30 * time.Second},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.driftDetection.syncPeriod"),
+				})),
+			))
+		})
 	})
 
 	Context("Token Controller", func() {
@@ -161,4 +214,178 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 			))
 		})
 	})
+	Context("Health Check Controller", func() {
+		var check HealthCheck
+
+		BeforeEach(func() {
+			check = HealthCheck{
+				Name:        "my-unit",
+				SystemdUnit: &SystemdUnitHealthCheck{UnitName: "my.service"},
+				Remediation: &HealthCheckRemediation{Action: RemediationActionRestartUnit},
+			}
+		})
+
+		It("should allow valid health checks", func() {
+			config.Controllers.HealthCheck.Checks = []HealthCheck{
+				check,
+				{Name: "var-mount", Path: &PathHealthCheck{Path: "/var", Mount: true}, Remediation: &HealthCheckRemediation{Action: RemediationActionCordon}},
+				{Name: "disk", DiskPressure: &DiskPressureHealthCheck{Path: "/var/lib", MinFreePercent: ptr.To[int32](10)}},
+				{Name: "ntp", NTP: &NTPHealthCheck{MaxDrift: &metav1.Duration{Duration: time.Second}}, Remediation: &HealthCheckRemediation{Action: RemediationActionSetNodeCondition, ConditionType: ptr.To("ClockDrift")}},
+				{Name: "script", Script: &ScriptHealthCheck{Command: []string{"/opt/bin/check"}}, Remediation: &HealthCheckRemediation{Action: RemediationActionReboot, MaxAttempts: ptr.To[int32](1)}},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because sync period is too small", func() {
+			config.Controllers.HealthCheck.SyncPeriod = &metav1.Duration{Duration: 10 * time.Second}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.syncPeriod"),
+				})),
+			))
+		})
+
+		It("should fail because names are invalid or duplicated", func() {
+			config.Controllers.HealthCheck.Checks = []HealthCheck{check, check, {Name: "Foo_Bar", Path: &PathHealthCheck{Path: "/foo"}}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.healthCheck.checks[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[2].name"),
+				})),
+			))
+		})
+
+		It("should fail because not exactly one check type is specified", func() {
+			check.Path = &PathHealthCheck{Path: "/foo"}
+			config.Controllers.HealthCheck.Checks = []HealthCheck{check, {Name: "none"}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[1]"),
+				})),
+			))
+		})
+
+		It("should fail because check types are invalid", func() {
+			config.Controllers.HealthCheck.Checks = []HealthCheck{
+				{Name: "unit", SystemdUnit: &SystemdUnitHealthCheck{}},
+				{Name: "path", Path: &PathHealthCheck{Path: "relative"}},
+				{Name: "disk", DiskPressure: &DiskPressureHealthCheck{Path: "/var", MinFreePercent: ptr.To[int32](101), MinFreeInodesPercent: ptr.To[int32](-1)}},
+				{Name: "ntp", NTP: &NTPHealthCheck{MaxDrift: &metav1.Duration{}}},
+				{Name: "script", Script: &ScriptHealthCheck{}},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[0].systemdUnit.unitName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[1].path.path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[2].diskPressure.minFreePercent"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[2].diskPressure.minFreeInodesPercent"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[3].ntp.maxDrift"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[4].script.command"),
+				})),
+			))
+		})
+
+		It("should fail because the remediation is invalid", func() {
+			config.Controllers.HealthCheck.Checks = []HealthCheck{
+				{Name: "foo", Path: &PathHealthCheck{Path: "/foo"}, Remediation: &HealthCheckRemediation{Action: "Explode"}},
+				{Name: "bar", Path: &PathHealthCheck{Path: "/bar"}, Remediation: &HealthCheckRemediation{Action: RemediationActionRestartUnit, MaxAttempts: ptr.To[int32](0)}},
+				{Name: "baz", Path: &PathHealthCheck{Path: "/baz"}, Remediation: &HealthCheckRemediation{Action: RemediationActionSetNodeCondition, Period: &metav1.Duration{}}},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.healthCheck.checks[0].remediation.action"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[1].remediation.unitName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[1].remediation.maxAttempts"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[2].remediation.conditionType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[2].remediation.period"),
+				})),
+			))
+		})
+	})
+
+	Context("Reboot Controller", func() {
+		It("should allow a valid configuration", func() {
+			config.Controllers.Reboot = &RebootControllerConfig{
+				MaxConcurrentReboots: ptr.To[int32](2),
+				MaintenanceWindow:    &MaintenanceWindow{Begin: "220000+0100", End: "230000+0100"},
+				DrainTimeout:         &metav1.Duration{Duration: 5 * time.Minute},
+				LeaseDuration:        &metav1.Duration{Duration: 30 * time.Minute},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because of invalid values", func() {
+			config.Controllers.Reboot = &RebootControllerConfig{
+				MaxConcurrentReboots: ptr.To[int32](0),
+				MaintenanceWindow:    &MaintenanceWindow{Begin: "foo", End: "230000+0100"},
+				DrainTimeout:         &metav1.Duration{Duration: 30 * time.Second},
+				LeaseDuration:        &metav1.Duration{Duration: time.Minute},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.reboot.maxConcurrentReboots"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.reboot.maintenanceWindow"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.reboot.drainTimeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.reboot.leaseDuration"),
+				})),
+			))
+		})
+	})
 })
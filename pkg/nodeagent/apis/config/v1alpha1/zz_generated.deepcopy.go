@@ -63,6 +63,12 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
 	in.OperatingSystemConfig.DeepCopyInto(&out.OperatingSystemConfig)
 	in.Token.DeepCopyInto(&out.Token)
+	in.HealthCheck.DeepCopyInto(&out.HealthCheck)
+	if in.Reboot != nil {
+		in, out := &in.Reboot, &out.Reboot
+		*out = new(RebootControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -76,6 +82,205 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskPressureHealthCheck) DeepCopyInto(out *DiskPressureHealthCheck) {
+	*out = *in
+	if in.MinFreePercent != nil {
+		in, out := &in.MinFreePercent, &out.MinFreePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinFreeInodesPercent != nil {
+		in, out := &in.MinFreeInodesPercent, &out.MinFreeInodesPercent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskPressureHealthCheck.
+func (in *DiskPressureHealthCheck) DeepCopy() *DiskPressureHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskPressureHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionConfig) DeepCopyInto(out *DriftDetectionConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionConfig.
+func (in *DriftDetectionConfig) DeepCopy() *DriftDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	if in.SystemdUnit != nil {
+		in, out := &in.SystemdUnit, &out.SystemdUnit
+		*out = new(SystemdUnitHealthCheck)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(PathHealthCheck)
+		**out = **in
+	}
+	if in.DiskPressure != nil {
+		in, out := &in.DiskPressure, &out.DiskPressure
+		*out = new(DiskPressureHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NTP != nil {
+		in, out := &in.NTP, &out.NTP
+		*out = new(NTPHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Script != nil {
+		in, out := &in.Script, &out.Script
+		*out = new(ScriptHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureDuration != nil {
+		in, out := &in.FailureDuration, &out.FailureDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(HealthCheckRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckControllerConfig) DeepCopyInto(out *HealthCheckControllerConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]HealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckControllerConfig.
+func (in *HealthCheckControllerConfig) DeepCopy() *HealthCheckControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckRemediation) DeepCopyInto(out *HealthCheckRemediation) {
+	*out = *in
+	if in.UnitName != nil {
+		in, out := &in.UnitName, &out.UnitName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConditionType != nil {
+		in, out := &in.ConditionType, &out.ConditionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckRemediation.
+func (in *HealthCheckRemediation) DeepCopy() *HealthCheckRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NTPHealthCheck) DeepCopyInto(out *NTPHealthCheck) {
+	*out = *in
+	if in.MaxDrift != nil {
+		in, out := &in.MaxDrift, &out.MaxDrift
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTPHealthCheck.
+func (in *NTPHealthCheck) DeepCopy() *NTPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(NTPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 	*out = *in
@@ -135,6 +340,11 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopyInto(out *OperatingSyst
 		*out = new(v3.Version)
 		**out = **in
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -148,6 +358,84 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopy() *OperatingSystemConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PathHealthCheck) DeepCopyInto(out *PathHealthCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PathHealthCheck.
+func (in *PathHealthCheck) DeepCopy() *PathHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PathHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebootControllerConfig) DeepCopyInto(out *RebootControllerConfig) {
+	*out = *in
+	if in.MaxConcurrentReboots != nil {
+		in, out := &in.MaxConcurrentReboots, &out.MaxConcurrentReboots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebootControllerConfig.
+func (in *RebootControllerConfig) DeepCopy() *RebootControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RebootControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScriptHealthCheck) DeepCopyInto(out *ScriptHealthCheck) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScriptHealthCheck.
+func (in *ScriptHealthCheck) DeepCopy() *ScriptHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ScriptHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -177,6 +465,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(Server)
 		**out = **in
 	}
+	if in.SupportBundle != nil {
+		in, out := &in.SupportBundle, &out.SupportBundle
+		*out = new(SupportBundleConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -190,6 +483,43 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportBundleConfiguration) DeepCopyInto(out *SupportBundleConfiguration) {
+	*out = *in
+	if in.JournalLines != nil {
+		in, out := &in.JournalLines, &out.JournalLines
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleConfiguration.
+func (in *SupportBundleConfiguration) DeepCopy() *SupportBundleConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportBundleConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemdUnitHealthCheck) DeepCopyInto(out *SystemdUnitHealthCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemdUnitHealthCheck.
+func (in *SystemdUnitHealthCheck) DeepCopy() *SystemdUnitHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemdUnitHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenControllerConfig) DeepCopyInto(out *TokenControllerConfig) {
 	*out = *in
@@ -25,6 +25,32 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_NodeAgentConfiguration(in)
 	SetDefaults_ClientConnectionConfiguration(&in.ClientConnection)
 	SetDefaults_ServerConfiguration(&in.Server)
+	if in.Server.SupportBundle != nil {
+		SetDefaults_SupportBundleConfiguration(in.Server.SupportBundle)
+	}
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
+	if in.Controllers.OperatingSystemConfig.DriftDetection != nil {
+		SetDefaults_DriftDetectionConfig(in.Controllers.OperatingSystemConfig.DriftDetection)
+	}
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
+	SetDefaults_HealthCheckControllerConfig(&in.Controllers.HealthCheck)
+	for i := range in.Controllers.HealthCheck.Checks {
+		a := &in.Controllers.HealthCheck.Checks[i]
+		SetDefaults_HealthCheck(a)
+		if a.DiskPressure != nil {
+			SetDefaults_DiskPressureHealthCheck(a.DiskPressure)
+		}
+		if a.NTP != nil {
+			SetDefaults_NTPHealthCheck(a.NTP)
+		}
+		if a.Script != nil {
+			SetDefaults_ScriptHealthCheck(a.Script)
+		}
+		if a.Remediation != nil {
+			SetDefaults_HealthCheckRemediation(a.Remediation)
+		}
+	}
+	if in.Controllers.Reboot != nil {
+		SetDefaults_RebootControllerConfig(in.Controllers.Reboot)
+	}
 }
@@ -8,8 +8,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -21,7 +23,10 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/controller/lease"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/node"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	rebootcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/reboot"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/token"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/reboot"
 )
 
 // AddToManager adds all controllers to the given manager.
@@ -43,6 +48,26 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed adding node controller: %w", err)
 	}
 
+	var rebootCoordinator *reboot.Coordinator
+	// Coordinated reboots require the node name because the pods running on the node must be evicted before rebooting.
+	if cfg.Controllers.Reboot != nil && nodeName != "" {
+		rebootCoordinator = &reboot.Coordinator{
+			Client:    mgr.GetClient(),
+			APIReader: mgr.GetAPIReader(),
+			FS:        afero.Afero{Fs: afero.NewOsFs()},
+			Clock:     clock.RealClock{},
+			DBus:      dbus.New(mgr.GetLogger().WithValues("controller", rebootcontroller.ControllerName)),
+			Recorder:  mgr.GetEventRecorderFor(rebootcontroller.ControllerName),
+			Config:    *cfg.Controllers.Reboot,
+		}
+
+		if err := (&rebootcontroller.Reconciler{
+			Coordinator: rebootCoordinator,
+		}).AddToManager(mgr, nodePredicate); err != nil {
+			return fmt.Errorf("failed adding reboot controller: %w", err)
+		}
+	}
+
 	var channel = make(chan event.TypedGenericEvent[*corev1.Secret])
 
 	if err := (&operatingsystemconfig.Reconciler{
@@ -54,6 +79,7 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		NodeName:               nodeName,
 		MachineName:            machineName,
 		CancelContext:          cancel,
+		RebootCoordinator:      rebootCoordinator,
 	}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding operating system config controller: %w", err)
 	}
@@ -72,7 +98,10 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		}
 	}
 
-	if err := (&healthcheck.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+	if err := (&healthcheck.Reconciler{
+		Config:            cfg.Controllers.HealthCheck,
+		RebootCoordinator: rebootCoordinator,
+	}).AddToManager(mgr, nodePredicate); err != nil {
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
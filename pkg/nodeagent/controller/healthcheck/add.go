@@ -12,6 +12,7 @@ import (
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/defaults"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -43,14 +44,27 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 		r.DBus = dbus.New(mgr.GetLogger().WithValues("controller", ControllerName))
 	}
 
+	if r.FS.Fs == nil {
+		r.FS = afero.Afero{Fs: afero.NewOsFs()}
+	}
+
 	if len(r.HealthCheckers) == 0 {
 		if err := r.setDefaultHealthChecks(); err != nil {
 			return err
 		}
 	}
 
+	configurableHealthCheckers, err := NewConfigurableHealthCheckers(r.Config.Checks, r.Client, r.FS, clock.RealClock{}, r.DBus, r.Recorder, r.RebootCoordinator)
+	if err != nil {
+		return fmt.Errorf("failed creating configured health checks: %w", err)
+	}
+	r.HealthCheckers = append(r.HealthCheckers, configurableHealthCheckers...)
+
 	if r.HealthCheckIntervalSeconds == 0 {
 		r.HealthCheckIntervalSeconds = defaultIntervalSeconds
+		if r.Config.SyncPeriod != nil {
+			r.HealthCheckIntervalSeconds = int32(r.Config.SyncPeriod.Seconds())
+		}
 	}
 
 	return builder.
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"syscall"
+	"time"
+)
+
+const (
+	// timeError is the clock state returned by adjtimex when the clock is not synchronized.
+	timeError = 5
+	// statusUnsync is the status bit of adjtimex indicating that the clock is not synchronized.
+	statusUnsync = 0x0040
+)
+
+func readClockState() (bool, time.Duration, error) {
+	timex := &syscall.Timex{}
+
+	state, err := syscall.Adjtimex(timex)
+	if err != nil {
+		return false, 0, err
+	}
+
+	synchronized := state != timeError && timex.Status&statusUnsync == 0
+	return synchronized, time.Duration(timex.Maxerror) * time.Microsecond, nil
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package healthcheck
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+func readClockState() (bool, time.Duration, error) {
+	return false, 0, fmt.Errorf("reading the system clock state is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/reboot"
+)
+
+type configurableHealthChecker struct {
+	name            string
+	probe           probe
+	failureDuration time.Duration
+	remediator      *remediator
+
+	clock        clock.Clock
+	recorder     record.EventRecorder
+	firstFailure *time.Time
+}
+
+// NewConfigurableHealthCheckers creates health checkers for the given health check configurations.
+func NewConfigurableHealthCheckers(
+	checks []nodeagentconfigv1alpha1.HealthCheck,
+	client client.Client,
+	fs afero.Afero,
+	clock clock.Clock,
+	dbus dbus.DBus,
+	recorder record.EventRecorder,
+	rebootCoordinator *reboot.Coordinator,
+) ([]HealthChecker, error) {
+	var (
+		history        = &remediationHistory{fs: fs}
+		healthCheckers = make([]HealthChecker, 0, len(checks))
+	)
+
+	for _, check := range checks {
+		checker := &configurableHealthChecker{
+			name:            check.Name,
+			failureDuration: ptr.Deref(check.FailureDuration, metav1.Duration{Duration: maxFailureDuration}).Duration,
+			clock:           clock,
+			recorder:        recorder,
+		}
+
+		switch {
+		case check.SystemdUnit != nil:
+			checker.probe = systemdUnitProbe(dbus, check.SystemdUnit.UnitName)
+		case check.Path != nil:
+			checker.probe = pathProbe(fs, check.Path.Path, check.Path.Mount)
+		case check.DiskPressure != nil:
+			checker.probe = diskPressureProbe(check.DiskPressure.Path, ptr.Deref(check.DiskPressure.MinFreePercent, 0), ptr.Deref(check.DiskPressure.MinFreeInodesPercent, 0))
+		case check.NTP != nil:
+			checker.probe = ntpProbe(ptr.Deref(check.NTP.MaxDrift, metav1.Duration{Duration: time.Second}).Duration)
+		case check.Script != nil:
+			checker.probe = scriptProbe(check.Script.Command, ptr.Deref(check.Script.Timeout, metav1.Duration{Duration: 10 * time.Second}).Duration)
+		default:
+			return nil, fmt.Errorf("health check %q does not specify a check type", check.Name)
+		}
+
+		if remediation := check.Remediation; remediation != nil {
+			checker.remediator = &remediator{
+				checkName:     check.Name,
+				action:        remediation.Action,
+				unitName:      ptr.Deref(remediation.UnitName, ""),
+				conditionType: corev1.NodeConditionType(ptr.Deref(remediation.ConditionType, "")),
+				maxAttempts:   int(ptr.Deref(remediation.MaxAttempts, 1)),
+				period:        ptr.Deref(remediation.Period, metav1.Duration{Duration: time.Hour}).Duration,
+				client:        client,
+				clock:         clock,
+				dbus:          dbus,
+				recorder:      recorder,
+				history:       history,
+
+				rebootCoordinator: rebootCoordinator,
+			}
+			if checker.remediator.unitName == "" && check.SystemdUnit != nil {
+				checker.remediator.unitName = check.SystemdUnit.UnitName
+			}
+		}
+
+		healthCheckers = append(healthCheckers, checker)
+	}
+
+	return healthCheckers, nil
+}
+
+// Name returns the name of this health check.
+func (c *configurableHealthChecker) Name() string {
+	return c.name
+}
+
+// Check executes the configured probe and remediates failures which last longer than the failure duration.
+func (c *configurableHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(c.Name())
+
+	checkErr := c.probe(ctx)
+	if checkErr == nil {
+		if c.firstFailure != nil {
+			log.Info("Health check succeeded again")
+			c.recorder.Eventf(node, corev1.EventTypeNormal, c.name, "Health check %q succeeded", c.name)
+			c.firstFailure = nil
+		}
+
+		if c.remediator != nil {
+			if err := c.remediator.resolve(ctx, node); err != nil {
+				return fmt.Errorf("failed resolving remediation of health check %q: %w", c.name, err)
+			}
+		}
+		return nil
+	}
+
+	if c.firstFailure == nil {
+		now := c.clock.Now()
+		c.firstFailure = &now
+
+		log.Error(checkErr, "Health check failed")
+		c.recorder.Eventf(node, corev1.EventTypeWarning, c.name, "Health check %q failed: %s", c.name, checkErr.Error())
+	}
+
+	if c.remediator == nil || c.clock.Since(*c.firstFailure) < c.failureDuration {
+		return nil
+	}
+
+	log.Error(checkErr, "Health check failed for too long, remediating", "failureDuration", c.failureDuration, "action", c.remediator.action)
+	if err := c.remediator.remediate(ctx, node, checkErr); err != nil {
+		return fmt.Errorf("failed remediating health check %q: %w", c.name, err)
+	}
+
+	if c.remediator.action == nodeagentconfigv1alpha1.RemediationActionRestartUnit || c.remediator.action == nodeagentconfigv1alpha1.RemediationActionReboot {
+		// give the component the full failure duration to recover before remediating again
+		c.firstFailure = nil
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/nodeagent/reboot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("ConfigurableHealthChecker", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		fs         afero.Afero
+		clock      *testclock.FakeClock
+		dbus       *fakedbus.DBus
+		recorder   *record.FakeRecorder
+
+		rebootCoordinator *reboot.Coordinator
+
+		node  *corev1.Node
+		check nodeagentconfigv1alpha1.HealthCheck
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(node).
+			WithStatusSubresource(node).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, indexer.PodNodeNameIndexerFunc).
+			Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		clock = testclock.NewFakeClock(time.Now())
+		dbus = fakedbus.New()
+		recorder = record.NewFakeRecorder(100)
+		rebootCoordinator = nil
+
+		check = nodeagentconfigv1alpha1.HealthCheck{
+			Name:            "my-unit",
+			SystemdUnit:     &nodeagentconfigv1alpha1.SystemdUnitHealthCheck{UnitName: "my.service"},
+			FailureDuration: &metav1.Duration{Duration: time.Minute},
+		}
+	})
+
+	newHealthChecker := func() HealthChecker {
+		healthCheckers, err := NewConfigurableHealthCheckers([]nodeagentconfigv1alpha1.HealthCheck{check}, fakeClient, fs, clock, dbus, recorder, rebootCoordinator)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(healthCheckers).To(HaveLen(1))
+		Expect(healthCheckers[0].Name()).To(Equal(check.Name))
+		return healthCheckers[0]
+	}
+
+	runCheck := func(healthChecker HealthChecker) {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		ExpectWithOffset(1, healthChecker.Check(ctx, node)).To(Succeed())
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+	}
+
+	Describe("#NewConfigurableHealthCheckers", func() {
+		It("should fail if no check type is specified", func() {
+			_, err := NewConfigurableHealthCheckers([]nodeagentconfigv1alpha1.HealthCheck{{Name: "foo"}}, fakeClient, fs, clock, dbus, recorder, nil)
+			Expect(err).To(MatchError(ContainSubstring(`health check "foo" does not specify a check type`)))
+		})
+	})
+
+	Describe("#Check", func() {
+		Context("without remediation", func() {
+			It("should only record events", func() {
+				healthChecker := newHealthChecker()
+
+				runCheck(healthChecker)
+				Expect(recorder.Events).To(Receive(ContainSubstring(`Health check "my-unit" failed: systemd unit "my.service" not found`)))
+
+				clock.Step(2 * time.Minute)
+				runCheck(healthChecker)
+				Expect(recorder.Events).NotTo(Receive())
+
+				dbus.AddUnitsToList(systemddbus.UnitStatus{Name: "my.service", ActiveState: "active"})
+				runCheck(healthChecker)
+				Expect(recorder.Events).To(Receive(ContainSubstring(`Health check "my-unit" succeeded`)))
+			})
+		})
+
+		Context("restart unit remediation", func() {
+			BeforeEach(func() {
+				check.Remediation = &nodeagentconfigv1alpha1.HealthCheckRemediation{
+					Action:      nodeagentconfigv1alpha1.RemediationActionRestartUnit,
+					MaxAttempts: ptr.To[int32](2),
+					Period:      &metav1.Duration{Duration: time.Hour},
+				}
+				dbus.AddUnitsToList(systemddbus.UnitStatus{Name: "my.service", ActiveState: "failed", SubState: "failed"})
+			})
+
+			It("should restart the unit after the failure duration and respect the rate limit", func() {
+				healthChecker := newHealthChecker()
+
+				runCheck(healthChecker)
+				Expect(dbus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionList}))
+
+				for i := 0; i < 2; i++ {
+					clock.Step(time.Minute)
+					runCheck(healthChecker)
+					runCheck(healthChecker)
+				}
+				Expect(dbus.Actions).To(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"my.service"}}))
+				Expect(countActions(dbus, fakedbus.ActionRestart)).To(Equal(2))
+				Expect(fs.Exists(RemediationHistoryFilePath)).To(BeTrue())
+
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(countActions(dbus, fakedbus.ActionRestart)).To(Equal(2))
+				Eventually(recorder.Events).Should(Receive(ContainSubstring("RestartUnit was already executed 2 times within 1h0m0s, skipping it")))
+
+				By("allowing remediations again after the period")
+				clock.Step(time.Hour)
+				runCheck(healthChecker)
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(countActions(dbus, fakedbus.ActionRestart)).To(Equal(3))
+			})
+
+			It("should respect the persisted rate limit of previous health checker instances", func() {
+				check.Remediation.Action = nodeagentconfigv1alpha1.RemediationActionReboot
+				check.Remediation.MaxAttempts = ptr.To[int32](1)
+
+				healthChecker := newHealthChecker()
+				runCheck(healthChecker)
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(countActions(dbus, fakedbus.ActionReboot)).To(Equal(1))
+
+				By("simulating a restart of gardener-node-agent after the reboot")
+				healthChecker = newHealthChecker()
+				runCheck(healthChecker)
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(countActions(dbus, fakedbus.ActionReboot)).To(Equal(1))
+			})
+
+			It("should reboot via the reboot coordinator and not count postponed reboots", func() {
+				check.Remediation.Action = nodeagentconfigv1alpha1.RemediationActionReboot
+				check.Remediation.MaxAttempts = ptr.To[int32](1)
+
+				Expect(fs.WriteFile(reboot.BootIDFilePath, []byte("boot-id"), 0444)).To(Succeed())
+				rebootCoordinator = &reboot.Coordinator{
+					Client:    fakeClient,
+					APIReader: fakeClient,
+					FS:        fs,
+					Clock:     clock,
+					DBus:      dbus,
+					Recorder:  recorder,
+					Config: nodeagentconfigv1alpha1.RebootControllerConfig{
+						MaxConcurrentReboots: ptr.To[int32](1),
+						DrainTimeout:         &metav1.Duration{Duration: 10 * time.Minute},
+						LeaseDuration:        &metav1.Duration{Duration: 30 * time.Minute},
+					},
+				}
+
+				lease := &coordinationv1.Lease{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-reboot-default-0", Namespace: "kube-system"},
+					Spec: coordinationv1.LeaseSpec{
+						HolderIdentity:       ptr.To("other-node"),
+						LeaseDurationSeconds: ptr.To[int32](1800),
+						RenewTime:            ptr.To(metav1.NewMicroTime(clock.Now())),
+					},
+				}
+				Expect(fakeClient.Create(ctx, lease)).To(Succeed())
+
+				healthChecker := newHealthChecker()
+				runCheck(healthChecker)
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(countActions(dbus, fakedbus.ActionReboot)).To(Equal(0))
+
+				By("releasing the reboot slot")
+				Expect(fakeClient.Delete(ctx, lease)).To(Succeed())
+				runCheck(healthChecker)
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(countActions(dbus, fakedbus.ActionReboot)).To(Equal(1))
+				Expect(node.Annotations).To(HaveKey(reboot.AnnotationKeyRebootHistory))
+				Expect(fs.ReadFile(RemediationHistoryFilePath)).To(ContainSubstring("my-unit:\n- "))
+
+				By("not recording the remediation again while waiting for the initiated reboot")
+				history, err := fs.ReadFile(RemediationHistoryFilePath)
+				Expect(err).NotTo(HaveOccurred())
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(countActions(dbus, fakedbus.ActionReboot)).To(Equal(1))
+				Expect(fs.ReadFile(RemediationHistoryFilePath)).To(Equal(history))
+			})
+		})
+
+		Context("cordon remediation", func() {
+			BeforeEach(func() {
+				check.Remediation = &nodeagentconfigv1alpha1.HealthCheckRemediation{Action: nodeagentconfigv1alpha1.RemediationActionCordon}
+			})
+
+			It("should cordon and uncordon the node", func() {
+				healthChecker := newHealthChecker()
+
+				runCheck(healthChecker)
+				Expect(node.Spec.Unschedulable).To(BeFalse())
+
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(node.Spec.Unschedulable).To(BeTrue())
+				Expect(node.Annotations).To(HaveKeyWithValue(AnnotationKeyCordonedByHealthCheck, "my-unit"))
+
+				dbus.AddUnitsToList(systemddbus.UnitStatus{Name: "my.service", ActiveState: "active"})
+				runCheck(healthChecker)
+				Expect(node.Spec.Unschedulable).To(BeFalse())
+				Expect(node.Annotations).NotTo(HaveKey(AnnotationKeyCordonedByHealthCheck))
+			})
+
+			It("should not uncordon a node which was cordoned by someone else", func() {
+				node.Spec.Unschedulable = true
+				Expect(fakeClient.Update(ctx, node)).To(Succeed())
+				dbus.AddUnitsToList(systemddbus.UnitStatus{Name: "my.service", ActiveState: "active"})
+
+				runCheck(newHealthChecker())
+				Expect(node.Spec.Unschedulable).To(BeTrue())
+			})
+		})
+
+		Context("node condition remediation", func() {
+			BeforeEach(func() {
+				check.Remediation = &nodeagentconfigv1alpha1.HealthCheckRemediation{
+					Action:        nodeagentconfigv1alpha1.RemediationActionSetNodeCondition,
+					ConditionType: ptr.To("MyUnitUnhealthy"),
+				}
+			})
+
+			It("should not add the condition while the health check succeeds", func() {
+				dbus.AddUnitsToList(systemddbus.UnitStatus{Name: "my.service", ActiveState: "active"})
+
+				runCheck(newHealthChecker())
+				Expect(node.Status.Conditions).To(BeEmpty())
+			})
+
+			It("should set the condition while the health check fails", func() {
+				healthChecker := newHealthChecker()
+
+				runCheck(healthChecker)
+				Expect(node.Status.Conditions).To(BeEmpty())
+
+				clock.Step(time.Minute)
+				runCheck(healthChecker)
+				Expect(node.Status.Conditions).To(ConsistOf(And(
+					HaveField("Type", corev1.NodeConditionType("MyUnitUnhealthy")),
+					HaveField("Status", corev1.ConditionTrue),
+					HaveField("Reason", "HealthCheckFailed"),
+					HaveField("Message", `systemd unit "my.service" not found`),
+				)))
+
+				dbus.AddUnitsToList(systemddbus.UnitStatus{Name: "my.service", ActiveState: "active"})
+				runCheck(healthChecker)
+				Expect(node.Status.Conditions).To(ConsistOf(And(
+					HaveField("Type", corev1.NodeConditionType("MyUnitUnhealthy")),
+					HaveField("Status", corev1.ConditionFalse),
+					HaveField("Reason", "HealthCheckSucceeded"),
+				)))
+			})
+		})
+	})
+
+	Describe("probes", func() {
+		var healthChecker HealthChecker
+
+		JustBeforeEach(func() {
+			check.SystemdUnit = nil
+			check.Remediation = &nodeagentconfigv1alpha1.HealthCheckRemediation{
+				Action:        nodeagentconfigv1alpha1.RemediationActionSetNodeCondition,
+				ConditionType: ptr.To("Unhealthy"),
+			}
+			check.FailureDuration = &metav1.Duration{}
+		})
+
+		expectMessage := func(message string) {
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			ExpectWithOffset(1, healthChecker.Check(ctx, node)).To(Succeed())
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			if message == "" {
+				ExpectWithOffset(1, node.Status.Conditions).To(BeEmpty())
+			} else {
+				ExpectWithOffset(1, node.Status.Conditions).To(ConsistOf(HaveField("Message", message)))
+			}
+		}
+
+		Context("path", func() {
+			It("should check that the path exists", func() {
+				check.Path = &nodeagentconfigv1alpha1.PathHealthCheck{Path: "/var/lib/foo"}
+				healthChecker = newHealthChecker()
+				expectMessage(`"/var/lib/foo" does not exist`)
+			})
+
+			It("should check that the path is a mount point", func() {
+				check.Path = &nodeagentconfigv1alpha1.PathHealthCheck{Path: "/var/lib/my dir", Mount: true}
+				healthChecker = newHealthChecker()
+				Expect(fs.MkdirAll("/var/lib/my dir", 0755)).To(Succeed())
+				Expect(fs.WriteFile("/proc/self/mountinfo", []byte("22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw\n"), 0644)).To(Succeed())
+				expectMessage(`"/var/lib/my dir" is not a mount point`)
+
+				Expect(fs.WriteFile("/proc/self/mountinfo", []byte("23 22 8:2 / /var/lib/my\\040dir rw,relatime shared:2 - ext4 /dev/sda2 rw\n"), 0644)).To(Succeed())
+				healthChecker = newHealthChecker()
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Status.Conditions).To(ConsistOf(HaveField("Status", corev1.ConditionFalse)))
+			})
+		})
+
+		Context("disk pressure", func() {
+			It("should check the free space and inodes", func() {
+				check.DiskPressure = &nodeagentconfigv1alpha1.DiskPressureHealthCheck{Path: "/var", MinFreePercent: ptr.To[int32](10), MinFreeInodesPercent: ptr.To[int32](5)}
+				healthChecker = newHealthChecker()
+
+				DeferCleanup(test.WithVar(&Statfs, func(path string, stat *syscall.Statfs_t) error {
+					Expect(path).To(Equal("/var"))
+					stat.Blocks, stat.Bavail, stat.Files, stat.Ffree = 100, 50, 100, 4
+					return nil
+				}))
+				expectMessage(`file system of "/var" has only 4.0% free inodes (minimum: 5%)`)
+			})
+		})
+
+		Context("ntp", func() {
+			It("should check that the clock is synchronized", func() {
+				check.NTP = &nodeagentconfigv1alpha1.NTPHealthCheck{MaxDrift: &metav1.Duration{Duration: time.Second}}
+				healthChecker = newHealthChecker()
+
+				DeferCleanup(test.WithVar(&ReadClockState, func() (bool, time.Duration, error) { return false, 0, nil }))
+				expectMessage("system clock is not synchronized")
+			})
+
+			It("should check the drift of the clock", func() {
+				check.NTP = &nodeagentconfigv1alpha1.NTPHealthCheck{MaxDrift: &metav1.Duration{Duration: time.Second}}
+				healthChecker = newHealthChecker()
+
+				DeferCleanup(test.WithVar(&ReadClockState, func() (bool, time.Duration, error) { return true, 2 * time.Second, nil }))
+				expectMessage("estimated drift of system clock 2s exceeds maximum 1s")
+			})
+		})
+
+		Context("script", func() {
+			It("should check the exit code of the command", func() {
+				check.Script = &nodeagentconfigv1alpha1.ScriptHealthCheck{Command: []string{"/opt/bin/check", "--foo"}, Timeout: &metav1.Duration{Duration: time.Second}}
+				healthChecker = newHealthChecker()
+
+				DeferCleanup(test.WithVar(&ExecCommandCombinedOutput, func(_ context.Context, command string, args ...string) ([]byte, error) {
+					Expect(command).To(Equal("/opt/bin/check"))
+					Expect(args).To(Equal([]string{"--foo"}))
+					return []byte("something is broken\n"), errors.New("fake")
+				}))
+				expectMessage(`command "/opt/bin/check --foo" failed: fake: something is broken`)
+			})
+		})
+	})
+})
+
+func countActions(dbus *fakedbus.DBus, action fakedbus.Action) int {
+	var count int
+	for _, a := range dbus.Actions {
+		if a.Action == action {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/afero"
+
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+// probe checks the health of a node component and returns an error if it is unhealthy.
+type probe func(ctx context.Context) error
+
+const (
+	filePathMountInfo = "/proc/self/mountinfo"
+	maxOutputLength   = 512
+)
+
+var (
+	// Statfs is an alias for syscall.Statfs. Exposed for testing.
+	Statfs = syscall.Statfs
+	// ReadClockState returns whether the system clock is synchronized and its estimated maximum error. Exposed for
+	// testing.
+	ReadClockState = readClockState
+	// ExecCommandCombinedOutput executes the given command and returns its combined output. Exposed for testing.
+	ExecCommandCombinedOutput = func(ctx context.Context, command string, args ...string) ([]byte, error) {
+		return exec.CommandContext(ctx, command, args...).CombinedOutput()
+	}
+)
+
+func systemdUnitProbe(db dbus.DBus, unitName string) probe {
+	return func(ctx context.Context) error {
+		units, err := db.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed listing systemd units: %w", err)
+		}
+
+		for _, unit := range units {
+			if unit.Name != unitName {
+				continue
+			}
+			if unit.ActiveState != "active" {
+				return fmt.Errorf("systemd unit %q is %s (%s)", unitName, unit.ActiveState, unit.SubState)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("systemd unit %q not found", unitName)
+	}
+}
+
+func pathProbe(fs afero.Afero, path string, mount bool) probe {
+	return func(_ context.Context) error {
+		if exists, err := fs.Exists(path); err != nil {
+			return fmt.Errorf("failed checking whether %q exists: %w", path, err)
+		} else if !exists {
+			return fmt.Errorf("%q does not exist", path)
+		}
+
+		if !mount {
+			return nil
+		}
+
+		mountInfo, err := fs.ReadFile(filePathMountInfo)
+		if err != nil {
+			return fmt.Errorf("failed reading %q: %w", filePathMountInfo, err)
+		}
+
+		if !isMountPoint(mountInfo, filepath.Clean(path)) {
+			return fmt.Errorf("%q is not a mount point", path)
+		}
+		return nil
+	}
+}
+
+// isMountPoint checks whether the given path is listed as mount point in the given content of a mountinfo file, see
+// https://man7.org/linux/man-pages/man5/proc_pid_mountinfo.5.html.
+func isMountPoint(mountInfo []byte, path string) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(mountInfo))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		if unescapeMountInfo(fields[4]) == path {
+			return true
+		}
+	}
+	return false
+}
+
+// unescapeMountInfo replaces the octal escape sequences used for white space characters in mountinfo files.
+func unescapeMountInfo(s string) string {
+	return strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`).Replace(s)
+}
+
+func diskPressureProbe(path string, minFreePercent, minFreeInodesPercent int32) probe {
+	return func(_ context.Context) error {
+		var stat syscall.Statfs_t
+		if err := Statfs(path, &stat); err != nil {
+			return fmt.Errorf("failed reading file system statistics of %q: %w", path, err)
+		}
+
+		if stat.Blocks > 0 {
+			if freePercent := float64(stat.Bavail) / float64(stat.Blocks) * 100; freePercent < float64(minFreePercent) {
+				return fmt.Errorf("file system of %q has only %.1f%% free space (minimum: %d%%)", path, freePercent, minFreePercent)
+			}
+		}
+
+		if stat.Files > 0 {
+			if freeInodesPercent := float64(stat.Ffree) / float64(stat.Files) * 100; freeInodesPercent < float64(minFreeInodesPercent) {
+				return fmt.Errorf("file system of %q has only %.1f%% free inodes (minimum: %d%%)", path, freeInodesPercent, minFreeInodesPercent)
+			}
+		}
+
+		return nil
+	}
+}
+
+func ntpProbe(maxDrift time.Duration) probe {
+	return func(_ context.Context) error {
+		synchronized, maxError, err := ReadClockState()
+		if err != nil {
+			return fmt.Errorf("failed reading system clock state: %w", err)
+		}
+
+		if !synchronized {
+			return fmt.Errorf("system clock is not synchronized")
+		}
+
+		if maxError > maxDrift {
+			return fmt.Errorf("estimated drift of system clock %s exceeds maximum %s", maxError, maxDrift)
+		}
+
+		return nil
+	}
+}
+
+func scriptProbe(command []string, timeout time.Duration) probe {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		output, err := ExecCommandCombinedOutput(ctx, command[0], command[1:]...)
+		if err == nil {
+			return nil
+		}
+
+		message := strings.TrimSpace(string(output))
+		if len(message) > maxOutputLength {
+			message = message[:maxOutputLength] + "..."
+		}
+
+		if exitErr := (&exec.ExitError{}); errors.As(err, &exitErr) {
+			return fmt.Errorf("command %q exited with code %d: %s", strings.Join(command, " "), exitErr.ExitCode(), message)
+		}
+		return fmt.Errorf("command %q failed: %w: %s", strings.Join(command, " "), err, message)
+	}
+}
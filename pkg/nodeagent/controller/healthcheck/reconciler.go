@@ -8,23 +8,32 @@ import (
 	"context"
 	"time"
 
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/reboot"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// Reconciler checks for containerd and kubelet health and restarts them if required.
+// Reconciler checks for containerd and kubelet health and restarts them if required. Additionally, it executes the
+// configured health checks and their remediations.
 type Reconciler struct {
 	Client                     client.Client
+	Config                     nodeagentconfigv1alpha1.HealthCheckControllerConfig
 	Recorder                   record.EventRecorder
 	DBus                       dbus.DBus
+	FS                         afero.Afero
 	HealthCheckers             []HealthChecker
 	HealthCheckIntervalSeconds int32
+	// RebootCoordinator is used for rebooting the node if a health check remediation requires it. If nil, the node is
+	// rebooted immediately.
+	RebootCoordinator *reboot.Coordinator
 }
 
 // Reconcile executes all defined health checks.
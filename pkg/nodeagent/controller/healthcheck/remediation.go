@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/reboot"
+)
+
+const (
+	// RemediationHistoryFilePath is the file path on the worker node that contains the history of executed
+	// remediations. It is persisted to the disk so that remediations like reboots are rate-limited across restarts of
+	// gardener-node-agent.
+	RemediationHistoryFilePath = nodeagentconfigv1alpha1.BaseDir + "/health-check-remediations.yaml"
+	// AnnotationKeyCordonedByHealthCheck is the key of an annotation on the Node containing the name of the health
+	// check which cordoned the node.
+	AnnotationKeyCordonedByHealthCheck = "node-agent.gardener.cloud/cordoned-by-health-check"
+
+	conditionReasonHealthCheckFailed    = "HealthCheckFailed"
+	conditionReasonHealthCheckSucceeded = "HealthCheckSucceeded"
+	maxRemediationHistoryEntries        = 100
+)
+
+type remediator struct {
+	checkName     string
+	action        nodeagentconfigv1alpha1.RemediationAction
+	unitName      string
+	conditionType corev1.NodeConditionType
+	maxAttempts   int
+	period        time.Duration
+
+	client   client.Client
+	clock    clock.Clock
+	dbus     dbus.DBus
+	recorder record.EventRecorder
+	history  *remediationHistory
+
+	rebootCoordinator *reboot.Coordinator
+}
+
+// remediate executes the remediation action for the failed health check.
+func (r *remediator) remediate(ctx context.Context, node *corev1.Node, checkErr error) error {
+	switch r.action {
+	case nodeagentconfigv1alpha1.RemediationActionSetNodeCondition:
+		return r.patchNodeCondition(ctx, node, corev1.ConditionTrue, conditionReasonHealthCheckFailed, checkErr.Error())
+
+	case nodeagentconfigv1alpha1.RemediationActionCordon:
+		if node.Spec.Unschedulable {
+			return nil
+		}
+		r.recorder.Eventf(node, corev1.EventTypeWarning, r.checkName, "Health check %q is failing, cordoning the node", r.checkName)
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = true
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyCordonedByHealthCheck, r.checkName)
+		return r.client.Patch(ctx, node, patch)
+
+	case nodeagentconfigv1alpha1.RemediationActionRestartUnit, nodeagentconfigv1alpha1.RemediationActionReboot:
+		allowed, err := r.history.allow(r.checkName, r.maxAttempts, r.period, r.clock.Now())
+		if err != nil {
+			return fmt.Errorf("failed reading remediation history: %w", err)
+		}
+		if !allowed {
+			r.recorder.Eventf(node, corev1.EventTypeWarning, r.checkName, "Health check %q is failing, but %s was already executed %d times within %s, skipping it", r.checkName, r.action, r.maxAttempts, r.period)
+			return nil
+		}
+
+		if r.action == nodeagentconfigv1alpha1.RemediationActionReboot && r.rebootCoordinator != nil {
+			return r.rebootCoordinated(ctx, node)
+		}
+
+		if err := r.history.record(r.checkName, r.clock.Now()); err != nil {
+			return fmt.Errorf("failed recording remediation: %w", err)
+		}
+
+		if r.action == nodeagentconfigv1alpha1.RemediationActionRestartUnit {
+			r.recorder.Eventf(node, corev1.EventTypeWarning, r.checkName, "Health check %q is failing, restarting unit %s", r.checkName, r.unitName)
+			return r.dbus.Restart(ctx, r.recorder, node, r.unitName)
+		}
+
+		r.recorder.Eventf(node, corev1.EventTypeWarning, r.checkName, "Health check %q is failing, rebooting the node", r.checkName)
+		return r.dbus.Reboot()
+	}
+
+	return fmt.Errorf("unsupported remediation action %q", r.action)
+}
+
+// rebootCoordinated reboots the node via the reboot coordinator. The remediation is recorded right before the reboot is
+// initiated, i.e., postponed reboots do not count towards the maximum number of attempts, and reboots which were already
+// initiated are not recorded again.
+func (r *remediator) rebootCoordinated(ctx context.Context, node *corev1.Node) error {
+	_, err := r.rebootCoordinator.Reboot(ctx, logf.FromContext(ctx), node, fmt.Sprintf("health check %q is failing", r.checkName), func() error {
+		if err := r.history.record(r.checkName, r.clock.Now()); err != nil {
+			return fmt.Errorf("failed recording remediation: %w", err)
+		}
+		return nil
+	})
+	return err
+}
+
+// resolve reverts the effects of state-changing remediation actions after the health check succeeds again.
+func (r *remediator) resolve(ctx context.Context, node *corev1.Node) error {
+	switch r.action {
+	case nodeagentconfigv1alpha1.RemediationActionSetNodeCondition:
+		return r.patchNodeCondition(ctx, node, corev1.ConditionFalse, conditionReasonHealthCheckSucceeded, fmt.Sprintf("Health check %q succeeded", r.checkName))
+
+	case nodeagentconfigv1alpha1.RemediationActionCordon:
+		if node.Annotations[AnnotationKeyCordonedByHealthCheck] != r.checkName {
+			return nil
+		}
+		r.recorder.Eventf(node, corev1.EventTypeNormal, r.checkName, "Health check %q succeeded again, uncordoning the node", r.checkName)
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = false
+		delete(node.Annotations, AnnotationKeyCordonedByHealthCheck)
+		return r.client.Patch(ctx, node, patch)
+	}
+
+	return nil
+}
+
+func (r *remediator) patchNodeCondition(ctx context.Context, node *corev1.Node, status corev1.ConditionStatus, reason, message string) error {
+	if status == corev1.ConditionFalse && !nodeagent.HasNodeCondition(node, r.conditionType) {
+		// Do not add a condition to the node if the health check has never failed.
+		return nil
+	}
+	return nodeagent.PatchNodeCondition(ctx, r.client, r.clock.Now(), node, r.conditionType, status, reason, message)
+}
+
+// remediationHistory keeps track of the executed remediations per health check and persists them to the disk.
+type remediationHistory struct {
+	fs   afero.Afero
+	lock sync.Mutex
+}
+
+func (h *remediationHistory) allow(checkName string, maxAttempts int, period time.Duration, now time.Time) (bool, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	history, err := h.read()
+	if err != nil {
+		return false, err
+	}
+
+	var attempts int
+	for _, t := range history[checkName] {
+		if now.Sub(t) < period {
+			attempts++
+		}
+	}
+
+	return attempts < maxAttempts, nil
+}
+
+func (h *remediationHistory) record(checkName string, now time.Time) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	history, err := h.read()
+	if err != nil {
+		return err
+	}
+
+	history[checkName] = append(history[checkName], now)
+	// keep only the most recent entries to prevent the file from growing indefinitely
+	if len(history[checkName]) > maxRemediationHistoryEntries {
+		history[checkName] = history[checkName][len(history[checkName])-maxRemediationHistoryEntries:]
+	}
+
+	content, err := yaml.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	return h.fs.WriteFile(RemediationHistoryFilePath, content, 0600)
+}
+
+func (h *remediationHistory) read() (map[string][]time.Time, error) {
+	history := map[string][]time.Time{}
+
+	content, err := h.fs.ReadFile(RemediationHistoryFilePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return history, nil
+		}
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(content, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
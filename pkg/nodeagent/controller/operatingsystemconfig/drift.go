@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	// EventReasonOSCDriftDetected is the reason of the event which is recorded when files or units on the node have
+	// drifted from the last applied operating system config.
+	EventReasonOSCDriftDetected = "OSCDriftDetected"
+	// EventReasonOSCDriftReapplied is the reason of the event which is recorded when drifted files or units have been
+	// re-applied.
+	EventReasonOSCDriftReapplied = "OSCDriftReapplied"
+
+	conditionReasonNoDrift        = "NoDriftDetected"
+	conditionReasonDriftDetected  = "DriftDetected"
+	conditionReasonDriftReapplied = "DriftReapplied"
+
+	unitFileStateEnabled         = "enabled"
+	unitFileStateEnabledRuntime  = "enabled-runtime"
+	unitFileStateDisabled        = "disabled"
+	unitFileStateMasked          = "masked"
+	unitFileStateMaskedRuntime   = "masked-runtime"
+	maxDriftEntriesInDescription = 10
+)
+
+type driftedFile struct {
+	file   extensionsv1alpha1.File
+	reason string
+}
+
+type driftedUnit struct {
+	unit   extensionsv1alpha1.Unit
+	reason string
+}
+
+type operatingSystemConfigDrift struct {
+	files []driftedFile
+	units []driftedUnit
+}
+
+func (d *operatingSystemConfigDrift) empty() bool {
+	return len(d.files) == 0 && len(d.units) == 0
+}
+
+func (d *operatingSystemConfigDrift) String() string {
+	var entries []string
+	for _, f := range d.files {
+		entries = append(entries, fmt.Sprintf("file %s: %s", f.file.Path, f.reason))
+	}
+	for _, u := range d.units {
+		entries = append(entries, fmt.Sprintf("unit %s: %s", u.unit.Name, u.reason))
+	}
+
+	if len(entries) > maxDriftEntriesInDescription {
+		entries = append(entries[:maxDriftEntriesInDescription], fmt.Sprintf("and %d more", len(entries)-maxDriftEntriesInDescription))
+	}
+
+	return strings.Join(entries, "; ")
+}
+
+// detectDrift compares the files and units on the node with the given operating system config. Files are compared by
+// the checksums of their contents (files from images are only checked for existence), units by the contents of their
+// unit and drop-in files and by their enablement state.
+func (r *Reconciler) detectDrift(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) (*operatingSystemConfigDrift, error) {
+	drift := &operatingSystemConfigDrift{}
+
+	for _, file := range collectAllFiles(osc) {
+		reason, err := r.detectFileDrift(file)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			drift.files = append(drift.files, driftedFile{file: file, reason: reason})
+		}
+	}
+
+	units := mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits)
+
+	unitNames := make([]string, 0, len(units))
+	for _, unit := range units {
+		unitNames = append(unitNames, unit.Name)
+	}
+
+	unitFileStates := make(map[string]string, len(units))
+	if len(unitNames) > 0 {
+		unitFiles, err := r.DBus.ListUnitFiles(ctx, unitNames...)
+		if err != nil {
+			return nil, fmt.Errorf("failed listing unit files: %w", err)
+		}
+		for _, unitFile := range unitFiles {
+			unitFileStates[path.Base(unitFile.Path)] = unitFile.Type
+		}
+	}
+
+	for _, unit := range units {
+		reason, err := r.detectUnitDrift(unit, unitFileStates[unit.Name])
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			drift.units = append(drift.units, driftedUnit{unit: unit, reason: reason})
+		}
+	}
+
+	return drift, nil
+}
+
+func (r *Reconciler) detectFileDrift(file extensionsv1alpha1.File) (string, error) {
+	if file.Content.Inline == nil {
+		exists, err := r.FS.Exists(file.Path)
+		if err != nil {
+			return "", fmt.Errorf("unable to check whether file %q exists: %w", file.Path, err)
+		}
+		if !exists {
+			return "missing", nil
+		}
+		return "", nil
+	}
+
+	desired, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+	if err != nil {
+		return "", fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
+	}
+
+	return r.detectContentDrift(file.Path, desired)
+}
+
+func (r *Reconciler) detectUnitDrift(unit extensionsv1alpha1.Unit, unitFileState string) (string, error) {
+	unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+
+	if unit.Content != nil {
+		reason, err := r.detectContentDrift(unitFilePath, []byte(*unit.Content))
+		if err != nil || reason != "" {
+			return "unit file " + reason, err
+		}
+	}
+
+	for _, dropIn := range unit.DropIns {
+		reason, err := r.detectContentDrift(path.Join(unitFilePath+".d", dropIn.Name), []byte(dropIn.Content))
+		if err != nil || reason != "" {
+			return fmt.Sprintf("drop-in %s %s", dropIn.Name, reason), err
+		}
+	}
+
+	// Only the explicit states are considered as drift, other states (e.g., 'static' for units without install
+	// section) cannot be changed by enabling or disabling the unit.
+	if unit.Name == nodeagentconfigv1alpha1.UnitName || ptr.Deref(unit.Enable, true) {
+		if slices.Contains([]string{unitFileStateDisabled, unitFileStateMasked, unitFileStateMaskedRuntime}, unitFileState) {
+			return "expected to be enabled but is " + unitFileState, nil
+		}
+	} else if slices.Contains([]string{unitFileStateEnabled, unitFileStateEnabledRuntime}, unitFileState) {
+		return "expected to be disabled but is " + unitFileState, nil
+	}
+
+	return "", nil
+}
+
+func (r *Reconciler) detectContentDrift(filePath string, desired []byte) (string, error) {
+	current, err := r.FS.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return "missing", nil
+		}
+		return "", fmt.Errorf("unable to read file %q: %w", filePath, err)
+	}
+
+	if sha256.Sum256(current) != sha256.Sum256(desired) {
+		return "checksum mismatch", nil
+	}
+
+	return "", nil
+}
+
+// reconcileDrift detects drift of the files and units on the node from the given (already applied) operating system
+// config, reports it via the Node condition and events, and re-applies the drifted files and units if configured.
+func (r *Reconciler) reconcileDrift(ctx context.Context, log logr.Logger, node *corev1.Node, osc *extensionsv1alpha1.OperatingSystemConfig, oscChecksum string) (reconcile.Result, error) {
+	result := reconcile.Result{RequeueAfter: r.Config.DriftDetection.SyncPeriod.Duration}
+
+	log.V(1).Info("Detecting drift of files and units from applied operating system config")
+	drift, err := r.detectDrift(ctx, osc)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed detecting drift: %w", err)
+	}
+
+	if drift.empty() {
+		return result, nodeagent.PatchNodeCondition(ctx, r.Client, r.Clock.Now(), node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift, corev1.ConditionFalse, conditionReasonNoDrift, "Files and units on the node match the applied operating system config.")
+	}
+
+	log.Info("Detected drift of files and units from applied operating system config", "driftedFiles", len(drift.files), "driftedUnits", len(drift.units))
+	r.Recorder.Eventf(node, corev1.EventTypeWarning, EventReasonOSCDriftDetected, "Files and units on the node have drifted from the applied operating system config: %s", drift.String())
+
+	if !r.Config.DriftDetection.Reapply {
+		return result, nodeagent.PatchNodeCondition(ctx, r.Client, r.Clock.Now(), node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift, corev1.ConditionTrue, conditionReasonDriftDetected, "Files and units on the node have drifted from the applied operating system config: "+drift.String())
+	}
+
+	oscChanges := drift.toOperatingSystemConfigChanges(r.FS, oscChecksum, mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits))
+
+	log.Info("Re-applying drifted files and units")
+	if err := r.applyChangedInlineFiles(log, oscChanges); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed re-applying drifted inline files: %w", err)
+	}
+	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed re-applying drifted imageRef files: %w", err)
+	}
+	if err := r.applyChangedUnits(ctx, log, oscChanges); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed re-applying drifted units: %w", err)
+	}
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+	if err := r.executeUnitCommands(ctx, log, node, oscChanges); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed executing unit commands for drifted units: %w", err)
+	}
+
+	r.Recorder.Eventf(node, corev1.EventTypeNormal, EventReasonOSCDriftReapplied, "Drifted files and units have been re-applied: %s", drift.String())
+	if err := nodeagent.PatchNodeCondition(ctx, r.Client, r.Clock.Now(), node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift, corev1.ConditionFalse, conditionReasonDriftReapplied, "Drifted files and units have been re-applied: "+drift.String()); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if oscChanges.MustRestartNodeAgent {
+		return r.restartNodeAgent(oscChanges, log)
+	}
+
+	return result, nil
+}
+
+// toOperatingSystemConfigChanges converts the drift to operatingSystemConfigChanges which are not persisted to the
+// disk. Units are restarted (or stopped) if their unit or drop-in files, their enablement state, or any of their
+// referenced files have drifted.
+func (d *operatingSystemConfigDrift) toOperatingSystemConfigChanges(fs afero.Afero, oscChecksum string, units []extensionsv1alpha1.Unit) *operatingSystemConfigChanges {
+	changes := &operatingSystemConfigChanges{
+		fs:                            fs,
+		skipPersist:                   true,
+		OperatingSystemConfigChecksum: oscChecksum,
+	}
+
+	unitNamesWithCommand := sets.New[string]()
+	addCommand := func(unit extensionsv1alpha1.Unit) {
+		if unitNamesWithCommand.Has(unit.Name) {
+			return
+		}
+		unitNamesWithCommand.Insert(unit.Name)
+		changes.Units.Commands = append(changes.Units.Commands, unitCommand{
+			Name:    unit.Name,
+			Command: getCommandToExecute(unit),
+		})
+	}
+
+	for _, u := range d.units {
+		changes.Units.Changed = append(changes.Units.Changed, changedUnit{
+			Unit:           u.unit,
+			DropInsChanges: dropIns{Changed: u.unit.DropIns},
+		})
+		addCommand(u.unit)
+	}
+
+	for _, f := range d.files {
+		changes.Files.Changed = append(changes.Files.Changed, f.file)
+
+		for _, unit := range units {
+			if slices.Contains(unit.FilePaths, f.file.Path) {
+				addCommand(unit)
+			}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Drift", func() {
+	var (
+		ctx        context.Context
+		log        logr.Logger
+		fs         afero.Afero
+		fakeDBus   *fakedbus.DBus
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		node       *corev1.Node
+		osc        *extensionsv1alpha1.OperatingSystemConfig
+
+		syncPeriod = 5 * time.Minute
+
+		filePath        = "/etc/foo/config"
+		fileContent     = "foo-config"
+		unitName        = "foo.service"
+		unitContent     = "[Unit]\nDescription=foo"
+		dropInName      = "10-bar.conf"
+		dropInContent   = "[Service]\nEnvironment=BAR=baz"
+		unitFilePath    = "/etc/systemd/system/" + unitName
+		dropInFilePath  = unitFilePath + ".d/" + dropInName
+		defaultUnitName = "default.service"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log = logr.Discard()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		recorder = record.NewFakeRecorder(10)
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.ShootScheme).
+			WithObjects(node).
+			WithStatusSubresource(&corev1.Node{}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			FS:       fs,
+			DBus:     fakeDBus,
+			Recorder: recorder,
+			Clock:    fakeClock,
+			Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+				DriftDetection: &nodeagentconfigv1alpha1.DriftDetectionConfig{
+					SyncPeriod: &metav1.Duration{Duration: syncPeriod},
+				},
+			},
+		}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Files: []extensionsv1alpha1.File{{
+					Path:    filePath,
+					Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: fileContent}},
+				}},
+				Units: []extensionsv1alpha1.Unit{
+					{
+						Name:      unitName,
+						Content:   ptr.To(unitContent),
+						DropIns:   []extensionsv1alpha1.DropIn{{Name: dropInName, Content: dropInContent}},
+						FilePaths: []string{filePath},
+					},
+					{
+						Name:   defaultUnitName,
+						Enable: ptr.To(false),
+					},
+				},
+			},
+		}
+
+		Expect(fs.WriteFile(filePath, []byte(fileContent), 0600)).To(Succeed())
+		Expect(fs.WriteFile(unitFilePath, []byte(unitContent), 0600)).To(Succeed())
+		Expect(fs.WriteFile(dropInFilePath, []byte(dropInContent), 0600)).To(Succeed())
+		fakeDBus.AddUnitFilesToList(
+			systemddbus.UnitFile{Path: unitFilePath, Type: "enabled"},
+			systemddbus.UnitFile{Path: "/usr/lib/systemd/system/" + defaultUnitName, Type: "disabled"},
+		)
+	})
+
+	driftCondition := func() *corev1.NodeCondition {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigDrift {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	It("should report no drift if files and units match the operating system config", func() {
+		Expect(reconciler.reconcileDrift(ctx, log, node, osc, "checksum")).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		condition := driftCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("NoDriftDetected"))
+		Expect(recorder.Events).To(BeEmpty())
+		Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionListUnitFiles, UnitNames: []string{unitName, defaultUnitName}}))
+	})
+
+	It("should only report drift if re-applying is disabled", func() {
+		Expect(fs.WriteFile(filePath, []byte("modified"), 0600)).To(Succeed())
+		Expect(fs.Remove(dropInFilePath)).To(Succeed())
+
+		Expect(reconciler.reconcileDrift(ctx, log, node, osc, "checksum")).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		condition := driftCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("DriftDetected"))
+		Expect(condition.Message).To(And(
+			ContainSubstring("file "+filePath+": checksum mismatch"),
+			ContainSubstring("unit "+unitName+": drop-in "+dropInName+" missing"),
+		))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonOSCDriftDetected)))
+
+		Expect(fs.ReadFile(filePath)).To(BeEquivalentTo("modified"))
+		Expect(fs.Exists(dropInFilePath)).To(BeFalse())
+		Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionListUnitFiles, UnitNames: []string{unitName, defaultUnitName}}))
+	})
+
+	It("should detect changed enablement states of units", func() {
+		fakeDBus = fakedbus.New()
+		fakeDBus.AddUnitFilesToList(
+			systemddbus.UnitFile{Path: unitFilePath, Type: "disabled"},
+			systemddbus.UnitFile{Path: "/usr/lib/systemd/system/" + defaultUnitName, Type: "enabled"},
+		)
+		reconciler.DBus = fakeDBus
+
+		Expect(reconciler.reconcileDrift(ctx, log, node, osc, "checksum")).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(driftCondition().Message).To(And(
+			ContainSubstring("unit "+unitName+": expected to be enabled but is disabled"),
+			ContainSubstring("unit "+defaultUnitName+": expected to be disabled but is enabled"),
+		))
+	})
+
+	It("should re-apply drifted files and units", func() {
+		reconciler.Config.DriftDetection.Reapply = true
+
+		Expect(fs.WriteFile(filePath, []byte("modified"), 0600)).To(Succeed())
+		Expect(fs.Remove(unitFilePath)).To(Succeed())
+
+		Expect(reconciler.reconcileDrift(ctx, log, node, osc, "checksum")).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fs.ReadFile(filePath)).To(BeEquivalentTo(fileContent))
+		Expect(fs.ReadFile(unitFilePath)).To(BeEquivalentTo(unitContent))
+		Expect(fs.ReadFile(dropInFilePath)).To(BeEquivalentTo(dropInContent))
+
+		Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+			{Action: fakedbus.ActionListUnitFiles, UnitNames: []string{unitName, defaultUnitName}},
+			{Action: fakedbus.ActionEnable, UnitNames: []string{unitName}},
+			{Action: fakedbus.ActionDaemonReload},
+			{Action: fakedbus.ActionRestart, UnitNames: []string{unitName}},
+		}))
+
+		condition := driftCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("DriftReapplied"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonOSCDriftDetected)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonOSCDriftReapplied)))
+	})
+
+	It("should restart units whose files have drifted", func() {
+		reconciler.Config.DriftDetection.Reapply = true
+
+		Expect(fs.WriteFile(filePath, []byte("modified"), 0600)).To(Succeed())
+
+		Expect(reconciler.reconcileDrift(ctx, log, node, osc, "checksum")).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fs.ReadFile(filePath)).To(BeEquivalentTo(fileContent))
+		Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+			{Action: fakedbus.ActionListUnitFiles, UnitNames: []string{unitName, defaultUnitName}},
+			{Action: fakedbus.ActionDaemonReload},
+			{Action: fakedbus.ActionRestart, UnitNames: []string{unitName}},
+		}))
+	})
+
+	It("should not patch the node condition again if nothing changed", func() {
+		Expect(reconciler.reconcileDrift(ctx, log, node, osc, "checksum")).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		heartbeatTime := driftCondition().LastHeartbeatTime
+
+		fakeClock.Step(time.Hour)
+		Expect(reconciler.reconcileDrift(ctx, log, node, osc, "checksum")).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(driftCondition().LastHeartbeatTime).To(Equal(heartbeatTime))
+	})
+})
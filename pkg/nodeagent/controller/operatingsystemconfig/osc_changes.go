@@ -98,7 +98,7 @@ func (o *operatingSystemConfigChanges) persist() error {
 	if err != nil {
 		return fmt.Errorf("failed marshalling the changes into YAML: %w", err)
 	}
-	return o.fs.WriteFile(lastComputedOperatingSystemConfigChangesFilePath, out, 0600)
+	return o.fs.WriteFile(LastComputedOperatingSystemConfigChangesFilePath, out, 0600)
 }
 
 func (o *operatingSystemConfigChanges) setMustRestartNodeAgent(restart bool) error {
@@ -291,9 +291,9 @@ func (o *operatingSystemConfigChanges) completedContainerdRegistriesDeleted(upst
 }
 
 func loadOSCChanges(fs afero.Afero) (*operatingSystemConfigChanges, error) {
-	raw, err := fs.ReadFile(lastComputedOperatingSystemConfigChangesFilePath)
+	raw, err := fs.ReadFile(LastComputedOperatingSystemConfigChangesFilePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed reading changes file %s: %w", lastComputedOperatingSystemConfigChangesFilePath, err)
+		return nil, fmt.Errorf("failed reading changes file %s: %w", LastComputedOperatingSystemConfigChangesFilePath, err)
 	}
 
 	changes := operatingSystemConfigChanges{}
@@ -34,6 +34,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -52,6 +53,7 @@ import (
 	healthcheckcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	filespkg "github.com/gardener/gardener/pkg/nodeagent/files"
+	"github.com/gardener/gardener/pkg/nodeagent/reboot"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -60,8 +62,12 @@ import (
 )
 
 const (
-	lastAppliedOperatingSystemConfigFilePath         = nodeagentconfigv1alpha1.BaseDir + "/last-applied-osc.yaml"
-	lastComputedOperatingSystemConfigChangesFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-computed-osc-changes.yaml"
+	// LastAppliedOperatingSystemConfigFilePath is the file path on the worker node that contains the last applied
+	// operating system config.
+	LastAppliedOperatingSystemConfigFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-applied-osc.yaml"
+	// LastComputedOperatingSystemConfigChangesFilePath is the file path on the worker node that contains the last
+	// computed operating system config changes.
+	LastComputedOperatingSystemConfigChangesFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-computed-osc-changes.yaml"
 
 	annotationUpdatingOperatingSystemVersion = "node-agent.gardener.cloud/updating-operating-system-version"
 	pathKubeletCPUManagerPolicyState         = kubeletcomponent.PathKubeletDirectory + "/cpu_manager_state"
@@ -100,6 +106,7 @@ type Reconciler struct {
 	Config        nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig
 	ConfigDir     string
 	Recorder      record.EventRecorder
+	Clock         clock.Clock
 	DBus          dbus.DBus
 	FS            afero.Afero
 	Extractor     registry.Extractor
@@ -116,11 +123,14 @@ type Reconciler struct {
 	// Channel and TokenSecretSyncConfigs are used by the reconciler to trigger events for the token reconciler during an in-place service-account-key rotation.
 	Channel                chan event.TypedGenericEvent[*corev1.Secret]
 	TokenSecretSyncConfigs []nodeagentconfigv1alpha1.TokenSecretSyncConfig
+	// RebootCoordinator is used for rebooting the node after an in-place OS update. If nil, the OS update command is
+	// expected to take care of the reboot.
+	RebootCoordinator *reboot.Coordinator
 }
 
 // Reconcile decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
 // node.
-func (r *Reconciler) Reconcile(reconcileCtx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, request reconcile.Request) (_ reconcile.Result, reconcileErr error) {
 	log := logf.FromContext(reconcileCtx)
 
 	ctx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, controllerutils.DefaultReconciliationTimeout)
@@ -151,6 +161,17 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, request reconcile.R
 		return reconcile.Result{}, fmt.Errorf("failed extracting OSC from secret: %w", err)
 	}
 
+	var (
+		inPlaceUpdate bool
+		status        applyStatus
+	)
+
+	if node != nil {
+		defer func() {
+			r.reportApplyStatus(reconcileCtx, log, node, oscChecksum, inPlaceUpdate, status, reconcileErr)
+		}()
+	}
+
 	log.Info("Applying containerd configuration")
 	if err := r.ReconcileContainerdConfig(ctx, log, osc); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed reconciling containerd configuration: %w", err)
@@ -168,6 +189,10 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, request reconcile.R
 	}
 
 	if node != nil && node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
+		if r.Config.DriftDetection != nil {
+			return r.reconcileDrift(ctx, log, node, osc, oscChecksum)
+		}
+
 		log.Info("Configuration on this node is up to date, nothing to be done")
 		return reconcile.Result{}, nil
 	}
@@ -184,6 +209,8 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, request reconcile.R
 	}
 
 	if isInPlaceUpdate(oscChanges) {
+		inPlaceUpdate = true
+
 		// In case of in-place update, we use retries for certain cases like OS update with higher timeouts,
 		// so we need to overwrite the context to use a longer timeout.
 		ctx, cancel = context.WithTimeout(reconcileCtx, 10*time.Minute)
@@ -192,12 +219,14 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, request reconcile.R
 		if !nodeHasInPlaceUpdateConditionWithReasonReadyForUpdate(node.Status.Conditions) {
 			if node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] != machinev1alpha1.LabelValueNodeUpdateFailed {
 				log.Info("Node is not ready for in-place update, will be requeued when the node has the ready-for-update condition", "node", node.Name)
+				status.set(nodeagentconfigv1alpha1.ConditionReasonInPlaceUpdatePending, fmt.Sprintf("Operating system config with checksum %s requires an in-place update, waiting for the node to become ready for the update.", oscChecksum))
 				return reconcile.Result{}, nil
 			}
 
 			log.Info("Node has label update-result with failed value, will continue retrying the update")
 		}
 
+		status.set(nodeagentconfigv1alpha1.ConditionReasonInPlaceUpdateInProgress, fmt.Sprintf("In-place update to operating system config with checksum %s is in progress.", oscChecksum))
 		log.Info("In-place update is in progress", "osUpdate", oscChanges.InPlaceUpdates.OperatingSystem,
 			"kubeletMinorVersionUpdate", oscChanges.InPlaceUpdates.Kubelet.MinorVersion,
 			"kubeletConfigUpdate", oscChanges.InPlaceUpdates.Kubelet.Config || oscChanges.InPlaceUpdates.Kubelet.CPUManagerPolicy,
@@ -267,9 +296,16 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, request reconcile.R
 	}
 
 	if err := r.performInPlaceUpdate(ctx, log, osc, oscChanges, node, osVersion); err != nil {
+		var postponedErr *rebootPostponedError
+		if errors.As(err, &postponedErr) {
+			status.set(nodeagentconfigv1alpha1.ConditionReasonInPlaceUpdateInProgress, fmt.Sprintf("In-place update to operating system config with checksum %s is in progress, %s.", oscChecksum, postponedErr.Error()))
+			return reconcile.Result{RequeueAfter: postponedErr.retryAfter}, nil
+		}
+
 		// If the error is retriable, we requeue with a delay.
 		if retriableErrorPatternRegex.MatchString(err.Error()) {
 			log.Error(err, "Update failed with retriable error, Requeuing with a delay")
+			status.set(nodeagentconfigv1alpha1.ConditionReasonInPlaceUpdateInProgress, fmt.Sprintf("In-place update to operating system config with checksum %s failed with retriable error, retrying: %s", oscChecksum, err.Error()))
 			return reconcile.Result{RequeueAfter: 10 * time.Minute}, nil
 		}
 		return reconcile.Result{}, err
@@ -278,14 +314,14 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, request reconcile.R
 	log.Info("Successfully applied operating system config")
 
 	if !r.SkipWritingStateFiles {
-		log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath)
+		log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", LastAppliedOperatingSystemConfigFilePath)
 		oscRaw, err := runtime.Encode(codec, osc)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("unable to encode OSC: %w", err)
 		}
 
-		if err := r.FS.WriteFile(lastAppliedOperatingSystemConfigFilePath, oscRaw, 0600); err != nil {
-			return reconcile.Result{}, fmt.Errorf("unable to write current OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
+		if err := r.FS.WriteFile(LastAppliedOperatingSystemConfigFilePath, oscRaw, 0600); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to write current OSC to file path %q: %w", LastAppliedOperatingSystemConfigFilePath, err)
 		}
 	}
 
@@ -735,7 +771,17 @@ func (r *Reconciler) performInPlaceUpdate(ctx context.Context, log logr.Logger,
 		if osVersionUpToDate, err := IsOsVersionUpToDate(currentOSVersion, osc); err != nil {
 			return err
 		} else if !osVersionUpToDate {
-			return reconcile.TerminalError(fmt.Errorf("stopping reconciliation until gardener-node-agent is restarted after the OS update. Current version: %q, Desired version: %q", *currentOSVersion, osc.Spec.InPlaceUpdates.OperatingSystemVersion))
+			if r.RebootCoordinator != nil {
+				retryAfter, err := r.RebootCoordinator.Reboot(ctx, log, node, fmt.Sprintf("operating system update to version %s", osc.Spec.InPlaceUpdates.OperatingSystemVersion), nil)
+				if err != nil {
+					return fmt.Errorf("failed rebooting node after OS update: %w", err)
+				}
+				if retryAfter > 0 {
+					return &rebootPostponedError{retryAfter: retryAfter}
+				}
+			}
+
+			return reconcile.TerminalError(fmt.Errorf("%w. Current version: %q, Desired version: %q", errWaitingForRestartAfterOSUpdate, *currentOSVersion, osc.Spec.InPlaceUpdates.OperatingSystemVersion))
 		}
 	}
 
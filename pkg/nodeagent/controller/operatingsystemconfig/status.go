@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// errWaitingForRestartAfterOSUpdate is returned when the OS update command succeeded, but the node was not yet
+// rebooted into the new OS version.
+var errWaitingForRestartAfterOSUpdate = errors.New("stopping reconciliation until gardener-node-agent is restarted after the OS update")
+
+// rebootPostponedError is returned when the node must be rebooted after the OS update, but the coordinated reboot
+// cannot be executed yet.
+type rebootPostponedError struct {
+	retryAfter time.Duration
+}
+
+func (e *rebootPostponedError) Error() string {
+	return fmt.Sprintf("waiting for the coordinated reboot after the OS update, retrying in %s", e.retryAfter.Round(time.Second))
+}
+
+// applyStatus tracks the progress of a reconciliation which does not (yet) result in a successfully applied
+// operating system config, e.g., while in-place updates are pending or in progress.
+type applyStatus struct {
+	reason  string
+	message string
+}
+
+func (s *applyStatus) set(reason, message string) {
+	s.reason = reason
+	s.message = message
+}
+
+// reportApplyStatus reports the result of applying the operating system config with the given checksum via the
+// OperatingSystemConfigApplied condition on the node. The checksum the condition refers to is recorded in an annotation
+// so that readers can distinguish the status of the current operating system config from stale reports. Failures to
+// update the condition are only logged so that they do not mask the actual reconciliation result.
+func (r *Reconciler) reportApplyStatus(ctx context.Context, log logr.Logger, node *corev1.Node, oscChecksum string, inPlaceUpdate bool, status applyStatus, reconcileErr error) {
+	var (
+		conditionStatus = corev1.ConditionFalse
+		reason          string
+		message         string
+	)
+
+	switch {
+	case errors.Is(reconcileErr, errWaitingForRestartAfterOSUpdate):
+		reason, message = nodeagentconfigv1alpha1.ConditionReasonInPlaceUpdateInProgress, reconcileErr.Error()
+	case reconcileErr != nil:
+		reason = nodeagentconfigv1alpha1.ConditionReasonOperatingSystemConfigApplyFailed
+		if inPlaceUpdate {
+			reason = nodeagentconfigv1alpha1.ConditionReasonInPlaceUpdateFailed
+		}
+		message = fmt.Sprintf("Failed applying operating system config with checksum %s: %s", oscChecksum, reconcileErr.Error())
+	case node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum:
+		conditionStatus = corev1.ConditionTrue
+		reason = nodeagentconfigv1alpha1.ConditionReasonOperatingSystemConfigApplied
+		message = fmt.Sprintf("Operating system config with checksum %s has been applied successfully.", oscChecksum)
+	case status.reason != "":
+		reason, message = status.reason, status.message
+	default:
+		return
+	}
+
+	if err := nodeagent.PatchNodeCondition(ctx, r.Client, r.Clock.Now(), node, nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigApplied, conditionStatus, reason, message); err != nil {
+		log.Error(err, "Failed reporting operating system config apply status")
+		return
+	}
+
+	// The annotation is updated after the condition, so that a condition of a previous operating system config is never
+	// attributed to the current one.
+	if node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumReportedOperatingSystemConfig] != oscChecksum {
+		patch := client.MergeFrom(node.DeepCopy())
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumReportedOperatingSystemConfig, oscChecksum)
+		if err := r.Client.Patch(ctx, node, patch); err != nil {
+			log.Error(err, "Failed reporting checksum of operating system config apply status")
+		}
+	}
+}
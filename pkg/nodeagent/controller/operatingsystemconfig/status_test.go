@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+var _ = Describe("Status", func() {
+	var (
+		ctx        context.Context
+		log        logr.Logger
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		node       *corev1.Node
+
+		oscChecksum = "abc123"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log = logr.Discard()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.ShootScheme).
+			WithObjects(node).
+			WithStatusSubresource(&corev1.Node{}).
+			Build()
+
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock}
+	})
+
+	appliedCondition := func() *corev1.NodeCondition {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == nodeagentconfigv1alpha1.NodeConditionTypeOperatingSystemConfigApplied {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	Describe("#reportApplyStatus", func() {
+		It("should report a successfully applied operating system config", func() {
+			node.Annotations = map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: oscChecksum}
+
+			reconciler.reportApplyStatus(ctx, log, node, oscChecksum, false, applyStatus{}, nil)
+
+			Expect(appliedCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(corev1.ConditionTrue),
+				"Reason":  Equal("Applied"),
+				"Message": ContainSubstring(oscChecksum),
+			})))
+		})
+
+		It("should report a failure", func() {
+			reconciler.reportApplyStatus(ctx, log, node, oscChecksum, false, applyStatus{}, errors.New(`unable to restart unit "foo.service"`))
+
+			Expect(appliedCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(corev1.ConditionFalse),
+				"Reason":  Equal("ApplyFailed"),
+				"Message": Equal(`Failed applying operating system config with checksum abc123: unable to restart unit "foo.service"`),
+			})))
+			Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyChecksumReportedOperatingSystemConfig, oscChecksum))
+		})
+
+		It("should report a failed in-place update", func() {
+			reconciler.reportApplyStatus(ctx, log, node, oscChecksum, true, applyStatus{}, errors.New("OS update failed"))
+
+			Expect(appliedCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionFalse),
+				"Reason": Equal("InPlaceUpdateFailed"),
+			})))
+		})
+
+		It("should report an in-progress in-place update when waiting for the restart after the OS update", func() {
+			err := reconcile.TerminalError(fmt.Errorf("%w. Current version: %q, Desired version: %q", errWaitingForRestartAfterOSUpdate, "1.0.0", "1.1.0"))
+
+			reconciler.reportApplyStatus(ctx, log, node, oscChecksum, true, applyStatus{}, err)
+
+			Expect(appliedCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(corev1.ConditionFalse),
+				"Reason":  Equal("InPlaceUpdateInProgress"),
+				"Message": ContainSubstring("restarted after the OS update"),
+			})))
+		})
+
+		It("should report the tracked status", func() {
+			reconciler.reportApplyStatus(ctx, log, node, oscChecksum, true, applyStatus{reason: "InPlaceUpdatePending", message: "waiting"}, nil)
+
+			Expect(appliedCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(corev1.ConditionFalse),
+				"Reason":  Equal("InPlaceUpdatePending"),
+				"Message": Equal("waiting"),
+			})))
+		})
+
+		It("should not report anything if there is nothing to report", func() {
+			reconciler.reportApplyStatus(ctx, log, node, oscChecksum, false, applyStatus{}, nil)
+
+			Expect(appliedCondition()).To(BeNil())
+			Expect(node.Annotations).NotTo(HaveKey(nodeagentconfigv1alpha1.AnnotationKeyChecksumReportedOperatingSystemConfig))
+		})
+
+		It("should keep the transition time if only the message changes", func() {
+			reconciler.reportApplyStatus(ctx, log, node, oscChecksum, false, applyStatus{}, errors.New("first"))
+			transitionTime := appliedCondition().LastTransitionTime
+
+			fakeClock.Step(time.Minute)
+			reconciler.reportApplyStatus(ctx, log, node, oscChecksum, false, applyStatus{}, errors.New("second"))
+
+			condition := appliedCondition()
+			Expect(condition.Message).To(ContainSubstring("second"))
+			Expect(condition.LastTransitionTime).To(Equal(transitionTime))
+			Expect(condition.LastHeartbeatTime.Time).To(BeTemporally(">", transitionTime.Time))
+		})
+	})
+})
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reboot_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/reboot"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#RebootInitiatedPredicate", func() {
+		var (
+			p    predicate.Predicate
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = RebootInitiatedPredicate()
+			node = &corev1.Node{}
+		})
+
+		It("should return false because no reboot was initiated", func() {
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return true because a reboot was initiated", func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node-agent.gardener.cloud/reboot-boot-id", "foo")
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeTrue())
+		})
+	})
+})
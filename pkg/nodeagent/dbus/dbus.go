@@ -34,6 +34,9 @@ type DBus interface {
 	Restart(ctx context.Context, recorder record.EventRecorder, node runtime.Object, unitName string) error
 	// List lists all units and returns the output.
 	List(ctx context.Context) ([]dbus.UnitStatus, error)
+	// ListUnitFiles lists the unit files of the given units together with their enablement state, same as executing
+	// "systemctl list-unit-files unit...".
+	ListUnitFiles(ctx context.Context, unitNames ...string) ([]dbus.UnitFile, error)
 	// Reboot this machines, is the same as executing "systemctl reboot".
 	Reboot() error
 }
@@ -119,6 +122,16 @@ func (d *db) List(ctx context.Context) ([]dbus.UnitStatus, error) {
 	return dbc.ListUnitsContext(ctx)
 }
 
+func (*db) ListUnitFiles(ctx context.Context, unitNames ...string) ([]dbus.UnitFile, error) {
+	dbc, err := dbus.NewWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer dbc.Close()
+
+	return dbc.ListUnitFilesByPatternsContext(ctx, nil, unitNames)
+}
+
 func (*db) DaemonReload(ctx context.Context) error {
 	dbc, err := dbus.NewWithContext(ctx)
 	if err != nil {
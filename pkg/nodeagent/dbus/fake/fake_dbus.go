@@ -6,6 +6,8 @@ package fake
 
 import (
 	"context"
+	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -37,6 +39,8 @@ const (
 	ActionReboot
 	// ActionList is constant for the 'List' action.
 	ActionList
+	// ActionListUnitFiles is constant for the 'ListUnitFiles' action.
+	ActionListUnitFiles
 )
 
 // SystemdAction is used for the implementation of the fake dbus.
@@ -50,6 +54,7 @@ type DBus struct {
 	Actions  []SystemdAction
 	failures map[string]error
 	units    []systemddbus.UnitStatus
+	files    []systemddbus.UnitFile
 
 	mutex sync.Mutex
 }
@@ -153,6 +158,33 @@ func (d *DBus) AddUnitsToList(units ...systemddbus.UnitStatus) {
 	d.units = append(d.units, units...)
 }
 
+// ListUnitFiles implements dbus.DBus.
+func (d *DBus) ListUnitFiles(_ context.Context, unitNames ...string) ([]systemddbus.UnitFile, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.Actions = append(d.Actions, SystemdAction{
+		Action:    ActionListUnitFiles,
+		UnitNames: unitNames,
+	})
+
+	var out []systemddbus.UnitFile
+	for _, file := range d.files {
+		if slices.Contains(unitNames, path.Base(file.Path)) {
+			out = append(out, file)
+		}
+	}
+	return out, nil
+}
+
+// AddUnitFilesToList adds the given unit files to the list of unit files that will be returned by ListUnitFiles.
+func (d *DBus) AddUnitFilesToList(files ...systemddbus.UnitFile) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.files = append(d.files, files...)
+}
+
 // Reboot implements dbus.DBus.
 func (d *DBus) Reboot() error {
 	d.mutex.Lock()
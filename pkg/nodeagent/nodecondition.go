@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchNodeCondition sets the condition with the given type on the node to the given status, reason and message. The
+// condition is added if it does not exist yet. The node status is only patched if the condition changes, and the last
+// transition time is only updated if the status changes.
+func PatchNodeCondition(ctx context.Context, c client.Client, now time.Time, node *corev1.Node, conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason, message string) error {
+	var condition *corev1.NodeCondition
+
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == conditionType {
+			condition = &node.Status.Conditions[i]
+			break
+		}
+	}
+
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+
+	if condition == nil {
+		node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: conditionType})
+		condition = &node.Status.Conditions[len(node.Status.Conditions)-1]
+	} else if condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return nil
+	}
+
+	if condition.Status != status {
+		condition.LastTransitionTime = metav1.NewTime(now)
+	}
+	condition.Status = status
+	condition.Reason = reason
+	condition.Message = message
+	condition.LastHeartbeatTime = metav1.NewTime(now)
+
+	if err := c.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching node condition %s: %w", conditionType, err)
+	}
+	return nil
+}
+
+// HasNodeCondition returns true if the node has a condition with the given type.
+func HasNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == conditionType {
+			return true
+		}
+	}
+	return false
+}
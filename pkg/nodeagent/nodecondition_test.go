@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/nodeagent"
+)
+
+var _ = Describe("NodeCondition", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		now        = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+		conditionType corev1.NodeConditionType = "MyCondition"
+		node          *corev1.Node
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(node).WithStatusSubresource(node).Build()
+	})
+
+	condition := func() corev1.NodeCondition {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == conditionType {
+				return condition
+			}
+		}
+		Fail("condition not found")
+		return corev1.NodeCondition{}
+	}
+
+	Describe("#PatchNodeCondition", func() {
+		It("should add the condition", func() {
+			Expect(PatchNodeCondition(ctx, fakeClient, now, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+
+			Expect(condition()).To(MatchFields(IgnoreExtras, Fields{
+				"Status":             Equal(corev1.ConditionTrue),
+				"Reason":             Equal("Reason"),
+				"Message":            Equal("message"),
+				"LastHeartbeatTime":  HaveField("Time", BeTemporally("==", now)),
+				"LastTransitionTime": HaveField("Time", BeTemporally("==", now)),
+			}))
+		})
+
+		It("should only update the last transition time if the status changes", func() {
+			Expect(PatchNodeCondition(ctx, fakeClient, now, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+			Expect(PatchNodeCondition(ctx, fakeClient, now.Add(time.Minute), node, conditionType, corev1.ConditionTrue, "Reason", "other message")).To(Succeed())
+
+			Expect(condition().LastTransitionTime.Time).To(BeTemporally("==", now))
+			Expect(condition().LastHeartbeatTime.Time).To(BeTemporally("==", now.Add(time.Minute)))
+			Expect(condition().Message).To(Equal("other message"))
+
+			Expect(PatchNodeCondition(ctx, fakeClient, now.Add(2*time.Minute), node, conditionType, corev1.ConditionFalse, "OtherReason", "other message")).To(Succeed())
+
+			Expect(condition().LastTransitionTime.Time).To(BeTemporally("==", now.Add(2*time.Minute)))
+			Expect(condition().Status).To(Equal(corev1.ConditionFalse))
+		})
+
+		It("should not patch the node if the condition does not change", func() {
+			Expect(PatchNodeCondition(ctx, fakeClient, now, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+			Expect(PatchNodeCondition(ctx, fakeClient, now.Add(time.Minute), node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+
+			Expect(condition().LastHeartbeatTime.Time).To(BeTemporally("==", now))
+		})
+	})
+
+	Describe("#HasNodeCondition", func() {
+		It("should return whether the node has the condition", func() {
+			Expect(HasNodeCondition(node, conditionType)).To(BeFalse())
+
+			node.Status.Conditions = []corev1.NodeCondition{{Type: conditionType}}
+			Expect(HasNodeCondition(node, conditionType)).To(BeTrue())
+		})
+	})
+})
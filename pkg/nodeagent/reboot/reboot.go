@@ -0,0 +1,496 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reboot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+const (
+	// AnnotationKeyRebootHistory is the key of an annotation on the Node containing the history of the reboots
+	// coordinated by gardener-node-agent.
+	AnnotationKeyRebootHistory = "node-agent.gardener.cloud/reboot-history"
+	// AnnotationKeyRebootBootID is the key of an annotation on the Node containing the boot ID of the node before a
+	// coordinated reboot was initiated. It is removed once the node has been rebooted.
+	AnnotationKeyRebootBootID = "node-agent.gardener.cloud/reboot-boot-id"
+	// AnnotationKeyRebootDrainStarted is the key of an annotation on the Node containing the time at which draining the
+	// node for a coordinated reboot was started.
+	AnnotationKeyRebootDrainStarted = "node-agent.gardener.cloud/reboot-drain-started"
+	// AnnotationKeyCordonedForReboot is the key of an annotation on the Node which is set if gardener-node-agent
+	// cordoned the node for a coordinated reboot.
+	AnnotationKeyCordonedForReboot = "node-agent.gardener.cloud/cordoned-for-reboot"
+
+	// EventReasonRebootPostponed is the reason of the event which is recorded when a reboot cannot be executed yet.
+	EventReasonRebootPostponed = "RebootPostponed"
+	// EventReasonRebooting is the reason of the event which is recorded when the node is rebooted.
+	EventReasonRebooting = "Rebooting"
+	// EventReasonRebootCompleted is the reason of the event which is recorded when the node is back after a reboot.
+	EventReasonRebootCompleted = "RebootCompleted"
+
+	// BootIDFilePath is the path of the file containing the boot ID of the node.
+	BootIDFilePath = "/proc/sys/kernel/random/boot_id"
+
+	defaultWorkerPoolName   = "default"
+	maxRebootHistoryEntries = 10
+	drainRetryPeriod        = 10 * time.Second
+	slotRetryPeriod         = time.Minute
+)
+
+// HistoryEntry is an entry of the reboot history of a node.
+type HistoryEntry struct {
+	// Reason is the reason for the reboot.
+	Reason string `json:"reason"`
+	// RebootTime is the time at which the reboot was initiated.
+	RebootTime metav1.Time `json:"rebootTime"`
+	// CompletionTime is the time at which gardener-node-agent detected that the node was rebooted.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// Coordinator reboots the node in a coordinated way. The node is only rebooted within the maintenance time window,
+// after a reboot slot of the worker pool has been acquired, and after the node has been drained.
+type Coordinator struct {
+	// Client is used for reading pods and for writing objects.
+	Client client.Client
+	// APIReader is used for reading the reboot leases and the shoot-info ConfigMap which are not cached.
+	APIReader client.Reader
+	FS        afero.Afero
+	Clock     clock.Clock
+	DBus      dbus.DBus
+	Recorder  record.EventRecorder
+	Config    nodeagentconfigv1alpha1.RebootControllerConfig
+}
+
+// Reboot reboots the node once all preconditions are met. If the node cannot be rebooted yet, the returned duration
+// indicates after which time the caller should call Reboot again. Callers must not assume that the node is rebooted
+// when a zero duration is returned, i.e., they should wait for gardener-node-agent to be restarted.
+// The optional beforeReboot function is called right before the reboot is initiated, i.e., it is neither called when
+// the reboot is postponed nor when the reboot was already initiated earlier. If it fails, the reboot is not initiated.
+func (c *Coordinator) Reboot(ctx context.Context, log logr.Logger, node *corev1.Node, reason string, beforeReboot func() error) (time.Duration, error) {
+	bootID, err := c.bootID()
+	if err != nil {
+		return 0, err
+	}
+
+	if node.Annotations[AnnotationKeyRebootBootID] == bootID {
+		log.Info("Reboot of node was already initiated, waiting for it to happen")
+		return 0, nil
+	}
+
+	lease, retryAfter, err := c.acquireSlot(ctx, log, node)
+	if err != nil || lease == nil {
+		return retryAfter, err
+	}
+
+	if err := c.cordon(ctx, node); err != nil {
+		return 0, fmt.Errorf("failed cordoning node: %w", err)
+	}
+
+	remainingPods, err := c.drain(ctx, log, node)
+	if err != nil {
+		return 0, fmt.Errorf("failed draining node: %w", err)
+	}
+
+	if len(remainingPods) > 0 {
+		drainStarted, err := time.Parse(time.RFC3339, node.Annotations[AnnotationKeyRebootDrainStarted])
+		if err != nil {
+			return 0, fmt.Errorf("failed parsing drain start time: %w", err)
+		}
+
+		if drainTimeout := c.Config.DrainTimeout.Duration; c.Clock.Since(drainStarted) > drainTimeout {
+			log.Info("Draining node for reboot timed out, postponing reboot", "drainTimeout", drainTimeout, "remainingPods", remainingPods)
+			c.Recorder.Eventf(node, corev1.EventTypeWarning, EventReasonRebootPostponed, "Draining node for reboot (%s) did not finish within %s, postponing reboot. Remaining pods: %s", reason, drainTimeout, strings.Join(remainingPods, ", "))
+			if err := c.abort(ctx, node, lease); err != nil {
+				return 0, err
+			}
+			return drainTimeout, nil
+		}
+
+		log.Info("Waiting for pods to be evicted before rebooting", "remainingPods", remainingPods)
+		return drainRetryPeriod, nil
+	}
+
+	history, err := readHistory(node)
+	if err != nil {
+		return 0, err
+	}
+	history = append(history, HistoryEntry{Reason: reason, RebootTime: metav1.NewTime(c.Clock.Now())})
+	if len(history) > maxRebootHistoryEntries {
+		history = history[len(history)-maxRebootHistoryEntries:]
+	}
+
+	if beforeReboot != nil {
+		if err := beforeReboot(); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := c.patchNode(ctx, node, func() error {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyRebootBootID, bootID)
+		return writeHistory(node, history)
+	}); err != nil {
+		return 0, fmt.Errorf("failed recording reboot on node: %w", err)
+	}
+
+	log.Info("Rebooting node", "reason", reason, "lease", client.ObjectKeyFromObject(lease))
+	c.Recorder.Eventf(node, corev1.EventTypeNormal, EventReasonRebooting, "Rebooting node: %s", reason)
+	return 0, c.DBus.Reboot()
+}
+
+// Complete finishes a coordinated reboot after the node has been rebooted, i.e., it uncordons the node (if it was
+// cordoned for the reboot), releases the reboot slot, and records the completion in the reboot history.
+func (c *Coordinator) Complete(ctx context.Context, log logr.Logger, node *corev1.Node) error {
+	bootIDBeforeReboot, ok := node.Annotations[AnnotationKeyRebootBootID]
+	if !ok {
+		return nil
+	}
+
+	bootID, err := c.bootID()
+	if err != nil {
+		return err
+	}
+	if bootID == bootIDBeforeReboot {
+		return nil
+	}
+
+	leases, err := c.slotLeases(ctx, node)
+	if err != nil {
+		return err
+	}
+	for _, lease := range leases {
+		if ptr.Deref(lease.Spec.HolderIdentity, "") == node.Name {
+			if err := c.releaseSlot(ctx, lease); err != nil {
+				return err
+			}
+		}
+	}
+
+	history, err := readHistory(node)
+	if err != nil {
+		return err
+	}
+	if len(history) > 0 && history[len(history)-1].CompletionTime == nil {
+		history[len(history)-1].CompletionTime = ptr.To(metav1.NewTime(c.Clock.Now()))
+	}
+
+	if err := c.patchNode(ctx, node, func() error {
+		c.uncordon(node)
+		delete(node.Annotations, AnnotationKeyRebootBootID)
+		return writeHistory(node, history)
+	}); err != nil {
+		return fmt.Errorf("failed completing reboot on node: %w", err)
+	}
+
+	log.Info("Node has been rebooted, released reboot slot")
+	c.Recorder.Event(node, corev1.EventTypeNormal, EventReasonRebootCompleted, "Node has been rebooted")
+	return nil
+}
+
+func (c *Coordinator) bootID() (string, error) {
+	content, err := c.FS.ReadFile(BootIDFilePath)
+	if err != nil {
+		return "", fmt.Errorf("failed reading boot ID from %s: %w", BootIDFilePath, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// acquireSlot returns the reboot lease held by the node. If the node does not hold a lease yet, it tries to acquire a
+// free one if the current time is within the maintenance time window. If no lease could be acquired, it returns the
+// duration after which acquiring a lease should be retried.
+func (c *Coordinator) acquireSlot(ctx context.Context, log logr.Logger, node *corev1.Node) (*coordinationv1.Lease, time.Duration, error) {
+	leases, err := c.slotLeases(ctx, node)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, lease := range leases {
+		if ptr.Deref(lease.Spec.HolderIdentity, "") == node.Name {
+			// The lease is renewed while the node is drained so that it does not expire and gets acquired by another
+			// node of the worker pool before the reboot.
+			if err := c.renewSlot(ctx, lease); err != nil {
+				return nil, 0, err
+			}
+			return lease, 0, nil
+		}
+	}
+
+	wait, err := c.durationUntilMaintenanceWindow(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if wait > 0 {
+		log.Info("Postponing reboot until the maintenance time window begins", "wait", wait)
+		c.Recorder.Eventf(node, corev1.EventTypeNormal, EventReasonRebootPostponed, "Postponing reboot until the maintenance time window begins in %s", wait.Round(time.Minute))
+		return nil, wait, nil
+	}
+
+	now := metav1.NewMicroTime(c.Clock.Now())
+	for _, lease := range leases {
+		if !c.isFree(lease) {
+			continue
+		}
+
+		lease.Spec = coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(node.Name),
+			LeaseDurationSeconds: ptr.To(int32(c.Config.LeaseDuration.Seconds())),
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		}
+
+		if lease.ResourceVersion == "" {
+			err = c.Client.Create(ctx, lease)
+		} else {
+			err = c.Client.Update(ctx, lease)
+		}
+		if apierrors.IsAlreadyExists(err) || apierrors.IsConflict(err) {
+			// another node acquired the slot concurrently
+			continue
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed acquiring reboot lease %s: %w", client.ObjectKeyFromObject(lease), err)
+		}
+
+		log.Info("Acquired reboot slot", "lease", client.ObjectKeyFromObject(lease))
+		return lease, 0, nil
+	}
+
+	log.Info("All reboot slots of the worker pool are taken, postponing reboot", "maxConcurrentReboots", len(leases))
+	c.Recorder.Eventf(node, corev1.EventTypeNormal, EventReasonRebootPostponed, "Postponing reboot because %d node(s) of the worker pool are already rebooting", len(leases))
+	return nil, slotRetryPeriod, nil
+}
+
+func (c *Coordinator) slotLeases(ctx context.Context, node *corev1.Node) ([]*coordinationv1.Lease, error) {
+	workerPoolName := node.Labels[v1beta1constants.LabelWorkerPool]
+	if workerPoolName == "" {
+		workerPoolName = defaultWorkerPoolName
+	}
+
+	leases := make([]*coordinationv1.Lease, 0, *c.Config.MaxConcurrentReboots)
+	for slot := range int(*c.Config.MaxConcurrentReboots) {
+		lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: gardenerutils.NodeRebootLeaseName(workerPoolName, slot), Namespace: metav1.NamespaceSystem}}
+		if err := c.APIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed reading reboot lease %s: %w", client.ObjectKeyFromObject(lease), err)
+		}
+		leases = append(leases, lease)
+	}
+
+	return leases, nil
+}
+
+func (c *Coordinator) isFree(lease *coordinationv1.Lease) bool {
+	if ptr.Deref(lease.Spec.HolderIdentity, "") == "" || lease.Spec.RenewTime == nil {
+		return true
+	}
+	expiry := lease.Spec.RenewTime.Add(time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second)
+	return c.Clock.Now().After(expiry)
+}
+
+// renewSlot renews the given reboot lease held by the node once half of its duration has passed.
+func (c *Coordinator) renewSlot(ctx context.Context, lease *coordinationv1.Lease) error {
+	leaseDuration := c.Config.LeaseDuration.Duration
+	if lease.Spec.RenewTime != nil && c.Clock.Since(lease.Spec.RenewTime.Time) < leaseDuration/2 {
+		return nil
+	}
+
+	lease.Spec.RenewTime = ptr.To(metav1.NewMicroTime(c.Clock.Now()))
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(leaseDuration.Seconds()))
+	// Update uses the resource version for optimistic locking, i.e., it fails if the lease was taken over concurrently.
+	if err := c.Client.Update(ctx, lease); err != nil {
+		return fmt.Errorf("failed renewing reboot lease %s: %w", client.ObjectKeyFromObject(lease), err)
+	}
+	return nil
+}
+
+// releaseSlot releases the given reboot lease. gardener-node-agent is only allowed to get and update reboot leases,
+// hence the lease is updated (with optimistic locking) instead of patched.
+func (c *Coordinator) releaseSlot(ctx context.Context, lease *coordinationv1.Lease) error {
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.AcquireTime = nil
+	lease.Spec.RenewTime = nil
+	if err := c.Client.Update(ctx, lease); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed releasing reboot lease %s: %w", client.ObjectKeyFromObject(lease), err)
+	}
+	return nil
+}
+
+// durationUntilMaintenanceWindow returns the duration until the maintenance time window begins, or zero if the
+// current time is within the window. The configured window takes precedence over the one of the shoot.
+func (c *Coordinator) durationUntilMaintenanceWindow(ctx context.Context) (time.Duration, error) {
+	begin, end, err := c.maintenanceWindow(ctx)
+	if err != nil || begin == "" || end == "" {
+		return 0, err
+	}
+
+	window, err := timewindow.ParseMaintenanceTimeWindow(begin, end)
+	if err != nil {
+		return 0, fmt.Errorf("failed parsing maintenance time window: %w", err)
+	}
+
+	now := c.Clock.Now().UTC()
+	if window.Contains(now) {
+		return 0, nil
+	}
+
+	nextBegin := window.AdjustedBegin(now)
+	if nextBegin.Before(now) {
+		nextBegin = nextBegin.AddDate(0, 0, 1)
+	}
+	return nextBegin.Sub(now), nil
+}
+
+func (c *Coordinator) maintenanceWindow(ctx context.Context) (string, string, error) {
+	if c.Config.MaintenanceWindow != nil {
+		return c.Config.MaintenanceWindow.Begin, c.Config.MaintenanceWindow.End, nil
+	}
+
+	shootInfo := &corev1.ConfigMap{}
+	if err := c.APIReader.Get(ctx, client.ObjectKey{Name: v1beta1constants.ConfigMapNameShootInfo, Namespace: metav1.NamespaceSystem}, shootInfo); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("failed reading shoot info: %w", err)
+	}
+
+	return shootInfo.Data["maintenanceBegin"], shootInfo.Data["maintenanceEnd"], nil
+}
+
+func (c *Coordinator) cordon(ctx context.Context, node *corev1.Node) error {
+	if _, ok := node.Annotations[AnnotationKeyRebootDrainStarted]; ok {
+		return nil
+	}
+
+	return c.patchNode(ctx, node, func() error {
+		if !node.Spec.Unschedulable {
+			node.Spec.Unschedulable = true
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyCordonedForReboot, "true")
+		}
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyRebootDrainStarted, c.Clock.Now().UTC().Format(time.RFC3339))
+		return nil
+	})
+}
+
+func (c *Coordinator) uncordon(node *corev1.Node) {
+	if _, ok := node.Annotations[AnnotationKeyCordonedForReboot]; ok {
+		node.Spec.Unschedulable = false
+	}
+	delete(node.Annotations, AnnotationKeyCordonedForReboot)
+	delete(node.Annotations, AnnotationKeyRebootDrainStarted)
+}
+
+func (c *Coordinator) abort(ctx context.Context, node *corev1.Node, lease *coordinationv1.Lease) error {
+	if err := c.releaseSlot(ctx, lease); err != nil {
+		return err
+	}
+
+	if err := c.patchNode(ctx, node, func() error {
+		c.uncordon(node)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed uncordoning node: %w", err)
+	}
+	return nil
+}
+
+// drain evicts all pods from the node which are not managed by a DaemonSet and are not mirror pods. Evictions
+// respect PodDisruptionBudgets, i.e., pods whose eviction is currently not allowed are retried later. It returns the
+// keys of the pods which are still running on the node.
+func (c *Coordinator) drain(ctx context.Context, log logr.Logger, node *corev1.Node) ([]string, error) {
+	podList := &corev1.PodList{}
+	if err := c.Client.List(ctx, podList, client.MatchingFields{indexer.PodNodeName: node.Name}); err != nil {
+		return nil, fmt.Errorf("failed listing pods for node %s: %w", node.Name, err)
+	}
+
+	var remainingPods []string
+	for _, pod := range podList.Items {
+		if !mustBeEvicted(&pod) {
+			continue
+		}
+		remainingPods = append(remainingPods, client.ObjectKeyFromObject(&pod).String())
+
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if err := c.Client.SubResource("eviction").Create(ctx, &pod, &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}); err != nil {
+			if apierrors.IsTooManyRequests(err) {
+				log.Info("Eviction of pod is currently not allowed, retrying later", "pod", client.ObjectKeyFromObject(&pod), "reason", err.Error())
+				continue
+			}
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed evicting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+		}
+	}
+
+	return remainingPods, nil
+}
+
+func mustBeEvicted(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil && controllerRef.Kind == "DaemonSet" && controllerRef.APIVersion == appsv1.SchemeGroupVersion.String() {
+		return false
+	}
+	return true
+}
+
+func (c *Coordinator) patchNode(ctx context.Context, node *corev1.Node, mutate func() error) error {
+	patch := client.MergeFrom(node.DeepCopy())
+	if err := mutate(); err != nil {
+		return err
+	}
+	return c.Client.Patch(ctx, node, patch)
+}
+
+func readHistory(node *corev1.Node) ([]HistoryEntry, error) {
+	var history []HistoryEntry
+
+	value, ok := node.Annotations[AnnotationKeyRebootHistory]
+	if !ok {
+		return history, nil
+	}
+
+	if err := json.Unmarshal([]byte(value), &history); err != nil {
+		return nil, fmt.Errorf("failed parsing reboot history: %w", err)
+	}
+	return history, nil
+}
+
+func writeHistory(node *corev1.Node, history []HistoryEntry) error {
+	value, err := json.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("failed marshalling reboot history: %w", err)
+	}
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyRebootHistory, string(value))
+	return nil
+}
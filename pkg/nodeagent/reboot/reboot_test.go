@@ -0,0 +1,468 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reboot_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	auth "k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	. "github.com/gardener/gardener/pkg/nodeagent/reboot"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/nodeagentauthorizer"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Coordinator", func() {
+	var (
+		ctx         context.Context
+		log         logr.Logger
+		fs          afero.Afero
+		fakeClient  client.Client
+		fakeDBus    *fakedbus.DBus
+		fakeClock   *testclock.FakeClock
+		recorder    *record.FakeRecorder
+		coordinator *Coordinator
+		node        *corev1.Node
+
+		evictionInterceptor func(pod client.Object) error
+
+		bootID       = "boot-id-1"
+		reason       = "some reason"
+		drainTimeout = 10 * time.Minute
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log = logr.Discard()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+		evictionInterceptor = nil
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   "node",
+			Labels: map[string]string{"worker.gardener.cloud/pool": "worker"},
+		}}
+
+		fakeClientWithWatch := fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.ShootScheme).
+			WithObjects(node).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, indexer.PodNodeNameIndexerFunc).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+					if subResourceName == "eviction" && evictionInterceptor != nil {
+						if err := evictionInterceptor(obj); err != nil {
+							return err
+						}
+					}
+					return c.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+				},
+			}).
+			Build()
+		fakeClient = fakeClientWithWatch
+
+		// The coordinator uses a client which enforces the rules of the node-agent-authorizer, i.e., the same
+		// permissions which gardener-node-agent has in a real cluster.
+		nodeAgentClient := authorizingClient(fakeClientWithWatch, nodeagentauthorizer.NewAuthorizer(logr.Discard(), nil, fakeClient, fakeClock, nil, false), node.Name)
+
+		Expect(fs.WriteFile(BootIDFilePath, []byte(bootID+"\n"), 0444)).To(Succeed())
+
+		coordinator = &Coordinator{
+			Client:    nodeAgentClient,
+			APIReader: nodeAgentClient,
+			FS:        fs,
+			Clock:     fakeClock,
+			DBus:      fakeDBus,
+			Recorder:  recorder,
+			Config: nodeagentconfigv1alpha1.RebootControllerConfig{
+				MaxConcurrentReboots: ptr.To[int32](1),
+				DrainTimeout:         &metav1.Duration{Duration: drainTimeout},
+				LeaseDuration:        &metav1.Duration{Duration: 30 * time.Minute},
+			},
+		}
+	})
+
+	getLease := func(name string) *coordinationv1.Lease {
+		lease := &coordinationv1.Lease{}
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: "kube-system"}, lease)).To(Succeed())
+		return lease
+	}
+
+	history := func() []HistoryEntry {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		var entries []HistoryEntry
+		ExpectWithOffset(1, json.Unmarshal([]byte(node.Annotations[AnnotationKeyRebootHistory]), &entries)).To(Succeed())
+		return entries
+	}
+
+	createPod := func(name string, mutate func(*corev1.Pod)) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: node.Name},
+		}
+		if mutate != nil {
+			mutate(pod)
+		}
+		ExpectWithOffset(1, fakeClient.Create(ctx, pod)).To(Succeed())
+		return pod
+	}
+
+	Describe("#Reboot", func() {
+		It("should acquire a reboot slot, cordon the node, record the history and reboot", func() {
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(BeZero())
+
+			lease := getLease("gardener-node-reboot-worker-0")
+			Expect(lease.Spec.HolderIdentity).To(PointTo(Equal(node.Name)))
+			Expect(lease.Spec.LeaseDurationSeconds).To(PointTo(Equal(int32(1800))))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+			Expect(node.Annotations).To(HaveKeyWithValue(AnnotationKeyCordonedForReboot, "true"))
+			Expect(node.Annotations).To(HaveKeyWithValue(AnnotationKeyRebootBootID, bootID))
+			Expect(history()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Reason":         Equal(reason),
+				"RebootTime":     HaveField("Time", BeTemporally("==", fakeClock.Now())),
+				"CompletionTime": BeNil(),
+			})))
+
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonRebooting)))
+		})
+
+		It("should call the given function right before rebooting", func() {
+			var called bool
+			Expect(coordinator.Reboot(ctx, log, node, reason, func() error {
+				Expect(fakeDBus.Actions).To(BeEmpty())
+				called = true
+				return nil
+			})).To(BeZero())
+
+			Expect(called).To(BeTrue())
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+		})
+
+		It("should not reboot if the given function fails", func() {
+			_, err := coordinator.Reboot(ctx, log, node, reason, func() error { return errors.New("fake") })
+			Expect(err).To(MatchError("fake"))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).NotTo(HaveKey(AnnotationKeyRebootBootID))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should not reboot again if the reboot was already initiated", func() {
+			node.Annotations = map[string]string{AnnotationKeyRebootBootID: bootID}
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, func() error {
+				Fail("function must not be called if the reboot was already initiated")
+				return nil
+			})).To(BeZero())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should postpone the reboot until the configured maintenance time window begins", func() {
+			coordinator.Config.MaintenanceWindow = &nodeagentconfigv1alpha1.MaintenanceWindow{Begin: "220000+0000", End: "230000+0000"}
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(12 * time.Hour))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardener-node-reboot-worker-0", Namespace: "kube-system"}, &coordinationv1.Lease{})).To(BeNotFoundError())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonRebootPostponed)))
+		})
+
+		It("should respect the maintenance time window of the shoot", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot-info", Namespace: "kube-system"},
+				Data:       map[string]string{"maintenanceBegin": "080000+0000", "maintenanceEnd": "090000+0000"},
+			})).To(Succeed())
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(22 * time.Hour))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should reboot within the maintenance time window", func() {
+			coordinator.Config.MaintenanceWindow = &nodeagentconfigv1alpha1.MaintenanceWindow{Begin: "090000+0000", End: "110000+0000"}
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(BeZero())
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+		})
+
+		It("should postpone the reboot if all reboot slots are taken", func() {
+			Expect(fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-reboot-worker-0", Namespace: "kube-system"},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("other-node"),
+					LeaseDurationSeconds: ptr.To[int32](1800),
+					RenewTime:            ptr.To(metav1.NewMicroTime(fakeClock.Now().Add(-time.Minute))),
+				},
+			})).To(Succeed())
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(time.Minute))
+
+			Expect(getLease("gardener-node-reboot-worker-0").Spec.HolderIdentity).To(PointTo(Equal("other-node")))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonRebootPostponed)))
+		})
+
+		It("should take over expired reboot slots", func() {
+			Expect(fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-reboot-worker-0", Namespace: "kube-system"},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("other-node"),
+					LeaseDurationSeconds: ptr.To[int32](1800),
+					RenewTime:            ptr.To(metav1.NewMicroTime(fakeClock.Now().Add(-time.Hour))),
+				},
+			})).To(Succeed())
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(BeZero())
+
+			Expect(getLease("gardener-node-reboot-worker-0").Spec.HolderIdentity).To(PointTo(Equal(node.Name)))
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+		})
+
+		It("should use further reboot slots if configured", func() {
+			coordinator.Config.MaxConcurrentReboots = ptr.To[int32](2)
+			Expect(fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-reboot-worker-0", Namespace: "kube-system"},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("other-node"),
+					LeaseDurationSeconds: ptr.To[int32](1800),
+					RenewTime:            ptr.To(metav1.NewMicroTime(fakeClock.Now())),
+				},
+			})).To(Succeed())
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(BeZero())
+
+			Expect(getLease("gardener-node-reboot-worker-1").Spec.HolderIdentity).To(PointTo(Equal(node.Name)))
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+		})
+
+		It("should evict the pods before rebooting", func() {
+			pod := createPod("pod", nil)
+			createPod("daemonset-pod", func(pod *corev1.Pod) {
+				pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "ds", UID: "uid", Controller: ptr.To(true)}}
+			})
+			createPod("mirror-pod", func(pod *corev1.Pod) {
+				pod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "foo"}
+			})
+			createPod("completed-pod", func(pod *corev1.Pod) {
+				pod.Status.Phase = corev1.PodSucceeded
+			})
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(10 * time.Second))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(BeZero())
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+		})
+
+		It("should postpone the reboot if pods cannot be evicted within the drain timeout", func() {
+			pod := createPod("pod", nil)
+			evictionInterceptor = func(_ client.Object) error {
+				return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+			}
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(10 * time.Second))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+
+			fakeClock.Step(drainTimeout + time.Second)
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(drainTimeout))
+
+			Expect(getLease("gardener-node-reboot-worker-0").Spec.HolderIdentity).To(BeNil())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(node.Annotations).NotTo(HaveKey(AnnotationKeyCordonedForReboot))
+			Expect(node.Annotations).NotTo(HaveKey(AnnotationKeyRebootDrainStarted))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonRebootPostponed)))
+		})
+
+		It("should renew the reboot lease while draining the node", func() {
+			coordinator.Config.DrainTimeout = &metav1.Duration{Duration: time.Hour}
+			createPod("pod", nil)
+			evictionInterceptor = func(_ client.Object) error {
+				return apierrors.NewTooManyRequests("Cannot evict pod", 10)
+			}
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(10 * time.Second))
+			acquireTime := fakeClock.Now()
+
+			fakeClock.Step(10 * time.Minute)
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(10 * time.Second))
+			Expect(getLease("gardener-node-reboot-worker-0").Spec.RenewTime.Time).To(BeTemporally("==", acquireTime))
+
+			fakeClock.Step(6 * time.Minute)
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(10 * time.Second))
+
+			lease := getLease("gardener-node-reboot-worker-0")
+			Expect(lease.Spec.HolderIdentity).To(PointTo(Equal(node.Name)))
+			Expect(lease.Spec.RenewTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			Expect(lease.Spec.AcquireTime.Time).To(BeTemporally("==", acquireTime))
+		})
+
+		It("should return an error if evicting a pod fails unexpectedly", func() {
+			createPod("pod", nil)
+			evictionInterceptor = func(_ client.Object) error {
+				return apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "pod", nil)
+			}
+
+			_, err := coordinator.Reboot(ctx, log, node, reason, nil)
+			Expect(err).To(MatchError(ContainSubstring("failed evicting pod default/pod")))
+		})
+
+		It("should not uncordon a node which was already cordoned", func() {
+			node.Spec.Unschedulable = true
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+			createPod("pod", nil)
+			evictionInterceptor = func(_ client.Object) error {
+				return apierrors.NewTooManyRequests("Cannot evict pod", 10)
+			}
+
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(10 * time.Second))
+			fakeClock.Step(drainTimeout + time.Second)
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(Equal(drainTimeout))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+		})
+	})
+
+	Describe("#Complete", func() {
+		BeforeEach(func() {
+			Expect(coordinator.Reboot(ctx, log, node, reason, nil)).To(BeZero())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonRebooting)))
+		})
+
+		It("should do nothing if the node was not rebooted yet", func() {
+			Expect(coordinator.Complete(ctx, log, node)).To(Succeed())
+
+			Expect(getLease("gardener-node-reboot-worker-0").Spec.HolderIdentity).To(PointTo(Equal(node.Name)))
+			Expect(node.Annotations).To(HaveKey(AnnotationKeyRebootBootID))
+		})
+
+		It("should release the reboot slot and uncordon the node after the reboot", func() {
+			Expect(fs.WriteFile(BootIDFilePath, []byte("boot-id-2\n"), 0444)).To(Succeed())
+			rebootTime := fakeClock.Now()
+			fakeClock.Step(5 * time.Minute)
+
+			Expect(coordinator.Complete(ctx, log, node)).To(Succeed())
+
+			Expect(getLease("gardener-node-reboot-worker-0").Spec.HolderIdentity).To(BeNil())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(node.Annotations).NotTo(HaveKey(AnnotationKeyRebootBootID))
+			Expect(node.Annotations).NotTo(HaveKey(AnnotationKeyCordonedForReboot))
+			Expect(node.Annotations).NotTo(HaveKey(AnnotationKeyRebootDrainStarted))
+			Expect(history()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Reason":         Equal(reason),
+				"RebootTime":     HaveField("Time", BeTemporally("==", rebootTime)),
+				"CompletionTime": PointTo(HaveField("Time", BeTemporally("==", fakeClock.Now()))),
+			})))
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonRebootCompleted)))
+		})
+	})
+})
+
+// authorizingClient returns a client which authorizes all requests with the given authorizer on behalf of the
+// gardener-node-agent of the given node before passing them to the given client.
+func authorizingClient(c client.WithWatch, authorizer auth.Authorizer, nodeName string) client.Client {
+	authorize := func(ctx context.Context, verb string, obj runtime.Object, namespace, name, subresource string) error {
+		gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+		if err != nil {
+			return err
+		}
+		resource, _ := meta.UnsafeGuessKindToResource(schema.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: strings.TrimSuffix(gvk.Kind, "List")})
+
+		decision, reason, err := authorizer.Authorize(ctx, auth.AttributesRecord{
+			User:            &user.DefaultInfo{Name: v1beta1constants.NodeAgentUserNamePrefix + nodeName, Groups: []string{v1beta1constants.NodeAgentsGroup}},
+			Verb:            verb,
+			Namespace:       namespace,
+			APIGroup:        resource.Group,
+			Resource:        resource.Resource,
+			Subresource:     subresource,
+			Name:            name,
+			ResourceRequest: true,
+		})
+		if err != nil {
+			return err
+		}
+		if decision != auth.DecisionAllow {
+			return apierrors.NewForbidden(resource.GroupResource(), name, errors.New(reason))
+		}
+		return nil
+	}
+
+	return interceptor.NewClient(c, interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if err := authorize(ctx, "get", obj, key.Namespace, key.Name, ""); err != nil {
+				return err
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			listOptions := (&client.ListOptions{}).ApplyOptions(opts)
+			if err := authorize(ctx, "list", list, listOptions.Namespace, "", ""); err != nil {
+				return err
+			}
+			return c.List(ctx, list, opts...)
+		},
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			// The name is not part of the request attributes for create requests.
+			if err := authorize(ctx, "create", obj, obj.GetNamespace(), "", ""); err != nil {
+				return err
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if err := authorize(ctx, "update", obj, obj.GetNamespace(), obj.GetName(), ""); err != nil {
+				return err
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if err := authorize(ctx, "patch", obj, obj.GetNamespace(), obj.GetName(), ""); err != nil {
+				return err
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+		SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+			if err := authorize(ctx, "create", obj, obj.GetNamespace(), obj.GetName(), subResourceName); err != nil {
+				return err
+			}
+			return c.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+		},
+	})
+}
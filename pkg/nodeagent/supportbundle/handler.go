@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// HandlerPath is the path at which the support bundle handler is served by gardener-node-agent.
+const HandlerPath = "/debug/support-bundle"
+
+// NewHandler returns a http.Handler which streams a support bundle collected by the given collector.
+func NewHandler(collector *Collector) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", FileName(collector.NodeName, collector.Clock.Now())))
+
+		if err := collector.Collect(r.Context(), w); err != nil {
+			collector.Log.Error(err, "Failed streaming support bundle")
+		}
+	})
+}
+
+// NewServer returns a server which serves the support bundle handler on the given port of the loopback interface.
+// The support bundle contains sensitive information about the node, hence it must only be accessible from the node
+// itself and is never served on the network.
+func NewServer(collector *Collector, port int) *manager.Server {
+	mux := http.NewServeMux()
+	mux.Handle(HandlerPath, NewHandler(collector))
+
+	return &manager.Server{
+		Name: "support-bundle",
+		Server: &http.Server{
+			Addr:              net.JoinHostPort("127.0.0.1", strconv.Itoa(port)),
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
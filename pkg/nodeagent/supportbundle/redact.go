@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle
+
+import (
+	"regexp"
+)
+
+// RedactedValue is the value which replaces sensitive data in the support bundle.
+const RedactedValue = "<redacted>"
+
+var (
+	// privateKeyRegex matches PEM encoded private keys.
+	privateKeyRegex = regexp.MustCompile(`(?s)-----BEGIN ([A-Z ]*)PRIVATE KEY-----.*?-----END ([A-Z ]*)PRIVATE KEY-----`)
+	// sensitiveKeyValueRegex matches YAML/JSON/TOML key-value pairs whose keys typically hold credentials.
+	sensitiveKeyValueRegex = regexp.MustCompile(`(?im)^(\s*"?[\w.-]*(?:token|password|passwd|secret|client-key-data|private[_-]?key|credentials?)"?\s*[:=]\s*)("[^"\n]*"|'[^'\n]*'|[^\s,}\n]+)`)
+	// bearerTokenRegex matches bearer tokens in HTTP headers or command lines.
+	bearerTokenRegex = regexp.MustCompile(`(?i)(bearer\s+)[a-z0-9._~+/=-]+`)
+	// inlineDataRegex matches the base64 encoded file contents of files embedded in an OperatingSystemConfig.
+	inlineDataRegex = regexp.MustCompile(`(?m)^(\s*inline:\s*\n\s*data:\s*)(\S+)`)
+)
+
+// Redact replaces sensitive information like private keys, tokens, passwords and the inline file contents of an
+// OperatingSystemConfig in the given content.
+func Redact(content []byte) []byte {
+	content = privateKeyRegex.ReplaceAll(content, []byte(RedactedValue))
+	content = inlineDataRegex.ReplaceAll(content, []byte("${1}"+RedactedValue))
+	content = sensitiveKeyValueRegex.ReplaceAll(content, []byte("${1}"+RedactedValue))
+	return bearerTokenRegex.ReplaceAll(content, []byte("${1}"+RedactedValue))
+}
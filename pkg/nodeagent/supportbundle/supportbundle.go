@@ -0,0 +1,311 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os/exec"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	kubeletcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	// DefaultJournalLines is the default number of journal lines collected per unit.
+	DefaultJournalLines = 1000
+
+	filePathContainerdConfig = "/etc/containerd/config.toml"
+	filePermissions          = 0600
+)
+
+// DefaultJournalUnits is the list of systemd units whose journal excerpts are collected by default.
+var DefaultJournalUnits = []string{
+	nodeagentconfigv1alpha1.UnitName,
+	nodeagentconfigv1alpha1.InitUnitName,
+	v1beta1constants.OperatingSystemConfigUnitNameKubeletService,
+	v1beta1constants.OperatingSystemConfigUnitNameContainerDService,
+}
+
+// ReadJournal reads the last lines of the journal of the given unit. Exposed for testing.
+var ReadJournal = func(ctx context.Context, unitName string, lines int) ([]byte, error) {
+	return exec.CommandContext(ctx, "journalctl", "--no-pager", "--output=short-iso", "--unit", unitName, "--lines", strconv.Itoa(lines)).Output()
+}
+
+// Collector collects diagnostic information about a node and writes it as a redacted tarball.
+type Collector struct {
+	Log   logr.Logger
+	FS    afero.Afero
+	DBus  dbus.DBus
+	Clock clock.Clock
+	// Client is used for collecting cluster resources related to the node. If it is nil, no cluster resources are
+	// collected.
+	Client client.Reader
+	// NodeName is the name of the node for which cluster resources are collected. If it is empty (e.g., because the
+	// node was not yet registered when gardener-node-agent started), it is looked up via HostName for each collection.
+	NodeName string
+	// HostName is the hostname of the machine. It is used for looking up the node if NodeName is empty.
+	HostName string
+	// JournalUnits is the list of systemd units whose journal excerpts are collected.
+	JournalUnits []string
+	// JournalLines is the number of journal lines collected per unit.
+	JournalLines int
+}
+
+// Collect collects all diagnostic information and writes it as gzip-compressed tarball to the given writer. Failures
+// of individual collectors do not abort the collection, they are recorded in the 'errors.txt' file of the bundle.
+func (c *Collector) Collect(ctx context.Context, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	b := &bundle{tarWriter: tarWriter, modTime: c.Clock.Now()}
+
+	for _, collect := range []func(context.Context, *bundle) error{
+		c.collectUnits,
+		c.collectOperatingSystemConfig,
+		c.collectStaticPodManifests,
+		c.collectContainerdConfig,
+		c.collectJournal,
+		c.collectClusterResources,
+	} {
+		if err := collect(ctx, b); err != nil {
+			if b.writeErr != nil {
+				return fmt.Errorf("failed writing support bundle: %w", b.writeErr)
+			}
+			c.Log.Info("Failed collecting diagnostic information, continuing", "error", err.Error())
+			b.errs = append(b.errs, err)
+		}
+	}
+
+	if len(b.errs) > 0 {
+		b.add("errors.txt", []byte(errors.Join(b.errs...).Error()+"\n"))
+	}
+
+	if b.writeErr != nil {
+		return fmt.Errorf("failed writing support bundle: %w", b.writeErr)
+	}
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed closing tar writer: %w", err)
+	}
+	return gzipWriter.Close()
+}
+
+// FileName returns the default file name of a support bundle collected for the given node at the given time.
+func FileName(nodeName string, now time.Time) string {
+	if nodeName == "" {
+		nodeName = "node"
+	}
+	return fmt.Sprintf("support-bundle-%s-%s.tar.gz", nodeName, now.UTC().Format("20060102-150405"))
+}
+
+type unitStatus struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	LoadState   string `json:"loadState"`
+	ActiveState string `json:"activeState"`
+	SubState    string `json:"subState"`
+}
+
+func (c *Collector) collectUnits(ctx context.Context, b *bundle) error {
+	units, err := c.DBus.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing systemd units: %w", err)
+	}
+
+	statuses := make([]unitStatus, 0, len(units))
+	for _, unit := range units {
+		statuses = append(statuses, unitStatus{
+			Name:        unit.Name,
+			Description: unit.Description,
+			LoadState:   unit.LoadState,
+			ActiveState: unit.ActiveState,
+			SubState:    unit.SubState,
+		})
+	}
+
+	return b.addYAML("node/units.yaml", statuses)
+}
+
+func (c *Collector) collectOperatingSystemConfig(_ context.Context, b *bundle) error {
+	var errs []error
+
+	for _, file := range []struct{ name, path string }{
+		{name: "osc/last-applied-osc.yaml", path: operatingsystemconfig.LastAppliedOperatingSystemConfigFilePath},
+		{name: "osc/last-computed-osc-changes.yaml", path: operatingsystemconfig.LastComputedOperatingSystemConfigChangesFilePath},
+	} {
+		if err := c.copyFile(b, file.name, file.path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Collector) collectStaticPodManifests(_ context.Context, b *bundle) error {
+	return c.copyDir(b, "node/static-pods", kubeletcomponent.FilePathKubernetesManifests)
+}
+
+func (c *Collector) collectContainerdConfig(_ context.Context, b *bundle) error {
+	return c.copyFile(b, "node/containerd/config.toml", filePathContainerdConfig)
+}
+
+func (c *Collector) collectJournal(ctx context.Context, b *bundle) error {
+	var errs []error
+
+	for _, unitName := range c.JournalUnits {
+		out, err := ReadJournal(ctx, unitName, c.JournalLines)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed reading journal of unit %q: %w", unitName, err))
+			continue
+		}
+		b.add(path.Join("node/journal", unitName+".log"), Redact(out))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Collector) collectClusterResources(ctx context.Context, b *bundle) error {
+	if c.Client == nil {
+		return nil
+	}
+
+	node, err := c.getNode(ctx)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		c.Log.V(1).Info("Node is not registered yet, skipping cluster resources")
+		return nil
+	}
+
+	var errs []error
+
+	node.ManagedFields = nil
+	if checksum, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; ok {
+		b.add("osc/applied-checksum", []byte(checksum+"\n"))
+	}
+	errs = append(errs, b.addYAML("cluster/node.yaml", node))
+
+	podList := &corev1.PodList{}
+	if err := c.Client.List(ctx, podList, client.MatchingFields{indexer.PodNodeName: node.Name}); err != nil {
+		errs = append(errs, fmt.Errorf("failed listing pods on node %q: %w", node.Name, err))
+	} else {
+		for i := range podList.Items {
+			podList.Items[i].ManagedFields = nil
+		}
+		errs = append(errs, b.addYAML("cluster/pods.yaml", podList))
+	}
+
+	return errors.Join(errs...)
+}
+
+// getNode returns the node of this machine. It returns nil if the node is not registered yet.
+func (c *Collector) getNode(ctx context.Context) (*corev1.Node, error) {
+	if c.NodeName != "" {
+		node := &corev1.Node{}
+		if err := c.Client.Get(ctx, client.ObjectKey{Name: c.NodeName}, node); err != nil {
+			return nil, fmt.Errorf("failed reading node %q: %w", c.NodeName, err)
+		}
+		return node, nil
+	}
+
+	if c.HostName == "" {
+		return nil, nil
+	}
+
+	return nodeagent.FetchNodeByHostName(ctx, c.Client, c.HostName)
+}
+
+func (c *Collector) copyFile(b *bundle, name, filePath string) error {
+	content, err := c.FS.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			c.Log.V(1).Info("File does not exist, skipping", "path", filePath)
+			return nil
+		}
+		return fmt.Errorf("failed reading file %q: %w", filePath, err)
+	}
+
+	b.add(name, Redact(content))
+	return nil
+}
+
+func (c *Collector) copyDir(b *bundle, name, dirPath string) error {
+	entries, err := c.FS.ReadDir(dirPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			c.Log.V(1).Info("Directory does not exist, skipping", "path", dirPath)
+			return nil
+		}
+		return fmt.Errorf("failed reading directory %q: %w", dirPath, err)
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		errs = append(errs, c.copyFile(b, path.Join(name, entry.Name()), path.Join(dirPath, entry.Name())))
+	}
+
+	return errors.Join(errs...)
+}
+
+type bundle struct {
+	tarWriter *tar.Writer
+	modTime   time.Time
+	errs      []error
+	writeErr  error
+}
+
+func (b *bundle) add(name string, content []byte) {
+	if b.writeErr != nil {
+		return
+	}
+
+	if err := b.tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    filePermissions,
+		Size:    int64(len(content)),
+		ModTime: b.modTime,
+	}); err != nil {
+		b.writeErr = err
+		return
+	}
+
+	if _, err := b.tarWriter.Write(content); err != nil {
+		b.writeErr = err
+	}
+}
+
+func (b *bundle) addYAML(name string, obj any) error {
+	content, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed marshalling %s: %w", name, err)
+	}
+
+	b.add(name, Redact(content))
+	return nil
+}
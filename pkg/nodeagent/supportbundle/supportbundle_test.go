@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package supportbundle_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	. "github.com/gardener/gardener/pkg/nodeagent/supportbundle"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Collector", func() {
+	var (
+		ctx = context.Background()
+
+		fs         afero.Afero
+		dbus       *fakedbus.DBus
+		fakeClient client.Client
+		collector  *Collector
+
+		nodeName = "node-1"
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		dbus = fakedbus.New()
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, indexer.PodNodeNameIndexerFunc).
+			Build()
+
+		collector = &Collector{
+			Log:          logr.Discard(),
+			FS:           fs,
+			DBus:         dbus,
+			Clock:        testclock.NewFakeClock(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+			Client:       fakeClient,
+			NodeName:     nodeName,
+			JournalUnits: []string{"kubelet.service"},
+			JournalLines: 10,
+		}
+
+		DeferCleanup(test.WithVar(&ReadJournal, func(_ context.Context, unitName string, lines int) ([]byte, error) {
+			Expect(unitName).To(Equal("kubelet.service"))
+			Expect(lines).To(Equal(10))
+			return []byte("kubelet started with Bearer abcdef\n"), nil
+		}))
+	})
+
+	Describe("#Collect", func() {
+		It("should collect all diagnostic information", func() {
+			dbus.AddUnitsToList(systemddbus.UnitStatus{Name: "kubelet.service", LoadState: "loaded", ActiveState: "active", SubState: "running"})
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte("spec:\n  files:\n  - content:\n      inline:\n        data: c2VjcmV0\n        encoding: b64\n    path: /etc/foo\n"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/kubernetes/manifests/kube-apiserver.yaml", []byte("kind: Pod\n"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/containerd/config.toml", []byte("version = 2\n"), 0600)).To(Succeed())
+
+			Expect(fakeClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:        nodeName,
+				Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: "abc123"},
+			}})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "kube-system"}, Spec: corev1.PodSpec{NodeName: nodeName}})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other-pod", Namespace: "kube-system"}, Spec: corev1.PodSpec{NodeName: "other-node"}})).To(Succeed())
+
+			buf := &bytes.Buffer{}
+			Expect(collector.Collect(ctx, buf)).To(Succeed())
+
+			files := readTarball(buf)
+			Expect(files).To(HaveKey("node/units.yaml"))
+			Expect(files["node/units.yaml"]).To(ContainSubstring("activeState: active"))
+			Expect(files["osc/last-applied-osc.yaml"]).To(ContainSubstring("data: <redacted>"))
+			Expect(files["osc/last-applied-osc.yaml"]).To(ContainSubstring("path: /etc/foo"))
+			Expect(files["osc/applied-checksum"]).To(Equal("abc123\n"))
+			Expect(files["node/static-pods/kube-apiserver.yaml"]).To(Equal("kind: Pod\n"))
+			Expect(files["node/containerd/config.toml"]).To(Equal("version = 2\n"))
+			Expect(files["node/journal/kubelet.service.log"]).To(Equal("kubelet started with Bearer <redacted>\n"))
+			Expect(files["cluster/node.yaml"]).To(ContainSubstring("name: " + nodeName))
+			Expect(files["cluster/pods.yaml"]).To(ContainSubstring("name: pod"))
+			Expect(files["cluster/pods.yaml"]).NotTo(ContainSubstring("other-pod"))
+			Expect(files).NotTo(HaveKey("cluster/events.yaml"))
+			Expect(files).NotTo(HaveKey("errors.txt"))
+		})
+
+		It("should look up the node via the hostname if the node name is not known", func() {
+			collector.NodeName = ""
+			collector.HostName = "host-1"
+
+			Expect(fakeClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName, Labels: map[string]string{corev1.LabelHostname: "host-1"}}})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "kube-system"}, Spec: corev1.PodSpec{NodeName: nodeName}})).To(Succeed())
+
+			buf := &bytes.Buffer{}
+			Expect(collector.Collect(ctx, buf)).To(Succeed())
+
+			files := readTarball(buf)
+			Expect(files["cluster/node.yaml"]).To(ContainSubstring("name: " + nodeName))
+			Expect(files["cluster/pods.yaml"]).To(ContainSubstring("name: pod"))
+			Expect(files).NotTo(HaveKey("errors.txt"))
+		})
+
+		It("should skip cluster resources if the node is not registered yet", func() {
+			collector.NodeName = ""
+			collector.HostName = "host-1"
+
+			buf := &bytes.Buffer{}
+			Expect(collector.Collect(ctx, buf)).To(Succeed())
+
+			files := readTarball(buf)
+			Expect(files).To(HaveKey("node/units.yaml"))
+			Expect(files).NotTo(HaveKey("cluster/node.yaml"))
+			Expect(files).NotTo(HaveKey("errors.txt"))
+		})
+
+		It("should record failures of individual collectors and continue", func() {
+			DeferCleanup(test.WithVar(&ReadJournal, func(context.Context, string, int) ([]byte, error) {
+				return nil, errors.New("journalctl not found")
+			}))
+			collector.Client = nil
+
+			buf := &bytes.Buffer{}
+			Expect(collector.Collect(ctx, buf)).To(Succeed())
+
+			files := readTarball(buf)
+			Expect(files).To(HaveKey("node/units.yaml"))
+			Expect(files).NotTo(HaveKey("cluster/node.yaml"))
+			Expect(files["errors.txt"]).To(ContainSubstring(`failed reading journal of unit "kubelet.service": journalctl not found`))
+		})
+	})
+
+	Describe("#NewHandler", func() {
+		It("should stream the support bundle", func() {
+			collector.Client = nil
+
+			recorder := httptest.NewRecorder()
+			NewHandler(collector).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/gzip"))
+			Expect(recorder.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="support-bundle-node-1-20250102-030405.tar.gz"`))
+			Expect(readTarball(recorder.Body)).To(HaveKey("node/units.yaml"))
+		})
+
+		It("should reject other methods than GET", func() {
+			recorder := httptest.NewRecorder()
+			NewHandler(collector).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, HandlerPath, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+	})
+})
+
+func readTarball(r io.Reader) map[string]string {
+	gzipReader, err := gzip.NewReader(r)
+	Expect(err).NotTo(HaveOccurred())
+
+	var (
+		tarReader = tar.NewReader(gzipReader)
+		files     = map[string]string{}
+	)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		Expect(err).NotTo(HaveOccurred())
+
+		content, err := io.ReadAll(tarReader)
+		Expect(err).NotTo(HaveOccurred())
+		files[header.Name] = string(content)
+	}
+
+	return files
+}
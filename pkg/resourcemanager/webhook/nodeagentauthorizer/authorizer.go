@@ -8,6 +8,9 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
@@ -22,27 +25,37 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	auth "k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // NewAuthorizer returns a new authorizer for requests from gardener-node-agents. It never has an opinion on the request.
-func NewAuthorizer(logger logr.Logger, sourceClient, targetClient client.Client, machineNamespace *string, authorizeWithSelectors bool) *authorizer {
+func NewAuthorizer(logger logr.Logger, sourceClient, targetClient client.Client, clock clock.Clock, machineNamespace *string, authorizeWithSelectors bool) *authorizer {
 	return &authorizer{
 		sourceClient:           sourceClient,
 		targetClient:           targetClient,
+		clock:                  clock,
 		logger:                 logger,
 		machineNamespace:       machineNamespace,
 		authorizeWithSelectors: authorizeWithSelectors,
 	}
 }
 
-const valitailTokenSecretName = "gardener-valitail"
+const (
+	valitailTokenSecretName = "gardener-valitail"
+	// nodeRebootDefaultWorkerPoolName is the worker pool name used by gardener-node-agent for the reboot leases of nodes
+	// without worker pool label.
+	nodeRebootDefaultWorkerPoolName = "default"
+)
 
 var (
 	certificateSigningRequestResource = certificatesv1.Resource("certificatesigningrequests")
+	configMapResource                 = corev1.Resource("configmaps")
 	eventCoreResource                 = corev1.Resource("events")
 	eventResource                     = eventsv1.Resource("events")
 	leaseResource                     = coordinationv1.Resource("leases")
@@ -54,6 +67,7 @@ var (
 type authorizer struct {
 	sourceClient client.Client
 	targetClient client.Client
+	clock        clock.Clock
 	logger       logr.Logger
 	// machineNamespace is the namespace where the Machine object is located. If nil, the node name is used for
 	// authorization instead of the machine name. This scenario is used for gardenadm scenario.
@@ -84,6 +98,8 @@ func (a *authorizer) Authorize(ctx context.Context, attrs auth.Attributes) (auth
 		switch requestResource {
 		case certificateSigningRequestResource:
 			return a.authorizeCertificateSigningRequest(ctx, requestLog, attrs)
+		case configMapResource:
+			return a.authorizeConfigMap(requestLog, attrs)
 		case eventCoreResource, eventResource:
 			return a.authorizeEvent(requestLog, attrs)
 		case leaseResource:
@@ -126,6 +142,24 @@ func (a *authorizer) authorizeCertificateSigningRequest(ctx context.Context, log
 	return auth.DecisionAllow, "", nil
 }
 
+func (a *authorizer) authorizeConfigMap(log logr.Logger, attrs auth.Attributes) (auth.Decision, string, error) {
+	if ok, reason := a.checkSubresource(log, attrs); !ok {
+		return auth.DecisionDeny, reason, nil
+	}
+
+	if allowed, reason := a.checkVerb(log, attrs, "get"); !allowed {
+		return auth.DecisionDeny, reason, nil
+	}
+
+	// gardener-node-agent reads the maintenance time window of the shoot for coordinating reboots.
+	if attrs.GetName() != v1beta1constants.ConfigMapNameShootInfo || attrs.GetNamespace() != metav1.NamespaceSystem {
+		log.Info("Denying authorization because gardener-node-agent is not allowed to access the config map")
+		return auth.DecisionDeny, fmt.Sprintf("gardener-node-agent can only access config map %q in %q namespace", v1beta1constants.ConfigMapNameShootInfo, metav1.NamespaceSystem), nil
+	}
+
+	return auth.DecisionAllow, "", nil
+}
+
 func (a *authorizer) authorizeEvent(log logr.Logger, attrs auth.Attributes) (auth.Decision, string, error) {
 	if ok, reason := a.checkSubresource(log, attrs); !ok {
 		return auth.DecisionDeny, reason, nil
@@ -154,14 +188,66 @@ func (a *authorizer) authorizeLease(ctx context.Context, log logr.Logger, machin
 	}
 
 	allowedLease := "gardener-node-agent-" + nodeName
-	if (attrs.GetVerb() != "create" && attrs.GetName() != allowedLease) || attrs.GetNamespace() != metav1.NamespaceSystem {
+	// The reboot leases are shared by all gardener-node-agents of a worker pool to limit the number of concurrently
+	// rebooting nodes.
+	isRebootLease := strings.HasPrefix(attrs.GetName(), gardenerutils.NodeRebootLeasePrefix) && (attrs.GetVerb() == "get" || attrs.GetVerb() == "update")
+	if (attrs.GetVerb() != "create" && attrs.GetName() != allowedLease && !isRebootLease) || attrs.GetNamespace() != metav1.NamespaceSystem {
 		log.Info("Denying authorization because gardener-node-agent is not allowed to access the lease", "nodeName", nodeName, "machineName", machineName, "leaseName", attrs.GetName())
 		return auth.DecisionDeny, fmt.Sprintf("this gardener-node-agent can only access lease %q in %q namespace", allowedLease, metav1.NamespaceSystem), nil
 	}
 
+	if isRebootLease {
+		return a.authorizeRebootLease(ctx, log, nodeName, attrs)
+	}
+
 	return auth.DecisionAllow, "", nil
 }
 
+// authorizeRebootLease only allows access to the reboot leases of the worker pool of the node. Updates are only allowed
+// if the lease is not held by a different node, i.e., a node cannot take over the reboot slot of another node unless
+// the lease expired.
+func (a *authorizer) authorizeRebootLease(ctx context.Context, log logr.Logger, nodeName string, attrs auth.Attributes) (auth.Decision, string, error) {
+	node := &corev1.Node{}
+	if err := a.targetClient.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return auth.DecisionDeny, "", fmt.Errorf("error getting node %q: %w", nodeName, err)
+	}
+
+	workerPoolName := node.Labels[v1beta1constants.LabelWorkerPool]
+	if workerPoolName == "" {
+		workerPoolName = nodeRebootDefaultWorkerPoolName
+	}
+
+	slot, ok := strings.CutPrefix(attrs.GetName(), gardenerutils.NodeRebootLeasePrefix+workerPoolName+"-")
+	if _, err := strconv.Atoi(slot); !ok || err != nil {
+		log.Info("Denying authorization because the reboot lease belongs to a different worker pool", "nodeName", nodeName, "workerPool", workerPoolName, "leaseName", attrs.GetName())
+		return auth.DecisionDeny, fmt.Sprintf("this gardener-node-agent can only access the reboot leases of worker pool %q", workerPoolName), nil
+	}
+
+	if attrs.GetVerb() != "update" {
+		return auth.DecisionAllow, "", nil
+	}
+
+	lease := &coordinationv1.Lease{}
+	if err := a.targetClient.Get(ctx, client.ObjectKey{Name: attrs.GetName(), Namespace: attrs.GetNamespace()}, lease); err != nil {
+		if errors.IsNotFound(err) {
+			return auth.DecisionAllow, "", nil
+		}
+		return auth.DecisionDeny, "", fmt.Errorf("error getting lease %q: %w", attrs.GetName(), err)
+	}
+
+	holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+	if holder == "" || holder == nodeName || lease.Spec.RenewTime == nil {
+		return auth.DecisionAllow, "", nil
+	}
+
+	if expiry := lease.Spec.RenewTime.Add(time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second); a.clock.Now().After(expiry) {
+		return auth.DecisionAllow, "", nil
+	}
+
+	log.Info("Denying authorization because the reboot lease is held by a different node", "nodeName", nodeName, "holder", holder, "leaseName", attrs.GetName())
+	return auth.DecisionDeny, fmt.Sprintf("reboot lease %q is held by node %q", attrs.GetName(), holder), nil
+}
+
 func (a *authorizer) authorizeNode(ctx context.Context, log logr.Logger, machineName string, attrs auth.Attributes) (auth.Decision, string, error) {
 	if ok, reason := a.checkSubresource(log, attrs, "status"); !ok {
 		return auth.DecisionDeny, reason, nil
@@ -192,11 +278,15 @@ func (a *authorizer) authorizeNode(ctx context.Context, log logr.Logger, machine
 }
 
 func (a *authorizer) authorizePod(ctx context.Context, log logr.Logger, machineName string, attrs auth.Attributes) (auth.Decision, string, error) {
-	if ok, reason := a.checkSubresource(log, attrs); !ok {
+	if ok, reason := a.checkSubresource(log, attrs, "eviction"); !ok {
 		return auth.DecisionDeny, reason, nil
 	}
 
 	allowedVerbs := []string{"get", "list", "watch", "delete"}
+	if attrs.GetSubresource() == "eviction" {
+		// gardener-node-agent evicts the pods running on its node before coordinated reboots.
+		allowedVerbs = []string{"create"}
+	}
 	if allowed, reason := a.checkVerb(log, attrs, allowedVerbs...); !allowed {
 		return auth.DecisionDeny, reason, nil
 	}
@@ -233,7 +323,7 @@ func (a *authorizer) authorizePod(ctx context.Context, log logr.Logger, machineN
 		log.Info("Denying request because only listing/watching pods with spec.nodeName field selector for the same node is allowed")
 		return auth.DecisionDeny, fmt.Sprintf("can only list/watch pods with spec.nodeName=%s field selector", nodeName), nil
 
-	case "get", "delete":
+	case "get", "delete", "create":
 		return a.authorizeSinglePod(ctx, log, nodeName, attrs)
 	}
 
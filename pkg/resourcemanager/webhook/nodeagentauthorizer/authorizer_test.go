@@ -7,18 +7,22 @@ package nodeagentauthorizer_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	certificatesv1 "k8s.io/api/certificates/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apiserver/pkg/authentication/user"
 	auth "k8s.io/apiserver/pkg/authorization/authorizer"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -38,6 +42,7 @@ var _ = Describe("Authorizer", func() {
 		sourceClient     client.Client
 		targetClient     client.Client
 		machineNamespace string
+		fakeClock        *testclock.FakeClock
 
 		machineName          string
 		machineSecretName    string
@@ -58,7 +63,8 @@ var _ = Describe("Authorizer", func() {
 		sourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 		targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
 		machineNamespace = "shoot--foo"
-		authorizer = NewAuthorizer(log, sourceClient, targetClient, &machineNamespace, true)
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+		authorizer = NewAuthorizer(log, sourceClient, targetClient, fakeClock, &machineNamespace, true)
 
 		machineName = "foo-machine"
 		machineSecretName = "foo-machine-secret"
@@ -108,7 +114,7 @@ var _ = Describe("Authorizer", func() {
 		node = &corev1.Node{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:   nodeName,
-				Labels: map[string]string{"node.gardener.cloud/machine-name": machineName},
+				Labels: map[string]string{"node.gardener.cloud/machine-name": machineName, "worker.gardener.cloud/pool": "worker-1"},
 			},
 		}
 		Expect(targetClient.Create(ctx, node)).To(Succeed())
@@ -256,6 +262,64 @@ var _ = Describe("Authorizer", func() {
 			)
 		})
 
+		Context("#ConfigMaps", func() {
+			It("should allow to get the shoot-info config map", func() {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "shoot-info",
+					Namespace:       "kube-system",
+					APIGroup:        "",
+					Resource:        "configmaps",
+					ResourceRequest: true,
+					Verb:            "get",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionAllow))
+				Expect(reason).To(BeEmpty())
+			})
+
+			It("should deny to get other config maps", func() {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "foo",
+					Namespace:       "kube-system",
+					APIGroup:        "",
+					Resource:        "configmaps",
+					ResourceRequest: true,
+					Verb:            "get",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(`gardener-node-agent can only access config map "shoot-info" in "kube-system" namespace`))
+			})
+
+			DescribeTable("should deny because no allowed verb", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "shoot-info",
+					Namespace:       "kube-system",
+					APIGroup:        "",
+					Resource:        "configmaps",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [get]"))
+			},
+				Entry("list", "list"),
+				Entry("watch", "watch"),
+				Entry("update", "update"),
+				Entry("delete", "delete"),
+			)
+		})
+
 		Context("#Events", func() {
 			DescribeTable("should allow some verbs", func(verb string) {
 				attrs := &auth.AttributesRecord{
@@ -380,6 +444,127 @@ var _ = Describe("Authorizer", func() {
 				Entry("watch", "watch"),
 			)
 
+			DescribeTable("should allow accessing the reboot leases", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "gardener-node-reboot-worker-1-0",
+					Namespace:       "kube-system",
+					APIGroup:        "coordination.k8s.io",
+					Resource:        "leases",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionAllow))
+				Expect(reason).To(BeEmpty())
+			},
+				Entry("get", "get"),
+				Entry("update", "update"),
+			)
+
+			DescribeTable("should deny accessing the reboot leases of a different worker pool", func(leaseName string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            leaseName,
+					Namespace:       "kube-system",
+					APIGroup:        "coordination.k8s.io",
+					Resource:        "leases",
+					ResourceRequest: true,
+					Verb:            "update",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(`this gardener-node-agent can only access the reboot leases of worker pool "worker-1"`))
+			},
+				Entry("different worker pool", "gardener-node-reboot-worker-2-0"),
+				Entry("worker pool with same prefix", "gardener-node-reboot-worker-1-a-0"),
+				Entry("default worker pool", "gardener-node-reboot-default-0"),
+			)
+
+			Context("updating a reboot lease held by a node", func() {
+				var (
+					lease *coordinationv1.Lease
+					attrs *auth.AttributesRecord
+				)
+
+				BeforeEach(func() {
+					lease = &coordinationv1.Lease{
+						ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-reboot-worker-1-0", Namespace: "kube-system"},
+						Spec: coordinationv1.LeaseSpec{
+							HolderIdentity:       ptr.To("other-node"),
+							LeaseDurationSeconds: ptr.To[int32](600),
+							RenewTime:            ptr.To(metav1.NewMicroTime(fakeClock.Now().Add(-5 * time.Minute))),
+						},
+					}
+					attrs = &auth.AttributesRecord{
+						User:            nodeAgentUser,
+						Name:            lease.Name,
+						Namespace:       "kube-system",
+						APIGroup:        "coordination.k8s.io",
+						Resource:        "leases",
+						ResourceRequest: true,
+						Verb:            "update",
+					}
+
+					Expect(targetClient.Create(ctx, lease)).To(Succeed())
+					DeferCleanup(func() {
+						Expect(targetClient.Delete(ctx, lease)).To(Succeed())
+					})
+				})
+
+				It("should deny updating the lease if it is held by a different node", func() {
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionDeny))
+					Expect(reason).To(Equal(`reboot lease "gardener-node-reboot-worker-1-0" is held by node "other-node"`))
+				})
+
+				It("should allow updating the lease if the lease of the different node expired", func() {
+					fakeClock.Step(10 * time.Minute)
+
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionAllow))
+					Expect(reason).To(BeEmpty())
+				})
+
+				It("should allow updating the lease if it is held by the node itself", func() {
+					lease.Spec.HolderIdentity = ptr.To(nodeName)
+					Expect(targetClient.Update(ctx, lease)).To(Succeed())
+
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionAllow))
+					Expect(reason).To(BeEmpty())
+				})
+			})
+
+			DescribeTable("should deny listing or watching the reboot leases", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "gardener-node-reboot-worker-1-0",
+					Namespace:       "kube-system",
+					APIGroup:        "coordination.k8s.io",
+					Resource:        "leases",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				decision, _, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+			},
+				Entry("list", "list"),
+				Entry("watch", "watch"),
+			)
+
 			DescribeTable("should deny accessing a lease which belongs to a different gardener-node-agent instance", func(verb string) {
 				attrs := &auth.AttributesRecord{
 					User:            nodeAgentUser,
@@ -724,7 +909,7 @@ var _ = Describe("Authorizer", func() {
 			DescribeTable("should allow accessing the pods because authorizeWithSelectors is false", func(verb string) {
 				attrs.Name = ""
 				attrs.Verb = verb
-				authorizer = NewAuthorizer(log, sourceClient, targetClient, &machineNamespace, false)
+				authorizer = NewAuthorizer(log, sourceClient, targetClient, fakeClock, &machineNamespace, false)
 				decision, reason, err := authorizer.Authorize(ctx, attrs)
 
 				Expect(err).NotTo(HaveOccurred())
@@ -821,6 +1006,42 @@ var _ = Describe("Authorizer", func() {
 				Entry("watch", "watch"),
 			)
 
+			It("should allow evicting pods which belong to the same node", func() {
+				attrs.Subresource = "eviction"
+				attrs.Verb = "create"
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionAllow))
+				Expect(reason).To(BeEmpty())
+			})
+
+			It("should deny evicting pods which belong to a different node", func() {
+				attrs.Subresource = "eviction"
+				attrs.Verb = "create"
+				pod.Spec.NodeName = "different-node"
+				Expect(targetClient.Update(ctx, pod)).To(Succeed())
+
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(ContainSubstring(fmt.Sprintf("pod %q does not belong to node %q", client.ObjectKeyFromObject(pod), nodeName)))
+			})
+
+			DescribeTable("should deny because no allowed verb for the eviction subresource", func(verb string) {
+				attrs.Subresource = "eviction"
+				attrs.Verb = verb
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [create]"))
+			},
+				Entry("get", "get"),
+				Entry("delete", "delete"),
+			)
+
 			DescribeTable("should deny because no allowed verb", func(verb string) {
 				attrs.Verb = verb
 				decision, reason, err := authorizer.Authorize(ctx, attrs)
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package availability
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// DataKey is the key in the data of the availability ConfigMap holding the JSON-encoded Record.
+const DataKey = "availability.json"
+
+// Record is the rolling availability record of the conditions of a shoot. It is maintained by gardenlet in the
+// '<shoot-name>.availability' ConfigMap in the project namespace of the shoot, which is labeled with
+// 'gardener.cloud/role=availability'. Consumers like gardener-metrics-exporter read it via FromConfigMap.
+type Record struct {
+	// Window is the duration of the rolling window the availability is computed for.
+	Window metav1.Duration `json:"window"`
+	// Conditions contains the availability of the individual shoot conditions.
+	Conditions []ConditionAvailability `json:"conditions"`
+}
+
+// ConditionAvailability is the availability of a single shoot condition.
+type ConditionAvailability struct {
+	// Type is the type of the condition.
+	Type gardencorev1beta1.ConditionType `json:"type"`
+	// TrackedSince is the time when the tracking of the condition started.
+	TrackedSince metav1.Time `json:"trackedSince"`
+	// Incidents is the list of periods in which the condition was False, ordered by their start time.
+	Incidents []Incident `json:"incidents,omitempty"`
+}
+
+// Incident is a period in which a shoot condition was False.
+type Incident struct {
+	// Start is the time when the condition became False.
+	Start metav1.Time `json:"start"`
+	// End is the time when the condition stopped being False. It is unset for ongoing incidents.
+	End *metav1.Time `json:"end,omitempty"`
+	// Reason is the reason of the condition when the incident started.
+	Reason string `json:"reason,omitempty"`
+}
+
+// FromConfigMap decodes the availability record from the given availability ConfigMap.
+func FromConfigMap(configMap *corev1.ConfigMap) (*Record, error) {
+	raw, ok := configMap.Data[DataKey]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s/%s does not contain key %q", configMap.Namespace, configMap.Name, DataKey)
+	}
+
+	record := &Record{}
+	if err := json.Unmarshal([]byte(raw), record); err != nil {
+		return nil, fmt.Errorf("failed decoding availability record of ConfigMap %s/%s: %w", configMap.Namespace, configMap.Name, err)
+	}
+	return record, nil
+}
+
+// ComputePercentage computes the percentage of the observed part of the window in which the condition was not False.
+// If the tracking started less than the window ago, only the time since the start of the tracking is considered.
+func ComputePercentage(availability ConditionAvailability, window time.Duration, now time.Time) float64 {
+	observedSince := now.Add(-window)
+	if availability.TrackedSince.After(observedSince) {
+		observedSince = availability.TrackedSince.Time
+	}
+
+	observed := now.Sub(observedSince)
+	if observed <= 0 {
+		return 100
+	}
+
+	var downtime time.Duration
+	for _, incident := range availability.Incidents {
+		start, end := incident.Start.Time, now
+		if incident.End != nil {
+			end = incident.End.Time
+		}
+		if start.Before(observedSince) {
+			start = observedSince
+		}
+		if end.After(start) {
+			downtime += end.Sub(start)
+		}
+	}
+
+	if downtime > observed {
+		downtime = observed
+	}
+
+	return 100 * (1 - downtime.Seconds()/observed.Seconds())
+}
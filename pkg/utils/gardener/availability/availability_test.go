@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package availability_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener/availability"
+)
+
+var _ = Describe("Availability", func() {
+	var (
+		now    = time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+		window = 30 * 24 * time.Hour
+	)
+
+	Describe("#FromConfigMap", func() {
+		It("should decode the availability record", func() {
+			record, err := FromConfigMap(&corev1.ConfigMap{Data: map[string]string{DataKey: `{"window":"720h0m0s","conditions":[{"type":"APIServerAvailable","trackedSince":"2026-01-01T00:00:00Z","incidents":[{"start":"2026-01-12T10:00:00Z","end":"2026-01-12T10:20:00Z","reason":"HealthzRequestFailed"}]}]}`}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(record).To(Equal(&Record{
+				Window: metav1.Duration{Duration: window},
+				Conditions: []ConditionAvailability{{
+					Type:         gardencorev1beta1.ShootAPIServerAvailable,
+					TrackedSince: metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Local()),
+					Incidents: []Incident{{
+						Start:  metav1.NewTime(time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC).Local()),
+						End:    &metav1.Time{Time: time.Date(2026, 1, 12, 10, 20, 0, 0, time.UTC).Local()},
+						Reason: "HealthzRequestFailed",
+					}},
+				}},
+			}))
+		})
+
+		It("should fail if the data key is missing", func() {
+			_, err := FromConfigMap(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo.availability", Namespace: "garden-bar"}})
+			Expect(err).To(MatchError(ContainSubstring(`does not contain key "availability.json"`)))
+		})
+
+		It("should fail if the record is corrupted", func() {
+			_, err := FromConfigMap(&corev1.ConfigMap{Data: map[string]string{DataKey: "{"}})
+			Expect(err).To(MatchError(ContainSubstring("failed decoding availability record")))
+		})
+	})
+
+	Describe("#ComputePercentage", func() {
+		It("should return full availability without incidents", func() {
+			Expect(ComputePercentage(ConditionAvailability{TrackedSince: metav1.Time{Time: now.Add(-time.Hour)}}, window, now)).To(Equal(100.0))
+		})
+
+		It("should return full availability if the tracking just started", func() {
+			Expect(ComputePercentage(ConditionAvailability{TrackedSince: metav1.Time{Time: now}}, window, now)).To(Equal(100.0))
+		})
+
+		It("should only consider the time since the tracking started", func() {
+			Expect(ComputePercentage(ConditionAvailability{
+				TrackedSince: metav1.Time{Time: now.Add(-10 * time.Hour)},
+				Incidents:    []Incident{{Start: metav1.Time{Time: now.Add(-2 * time.Hour)}, End: &metav1.Time{Time: now.Add(-time.Hour)}}},
+			}, window, now)).To(BeNumerically("~", 90.0, 0.001))
+		})
+
+		It("should account ongoing incidents until now", func() {
+			Expect(ComputePercentage(ConditionAvailability{
+				TrackedSince: metav1.Time{Time: now.Add(-10 * time.Hour)},
+				Incidents:    []Incident{{Start: metav1.Time{Time: now.Add(-5 * time.Hour)}}},
+			}, window, now)).To(BeNumerically("~", 50.0, 0.001))
+		})
+
+		It("should only consider the part of incidents within the window", func() {
+			Expect(ComputePercentage(ConditionAvailability{
+				TrackedSince: metav1.Time{Time: now.Add(-60 * 24 * time.Hour)},
+				Incidents:    []Incident{{Start: metav1.Time{Time: now.Add(-31 * 24 * time.Hour)}, End: &metav1.Time{Time: now.Add(-27 * 24 * time.Hour)}}},
+			}, window, now)).To(BeNumerically("~", 90.0, 0.001))
+		})
+	})
+})
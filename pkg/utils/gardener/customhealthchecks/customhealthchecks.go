@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package customhealthchecks
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// ObjectVariable is the name of the variable the checked object is bound to in the expressions.
+	ObjectVariable = "object"
+	// MaxChecks is the maximum number of custom health checks which can be declared for a Shoot.
+	MaxChecks = 20
+
+	// costLimit is the maximum cost of evaluating a single custom health check expression for a single object.
+	costLimit = 1000000
+	// cacheSize is the maximum number of compiled expressions kept in the cache.
+	cacheSize = 1000
+)
+
+// cache contains the compiled programs by their expressions. Compiling CEL expressions is expensive, hence they are only
+// compiled again if the expression changed.
+var cache = lru.New(cacheSize)
+
+// Compile compiles the given custom health check expression. The expression must evaluate to a bool. The returned
+// program is shared and safe for concurrent use.
+func Compile(expression string) (cel.Program, error) {
+	if cached, ok := cache.Get(expression); ok {
+		return cached.(cel.Program), nil
+	}
+
+	env, err := cel.NewEnv(cel.Variable(ObjectVariable, cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool but has type %s", ast.OutputType())
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	cache.Add(expression, program)
+	return program, nil
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package customhealthchecks_test
+
+import (
+	"github.com/google/cel-go/common/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/gardener/customhealthchecks"
+)
+
+var _ = Describe("CustomHealthChecks", func() {
+	Describe("#Compile", func() {
+		It("should compile a valid expression", func() {
+			program, err := Compile("object.status.readyReplicas >= 2")
+			Expect(err).NotTo(HaveOccurred())
+
+			result, _, err := program.Eval(map[string]any{ObjectVariable: map[string]any{"status": map[string]any{"readyReplicas": 3}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(types.True))
+		})
+
+		It("should return the cached program for unchanged expressions", func() {
+			program1, err := Compile("object.spec.unschedulable != true")
+			Expect(err).NotTo(HaveOccurred())
+			program2, err := Compile("object.spec.unschedulable != true")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(program2).To(BeIdenticalTo(program1))
+		})
+
+		It("should fail for invalid expressions", func() {
+			_, err := Compile("'foo' +")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for expressions which do not evaluate to bool", func() {
+			_, err := Compile("'foo'")
+			Expect(err).To(MatchError(ContainSubstring("expression must evaluate to bool")))
+		})
+	})
+})
@@ -28,6 +28,9 @@ const (
 	MachineDeploymentKind = "MachineDeployment"
 	// NodeLeasePrefix describes the Prefix of the lease that this node is corresponding to
 	NodeLeasePrefix = "gardener-node-agent-"
+	// NodeRebootLeasePrefix describes the prefix of the leases which are used by gardener-node-agents to limit the
+	// number of concurrently rebooting nodes per worker pool.
+	NodeRebootLeasePrefix = "gardener-node-reboot-"
 )
 
 // BuildOwnerToMachinesMap returns a map that associates `MachineSet` names to the given `machines`.
@@ -183,6 +186,11 @@ func NodeAgentLeaseName(nodeName string) string {
 	return NodeLeasePrefix + nodeName
 }
 
+// NodeRebootLeaseName returns the name of the Lease object for the given reboot slot of the given worker pool.
+func NodeRebootLeaseName(workerPoolName string, slot int) string {
+	return fmt.Sprintf("%s%s-%d", NodeRebootLeasePrefix, workerPoolName, slot)
+}
+
 // IsMachineDeploymentStrategyManualInPlace checks whether the given strategy is InPlaceUpdate and orchestration type is Manual.
 func IsMachineDeploymentStrategyManualInPlace(strategy machinev1alpha1.MachineDeploymentStrategy) bool {
 	return strategy.Type == machinev1alpha1.InPlaceUpdateMachineDeploymentStrategyType && strategy.InPlaceUpdate != nil && strategy.InPlaceUpdate.OrchestrationType == machinev1alpha1.OrchestrationTypeManual
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metricnames
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"unicode"
+)
+
+// Count returns the number of metric names matched by the given regular expression. Only regular expressions matching
+// a finite set of names are accepted, i.e., wildcards, anchors and unbounded repetitions are rejected. The result is
+// capped at the given maximum.
+func Count(expr string, maximum int) (int, error) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return 0, fmt.Errorf("must be a valid regular expression: %w", err)
+	}
+
+	return countMatches(re.Simplify(), maximum)
+}
+
+func countMatches(re *syntax.Regexp, maximum int) (int, error) {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return 1, nil
+
+	case syntax.OpLiteral:
+		count := 1
+		if re.Flags&syntax.FoldCase != 0 {
+			for _, r := range re.Rune {
+				if unicode.SimpleFold(r) != r {
+					count = min(count*2, maximum)
+				}
+			}
+		}
+		return count, nil
+
+	case syntax.OpCharClass:
+		count := 0
+		for i := 0; i < len(re.Rune); i += 2 {
+			count = min(count+int(re.Rune[i+1]-re.Rune[i])+1, maximum)
+		}
+		return count, nil
+
+	case syntax.OpCapture:
+		return countMatches(re.Sub[0], maximum)
+
+	case syntax.OpQuest:
+		count, err := countMatches(re.Sub[0], maximum)
+		return min(count+1, maximum), err
+
+	case syntax.OpConcat, syntax.OpAlternate:
+		result := 0
+		if re.Op == syntax.OpConcat {
+			result = 1
+		}
+
+		for _, sub := range re.Sub {
+			count, err := countMatches(sub, maximum)
+			if err != nil {
+				return 0, err
+			}
+
+			if re.Op == syntax.OpConcat {
+				result = min(result*count, maximum)
+			} else {
+				result = min(result+count, maximum)
+			}
+		}
+		return result, nil
+
+	default:
+		return 0, fmt.Errorf("must only match a limited set of metric names, wildcards, anchors and unbounded repetitions are not supported")
+	}
+}
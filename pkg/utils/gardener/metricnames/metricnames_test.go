@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metricnames_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/gardener/metricnames"
+)
+
+var _ = Describe("MetricNames", func() {
+	DescribeTable("#Count",
+		func(expr string, expected int) {
+			Expect(Count(expr, 10)).To(Equal(expected))
+		},
+
+		Entry("metric name", "apiserver_request_total", 1),
+		Entry("alternation", "kube_pod_(info|labels)", 2),
+		Entry("nested alternation", "etcd_(disk|mvcc)_(a|b)_total", 4),
+		Entry("optional suffix", "up(_total)?", 2),
+		Entry("character class", "kube_[a-c]", 3),
+		Entry("case-insensitive literal", "(?i)up", 4),
+		Entry("capped at the maximum", "[a-z]", 10),
+	)
+
+	DescribeTable("#Count with unsupported expressions",
+		func(expr, errorSubstring string) {
+			_, err := Count(expr, 10)
+			Expect(err).To(MatchError(ContainSubstring(errorSubstring)))
+		},
+
+		Entry("wildcard", "etcd_.+", "wildcards, anchors and unbounded repetitions are not supported"),
+		Entry("unbounded repetition", "up_*", "wildcards, anchors and unbounded repetitions are not supported"),
+		Entry("anchor", "^up$", "wildcards, anchors and unbounded repetitions are not supported"),
+		Entry("invalid regular expression", "(", "must be a valid regular expression"),
+	)
+})
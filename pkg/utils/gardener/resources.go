@@ -8,11 +8,14 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	unstructuredutils "github.com/gardener/gardener/pkg/utils/kubernetes/unstructured"
 )
 
@@ -46,3 +49,34 @@ func PrepareReferencedResourcesForSeedCopy(ctx context.Context, cl client.Client
 
 	return unstructuredObjs, nil
 }
+
+// SecretKeyReference references a key of a secret referenced in the `.spec.resources` of a Shoot. It is used in the
+// Shoot API as well as in the configuration which shoot owners provide via referenced ConfigMaps.
+type SecretKeyReference = gardencorev1beta1.ResourceSecretKeyReference
+
+// ValidateSecretKeyReference validates that the given reference refers to a secret in the given resource references
+// of a Shoot.
+func ValidateSecretKeyReference(ref SecretKeyReference, resources []gardencorev1beta1.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ref.ResourceName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceName"), "must provide a resource name"))
+	} else if v1beta1helper.GetReferencedSecretName(resources, ref.ResourceName) == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceName"), ref.ResourceName, "must refer to a secret in the .spec.resources of the Shoot"))
+	}
+
+	if ref.Key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "must provide a key"))
+	}
+
+	return allErrs
+}
+
+// ReferencedSecretKeySelector returns a selector for the key of the secret in the control plane namespace which is the
+// copy of the secret referenced by the given reference.
+func ReferencedSecretKeySelector(ref SecretKeyReference, resources []gardencorev1beta1.NamedResourceReference) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: v1beta1constants.ReferencedResourcesPrefix + v1beta1helper.GetReferencedSecretName(resources, ref.ResourceName)},
+		Key:                  ref.Key,
+	}
+}
@@ -10,9 +10,11 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -147,4 +149,52 @@ var _ = Describe("Resources", func() {
 			Expect(err).To(MatchError(ContainSubstring("object not found")))
 		})
 	})
+
+	Context("secret key references", func() {
+		var resources []gardencorev1beta1.NamedResourceReference
+
+		BeforeEach(func() {
+			resources = []gardencorev1beta1.NamedResourceReference{
+				{Name: "credentials", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-credentials"}},
+				{Name: "config", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-config"}},
+			}
+		})
+
+		Describe("#ValidateSecretKeyReference", func() {
+			It("should succeed for a reference to a secret", func() {
+				Expect(ValidateSecretKeyReference(SecretKeyReference{ResourceName: "credentials", Key: "token"}, resources, field.NewPath("ref"))).To(BeEmpty())
+			})
+
+			It("should fail for references to unknown resources and empty keys", func() {
+				Expect(ValidateSecretKeyReference(SecretKeyReference{ResourceName: "config"}, resources, field.NewPath("ref"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("ref.resourceName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("ref.key"),
+					})),
+				))
+			})
+
+			It("should fail for an empty resource name", func() {
+				Expect(ValidateSecretKeyReference(SecretKeyReference{Key: "token"}, resources, field.NewPath("ref"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("ref.resourceName"),
+					})),
+				))
+			})
+		})
+
+		Describe("#ReferencedSecretKeySelector", func() {
+			It("should return a selector for the copy of the referenced secret", func() {
+				Expect(ReferencedSecretKeySelector(SecretKeyReference{ResourceName: "credentials", Key: "token"}, resources)).To(Equal(&corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "ref-my-credentials"},
+					Key:                  "token",
+				}))
+			})
+		})
+	})
 })
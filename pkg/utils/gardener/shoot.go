@@ -215,6 +215,8 @@ const (
 	ShootProjectConfigMapSuffixCACluster = "ca-cluster"
 	// ShootProjectConfigMapSuffixCAKubelet is a constant for a shoot project secret with suffix 'ca-kubelet'.
 	ShootProjectConfigMapSuffixCAKubelet = "ca-kubelet"
+	// ShootProjectConfigMapSuffixAvailability is a constant for a shoot project config map with suffix 'availability'.
+	ShootProjectConfigMapSuffixAvailability = "availability"
 )
 
 // GetShootProjectSecretSuffixes returns the list of shoot-related project secret suffixes.
@@ -239,6 +241,7 @@ func GetShootProjectConfigMapSuffixes() []string {
 	return []string{
 		ShootProjectConfigMapSuffixCACluster,
 		ShootProjectConfigMapSuffixCAKubelet,
+		ShootProjectConfigMapSuffixAvailability,
 	}
 }
 
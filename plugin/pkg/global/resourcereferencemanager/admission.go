@@ -1019,6 +1019,19 @@ func (r *ReferenceManager) ensureShootReferences(ctx context.Context, attributes
 		}
 	}
 
+	alertingRulesConfigMapName := func(monitoring *core.Monitoring) string {
+		if monitoring != nil && monitoring.Alerting != nil && monitoring.Alerting.Rules != nil {
+			return monitoring.Alerting.Rules.ConfigMapName
+		}
+		return ""
+	}
+
+	if configMapName := alertingRulesConfigMapName(shoot.Spec.Monitoring); configMapName != "" && configMapName != alertingRulesConfigMapName(oldShoot.Spec.Monitoring) && shoot.DeletionTimestamp == nil {
+		if err := r.lookupConfigMap(ctx, shoot.Namespace, configMapName); err != nil {
+			return fmt.Errorf("failed to resolve alerting rules config map reference: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1467,6 +1467,16 @@ var _ = Describe("resourcereferencemanager", func() {
 					}
 				}, "failed to resolve structured authorization kubeconfig secret reference")
 			})
+
+			Context("alerting rules config maps", func() {
+				tests("alerting rules config map", "configmaps", func(shoot *core.Shoot) {
+					shoot.Spec.Monitoring = &core.Monitoring{
+						Alerting: &core.Alerting{
+							Rules: &core.AlertingRules{ConfigMapName: "foo"},
+						},
+					}
+				}, "failed to resolve alerting rules config map reference")
+			})
 		})
 
 		Context("tests for Seed objects", func() {
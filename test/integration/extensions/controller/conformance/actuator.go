@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// actuator is a minimal Infrastructure actuator which fulfills the contract checked by the conformance suite. It
+// reports a provider status and persists its state so that it can be restored after a migration.
+type actuator struct {
+	client client.Client
+}
+
+func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, _ *extensionscontroller.Cluster) error {
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"region":"` + infrastructure.Spec.Region + `"}`)}
+	infrastructure.Status.State = &runtime.RawExtension{Raw: []byte(`{"network":"` + infrastructure.Name + `"}`)}
+	return a.client.Status().Patch(ctx, infrastructure, patch)
+}
+
+func (a *actuator) Delete(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
+	return nil
+}
+
+func (a *actuator) ForceDelete(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
+	return nil
+}
+
+func (a *actuator) Migrate(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
+	return nil
+}
+
+func (a *actuator) Restore(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return a.Reconcile(ctx, log, infrastructure, cluster)
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	controllerconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/conformance"
+	"github.com/gardener/gardener/extensions/pkg/controller/heartbeat"
+	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	extensionsintegrationtest "github.com/gardener/gardener/test/integration/extensions/controller"
+)
+
+func TestConformance(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Integration Extensions Controller Conformance Suite")
+}
+
+const testID = "extensions-conformance-test"
+
+var (
+	ctx = context.Background()
+	log logr.Logger
+
+	restConfig *rest.Config
+	testEnv    *envtest.Environment
+	testClient client.Client
+
+	heartbeatNamespace *corev1.Namespace
+)
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, zap.WriteTo(GinkgoWriter)))
+	log = logf.Log.WithName(testID)
+
+	By("Start test environment")
+	var err error
+	testEnv, err = conformance.NewTestEnvironment()
+	Expect(err).NotTo(HaveOccurred())
+
+	restConfig, err = testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(restConfig).NotTo(BeNil())
+
+	DeferCleanup(func() {
+		By("Stop test environment")
+		Expect(testEnv.Stop()).To(Succeed())
+	})
+
+	By("Create test client")
+	testClient, err = client.New(restConfig, client.Options{Scheme: kubernetes.SeedScheme})
+	Expect(err).NotTo(HaveOccurred())
+
+	By("Create heartbeat Namespace")
+	heartbeatNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: testID + "-"}}
+	Expect(testClient.Create(ctx, heartbeatNamespace)).To(Succeed())
+
+	DeferCleanup(func() {
+		By("Delete heartbeat Namespace")
+		Expect(testClient.Delete(ctx, heartbeatNamespace)).To(Or(Succeed(), BeNotFoundError()))
+	})
+
+	By("Setup manager")
+	mgr, err := manager.New(restConfig, manager.Options{
+		Scheme:  kubernetes.SeedScheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+		Controller: controllerconfig.Controller{
+			SkipNameValidation: ptr.To(true),
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	By("Register controllers")
+	Expect(infrastructure.Add(mgr, infrastructure.AddArgs{
+		Actuator:   &actuator{client: mgr.GetClient()},
+		Predicates: infrastructure.DefaultPredicates(ctx, mgr, false),
+		Type:       extensionsintegrationtest.Type,
+	})).To(Succeed())
+
+	Expect(heartbeat.Add(mgr, heartbeat.AddArgs{
+		ControllerOptions:    controller.Options{},
+		ExtensionName:        testID,
+		Namespace:            heartbeatNamespace.Name,
+		RenewIntervalSeconds: 1,
+		Clock:                clock.RealClock{},
+	})).To(Succeed())
+
+	By("Start manager")
+	mgrContext, mgrCancel := context.WithCancel(ctx)
+
+	go func() {
+		defer GinkgoRecover()
+		Expect(mgr.Start(mgrContext)).To(Succeed())
+	}()
+
+	DeferCleanup(func() {
+		By("Stop manager")
+		mgrCancel()
+	})
+})
+
+var _ = conformance.DescribeSuite(func() conformance.Config {
+	return conformance.Config{
+		Client:             testClient,
+		Log:                log,
+		ExtensionName:      testID,
+		NewCluster:         newCluster,
+		HeartbeatNamespace: heartbeatNamespace.Name,
+		PollInterval:       100 * time.Millisecond,
+		Timeout:            time.Minute,
+	}
+}, infrastructureTestCase)
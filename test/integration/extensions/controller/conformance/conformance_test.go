@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/conformance"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsintegrationtest "github.com/gardener/gardener/test/integration/extensions/controller"
+)
+
+var infrastructureTestCase = conformance.TestCase{
+	Kind: extensionsv1alpha1.InfrastructureResource,
+	NewObject: func(namespace string) extensionsv1alpha1.Object {
+		return &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "infrastructure",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: extensionsintegrationtest.Type,
+				},
+				Region:    "region",
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+			},
+		}
+	},
+	Update: func(obj extensionsv1alpha1.Object) {
+		obj.(*extensionsv1alpha1.Infrastructure).Spec.Region = "other-region"
+	},
+	Verify: func(_ context.Context, obj extensionsv1alpha1.Object) error {
+		infrastructure := obj.(*extensionsv1alpha1.Infrastructure)
+		if expected := `{"region":"` + infrastructure.Spec.Region + `"}`; string(infrastructure.Status.ProviderStatus.Raw) != expected {
+			return fmt.Errorf("status.providerStatus is %s, expected %s", infrastructure.Status.ProviderStatus.Raw, expected)
+		}
+		return nil
+	},
+	RequireProviderStatus: true,
+	RequireState:          true,
+}
+
+func newCluster(namespace string) *extensionsv1alpha1.Cluster {
+	return &extensionsv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+		},
+		Spec: extensionsv1alpha1.ClusterSpec{
+			CloudProfile: runtime.RawExtension{Raw: []byte("{}")},
+			Seed:         runtime.RawExtension{Raw: []byte("{}")},
+			Shoot:        runtime.RawExtension{Raw: []byte("{}")},
+		},
+	}
+}
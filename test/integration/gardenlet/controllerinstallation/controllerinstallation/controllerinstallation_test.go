@@ -567,6 +567,15 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 			}}
 			Expect(testClient.Create(ctx, gardenClusterServiceAccount)).To(Succeed())
 
+			By("Create last healthy revision snapshot")
+			// This Secret is typically created by the care controller which does not run in this integration test, so
+			// let's fake it here.
+			lastHealthySecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:      controllerInstallation.Name + "-last-healthy",
+				Namespace: "garden",
+			}}
+			Expect(testClient.Create(ctx, lastHealthySecret)).To(Succeed())
+
 			By("Delete ControllerInstallation")
 			Expect(testClient.Delete(ctx, controllerInstallation)).To(Succeed())
 
@@ -580,6 +589,7 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
 			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
 			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(gardenClusterServiceAccount), gardenClusterServiceAccount)).To(BeNotFoundError())
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(lastHealthySecret), lastHealthySecret)).To(BeNotFoundError())
 		})
 
 		It("should not overwrite the Installed condition when it is not 'Unknown'", func() {